@@ -448,6 +448,8 @@ func (f *factory) RepoOverride(repo string) error {
 	return nil
 }
 
+func (f *factory) BranchOverride(branch string) {}
+
 func (f *factory) ApiClient(repoHost string) (*api.Client, error) {
 	return nil, errors.New("not implemented")
 }
@@ -101,6 +101,18 @@ func main() {
 				}
 			},
 
+			// configure color theme
+			func(i *iostreams.IOStreams) {
+				name, _ := cfg.Get("", "theme")
+				colors, _ := cfg.Get("", "theme_colors")
+				theme, err := iostreams.NewTheme(name, colors)
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "ignoring the color theme: %s\n", err)
+					return
+				}
+				i.SetTheme(theme)
+			},
+
 			// configure prompt
 			func(i *iostreams.IOStreams) {
 				if value, found := utils.IsEnvVarEnabled("NO_PROMPT"); found {
@@ -128,7 +140,15 @@ func main() {
 	if len(os.Args) > 0 {
 		expandedArgs = os.Args[1:]
 	}
-	rootCmd := commands.NewCmdRoot(cmdFactory)
+	if len(expandedArgs) == 1 && expandedArgs[0] == profileStartupFlag {
+		if err := profileStartup(cmdFactory); err != nil {
+			cmdFactory.IO().LogErrorf("failed to profile start-up: %s\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	rootCmd := commands.NewCmdRootForArgs(cmdFactory, expandedArgs)
 	cmd, _, err := rootCmd.Traverse(expandedArgs)
 
 	setupTelemetryHook(cfg, cmdFactory, cmd)
@@ -146,6 +166,9 @@ func main() {
 			fmt.Printf("%v -> %v\n", originalArgs, expandedArgs)
 		}
 
+		// The expansion can name a command that wasn't built for the original arguments.
+		rootCmd = commands.NewCmdRootForArgs(cmdFactory, expandedArgs)
+
 		if isShell {
 			externalCmd := exec.Command(expandedArgs[0], expandedArgs[1:]...)
 			externalCmd.Stderr = os.Stderr
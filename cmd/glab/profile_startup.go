@@ -0,0 +1,142 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+)
+
+// profileStartupFlag reports the start-up cost instead of running a command.
+const profileStartupFlag = "--profile-startup"
+
+// maxProfiledPackages is how many of the slowest packages to report.
+const maxProfiledPackages = 25
+
+// initTraceLine matches the lines printed by GODEBUG=inittrace=1, like:
+// init gitlab.com/gitlab-org/cli/internal/config @1.2 ms, 0.15 ms clock, 1024 bytes, 12 allocs
+var initTraceLine = regexp.MustCompile(`^init (\S+) @\S+ ms, ([0-9.]+) ms clock, (\d+) bytes, (\d+) allocs$`)
+
+type packageInit struct {
+	pkg    string
+	clock  time.Duration
+	bytes  int64
+	allocs int64
+}
+
+// parseInitTrace reads the package init costs from GODEBUG=inittrace=1 output,
+// slowest first.
+func parseInitTrace(r io.Reader) ([]packageInit, error) {
+	var inits []packageInit
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		m := initTraceLine.FindStringSubmatch(scanner.Text())
+		if m == nil {
+			continue
+		}
+		ms, err := strconv.ParseFloat(m[2], 64)
+		if err != nil {
+			return nil, err
+		}
+		bytes, err := strconv.ParseInt(m[3], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		allocs, err := strconv.ParseInt(m[4], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		inits = append(inits, packageInit{
+			pkg:    m[1],
+			clock:  time.Duration(ms * float64(time.Millisecond)),
+			bytes:  bytes,
+			allocs: allocs,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	slices.SortStableFunc(inits, func(a, b packageInit) int {
+		return int(b.clock - a.clock)
+	})
+	return inits, nil
+}
+
+// profileStartup runs glab again with the Go runtime's init tracing turned on,
+// and reports the slowest packages to initialize and how long each top-level
+// command takes to build.
+func profileStartup(f cmdutils.Factory) error {
+	executable, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	var trace bytes.Buffer
+	child := exec.Command(executable, "--version")
+	child.Env = append(os.Environ(), "GODEBUG="+godebugWithInitTrace(os.Getenv("GODEBUG")))
+	child.Stdout = io.Discard
+	child.Stderr = &trace
+	if err := child.Run(); err != nil {
+		return fmt.Errorf("failed to run %s: %w", executable, err)
+	}
+
+	inits, err := parseInitTrace(&trace)
+	if err != nil {
+		return fmt.Errorf("failed to read the init trace: %w", err)
+	}
+
+	out := f.IO().StdOut
+	c := f.IO().Color()
+
+	var total time.Duration
+	for _, i := range inits {
+		total += i.clock
+	}
+	fmt.Fprintf(out, "%s %d packages, %s\n", c.Bold("Package initialization:"), len(inits), formatDuration(total))
+	table := tableprinter.New(f.IO())
+	table.AddHeader("PACKAGE", "CLOCK", "BYTES", "ALLOCS")
+	for _, i := range inits[:min(len(inits), maxProfiledPackages)] {
+		table.AddRow(i.pkg, formatDuration(i.clock), i.bytes, i.allocs)
+	}
+	fmt.Fprintln(out, table.Render())
+
+	timings := commands.ProfileCommandGroups(f)
+	slices.SortStableFunc(timings, func(a, b commands.CommandGroupTiming) int {
+		return int(b.Duration - a.Duration)
+	})
+	total = 0
+	for _, t := range timings {
+		total += t.Duration
+	}
+	fmt.Fprintf(out, "%s %d commands, %s\n", c.Bold("Command registration:"), len(timings), formatDuration(total))
+	table = tableprinter.New(f.IO())
+	table.AddHeader("COMMAND", "DURATION")
+	for _, t := range timings {
+		table.AddRow(t.Name, formatDuration(t.Duration))
+	}
+	fmt.Fprint(out, table.Render())
+	return nil
+}
+
+// godebugWithInitTrace adds inittrace=1 to the existing GODEBUG settings.
+func godebugWithInitTrace(godebug string) string {
+	if godebug == "" {
+		return "inittrace=1"
+	}
+	return godebug + ",inittrace=1"
+}
+
+func formatDuration(d time.Duration) string {
+	return fmt.Sprintf("%.3fms", float64(d)/float64(time.Millisecond))
+}
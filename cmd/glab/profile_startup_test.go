@@ -0,0 +1,36 @@
+//go:build !integration
+
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseInitTrace(t *testing.T) {
+	trace := heredoc.Doc(`
+		init internal/bytealg @0.007 ms, 0 ms clock, 0 bytes, 0 allocs
+		init github.com/alecthomas/chroma/v2/lexers @12 ms, 11 ms clock, 2413504 bytes, 25862 allocs
+		some unrelated output
+		init gitlab.com/gitlab-org/cli/internal/config @25 ms, 0.15 ms clock, 1024 bytes, 12 allocs
+	`)
+
+	inits, err := parseInitTrace(strings.NewReader(trace))
+	require.NoError(t, err)
+
+	assert.Equal(t, []packageInit{
+		{pkg: "github.com/alecthomas/chroma/v2/lexers", clock: 11 * time.Millisecond, bytes: 2413504, allocs: 25862},
+		{pkg: "gitlab.com/gitlab-org/cli/internal/config", clock: 150 * time.Microsecond, bytes: 1024, allocs: 12},
+		{pkg: "internal/bytealg", clock: 0, bytes: 0, allocs: 0},
+	}, inits)
+}
+
+func Test_godebugWithInitTrace(t *testing.T) {
+	assert.Equal(t, "inittrace=1", godebugWithInitTrace(""))
+	assert.Equal(t, "http2client=0,inittrace=1", godebugWithInitTrace("http2client=0"))
+}
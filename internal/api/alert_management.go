@@ -0,0 +1,211 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// Alert statuses, as used by the GraphQL AlertManagementStatus enum.
+const (
+	AlertStatusTriggered    = "TRIGGERED"
+	AlertStatusAcknowledged = "ACKNOWLEDGED"
+	AlertStatusResolved     = "RESOLVED"
+	AlertStatusIgnored      = "IGNORED"
+)
+
+type AlertIssue struct {
+	IID    string `json:"iid"`
+	WebURL string `json:"webUrl"`
+}
+
+type Alert struct {
+	IID        string      `json:"iid"`
+	Title      string      `json:"title"`
+	Severity   string      `json:"severity"`
+	Status     string      `json:"status"`
+	Service    string      `json:"service"`
+	EventCount int64       `json:"eventCount"`
+	StartedAt  *time.Time  `json:"startedAt"`
+	WebURL     string      `json:"webUrl"`
+	Issue      *AlertIssue `json:"issue"`
+}
+
+type ListAlertsOptions struct {
+	// Statuses filters the alerts by status. All alerts are returned when empty.
+	Statuses []string
+	Search   string
+	First    int
+}
+
+const alertFields = `
+        iid
+        title
+        severity
+        status
+        service
+        eventCount
+        startedAt
+        webUrl
+        issue {
+          iid
+          webUrl
+        }`
+
+const alertsQuery = `
+query($fullPath: ID!, $statuses: [AlertManagementStatus!], $search: String, $first: Int) {
+  project(fullPath: $fullPath) {
+    alertManagementAlerts(statuses: $statuses, search: $search, first: $first, sort: STARTED_AT_DESC) {
+      nodes {` + alertFields + `
+      }
+    }
+  }
+}
+`
+
+// ListAlerts returns the alerts of a project, most recently started first.
+func ListAlerts(client *gitlab.Client, projectPath string, opts *ListAlertsOptions) ([]*Alert, error) {
+	var response struct {
+		graphQLErrors
+		Data struct {
+			Project *struct {
+				Alerts struct {
+					Nodes []*Alert `json:"nodes"`
+				} `json:"alertManagementAlerts"`
+			} `json:"project"`
+		} `json:"data"`
+	}
+
+	variables := map[string]any{"fullPath": projectPath}
+	if len(opts.Statuses) > 0 {
+		variables["statuses"] = opts.Statuses
+	}
+	if opts.Search != "" {
+		variables["search"] = opts.Search
+	}
+	if opts.First > 0 {
+		variables["first"] = opts.First
+	}
+
+	_, err := client.GraphQL.Do(gitlab.GraphQLQuery{
+		Query:     alertsQuery,
+		Variables: variables,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if err := response.err(); err != nil {
+		return nil, err
+	}
+	if response.Data.Project == nil {
+		return nil, fmt.Errorf("project %q not found.", projectPath)
+	}
+
+	return response.Data.Project.Alerts.Nodes, nil
+}
+
+const updateAlertStatusMutation = `
+mutation($projectPath: ID!, $iid: String!, $status: AlertManagementStatus!) {
+  updateAlertStatus(input: {projectPath: $projectPath, iid: $iid, status: $status}) {
+    alert {` + alertFields + `
+    }
+    errors
+  }
+}
+`
+
+// UpdateAlertStatus sets the status of an alert and returns the updated alert.
+func UpdateAlertStatus(client *gitlab.Client, projectPath, iid, status string) (*Alert, error) {
+	var response struct {
+		graphQLErrors
+		Data struct {
+			UpdateAlertStatus *struct {
+				Alert  *Alert   `json:"alert"`
+				Errors []string `json:"errors"`
+			} `json:"updateAlertStatus"`
+		} `json:"data"`
+	}
+
+	_, err := client.GraphQL.Do(gitlab.GraphQLQuery{
+		Query: updateAlertStatusMutation,
+		Variables: map[string]any{
+			"projectPath": projectPath,
+			"iid":         iid,
+			"status":      status,
+		},
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if err := response.err(); err != nil {
+		return nil, err
+	}
+
+	result := response.Data.UpdateAlertStatus
+	if result == nil {
+		return nil, errors.New("failed to update the alert status.")
+	}
+	if len(result.Errors) > 0 {
+		return nil, errors.New(strings.Join(result.Errors, ", "))
+	}
+	if result.Alert == nil {
+		return nil, fmt.Errorf("alert %s not found.", iid)
+	}
+
+	return result.Alert, nil
+}
+
+const createAlertIssueMutation = `
+mutation($projectPath: ID!, $iid: String!) {
+  createAlertIssue(input: {projectPath: $projectPath, iid: $iid}) {
+    issue {
+      iid
+      webUrl
+    }
+    errors
+  }
+}
+`
+
+// CreateAlertIncident creates an incident issue from an alert and returns it.
+func CreateAlertIncident(client *gitlab.Client, projectPath, iid string) (*AlertIssue, error) {
+	var response struct {
+		graphQLErrors
+		Data struct {
+			CreateAlertIssue *struct {
+				Issue  *AlertIssue `json:"issue"`
+				Errors []string    `json:"errors"`
+			} `json:"createAlertIssue"`
+		} `json:"data"`
+	}
+
+	_, err := client.GraphQL.Do(gitlab.GraphQLQuery{
+		Query: createAlertIssueMutation,
+		Variables: map[string]any{
+			"projectPath": projectPath,
+			"iid":         iid,
+		},
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if err := response.err(); err != nil {
+		return nil, err
+	}
+
+	result := response.Data.CreateAlertIssue
+	if result == nil {
+		return nil, errors.New("failed to create an incident from the alert.")
+	}
+	if len(result.Errors) > 0 {
+		return nil, errors.New(strings.Join(result.Errors, ", "))
+	}
+	if result.Issue == nil {
+		return nil, fmt.Errorf("alert %s not found.", iid)
+	}
+
+	return result.Issue, nil
+}
@@ -0,0 +1,153 @@
+//go:build !integration
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListAlerts(t *testing.T) {
+	tests := []struct {
+		name          string
+		opts          *ListAlertsOptions
+		response      string
+		wantVariables map[string]any
+		wantIIDs      []string
+		wantError     string
+	}{
+		{
+			name: "Firing alerts",
+			opts: &ListAlertsOptions{Statuses: []string{AlertStatusTriggered, AlertStatusAcknowledged}, First: 20},
+			response: `{"data": {"project": {"alertManagementAlerts": {"nodes": [
+				{"iid": "2", "title": "High error rate", "severity": "CRITICAL", "status": "TRIGGERED", "eventCount": 3},
+				{"iid": "1", "title": "Disk almost full", "severity": "MEDIUM", "status": "ACKNOWLEDGED", "issue": {"iid": "9"}}
+			]}}}}`,
+			wantVariables: map[string]any{
+				"fullPath": "OWNER/REPO",
+				"statuses": []any{"TRIGGERED", "ACKNOWLEDGED"},
+				"first":    float64(20),
+			},
+			wantIIDs: []string{"2", "1"},
+		},
+		{
+			name:     "All alerts matching a search",
+			opts:     &ListAlertsOptions{Search: "disk"},
+			response: `{"data": {"project": {"alertManagementAlerts": {"nodes": []}}}}`,
+			wantVariables: map[string]any{
+				"fullPath": "OWNER/REPO",
+				"search":   "disk",
+			},
+			wantIIDs: []string{},
+		},
+		{
+			name:      "Project not found",
+			opts:      &ListAlertsOptions{},
+			response:  `{"data": {"project": null}}`,
+			wantError: `project "OWNER/REPO" not found.`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var variables map[string]any
+			client := newGraphQLTestClient(t, tt.response, &variables)
+
+			got, err := ListAlerts(client, "OWNER/REPO", tt.opts)
+			if tt.wantError != "" {
+				require.EqualError(t, err, tt.wantError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantVariables, variables)
+			iids := make([]string, 0, len(got))
+			for _, a := range got {
+				iids = append(iids, a.IID)
+			}
+			assert.Equal(t, tt.wantIIDs, iids)
+		})
+	}
+}
+
+func TestUpdateAlertStatus(t *testing.T) {
+	tests := []struct {
+		name      string
+		response  string
+		wantError string
+	}{
+		{
+			name:     "Status updated",
+			response: `{"data": {"updateAlertStatus": {"alert": {"iid": "2", "title": "High error rate", "status": "RESOLVED"}, "errors": []}}}`,
+		},
+		{
+			name:      "Alert not found",
+			response:  `{"data": {"updateAlertStatus": {"alert": null, "errors": []}}}`,
+			wantError: "alert 2 not found.",
+		},
+		{
+			name:      "Mutation errors",
+			response:  `{"data": {"updateAlertStatus": {"alert": null, "errors": ["Status is invalid"]}}}`,
+			wantError: "Status is invalid",
+		},
+		{
+			name:      "GraphQL errors",
+			response:  `{"errors": [{"message": "The resource that you are attempting to access does not exist"}]}`,
+			wantError: "GraphQL errors: The resource that you are attempting to access does not exist",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var variables map[string]any
+			client := newGraphQLTestClient(t, tt.response, &variables)
+
+			alert, err := UpdateAlertStatus(client, "OWNER/REPO", "2", AlertStatusResolved)
+			if tt.wantError != "" {
+				require.EqualError(t, err, tt.wantError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, map[string]any{"projectPath": "OWNER/REPO", "iid": "2", "status": "RESOLVED"}, variables)
+			assert.Equal(t, AlertStatusResolved, alert.Status)
+		})
+	}
+}
+
+func TestCreateAlertIncident(t *testing.T) {
+	tests := []struct {
+		name      string
+		response  string
+		want      *AlertIssue
+		wantError string
+	}{
+		{
+			name:     "Incident created",
+			response: `{"data": {"createAlertIssue": {"issue": {"iid": "9", "webUrl": "https://gitlab.com/OWNER/REPO/-/issues/9"}, "errors": []}}}`,
+			want:     &AlertIssue{IID: "9", WebURL: "https://gitlab.com/OWNER/REPO/-/issues/9"},
+		},
+		{
+			name:      "Alert already has an incident",
+			response:  `{"data": {"createAlertIssue": {"issue": null, "errors": ["An issue already exists"]}}}`,
+			wantError: "An issue already exists",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newGraphQLTestClient(t, tt.response, nil)
+
+			got, err := CreateAlertIncident(client, "OWNER/REPO", "2")
+			if tt.wantError != "" {
+				require.EqualError(t, err, tt.wantError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,311 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// CacheHeader is set on responses served from the response cache.
+// Its value is either "hit" for fresh entries or "stale" for expired entries served
+// because the GitLab instance could not be reached.
+const CacheHeader = "X-Glab-Cache"
+
+// maxCacheSize is the size of the largest response body that is cached. Larger
+// responses, like artifact and archive downloads, are passed through.
+const maxCacheSize = 1 << 20
+
+// WithoutCache makes a request bypass the response cache. Polling loops use it, so
+// they see fresh data on every request while the cache is enabled.
+func WithoutCache() gitlab.RequestOptionFunc {
+	return gitlab.WithHeader("Cache-Control", "no-cache")
+}
+
+// authHeaders identify the user a response belongs to, so cached responses are never
+// shared between different tokens.
+var authHeaders = []string{
+	gitlab.AccessTokenHeaderName,
+	gitlab.JobTokenHeaderName,
+	"Authorization",
+}
+
+// cacheTransport caches successful GET responses with a JSON body of up to
+// maxCacheSize on disk for ttl. When the GitLab instance can't be reached,
+// expired entries younger than staleTTL are served instead and a (cached)
+// notice is printed once. Any successful write request invalidates all cached
+// responses of the host. Requests with Cache-Control: no-cache are always sent,
+// and their response is stored for later requests.
+type cacheTransport struct {
+	rt       http.RoundTripper
+	dir      string
+	ttl      time.Duration
+	staleTTL time.Duration
+	w        io.Writer
+
+	now        func() time.Time
+	noticeOnce sync.Once
+}
+
+type cacheEntry struct {
+	StoredAt time.Time `json:"stored_at"`
+	Response []byte    `json:"response"`
+}
+
+// DefaultCacheDir returns the directory used for the response cache.
+func DefaultCacheDir() (string, error) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(cacheDir, "glab", "http"), nil
+}
+
+func (c *cacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	isRead, err := isReadRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isRead {
+		resp, err := c.rt.RoundTrip(req)
+		if err == nil && resp.StatusCode < http.StatusBadRequest {
+			_ = os.RemoveAll(c.hostDir(req))
+		}
+		return resp, err
+	}
+
+	if req.Method != http.MethodGet {
+		return c.rt.RoundTrip(req)
+	}
+
+	path := c.entryPath(req)
+	var entry *cacheEntry
+	if !bypassCache(req) {
+		entry, _ = c.load(path)
+	}
+	if entry != nil && c.now().Sub(entry.StoredAt) < c.ttl {
+		if resp, err := entry.response(req, "hit"); err == nil {
+			return resp, nil
+		}
+	}
+
+	resp, err := c.rt.RoundTrip(req)
+	if err != nil {
+		if entry == nil || c.now().Sub(entry.StoredAt) >= c.staleTTL {
+			return nil, err
+		}
+		stale, staleErr := entry.response(req, "stale")
+		if staleErr != nil {
+			return nil, err
+		}
+		c.noticeOnce.Do(func() {
+			fmt.Fprintf(c.w, "(cached) Unable to reach %s. Showing cached data from %s.\n", req.URL.Host, entry.StoredAt.Local().Format(time.RFC1123))
+		})
+		return stale, nil
+	}
+
+	if resp.StatusCode == http.StatusOK && isJSON(resp) {
+		_ = c.store(path, resp)
+	}
+
+	return resp, nil
+}
+
+// bypassCache reports whether req asks for a fresh response with Cache-Control.
+func bypassCache(req *http.Request) bool {
+	for _, directive := range strings.Split(req.Header.Get("Cache-Control"), ",") {
+		if d := strings.TrimSpace(directive); d == "no-cache" || d == "no-store" {
+			return true
+		}
+	}
+	return false
+}
+
+// isJSON reports whether resp has a JSON body, like the responses of the REST and GraphQL APIs.
+func isJSON(resp *http.Response) bool {
+	mediaType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	return err == nil && (mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"))
+}
+
+// isReadRequest reports whether req doesn't modify any data. GraphQL queries are
+// sent with POST, so their body is checked for mutations.
+func isReadRequest(req *http.Request) (bool, error) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true, nil
+	case http.MethodPost:
+		if !strings.HasSuffix(req.URL.Path, "/api/graphql") {
+			return false, nil
+		}
+	default:
+		return false, nil
+	}
+
+	r1, r2, err := drainBody(req.Body)
+	if err != nil {
+		return false, err
+	}
+	req.Body = r2
+
+	var query gitlab.GraphQLQuery
+	if err := json.NewDecoder(r1).Decode(&query); err != nil {
+		return false, nil
+	}
+	return !hasGraphQLWrite(query.Query), nil
+}
+
+// hasGraphQLWrite reports whether a GraphQL document has a mutation or
+// subscription operation, or can't be scanned. It skips the ignored tokens of
+// GraphQL (the byte order mark, whitespace, commas, and comments) and strings,
+// and checks the keyword that starts each top-level definition.
+func hasGraphQLWrite(doc string) bool {
+	depth := 0
+	definitionStart := true
+	for i := 0; i < len(doc); {
+		switch ch := doc[i]; {
+		case strings.HasPrefix(doc[i:], "\ufeff"):
+			i += len("\ufeff")
+		case ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r' || ch == ',':
+			i++
+		case ch == '#':
+			end := strings.IndexAny(doc[i:], "\r\n")
+			if end < 0 {
+				return false
+			}
+			i += end
+		case strings.HasPrefix(doc[i:], `"""`):
+			end := strings.Index(strings.ReplaceAll(doc[i+3:], `\"""`, "\x00\x00\x00\x00"), `"""`)
+			if end < 0 {
+				return true
+			}
+			i += 3 + end + 3
+		case ch == '"':
+			j := i + 1
+			for j < len(doc) && doc[j] != '"' && doc[j] != '\n' {
+				if doc[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			if j >= len(doc) || doc[j] != '"' {
+				return true
+			}
+			i = j + 1
+		case ch == '{':
+			depth++
+			definitionStart = false
+			i++
+		case ch == '}':
+			depth--
+			if depth < 0 {
+				return true
+			}
+			definitionStart = depth == 0
+			i++
+		case ch == '_' || ch >= 'a' && ch <= 'z' || ch >= 'A' && ch <= 'Z':
+			j := i + 1
+			for j < len(doc) && (doc[j] == '_' || doc[j] >= 'a' && doc[j] <= 'z' || doc[j] >= 'A' && doc[j] <= 'Z' || doc[j] >= '0' && doc[j] <= '9') {
+				j++
+			}
+			if definitionStart {
+				if name := doc[i:j]; name == "mutation" || name == "subscription" {
+					return true
+				}
+				definitionStart = false
+			}
+			i = j
+		default:
+			i++
+		}
+	}
+	return false
+}
+
+func (c *cacheTransport) hostDir(req *http.Request) string {
+	return filepath.Join(c.dir, strings.ReplaceAll(req.URL.Host, ":", "_"))
+}
+
+func (c *cacheTransport) entryPath(req *http.Request) string {
+	h := sha256.New()
+	fmt.Fprintln(h, req.Method, req.URL.String())
+	for _, name := range authHeaders {
+		fmt.Fprintln(h, req.Header.Get(name))
+	}
+	return filepath.Join(c.hostDir(req), hex.EncodeToString(h.Sum(nil))+".json")
+}
+
+func (c *cacheTransport) load(path string) (*cacheEntry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var entry cacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// store caches resp unless its body is larger than maxCacheSize. It reads at most
+// maxCacheSize+1 bytes of the body, and the caller reads the rest from resp as usual.
+func (c *cacheTransport) store(path string, resp *http.Response) error {
+	if resp.ContentLength > maxCacheSize {
+		return nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxCacheSize+1))
+	if err != nil {
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		return err
+	}
+	if len(body) > maxCacheSize {
+		resp.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(body), resp.Body), resp.Body}
+		return nil
+	}
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	stored := *resp
+	stored.Body = io.NopCloser(bytes.NewReader(body))
+	var buf bytes.Buffer
+	if err := stored.Write(&buf); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(cacheEntry{StoredAt: c.now(), Response: buf.Bytes()})
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
+func (e *cacheEntry) response(req *http.Request, state string) (*http.Response, error) {
+	resp, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(e.Response)), req)
+	if err != nil {
+		return nil, err
+	}
+	resp.Header.Set(CacheHeader, state)
+	return resp, nil
+}
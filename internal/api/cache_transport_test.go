@@ -0,0 +1,314 @@
+//go:build !integration
+
+package api
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/config"
+)
+
+type fakeRoundTripper struct {
+	calls       int
+	err         error
+	body        string
+	contentType string
+}
+
+func (f *fakeRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	contentType := f.contentType
+	if contentType == "" {
+		contentType = "application/json"
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{contentType}},
+		Body:       io.NopCloser(strings.NewReader(f.body)),
+		Request:    req,
+	}, nil
+}
+
+func newTestCacheTransport(t *testing.T, rt http.RoundTripper, now *time.Time) (*cacheTransport, *bytes.Buffer) {
+	t.Helper()
+
+	var stderr bytes.Buffer
+	return &cacheTransport{
+		rt:       rt,
+		dir:      t.TempDir(),
+		ttl:      time.Minute,
+		staleTTL: 24 * time.Hour,
+		w:        &stderr,
+		now:      func() time.Time { return *now },
+	}, &stderr
+}
+
+func doRequest(t *testing.T, rt http.RoundTripper, method, url, token, body string) (*http.Response, string) {
+	t.Helper()
+
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("PRIVATE-TOKEN", token)
+
+	resp, err := rt.RoundTrip(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp, string(data)
+}
+
+func TestCacheTransport(t *testing.T) {
+	const url = "https://gitlab.example.com/api/v4/projects/1/merge_requests"
+
+	t.Run("Fresh responses are served from the cache", func(t *testing.T) {
+		now := time.Now()
+		upstream := &fakeRoundTripper{body: `[{"iid": 1}]`}
+		cache, _ := newTestCacheTransport(t, upstream, &now)
+
+		resp, body := doRequest(t, cache, http.MethodGet, url, "token", "")
+		assert.Empty(t, resp.Header.Get(CacheHeader))
+		assert.Equal(t, `[{"iid": 1}]`, body)
+
+		upstream.body = `[{"iid": 2}]`
+		resp, body = doRequest(t, cache, http.MethodGet, url, "token", "")
+		assert.Equal(t, "hit", resp.Header.Get(CacheHeader))
+		assert.Equal(t, `[{"iid": 1}]`, body)
+		assert.Equal(t, 1, upstream.calls)
+	})
+
+	t.Run("Requests with Cache-Control: no-cache are always sent", func(t *testing.T) {
+		now := time.Now()
+		upstream := &fakeRoundTripper{body: `{"status": "running"}`}
+		cache, _ := newTestCacheTransport(t, upstream, &now)
+
+		doRequest(t, cache, http.MethodGet, url, "token", "")
+
+		upstream.body = `{"status": "success"}`
+		req, err := http.NewRequest(http.MethodGet, url, nil)
+		require.NoError(t, err)
+		req.Header.Set("PRIVATE-TOKEN", "token")
+		req.Header.Set("Cache-Control", "no-cache")
+		resp, err := cache.RoundTrip(req)
+		require.NoError(t, err)
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Empty(t, resp.Header.Get(CacheHeader))
+		assert.JSONEq(t, `{"status": "success"}`, string(body))
+
+		// The fresh response is stored for later requests.
+		resp, body2 := doRequest(t, cache, http.MethodGet, url, "token", "")
+		assert.Equal(t, "hit", resp.Header.Get(CacheHeader))
+		assert.JSONEq(t, `{"status": "success"}`, body2)
+		assert.Equal(t, 2, upstream.calls)
+	})
+
+	t.Run("Responses that aren't JSON are not cached", func(t *testing.T) {
+		now := time.Now()
+		upstream := &fakeRoundTripper{body: "PK\x03\x04", contentType: "application/zip"}
+		cache, _ := newTestCacheTransport(t, upstream, &now)
+
+		doRequest(t, cache, http.MethodGet, url+"/1/artifacts", "token", "")
+		resp, body := doRequest(t, cache, http.MethodGet, url+"/1/artifacts", "token", "")
+		assert.Empty(t, resp.Header.Get(CacheHeader))
+		assert.Equal(t, "PK\x03\x04", body)
+		assert.Equal(t, 2, upstream.calls)
+	})
+
+	t.Run("Responses larger than the size limit are not cached", func(t *testing.T) {
+		now := time.Now()
+		large := `"` + strings.Repeat("a", maxCacheSize) + `"`
+		upstream := &fakeRoundTripper{body: large}
+		cache, _ := newTestCacheTransport(t, upstream, &now)
+
+		_, body := doRequest(t, cache, http.MethodGet, url, "token", "")
+		assert.Equal(t, large, body)
+		resp, body := doRequest(t, cache, http.MethodGet, url, "token", "")
+		assert.Empty(t, resp.Header.Get(CacheHeader))
+		assert.Equal(t, large, body)
+		assert.Equal(t, 2, upstream.calls)
+	})
+
+	t.Run("Expired responses are fetched again", func(t *testing.T) {
+		now := time.Now()
+		upstream := &fakeRoundTripper{body: `[{"iid": 1}]`}
+		cache, _ := newTestCacheTransport(t, upstream, &now)
+
+		doRequest(t, cache, http.MethodGet, url, "token", "")
+		now = now.Add(2 * time.Minute)
+		upstream.body = `[{"iid": 2}]`
+
+		resp, body := doRequest(t, cache, http.MethodGet, url, "token", "")
+		assert.Empty(t, resp.Header.Get(CacheHeader))
+		assert.Equal(t, `[{"iid": 2}]`, body)
+		assert.Equal(t, 2, upstream.calls)
+	})
+
+	t.Run("Responses are not shared between tokens", func(t *testing.T) {
+		now := time.Now()
+		upstream := &fakeRoundTripper{body: `[{"iid": 1}]`}
+		cache, _ := newTestCacheTransport(t, upstream, &now)
+
+		doRequest(t, cache, http.MethodGet, url, "token", "")
+		resp, _ := doRequest(t, cache, http.MethodGet, url, "other-token", "")
+		assert.Empty(t, resp.Header.Get(CacheHeader))
+		assert.Equal(t, 2, upstream.calls)
+	})
+
+	t.Run("Stale responses are served when offline", func(t *testing.T) {
+		now := time.Now()
+		upstream := &fakeRoundTripper{body: `[{"iid": 1}]`}
+		cache, stderr := newTestCacheTransport(t, upstream, &now)
+
+		doRequest(t, cache, http.MethodGet, url, "token", "")
+		now = now.Add(time.Hour)
+		upstream.err = errors.New("dial tcp: no such host")
+
+		resp, body := doRequest(t, cache, http.MethodGet, url, "token", "")
+		assert.Equal(t, "stale", resp.Header.Get(CacheHeader))
+		assert.Equal(t, `[{"iid": 1}]`, body)
+		doRequest(t, cache, http.MethodGet, url, "token", "")
+		assert.Equal(t, 1, strings.Count(stderr.String(), "(cached) Unable to reach gitlab.example.com."))
+	})
+
+	t.Run("Responses older than the stale TTL are not served when offline", func(t *testing.T) {
+		now := time.Now()
+		upstream := &fakeRoundTripper{body: `[{"iid": 1}]`}
+		cache, stderr := newTestCacheTransport(t, upstream, &now)
+		cache.staleTTL = 0
+
+		doRequest(t, cache, http.MethodGet, url, "token", "")
+		now = now.Add(time.Hour)
+		upstream.err = errors.New("dial tcp: no such host")
+
+		req, err := http.NewRequest(http.MethodGet, url, nil)
+		require.NoError(t, err)
+		req.Header.Set("PRIVATE-TOKEN", "token")
+		_, err = cache.RoundTrip(req)
+		assert.EqualError(t, err, "dial tcp: no such host")
+		assert.Empty(t, stderr.String())
+	})
+
+	t.Run("Offline without a cached response returns the error", func(t *testing.T) {
+		now := time.Now()
+		upstream := &fakeRoundTripper{err: errors.New("dial tcp: no such host")}
+		cache, _ := newTestCacheTransport(t, upstream, &now)
+
+		req, err := http.NewRequest(http.MethodGet, url, nil)
+		require.NoError(t, err)
+		_, err = cache.RoundTrip(req)
+		assert.EqualError(t, err, "dial tcp: no such host")
+	})
+
+	t.Run("Write requests invalidate the cache", func(t *testing.T) {
+		now := time.Now()
+		upstream := &fakeRoundTripper{body: `[{"iid": 1}]`}
+		cache, _ := newTestCacheTransport(t, upstream, &now)
+
+		doRequest(t, cache, http.MethodGet, url, "token", "")
+		doRequest(t, cache, http.MethodPut, url+"/1", "token", `{"title": "new"}`)
+
+		resp, _ := doRequest(t, cache, http.MethodGet, url, "token", "")
+		assert.Empty(t, resp.Header.Get(CacheHeader))
+		assert.Equal(t, 3, upstream.calls)
+	})
+
+	t.Run("GraphQL queries keep the cache", func(t *testing.T) {
+		now := time.Now()
+		upstream := &fakeRoundTripper{body: `[{"iid": 1}]`}
+		cache, _ := newTestCacheTransport(t, upstream, &now)
+
+		doRequest(t, cache, http.MethodGet, url, "token", "")
+		doRequest(t, cache, http.MethodPost, "https://gitlab.example.com/api/graphql", "token", `{"query": "query { currentUser { id } }"}`)
+
+		resp, _ := doRequest(t, cache, http.MethodGet, url, "token", "")
+		assert.Equal(t, "hit", resp.Header.Get(CacheHeader))
+	})
+
+	t.Run("GraphQL mutations invalidate the cache", func(t *testing.T) {
+		now := time.Now()
+		upstream := &fakeRoundTripper{body: `[{"iid": 1}]`}
+		cache, _ := newTestCacheTransport(t, upstream, &now)
+
+		doRequest(t, cache, http.MethodGet, url, "token", "")
+		doRequest(t, cache, http.MethodPost, "https://gitlab.example.com/api/graphql", "token", `{"query": "# Mark all done\n, mutation { todosMarkAllDone(input: {}) { errors } }"}`)
+
+		resp, _ := doRequest(t, cache, http.MethodGet, url, "token", "")
+		assert.Empty(t, resp.Header.Get(CacheHeader))
+	})
+}
+
+func TestHasGraphQLWrite(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		want bool
+	}{
+		{name: "shorthand query", doc: "{ currentUser { id } }", want: false},
+		{name: "named query", doc: "query mutation($id: ID!) { mutation: node(id: $id) { id } }", want: false},
+		{name: "query with a comment", doc: "# mutation\nquery { currentUser { id } }", want: false},
+		{name: "query with strings", doc: `query { project(fullPath: "} mutation {") { description(format: """ } mutation { \""" """) } }`, want: false},
+		{name: "fragment and query", doc: "fragment F on User { id } query { currentUser { ...F } }", want: false},
+		{name: "mutation", doc: "mutation { todosMarkAllDone(input: {}) { errors } }", want: true},
+		{name: "mutation after a comment", doc: "# Mark all done\nmutation { todosMarkAllDone(input: {}) { errors } }", want: true},
+		{name: "mutation after a comma", doc: ", mutation { todosMarkAllDone(input: {}) { errors } }", want: true},
+		{name: "mutation after a byte order mark", doc: "\ufeffmutation { todosMarkAllDone(input: {}) { errors } }", want: true},
+		{name: "mutation after a query", doc: "query A { currentUser { id } } mutation B { todosMarkAllDone(input: {}) { errors } }", want: true},
+		{name: "subscription", doc: "subscription { issueUpdated(issuableId: 1) { id } }", want: true},
+		{name: "unterminated string", doc: `query { project(fullPath: "a) { id } }`, want: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, hasGraphQLWrite(tc.doc))
+		})
+	}
+}
+
+func TestNewClientFromConfig_CacheTTL(t *testing.T) {
+	t.Setenv("GLAB_CACHE_TTL", "not-a-duration")
+
+	_, err := NewClientFromConfig("gitlab.example.com", config.NewBlankConfig(), false, "glab test")
+	assert.EqualError(t, err, `invalid cache_ttl value "not-a-duration": time: invalid duration "not-a-duration"`)
+}
+
+func TestNewClientFromConfig_CacheStaleTTL(t *testing.T) {
+	t.Setenv("GLAB_CACHE_TTL", "5m")
+	t.Setenv("GLAB_CACHE_STALE_TTL", "not-a-duration")
+
+	_, err := NewClientFromConfig("gitlab.example.com", config.NewBlankConfig(), false, "glab test")
+	assert.EqualError(t, err, `invalid cache_stale_ttl value "not-a-duration": time: invalid duration "not-a-duration"`)
+}
+
+func TestWithoutCache_repeatedPollSeesFreshData(t *testing.T) {
+	now := time.Now()
+	upstream := &fakeRoundTripper{body: `{"iid": 1, "state": "opened"}`}
+	cache, _ := newTestCacheTransport(t, upstream, &now)
+
+	client, err := gitlab.NewClient("token", gitlab.WithBaseURL("https://gitlab.example.com"), gitlab.WithHTTPClient(&http.Client{Transport: cache}))
+	require.NoError(t, err)
+
+	mr, _, err := client.MergeRequests.GetMergeRequest("OWNER/REPO", 1, nil, WithoutCache())
+	require.NoError(t, err)
+	assert.Equal(t, "opened", mr.State)
+
+	upstream.body = `{"iid": 1, "state": "merged"}`
+	mr, _, err = client.MergeRequests.GetMergeRequest("OWNER/REPO", 1, nil, WithoutCache())
+	require.NoError(t, err)
+	assert.Equal(t, "merged", mr.State)
+	assert.Equal(t, 2, upstream.calls)
+}
@@ -0,0 +1,72 @@
+package api
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// CertificatePinError is returned when the certificate of a GitLab instance
+// doesn't match the pinned_cert_sha256 setting of its host.
+type CertificatePinError struct {
+	Host        string
+	Fingerprint string
+}
+
+func (e *CertificatePinError) Error() string {
+	return fmt.Sprintf("the certificate of %[1]s doesn't match its pinned_cert_sha256 setting. Its SHA-256 fingerprint is %[2]s. "+
+		"The connection might be intercepted. If the certificate of the instance was renewed, "+
+		"check the new fingerprint with your administrator, then update the pin with 'glab config set pinned_cert_sha256 <fingerprint> --host %[1]s'.",
+		e.Host, e.Fingerprint)
+}
+
+// ParseCertPins parses the pinned_cert_sha256 setting: a comma-separated list
+// of SHA-256 fingerprints of certificates, in hex, with or without colons. Pin
+// both the current and the next certificate of an instance to rotate it without
+// errors.
+func ParseCertPins(value string) ([]string, error) {
+	var pins []string
+	for pin := range strings.SplitSeq(value, ",") {
+		pin = strings.TrimSpace(pin)
+		if pin == "" {
+			continue
+		}
+		normalized := strings.ToLower(strings.ReplaceAll(pin, ":", ""))
+		if b, err := hex.DecodeString(normalized); err != nil || len(b) != sha256.Size {
+			return nil, fmt.Errorf("invalid pinned_cert_sha256 value %q: must be the SHA-256 fingerprint of a certificate, in hex.", pin)
+		}
+		pins = append(pins, normalized)
+	}
+	return pins, nil
+}
+
+// CertificateFingerprint returns the SHA-256 fingerprint of a DER certificate,
+// in the format of 'openssl x509 -fingerprint -sha256'.
+func CertificateFingerprint(der []byte) string {
+	sum := sha256.Sum256(der)
+	parts := make([]string, len(sum))
+	for i, b := range sum {
+		parts[i] = fmt.Sprintf("%02X", b)
+	}
+	return strings.Join(parts, ":")
+}
+
+// verifyPinnedCertificate returns a tls.Config.VerifyConnection function that
+// checks the leaf certificate presented by host against the pins. It runs
+// after, and in addition to, the validation of the certificate chain.
+func verifyPinnedCertificate(host string, pins []string) func(tls.ConnectionState) error {
+	return func(cs tls.ConnectionState) error {
+		if len(cs.PeerCertificates) == 0 {
+			return errors.New("the server presented no certificate to check against pinned_cert_sha256.")
+		}
+		sum := sha256.Sum256(cs.PeerCertificates[0].Raw)
+		if slices.Contains(pins, hex.EncodeToString(sum[:])) {
+			return nil
+		}
+		return &CertificatePinError{Host: host, Fingerprint: CertificateFingerprint(cs.PeerCertificates[0].Raw)}
+	}
+}
@@ -0,0 +1,76 @@
+//go:build !integration
+
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestParseCertPins(t *testing.T) {
+	pin := strings.Repeat("ab", 32)
+	colons := strings.ToUpper(strings.TrimSuffix(strings.Repeat("ab:", 32), ":"))
+
+	pins, err := ParseCertPins(pin + ", " + colons + ",")
+	require.NoError(t, err)
+	assert.Equal(t, []string{pin, pin}, pins)
+
+	_, err = ParseCertPins("abcd")
+	assert.EqualError(t, err, `invalid pinned_cert_sha256 value "abcd": must be the SHA-256 fingerprint of a certificate, in hex.`)
+}
+
+func TestPinnedCertificates(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	sum := sha256.Sum256(server.Certificate().Raw)
+	serverPin := hex.EncodeToString(sum[:])
+	otherPin := strings.Repeat("00", 32)
+
+	newClient := func(t *testing.T, pins []string) *Client {
+		t.Helper()
+
+		// The test server has a self-signed certificate: the pin is checked
+		// even when the certificate chain isn't.
+		client, err := NewClient(
+			func(*http.Client) (gitlab.AuthSource, error) {
+				return gitlab.AccessTokenAuthSource{Token: "token"}, nil
+			},
+			WithBaseURL(server.URL),
+			WithInsecureSkipVerify(true),
+			WithPinnedCertificates(pins),
+		)
+		require.NoError(t, err)
+		return client
+	}
+
+	t.Run("matching pin", func(t *testing.T) {
+		resp, err := newClient(t, []string{otherPin, serverPin}).HTTPClient().Get(server.URL)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("changed certificate", func(t *testing.T) {
+		_, err := newClient(t, []string{otherPin}).HTTPClient().Get(server.URL)
+		require.Error(t, err)
+
+		var pinErr *CertificatePinError
+		require.True(t, errors.As(err, &pinErr))
+		assert.Equal(t, "127.0.0.1", pinErr.Host)
+		assert.Equal(t, CertificateFingerprint(server.Certificate().Raw), pinErr.Fingerprint)
+		assert.Contains(t, err.Error(), "'glab config set pinned_cert_sha256 <fingerprint> --host 127.0.0.1'")
+	})
+}
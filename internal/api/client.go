@@ -45,6 +45,8 @@ type Client struct {
 	// client certificate files
 	clientCertFile string
 	clientKeyFile  string
+	// SHA-256 fingerprints of the certificates accepted for the instance
+	pinnedCerts []string
 
 	baseURL    string
 	authSource gitlab.AuthSource
@@ -54,6 +56,32 @@ type Client struct {
 	userAgent string
 
 	customHeaders map[string]string
+
+	// response cache, disabled when cacheTTL is zero. Expired responses are
+	// served when GitLab can't be reached, if younger than cacheStaleTTL.
+	cacheDir      string
+	cacheTTL      time.Duration
+	cacheStaleTTL time.Duration
+
+	// user cache, disabled when userCacheTTL is zero
+	userCacheDir      string
+	userCacheTTL      time.Duration
+	userCacheIdentity string
+	users             *userCache
+}
+
+// initializeUserCache sets up the user cache for the host of the GitLab client, if enabled.
+func (c *Client) initializeUserCache() {
+	if c.userCacheTTL <= 0 {
+		return
+	}
+	c.users = &userCache{
+		dir:      c.userCacheDir,
+		host:     c.gitlabClient.BaseURL().Host,
+		ttl:      c.userCacheTTL,
+		identity: c.userCacheIdentity,
+		now:      time.Now,
+	}
 }
 
 func (c *Client) HTTPClient() *http.Client {
@@ -108,6 +136,7 @@ func NewClient(newAuthSource newAuthSource, options ...ClientOption) (*Client, e
 
 	// 4. initialize the GitLab client
 	if client.gitlabClient != nil {
+		client.initializeUserCache()
 		return client, nil
 	}
 
@@ -127,6 +156,7 @@ func NewClient(newAuthSource newAuthSource, options ...ClientOption) (*Client, e
 	}
 
 	client.gitlabClient = gitlabClient
+	client.initializeUserCache()
 	return client, nil
 }
 
@@ -174,6 +204,10 @@ func (c *Client) initializeHTTPClient() error {
 		tlsConfig.Certificates = []tls.Certificate{clientCert}
 	}
 
+	if len(c.pinnedCerts) > 0 {
+		tlsConfig.VerifyConnection = verifyPinnedCertificate(u.Hostname(), c.pinnedCerts)
+	}
+
 	// Set appropriate timeouts based on whether custom CA is used
 	dialTimeout := 5 * time.Second
 	keepAlive := 5 * time.Second
@@ -202,6 +236,10 @@ func (c *Client) initializeHTTPClient() error {
 		rt = &debugTransport{rt: rt, w: os.Stderr}
 	}
 
+	if c.cacheTTL > 0 {
+		rt = &cacheTransport{rt: rt, dir: c.cacheDir, ttl: c.cacheTTL, staleTTL: c.cacheStaleTTL, w: os.Stderr, now: time.Now}
+	}
+
 	c.httpClient = &http.Client{Transport: rt}
 	return nil
 }
@@ -231,6 +269,15 @@ func WithClientCertificate(certFile, keyFile string) ClientOption {
 	}
 }
 
+// WithPinnedCertificates configures the client to only accept the certificates
+// of the instance with these SHA-256 fingerprints, in lowercase hex
+func WithPinnedCertificates(fingerprints []string) ClientOption {
+	return func(c *Client) error {
+		c.pinnedCerts = fingerprints
+		return nil
+	}
+}
+
 // WithInsecureSkipVerify configures the client to skip TLS verification
 func WithInsecureSkipVerify(skip bool) ClientOption {
 	return func(c *Client) error {
@@ -271,6 +318,28 @@ func WithUserAgent(userAgent string) ClientOption {
 	}
 }
 
+// WithResponseCache configures the client to cache responses of read requests in dir for ttl.
+// When GitLab can't be reached, expired responses younger than staleTTL are served instead.
+func WithResponseCache(dir string, ttl, staleTTL time.Duration) ClientOption {
+	return func(c *Client) error {
+		c.cacheDir = dir
+		c.cacheTTL = ttl
+		c.cacheStaleTTL = staleTTL
+		return nil
+	}
+}
+
+// WithUserCache configures the client to cache the users it looks up by username in dir
+// for ttl. identity identifies the token, to cache the current user, and can be empty.
+func WithUserCache(dir string, ttl time.Duration, identity string) ClientOption {
+	return func(c *Client) error {
+		c.userCacheDir = dir
+		c.userCacheTTL = ttl
+		c.userCacheIdentity = identity
+		return nil
+	}
+}
+
 // NewClientFromConfig initializes the global api with the config data
 func NewClientFromConfig(repoHost string, cfg config.Config, isGraphQL bool, userAgent string) (*Client, error) {
 	apiHost, _ := cfg.Get(repoHost, "api_host")
@@ -292,6 +361,10 @@ func NewClientFromConfig(repoHost string, cfg config.Config, isGraphQL bool, use
 	caCert, _ := cfg.Get(repoHost, "ca_cert")
 	clientCert, _ := cfg.Get(repoHost, "client_cert")
 	keyFile, _ := cfg.Get(repoHost, "client_key")
+	pinnedCert, _ := cfg.Get(repoHost, "pinned_cert_sha256")
+	cacheTTL, _ := cfg.Get(repoHost, "cache_ttl")
+	cacheStaleTTL, _ := cfg.Get(repoHost, "cache_stale_ttl")
+	userCacheTTL, _ := cfg.Get(repoHost, "user_cache_ttl")
 
 	// Build options based on configuration
 	options := []ClientOption{
@@ -309,6 +382,9 @@ func NewClientFromConfig(repoHost string, cfg config.Config, isGraphQL bool, use
 
 	// determine auth source
 	var newAuthSource newAuthSource
+	// The current user is only cached for access tokens: OAuth2 tokens are refreshed,
+	// and job tokens can't look up the current user.
+	identity := ""
 	switch {
 	case isOAuth2Cfg == "true":
 		newAuthSource = func(client *http.Client) (gitlab.AuthSource, error) {
@@ -326,6 +402,7 @@ func NewClientFromConfig(repoHost string, cfg config.Config, isGraphQL bool, use
 		newAuthSource = func(*http.Client) (gitlab.AuthSource, error) {
 			return gitlab.AccessTokenAuthSource{Token: token}, nil
 		}
+		identity = tokenIdentity(token)
 	}
 
 	var baseURL string
@@ -348,6 +425,49 @@ func NewClientFromConfig(repoHost string, cfg config.Config, isGraphQL bool, use
 		options = append(options, WithInsecureSkipVerify(skipTlsVerify))
 	}
 
+	if pinnedCert != "" {
+		pins, err := ParseCertPins(pinnedCert)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, WithPinnedCertificates(pins))
+	}
+
+	if cacheTTL != "" {
+		ttl, err := time.ParseDuration(cacheTTL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cache_ttl value %q: %w", cacheTTL, err)
+		}
+		var staleTTL time.Duration
+		if cacheStaleTTL != "" {
+			staleTTL, err = time.ParseDuration(cacheStaleTTL)
+			if err != nil {
+				return nil, fmt.Errorf("invalid cache_stale_ttl value %q: %w", cacheStaleTTL, err)
+			}
+		}
+		if ttl > 0 {
+			cacheDir, err := DefaultCacheDir()
+			if err != nil {
+				return nil, fmt.Errorf("failed to determine the cache directory: %w", err)
+			}
+			options = append(options, WithResponseCache(cacheDir, ttl, staleTTL))
+		}
+	}
+
+	if userCacheTTL != "" {
+		ttl, err := time.ParseDuration(userCacheTTL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid user_cache_ttl value %q: %w", userCacheTTL, err)
+		}
+		if ttl > 0 {
+			userCacheDir, err := DefaultUserCacheDir()
+			if err != nil {
+				return nil, fmt.Errorf("failed to determine the cache directory: %w", err)
+			}
+			options = append(options, WithUserCache(userCacheDir, ttl, identity))
+		}
+	}
+
 	return NewClient(newAuthSource, options...)
 }
 
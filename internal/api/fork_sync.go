@@ -0,0 +1,134 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// ErrForkSyncUnsupported is returned when the GitLab instance doesn't support
+// comparing and synchronizing forks through the API.
+var ErrForkSyncUnsupported = errors.New("the GitLab instance doesn't support fork synchronization.")
+
+// ForkDetails describes how a branch of a fork compares to the default branch of its upstream project.
+type ForkDetails struct {
+	Ahead        int  `json:"ahead"`
+	Behind       int  `json:"behind"`
+	IsSyncing    bool `json:"isSyncing"`
+	HasConflicts bool `json:"hasConflicts"`
+}
+
+const forkDetailsFields = `
+      ahead
+      behind
+      isSyncing
+      hasConflicts`
+
+const forkDetailsQuery = `
+query($fullPath: ID!, $ref: String) {
+  project(fullPath: $fullPath) {
+    forkDetails(ref: $ref) {` + forkDetailsFields + `
+    }
+  }
+}
+`
+
+// unsupportedField reports whether the GraphQL errors are caused by a field unknown to the instance.
+func (e graphQLErrors) unsupportedField(field string) bool {
+	for _, err := range e.Errors {
+		if strings.Contains(err.Message, "'"+field+"'") && strings.Contains(err.Message, "doesn't exist") {
+			return true
+		}
+	}
+	return false
+}
+
+// GetForkDetails compares ref of the fork at projectPath with the default branch of its upstream project.
+func GetForkDetails(client *gitlab.Client, projectPath, ref string) (*ForkDetails, error) {
+	var response struct {
+		graphQLErrors
+		Data struct {
+			Project *struct {
+				ForkDetails *ForkDetails `json:"forkDetails"`
+			} `json:"project"`
+		} `json:"data"`
+	}
+
+	_, err := client.GraphQL.Do(gitlab.GraphQLQuery{
+		Query: forkDetailsQuery,
+		Variables: map[string]any{
+			"fullPath": projectPath,
+			"ref":      ref,
+		},
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.unsupportedField("forkDetails") {
+		return nil, ErrForkSyncUnsupported
+	}
+	if err := response.err(); err != nil {
+		return nil, err
+	}
+	if response.Data.Project == nil {
+		return nil, fmt.Errorf("project %q not found.", projectPath)
+	}
+	if response.Data.Project.ForkDetails == nil {
+		return nil, fmt.Errorf("no fork details available for branch %q of %s.", ref, projectPath)
+	}
+
+	return response.Data.Project.ForkDetails, nil
+}
+
+const syncForkMutation = `
+mutation($projectPath: ID!, $targetBranch: String!) {
+  projectSyncFork(input: {projectPath: $projectPath, targetBranch: $targetBranch}) {
+    details {` + forkDetailsFields + `
+    }
+    errors
+  }
+}
+`
+
+// SyncFork updates targetBranch of the fork at projectPath with the default branch of its upstream project.
+// The synchronization runs in the background, so the returned details can still report IsSyncing.
+func SyncFork(client *gitlab.Client, projectPath, targetBranch string) (*ForkDetails, error) {
+	var response struct {
+		graphQLErrors
+		Data struct {
+			ProjectSyncFork *struct {
+				Details *ForkDetails `json:"details"`
+				Errors  []string     `json:"errors"`
+			} `json:"projectSyncFork"`
+		} `json:"data"`
+	}
+
+	_, err := client.GraphQL.Do(gitlab.GraphQLQuery{
+		Query: syncForkMutation,
+		Variables: map[string]any{
+			"projectPath":  projectPath,
+			"targetBranch": targetBranch,
+		},
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.unsupportedField("projectSyncFork") {
+		return nil, ErrForkSyncUnsupported
+	}
+	if err := response.err(); err != nil {
+		return nil, err
+	}
+
+	result := response.Data.ProjectSyncFork
+	if result == nil {
+		return nil, errors.New("failed to synchronize the fork.")
+	}
+	if len(result.Errors) > 0 {
+		return nil, errors.New(strings.Join(result.Errors, ", "))
+	}
+
+	return result.Details, nil
+}
@@ -0,0 +1,108 @@
+//go:build !integration
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetForkDetails(t *testing.T) {
+	tests := []struct {
+		name      string
+		response  string
+		want      *ForkDetails
+		wantError error
+		wantMsg   string
+	}{
+		{
+			name:     "Fork behind upstream",
+			response: `{"data": {"project": {"forkDetails": {"ahead": 0, "behind": 3, "isSyncing": false, "hasConflicts": false}}}}`,
+			want:     &ForkDetails{Behind: 3},
+		},
+		{
+			name:      "Unsupported instance",
+			response:  `{"errors": [{"message": "Field 'forkDetails' doesn't exist on type 'Project'"}]}`,
+			wantError: ErrForkSyncUnsupported,
+		},
+		{
+			name:     "Project not found",
+			response: `{"data": {"project": null}}`,
+			wantMsg:  `project "OWNER/REPO" not found.`,
+		},
+		{
+			name:     "Not a fork",
+			response: `{"data": {"project": {"forkDetails": null}}}`,
+			wantMsg:  `no fork details available for branch "main" of OWNER/REPO.`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var variables map[string]any
+			client := newGraphQLTestClient(t, tt.response, &variables)
+
+			got, err := GetForkDetails(client, "OWNER/REPO", "main")
+			switch {
+			case tt.wantError != nil:
+				require.ErrorIs(t, err, tt.wantError)
+			case tt.wantMsg != "":
+				require.EqualError(t, err, tt.wantMsg)
+			default:
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+				assert.Equal(t, map[string]any{"fullPath": "OWNER/REPO", "ref": "main"}, variables)
+			}
+		})
+	}
+}
+
+func TestSyncFork(t *testing.T) {
+	tests := []struct {
+		name      string
+		response  string
+		want      *ForkDetails
+		wantError error
+		wantMsg   string
+	}{
+		{
+			name:     "Sync started",
+			response: `{"data": {"projectSyncFork": {"details": {"ahead": 0, "behind": 3, "isSyncing": true, "hasConflicts": false}, "errors": []}}}`,
+			want:     &ForkDetails{Behind: 3, IsSyncing: true},
+		},
+		{
+			name:      "Unsupported instance",
+			response:  `{"errors": [{"message": "Field 'projectSyncFork' doesn't exist on type 'Mutation'"}]}`,
+			wantError: ErrForkSyncUnsupported,
+		},
+		{
+			name:     "Mutation errors",
+			response: `{"data": {"projectSyncFork": {"details": null, "errors": ["Target branch does not exist"]}}}`,
+			wantMsg:  "Target branch does not exist",
+		},
+		{
+			name:     "Empty mutation response",
+			response: `{"data": {"projectSyncFork": null}}`,
+			wantMsg:  "failed to synchronize the fork.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newGraphQLTestClient(t, tt.response, nil)
+
+			got, err := SyncFork(client, "OWNER/REPO", "main")
+			switch {
+			case tt.wantError != nil:
+				require.ErrorIs(t, err, tt.wantError)
+			case tt.wantMsg != "":
+				require.EqualError(t, err, tt.wantMsg)
+			default:
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,220 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// graphQLErrors are errors returned by the GraphQL API alongside a successful HTTP response.
+type graphQLErrors struct {
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+func (e graphQLErrors) err() error {
+	if len(e.Errors) == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		messages = append(messages, err.Message)
+	}
+	return fmt.Errorf("GraphQL errors: %s", strings.Join(messages, ", "))
+}
+
+type OncallUser struct {
+	Username string `json:"username"`
+	Name     string `json:"name"`
+}
+
+type OncallSchedule struct {
+	IID         string       `json:"iid"`
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	Timezone    string       `json:"timezone"`
+	OncallUsers []OncallUser `json:"oncallUsers"`
+}
+
+type EscalationRule struct {
+	Status             string `json:"status"`
+	ElapsedTimeSeconds int64  `json:"elapsedTimeSeconds"`
+	OncallSchedule     *struct {
+		IID  string `json:"iid"`
+		Name string `json:"name"`
+	} `json:"oncallSchedule"`
+	User *OncallUser `json:"user"`
+}
+
+type EscalationPolicy struct {
+	// ID is the global ID of the policy, for example gid://gitlab/IncidentManagement::EscalationPolicy/1
+	ID          string           `json:"id"`
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	Rules       []EscalationRule `json:"rules"`
+}
+
+// NumericID returns the numeric part of the global ID of the policy.
+func (p *EscalationPolicy) NumericID() int64 {
+	id, _ := strconv.ParseInt(p.ID[strings.LastIndex(p.ID, "/")+1:], 10, 64)
+	return id
+}
+
+const oncallSchedulesQuery = `
+query($fullPath: ID!) {
+  project(fullPath: $fullPath) {
+    incidentManagementOncallSchedules {
+      nodes {
+        iid
+        name
+        description
+        timezone
+        oncallUsers {
+          username
+          name
+        }
+      }
+    }
+  }
+}
+`
+
+// ListOncallSchedules returns the on-call schedules of a project, including the users currently on call.
+func ListOncallSchedules(client *gitlab.Client, projectPath string) ([]*OncallSchedule, error) {
+	var response struct {
+		graphQLErrors
+		Data struct {
+			Project *struct {
+				Schedules struct {
+					Nodes []*OncallSchedule `json:"nodes"`
+				} `json:"incidentManagementOncallSchedules"`
+			} `json:"project"`
+		} `json:"data"`
+	}
+
+	_, err := client.GraphQL.Do(gitlab.GraphQLQuery{
+		Query:     oncallSchedulesQuery,
+		Variables: map[string]any{"fullPath": projectPath},
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if err := response.err(); err != nil {
+		return nil, err
+	}
+	if response.Data.Project == nil {
+		return nil, fmt.Errorf("project %q not found.", projectPath)
+	}
+
+	return response.Data.Project.Schedules.Nodes, nil
+}
+
+const escalationPoliciesQuery = `
+query($fullPath: ID!) {
+  project(fullPath: $fullPath) {
+    incidentManagementEscalationPolicies {
+      nodes {
+        id
+        name
+        description
+        rules {
+          status
+          elapsedTimeSeconds
+          oncallSchedule {
+            iid
+            name
+          }
+          user {
+            username
+            name
+          }
+        }
+      }
+    }
+  }
+}
+`
+
+// ListEscalationPolicies returns the escalation policies of a project.
+func ListEscalationPolicies(client *gitlab.Client, projectPath string) ([]*EscalationPolicy, error) {
+	var response struct {
+		graphQLErrors
+		Data struct {
+			Project *struct {
+				Policies struct {
+					Nodes []*EscalationPolicy `json:"nodes"`
+				} `json:"incidentManagementEscalationPolicies"`
+			} `json:"project"`
+		} `json:"data"`
+	}
+
+	_, err := client.GraphQL.Do(gitlab.GraphQLQuery{
+		Query:     escalationPoliciesQuery,
+		Variables: map[string]any{"fullPath": projectPath},
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if err := response.err(); err != nil {
+		return nil, err
+	}
+	if response.Data.Project == nil {
+		return nil, fmt.Errorf("project %q not found.", projectPath)
+	}
+
+	return response.Data.Project.Policies.Nodes, nil
+}
+
+const setEscalationPolicyMutation = `
+mutation($projectPath: ID!, $iid: String!, $escalationPolicyId: IncidentManagementEscalationPolicyID) {
+  issueSetEscalationPolicy(input: {projectPath: $projectPath, iid: $iid, escalationPolicyId: $escalationPolicyId}) {
+    errors
+  }
+}
+`
+
+// SetIncidentEscalationPolicy sets the escalation policy of an incident.
+// An empty policyID removes the escalation policy from the incident.
+func SetIncidentEscalationPolicy(client *gitlab.Client, projectPath string, iid int64, policyID string) error {
+	var response struct {
+		graphQLErrors
+		Data struct {
+			SetEscalationPolicy *struct {
+				Errors []string `json:"errors"`
+			} `json:"issueSetEscalationPolicy"`
+		} `json:"data"`
+	}
+
+	var escalationPolicyID any
+	if policyID != "" {
+		escalationPolicyID = policyID
+	}
+
+	_, err := client.GraphQL.Do(gitlab.GraphQLQuery{
+		Query: setEscalationPolicyMutation,
+		Variables: map[string]any{
+			"projectPath":        projectPath,
+			"iid":                strconv.FormatInt(iid, 10),
+			"escalationPolicyId": escalationPolicyID,
+		},
+	}, &response)
+	if err != nil {
+		return err
+	}
+	if err := response.err(); err != nil {
+		return err
+	}
+	if response.Data.SetEscalationPolicy == nil {
+		return errors.New("failed to set the escalation policy.")
+	}
+	if len(response.Data.SetEscalationPolicy.Errors) > 0 {
+		return errors.New(strings.Join(response.Data.SetEscalationPolicy.Errors, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,199 @@
+//go:build !integration
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// newGraphQLTestClient returns a client whose GraphQL requests are answered with response.
+// The variables of the last request are stored in variables.
+func newGraphQLTestClient(t *testing.T, response string, variables *map[string]any) *gitlab.Client {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/graphql", r.URL.Path)
+
+		var query gitlab.GraphQLQuery
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&query))
+		if variables != nil {
+			*variables = query.Variables
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := gitlab.NewClient("test-token", gitlab.WithBaseURL(server.URL+"/api/v4"))
+	require.NoError(t, err)
+
+	return client
+}
+
+func TestListOncallSchedules(t *testing.T) {
+	tests := []struct {
+		name      string
+		response  string
+		want      []*OncallSchedule
+		wantError string
+	}{
+		{
+			name: "Schedules with users on call",
+			response: `{"data": {"project": {"incidentManagementOncallSchedules": {"nodes": [
+				{"iid": "1", "name": "Primary", "timezone": "UTC", "oncallUsers": [{"username": "alice", "name": "Alice"}]}
+			]}}}}`,
+			want: []*OncallSchedule{
+				{IID: "1", Name: "Primary", Timezone: "UTC", OncallUsers: []OncallUser{{Username: "alice", Name: "Alice"}}},
+			},
+		},
+		{
+			name:      "Project not found",
+			response:  `{"data": {"project": null}}`,
+			wantError: `project "OWNER/REPO" not found.`,
+		},
+		{
+			name:      "GraphQL errors",
+			response:  `{"data": {"project": null}, "errors": [{"message": "first"}, {"message": "second"}]}`,
+			wantError: "GraphQL errors: first, second",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newGraphQLTestClient(t, tt.response, nil)
+
+			got, err := ListOncallSchedules(client, "OWNER/REPO")
+			if tt.wantError != "" {
+				require.EqualError(t, err, tt.wantError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestListEscalationPolicies(t *testing.T) {
+	tests := []struct {
+		name      string
+		response  string
+		wantNames []string
+		wantError string
+	}{
+		{
+			name: "Policies with rules",
+			response: `{"data": {"project": {"incidentManagementEscalationPolicies": {"nodes": [
+				{"id": "gid://gitlab/IncidentManagement::EscalationPolicy/7", "name": "Default", "rules": [
+					{"status": "ACKNOWLEDGED", "elapsedTimeSeconds": 300, "oncallSchedule": {"iid": "1", "name": "Primary"}}
+				]}
+			]}}}}`,
+			wantNames: []string{"Default"},
+		},
+		{
+			name:      "Project not found",
+			response:  `{"data": {"project": null}}`,
+			wantError: `project "OWNER/REPO" not found.`,
+		},
+		{
+			name:      "GraphQL errors",
+			response:  `{"errors": [{"message": "Field 'incidentManagementEscalationPolicies' doesn't exist"}]}`,
+			wantError: "GraphQL errors: Field 'incidentManagementEscalationPolicies' doesn't exist",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newGraphQLTestClient(t, tt.response, nil)
+
+			got, err := ListEscalationPolicies(client, "OWNER/REPO")
+			if tt.wantError != "" {
+				require.EqualError(t, err, tt.wantError)
+				return
+			}
+
+			require.NoError(t, err)
+			names := make([]string, 0, len(got))
+			for _, p := range got {
+				names = append(names, p.Name)
+			}
+			assert.Equal(t, tt.wantNames, names)
+			assert.Equal(t, int64(7), got[0].NumericID())
+			require.NotNil(t, got[0].Rules[0].OncallSchedule)
+			assert.Equal(t, "Primary", got[0].Rules[0].OncallSchedule.Name)
+		})
+	}
+}
+
+func TestSetIncidentEscalationPolicy(t *testing.T) {
+	tests := []struct {
+		name          string
+		policyID      string
+		response      string
+		wantVariables map[string]any
+		wantError     string
+	}{
+		{
+			name:     "Set a policy",
+			policyID: "gid://gitlab/IncidentManagement::EscalationPolicy/7",
+			response: `{"data": {"issueSetEscalationPolicy": {"errors": []}}}`,
+			wantVariables: map[string]any{
+				"projectPath":        "OWNER/REPO",
+				"iid":                "12",
+				"escalationPolicyId": "gid://gitlab/IncidentManagement::EscalationPolicy/7",
+			},
+		},
+		{
+			name:     "Remove the policy",
+			response: `{"data": {"issueSetEscalationPolicy": {"errors": []}}}`,
+			wantVariables: map[string]any{
+				"projectPath":        "OWNER/REPO",
+				"iid":                "12",
+				"escalationPolicyId": nil,
+			},
+		},
+		{
+			name:      "Mutation errors",
+			policyID:  "gid://gitlab/IncidentManagement::EscalationPolicy/7",
+			response:  `{"data": {"issueSetEscalationPolicy": {"errors": ["Escalation policies are not supported for this issue type"]}}}`,
+			wantError: "Escalation policies are not supported for this issue type",
+		},
+		{
+			name:      "GraphQL errors",
+			policyID:  "gid://gitlab/IncidentManagement::EscalationPolicy/7",
+			response:  `{"data": {"issueSetEscalationPolicy": null}, "errors": [{"message": "The resource that you are attempting to access does not exist"}]}`,
+			wantError: "GraphQL errors: The resource that you are attempting to access does not exist",
+		},
+		{
+			name:      "Empty mutation response",
+			policyID:  "gid://gitlab/IncidentManagement::EscalationPolicy/7",
+			response:  `{"data": {"issueSetEscalationPolicy": null}}`,
+			wantError: "failed to set the escalation policy.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var variables map[string]any
+			client := newGraphQLTestClient(t, tt.response, &variables)
+
+			err := SetIncidentEscalationPolicy(client, "OWNER/REPO", 12, tt.policyID)
+			if tt.wantError != "" {
+				require.EqualError(t, err, tt.wantError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantVariables, variables)
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package api
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// issueFieldSelections maps the REST names of the issue fields that can be fetched
+// sparsely to their GraphQL selections.
+var issueFieldSelections = map[string]string{
+	"id":           "id",
+	"iid":          "iid",
+	"title":        "title",
+	"state":        "state",
+	"description":  "description",
+	"web_url":      "webUrl",
+	"labels":       "labels { nodes { title } }",
+	"confidential": "confidential",
+	"created_at":   "createdAt",
+	"updated_at":   "updatedAt",
+	"closed_at":    "closedAt",
+	"due_date":     "dueDate",
+}
+
+// IssueFields returns the REST names of the issue fields that ListIssueFields can fetch.
+func IssueFields() []string {
+	return []string{"id", "iid", "title", "state", "description", "web_url", "labels", "confidential", "created_at", "updated_at", "closed_at", "due_date"}
+}
+
+// IssueFieldsFilter filters the issues listed by ListIssueFields. Empty fields don't filter.
+type IssueFieldsFilter struct {
+	// State is opened, closed, or all.
+	State            string
+	Labels           []string
+	NotLabels        []string
+	Milestone        string
+	Search           string
+	In               []string
+	AssigneeUsername string
+	NotAssignee      string
+	AuthorUsername   string
+	NotAuthor        string
+	Confidential     bool
+	// IssueType is issue, incident, or test_case.
+	IssueType string
+	// Sort is a GraphQL IssueSort value, like CREATED_DESC.
+	Sort  string
+	First int64
+}
+
+// ListIssueFields lists the first issues of a project, or of a group and its subgroups,
+// with GraphQL. Only the requested fields are fetched and set on the returned issues,
+// which keeps responses small on big projects.
+func ListIssueFields(client *gitlab.Client, fullPath string, isGroup bool, fields []string, filter *IssueFieldsFilter) ([]*gitlab.Issue, error) {
+	selections := make([]string, 0, len(fields))
+	for _, field := range fields {
+		selection, ok := issueFieldSelections[field]
+		if !ok {
+			return nil, fmt.Errorf("unknown issue field %q.", field)
+		}
+		selections = append(selections, selection)
+	}
+
+	declarations := []string{"$fullPath: ID!"}
+	arguments := []string{}
+	variables := map[string]any{"fullPath": fullPath}
+	addArgument := func(name, graphQLType string, value any) {
+		declarations = append(declarations, fmt.Sprintf("$%s: %s", name, graphQLType))
+		arguments = append(arguments, fmt.Sprintf("%s: $%s", name, name))
+		variables[name] = value
+	}
+
+	addArgument("first", "Int", filter.First)
+	if filter.State != "" {
+		addArgument("state", "IssuableState", filter.State)
+	}
+	if len(filter.Labels) > 0 {
+		addArgument("labelName", "[String]", filter.Labels)
+	}
+	if filter.Milestone != "" {
+		addArgument("milestoneTitle", "[String]", []string{filter.Milestone})
+	}
+	if filter.Search != "" {
+		addArgument("search", "String", filter.Search)
+		if len(filter.In) > 0 {
+			in := make([]string, 0, len(filter.In))
+			for _, field := range filter.In {
+				in = append(in, strings.ToUpper(strings.TrimSpace(field)))
+			}
+			addArgument("in", "[IssuableSearchableField!]", in)
+		}
+	}
+	if filter.AssigneeUsername != "" {
+		addArgument("assigneeUsernames", "[String!]", []string{filter.AssigneeUsername})
+	}
+	if filter.AuthorUsername != "" {
+		addArgument("authorUsername", "String", filter.AuthorUsername)
+	}
+	if filter.Confidential {
+		addArgument("confidential", "Boolean", true)
+	}
+	if filter.IssueType != "" {
+		addArgument("types", "[IssueType!]", []string{strings.ToUpper(filter.IssueType)})
+	}
+	if filter.Sort != "" {
+		addArgument("sort", "IssueSort", filter.Sort)
+	}
+	if len(filter.NotLabels) > 0 || filter.NotAssignee != "" || filter.NotAuthor != "" {
+		not := map[string]any{}
+		if len(filter.NotLabels) > 0 {
+			not["labelName"] = filter.NotLabels
+		}
+		if filter.NotAssignee != "" {
+			not["assigneeUsernames"] = []string{filter.NotAssignee}
+		}
+		if filter.NotAuthor != "" {
+			not["authorUsername"] = []string{filter.NotAuthor}
+		}
+		addArgument("not", "NegatedIssueFilterInput", not)
+	}
+
+	namespace := "project"
+	if isGroup {
+		namespace = "group"
+		arguments = append(arguments, "includeSubgroups: true")
+	}
+	query := fmt.Sprintf(`
+query(%s) {
+  namespace: %s(fullPath: $fullPath) {
+    issues(%s) {
+      nodes {
+        %s
+      }
+    }
+  }
+}
+`, strings.Join(declarations, ", "), namespace, strings.Join(arguments, ", "), strings.Join(selections, "\n        "))
+
+	var response struct {
+		graphQLErrors
+		Data struct {
+			Namespace *struct {
+				Issues struct {
+					Nodes []struct {
+						ID           string     `json:"id"`
+						IID          string     `json:"iid"`
+						Title        string     `json:"title"`
+						State        string     `json:"state"`
+						Description  string     `json:"description"`
+						WebURL       string     `json:"webUrl"`
+						Confidential bool       `json:"confidential"`
+						CreatedAt    *time.Time `json:"createdAt"`
+						UpdatedAt    *time.Time `json:"updatedAt"`
+						ClosedAt     *time.Time `json:"closedAt"`
+						DueDate      string     `json:"dueDate"`
+						Labels       struct {
+							Nodes []struct {
+								Title string `json:"title"`
+							} `json:"nodes"`
+						} `json:"labels"`
+					} `json:"nodes"`
+				} `json:"issues"`
+			} `json:"namespace"`
+		} `json:"data"`
+	}
+
+	_, err := client.GraphQL.Do(gitlab.GraphQLQuery{
+		Query:     query,
+		Variables: variables,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if err := response.err(); err != nil {
+		return nil, err
+	}
+	if response.Data.Namespace == nil {
+		return nil, fmt.Errorf("%s %q not found.", namespace, fullPath)
+	}
+
+	issues := make([]*gitlab.Issue, 0, len(response.Data.Namespace.Issues.Nodes))
+	for _, node := range response.Data.Namespace.Issues.Nodes {
+		issue := &gitlab.Issue{
+			Title:        node.Title,
+			State:        node.State,
+			Description:  node.Description,
+			WebURL:       node.WebURL,
+			Confidential: node.Confidential,
+			CreatedAt:    node.CreatedAt,
+			UpdatedAt:    node.UpdatedAt,
+			ClosedAt:     node.ClosedAt,
+		}
+		if node.ID != "" {
+			// Global IDs look like gid://gitlab/Issue/123.
+			id := node.ID[strings.LastIndex(node.ID, "/")+1:]
+			if issue.ID, err = strconv.ParseInt(id, 10, 64); err != nil {
+				return nil, fmt.Errorf("invalid issue ID %q: %w", node.ID, err)
+			}
+		}
+		if node.IID != "" {
+			if issue.IID, err = strconv.ParseInt(node.IID, 10, 64); err != nil {
+				return nil, fmt.Errorf("invalid issue IID %q: %w", node.IID, err)
+			}
+		}
+		if node.DueDate != "" {
+			dueDate, err := time.Parse(time.DateOnly, node.DueDate)
+			if err != nil {
+				return nil, fmt.Errorf("invalid due date %q: %w", node.DueDate, err)
+			}
+			issue.DueDate = gitlab.Ptr(gitlab.ISOTime(dueDate))
+		}
+		for _, label := range node.Labels.Nodes {
+			issue.Labels = append(issue.Labels, label.Title)
+		}
+		issues = append(issues, issue)
+	}
+	return issues, nil
+}
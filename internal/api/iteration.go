@@ -0,0 +1,149 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// CurrentIterationArg is the argument of commands that selects the current iteration.
+const CurrentIterationArg = "@current"
+
+// IterationState returns the name of the state of an iteration, as returned by the API.
+func IterationState(state int64) string {
+	switch state {
+	case 1:
+		return "upcoming"
+	case 2:
+		return "current"
+	case 3:
+		return "closed"
+	}
+	return "unknown"
+}
+
+// ListIterations returns all the iterations of a group, or of a project and its
+// ancestor groups when group is empty, in the given state. All states are
+// returned when state is empty.
+func ListIterations(client *gitlab.Client, project, group, state string) ([]*gitlab.GroupIteration, error) {
+	var statePtr *string
+	if state != "" {
+		statePtr = gitlab.Ptr(state)
+	}
+
+	if group != "" {
+		opts := &gitlab.ListGroupIterationsOptions{
+			ListOptions:      gitlab.ListOptions{PerPage: MaxPerPage},
+			State:            statePtr,
+			IncludeAncestors: gitlab.Ptr(true),
+		}
+		return gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.GroupIteration, *gitlab.Response, error) {
+			return client.GroupIterations.ListGroupIterations(group, opts, p)
+		})
+	}
+
+	opts := &gitlab.ListProjectIterationsOptions{
+		ListOptions:      gitlab.ListOptions{PerPage: MaxPerPage},
+		State:            statePtr,
+		IncludeAncestors: gitlab.Ptr(true),
+	}
+	projectIterations, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.ProjectIteration, *gitlab.Response, error) {
+		return client.ProjectIterations.ListProjectIterations(project, opts, p)
+	})
+	if err != nil {
+		return nil, err
+	}
+	iterations := make([]*gitlab.GroupIteration, 0, len(projectIterations))
+	for _, it := range projectIterations {
+		iterations = append(iterations, (*gitlab.GroupIteration)(it))
+	}
+	return iterations, nil
+}
+
+// CurrentIteration returns the current iteration of a group, or of a project
+// and its ancestor groups when group is empty. It fails when there is no
+// current iteration, or when several iteration cadences have one.
+func CurrentIteration(client *gitlab.Client, project, group string) (*gitlab.GroupIteration, error) {
+	iterations, err := ListIterations(client, project, group, "current")
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(iterations) {
+	case 0:
+		return nil, errors.New("there is no current iteration.")
+	case 1:
+		return iterations[0], nil
+	}
+	ids := make([]string, 0, len(iterations))
+	for _, it := range iterations {
+		ids = append(ids, fmt.Sprintf("%d (%s)", it.ID, it.Title))
+	}
+	return nil, fmt.Errorf("there are several current iterations, use the ID of one of them: %s.", strings.Join(ids, ", "))
+}
+
+// IterationCadence is an iteration cadence of a group.
+type IterationCadence struct {
+	ID                  string `json:"id"`
+	Title               string `json:"title"`
+	Description         string `json:"description"`
+	Automatic           bool   `json:"automatic"`
+	Active              bool   `json:"active"`
+	StartDate           string `json:"startDate"`
+	DurationInWeeks     int    `json:"durationInWeeks"`
+	IterationsInAdvance int    `json:"iterationsInAdvance"`
+	RollOver            bool   `json:"rollOver"`
+}
+
+const iterationCadencesQuery = `
+query($fullPath: ID!) {
+  group(fullPath: $fullPath) {
+    iterationCadences(includeAncestorGroups: true) {
+      nodes {
+        id
+        title
+        description
+        automatic
+        active
+        startDate
+        durationInWeeks
+        iterationsInAdvance
+        rollOver
+      }
+    }
+  }
+}
+`
+
+// ListIterationCadences returns the iteration cadences of a group and its
+// ancestor groups. Cadences are only available with GraphQL.
+func ListIterationCadences(client *gitlab.Client, groupPath string) ([]*IterationCadence, error) {
+	var response struct {
+		graphQLErrors
+		Data struct {
+			Group *struct {
+				IterationCadences struct {
+					Nodes []*IterationCadence `json:"nodes"`
+				} `json:"iterationCadences"`
+			} `json:"group"`
+		} `json:"data"`
+	}
+
+	_, err := client.GraphQL.Do(gitlab.GraphQLQuery{
+		Query:     iterationCadencesQuery,
+		Variables: map[string]any{"fullPath": groupPath},
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if err := response.err(); err != nil {
+		return nil, err
+	}
+	if response.Data.Group == nil {
+		return nil, fmt.Errorf("group %q not found.", groupPath)
+	}
+
+	return response.Data.Group.IterationCadences.Nodes, nil
+}
@@ -0,0 +1,83 @@
+//go:build !integration
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCurrentIteration(t *testing.T) {
+	tests := []struct {
+		name       string
+		iterations []*gitlab.ProjectIteration
+		wantID     int64
+		wantError  string
+	}{
+		{
+			name:       "one current iteration",
+			iterations: []*gitlab.ProjectIteration{{ID: 7, Title: "Sprint 4", State: 2}},
+			wantID:     7,
+		},
+		{
+			name:      "no current iteration",
+			wantError: "there is no current iteration.",
+		},
+		{
+			name:       "several cadences",
+			iterations: []*gitlab.ProjectIteration{{ID: 7, Title: "Sprint 4"}, {ID: 9, Title: "Ops 2"}},
+			wantError:  "there are several current iterations, use the ID of one of them: 7 (Sprint 4), 9 (Ops 2).",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tc := gitlabtesting.NewTestClient(t)
+			tc.MockProjectIterations.EXPECT().
+				ListProjectIterations("OWNER/REPO", gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ any, opts *gitlab.ListProjectIterationsOptions, _ ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectIteration, *gitlab.Response, error) {
+					assert.Equal(t, "current", *opts.State)
+					assert.True(t, *opts.IncludeAncestors)
+					return tt.iterations, &gitlab.Response{}, nil
+				})
+
+			got, err := CurrentIteration(tc.Client, "OWNER/REPO", "")
+			if tt.wantError != "" {
+				require.EqualError(t, err, tt.wantError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantID, got.ID)
+		})
+	}
+}
+
+func TestListIterationCadences(t *testing.T) {
+	var variables map[string]any
+	client := newGraphQLTestClient(t, `{"data": {"group": {"iterationCadences": {"nodes": [
+		{"id": "gid://gitlab/Iterations::Cadence/3", "title": "Sprints", "automatic": true, "active": true, "startDate": "2026-01-05", "durationInWeeks": 2, "iterationsInAdvance": 2, "rollOver": true}
+	]}}}}`, &variables)
+
+	got, err := ListIterationCadences(client, "my-group")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"fullPath": "my-group"}, variables)
+	assert.Equal(t, []*IterationCadence{{
+		ID:                  "gid://gitlab/Iterations::Cadence/3",
+		Title:               "Sprints",
+		Automatic:           true,
+		Active:              true,
+		StartDate:           "2026-01-05",
+		DurationInWeeks:     2,
+		IterationsInAdvance: 2,
+		RollOver:            true,
+	}}, got)
+
+	client = newGraphQLTestClient(t, `{"data": {"group": null}}`, nil)
+	_, err = ListIterationCadences(client, "missing")
+	assert.EqualError(t, err, `group "missing" not found.`)
+}
@@ -41,20 +41,22 @@ func ListGroupMRs(client *gitlab.Client, projectID any, opts *gitlab.ListGroupMe
 	if len(assigneeIds) > 0 || len(reviewerIds) > 0 {
 		return listGroupMRsWithAssigneesOrReviewers(client, projectID, opts, assigneeIds, reviewerIds)
 	} else {
-		return listGroupMRsBase(client, projectID, opts)
+		mrs, resp, err := listGroupMRsBase(client, projectID, opts)
+		composedListOpts.setResponse(resp)
+		return mrs, err
 	}
 }
 
-func listGroupMRsBase(client *gitlab.Client, groupID any, opts *gitlab.ListGroupMergeRequestsOptions) ([]*gitlab.BasicMergeRequest, error) {
+func listGroupMRsBase(client *gitlab.Client, groupID any, opts *gitlab.ListGroupMergeRequestsOptions) ([]*gitlab.BasicMergeRequest, *gitlab.Response, error) {
 	if opts.PerPage == 0 {
 		opts.PerPage = DefaultListLimit
 	}
 
-	mrs, _, err := client.MergeRequests.ListGroupMergeRequests(groupID, opts)
+	mrs, resp, err := client.MergeRequests.ListGroupMergeRequests(groupID, opts)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return mrs, nil
+	return mrs, resp, nil
 }
 
 func listGroupMRsWithAssigneesOrReviewers(client *gitlab.Client, projectID any, opts *gitlab.ListGroupMergeRequestsOptions, assigneeIds []int, reviewerIds []int) ([]*gitlab.BasicMergeRequest, error) {
@@ -65,7 +67,7 @@ func listGroupMRsWithAssigneesOrReviewers(client *gitlab.Client, projectID any,
 	mrMap := make(map[int64]*gitlab.BasicMergeRequest)
 	for _, id := range assigneeIds {
 		opts.AssigneeID = gitlab.AssigneeID(id)
-		assigneeMrs, err := listGroupMRsBase(client, projectID, opts)
+		assigneeMrs, _, err := listGroupMRsBase(client, projectID, opts)
 		if err != nil {
 			return nil, err
 		}
@@ -76,7 +78,7 @@ func listGroupMRsWithAssigneesOrReviewers(client *gitlab.Client, projectID any,
 	opts.AssigneeID = nil // reset because it's Assignee OR Reviewer
 	for _, id := range reviewerIds {
 		opts.ReviewerID = gitlab.ReviewerID(id)
-		reviewerMrs, err := listGroupMRsBase(client, projectID, opts)
+		reviewerMrs, _, err := listGroupMRsBase(client, projectID, opts)
 		if err != nil {
 			return nil, err
 		}
@@ -126,20 +128,22 @@ var ListMRs = func(client *gitlab.Client, projectID any, opts *gitlab.ListProjec
 	if len(assigneeIds) > 0 || len(reviewerIds) > 0 {
 		return listMRsWithAssigneesOrReviewers(client, projectID, opts, assigneeIds, reviewerIds)
 	} else {
-		return listMRsBase(client, projectID, opts)
+		mrs, resp, err := listMRsBase(client, projectID, opts)
+		composedListOpts.setResponse(resp)
+		return mrs, err
 	}
 }
 
-func listMRsBase(client *gitlab.Client, projectID any, opts *gitlab.ListProjectMergeRequestsOptions) ([]*gitlab.BasicMergeRequest, error) {
+func listMRsBase(client *gitlab.Client, projectID any, opts *gitlab.ListProjectMergeRequestsOptions) ([]*gitlab.BasicMergeRequest, *gitlab.Response, error) {
 	if opts.PerPage == 0 {
 		opts.PerPage = DefaultListLimit
 	}
 
-	mrs, _, err := client.MergeRequests.ListProjectMergeRequests(projectID, opts)
+	mrs, resp, err := client.MergeRequests.ListProjectMergeRequests(projectID, opts)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return mrs, nil
+	return mrs, resp, nil
 }
 
 func listMRsWithAssigneesOrReviewers(client *gitlab.Client, projectID any, opts *gitlab.ListProjectMergeRequestsOptions, assigneeIds []int, reviewerIds []int) ([]*gitlab.BasicMergeRequest, error) {
@@ -150,7 +154,7 @@ func listMRsWithAssigneesOrReviewers(client *gitlab.Client, projectID any, opts
 	mrMap := make(map[int64]*gitlab.BasicMergeRequest)
 	for _, id := range assigneeIds {
 		opts.AssigneeID = gitlab.AssigneeID(id)
-		assigneeMrs, err := listMRsBase(client, projectID, opts)
+		assigneeMrs, _, err := listMRsBase(client, projectID, opts)
 		if err != nil {
 			return nil, err
 		}
@@ -161,7 +165,7 @@ func listMRsWithAssigneesOrReviewers(client *gitlab.Client, projectID any, opts
 	opts.AssigneeID = nil // reset because it's Assignee OR Reviewer
 	for _, id := range reviewerIds {
 		opts.ReviewerID = gitlab.ReviewerID(id)
-		reviewerMrs, err := listMRsBase(client, projectID, opts)
+		reviewerMrs, _, err := listMRsBase(client, projectID, opts)
 		if err != nil {
 			return nil, err
 		}
@@ -210,6 +214,13 @@ var DeleteMR = func(client *gitlab.Client, projectID any, mrID int64) error {
 type cliListMROptions struct {
 	assigneeIds []int
 	reviewerIds []int
+	response    **gitlab.Response
+}
+
+func (c *cliListMROptions) setResponse(resp *gitlab.Response) {
+	if c.response != nil {
+		*c.response = resp
+	}
 }
 
 type CliListMROption func(*cliListMROptions)
@@ -226,6 +237,15 @@ func WithMRReviewers(reviewerIds []int) CliListMROption {
 	}
 }
 
+// WithMRResponse stores the response of the list request in resp, for its
+// pagination state. It's left nil when the merge requests are filtered by
+// assignees or reviewers, because that takes a request per user.
+func WithMRResponse(resp **gitlab.Response) CliListMROption {
+	return func(c *cliListMROptions) {
+		c.response = resp
+	}
+}
+
 func composeCliListMROptions(optionSetters ...CliListMROption) *cliListMROptions {
 	opts := &cliListMROptions{}
 	for _, setter := range optionSetters {
@@ -233,3 +253,27 @@ func composeCliListMROptions(optionSetters ...CliListMROption) *cliListMROptions
 	}
 	return opts
 }
+
+// MergeRequestForCommit returns the merge request in the state that has the commit as its head,
+// or else the first merge request in the state that contains the commit, or nil if there is none.
+// The state "" or "any" matches merge requests in any state.
+func MergeRequestForCommit(client *gitlab.Client, projectID any, sha, state string) (*gitlab.BasicMergeRequest, error) {
+	mrs, _, err := client.Commits.ListMergeRequestsByCommit(projectID, sha)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *gitlab.BasicMergeRequest
+	for _, mr := range mrs {
+		if state != "" && state != "any" && mr.State != state {
+			continue
+		}
+		if mr.SHA == sha {
+			return mr, nil
+		}
+		if found == nil {
+			found = mr
+		}
+	}
+	return found, nil
+}
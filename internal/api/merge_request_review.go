@@ -0,0 +1,64 @@
+package api
+
+import (
+	"errors"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// ErrReviewerStateUnsupported is returned when the GitLab instance doesn't support
+// setting the review state of a reviewer through the API.
+var ErrReviewerStateUnsupported = errors.New("the GitLab instance doesn't support requesting changes.")
+
+// ReviewerStateRequestedChanges is the review state of a reviewer who requested changes.
+const ReviewerStateRequestedChanges = "REQUESTED_CHANGES"
+
+const updateReviewerStateMutation = `
+mutation($projectPath: ID!, $iid: String!, $reviewerState: MergeRequestReviewState!) {
+  mergeRequestUpdateReviewerState(input: {projectPath: $projectPath, iid: $iid, reviewerState: $reviewerState}) {
+    errors
+  }
+}
+`
+
+// SetReviewerState sets the review state of the current user, who must be a reviewer
+// of the merge request.
+func SetReviewerState(client *gitlab.Client, projectPath, iid, state string) error {
+	var response struct {
+		graphQLErrors
+		Data struct {
+			MergeRequestUpdateReviewerState *struct {
+				Errors []string `json:"errors"`
+			} `json:"mergeRequestUpdateReviewerState"`
+		} `json:"data"`
+	}
+
+	_, err := client.GraphQL.Do(gitlab.GraphQLQuery{
+		Query: updateReviewerStateMutation,
+		Variables: map[string]any{
+			"projectPath":   projectPath,
+			"iid":           iid,
+			"reviewerState": state,
+		},
+	}, &response)
+	if err != nil {
+		return err
+	}
+	if response.unsupportedField("mergeRequestUpdateReviewerState") {
+		return ErrReviewerStateUnsupported
+	}
+	if err := response.err(); err != nil {
+		return err
+	}
+
+	result := response.Data.MergeRequestUpdateReviewerState
+	if result == nil {
+		return errors.New("failed to update the reviewer state.")
+	}
+	if len(result.Errors) > 0 {
+		return errors.New(strings.Join(result.Errors, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+//go:build !integration
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetReviewerState(t *testing.T) {
+	tests := []struct {
+		name      string
+		response  string
+		wantError error
+		wantMsg   string
+	}{
+		{
+			name:     "State updated",
+			response: `{"data": {"mergeRequestUpdateReviewerState": {"errors": []}}}`,
+		},
+		{
+			name:      "Unsupported instance",
+			response:  `{"errors": [{"message": "Field 'mergeRequestUpdateReviewerState' doesn't exist on type 'Mutation'"}]}`,
+			wantError: ErrReviewerStateUnsupported,
+		},
+		{
+			name:     "Not a reviewer",
+			response: `{"data": {"mergeRequestUpdateReviewerState": {"errors": ["Reviewer not found"]}}}`,
+			wantMsg:  "Reviewer not found",
+		},
+		{
+			name:     "Empty mutation response",
+			response: `{"data": {"mergeRequestUpdateReviewerState": null}}`,
+			wantMsg:  "failed to update the reviewer state.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var variables map[string]any
+			client := newGraphQLTestClient(t, tt.response, &variables)
+
+			err := SetReviewerState(client, "OWNER/REPO", "12", ReviewerStateRequestedChanges)
+			switch {
+			case tt.wantError != nil:
+				require.ErrorIs(t, err, tt.wantError)
+			case tt.wantMsg != "":
+				require.EqualError(t, err, tt.wantMsg)
+			default:
+				require.NoError(t, err)
+				assert.Equal(t, map[string]any{"projectPath": "OWNER/REPO", "iid": "12", "reviewerState": "REQUESTED_CHANGES"}, variables)
+			}
+		})
+	}
+}
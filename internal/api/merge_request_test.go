@@ -0,0 +1,50 @@
+//go:build !integration
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+)
+
+func TestMergeRequestForCommit(t *testing.T) {
+	mrs := []*gitlab.BasicMergeRequest{
+		{IID: 1, State: "merged", SHA: "abc"},
+		{IID: 2, State: "opened", SHA: "def"},
+		{IID: 3, State: "opened", SHA: "abc"},
+	}
+
+	tests := []struct {
+		name    string
+		sha     string
+		state   string
+		wantIID int64
+	}{
+		{name: "head of an open merge request", sha: "abc", state: "opened", wantIID: 3},
+		{name: "head of a merge request in any state", sha: "abc", state: "", wantIID: 1},
+		{name: "head of a merge request in any state, explicitly", sha: "abc", state: "any", wantIID: 1},
+		{name: "contained in an open merge request", sha: "123", state: "opened", wantIID: 2},
+		{name: "no merge request in the state", sha: "abc", state: "closed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tc := gitlabtesting.NewTestClient(t)
+			tc.MockCommits.EXPECT().
+				ListMergeRequestsByCommit("OWNER/REPO", tt.sha).
+				Return(mrs, &gitlab.Response{}, nil)
+
+			got, err := MergeRequestForCommit(tc.Client, "OWNER/REPO", tt.sha, tt.state)
+			require.NoError(t, err)
+			if tt.wantIID == 0 {
+				assert.Nil(t, got)
+				return
+			}
+			assert.Equal(t, tt.wantIID, got.IID)
+		})
+	}
+}
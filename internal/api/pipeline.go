@@ -83,15 +83,16 @@ func (s bridgeSort) Less(i, j int) bool {
 }
 
 // PipelineJobsWithID returns a list of jobs in a pipeline for a id.
-// The jobs are returned in the order in which they were created
-func PipelineJobsWithID(client *gitlab.Client, pid any, ppid int64) ([]*gitlab.Job, []*gitlab.Bridge, error) {
+// The jobs are returned in the order in which they were created.
+// The options are passed to every request.
+func PipelineJobsWithID(client *gitlab.Client, pid any, ppid int64, options ...gitlab.RequestOptionFunc) ([]*gitlab.Job, []*gitlab.Bridge, error) {
 	opts := &gitlab.ListJobsOptions{
 		ListOptions: gitlab.ListOptions{
 			PerPage: 500,
 		},
 	}
 	jobsList, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Job, *gitlab.Response, error) {
-		return client.Jobs.ListPipelineJobs(pid, ppid, opts, p)
+		return client.Jobs.ListPipelineJobs(pid, ppid, opts, append([]gitlab.RequestOptionFunc{p}, options...)...)
 	})
 	if err != nil {
 		return nil, nil, err
@@ -99,7 +100,7 @@ func PipelineJobsWithID(client *gitlab.Client, pid any, ppid int64) ([]*gitlab.J
 	// reset
 	opts.Page = 0
 	bridgesList, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Bridge, *gitlab.Response, error) {
-		return client.Jobs.ListPipelineBridges(pid, ppid, opts, p)
+		return client.Jobs.ListPipelineBridges(pid, ppid, opts, append([]gitlab.RequestOptionFunc{p}, options...)...)
 	})
 	if err != nil {
 		return nil, nil, err
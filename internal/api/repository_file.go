@@ -0,0 +1,26 @@
+package api
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// GetFile returns a file of the repository of a project at ref. The API returns
+// the content in base64; the returned file has the decoded content, with the
+// text encoding.
+func GetFile(client *gitlab.Client, projectID any, path, ref string) (*gitlab.File, error) {
+	file, _, err := client.RepositoryFiles.GetFile(projectID, path, &gitlab.GetFileOptions{Ref: gitlab.Ptr(ref)})
+	if err != nil {
+		return nil, err
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(file.Content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the file %s: %w", path, err)
+	}
+	file.Content = string(decoded)
+	file.Encoding = "text"
+	return file, nil
+}
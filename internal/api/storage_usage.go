@@ -0,0 +1,141 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// ProjectStorage is the storage used by a project, in bytes.
+type ProjectStorage struct {
+	FullPath              string `json:"full_path"`
+	StorageSize           int64  `json:"storage_size"`
+	RepositorySize        int64  `json:"repository_size"`
+	JobArtifactsSize      int64  `json:"job_artifacts_size"`
+	PackagesSize          int64  `json:"packages_size"`
+	ContainerRegistrySize int64  `json:"container_registry_size"`
+	LFSObjectsSize        int64  `json:"lfs_objects_size"`
+}
+
+// GroupStorage is the storage used by the projects of a group and its subgroups, in bytes.
+type GroupStorage struct {
+	FullPath            string            `json:"full_path"`
+	DependencyProxySize int64             `json:"dependency_proxy_size"`
+	Projects            []*ProjectStorage `json:"projects"`
+}
+
+// ProjectStorageFromStatistics returns the storage of a project fetched with statistics.
+func ProjectStorageFromStatistics(project *gitlab.Project) *ProjectStorage {
+	storage := &ProjectStorage{FullPath: project.PathWithNamespace}
+	if s := project.Statistics; s != nil {
+		storage.StorageSize = s.StorageSize
+		storage.RepositorySize = s.RepositorySize
+		storage.JobArtifactsSize = s.JobArtifactsSize
+		storage.PackagesSize = s.PackagesSize
+		storage.ContainerRegistrySize = s.ContainerRegistrySize
+		storage.LFSObjectsSize = s.LFSObjectsSize
+	}
+	return storage
+}
+
+const groupStorageQuery = `
+query($fullPath: ID!, $after: String) {
+  group(fullPath: $fullPath) {
+    dependencyProxyTotalSizeBytes
+    projects(includeSubgroups: true, first: 100, after: $after) {
+      pageInfo {
+        hasNextPage
+        endCursor
+      }
+      nodes {
+        fullPath
+        statistics {
+          storageSize
+          repositorySize
+          buildArtifactsSize
+          packagesSize
+          containerRegistrySize
+          lfsObjectsSize
+        }
+      }
+    }
+  }
+}
+`
+
+// GetGroupStorage returns the storage used by every project of a group and its subgroups.
+func GetGroupStorage(client *gitlab.Client, groupPath string) (*GroupStorage, error) {
+	storage := &GroupStorage{FullPath: groupPath}
+
+	var after *string
+	for {
+		var response struct {
+			graphQLErrors
+			Data struct {
+				Group *struct {
+					DependencyProxyTotalSizeBytes json.Number `json:"dependencyProxyTotalSizeBytes"`
+					Projects                      struct {
+						PageInfo struct {
+							HasNextPage bool   `json:"hasNextPage"`
+							EndCursor   string `json:"endCursor"`
+						} `json:"pageInfo"`
+						Nodes []struct {
+							FullPath   string `json:"fullPath"`
+							Statistics *struct {
+								StorageSize           float64 `json:"storageSize"`
+								RepositorySize        float64 `json:"repositorySize"`
+								BuildArtifactsSize    float64 `json:"buildArtifactsSize"`
+								PackagesSize          float64 `json:"packagesSize"`
+								ContainerRegistrySize float64 `json:"containerRegistrySize"`
+								LFSObjectsSize        float64 `json:"lfsObjectsSize"`
+							} `json:"statistics"`
+						} `json:"nodes"`
+					} `json:"projects"`
+				} `json:"group"`
+			} `json:"data"`
+		}
+
+		_, err := client.GraphQL.Do(gitlab.GraphQLQuery{
+			Query: groupStorageQuery,
+			Variables: map[string]any{
+				"fullPath": groupPath,
+				"after":    after,
+			},
+		}, &response)
+		if err != nil {
+			return nil, err
+		}
+		if err := response.err(); err != nil {
+			return nil, err
+		}
+		group := response.Data.Group
+		if group == nil {
+			return nil, fmt.Errorf("group %q not found.", groupPath)
+		}
+
+		if group.DependencyProxyTotalSizeBytes != "" {
+			storage.DependencyProxySize, err = group.DependencyProxyTotalSizeBytes.Int64()
+			if err != nil {
+				return nil, fmt.Errorf("invalid dependency proxy size: %w", err)
+			}
+		}
+		for _, node := range group.Projects.Nodes {
+			project := &ProjectStorage{FullPath: node.FullPath}
+			if s := node.Statistics; s != nil {
+				project.StorageSize = int64(s.StorageSize)
+				project.RepositorySize = int64(s.RepositorySize)
+				project.JobArtifactsSize = int64(s.BuildArtifactsSize)
+				project.PackagesSize = int64(s.PackagesSize)
+				project.ContainerRegistrySize = int64(s.ContainerRegistrySize)
+				project.LFSObjectsSize = int64(s.LFSObjectsSize)
+			}
+			storage.Projects = append(storage.Projects, project)
+		}
+
+		if !group.Projects.PageInfo.HasNextPage {
+			return storage, nil
+		}
+		after = &group.Projects.PageInfo.EndCursor
+	}
+}
@@ -43,3 +43,39 @@ var UsersByNames = func(client *gitlab.Client, names []string) ([]*gitlab.User,
 	}
 	return users, nil
 }
+
+// UserByName returns the user with the username, or the current user for "@me", like the
+// UserByName function, but through the user cache of the client when user_cache_ttl is set.
+// Only the ID, username, and name of cached users are set, and a cached user might have been
+// renamed or deleted since: use the function when that matters, for example for tokens.
+func (c *Client) UserByName(name string) (*gitlab.User, error) {
+	if c.users != nil {
+		if u := c.users.get(name); u != nil {
+			return u, nil
+		}
+	}
+
+	user, err := UserByName(c.Lab(), name)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.users != nil {
+		c.users.put(user, name == "@me")
+	}
+	return user, nil
+}
+
+// UsersByNames returns the users with the usernames, looked up with the UserByName method.
+func (c *Client) UsersByNames(names []string) ([]*gitlab.User, error) {
+	users := make([]*gitlab.User, 0, len(names))
+	for _, name := range names {
+		user, err := c.UserByName(name)
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, user)
+	}
+	return users, nil
+}
@@ -0,0 +1,125 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/filelock"
+)
+
+// userCacheLockTimeout is how long storing a user waits for other glab processes to store
+// theirs. The cache only saves requests, so the user isn't stored when it takes longer.
+const userCacheLockTimeout = 5 * time.Second
+
+// userCache is a persistent cache of users by username, per host, so that filters like
+// --assignee don't look up the same users on every command. The current user is
+// cached per token, because it depends on the token.
+type userCache struct {
+	dir  string
+	host string
+	ttl  time.Duration
+	// identity identifies the token of the client, or is empty if the current
+	// user can't be cached.
+	identity string
+
+	now func() time.Time
+}
+
+type cachedUser struct {
+	ID       int64     `json:"id"`
+	Username string    `json:"username"`
+	Name     string    `json:"name"`
+	StoredAt time.Time `json:"stored_at"`
+}
+
+type userCacheFile struct {
+	Users   map[string]cachedUser `json:"users"`
+	Current map[string]cachedUser `json:"current"`
+}
+
+// DefaultUserCacheDir returns the directory used for the user cache.
+func DefaultUserCacheDir() (string, error) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(cacheDir, "glab", "users"), nil
+}
+
+// tokenIdentity returns a hash that identifies a token without revealing it.
+func tokenIdentity(token string) string {
+	if token == "" {
+		return ""
+	}
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
+}
+
+func (c *userCache) path() string {
+	return filepath.Join(c.dir, strings.ReplaceAll(c.host, ":", "_")+".json")
+}
+
+// get returns the cached user with the username, or the current user if name is "@me".
+func (c *userCache) get(name string) *gitlab.User {
+	f := c.load()
+	var u cachedUser
+	var ok bool
+	if name == "@me" {
+		u, ok = f.Current[c.identity]
+	} else {
+		u, ok = f.Users[strings.ToLower(name)]
+	}
+	if !ok || c.now().Sub(u.StoredAt) >= c.ttl {
+		return nil
+	}
+	return &gitlab.User{ID: u.ID, Username: u.Username, Name: u.Name}
+}
+
+// put stores a user. Errors are ignored, because the cache only saves requests.
+func (c *userCache) put(user *gitlab.User, current bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), userCacheLockTimeout)
+	defer cancel()
+
+	if err := os.MkdirAll(c.dir, 0o700); err != nil {
+		return
+	}
+
+	// The file is read again under the lock, so users stored by other glab processes
+	// in the meantime are kept.
+	_ = filelock.With(ctx, c.path(), func() error {
+		f := c.load()
+		u := cachedUser{ID: user.ID, Username: user.Username, Name: user.Name, StoredAt: c.now()}
+		f.Users[strings.ToLower(user.Username)] = u
+		if current && c.identity != "" {
+			f.Current[c.identity] = u
+		}
+
+		data, err := json.Marshal(f)
+		if err != nil {
+			return err
+		}
+		return filelock.WriteFile(c.path(), data, 0o600)
+	})
+}
+
+func (c *userCache) load() *userCacheFile {
+	f := &userCacheFile{}
+	if data, err := os.ReadFile(c.path()); err == nil {
+		_ = json.Unmarshal(data, f)
+	}
+	if f.Users == nil {
+		f.Users = map[string]cachedUser{}
+	}
+	if f.Current == nil {
+		f.Current = map[string]cachedUser{}
+	}
+	return f
+}
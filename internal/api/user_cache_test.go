@@ -0,0 +1,181 @@
+//go:build !integration
+
+package api
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+	"go.uber.org/mock/gomock"
+
+	"gitlab.com/gitlab-org/cli/internal/config"
+)
+
+func newTestUserCacheClient(t *testing.T, tc *gitlabtesting.TestClient, identity string, now *time.Time) *Client {
+	t.Helper()
+
+	client := &Client{gitlabClient: tc.Client}
+	client.users = &userCache{
+		dir:      t.TempDir(),
+		host:     "gitlab.example.com",
+		ttl:      time.Hour,
+		identity: identity,
+		now:      func() time.Time { return *now },
+	}
+	return client
+}
+
+func TestUserByName_Cache(t *testing.T) {
+	user := &gitlab.User{ID: 42, Username: "Alice", Name: "Alice Example", Email: "alice@example.com"}
+	cached := &gitlab.User{ID: 42, Username: "Alice", Name: "Alice Example"}
+
+	t.Run("users are looked up once", func(t *testing.T) {
+		tc := gitlabtesting.NewTestClient(t)
+		now := time.Now()
+		client := newTestUserCacheClient(t, tc, "", &now)
+
+		tc.MockUsers.EXPECT().
+			ListUsers(&gitlab.ListUsersOptions{Username: gitlab.Ptr("alice"), ListOptions: gitlab.ListOptions{PerPage: DefaultListLimit}}).
+			Return([]*gitlab.User{user}, nil, nil).
+			Times(1)
+
+		got, err := client.UserByName("alice")
+		require.NoError(t, err)
+		assert.Equal(t, user, got)
+
+		got, err = client.UserByName("ALICE")
+		require.NoError(t, err)
+		assert.Equal(t, cached, got)
+	})
+
+	t.Run("expired users are looked up again", func(t *testing.T) {
+		tc := gitlabtesting.NewTestClient(t)
+		now := time.Now()
+		client := newTestUserCacheClient(t, tc, "", &now)
+
+		tc.MockUsers.EXPECT().
+			ListUsers(gomock.Any()).
+			Return([]*gitlab.User{user}, nil, nil).
+			Times(2)
+
+		_, err := client.UserByName("alice")
+		require.NoError(t, err)
+
+		now = now.Add(time.Hour)
+		_, err = client.UserByName("alice")
+		require.NoError(t, err)
+	})
+
+	t.Run("current user is cached for the token", func(t *testing.T) {
+		tc := gitlabtesting.NewTestClient(t)
+		now := time.Now()
+		client := newTestUserCacheClient(t, tc, tokenIdentity("token"), &now)
+
+		tc.MockUsers.EXPECT().CurrentUser().Return(user, nil, nil).Times(1)
+
+		_, err := client.UserByName("@me")
+		require.NoError(t, err)
+
+		got, err := client.UserByName("@me")
+		require.NoError(t, err)
+		assert.Equal(t, cached, got)
+
+		// Looking up the current user also caches it by username.
+		got, err = client.UserByName("alice")
+		require.NoError(t, err)
+		assert.Equal(t, cached, got)
+	})
+
+	t.Run("current user is not cached without a token identity", func(t *testing.T) {
+		tc := gitlabtesting.NewTestClient(t)
+		now := time.Now()
+		client := newTestUserCacheClient(t, tc, "", &now)
+
+		tc.MockUsers.EXPECT().CurrentUser().Return(user, nil, nil).Times(2)
+
+		_, err := client.UserByName("@me")
+		require.NoError(t, err)
+		_, err = client.UserByName("@me")
+		require.NoError(t, err)
+	})
+
+	t.Run("the function doesn't use the cache", func(t *testing.T) {
+		tc := gitlabtesting.NewTestClient(t)
+		now := time.Now()
+		client := newTestUserCacheClient(t, tc, "", &now)
+
+		tc.MockUsers.EXPECT().ListUsers(gomock.Any()).Return([]*gitlab.User{user}, nil, nil).Times(2)
+
+		_, err := client.UserByName("alice")
+		require.NoError(t, err)
+
+		got, err := UserByName(tc.Client, "alice")
+		require.NoError(t, err)
+		assert.Equal(t, user, got)
+	})
+
+	t.Run("users are stored by concurrent lookups", func(t *testing.T) {
+		tc := gitlabtesting.NewTestClient(t)
+		now := time.Now()
+		client := newTestUserCacheClient(t, tc, "", &now)
+
+		names := []string{"alice", "bob", "carol", "dave", "erin"}
+		for i, name := range names {
+			tc.MockUsers.EXPECT().
+				ListUsers(&gitlab.ListUsersOptions{Username: gitlab.Ptr(name), ListOptions: gitlab.ListOptions{PerPage: DefaultListLimit}}).
+				Return([]*gitlab.User{{ID: int64(i), Username: name}}, nil, nil).
+				Times(1)
+		}
+
+		var wg sync.WaitGroup
+		for _, name := range names {
+			wg.Go(func() {
+				_, err := client.UserByName(name)
+				assert.NoError(t, err)
+			})
+		}
+		wg.Wait()
+
+		for i, name := range names {
+			got, err := client.UserByName(name)
+			require.NoError(t, err)
+			assert.Equal(t, int64(i), got.ID)
+		}
+	})
+}
+
+func TestClient_UserByName_NoCache(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+	client := &Client{gitlabClient: tc.Client}
+
+	tc.MockUsers.EXPECT().ListUsers(gomock.Any()).Return([]*gitlab.User{{ID: 42, Username: "alice"}}, nil, nil).Times(2)
+
+	for range 2 {
+		_, err := client.UserByName("alice")
+		require.NoError(t, err)
+	}
+}
+
+func TestNewClientFromConfig_UserCacheTTL(t *testing.T) {
+	t.Setenv("GLAB_USER_CACHE_TTL", "not-a-duration")
+
+	_, err := NewClientFromConfig("gitlab.example.com", config.NewBlankConfig(), false, "glab test")
+	assert.EqualError(t, err, `invalid user_cache_ttl value "not-a-duration": time: invalid duration "not-a-duration"`)
+
+	t.Setenv("GLAB_USER_CACHE_TTL", "")
+	client, err := NewClientFromConfig("gitlab.example.com", config.NewBlankConfig(), false, "glab test")
+	require.NoError(t, err)
+	assert.Nil(t, client.users, "the cache is disabled unless user_cache_ttl is set")
+
+	t.Setenv("GLAB_USER_CACHE_TTL", "24h")
+	client, err = NewClientFromConfig("gitlab.example.com", config.NewBlankConfig(), false, "glab test")
+	require.NoError(t, err)
+	require.NotNil(t, client.users)
+	assert.Equal(t, 24*time.Hour, client.users.ttl)
+	assert.Equal(t, "gitlab.example.com", client.users.host)
+}
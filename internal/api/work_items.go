@@ -0,0 +1,378 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// WorkItem is an issue, task, objective, key result, or other work item.
+type WorkItem struct {
+	// ID is the global ID of the work item, for example gid://gitlab/WorkItem/1
+	ID          string `json:"id"`
+	IID         int64  `json:"iid"`
+	Type        string `json:"type"`
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	// State is OPEN or CLOSED.
+	State     string      `json:"state"`
+	Author    string      `json:"author,omitempty"`
+	CreatedAt *time.Time  `json:"created_at,omitempty"`
+	WebURL    string      `json:"web_url"`
+	Parent    *WorkItem   `json:"parent,omitempty"`
+	Children  []*WorkItem `json:"children,omitempty"`
+}
+
+// Closed returns whether the work item is closed, which marks tasks as completed.
+func (w *WorkItem) Closed() bool {
+	return w.State == "CLOSED"
+}
+
+// workItemNode is a work item in GraphQL responses.
+type workItemNode struct {
+	ID           string     `json:"id"`
+	IID          string     `json:"iid"`
+	Title        string     `json:"title"`
+	Description  string     `json:"description"`
+	State        string     `json:"state"`
+	WebURL       string     `json:"webUrl"`
+	CreatedAt    *time.Time `json:"createdAt"`
+	WorkItemType struct {
+		Name string `json:"name"`
+	} `json:"workItemType"`
+	Author *struct {
+		Username string `json:"username"`
+	} `json:"author"`
+	Widgets []struct {
+		Type     string        `json:"type"`
+		Parent   *workItemNode `json:"parent"`
+		Children *struct {
+			Nodes []*workItemNode `json:"nodes"`
+		} `json:"children"`
+	} `json:"widgets"`
+}
+
+func (n *workItemNode) workItem() *WorkItem {
+	w := &WorkItem{
+		ID:          n.ID,
+		Type:        n.WorkItemType.Name,
+		Title:       n.Title,
+		Description: n.Description,
+		State:       n.State,
+		CreatedAt:   n.CreatedAt,
+		WebURL:      n.WebURL,
+	}
+	w.IID, _ = strconv.ParseInt(n.IID, 10, 64)
+	if n.Author != nil {
+		w.Author = n.Author.Username
+	}
+	for _, widget := range n.Widgets {
+		if widget.Type != "HIERARCHY" {
+			continue
+		}
+		if widget.Parent != nil {
+			w.Parent = widget.Parent.workItem()
+		}
+		if widget.Children != nil {
+			for _, child := range widget.Children.Nodes {
+				w.Children = append(w.Children, child.workItem())
+			}
+		}
+	}
+	return w
+}
+
+const workItemFields = `
+  id
+  iid
+  title
+  state
+  webUrl
+  workItemType { name }
+`
+
+var workItemQuery = `
+query($fullPath: ID!, $iid: String!) {
+  project(fullPath: $fullPath) {
+    workItems(iid: $iid) {
+      nodes {
+        ` + workItemFields + `
+        description
+        createdAt
+        author { username }
+        widgets {
+          type
+          ... on WorkItemWidgetHierarchy {
+            parent { ` + workItemFields + ` }
+            children { nodes { ` + workItemFields + ` } }
+          }
+        }
+      }
+    }
+  }
+}
+`
+
+// GetWorkItem returns the work item of a project with its parent and children.
+// Issues are work items too, with the same IID.
+func GetWorkItem(client *gitlab.Client, projectPath string, iid int64) (*WorkItem, error) {
+	var response struct {
+		graphQLErrors
+		Data struct {
+			Project *struct {
+				WorkItems struct {
+					Nodes []*workItemNode `json:"nodes"`
+				} `json:"workItems"`
+			} `json:"project"`
+		} `json:"data"`
+	}
+
+	_, err := client.GraphQL.Do(gitlab.GraphQLQuery{
+		Query:     workItemQuery,
+		Variables: map[string]any{"fullPath": projectPath, "iid": strconv.FormatInt(iid, 10)},
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if err := response.err(); err != nil {
+		return nil, err
+	}
+	if response.Data.Project == nil {
+		return nil, fmt.Errorf("project %q not found.", projectPath)
+	}
+	if len(response.Data.Project.WorkItems.Nodes) == 0 {
+		return nil, fmt.Errorf("work item #%d not found in %s.", iid, projectPath)
+	}
+
+	return response.Data.Project.WorkItems.Nodes[0].workItem(), nil
+}
+
+// WorkItemsFilter filters the work items listed by ListWorkItems. Empty fields
+// don't filter.
+type WorkItemsFilter struct {
+	// Types are GraphQL IssueType values, like TASK or OBJECTIVE.
+	Types []string
+	// State is opened, closed, or all.
+	State string
+	First int64
+}
+
+// ListWorkItems lists the first work items of a project.
+func ListWorkItems(client *gitlab.Client, projectPath string, filter *WorkItemsFilter) ([]*WorkItem, error) {
+	declarations := []string{"$fullPath: ID!", "$first: Int"}
+	arguments := []string{"first: $first"}
+	variables := map[string]any{"fullPath": projectPath, "first": filter.First}
+	if len(filter.Types) > 0 {
+		declarations = append(declarations, "$types: [IssueType!]")
+		arguments = append(arguments, "types: $types")
+		variables["types"] = filter.Types
+	}
+	if filter.State != "" && filter.State != "all" {
+		declarations = append(declarations, "$state: IssuableState")
+		arguments = append(arguments, "state: $state")
+		variables["state"] = filter.State
+	}
+
+	query := fmt.Sprintf(`
+query(%s) {
+  project(fullPath: $fullPath) {
+    workItems(%s) {
+      nodes { %s }
+    }
+  }
+}
+`, strings.Join(declarations, ", "), strings.Join(arguments, ", "), workItemFields)
+
+	var response struct {
+		graphQLErrors
+		Data struct {
+			Project *struct {
+				WorkItems struct {
+					Nodes []*workItemNode `json:"nodes"`
+				} `json:"workItems"`
+			} `json:"project"`
+		} `json:"data"`
+	}
+
+	_, err := client.GraphQL.Do(gitlab.GraphQLQuery{Query: query, Variables: variables}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if err := response.err(); err != nil {
+		return nil, err
+	}
+	if response.Data.Project == nil {
+		return nil, fmt.Errorf("project %q not found.", projectPath)
+	}
+
+	items := make([]*WorkItem, 0, len(response.Data.Project.WorkItems.Nodes))
+	for _, node := range response.Data.Project.WorkItems.Nodes {
+		items = append(items, node.workItem())
+	}
+	return items, nil
+}
+
+const workItemTypesQuery = `
+query($fullPath: ID!) {
+  project(fullPath: $fullPath) {
+    workItemTypes {
+      nodes { id name }
+    }
+  }
+}
+`
+
+// workItemTypeID returns the global ID of the work item type with the name
+// typeName, like Task, in a project.
+func workItemTypeID(client *gitlab.Client, projectPath, typeName string) (string, error) {
+	var response struct {
+		graphQLErrors
+		Data struct {
+			Project *struct {
+				WorkItemTypes struct {
+					Nodes []struct {
+						ID   string `json:"id"`
+						Name string `json:"name"`
+					} `json:"nodes"`
+				} `json:"workItemTypes"`
+			} `json:"project"`
+		} `json:"data"`
+	}
+
+	_, err := client.GraphQL.Do(gitlab.GraphQLQuery{
+		Query:     workItemTypesQuery,
+		Variables: map[string]any{"fullPath": projectPath},
+	}, &response)
+	if err != nil {
+		return "", err
+	}
+	if err := response.err(); err != nil {
+		return "", err
+	}
+	if response.Data.Project == nil {
+		return "", fmt.Errorf("project %q not found.", projectPath)
+	}
+
+	names := make([]string, 0, len(response.Data.Project.WorkItemTypes.Nodes))
+	for _, t := range response.Data.Project.WorkItemTypes.Nodes {
+		if strings.EqualFold(t.Name, typeName) {
+			return t.ID, nil
+		}
+		names = append(names, t.Name)
+	}
+	return "", fmt.Errorf("work item type %q isn't available in %s. Available types: %s.", typeName, projectPath, strings.Join(names, ", "))
+}
+
+// CreateWorkItemOptions are the options of CreateWorkItem.
+type CreateWorkItemOptions struct {
+	// Type is the name of the type, like Task or Objective.
+	Type        string
+	Title       string
+	Description string
+	// ParentID is the global ID of the parent work item, if any.
+	ParentID string
+}
+
+const createWorkItemMutation = `
+mutation($input: WorkItemCreateInput!) {
+  workItemCreate(input: $input) {
+    workItem { ` + workItemFields + ` }
+    errors
+  }
+}
+`
+
+// CreateWorkItem creates a work item in a project.
+func CreateWorkItem(client *gitlab.Client, projectPath string, opts *CreateWorkItemOptions) (*WorkItem, error) {
+	typeID, err := workItemTypeID(client, projectPath, opts.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	input := map[string]any{
+		"namespacePath":  projectPath,
+		"workItemTypeId": typeID,
+		"title":          opts.Title,
+	}
+	if opts.Description != "" {
+		input["descriptionWidget"] = map[string]any{"description": opts.Description}
+	}
+	if opts.ParentID != "" {
+		input["hierarchyWidget"] = map[string]any{"parentId": opts.ParentID}
+	}
+
+	var response struct {
+		graphQLErrors
+		Data struct {
+			WorkItemCreate *struct {
+				WorkItem *workItemNode `json:"workItem"`
+				Errors   []string      `json:"errors"`
+			} `json:"workItemCreate"`
+		} `json:"data"`
+	}
+
+	_, err = client.GraphQL.Do(gitlab.GraphQLQuery{
+		Query:     createWorkItemMutation,
+		Variables: map[string]any{"input": input},
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if err := response.err(); err != nil {
+		return nil, err
+	}
+	result := response.Data.WorkItemCreate
+	if result == nil || (result.WorkItem == nil && len(result.Errors) == 0) {
+		return nil, errors.New("failed to create the work item.")
+	}
+	if len(result.Errors) > 0 {
+		return nil, errors.New(strings.Join(result.Errors, ", "))
+	}
+	return result.WorkItem.workItem(), nil
+}
+
+const updateWorkItemStateMutation = `
+mutation($id: WorkItemID!, $stateEvent: WorkItemStateEvent!) {
+  workItemUpdate(input: {id: $id, stateEvent: $stateEvent}) {
+    workItem { ` + workItemFields + ` }
+    errors
+  }
+}
+`
+
+// SetWorkItemState closes or reopens a work item, with the state event CLOSE
+// or REOPEN.
+func SetWorkItemState(client *gitlab.Client, id, stateEvent string) (*WorkItem, error) {
+	var response struct {
+		graphQLErrors
+		Data struct {
+			WorkItemUpdate *struct {
+				WorkItem *workItemNode `json:"workItem"`
+				Errors   []string      `json:"errors"`
+			} `json:"workItemUpdate"`
+		} `json:"data"`
+	}
+
+	_, err := client.GraphQL.Do(gitlab.GraphQLQuery{
+		Query:     updateWorkItemStateMutation,
+		Variables: map[string]any{"id": id, "stateEvent": stateEvent},
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if err := response.err(); err != nil {
+		return nil, err
+	}
+	result := response.Data.WorkItemUpdate
+	if result == nil || (result.WorkItem == nil && len(result.Errors) == 0) {
+		return nil, errors.New("failed to update the work item.")
+	}
+	if len(result.Errors) > 0 {
+		return nil, errors.New(strings.Join(result.Errors, ", "))
+	}
+	return result.WorkItem.workItem(), nil
+}
@@ -0,0 +1,170 @@
+//go:build !integration
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestGetWorkItem(t *testing.T) {
+	tests := []struct {
+		name      string
+		response  string
+		want      *WorkItem
+		wantError string
+	}{
+		{
+			name: "Issue with tasks",
+			response: `{"data": {"project": {"workItems": {"nodes": [{
+				"id": "gid://gitlab/WorkItem/100", "iid": "12", "title": "Search", "state": "OPEN",
+				"webUrl": "https://gitlab.com/OWNER/REPO/-/issues/12", "workItemType": {"name": "Issue"},
+				"description": "Faster search", "author": {"username": "alice"},
+				"widgets": [
+					{"type": "DESCRIPTION"},
+					{"type": "HIERARCHY", "parent": null, "children": {"nodes": [
+						{"id": "gid://gitlab/WorkItem/101", "iid": "13", "title": "Index", "state": "CLOSED", "webUrl": "https://gitlab.com/OWNER/REPO/-/work_items/13", "workItemType": {"name": "Task"}},
+						{"id": "gid://gitlab/WorkItem/102", "iid": "14", "title": "Docs", "state": "OPEN", "webUrl": "https://gitlab.com/OWNER/REPO/-/work_items/14", "workItemType": {"name": "Task"}}
+					]}}
+				]
+			}]}}}}`,
+			want: &WorkItem{
+				ID: "gid://gitlab/WorkItem/100", IID: 12, Type: "Issue", Title: "Search", Description: "Faster search",
+				State: "OPEN", Author: "alice", WebURL: "https://gitlab.com/OWNER/REPO/-/issues/12",
+				Children: []*WorkItem{
+					{ID: "gid://gitlab/WorkItem/101", IID: 13, Type: "Task", Title: "Index", State: "CLOSED", WebURL: "https://gitlab.com/OWNER/REPO/-/work_items/13"},
+					{ID: "gid://gitlab/WorkItem/102", IID: 14, Type: "Task", Title: "Docs", State: "OPEN", WebURL: "https://gitlab.com/OWNER/REPO/-/work_items/14"},
+				},
+			},
+		},
+		{
+			name:      "Work item not found",
+			response:  `{"data": {"project": {"workItems": {"nodes": []}}}}`,
+			wantError: "work item #12 not found in OWNER/REPO.",
+		},
+		{
+			name:      "Project not found",
+			response:  `{"data": {"project": null}}`,
+			wantError: `project "OWNER/REPO" not found.`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var variables map[string]any
+			client := newGraphQLTestClient(t, tt.response, &variables)
+
+			got, err := GetWorkItem(client, "OWNER/REPO", 12)
+			if tt.wantError != "" {
+				require.EqualError(t, err, tt.wantError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, map[string]any{"fullPath": "OWNER/REPO", "iid": "12"}, variables)
+			assert.Equal(t, tt.want, got)
+			assert.False(t, got.Closed())
+			assert.True(t, got.Children[0].Closed())
+		})
+	}
+}
+
+func TestListWorkItems(t *testing.T) {
+	var variables map[string]any
+	client := newGraphQLTestClient(t, `{"data": {"project": {"workItems": {"nodes": [
+		{"id": "gid://gitlab/WorkItem/101", "iid": "13", "title": "Index", "state": "OPEN", "workItemType": {"name": "Task"}}
+	]}}}}`, &variables)
+
+	got, err := ListWorkItems(client, "OWNER/REPO", &WorkItemsFilter{Types: []string{"TASK"}, State: "opened", First: 30})
+	require.NoError(t, err)
+
+	assert.Equal(t, []*WorkItem{{ID: "gid://gitlab/WorkItem/101", IID: 13, Type: "Task", Title: "Index", State: "OPEN"}}, got)
+	assert.Equal(t, map[string]any{"fullPath": "OWNER/REPO", "first": float64(30), "types": []any{"TASK"}, "state": "opened"}, variables)
+}
+
+func TestCreateWorkItem(t *testing.T) {
+	responses := []string{
+		`{"data": {"project": {"workItemTypes": {"nodes": [
+			{"id": "gid://gitlab/WorkItems::Type/1", "name": "Issue"},
+			{"id": "gid://gitlab/WorkItems::Type/5", "name": "Task"}
+		]}}}}`,
+		`{"data": {"workItemCreate": {"workItem": {"id": "gid://gitlab/WorkItem/103", "iid": "15", "title": "Tests", "state": "OPEN", "workItemType": {"name": "Task"}}, "errors": []}}}`,
+	}
+	var queries []gitlab.GraphQLQuery
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var query gitlab.GraphQLQuery
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&query))
+		queries = append(queries, query)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(responses[len(queries)-1]))
+	}))
+	t.Cleanup(server.Close)
+	client, err := gitlab.NewClient("test-token", gitlab.WithBaseURL(server.URL+"/api/v4"))
+	require.NoError(t, err)
+
+	got, err := CreateWorkItem(client, "OWNER/REPO", &CreateWorkItemOptions{
+		Type:     "Task",
+		Title:    "Tests",
+		ParentID: "gid://gitlab/WorkItem/100",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(15), got.IID)
+	require.Len(t, queries, 2)
+	assert.Equal(t, map[string]any{"input": map[string]any{
+		"namespacePath":   "OWNER/REPO",
+		"workItemTypeId":  "gid://gitlab/WorkItems::Type/5",
+		"title":           "Tests",
+		"hierarchyWidget": map[string]any{"parentId": "gid://gitlab/WorkItem/100"},
+	}}, queries[1].Variables)
+}
+
+func TestCreateWorkItemUnknownType(t *testing.T) {
+	client := newGraphQLTestClient(t, `{"data": {"project": {"workItemTypes": {"nodes": [{"id": "gid://gitlab/WorkItems::Type/1", "name": "Issue"}]}}}}`, nil)
+
+	_, err := CreateWorkItem(client, "OWNER/REPO", &CreateWorkItemOptions{Type: "Objective", Title: "Grow"})
+	require.EqualError(t, err, `work item type "Objective" isn't available in OWNER/REPO. Available types: Issue.`)
+}
+
+func TestSetWorkItemState(t *testing.T) {
+	tests := []struct {
+		name      string
+		response  string
+		wantState string
+		wantError string
+	}{
+		{
+			name:      "Closed",
+			response:  `{"data": {"workItemUpdate": {"workItem": {"id": "gid://gitlab/WorkItem/101", "iid": "13", "state": "CLOSED", "workItemType": {"name": "Task"}}, "errors": []}}}`,
+			wantState: "CLOSED",
+		},
+		{
+			name:      "Mutation errors",
+			response:  `{"data": {"workItemUpdate": {"workItem": null, "errors": ["You don't have permission"]}}}`,
+			wantError: "You don't have permission",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var variables map[string]any
+			client := newGraphQLTestClient(t, tt.response, &variables)
+
+			got, err := SetWorkItemState(client, "gid://gitlab/WorkItem/101", "CLOSE")
+			if tt.wantError != "" {
+				require.EqualError(t, err, tt.wantError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantState, got.State)
+			assert.Equal(t, map[string]any{"id": "gid://gitlab/WorkItem/101", "stateEvent": "CLOSE"}, variables)
+		})
+	}
+}
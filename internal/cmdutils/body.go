@@ -0,0 +1,55 @@
+package cmdutils
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+)
+
+const bodyFileFlag = "body-file"
+
+// AddBodyFileFlag adds the --body-file flag to cmd. It reads the text of the flag
+// bodyFlag, like --message or --description, from a file, or from the standard
+// input when the path is "-". The command calls ApplyBodyFile before it reads bodyFlag.
+func AddBodyFileFlag(cmd *cobra.Command, bodyFlag string) {
+	cmd.Flags().String(bodyFileFlag, "", fmt.Sprintf("Read the --%s text from a file. Use - to read from standard input.", bodyFlag))
+	cmd.MarkFlagsMutuallyExclusive(bodyFlag, bodyFileFlag)
+}
+
+// ApplyBodyFile sets the flag bodyFlag to the text read from the --body-file path,
+// if it's set. The flag then counts as changed, like when the text is passed directly.
+func ApplyBodyFile(cmd *cobra.Command, ios *iostreams.IOStreams, bodyFlag string) error {
+	path, _ := cmd.Flags().GetString(bodyFileFlag)
+	if path == "" {
+		return nil
+	}
+
+	body, err := ReadBodyFile(ios, path)
+	if err != nil {
+		return err
+	}
+	return cmd.Flags().Set(bodyFlag, body)
+}
+
+// ReadBodyFile returns the contents of the file at path, or of the standard input
+// when path is "-".
+func ReadBodyFile(ios *iostreams.IOStreams, path string) (string, error) {
+	var b []byte
+	var err error
+
+	if path == "-" {
+		b, err = io.ReadAll(ios.In)
+		_ = ios.In.Close()
+	} else {
+		b, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return "", fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	return string(b), nil
+}
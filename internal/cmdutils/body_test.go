@@ -0,0 +1,96 @@
+//go:build !integration
+
+package cmdutils
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+)
+
+func TestApplyBodyFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "body.md")
+	require.NoError(t, os.WriteFile(path, []byte("from a file\n"), 0o600))
+
+	tests := []struct {
+		name        string
+		args        []string
+		stdin       string
+		wantMessage string
+		wantChanged bool
+		wantErr     string
+	}{
+		{
+			name:        "no body file",
+			args:        []string{"--message", "inline"},
+			wantMessage: "inline",
+			wantChanged: true,
+		},
+		{
+			name: "nothing set",
+		},
+		{
+			name:        "file",
+			args:        []string{"--body-file", path},
+			wantMessage: "from a file\n",
+			wantChanged: true,
+		},
+		{
+			name:        "standard input",
+			args:        []string{"--body-file", "-"},
+			stdin:       "from stdin",
+			wantMessage: "from stdin",
+			wantChanged: true,
+		},
+		{
+			name:    "missing file",
+			args:    []string{"--body-file", filepath.Join(t.TempDir(), "missing.md")},
+			wantErr: "no such file or directory",
+		},
+		{
+			name:    "both flags",
+			args:    []string{"--body-file", path, "--message", "inline"},
+			wantErr: "if any flags in the group [message body-file] are set none of the others can be",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ios := iostreams.New(iostreams.WithStdin(io.NopCloser(strings.NewReader(tc.stdin)), false))
+
+			var message string
+			cmd := &cobra.Command{
+				Use: "note",
+				RunE: func(cmd *cobra.Command, args []string) error {
+					return ApplyBodyFile(cmd, ios, "message")
+				},
+			}
+			cmd.Flags().StringVarP(&message, "message", "m", "", "")
+			AddBodyFileFlag(cmd, "message")
+			cmd.SetArgs(tc.args)
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+
+			err := cmd.Execute()
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantMessage, message)
+			assert.Equal(t, tc.wantChanged, cmd.Flags().Changed("message"))
+		})
+	}
+}
@@ -22,7 +22,7 @@ func (e *enumValue) String() string {
 func (e *enumValue) Set(v string) error {
 	_, ok := e.allowed[v]
 	if !ok {
-		return fmt.Errorf("must be one of %v", slices.Collect(maps.Keys(e.allowed)))
+		return fmt.Errorf("must be one of %v", slices.Sorted(maps.Keys(e.allowed)))
 	}
 	*e.valueRef = v
 	return nil
@@ -1,7 +1,10 @@
 package cmdutils
 
 import (
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"sync"
 
@@ -30,6 +33,8 @@ type Factory interface {
 	Remotes() (glrepo.Remotes, error)
 	Config() config.Config
 	Branch() (string, error)
+	// BranchOverride makes Branch return branch instead of the current branch.
+	BranchOverride(branch string)
 	IO() *iostreams.IOStreams
 	DefaultHostname() string
 	BuildInfo() api.BuildInfo
@@ -37,31 +42,54 @@ type Factory interface {
 }
 
 type DefaultFactory struct {
-	io              *iostreams.IOStreams
-	config          config.Config
-	resolveRepos    bool
-	buildInfo       api.BuildInfo
+	io           *iostreams.IOStreams
+	config       config.Config
+	resolveRepos bool
+	buildInfo    api.BuildInfo
+
+	// The default hostname and remotes are resolved on first use, so that commands
+	// that don't need them, like --help and completion, start faster.
+	hostOnce        sync.Once
 	defaultHostname string
 	defaultProtocol string
+	remotesOnce     sync.Once
+	remotes         glrepo.Remotes
+	remotesErr      error
 
 	mu sync.Mutex // protects the fields below
 	// cachedBaseRepo if set is the SSoT of the repository to use in BaseRepo(), GitLabClient() and other factory function that require a repository.
 	// This is also being set for a repo override.
 	cachedBaseRepo glrepo.Interface
+	// gitlabClients caches the clients returned by GitLabClient() by host.
+	gitlabClients map[string]*gitlab.Client
+	// branchOverride if set is returned by Branch() instead of the current branch.
+	branchOverride string
 }
 
+// NewFactory returns a factory that doesn't read git remotes or create API clients
+// until a command asks for them.
 func NewFactory(io *iostreams.IOStreams, resolveRepos bool, cfg config.Config, buildInfo api.BuildInfo) *DefaultFactory {
-	f := &DefaultFactory{
-		io:              io,
-		config:          cfg,
-		resolveRepos:    resolveRepos,
-		buildInfo:       buildInfo,
-		defaultHostname: glinstance.DefaultHostname,
-		defaultProtocol: glinstance.DefaultProtocol,
+	return &DefaultFactory{
+		io:           io,
+		config:       cfg,
+		resolveRepos: resolveRepos,
+		buildInfo:    buildInfo,
 	}
+}
+
+// DefaultHostname returns the host from the host setting, or else from the git
+// remotes, or else gitlab.com.
+func (f *DefaultFactory) DefaultHostname() string {
+	f.hostOnce.Do(f.resolveDefaultHostname)
+	return f.defaultHostname
+}
+
+func (f *DefaultFactory) resolveDefaultHostname() {
+	f.defaultHostname = glinstance.DefaultHostname
+	f.defaultProtocol = glinstance.DefaultProtocol
 
 	// Fetch the custom host config from env vars, then local config.yml, then global config,yml.
-	customGLHost, _ := cfg.Get("", "host")
+	customGLHost, _ := f.config.Get("", "host")
 	if customGLHost != "" {
 		if utils.IsValidURL(customGLHost) {
 			var protocol string
@@ -71,16 +99,10 @@ func NewFactory(io *iostreams.IOStreams, resolveRepos bool, cfg config.Config, b
 		f.defaultHostname = customGLHost
 	}
 
-	baseRepo, err := f.BaseRepo()
+	remotes, err := f.readRemotes(f.defaultHostname)
 	if err == nil {
-		f.defaultHostname = baseRepo.RepoHost()
+		f.defaultHostname = remotes[0].RepoHost()
 	}
-
-	return f
-}
-
-func (f *DefaultFactory) DefaultHostname() string {
-	return f.defaultHostname
 }
 
 func (f *DefaultFactory) RepoOverride(repo string) error {
@@ -88,19 +110,20 @@ func (f *DefaultFactory) RepoOverride(repo string) error {
 		return nil
 	}
 
-	f.mu.Lock()
-	defer f.mu.Unlock()
-	baseRepo, err := glrepo.FromFullName(repo, f.defaultHostname)
+	baseRepo, err := glrepo.FromFullName(repo, f.DefaultHostname())
 	if err != nil {
 		return err // return the error if repo was overridden.
 	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
 	f.cachedBaseRepo = baseRepo
 	return nil
 }
 
 func (f *DefaultFactory) ApiClient(repoHost string) (*api.Client, error) {
 	if repoHost == "" {
-		repoHost = f.defaultHostname
+		repoHost = f.DefaultHostname()
 	}
 	c, err := api.NewClientFromConfig(repoHost, f.config, false, f.buildInfo.UserAgent())
 	if err != nil {
@@ -127,15 +150,25 @@ func (f *DefaultFactory) GitLabClient() (*gitlab.Client, error) {
 	case nil:
 		repoHost = repo.RepoHost()
 	default:
-		repoHost = f.defaultHostname
+		repoHost = f.DefaultHostname()
 		dbg.Debug("The current command request Factory.GitLabClient() without being able to resolve a base repository. The command should probably use Factory.ApiClient() instead")
 	}
 
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if c, ok := f.gitlabClients[repoHost]; ok {
+		return c, nil
+	}
+
 	c, err := api.NewClientFromConfig(repoHost, f.config, false, f.buildInfo.UserAgent())
 	if err != nil {
 		return nil, err
 	}
 
+	if f.gitlabClients == nil {
+		f.gitlabClients = map[string]*gitlab.Client{}
+	}
+	f.gitlabClients[repoHost] = c.Lab()
 	return c.Lab(), nil
 }
 
@@ -148,16 +181,76 @@ func (f *DefaultFactory) BaseRepo() (glrepo.Interface, error) {
 		return cachedBaseRepo, nil
 	}
 
-	baseRepo, err := f.resolveBaseRepoFromRemotes()
+	baseRepo, err := f.mappedBaseRepo()
 	if err != nil {
 		return nil, err
 	}
+	if baseRepo == nil {
+		baseRepo, err = f.resolveBaseRepoFromRemotes()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	// cache base repo
 	f.cachedBaseRepo = baseRepo
 	return f.cachedBaseRepo, nil
 }
 
+// mappedBaseRepo returns the project that the monorepo_projects setting maps
+// the working directory to, or nil when no rule matches.
+func (f *DefaultFactory) mappedBaseRepo() (glrepo.Interface, error) {
+	if setting, _ := f.config.Get("", "monorepo_projects"); setting == "" {
+		return nil, nil
+	}
+
+	top, err := git.ToplevelDir()
+	if err != nil || top == "" {
+		return nil, nil
+	}
+	dir, err := os.Getwd()
+	if err != nil {
+		return nil, nil
+	}
+	rel, err := relativeDir(top, dir)
+	if err != nil {
+		return nil, nil
+	}
+
+	project, ok := glrepo.MappedProject(f.config, rel)
+	if !ok {
+		return nil, nil
+	}
+
+	host := f.DefaultHostname()
+	if remotes, err := f.Remotes(); err == nil && len(remotes) > 0 {
+		host = remotes[0].RepoHost()
+	}
+	baseRepo, err := glrepo.FromFullName(project, host)
+	if err != nil {
+		return nil, fmt.Errorf("invalid project %q in the monorepo_projects setting: %w", project, err)
+	}
+	return baseRepo, nil
+}
+
+// relativeDir returns dir relative to top, with forward slashes. Symbolic links
+// are resolved first, because git reports the real path of the top-level directory.
+func relativeDir(top, dir string) (string, error) {
+	top, err := filepath.EvalSymlinks(top)
+	if err != nil {
+		return "", err
+	}
+	dir, err = filepath.EvalSymlinks(dir)
+	if err != nil {
+		return "", err
+	}
+	rel, err := filepath.Rel(top, dir)
+	if err != nil {
+		return "", err
+	}
+	return filepath.ToSlash(rel), nil
+}
+
 func (f *DefaultFactory) resolveBaseRepoFromRemotes() (glrepo.Interface, error) {
 	remotes, err := f.Remotes()
 	if err != nil {
@@ -173,7 +266,7 @@ func (f *DefaultFactory) resolveBaseRepoFromRemotes() (glrepo.Interface, error)
 		return nil, err
 	}
 
-	repoContext, err := glrepo.ResolveRemotesToRepos(remotes, ac.Lab(), f.defaultHostname)
+	repoContext, err := glrepo.ResolveRemotesToRepos(remotes, ac.Lab(), f.DefaultHostname())
 	if err != nil {
 		return nil, err
 	}
@@ -187,14 +280,23 @@ func (f *DefaultFactory) resolveBaseRepoFromRemotes() (glrepo.Interface, error)
 }
 
 func (f *DefaultFactory) Remotes() (glrepo.Remotes, error) {
+	defaultHostname := f.DefaultHostname()
+	f.remotesOnce.Do(func() {
+		f.remotes, f.remotesErr = f.readRemotes(defaultHostname)
+	})
+	return f.remotes, f.remotesErr
+}
+
+// readRemotes reads the git remotes of the repository in the working directory.
+func (f *DefaultFactory) readRemotes(defaultHostname string) (glrepo.Remotes, error) {
 	hostOverride := ""
-	if !strings.EqualFold(glinstance.DefaultHostname, f.defaultHostname) {
-		hostOverride = f.defaultHostname
+	if !strings.EqualFold(glinstance.DefaultHostname, defaultHostname) {
+		hostOverride = defaultHostname
 	}
 	rr := &remoteResolver{
 		readRemotes:     git.Remotes,
 		getConfig:       f.Config,
-		defaultHostname: f.defaultHostname,
+		defaultHostname: defaultHostname,
 	}
 	fn := rr.Resolver(hostOverride)
 	return fn()
@@ -205,13 +307,31 @@ func (f *DefaultFactory) Config() config.Config {
 }
 
 func (f *DefaultFactory) Branch() (string, error) {
+	f.mu.Lock()
+	branchOverride := f.branchOverride
+	f.mu.Unlock()
+	if branchOverride != "" {
+		return branchOverride, nil
+	}
+
 	currentBranch, err := git.CurrentBranch()
+	if errors.Is(err, git.ErrNotOnAnyBranch) {
+		// CI/CD jobs and tag checkouts have a detached HEAD. The merge request of
+		// the checked-out commit is looked up by the commands that need it.
+		currentBranch, err = git.DetachedHeadBranch()
+	}
 	if err != nil {
 		return "", fmt.Errorf("could not determine current branch: %w", err)
 	}
 	return currentBranch, nil
 }
 
+func (f *DefaultFactory) BranchOverride(branch string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.branchOverride = branch
+}
+
 func (f *DefaultFactory) IO() *iostreams.IOStreams {
 	return f.io
 }
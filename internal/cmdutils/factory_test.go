@@ -4,14 +4,20 @@ package cmdutils
 
 import (
 	"net/url"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
 
 	"gitlab.com/gitlab-org/cli/internal/api"
 	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/git"
+	"gitlab.com/gitlab-org/cli/test"
 )
 
 func TestFactory_ResolveHostNameFromConfig(t *testing.T) {
@@ -24,7 +30,7 @@ func TestFactory_ResolveHostNameFromConfig(t *testing.T) {
 	f := NewFactory(nil, false, cfg, api.BuildInfo{})
 
 	// THEN
-	assert.Equal(t, "gitlab.example.com", f.defaultHostname)
+	assert.Equal(t, "gitlab.example.com", f.DefaultHostname())
 }
 
 func TestFactory_ResolveHostNameFromEnv(t *testing.T) {
@@ -38,7 +44,7 @@ func TestFactory_ResolveHostNameFromEnv(t *testing.T) {
 	f := NewFactory(nil, false, cfg, api.BuildInfo{})
 
 	// THEN
-	assert.Equal(t, "gitlab.example.com", f.defaultHostname)
+	assert.Equal(t, "gitlab.example.com", f.DefaultHostname())
 }
 
 func TestFactory_ResolveToGitLabComByDefault(t *testing.T) {
@@ -49,7 +55,39 @@ func TestFactory_ResolveToGitLabComByDefault(t *testing.T) {
 	f := NewFactory(nil, false, cfg, api.BuildInfo{})
 
 	// THEN
-	assert.Equal(t, "gitlab.com", f.defaultHostname)
+	assert.Equal(t, "gitlab.com", f.DefaultHostname())
+}
+
+func TestFactory_ResolvesLazily(t *testing.T) {
+	// GIVEN
+	cfg := config.NewFromString(heredoc.Doc(`
+		host: gitlab.example.com
+	`))
+
+	// WHEN
+	f := NewFactory(nil, false, cfg, api.BuildInfo{})
+
+	// THEN
+	assert.Empty(t, f.defaultHostname)
+	assert.Nil(t, f.cachedBaseRepo)
+	assert.Equal(t, "gitlab.example.com", f.DefaultHostname())
+}
+
+func TestFactory_GitLabClientIsReused(t *testing.T) {
+	// GIVEN
+	cfg := config.NewFromString(heredoc.Doc(`
+		host: gitlab.example.com
+	`))
+	f := NewFactory(nil, false, cfg, api.BuildInfo{})
+
+	// WHEN
+	c1, err := f.GitLabClient()
+	require.NoError(t, err)
+	c2, err := f.GitLabClient()
+	require.NoError(t, err)
+
+	// THEN
+	assert.Same(t, c1, c2)
 }
 
 func TestFactory_GitLabClientUsesCorrectHost(t *testing.T) {
@@ -110,3 +148,100 @@ func mustURL(t *testing.T, s string) *url.URL {
 
 	return u
 }
+
+func TestFactory_BaseRepoFromMonorepoProjects(t *testing.T) {
+	// GIVEN
+	top := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(top, "services", "auth", "api"), 0o755))
+	t.Chdir(filepath.Join(top, "services", "auth", "api"))
+	oldToplevelDir := git.ToplevelDir
+	git.ToplevelDir = func() (string, error) { return top, nil }
+	t.Cleanup(func() { git.ToplevelDir = oldToplevelDir })
+
+	cfg := config.NewFromString(heredoc.Doc(`
+		host: gitlab.example.com
+		monorepo_projects: services/auth=group/auth-service
+	`))
+
+	// WHEN
+	f := NewFactory(nil, false, cfg, api.BuildInfo{})
+	repo, err := f.BaseRepo()
+
+	// THEN
+	require.NoError(t, err)
+	assert.Equal(t, "group/auth-service", repo.FullName())
+	assert.Equal(t, "gitlab.example.com", repo.RepoHost())
+}
+
+func TestFactory_BranchOverride(t *testing.T) {
+	// GIVEN
+	f := NewFactory(nil, false, config.NewBlankConfig(), api.BuildInfo{})
+	cs, teardown := test.InitCmdStubber()
+	defer teardown()
+
+	// WHEN
+	f.BranchOverride("feature")
+	branch, err := f.Branch()
+
+	// THEN
+	require.NoError(t, err)
+	assert.Equal(t, "feature", branch)
+	assert.Empty(t, cs.Calls)
+}
+
+func TestFactory_BranchOfDetachedHead(t *testing.T) {
+	tests := []struct {
+		name       string
+		env        map[string]string
+		localRefs  string
+		wantBranch string
+		wantErr    error
+	}{
+		{
+			name:       "CI/CD branch",
+			env:        map[string]string{"CI_COMMIT_BRANCH": "main"},
+			wantBranch: "main",
+		},
+		{
+			name:       "local branch that points to the commit",
+			localRefs:  "feature\n",
+			wantBranch: "feature",
+		},
+		{
+			name:    "tag checkout",
+			env:     map[string]string{"CI_COMMIT_TAG": "v1.2.0", "CI_COMMIT_REF_NAME": "v1.2.0"},
+			wantErr: git.ErrNotOnAnyBranch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// GIVEN
+			for _, k := range []string{"CI_COMMIT_BRANCH", "CI_COMMIT_TAG", "CI_COMMIT_REF_NAME"} {
+				t.Setenv(k, tt.env[k])
+			}
+			f := NewFactory(nil, false, config.NewBlankConfig(), api.BuildInfo{})
+			require.NoError(t, f.RepoOverride("OWNER/REPO"))
+
+			// The factory has no API client, so a request would fail the test.
+			tc := gitlabtesting.NewTestClient(t)
+			f.gitlabClients = map[string]*gitlab.Client{"gitlab.com": tc.Client}
+
+			cs, teardown := test.InitCmdStubber()
+			defer teardown()
+			cs.StubError("")      // git symbolic-ref
+			cs.Stub(tt.localRefs) // git for-each-ref
+
+			// WHEN
+			branch, err := f.Branch()
+
+			// THEN
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantBranch, branch)
+		})
+	}
+}
@@ -1,21 +1,18 @@
 package cmdutils
 
 import (
+	"os"
+
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
-func GroupOverride(cmd *cobra.Command) (string, error) {
-	// Bind cobra command persistent flags to env user viper
-	viper.SetEnvPrefix("GITLAB")
-	err := viper.BindEnv("group")
-	if err != nil {
-		return "", err
-	}
-
-	// Get group from env
-	groupFromEnv := viper.GetString("group")
+// groupEnv is the environment variable with the default group of group-level commands.
+const groupEnv = "GITLAB_GROUP"
 
+// GroupOverride returns the group selected by the --group flag, or else by the
+// GITLAB_GROUP environment variable. It returns no group if the --repo flag is set.
+// It only reads the flags of cmd, so that commands can run concurrently.
+func GroupOverride(cmd *cobra.Command) (string, error) {
 	// Get group/repo flags
 	group, err := cmd.Flags().GetString("group")
 	if err != nil {
@@ -36,6 +33,6 @@ func GroupOverride(cmd *cobra.Command) (string, error) {
 		return group, nil
 	default:
 		// Consider environment if no repo or group flags are set
-		return groupFromEnv, nil
+		return os.Getenv(groupEnv), nil
 	}
 }
@@ -38,6 +38,8 @@ func (f *dummyFactory) RepoOverride(repo string) error {
 	return nil
 }
 
+func (f *dummyFactory) BranchOverride(branch string) {}
+
 func (f *dummyFactory) ApiClient(repoHost string) (*api.Client, error) {
 	return nil, nil
 }
@@ -0,0 +1,80 @@
+package cmdutils
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// ListMeta is the pagination and rate limit state of a list response, printed
+// by list commands with --include-meta.
+type ListMeta struct {
+	// TotalItems and TotalPages are nil when GitLab doesn't count the items,
+	// like for lists of more than 10,000 items.
+	TotalItems  *int64 `json:"total_items"`
+	TotalPages  *int64 `json:"total_pages"`
+	CurrentPage int64  `json:"current_page"`
+	PerPage     int64  `json:"per_page"`
+	// NextPage is nil on the last page.
+	NextPage  *int64     `json:"next_page"`
+	RateLimit *RateLimit `json:"rate_limit,omitempty"`
+}
+
+// RateLimit is the rate limit state sent by GitLab in the RateLimit-* headers.
+type RateLimit struct {
+	Limit     int64      `json:"limit"`
+	Remaining int64      `json:"remaining"`
+	ResetAt   *time.Time `json:"reset_at,omitempty"`
+}
+
+// ListEnvelope wraps the JSON output of list commands with --include-meta.
+type ListEnvelope struct {
+	Data any      `json:"data"`
+	Meta ListMeta `json:"meta"`
+}
+
+// NewListMeta returns the pagination and rate limit state of resp.
+func NewListMeta(resp *gitlab.Response) ListMeta {
+	var meta ListMeta
+	if resp == nil {
+		return meta
+	}
+
+	meta.CurrentPage = resp.CurrentPage
+	meta.PerPage = resp.ItemsPerPage
+	if resp.NextPage != 0 {
+		meta.NextPage = gitlab.Ptr(resp.NextPage)
+	}
+	if resp.Response == nil {
+		return meta
+	}
+
+	if resp.Header.Get("X-Total") != "" {
+		meta.TotalItems = gitlab.Ptr(resp.TotalItems)
+	}
+	if resp.Header.Get("X-Total-Pages") != "" {
+		meta.TotalPages = gitlab.Ptr(resp.TotalPages)
+	}
+
+	limit, err := strconv.ParseInt(resp.Header.Get("RateLimit-Limit"), 10, 64)
+	if err != nil {
+		return meta
+	}
+	meta.RateLimit = &RateLimit{Limit: limit}
+	meta.RateLimit.Remaining, _ = strconv.ParseInt(resp.Header.Get("RateLimit-Remaining"), 10, 64)
+	if reset, err := strconv.ParseInt(resp.Header.Get("RateLimit-Reset"), 10, 64); err == nil {
+		meta.RateLimit.ResetAt = gitlab.Ptr(time.Unix(reset, 0).UTC())
+	}
+	return meta
+}
+
+// ListJSON returns the JSON output of a list command: the items, or with
+// includeMeta, the items wrapped in a ListEnvelope with the state of resp.
+func ListJSON(items any, resp *gitlab.Response, includeMeta bool) ([]byte, error) {
+	if !includeMeta {
+		return json.Marshal(items)
+	}
+	return json.Marshal(ListEnvelope{Data: items, Meta: NewListMeta(resp)})
+}
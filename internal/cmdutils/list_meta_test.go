@@ -0,0 +1,78 @@
+//go:build !integration
+
+package cmdutils
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestNewListMeta(t *testing.T) {
+	t.Run("all headers", func(t *testing.T) {
+		header := http.Header{}
+		header.Set("X-Total", "45")
+		header.Set("X-Total-Pages", "3")
+		header.Set("RateLimit-Limit", "2000")
+		header.Set("RateLimit-Remaining", "1990")
+		header.Set("RateLimit-Reset", "1760000000")
+		resp := &gitlab.Response{
+			Response:     &http.Response{Header: header},
+			TotalItems:   45,
+			TotalPages:   3,
+			CurrentPage:  2,
+			ItemsPerPage: 20,
+			NextPage:     3,
+		}
+
+		meta := NewListMeta(resp)
+
+		assert.Equal(t, ListMeta{
+			TotalItems:  gitlab.Ptr(int64(45)),
+			TotalPages:  gitlab.Ptr(int64(3)),
+			CurrentPage: 2,
+			PerPage:     20,
+			NextPage:    gitlab.Ptr(int64(3)),
+			RateLimit: &RateLimit{
+				Limit:     2000,
+				Remaining: 1990,
+				ResetAt:   gitlab.Ptr(time.Unix(1760000000, 0).UTC()),
+			},
+		}, meta)
+	})
+
+	t.Run("uncounted last page without rate limit", func(t *testing.T) {
+		resp := &gitlab.Response{
+			Response:     &http.Response{Header: http.Header{}},
+			CurrentPage:  4,
+			ItemsPerPage: 100,
+		}
+
+		assert.Equal(t, ListMeta{CurrentPage: 4, PerPage: 100}, NewListMeta(resp))
+	})
+
+	t.Run("no response", func(t *testing.T) {
+		assert.Equal(t, ListMeta{}, NewListMeta(nil))
+	})
+}
+
+func TestListJSON(t *testing.T) {
+	items := []string{"a", "b"}
+	resp := &gitlab.Response{CurrentPage: 1, ItemsPerPage: 2, NextPage: 2}
+
+	out, err := ListJSON(items, resp, false)
+	require.NoError(t, err)
+	assert.JSONEq(t, `["a","b"]`, string(out))
+
+	out, err = ListJSON(items, resp, true)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"data": ["a","b"],
+		"meta": {"total_items": null, "total_pages": null, "current_page": 1, "per_page": 2, "next_page": 2}
+	}`, string(out))
+}
@@ -0,0 +1,116 @@
+package cmdutils
+
+import (
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+)
+
+var (
+	headingRE = regexp.MustCompile(`^ {0,3}#{1,6}[ \t]+(.*?)(?:[ \t]+#+)?[ \t]*$`)
+	fenceRE   = regexp.MustCompile("^ {0,3}(```|~~~)")
+)
+
+// ListProjectTemplates lists the description templates of a project, from the
+// .gitlab directory of its repository on ref, with the repository files API. Unlike
+// ListGitLabTemplates, it works for any project, including with the -R flag.
+func ListProjectTemplates(client *gitlab.Client, repo, tmplType, ref string) ([]string, error) {
+	tree, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.TreeNode, *gitlab.Response, error) {
+		return client.Repositories.ListTree(repo, &gitlab.ListTreeOptions{
+			ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage},
+			Path:        gitlab.Ptr(path.Join(".gitlab", tmplType)),
+			Ref:         gitlab.Ptr(ref),
+		}, p)
+	})
+	if api.Is404(err) {
+		return []string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	files := []string{}
+	for _, node := range tree {
+		if node.Type != "blob" || strings.HasPrefix(node.Name, ".") || !strings.HasSuffix(node.Name, ".md") {
+			continue
+		}
+		files = append(files, strings.TrimSuffix(node.Name, ".md"))
+	}
+	slices.Sort(files)
+	return files, nil
+}
+
+// LoadProjectTemplate loads a description template of a project from its
+// repository on ref. Like LoadGitLabTemplate, it returns an empty string if the
+// template doesn't exist.
+func LoadProjectTemplate(client *gitlab.Client, repo, tmplType, tmplName, ref string) (string, error) {
+	if !strings.HasSuffix(tmplName, ".md") {
+		tmplName = tmplName + ".md"
+	}
+
+	content, _, err := client.RepositoryFiles.GetRawFile(repo, path.Join(".gitlab", tmplType, tmplName), &gitlab.GetRawFileOptions{Ref: gitlab.Ptr(ref)})
+	if api.Is404(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(content)), nil
+}
+
+// MarkdownHeadings returns the text of the ATX headings of a Markdown document,
+// like "Steps to reproduce" for "## Steps to reproduce". Headings in code blocks
+// are ignored.
+func MarkdownHeadings(content string) []string {
+	var headings []string
+	fence := ""
+	for line := range strings.SplitSeq(content, "\n") {
+		if m := fenceRE.FindStringSubmatch(line); m != nil {
+			switch fence {
+			case "":
+				fence = m[1]
+			case m[1]:
+				fence = ""
+			}
+			continue
+		}
+		if fence != "" {
+			continue
+		}
+		if m := headingRE.FindStringSubmatch(line); m != nil && m[1] != "" {
+			headings = append(headings, m[1])
+		}
+	}
+	return headings
+}
+
+// MissingSections returns the headings of a template that a description doesn't
+// have anymore, among the required ones. The special value "all" requires every
+// heading of the template. Headings are compared case-insensitively.
+func MissingSections(template, description string, required []string) []string {
+	if len(required) == 0 {
+		return nil
+	}
+	all := slices.Contains(required, "all")
+
+	present := map[string]bool{}
+	for _, heading := range MarkdownHeadings(description) {
+		present[strings.ToLower(heading)] = true
+	}
+
+	var missing []string
+	for _, heading := range MarkdownHeadings(template) {
+		if !all && !slices.ContainsFunc(required, func(r string) bool { return strings.EqualFold(strings.TrimSpace(r), heading) }) {
+			continue
+		}
+		if !present[strings.ToLower(heading)] && !slices.Contains(missing, heading) {
+			missing = append(missing, heading)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,78 @@
+//go:build !integration
+
+package cmdutils
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+)
+
+func TestListProjectTemplates(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockRepositories.EXPECT().ListTree("OWNER/REPO", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(pid any, opts *gitlab.ListTreeOptions, _ ...gitlab.RequestOptionFunc) ([]*gitlab.TreeNode, *gitlab.Response, error) {
+			assert.Equal(t, ".gitlab/issue_templates", *opts.Path)
+			assert.Equal(t, "main", *opts.Ref)
+			return []*gitlab.TreeNode{
+				{Name: "Feature.md", Type: "blob"},
+				{Name: "Bug.md", Type: "blob"},
+				{Name: ".hidden.md", Type: "blob"},
+				{Name: "README.txt", Type: "blob"},
+				{Name: "old.md", Type: "tree"},
+			}, &gitlab.Response{}, nil
+		})
+
+	names, err := ListProjectTemplates(testClient.Client, "OWNER/REPO", IssueTemplate, "main")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Bug", "Feature"}, names)
+}
+
+func TestListProjectTemplates_NoDirectory(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockRepositories.EXPECT().ListTree("OWNER/REPO", gomock.Any(), gomock.Any()).
+		Return(nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, &gitlab.ErrorResponse{Response: &http.Response{StatusCode: http.StatusNotFound}})
+
+	names, err := ListProjectTemplates(testClient.Client, "OWNER/REPO", IssueTemplate, "main")
+	require.NoError(t, err)
+	assert.Empty(t, names)
+}
+
+func TestLoadProjectTemplate(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockRepositoryFiles.EXPECT().
+		GetRawFile("OWNER/REPO", ".gitlab/issue_templates/Bug.md", &gitlab.GetRawFileOptions{Ref: gitlab.Ptr("main")}).
+		Return([]byte("\n## Summary\n\n"), nil, nil)
+	testClient.MockRepositoryFiles.EXPECT().
+		GetRawFile("OWNER/REPO", ".gitlab/issue_templates/Missing.md", gomock.Any()).
+		Return(nil, nil, &gitlab.ErrorResponse{Response: &http.Response{StatusCode: http.StatusNotFound}})
+
+	content, err := LoadProjectTemplate(testClient.Client, "OWNER/REPO", IssueTemplate, "Bug", "main")
+	require.NoError(t, err)
+	assert.Equal(t, "## Summary", content)
+
+	content, err = LoadProjectTemplate(testClient.Client, "OWNER/REPO", IssueTemplate, "Missing", "main")
+	require.NoError(t, err)
+	assert.Empty(t, content)
+}
+
+func TestMarkdownHeadings(t *testing.T) {
+	content := "# Title #\nText\n  ## Steps to reproduce\n```\n## Not a heading\n```\n####### Too deep\n#NoSpace\n### Expected behavior  \n"
+	assert.Equal(t, []string{"Title", "Steps to reproduce", "Expected behavior"}, MarkdownHeadings(content))
+}
+
+func TestMissingSections(t *testing.T) {
+	template := "## Summary\n\n## Steps to reproduce\n\n## Expected behavior\n"
+	description := "## summary\n\nIt's broken.\n\n## Expected behavior\n\nIt works.\n"
+
+	assert.Nil(t, MissingSections(template, description, nil))
+	assert.Equal(t, []string{"Steps to reproduce"}, MissingSections(template, description, []string{"all"}))
+	assert.Equal(t, []string{"Steps to reproduce"}, MissingSections(template, description, []string{"Summary", " steps to reproduce"}))
+	assert.Empty(t, MissingSections(template, description, []string{"Summary", "Not in the template"}))
+}
@@ -0,0 +1,25 @@
+package cmdutils
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// EnableRefOverride adds the --ref flag to cmd and its children, to use a branch instead of the
+// current branch, for example on the detached HEAD of a CI/CD job.
+func EnableRefOverride(cmd *cobra.Command, f Factory) {
+	cmd.PersistentFlags().String("ref", "", "Use this branch instead of the current branch, for example on a detached HEAD.")
+
+	originalPreRunE := cmd.PersistentPreRunE
+	cmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		if originalPreRunE != nil {
+			if err := originalPreRunE(cmd, args); err != nil {
+				return err
+			}
+		}
+
+		if ref, _ := cmd.Flags().GetString("ref"); ref != "" {
+			f.BranchOverride(ref)
+		}
+		return nil
+	}
+}
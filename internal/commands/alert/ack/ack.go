@@ -0,0 +1,67 @@
+package ack
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/alert/alertutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	alerts []string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdAck(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	alertAckCmd := &cobra.Command{
+		Use:     "ack <iid>... [flags]",
+		Aliases: []string{"acknowledge"},
+		Short:   `Acknowledge one or more alerts.`,
+		Args:    cobra.MinimumNArgs(1),
+		Example: heredoc.Doc(`
+			# Acknowledge alert 42
+			$ glab alert ack 42
+
+			# Acknowledge several alerts at once
+			$ glab alert ack 42 43 44
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.alerts = args
+			return opts.run()
+		},
+	}
+
+	return alertAckCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	return alertutils.UpdateStatus(o.io, client, repo.FullName(), o.alerts, api.AlertStatusAcknowledged, "Acknowledged")
+}
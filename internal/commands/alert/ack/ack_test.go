@@ -0,0 +1,102 @@
+//go:build !integration
+
+package ack
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func expectStatusUpdate(t *testing.T, tc *gitlabtesting.TestClient, iid, body string) {
+	tc.MockGraphQL.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(query gitlab.GraphQLQuery, response any, _ ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+			assert.Equal(t, iid, query.Variables["iid"])
+			assert.Equal(t, "ACKNOWLEDGED", query.Variables["status"])
+			return nil, json.Unmarshal([]byte(body), response)
+		})
+}
+
+func Test_AlertAck(t *testing.T) {
+	type testCase struct {
+		name        string
+		cli         string
+		expectedMsg []string
+		wantErr     bool
+		wantStderr  string
+		setupMock   func(t *testing.T, tc *gitlabtesting.TestClient)
+	}
+
+	testCases := []testCase{
+		{
+			name:        "Ack one alert",
+			cli:         "2",
+			expectedMsg: []string{"Acknowledged alert #2: High error rate"},
+			setupMock: func(t *testing.T, tc *gitlabtesting.TestClient) {
+				expectStatusUpdate(t, tc, "2", `{"data": {"updateAlertStatus": {"alert": {"iid": "2", "title": "High error rate", "status": "ACKNOWLEDGED"}, "errors": []}}}`)
+			},
+		},
+		{
+			name:        "Ack several alerts",
+			cli:         "2 '#3'",
+			expectedMsg: []string{"Acknowledged alert #2: High error rate", "Acknowledged alert #3: Disk almost full"},
+			setupMock: func(t *testing.T, tc *gitlabtesting.TestClient) {
+				expectStatusUpdate(t, tc, "2", `{"data": {"updateAlertStatus": {"alert": {"iid": "2", "title": "High error rate", "status": "ACKNOWLEDGED"}, "errors": []}}}`)
+				expectStatusUpdate(t, tc, "3", `{"data": {"updateAlertStatus": {"alert": {"iid": "3", "title": "Disk almost full", "status": "ACKNOWLEDGED"}, "errors": []}}}`)
+			},
+		},
+		{
+			name:       "Alert not found",
+			cli:        "5",
+			wantErr:    true,
+			wantStderr: "failed to update alert #5: alert 5 not found.",
+			setupMock: func(t *testing.T, tc *gitlabtesting.TestClient) {
+				expectStatusUpdate(t, tc, "5", `{"data": {"updateAlertStatus": {"alert": null, "errors": []}}}`)
+			},
+		},
+		{
+			name:       "Invalid alert IID",
+			cli:        "abc",
+			wantErr:    true,
+			wantStderr: "invalid alert IID: \"abc\"",
+			setupMock:  func(t *testing.T, tc *gitlabtesting.TestClient) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// GIVEN
+			testClient := gitlabtesting.NewTestClient(t)
+			tc.setupMock(t, testClient)
+			exec := cmdtest.SetupCmdForTest(
+				t,
+				NewCmdAck,
+				false,
+				cmdtest.WithGitLabClient(testClient.Client),
+			)
+
+			// WHEN
+			out, err := exec(tc.cli)
+
+			// THEN
+			if tc.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantStderr)
+				return
+			}
+			require.NoError(t, err)
+			for _, msg := range tc.expectedMsg {
+				assert.Contains(t, out.String(), msg)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package alert
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	alertAckCmd "gitlab.com/gitlab-org/cli/internal/commands/alert/ack"
+	alertIncidentCmd "gitlab.com/gitlab-org/cli/internal/commands/alert/incident"
+	alertListCmd "gitlab.com/gitlab-org/cli/internal/commands/alert/list"
+	alertResolveCmd "gitlab.com/gitlab-org/cli/internal/commands/alert/resolve"
+)
+
+func NewCmdAlert(f cmdutils.Factory) *cobra.Command {
+	alertCmd := &cobra.Command{
+		Use:   "alert <command> [flags]",
+		Short: `Manage alerts of a project.`,
+		Long: heredoc.Doc(`
+		Alerts are created by integrations like Prometheus or HTTP endpoints
+		when a monitored service misbehaves. Acknowledge and resolve alerts,
+		or turn them into incidents to track the response with 'glab incident'.
+		`),
+	}
+
+	cmdutils.EnableRepoOverride(alertCmd, f)
+
+	alertCmd.AddCommand(alertListCmd.NewCmdList(f))
+	alertCmd.AddCommand(alertAckCmd.NewCmdAck(f))
+	alertCmd.AddCommand(alertResolveCmd.NewCmdResolve(f))
+	alertCmd.AddCommand(alertIncidentCmd.NewCmdIncident(f))
+	return alertCmd
+}
@@ -0,0 +1,63 @@
+package alertutils
+
+import (
+	"fmt"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+)
+
+// ParseIID returns the IID of an alert given as 123 or #123.
+func ParseIID(arg string) (string, error) {
+	iid := strings.TrimPrefix(arg, "#")
+	for _, r := range iid {
+		if r < '0' || r > '9' {
+			return "", fmt.Errorf("invalid alert IID: %q", arg)
+		}
+	}
+	if iid == "" {
+		return "", fmt.Errorf("invalid alert IID: %q", arg)
+	}
+
+	return iid, nil
+}
+
+// SeverityLabel returns the severity of an alert in lowercase, colored by urgency.
+func SeverityLabel(c *iostreams.ColorPalette, severity string) string {
+	label := strings.ToLower(severity)
+	switch severity {
+	case "CRITICAL", "HIGH":
+		return c.Red(label)
+	case "MEDIUM":
+		return c.Yellow(label)
+	default:
+		return c.Gray(label)
+	}
+}
+
+// UpdateStatus sets the status of each alert and prints the result. verb is used
+// in the confirmation message, for example "Acknowledged".
+func UpdateStatus(io *iostreams.IOStreams, client *gitlab.Client, projectPath string, args []string, status, verb string) error {
+	iids := make([]string, 0, len(args))
+	for _, arg := range args {
+		iid, err := ParseIID(arg)
+		if err != nil {
+			return err
+		}
+		iids = append(iids, iid)
+	}
+
+	c := io.Color()
+	for _, iid := range iids {
+		alert, err := api.UpdateAlertStatus(client, projectPath, iid, status)
+		if err != nil {
+			return fmt.Errorf("failed to update alert #%s: %w", iid, err)
+		}
+		fmt.Fprintf(io.StdOut, "%s %s alert #%s: %s\n", c.GreenCheck(), verb, alert.IID, alert.Title)
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+//go:build !integration
+
+package alertutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIID(t *testing.T) {
+	tests := []struct {
+		arg     string
+		want    string
+		wantErr bool
+	}{
+		{arg: "42", want: "42"},
+		{arg: "#42", want: "42"},
+		{arg: "", wantErr: true},
+		{arg: "#", wantErr: true},
+		{arg: "4a", wantErr: true},
+		{arg: "-1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.arg, func(t *testing.T) {
+			got, err := ParseIID(tt.arg)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package incident
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/alert/alertutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	alert string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdIncident(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	alertIncidentCmd := &cobra.Command{
+		Use:   "incident <iid> [flags]",
+		Short: `Create an incident from an alert.`,
+		Long: heredoc.Doc(`
+		Create an incident from an alert. The incident is linked to the alert,
+		and resolving the incident resolves the alert.
+		`),
+		Args: cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			# Create an incident from alert 42
+			$ glab alert incident 42
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.alert = args[0]
+			return opts.run()
+		},
+	}
+
+	return alertIncidentCmd
+}
+
+func (o *options) run() error {
+	iid, err := alertutils.ParseIID(o.alert)
+	if err != nil {
+		return err
+	}
+
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	incident, err := api.CreateAlertIncident(client, repo.FullName(), iid)
+	if err != nil {
+		return err
+	}
+
+	c := o.io.Color()
+	fmt.Fprintf(o.io.StdOut, "%s Created incident #%s from alert #%s.\n", c.GreenCheck(), incident.IID, iid)
+	fmt.Fprintln(o.io.StdOut, incident.WebURL)
+
+	return nil
+}
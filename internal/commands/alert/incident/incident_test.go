@@ -0,0 +1,80 @@
+//go:build !integration
+
+package incident
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_AlertIncident(t *testing.T) {
+	type testCase struct {
+		name        string
+		cli         string
+		response    string
+		expectedMsg []string
+		wantErr     bool
+		wantStderr  string
+	}
+
+	testCases := []testCase{
+		{
+			name:     "Create an incident from an alert",
+			cli:      "2",
+			response: `{"data": {"createAlertIssue": {"issue": {"iid": "9", "webUrl": "https://gitlab.com/OWNER/REPO/-/issues/9"}, "errors": []}}}`,
+			expectedMsg: []string{
+				"Created incident #9 from alert #2.",
+				"https://gitlab.com/OWNER/REPO/-/issues/9",
+			},
+		},
+		{
+			name:       "Alert that already has an incident",
+			cli:        "'#2'",
+			response:   `{"data": {"createAlertIssue": {"issue": null, "errors": ["An issue already exists"]}}}`,
+			wantErr:    true,
+			wantStderr: "An issue already exists",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// GIVEN
+			testClient := gitlabtesting.NewTestClient(t)
+			testClient.MockGraphQL.EXPECT().
+				Do(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(query gitlab.GraphQLQuery, response any, _ ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+					assert.Equal(t, "2", query.Variables["iid"])
+					return nil, json.Unmarshal([]byte(tc.response), response)
+				})
+			exec := cmdtest.SetupCmdForTest(
+				t,
+				NewCmdIncident,
+				false,
+				cmdtest.WithGitLabClient(testClient.Client),
+			)
+
+			// WHEN
+			out, err := exec(tc.cli)
+
+			// THEN
+			if tc.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantStderr)
+				return
+			}
+			require.NoError(t, err)
+			for _, msg := range tc.expectedMsg {
+				assert.Contains(t, out.String(), msg)
+			}
+		})
+	}
+}
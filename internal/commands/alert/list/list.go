@@ -0,0 +1,139 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/alert/alertutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	status       string
+	search       string
+	perPage      int
+	outputFormat string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdList(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	alertListCmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   `List alerts of a project.`,
+		Long:    "List alerts of a project. By default, only firing alerts are listed: alerts that are triggered or acknowledged.",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Example: heredoc.Doc(`
+			# List the firing alerts of the current project
+			$ glab alert list
+
+			# List resolved alerts that mention "disk"
+			$ glab alert list --status resolved --search disk
+
+			# List all alerts as JSON
+			$ glab alert list --status all -F json
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+
+	fl := alertListCmd.Flags()
+	fl.VarP(cmdutils.NewEnumValue([]string{"firing", "triggered", "acknowledged", "resolved", "ignored", "all"}, "firing", &opts.status), "status", "s", "Filter alerts by status: firing, triggered, acknowledged, resolved, ignored, all.")
+	fl.StringVar(&opts.search, "search", "", "Search alerts by title, description, service, or monitoring tool.")
+	fl.IntVarP(&opts.perPage, "per-page", "P", 30, "Number of alerts to list.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+
+	return alertListCmd
+}
+
+func (o *options) statuses() []string {
+	switch o.status {
+	case "all":
+		return nil
+	case "firing":
+		return []string{api.AlertStatusTriggered, api.AlertStatusAcknowledged}
+	default:
+		return []string{strings.ToUpper(o.status)}
+	}
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	alerts, err := api.ListAlerts(client, repo.FullName(), &api.ListAlertsOptions{
+		Statuses: o.statuses(),
+		Search:   o.search,
+		First:    o.perPage,
+	})
+	if err != nil {
+		return err
+	}
+
+	if o.outputFormat == "json" {
+		alertsJSON, _ := json.Marshal(alerts)
+		fmt.Fprintln(o.io.StdOut, string(alertsJSON))
+		return nil
+	}
+
+	if len(alerts) == 0 {
+		o.io.LogInfof("No %s alerts found for %s.\n", o.statusDescription(), repo.FullName())
+		return nil
+	}
+
+	c := o.io.Color()
+	table := tableprinter.New(o.io)
+	table.AddHeader("IID", "SEVERITY", "STATUS", "TITLE", "EVENTS", "STARTED", "INCIDENT")
+	for _, a := range alerts {
+		started := ""
+		if a.StartedAt != nil {
+			started = utils.TimeToPrettyTimeAgo(*a.StartedAt)
+		}
+		incident := ""
+		if a.Issue != nil {
+			incident = "#" + a.Issue.IID
+		}
+		table.AddRow("#"+a.IID, alertutils.SeverityLabel(c, a.Severity), strings.ToLower(a.Status), a.Title, a.EventCount, c.Gray(started), incident)
+	}
+	fmt.Fprint(o.io.StdOut, table.String())
+
+	return nil
+}
+
+func (o *options) statusDescription() string {
+	if o.status == "all" {
+		return "open or closed"
+	}
+	return o.status
+}
@@ -0,0 +1,95 @@
+//go:build !integration
+
+package list
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func alertsResponse(t *testing.T) string {
+	startedAt := time.Now().Add(-2 * time.Hour).Format(time.RFC3339)
+	response, err := json.Marshal(map[string]any{
+		"data": map[string]any{"project": map[string]any{"alertManagementAlerts": map[string]any{"nodes": []any{
+			map[string]any{"iid": "2", "title": "High error rate", "severity": "CRITICAL", "status": "TRIGGERED", "eventCount": 3, "startedAt": startedAt},
+			map[string]any{"iid": "1", "title": "Disk almost full", "severity": "MEDIUM", "status": "ACKNOWLEDGED", "eventCount": 1, "startedAt": startedAt, "issue": map[string]any{"iid": "9"}},
+		}}}},
+	})
+	require.NoError(t, err)
+	return string(response)
+}
+
+func Test_AlertList(t *testing.T) {
+	type testCase struct {
+		name         string
+		cli          string
+		response     string
+		wantStatuses any
+		expectedMsg  []string
+	}
+
+	testCases := []testCase{
+		{
+			name:         "List firing alerts",
+			cli:          "",
+			response:     alertsResponse(t),
+			wantStatuses: []string{"TRIGGERED", "ACKNOWLEDGED"},
+			expectedMsg: []string{
+				"IID", "SEVERITY", "STATUS",
+				"#2", "critical", "triggered", "High error rate", "about 2 hours ago",
+				"#1", "medium", "acknowledged", "Disk almost full", "#9",
+			},
+		},
+		{
+			name:         "List resolved alerts",
+			cli:          "--status resolved",
+			response:     `{"data": {"project": {"alertManagementAlerts": {"nodes": []}}}}`,
+			wantStatuses: []string{"RESOLVED"},
+			expectedMsg:  []string{"No resolved alerts found for OWNER/REPO."},
+		},
+		{
+			name:        "List all alerts as JSON",
+			cli:         "-s all -F json",
+			response:    alertsResponse(t),
+			expectedMsg: []string{`"iid":"2"`, `"severity":"CRITICAL"`},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// GIVEN
+			testClient := gitlabtesting.NewTestClient(t)
+			testClient.MockGraphQL.EXPECT().
+				Do(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(query gitlab.GraphQLQuery, response any, _ ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+					assert.Equal(t, tc.wantStatuses, query.Variables["statuses"])
+					return nil, json.Unmarshal([]byte(tc.response), response)
+				})
+			exec := cmdtest.SetupCmdForTest(
+				t,
+				NewCmdList,
+				false,
+				cmdtest.WithGitLabClient(testClient.Client),
+			)
+
+			// WHEN
+			out, err := exec(tc.cli)
+
+			// THEN
+			require.NoError(t, err)
+			for _, msg := range tc.expectedMsg {
+				assert.Contains(t, out.String(), msg)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package resolve
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/alert/alertutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	alerts []string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdResolve(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	alertResolveCmd := &cobra.Command{
+		Use:   "resolve <iid>... [flags]",
+		Short: `Resolve one or more alerts.`,
+		Args:  cobra.MinimumNArgs(1),
+		Example: heredoc.Doc(`
+			# Resolve alert 42
+			$ glab alert resolve 42
+
+			# Resolve several alerts at once
+			$ glab alert resolve 42 43 44
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.alerts = args
+			return opts.run()
+		},
+	}
+
+	return alertResolveCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	return alertutils.UpdateStatus(o.io, client, repo.FullName(), o.alerts, api.AlertStatusResolved, "Resolved")
+}
@@ -52,7 +52,7 @@ func (o *options) run() error {
 		return nil
 	}
 
-	table := tableprinter.NewTablePrinter()
+	table := tableprinter.New(o.io)
 	table.MaxColWidth = 70
 
 	aliasMap := aliasCfg.All()
@@ -62,7 +62,7 @@ func (o *options) run() error {
 	}
 	sort.Strings(keys)
 
-	table.AddRow("Alias", "Command")
+	table.AddHeader("Alias", "Command")
 	for _, alias := range keys {
 		table.AddRow(alias, aliasMap[alias])
 	}
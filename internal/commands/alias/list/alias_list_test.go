@@ -16,6 +16,8 @@ import (
 )
 
 func TestAliasList(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
 	tests := []struct {
 		name       string
 		config     string
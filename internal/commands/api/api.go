@@ -25,6 +25,7 @@ import (
 	"gitlab.com/gitlab-org/cli/internal/glrepo"
 	"gitlab.com/gitlab-org/cli/internal/iostreams"
 	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/pagination"
 )
 
 type options struct {
@@ -44,6 +45,8 @@ type options struct {
 	requestHeaders      []string
 	showResponseHeaders bool
 	paginate            bool
+	resume              bool
+	maxRetries          int
 	silent              bool
 	outputFormat        string
 }
@@ -112,6 +115,14 @@ func NewCmdApi(f cmdutils.Factory, runF func(*options) error) *cobra.Command {
 		- The original query must accept an %[1]s$endCursor: String%[1]s variable.
 		- The query must fetch the %[1]spageInfo{ hasNextPage, endCursor }%[1]s set of fields from a collection.
 
+		A page that fails with HTTP 429 or a server error is retried up to %[1]s--max-retries%[1]s times,
+		after the delay of the %[1]sRetry-After%[1]s response header.
+
+		With %[1]s--resume%[1]s, the progress of %[1]s--paginate%[1]s is recorded after each page. When a run
+		is interrupted, run the same command again to continue from the last completed page instead of
+		the first one. Use it with %[1]s--output ndjson%[1]s, and append the output to the output of the
+		interrupted run.
+
 		The %[1]s--output%[1]s flag controls the output format:
 
 		- %[1]sjson%[1]s (default): Pretty-printed JSON. Arrays are output as a single JSON array.
@@ -126,6 +137,7 @@ func NewCmdApi(f cmdutils.Factory, runF func(*options) error) *cobra.Command {
 			$ glab api issues --paginate
 			$ glab api issues --paginate --output ndjson
 			$ glab api issues --paginate --output ndjson | jq 'select(.state == "opened")'
+			$ glab api issues --paginate --output ndjson --resume >> issues.ndjson
 			$ glab api graphql -f query="query { currentUser { username } }"
 			$ glab api graphql -f query='
 			  query {
@@ -193,6 +205,8 @@ func NewCmdApi(f cmdutils.Factory, runF func(*options) error) *cobra.Command {
 	cmd.Flags().StringArrayVarP(&opts.requestHeaders, "header", "H", nil, "Add an additional HTTP request header.")
 	cmd.Flags().BoolVarP(&opts.showResponseHeaders, "include", "i", false, "Include HTTP response headers in the output.")
 	cmd.Flags().BoolVar(&opts.paginate, "paginate", false, "Make additional HTTP requests to fetch all pages of results.")
+	cmd.Flags().BoolVar(&opts.resume, "resume", false, "Record the progress of --paginate, and continue an interrupted run of the same request from the last completed page.")
+	cmd.Flags().IntVar(&opts.maxRetries, "max-retries", 3, "Maximum number of retries of a page that fails with HTTP 429 or a server error, in --paginate mode.")
 	cmd.Flags().StringVar(&opts.requestInputFile, "input", "", "The file to use as the body for the HTTP request.")
 	cmd.Flags().BoolVar(&opts.silent, "silent", false, "Do not print the response body.")
 	cmd.Flags().Var(cmdutils.NewEnumValue([]string{"json", "ndjson"}, "json", &opts.outputFormat), "output", "Format output as: json, ndjson.")
@@ -216,6 +230,14 @@ func (o *options) validate(cmd *cobra.Command) error {
 		return &cmdutils.FlagError{Err: errors.New(`the '--paginate' option is not supported for non-GET requests.`)}
 	}
 
+	if o.resume && !o.paginate {
+		return &cmdutils.FlagError{Err: errors.New(`the '--resume' option requires '--paginate'.`)}
+	}
+
+	if o.maxRetries < 0 {
+		return &cmdutils.FlagError{Err: errors.New(`the '--max-retries' option must be at least 0.`)}
+	}
+
 	if o.outputFormat != "json" && o.outputFormat != "ndjson" {
 		return &cmdutils.FlagError{Err: fmt.Errorf("invalid output format %q: must be 'json' or 'ndjson'", o.outputFormat)}
 	}
@@ -287,9 +309,34 @@ func (o *options) run(ctx context.Context) error {
 		return err
 	}
 
+	var j *pagination.Journal
+	if o.resume {
+		key, err := pagination.JournalKey("api", struct {
+			Host   string         `json:"host"`
+			Method string         `json:"method"`
+			Path   string         `json:"path"`
+			Params map[string]any `json:"params"`
+		}{client.Lab().BaseURL().String(), method, requestPath, params})
+		if err != nil {
+			return err
+		}
+		j, err = pagination.OpenJournal(key, method+" "+requestPath)
+		if err != nil {
+			return err
+		}
+		if j.Next != "" {
+			if isGraphQL {
+				params["endCursor"] = j.Next
+			} else {
+				requestPath = j.Next
+			}
+			fmt.Fprintf(o.io.StdErr, "Resuming after page %d.\n", j.Pages)
+		}
+	}
+
 	hasNextPage := true
 	for hasNextPage {
-		resp, err := httpRequest(ctx, client, method, requestPath, requestBody, requestHeaders)
+		resp, err := o.requestPage(ctx, client, method, requestPath, requestBody, requestHeaders)
 		if err != nil {
 			return err
 		}
@@ -312,6 +359,21 @@ func (o *options) run(ctx context.Context) error {
 			requestPath, hasNextPage = findNextPage(resp)
 		}
 
+		if j != nil {
+			if hasNextPage {
+				next := requestPath
+				if isGraphQL {
+					next = endCursor
+				}
+				err = j.Save(next)
+			} else {
+				err = j.Remove()
+			}
+			if err != nil {
+				return fmt.Errorf("failed to update the journal: %w", err)
+			}
+		}
+
 		if hasNextPage && o.showResponseHeaders {
 			fmt.Fprint(o.io.StdOut, "\n")
 		}
@@ -320,6 +382,18 @@ func (o *options) run(ctx context.Context) error {
 	return nil
 }
 
+// requestPage makes the request, and in --paginate mode retries it when it fails
+// with HTTP 429 or a server error.
+func (o *options) requestPage(ctx context.Context, client *api.Client, method, path string, body any, headers []string) (*http.Response, error) {
+	maxRetries := o.maxRetries
+	if !o.paginate {
+		maxRetries = 0
+	}
+	return pagination.Retry(ctx, o.io.StdErr, maxRetries, func() (*http.Response, error) {
+		return httpRequest(ctx, client, method, path, body, headers)
+	})
+}
+
 func processResponse(resp *http.Response, opts *options, headersOutputStream io.Writer) (string, error) {
 	if opts.showResponseHeaders {
 		fmt.Fprintln(headersOutputStream, resp.Proto, resp.Status)
@@ -165,6 +165,16 @@ func Test_NewCmdApi(t *testing.T) {
 			},
 			wantsErr: false,
 		},
+		{
+			name:     "resume without pagination",
+			cli:      "projects/OWNER%2FREPO/issues --resume",
+			wantsErr: true,
+		},
+		{
+			name:     "negative max retries",
+			cli:      "projects/OWNER%2FREPO/issues --paginate --max-retries -1",
+			wantsErr: true,
+		},
 		{
 			name:     "input pagination",
 			cli:      "--input projects/OWNER%2FREPO/issues --paginate",
@@ -526,6 +536,144 @@ func Test_apiRun_paginationREST(t *testing.T) {
 	assert.Equal(t, "https://gitlab.com/api/v4/projects/1227/issues?page=3", responses[2].Request.URL.String())
 }
 
+func Test_apiRun_paginationRetry(t *testing.T) {
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+
+	requestCount := 0
+	responses := []*http.Response{
+		{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewBufferString(`{"page":1}`)),
+			Header: http.Header{
+				"Link": []string{`<https://gitlab.com/api/v4/projects/1227/issues?page=2>; rel="next"`},
+			},
+		},
+		{
+			StatusCode: http.StatusTooManyRequests,
+			Body:       io.NopCloser(bytes.NewBufferString(`{"message":"Retry later"}`)),
+			Header: http.Header{
+				"Content-Type": []string{"application/json"},
+				"Retry-After":  []string{"0"},
+			},
+		},
+		{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewBufferString(`{"page":2}`)),
+			Header:     http.Header{},
+		},
+	}
+
+	var tr roundTripFunc = func(req *http.Request) (*http.Response, error) {
+		resp := responses[requestCount]
+		resp.Request = req
+		requestCount++
+		return resp, nil
+	}
+	a := cmdtest.NewTestApiClient(t, &http.Client{Transport: tr}, "OTOKEN", "gitlab.com")
+	options := options{
+		io: ios,
+		baseRepo: func() (glrepo.Interface, error) {
+			return nil, fmt.Errorf("not supposed to be called")
+		},
+		apiClient: func(repoHost string) (*api.Client, error) {
+			return a, nil
+		},
+
+		requestPath: "issues",
+		paginate:    true,
+		maxRetries:  1,
+	}
+
+	err := options.run(t.Context())
+	require.NoError(t, err)
+
+	assert.Equal(t, `{"page":1}{"page":2}`, stdout.String(), "stdout")
+	assert.Equal(t, "glab: HTTP 429, retrying in 0s (1/1)\n", stderr.String(), "stderr")
+	assert.Equal(t, "https://gitlab.com/api/v4/projects/1227/issues?page=2", responses[2].Request.URL.String())
+}
+
+func Test_apiRun_paginationResume(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	var requests []string
+	newOptions := func(t *testing.T, responses []*http.Response) (options, *bytes.Buffer, *bytes.Buffer) {
+		ios, _, stdout, stderr := cmdtest.TestIOStreams()
+		var tr roundTripFunc = func(req *http.Request) (*http.Response, error) {
+			resp := responses[0]
+			responses = responses[1:]
+			resp.Request = req
+			requests = append(requests, req.URL.String())
+			return resp, nil
+		}
+		a := cmdtest.NewTestApiClient(t, &http.Client{Transport: tr}, "OTOKEN", "gitlab.com")
+		return options{
+			io: ios,
+			baseRepo: func() (glrepo.Interface, error) {
+				return nil, fmt.Errorf("not supposed to be called")
+			},
+			apiClient: func(repoHost string) (*api.Client, error) {
+				return a, nil
+			},
+
+			requestPath:  "issues",
+			paginate:     true,
+			resume:       true,
+			outputFormat: "ndjson",
+		}, stdout, stderr
+	}
+
+	// The first run is interrupted by a server error on the second page.
+	opts, stdout, _ := newOptions(t, []*http.Response{
+		{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewBufferString(`[{"id":1}]`)),
+			Header: http.Header{
+				"Content-Type": []string{"application/json"},
+				"Link":         []string{`<https://gitlab.com/api/v4/issues?page=2&per_page=100>; rel="next"`},
+			},
+		},
+		{
+			StatusCode: http.StatusServiceUnavailable,
+			Body:       io.NopCloser(bytes.NewBufferString(`Service Unavailable`)),
+			Header:     http.Header{},
+		},
+	})
+	err := opts.run(t.Context())
+	require.Error(t, err)
+	assert.Equal(t, "{\"id\":1}\nService Unavailable", stdout.String())
+
+	// The second run continues from the second page.
+	opts, stdout, stderr := newOptions(t, []*http.Response{
+		{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewBufferString(`[{"id":2}]`)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		},
+	})
+	err = opts.run(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, "{\"id\":2}\n", stdout.String())
+	assert.Equal(t, "Resuming after page 1.\n", stderr.String())
+
+	// Once completed, the next run starts from the first page again.
+	opts, _, _ = newOptions(t, []*http.Response{
+		{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewBufferString(`[{"id":1}]`)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		},
+	})
+	err = opts.run(t.Context())
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"https://gitlab.com/api/v4/issues?per_page=100",
+		"https://gitlab.com/api/v4/issues?page=2&per_page=100",
+		"https://gitlab.com/api/v4/issues?page=2&per_page=100",
+		"https://gitlab.com/api/v4/issues?per_page=100",
+	}, requests)
+}
+
 func Test_apiRun_paginationGraphQL(t *testing.T) {
 	ios, _, stdout, stderr := cmdtest.TestIOStreams()
 
@@ -84,6 +84,28 @@ func (o *options) run(ctx context.Context) error {
 		return err
 	}
 
+	if err := o.verifyProvenance(ctx, client); err != nil {
+		return err
+	}
+
+	o.success()
+
+	return nil
+}
+
+// VerifyProvenance verifies the provenance of the file filename, which was attested
+// by a pipeline of project. It requires the cosign binary.
+func VerifyProvenance(ctx context.Context, client *gitlab.Client, exec cmdutils.Executor, project, filename string) error {
+	o := &options{
+		defaultHostname: glinstance.DefaultHostname,
+		exec:            exec,
+		project:         project,
+		filename:        filename,
+	}
+	return o.verifyProvenance(ctx, client)
+}
+
+func (o *options) verifyProvenance(ctx context.Context, client *gitlab.Client) error {
 	project, err := api.GetProject(client, o.project)
 	if err != nil {
 		return err
@@ -104,14 +126,7 @@ func (o *options) run(ctx context.Context) error {
 		return err
 	}
 
-	err = o.verify(ctx, o.filename, project.PathWithNamespace, bundle)
-	if err != nil {
-		return err
-	}
-
-	o.success()
-
-	return nil
+	return o.verify(ctx, o.filename, project.PathWithNamespace, bundle)
 }
 
 func (o *options) sha256(filename string) (string, error) {
@@ -30,7 +30,7 @@ type LoginOptions struct {
 	IO              *iostreams.IOStreams
 	Config          func() config.Config
 	apiClient       func(repoHost string) (*api.Client, error)
-	defaultHostname string
+	defaultHostname func() string
 
 	Interactive bool
 
@@ -52,7 +52,7 @@ func NewCmdLogin(f cmdutils.Factory) *cobra.Command {
 		IO:              f.IO(),
 		Config:          f.Config,
 		apiClient:       f.ApiClient,
-		defaultHostname: f.DefaultHostname(),
+		defaultHostname: f.DefaultHostname,
 	}
 
 	var tokenStdin bool
@@ -268,13 +268,13 @@ func loginRun(ctx context.Context, opts *LoginOptions) error {
 			// Check if user selected "Enter a different hostname"
 			if selectedOption == promptLoginDifferentHostname {
 				// Fall back to manual entry
-				hostname = opts.defaultHostname
+				hostname = opts.defaultHostname()
 				apiHostname = hostname
 
 				hostnameInput := huh.NewInput().
 					Title("GitLab hostname:").
 					Value(&hostname).
-					Placeholder(opts.defaultHostname).
+					Placeholder(opts.defaultHostname()).
 					Validate(func(s string) error {
 						return hostnameValidator(s)
 					})
@@ -284,7 +284,7 @@ func loginRun(ctx context.Context, opts *LoginOptions) error {
 				}
 
 				// Set default for API hostname
-				if apiHostname == opts.defaultHostname {
+				if apiHostname == opts.defaultHostname() {
 					apiHostname = hostname
 				}
 
@@ -316,8 +316,8 @@ func loginRun(ctx context.Context, opts *LoginOptions) error {
 			if hosts, err := cfg.Hosts(); err == nil {
 				options = append(options, hosts...)
 			}
-			if !slices.Contains(options, opts.defaultHostname) {
-				options = append(options, opts.defaultHostname)
+			if !slices.Contains(options, opts.defaultHostname()) {
+				options = append(options, opts.defaultHostname())
 			}
 			options = append(options, promptSelfManagedOrDedicatedInstance)
 
@@ -330,13 +330,13 @@ func loginRun(ctx context.Context, opts *LoginOptions) error {
 			isSelfHosted = selectedOption == promptSelfManagedOrDedicatedInstance
 
 			if isSelfHosted {
-				hostname = opts.defaultHostname
+				hostname = opts.defaultHostname()
 				apiHostname = hostname
 
 				hostnameInput := huh.NewInput().
 					Title("GitLab hostname:").
 					Value(&hostname).
-					Placeholder(opts.defaultHostname).
+					Placeholder(opts.defaultHostname()).
 					Validate(func(s string) error {
 						return hostnameValidator(s)
 					})
@@ -346,7 +346,7 @@ func loginRun(ctx context.Context, opts *LoginOptions) error {
 				}
 
 				// Set default for API hostname
-				if apiHostname == opts.defaultHostname {
+				if apiHostname == opts.defaultHostname() {
 					apiHostname = hostname
 				}
 
@@ -30,10 +30,9 @@ type options struct {
 
 func NewCmdStatus(f cmdutils.Factory, runE func(*options) error) *cobra.Command {
 	opts := &options{
-		io:              f.IO(),
-		apiClient:       f.ApiClient,
-		config:          f.Config,
-		defaultHostname: f.DefaultHostname(),
+		io:        f.IO(),
+		apiClient: f.ApiClient,
+		config:    f.Config,
 	}
 
 	cmd := &cobra.Command{
@@ -51,6 +50,8 @@ func NewCmdStatus(f cmdutils.Factory, runE func(*options) error) *cobra.Command
 			mcpannotations.Safe: "true",
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.defaultHostname = f.DefaultHostname()
+
 			if runE != nil {
 				return runE(opts)
 			}
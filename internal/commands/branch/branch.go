@@ -0,0 +1,30 @@
+package branch
+
+import (
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	cmdCreate "gitlab.com/gitlab-org/cli/internal/commands/branch/create"
+	cmdDelete "gitlab.com/gitlab-org/cli/internal/commands/branch/delete"
+	cmdList "gitlab.com/gitlab-org/cli/internal/commands/branch/list"
+	cmdProtect "gitlab.com/gitlab-org/cli/internal/commands/branch/protect"
+	cmdUnprotect "gitlab.com/gitlab-org/cli/internal/commands/branch/unprotect"
+)
+
+func NewCmdBranch(f cmdutils.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "branch <command> [flags]",
+		Short: `Work with the branches of a repository.`,
+		Long:  ``,
+	}
+
+	cmdutils.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdList.NewCmdList(f))
+	cmd.AddCommand(cmdCreate.NewCmdCreate(f))
+	cmd.AddCommand(cmdDelete.NewCmdDelete(f))
+	cmd.AddCommand(cmdProtect.NewCmdProtect(f))
+	cmd.AddCommand(cmdUnprotect.NewCmdUnprotect(f))
+
+	return cmd
+}
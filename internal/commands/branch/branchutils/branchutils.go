@@ -0,0 +1,100 @@
+package branchutils
+
+import (
+	"strconv"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// AccessLevelNames are the roles that can be allowed to push or merge to a
+// protected branch, from the most to the least restrictive.
+var AccessLevelNames = []string{"no-one", "developer", "maintainer"}
+
+// AccessLevels maps the names of AccessLevelNames to their access levels.
+var AccessLevels = map[string]gitlab.AccessLevelValue{
+	"no-one":     gitlab.NoPermissions,
+	"developer":  gitlab.DeveloperPermissions,
+	"maintainer": gitlab.MaintainerPermissions,
+}
+
+// RoleLevels returns the role-based access levels of a protected branch,
+// ignoring the access given to specific users, groups, and deploy keys.
+func RoleLevels(access []*gitlab.BranchAccessDescription) []gitlab.AccessLevelValue {
+	var levels []gitlab.AccessLevelValue
+	for _, a := range access {
+		if isRoleBased(a.UserID, a.GroupID, a.DeployKeyID) {
+			levels = append(levels, a.AccessLevel)
+		}
+	}
+	return levels
+}
+
+// TagRoleLevels returns the role-based access levels of a protected tag, like
+// RoleLevels does for a protected branch.
+func TagRoleLevels(access []*gitlab.TagAccessDescription) []gitlab.AccessLevelValue {
+	var levels []gitlab.AccessLevelValue
+	for _, a := range access {
+		if isRoleBased(a.UserID, a.GroupID, a.DeployKeyID) {
+			levels = append(levels, a.AccessLevel)
+		}
+	}
+	return levels
+}
+
+// isRoleBased reports whether an access level of a protected branch or tag is
+// given to a role, rather than to a specific user, group, or deploy key.
+func isRoleBased(userID, groupID, deployKeyID int64) bool {
+	return userID == 0 && groupID == 0 && deployKeyID == 0
+}
+
+// HasOnlyLevel reports whether level is the only role-based access level of a
+// protected branch.
+func HasOnlyLevel(access []*gitlab.BranchAccessDescription, level gitlab.AccessLevelValue) bool {
+	levels := RoleLevels(access)
+	return len(levels) == 1 && levels[0] == level
+}
+
+// ReplaceLevels removes the role-based access levels of a protected branch,
+// and adds level.
+func ReplaceLevels(access []*gitlab.BranchAccessDescription, level gitlab.AccessLevelValue) []*gitlab.BranchPermissionOptions {
+	var permissions []*gitlab.BranchPermissionOptions
+	for _, a := range access {
+		if isRoleBased(a.UserID, a.GroupID, a.DeployKeyID) {
+			permissions = append(permissions, &gitlab.BranchPermissionOptions{ID: gitlab.Ptr(a.ID), Destroy: gitlab.Ptr(true)})
+		}
+	}
+	return append(permissions, &gitlab.BranchPermissionOptions{AccessLevel: gitlab.Ptr(level)})
+}
+
+// LevelNames returns the names of the role-based access levels of a protected
+// branch, comma-separated.
+func LevelNames(access []*gitlab.BranchAccessDescription) string {
+	return JoinLevels(RoleLevels(access))
+}
+
+// JoinLevels returns the names of the access levels, comma-separated, or
+// "none".
+func JoinLevels(levels []gitlab.AccessLevelValue) string {
+	if len(levels) == 0 {
+		return "none"
+	}
+	names := make([]string, 0, len(levels))
+	for _, level := range levels {
+		names = append(names, LevelName(level))
+	}
+	return strings.Join(names, ", ")
+}
+
+// LevelName returns the name of an access level.
+func LevelName(level gitlab.AccessLevelValue) string {
+	for name, value := range AccessLevels {
+		if value == level {
+			return name
+		}
+	}
+	if level == gitlab.AdminPermissions {
+		return "admin"
+	}
+	return strconv.Itoa(int(level))
+}
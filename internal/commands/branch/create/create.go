@@ -0,0 +1,89 @@
+package create
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	name string
+	ref  string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	branchCreateCmd := &cobra.Command{
+		Use:   "create <name> [flags]",
+		Short: `Create a branch from a branch, tag, or commit.`,
+		Long: heredoc.Doc(`
+			Create a branch on the GitLab server, from a branch, tag, or commit.
+			The local repository is not changed.
+		`),
+		Aliases: []string{"new"},
+		Args:    cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ glab branch create feature-x
+			$ glab branch create hotfix-1.2.1 --ref v1.2.0
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.name = args[0]
+			return opts.run()
+		},
+	}
+
+	branchCreateCmd.Flags().StringVarP(&opts.ref, "ref", "r", "", "Branch, tag, or commit to create the branch from. Defaults to the default branch.")
+
+	return branchCreateCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	ref := o.ref
+	if ref == "" {
+		project, _, err := client.Projects.GetProject(repo.FullName(), nil)
+		if err != nil {
+			return cmdutils.WrapError(err, "failed to get the default branch of the project.")
+		}
+		ref = project.DefaultBranch
+	}
+
+	branch, _, err := client.Branches.CreateBranch(repo.FullName(), &gitlab.CreateBranchOptions{
+		Branch: gitlab.Ptr(o.name),
+		Ref:    gitlab.Ptr(ref),
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to create branch %s.", o.name))
+	}
+
+	c := o.io.Color()
+	fmt.Fprintf(o.io.StdOut, "%s Created branch %s from %s\n %s\n", c.GreenCheck(), c.Bold(branch.Name), ref, branch.WebURL)
+	return nil
+}
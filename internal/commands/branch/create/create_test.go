@@ -0,0 +1,49 @@
+//go:build !integration
+
+package create
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_Create(t *testing.T) {
+	created := &gitlab.Branch{Name: "feature-x", WebURL: "https://gitlab.com/OWNER/REPO/-/tree/feature-x"}
+
+	t.Run("from the default branch", func(t *testing.T) {
+		testClient := gitlabtesting.NewTestClient(t)
+		testClient.MockProjects.EXPECT().GetProject("OWNER/REPO", gomock.Nil()).Return(&gitlab.Project{DefaultBranch: "main"}, nil, nil)
+		testClient.MockBranches.EXPECT().CreateBranch("OWNER/REPO", &gitlab.CreateBranchOptions{
+			Branch: gitlab.Ptr("feature-x"),
+			Ref:    gitlab.Ptr("main"),
+		}).Return(created, nil, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdCreate, false, cmdtest.WithGitLabClient(testClient.Client))
+
+		out, err := exec("feature-x")
+		require.NoError(t, err)
+		assert.Equal(t, "✓ Created branch feature-x from main\n https://gitlab.com/OWNER/REPO/-/tree/feature-x\n", out.String())
+	})
+
+	t.Run("from a ref", func(t *testing.T) {
+		testClient := gitlabtesting.NewTestClient(t)
+		testClient.MockBranches.EXPECT().CreateBranch("OWNER/REPO", &gitlab.CreateBranchOptions{
+			Branch: gitlab.Ptr("feature-x"),
+			Ref:    gitlab.Ptr("v1.2.0"),
+		}).Return(created, nil, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdCreate, false, cmdtest.WithGitLabClient(testClient.Client))
+
+		out, err := exec("feature-x --ref v1.2.0")
+		require.NoError(t, err)
+		assert.Equal(t, "✓ Created branch feature-x from v1.2.0\n https://gitlab.com/OWNER/REPO/-/tree/feature-x\n", out.String())
+	})
+}
@@ -0,0 +1,146 @@
+package delete
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	names  []string
+	merged bool
+	dryRun bool
+	yes    bool
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdDelete(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	branchDeleteCmd := &cobra.Command{
+		Use:   "delete [<name>...] [flags]",
+		Short: `Delete branches.`,
+		Long: heredoc.Doc(`
+			Delete branches on the GitLab server.
+
+			With --merged, delete all the branches merged into the default branch,
+			except the default branch and the protected branches. Use --dry-run to
+			list them without deleting them.
+		`),
+		Example: heredoc.Doc(`
+			$ glab branch delete feature-x feature-y
+			$ glab branch delete --merged --dry-run
+			$ glab branch delete --merged --yes
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.names = args
+
+			if opts.merged == (len(args) > 0) {
+				return &cmdutils.FlagError{Err: errors.New("specify the branches to delete, or --merged.")}
+			}
+			if !opts.yes && !opts.dryRun && !opts.io.PromptEnabled() {
+				return &cmdutils.FlagError{Err: errors.New("--yes or -y flag is required when not running interactively.")}
+			}
+
+			return opts.run(cmd.Context())
+		},
+	}
+
+	fl := branchDeleteCmd.Flags()
+	fl.BoolVar(&opts.merged, "merged", false, "Delete the branches merged into the default branch.")
+	fl.BoolVar(&opts.dryRun, "dry-run", false, "List the branches that would be deleted, without deleting them.")
+	fl.BoolVarP(&opts.yes, "yes", "y", false, "Skip the confirmation prompt.")
+
+	return branchDeleteCmd
+}
+
+func (o *options) run(ctx context.Context) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	names := o.names
+	if o.merged {
+		names, err = mergedBranches(client, repo.FullName())
+		if err != nil {
+			return err
+		}
+		if len(names) == 0 {
+			o.io.LogInfof("No merged branches to delete in %s.\n", repo.FullName())
+			return nil
+		}
+	}
+
+	if o.dryRun {
+		for _, name := range names {
+			fmt.Fprintf(o.io.StdOut, "Would delete branch %s\n", name)
+		}
+		return nil
+	}
+
+	if !o.yes {
+		msg := fmt.Sprintf("Delete branch %s?", names[0])
+		if len(names) > 1 {
+			msg = fmt.Sprintf("Delete %d branches?", len(names))
+		}
+		err = o.io.Confirm(ctx, &o.yes, msg)
+		if err != nil {
+			return cmdutils.WrapError(err, "could not prompt")
+		}
+		if !o.yes {
+			return cmdutils.CancelError()
+		}
+	}
+
+	for _, name := range names {
+		if _, err := client.Branches.DeleteBranch(repo.FullName(), name); err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to delete branch %s.", name))
+		}
+		fmt.Fprintf(o.io.StdOut, "%s Deleted branch %s\n", o.io.Color().RedCheck(), name)
+	}
+	return nil
+}
+
+// mergedBranches returns the names of the branches merged into the default
+// branch, except the default branch and the protected branches.
+func mergedBranches(client *gitlab.Client, repo string) ([]string, error) {
+	branches, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Branch, *gitlab.Response, error) {
+		return client.Branches.ListBranches(repo, &gitlab.ListBranchesOptions{ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage}}, p)
+	})
+	if err != nil {
+		return nil, cmdutils.WrapError(err, "failed to list branches.")
+	}
+
+	var names []string
+	for _, b := range branches {
+		if b.Merged && !b.Default && !b.Protected {
+			names = append(names, b.Name)
+		}
+	}
+	return names, nil
+}
@@ -0,0 +1,87 @@
+//go:build !integration
+
+package delete
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+var branches = []*gitlab.Branch{
+	{Name: "main", Default: true, Merged: true, Protected: true},
+	{Name: "release-1.0", Merged: true, Protected: true},
+	{Name: "feature-x"},
+	{Name: "old-fix", Merged: true},
+	{Name: "old-docs", Merged: true},
+}
+
+func Test_Delete(t *testing.T) {
+	t.Run("branches by name", func(t *testing.T) {
+		testClient := gitlabtesting.NewTestClient(t)
+		testClient.MockBranches.EXPECT().DeleteBranch("OWNER/REPO", "feature-x").Return(nil, nil)
+		testClient.MockBranches.EXPECT().DeleteBranch("OWNER/REPO", "old-fix").Return(nil, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdDelete, false, cmdtest.WithGitLabClient(testClient.Client))
+
+		out, err := exec("feature-x old-fix --yes")
+		require.NoError(t, err)
+		assert.Equal(t, "✓ Deleted branch feature-x\n✓ Deleted branch old-fix\n", out.String())
+	})
+
+	t.Run("merged branches", func(t *testing.T) {
+		testClient := gitlabtesting.NewTestClient(t)
+		testClient.MockBranches.EXPECT().ListBranches("OWNER/REPO", gomock.Any(), gomock.Any()).Return(branches, &gitlab.Response{}, nil)
+		testClient.MockBranches.EXPECT().DeleteBranch("OWNER/REPO", "old-fix").Return(nil, nil)
+		testClient.MockBranches.EXPECT().DeleteBranch("OWNER/REPO", "old-docs").Return(nil, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdDelete, false, cmdtest.WithGitLabClient(testClient.Client))
+
+		out, err := exec("--merged --yes")
+		require.NoError(t, err)
+		assert.Equal(t, "✓ Deleted branch old-fix\n✓ Deleted branch old-docs\n", out.String())
+	})
+
+	t.Run("merged branches dry run", func(t *testing.T) {
+		testClient := gitlabtesting.NewTestClient(t)
+		testClient.MockBranches.EXPECT().ListBranches("OWNER/REPO", gomock.Any(), gomock.Any()).Return(branches, &gitlab.Response{}, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdDelete, false, cmdtest.WithGitLabClient(testClient.Client))
+
+		out, err := exec("--merged --dry-run")
+		require.NoError(t, err)
+		assert.Equal(t, "Would delete branch old-fix\nWould delete branch old-docs\n", out.String())
+	})
+
+	t.Run("no merged branches", func(t *testing.T) {
+		testClient := gitlabtesting.NewTestClient(t)
+		testClient.MockBranches.EXPECT().ListBranches("OWNER/REPO", gomock.Any(), gomock.Any()).Return(branches[:3], &gitlab.Response{}, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdDelete, false, cmdtest.WithGitLabClient(testClient.Client))
+
+		out, err := exec("--merged --yes")
+		require.NoError(t, err)
+		assert.Equal(t, "No merged branches to delete in OWNER/REPO.\n", out.String())
+	})
+
+	t.Run("names and --merged", func(t *testing.T) {
+		exec := cmdtest.SetupCmdForTest(t, NewCmdDelete, false)
+
+		_, err := exec("feature-x --merged --yes")
+		assert.EqualError(t, err, "specify the branches to delete, or --merged.")
+	})
+
+	t.Run("non-interactive without --yes", func(t *testing.T) {
+		exec := cmdtest.SetupCmdForTest(t, NewCmdDelete, false)
+
+		_, err := exec("feature-x")
+		assert.EqualError(t, err, "--yes or -y flag is required when not running interactively.")
+	})
+}
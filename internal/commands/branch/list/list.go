@@ -0,0 +1,253 @@
+package list
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	search       string
+	merged       bool
+	unmerged     bool
+	staleDays    int
+	aheadBehind  bool
+	outputFormat string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+// Branch is a branch, with the number of commits that it is ahead and behind
+// the default branch when they are requested.
+type Branch struct {
+	*gitlab.Branch
+	Ahead  *int `json:"ahead,omitempty"`
+	Behind *int `json:"behind,omitempty"`
+}
+
+func NewCmdList(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	branchListCmd := &cobra.Command{
+		Use:   "list [flags]",
+		Short: `List the branches of a project.`,
+		Long: heredoc.Doc(`
+			List the branches of a project, with the state of each branch: default,
+			protected, or merged into the default branch.
+
+			With --ahead-behind, also show the number of commits that each branch has
+			that the default branch doesn't have, and the other way around. It takes two
+			requests per branch.
+		`),
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Example: heredoc.Doc(`
+			$ glab branch list
+			$ glab branch list --merged
+			$ glab branch list --stale 90 --ahead-behind
+			$ glab branch list --search release
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.staleDays < 0 {
+				return &cmdutils.FlagError{Err: errors.New("--stale can't be negative.")}
+			}
+			return opts.run()
+		},
+	}
+
+	fl := branchListCmd.Flags()
+	fl.StringVarP(&opts.search, "search", "s", "", "List the branches whose name contains this string.")
+	fl.BoolVar(&opts.merged, "merged", false, "List the branches merged into the default branch only.")
+	fl.BoolVar(&opts.unmerged, "unmerged", false, "List the branches not merged into the default branch only.")
+	fl.IntVar(&opts.staleDays, "stale", 0, "List the branches without commits in this number of days only.")
+	fl.BoolVar(&opts.aheadBehind, "ahead-behind", false, "Show the number of commits ahead and behind the default branch.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+	branchListCmd.MarkFlagsMutuallyExclusive("merged", "unmerged")
+
+	return branchListCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	listOpts := &gitlab.ListBranchesOptions{ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage}}
+	if o.search != "" {
+		listOpts.Search = gitlab.Ptr(o.search)
+	}
+	branches, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Branch, *gitlab.Response, error) {
+		return client.Branches.ListBranches(repo.FullName(), listOpts, p)
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to list branches.")
+	}
+
+	branches = slices.DeleteFunc(branches, func(b *gitlab.Branch) bool {
+		return !o.matches(b)
+	})
+	result := make([]*Branch, 0, len(branches))
+	for _, b := range branches {
+		result = append(result, &Branch{Branch: b})
+	}
+
+	if o.aheadBehind && len(result) > 0 {
+		if err := aheadBehind(client, repo.FullName(), result); err != nil {
+			return err
+		}
+	}
+
+	if o.outputFormat == "json" {
+		branchesJSON, _ := json.Marshal(result)
+		fmt.Fprintln(o.io.StdOut, string(branchesJSON))
+		return nil
+	}
+
+	if len(result) == 0 {
+		o.io.LogInfof("No branches found for %s.\n", repo.FullName())
+		return nil
+	}
+
+	o.printBranches(result)
+	return nil
+}
+
+// matches reports whether the branch passes the filters of the flags.
+func (o *options) matches(b *gitlab.Branch) bool {
+	if o.merged && !b.Merged {
+		return false
+	}
+	if o.unmerged && b.Merged {
+		return false
+	}
+	if o.staleDays > 0 {
+		if b.Commit == nil || b.Commit.CommittedDate == nil {
+			return false
+		}
+		if time.Since(*b.Commit.CommittedDate) < time.Duration(o.staleDays)*24*time.Hour {
+			return false
+		}
+	}
+	return true
+}
+
+// aheadBehind sets the number of commits that the branches are ahead and
+// behind the default branch.
+func aheadBehind(client *gitlab.Client, repo string, branches []*Branch) error {
+	var defaultBranch string
+	for _, b := range branches {
+		if b.Default {
+			defaultBranch = b.Name
+		}
+	}
+	if defaultBranch == "" {
+		project, _, err := client.Projects.GetProject(repo, nil)
+		if err != nil {
+			return cmdutils.WrapError(err, "failed to get the default branch of the project.")
+		}
+		defaultBranch = project.DefaultBranch
+	}
+
+	count := func(from, to string) (int, error) {
+		compare, _, err := client.Repositories.Compare(repo, &gitlab.CompareOptions{
+			From:     gitlab.Ptr(from),
+			To:       gitlab.Ptr(to),
+			Straight: gitlab.Ptr(false),
+		})
+		if err != nil {
+			return 0, cmdutils.WrapError(err, fmt.Sprintf("failed to compare %s with %s.", to, from))
+		}
+		return len(compare.Commits), nil
+	}
+
+	g := errgroup.Group{}
+	g.SetLimit(8)
+	for _, b := range branches {
+		if b.Name == defaultBranch {
+			b.Ahead, b.Behind = gitlab.Ptr(0), gitlab.Ptr(0)
+			continue
+		}
+		g.Go(func() error {
+			ahead, err := count(defaultBranch, b.Name)
+			if err != nil {
+				return err
+			}
+			behind, err := count(b.Name, defaultBranch)
+			if err != nil {
+				return err
+			}
+			b.Ahead, b.Behind = &ahead, &behind
+			return nil
+		})
+	}
+	return g.Wait()
+}
+
+func (o *options) printBranches(branches []*Branch) {
+	c := o.io.Color()
+	table := tableprinter.New(o.io)
+	header := []any{"NAME", "COMMIT", "UPDATED", "STATE"}
+	if o.aheadBehind {
+		header = append(header, "AHEAD", "BEHIND")
+	}
+	table.AddHeader(header...)
+
+	for _, b := range branches {
+		var state []string
+		if b.Default {
+			state = append(state, c.Green("default"))
+		}
+		if b.Protected {
+			state = append(state, c.Yellow("protected"))
+		}
+		if b.Merged {
+			state = append(state, c.Magenta("merged"))
+		}
+
+		commit, updated := "", ""
+		if b.Commit != nil {
+			commit = b.Commit.ShortID
+			if b.Commit.CommittedDate != nil {
+				updated = utils.TimeToPrettyTimeAgo(*b.Commit.CommittedDate)
+			}
+		}
+
+		row := []any{b.Name, c.Cyan(commit), c.Gray(updated), strings.Join(state, ", ")}
+		if o.aheadBehind {
+			row = append(row, strconv.Itoa(*b.Ahead), strconv.Itoa(*b.Behind))
+		}
+		table.AddRow(row...)
+	}
+	fmt.Fprint(o.io.StdOut, table.String())
+}
@@ -0,0 +1,107 @@
+//go:build !integration
+
+package list
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_List(t *testing.T) {
+	now := time.Now()
+	old := now.Add(-200 * 24 * time.Hour)
+	branches := []*gitlab.Branch{
+		{Name: "main", Default: true, Protected: true, Commit: &gitlab.Commit{ShortID: "aaaa1111", CommittedDate: &now}},
+		{Name: "feature-x", Commit: &gitlab.Commit{ShortID: "bbbb2222", CommittedDate: &now}},
+		{Name: "old-fix", Merged: true, Commit: &gitlab.Commit{ShortID: "cccc3333", CommittedDate: &old}},
+	}
+
+	tests := []struct {
+		name string
+		args string
+		want string
+	}{
+		{
+			name: "all branches",
+			want: "NAME\tCOMMIT\tUPDATED\tSTATE\n" +
+				"main\taaaa1111\tless than a minute ago\tdefault, protected\n" +
+				"feature-x\tbbbb2222\tless than a minute ago\t\n" +
+				"old-fix\tcccc3333\tabout 6 months ago\tmerged\n",
+		},
+		{
+			name: "merged branches",
+			args: "--merged",
+			want: "NAME\tCOMMIT\tUPDATED\tSTATE\nold-fix\tcccc3333\tabout 6 months ago\tmerged\n",
+		},
+		{
+			name: "unmerged branches",
+			args: "--unmerged",
+			want: "NAME\tCOMMIT\tUPDATED\tSTATE\n" +
+				"main\taaaa1111\tless than a minute ago\tdefault, protected\n" +
+				"feature-x\tbbbb2222\tless than a minute ago\t\n",
+		},
+		{
+			name: "stale branches",
+			args: "--stale 90",
+			want: "NAME\tCOMMIT\tUPDATED\tSTATE\nold-fix\tcccc3333\tabout 6 months ago\tmerged\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			testClient.MockBranches.EXPECT().ListBranches("OWNER/REPO", gomock.Any(), gomock.Any()).Return(branches, &gitlab.Response{}, nil)
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdList, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			out, err := exec(tt.args)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, out.String())
+		})
+	}
+}
+
+func Test_List_AheadBehind(t *testing.T) {
+	now := time.Now()
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockBranches.EXPECT().ListBranches("OWNER/REPO", &gitlab.ListBranchesOptions{
+		ListOptions: gitlab.ListOptions{PerPage: 100},
+		Search:      gitlab.Ptr("feature"),
+	}, gomock.Any()).Return([]*gitlab.Branch{
+		{Name: "feature-x", Commit: &gitlab.Commit{ShortID: "bbbb2222", CommittedDate: &now}},
+	}, &gitlab.Response{}, nil)
+	testClient.MockProjects.EXPECT().GetProject("OWNER/REPO", gomock.Nil()).Return(&gitlab.Project{DefaultBranch: "main"}, nil, nil)
+	testClient.MockRepositories.EXPECT().Compare("OWNER/REPO", &gitlab.CompareOptions{
+		From: gitlab.Ptr("main"), To: gitlab.Ptr("feature-x"), Straight: gitlab.Ptr(false),
+	}).Return(&gitlab.Compare{Commits: make([]*gitlab.Commit, 3)}, nil, nil)
+	testClient.MockRepositories.EXPECT().Compare("OWNER/REPO", &gitlab.CompareOptions{
+		From: gitlab.Ptr("feature-x"), To: gitlab.Ptr("main"), Straight: gitlab.Ptr(false),
+	}).Return(&gitlab.Compare{Commits: make([]*gitlab.Commit, 1)}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdList, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	out, err := exec("--search feature --ahead-behind")
+	require.NoError(t, err)
+	assert.Equal(t, "NAME\tCOMMIT\tUPDATED\tSTATE\tAHEAD\tBEHIND\n"+
+		"feature-x\tbbbb2222\tless than a minute ago\t\t3\t1\n", out.String())
+}
+
+func Test_List_NoBranches(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockBranches.EXPECT().ListBranches("OWNER/REPO", gomock.Any(), gomock.Any()).Return([]*gitlab.Branch{}, &gitlab.Response{}, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdList, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	out, err := exec("--merged")
+	require.NoError(t, err)
+	assert.Equal(t, "No branches found for OWNER/REPO.\n", out.String())
+}
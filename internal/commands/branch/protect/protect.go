@@ -0,0 +1,149 @@
+package protect
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/branch/branchutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	name              string
+	pushAccessLevel   string
+	mergeAccessLevel  string
+	allowForcePush    bool
+	codeOwnerApproval bool
+
+	// changed reports whether a flag was set, to only update the settings
+	// given on the command line when the branch is already protected.
+	changed func(flag string) bool
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdProtect(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	branchProtectCmd := &cobra.Command{
+		Use:   "protect <name> [flags]",
+		Short: `Protect a branch, or update the rule of a protected branch.`,
+		Long: heredoc.Doc(`
+			Protect a branch, or the branches that match a wildcard like 'release-*',
+			and set who is allowed to push and merge to them.
+
+			When the branch is already protected, only the settings of the given
+			flags are updated. Access given to specific users, groups, and deploy
+			keys is kept.
+		`),
+		Args: cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ glab branch protect main --push-access-level no-one --code-owner-approval
+			$ glab branch protect 'release-*' --merge-access-level developer
+			$ glab branch protect main --allow-force-push=false
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.name = args[0]
+			opts.changed = cmd.Flags().Changed
+			return opts.run()
+		},
+	}
+
+	fl := branchProtectCmd.Flags()
+	fl.Var(cmdutils.NewEnumValue(branchutils.AccessLevelNames, "maintainer", &opts.pushAccessLevel), "push-access-level", "Role allowed to push to the branch: no-one, developer, maintainer.")
+	fl.Var(cmdutils.NewEnumValue(branchutils.AccessLevelNames, "maintainer", &opts.mergeAccessLevel), "merge-access-level", "Role allowed to merge to the branch: no-one, developer, maintainer.")
+	fl.BoolVar(&opts.allowForcePush, "allow-force-push", false, "Allow force push to the branch.")
+	fl.BoolVar(&opts.codeOwnerApproval, "code-owner-approval", false, "Require the approval of code owners to push and merge to the branch.")
+
+	return branchProtectCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	pushLevel := branchutils.AccessLevels[o.pushAccessLevel]
+	mergeLevel := branchutils.AccessLevels[o.mergeAccessLevel]
+
+	current, _, err := client.ProtectedBranches.GetProtectedBranch(repo.FullName(), o.name)
+	switch {
+	case errors.Is(err, gitlab.ErrNotFound):
+		current, _, err = client.ProtectedBranches.ProtectRepositoryBranches(repo.FullName(), &gitlab.ProtectRepositoryBranchesOptions{
+			Name:                      gitlab.Ptr(o.name),
+			PushAccessLevel:           gitlab.Ptr(pushLevel),
+			MergeAccessLevel:          gitlab.Ptr(mergeLevel),
+			AllowForcePush:            gitlab.Ptr(o.allowForcePush),
+			CodeOwnerApprovalRequired: gitlab.Ptr(o.codeOwnerApproval),
+		})
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to protect branch %s.", o.name))
+		}
+		o.printRule("Protected", current)
+		return nil
+	case err != nil:
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to get the protection of branch %s.", o.name))
+	}
+
+	updateOpts := &gitlab.UpdateProtectedBranchOptions{}
+	if o.changed("push-access-level") {
+		updateOpts.AllowedToPush = gitlab.Ptr(branchutils.ReplaceLevels(current.PushAccessLevels, pushLevel))
+	}
+	if o.changed("merge-access-level") {
+		updateOpts.AllowedToMerge = gitlab.Ptr(branchutils.ReplaceLevels(current.MergeAccessLevels, mergeLevel))
+	}
+	if o.changed("allow-force-push") {
+		updateOpts.AllowForcePush = gitlab.Ptr(o.allowForcePush)
+	}
+	if o.changed("code-owner-approval") {
+		updateOpts.CodeOwnerApprovalRequired = gitlab.Ptr(o.codeOwnerApproval)
+	}
+	if *updateOpts == (gitlab.UpdateProtectedBranchOptions{}) {
+		o.printRule("Branch is already protected:", current)
+		return nil
+	}
+
+	updated, _, err := client.ProtectedBranches.UpdateProtectedBranch(repo.FullName(), o.name, updateOpts)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to update the protection of branch %s.", o.name))
+	}
+	o.printRule("Updated the protection of", updated)
+	return nil
+}
+
+func (o *options) printRule(summary string, rule *gitlab.ProtectedBranch) {
+	c := o.io.Color()
+	forcePush, codeOwners := "not allowed", "not required"
+	if rule.AllowForcePush {
+		forcePush = "allowed"
+	}
+	if rule.CodeOwnerApprovalRequired {
+		codeOwners = "required"
+	}
+	fmt.Fprintf(o.io.StdOut, "%s %s %s\n", c.GreenCheck(), summary, c.Bold(rule.Name))
+	fmt.Fprintf(o.io.StdOut, "  Allowed to push: %s\n", branchutils.LevelNames(rule.PushAccessLevels))
+	fmt.Fprintf(o.io.StdOut, "  Allowed to merge: %s\n", branchutils.LevelNames(rule.MergeAccessLevels))
+	fmt.Fprintf(o.io.StdOut, "  Force push: %s\n", forcePush)
+	fmt.Fprintf(o.io.StdOut, "  Code owner approval: %s\n", codeOwners)
+}
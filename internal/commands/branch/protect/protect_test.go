@@ -0,0 +1,94 @@
+//go:build !integration
+
+package protect
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_Protect(t *testing.T) {
+	notFound := &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}
+	maintainers := []*gitlab.BranchAccessDescription{{ID: 1, AccessLevel: gitlab.MaintainerPermissions}}
+
+	t.Run("new protected branch", func(t *testing.T) {
+		testClient := gitlabtesting.NewTestClient(t)
+		testClient.MockProtectedBranches.EXPECT().GetProtectedBranch("OWNER/REPO", "release-*").Return(nil, notFound, gitlab.ErrNotFound)
+		testClient.MockProtectedBranches.EXPECT().ProtectRepositoryBranches("OWNER/REPO", &gitlab.ProtectRepositoryBranchesOptions{
+			Name:                      gitlab.Ptr("release-*"),
+			PushAccessLevel:           gitlab.Ptr(gitlab.NoPermissions),
+			MergeAccessLevel:          gitlab.Ptr(gitlab.MaintainerPermissions),
+			AllowForcePush:            gitlab.Ptr(false),
+			CodeOwnerApprovalRequired: gitlab.Ptr(true),
+		}).Return(&gitlab.ProtectedBranch{
+			Name:                      "release-*",
+			PushAccessLevels:          []*gitlab.BranchAccessDescription{{AccessLevel: gitlab.NoPermissions}},
+			MergeAccessLevels:         maintainers,
+			CodeOwnerApprovalRequired: true,
+		}, nil, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdProtect, false, cmdtest.WithGitLabClient(testClient.Client))
+
+		out, err := exec("release-* --push-access-level no-one --code-owner-approval")
+		require.NoError(t, err)
+		assert.Equal(t, "✓ Protected release-*\n"+
+			"  Allowed to push: no-one\n"+
+			"  Allowed to merge: maintainer\n"+
+			"  Force push: not allowed\n"+
+			"  Code owner approval: required\n", out.String())
+	})
+
+	t.Run("update the given settings only", func(t *testing.T) {
+		testClient := gitlabtesting.NewTestClient(t)
+		testClient.MockProtectedBranches.EXPECT().GetProtectedBranch("OWNER/REPO", "main").Return(&gitlab.ProtectedBranch{
+			Name:              "main",
+			PushAccessLevels:  maintainers,
+			MergeAccessLevels: maintainers,
+		}, nil, nil)
+		testClient.MockProtectedBranches.EXPECT().UpdateProtectedBranch("OWNER/REPO", "main", &gitlab.UpdateProtectedBranchOptions{
+			AllowedToMerge: gitlab.Ptr([]*gitlab.BranchPermissionOptions{
+				{ID: gitlab.Ptr(int64(1)), Destroy: gitlab.Ptr(true)},
+				{AccessLevel: gitlab.Ptr(gitlab.DeveloperPermissions)},
+			}),
+			AllowForcePush: gitlab.Ptr(true),
+		}).Return(&gitlab.ProtectedBranch{
+			Name:              "main",
+			PushAccessLevels:  maintainers,
+			MergeAccessLevels: []*gitlab.BranchAccessDescription{{ID: 2, AccessLevel: gitlab.DeveloperPermissions}},
+			AllowForcePush:    true,
+		}, nil, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdProtect, false, cmdtest.WithGitLabClient(testClient.Client))
+
+		out, err := exec("main --merge-access-level developer --allow-force-push")
+		require.NoError(t, err)
+		assert.Equal(t, "✓ Updated the protection of main\n"+
+			"  Allowed to push: maintainer\n"+
+			"  Allowed to merge: developer\n"+
+			"  Force push: allowed\n"+
+			"  Code owner approval: not required\n", out.String())
+	})
+
+	t.Run("already protected", func(t *testing.T) {
+		testClient := gitlabtesting.NewTestClient(t)
+		testClient.MockProtectedBranches.EXPECT().GetProtectedBranch("OWNER/REPO", "main").Return(&gitlab.ProtectedBranch{
+			Name:              "main",
+			PushAccessLevels:  maintainers,
+			MergeAccessLevels: maintainers,
+		}, nil, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdProtect, false, cmdtest.WithGitLabClient(testClient.Client))
+
+		out, err := exec("main")
+		require.NoError(t, err)
+		assert.Contains(t, out.String(), "✓ Branch is already protected: main\n")
+	})
+}
@@ -0,0 +1,67 @@
+package unprotect
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	name string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdUnprotect(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	branchUnprotectCmd := &cobra.Command{
+		Use:   "unprotect <name>",
+		Short: `Remove the protection of a branch.`,
+		Args:  cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ glab branch unprotect feature-x
+			$ glab branch unprotect 'release-*'
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.name = args[0]
+			return opts.run()
+		},
+	}
+
+	return branchUnprotectCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.ProtectedBranches.UnprotectRepositoryBranches(repo.FullName(), o.name); err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to unprotect branch %s.", o.name))
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Unprotected branch %s\n", o.io.Color().RedCheck(), o.name)
+	return nil
+}
@@ -0,0 +1,25 @@
+//go:build !integration
+
+package unprotect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_Unprotect(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockProtectedBranches.EXPECT().UnprotectRepositoryBranches("OWNER/REPO", "release-*").Return(nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdUnprotect, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	out, err := exec("release-*")
+	require.NoError(t, err)
+	assert.Equal(t, "✓ Unprotected branch release-*\n", out.String())
+}
@@ -10,17 +10,22 @@ import (
 )
 
 func NewCmdRun(f cmdutils.Factory) *cobra.Command {
+	opts := &jobArtifact.DownloadOptions{}
+
 	jobArtifactCmd := &cobra.Command{
-		Use:     "artifact <refName> <jobName> [flags]",
+		Use:     "artifact <refName> [<jobName>] [flags]",
 		Short:   `Download all artifacts from the last pipeline.`,
 		Aliases: []string{"push"},
 		Example: heredoc.Doc(`
 			# Download all artifacts from the main branch and build job
 			$ glab ci artifact main build
 			$ glab ci artifact main deploy --path="artifacts/"
+			$ glab ci artifact main build --include "dist/**"
+			$ glab ci artifact main --job-glob "build-*"
+			$ glab ci artifact main test --print reports/junit.xml
 		`),
 		Long: ``,
-		Args: cobra.ExactArgs(2),
+		Args: jobArtifact.ValidateArgs(opts),
 		Annotations: map[string]string{
 			mcpannotations.Safe: "true",
 		},
@@ -33,16 +38,20 @@ func NewCmdRun(f cmdutils.Factory) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			path, err := cmd.Flags().GetString("path")
-			if err != nil {
-				return err
-			}
 
-			return jobArtifact.DownloadArtifacts(client, repo, path, false, args[0], args[1])
+			opts.Out = f.IO().StdOut
+			jobName := ""
+			if len(args) > 1 {
+				jobName = args[1]
+			}
+			return jobArtifact.DownloadArtifacts(client, repo, args[0], jobName, opts)
 		},
 		Deprecated: "use 'glab job artifact' instead.",
 	}
-	jobArtifactCmd.Flags().StringP("path", "p", "./", "Path to download the artifact files.")
+	jobArtifactCmd.Flags().StringVarP(&opts.Path, "path", "p", "./", "Path to download the artifact files.")
+	jobArtifact.AddDownloadFlags(jobArtifactCmd.Flags(), opts)
+	jobArtifactCmd.MarkFlagsMutuallyExclusive("print", "job-glob")
+	jobArtifactCmd.MarkFlagsMutuallyExclusive("print", "include")
 
 	return jobArtifactCmd
 }
@@ -0,0 +1,18 @@
+package artifacts
+
+import (
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	artifactsDiffCmd "gitlab.com/gitlab-org/cli/internal/commands/ci/artifacts/diff"
+)
+
+func NewCmdArtifacts(f cmdutils.Factory) *cobra.Command {
+	artifactsCmd := &cobra.Command{
+		Use:   "artifacts <command> [flags]",
+		Short: `Work with the artifacts of CI/CD pipelines.`,
+		Long:  ``,
+	}
+	artifactsCmd.AddCommand(artifactsDiffCmd.NewCmdDiff(f))
+	return artifactsCmd
+}
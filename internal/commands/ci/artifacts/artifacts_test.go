@@ -0,0 +1,24 @@
+//go:build !integration
+
+package artifacts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestNewCmdArtifacts(t *testing.T) {
+	ios, _, _, _ := cmdtest.TestIOStreams()
+	cmd := NewCmdArtifacts(cmdtest.NewTestFactory(ios))
+
+	assert.Equal(t, "artifacts <command> [flags]", cmd.Use)
+
+	subcommandNames := make([]string, 0, len(cmd.Commands()))
+	for _, subcmd := range cmd.Commands() {
+		subcommandNames = append(subcommandNames, subcmd.Name())
+	}
+	assert.ElementsMatch(t, []string{"diff"}, subcommandNames)
+}
@@ -0,0 +1,264 @@
+package diff
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+const (
+	// Read limit is 4GB, like for 'glab job artifact'.
+	zipReadLimit int64 = 4 * 1024 * 1024 * 1024
+	zipFileLimit int   = 100000
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	pipelines   [2]int64
+	job         string
+	stat        bool
+	maxTextSize int64
+}
+
+// artifactFile is a file of an artifacts archive.
+type artifactFile struct {
+	Size   int64
+	SHA256 string
+	// Text is the content of small text files, which are diffed line by line.
+	Text   string
+	IsText bool
+}
+
+func NewCmdDiff(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	diffCmd := &cobra.Command{
+		Use:   "diff <pipeline-a> <pipeline-b> --job <name> [flags]",
+		Short: `Compare the artifacts of a job in two pipelines.`,
+		Long: heredoc.Doc(`
+			Compare the artifacts of a job in two pipelines, to debug builds that
+			aren't reproducible.
+
+			The artifacts archives of the job are downloaded from both pipelines, and
+			their files are compared by size and SHA-256 checksum. For small text files
+			that changed, a line diff is shown too.
+
+			The command exits with status 1 when the artifacts differ.
+		`),
+		Args: cobra.ExactArgs(2),
+		Example: heredoc.Doc(`
+			# Compare the artifacts of the build job in pipelines 1234 and 1240
+			$ glab ci artifacts diff 1234 1240 --job build
+
+			# Only list the files that differ
+			$ glab ci artifacts diff 1234 1240 --job build --stat
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			for i, arg := range args {
+				id, err := strconv.ParseInt(arg, 10, 64)
+				if err != nil || id <= 0 {
+					return &cmdutils.FlagError{Err: fmt.Errorf("invalid pipeline ID %q.", arg)}
+				}
+				opts.pipelines[i] = id
+			}
+			if opts.maxTextSize < 0 {
+				return &cmdutils.FlagError{Err: errors.New("--max-text-size can't be negative.")}
+			}
+			return opts.run()
+		},
+	}
+
+	diffCmd.Flags().StringVarP(&opts.job, "job", "j", "", "The name of the job to compare the artifacts of.")
+	diffCmd.Flags().BoolVar(&opts.stat, "stat", false, "Only list the files that differ, without line diffs.")
+	diffCmd.Flags().Int64Var(&opts.maxTextSize, "max-text-size", 64*1024, "Show line diffs of text files up to this size in bytes. Use 0 to never show them.")
+	_ = diffCmd.MarkFlagRequired("job")
+
+	return diffCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	var archives [2]map[string]*artifactFile
+	for i, pipelineID := range o.pipelines {
+		job, err := findJob(client, repo.FullName(), pipelineID, o.job)
+		if err != nil {
+			return err
+		}
+		artifact, _, err := client.Jobs.GetJobArtifacts(repo.FullName(), job.ID)
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to download the artifacts of job %d.", job.ID))
+		}
+		archives[i], err = readArchive(artifact, o.maxTextSize)
+		if err != nil {
+			return fmt.Errorf("could not read the artifacts of job %d: %w", job.ID, err)
+		}
+	}
+
+	c := o.io.Color()
+	a, b := archives[0], archives[1]
+	names := make([]string, 0, len(a)+len(b))
+	for name := range a {
+		names = append(names, name)
+	}
+	for name := range b {
+		if _, ok := a[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	fmt.Fprintf(o.io.StdOut, "Comparing the artifacts of job %s in pipelines %d and %d.\n\n", o.job, o.pipelines[0], o.pipelines[1])
+
+	var added, removed, changed, unchanged int
+	var changedNames []string
+	for _, name := range names {
+		fa, fb := a[name], b[name]
+		switch {
+		case fb == nil:
+			removed++
+			fmt.Fprintf(o.io.StdOut, "%s %s (%d bytes)\n", c.Red("-"), name, fa.Size)
+		case fa == nil:
+			added++
+			fmt.Fprintf(o.io.StdOut, "%s %s (%d bytes)\n", c.Green("+"), name, fb.Size)
+		case fa.SHA256 != fb.SHA256:
+			changed++
+			changedNames = append(changedNames, name)
+			fmt.Fprintf(o.io.StdOut, "%s %s (%d -> %d bytes, sha256 %s -> %s)\n", c.Yellow("~"), name, fa.Size, fb.Size, fa.SHA256[:12], fb.SHA256[:12])
+		default:
+			unchanged++
+		}
+	}
+
+	if !o.stat {
+		for _, name := range changedNames {
+			fa, fb := a[name], b[name]
+			if !fa.IsText || !fb.IsText {
+				continue
+			}
+			fmt.Fprintf(o.io.StdOut, "\n%s\n%s\n", c.Bold("--- a/"+name), c.Bold("+++ b/"+name))
+			for _, line := range unifiedDiff(fa.Text, fb.Text) {
+				switch line[0] {
+				case '-':
+					line = c.Red(line)
+				case '+':
+					line = c.Green(line)
+				case '@':
+					line = c.Cyan(line)
+				}
+				fmt.Fprintln(o.io.StdOut, line)
+			}
+		}
+	}
+
+	if added+removed+changed == 0 {
+		fmt.Fprintf(o.io.StdOut, "%s The artifacts are identical: %s.\n", c.GreenCheck(), utils.Pluralize(unchanged, "file"))
+		return nil
+	}
+	fmt.Fprintf(o.io.StdOut, "\n%d changed, %d added, %d removed, %d unchanged.\n", changed, added, removed, unchanged)
+	return cmdutils.SilentError
+}
+
+// findJob returns the job of a pipeline with the name, which has artifacts. When a
+// job was retried, the latest attempt is used.
+func findJob(client *gitlab.Client, repo string, pipelineID int64, name string) (*gitlab.Job, error) {
+	jobs, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Job, *gitlab.Response, error) {
+		return client.Jobs.ListPipelineJobs(repo, pipelineID, &gitlab.ListJobsOptions{ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage}}, p)
+	})
+	if err != nil {
+		return nil, cmdutils.WrapError(err, fmt.Sprintf("failed to list the jobs of pipeline %d.", pipelineID))
+	}
+	for _, job := range jobs {
+		if job.Name != name {
+			continue
+		}
+		if job.ArtifactsFile.Filename == "" {
+			return nil, fmt.Errorf("job %s of pipeline %d has no artifacts.", name, pipelineID)
+		}
+		return job, nil
+	}
+	return nil, fmt.Errorf("pipeline %d has no job named %s.", pipelineID, name)
+}
+
+// readArchive reads the files of an artifacts archive, and keeps the content of
+// the text files up to maxTextSize bytes.
+func readArchive(artifact *bytes.Reader, maxTextSize int64) (map[string]*artifactFile, error) {
+	zipReader, err := zip.NewReader(artifact, artifact.Size())
+	if err != nil {
+		return nil, err
+	}
+	if len(zipReader.File) > zipFileLimit {
+		return nil, fmt.Errorf("zip archive includes too many files: limit is %d files", zipFileLimit)
+	}
+
+	files := make(map[string]*artifactFile, len(zipReader.File))
+	var read int64
+	for _, v := range zipReader.File {
+		if v.FileInfo().IsDir() {
+			continue
+		}
+		rc, err := v.Open()
+		if err != nil {
+			return nil, err
+		}
+		hash := sha256.New()
+		var content bytes.Buffer
+		w := io.Writer(hash)
+		keep := int64(v.UncompressedSize64) <= maxTextSize
+		if keep {
+			w = io.MultiWriter(hash, &content)
+		}
+		n, err := io.Copy(w, io.LimitReader(rc, zipReadLimit-read))
+		rc.Close()
+		if err != nil {
+			return nil, err
+		}
+		read += n
+		if read >= zipReadLimit {
+			return nil, fmt.Errorf("zip archive is too large: limit is %d bytes", zipReadLimit)
+		}
+
+		file := &artifactFile{Size: n, SHA256: hex.EncodeToString(hash.Sum(nil))}
+		if keep && utf8.Valid(content.Bytes()) && !bytes.ContainsRune(content.Bytes(), 0) {
+			file.Text, file.IsText = content.String(), true
+		}
+		files[v.Name] = file
+	}
+	return files, nil
+}
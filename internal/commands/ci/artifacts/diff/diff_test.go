@@ -0,0 +1,147 @@
+//go:build !integration
+
+package diff
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func zipArchive(t *testing.T, files map[string]string) *bytes.Reader {
+	t.Helper()
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, content := range files {
+		f, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return bytes.NewReader(buf.Bytes())
+}
+
+func TestDiff(t *testing.T) {
+	artifactsA := map[string]string{
+		"dist/app.js":     "console.log(1)\n",
+		"dist/version":    "1.0.0\nbuilt: monday\nby: ci\n",
+		"dist/removed.md": "gone\n",
+		"dist/logo.png":   "\x89PNG\x00\x01",
+	}
+	artifactsB := map[string]string{
+		"dist/app.js":   "console.log(1)\n",
+		"dist/version":  "1.0.0\nbuilt: tuesday\nby: ci\n",
+		"dist/added.md": "new\n",
+		"dist/logo.png": "\x89PNG\x00\x02",
+	}
+
+	testCases := []struct {
+		name      string
+		cli       string
+		a, b      map[string]string
+		wantOut   string
+		wantError error
+	}{
+		{
+			name: "different artifacts",
+			cli:  "100 200 --job build",
+			a:    artifactsA,
+			b:    artifactsB,
+			wantOut: heredoc.Doc(`
+				Comparing the artifacts of job build in pipelines 100 and 200.
+
+				+ dist/added.md (4 bytes)
+				~ dist/logo.png (6 -> 6 bytes, sha256 09824c6bec84 -> 19ed57bd9488)
+				- dist/removed.md (5 bytes)
+				~ dist/version (27 -> 28 bytes, sha256 e6c0998a7a6a -> 3ed0489f0e45)
+
+				--- a/dist/version
+				+++ b/dist/version
+				@@ -1,3 +1,3 @@
+				 1.0.0
+				-built: monday
+				+built: tuesday
+				 by: ci
+
+				2 changed, 1 added, 1 removed, 1 unchanged.
+			`),
+			wantError: cmdutils.SilentError,
+		},
+		{
+			name:      "stat only",
+			cli:       "100 200 --job build --stat",
+			a:         artifactsA,
+			b:         artifactsB,
+			wantError: cmdutils.SilentError,
+		},
+		{
+			name:    "identical artifacts",
+			cli:     "100 200 -j build",
+			a:       artifactsA,
+			b:       artifactsA,
+			wantOut: "Comparing the artifacts of job build in pipelines 100 and 200.\n\n✓ The artifacts are identical: 4 files.\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			for _, p := range []struct {
+				pipeline, job int64
+				files         map[string]string
+			}{{100, 1, tc.a}, {200, 2, tc.b}} {
+				testClient.MockJobs.EXPECT().ListPipelineJobs("OWNER/REPO", p.pipeline, gomock.Any(), gomock.Any()).Return([]*gitlab.Job{
+					{ID: p.job + 10, Name: "test"},
+					{ID: p.job, Name: "build", ArtifactsFile: gitlab.JobArtifactsFile{Filename: "artifacts.zip"}},
+				}, &gitlab.Response{}, nil)
+				testClient.MockJobs.EXPECT().GetJobArtifacts("OWNER/REPO", p.job).Return(zipArchive(t, p.files), nil, nil)
+			}
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdDiff, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			out, err := exec(tc.cli)
+			if tc.wantError != nil {
+				require.ErrorIs(t, err, tc.wantError)
+			} else {
+				require.NoError(t, err)
+			}
+			if tc.wantOut != "" {
+				assert.Equal(t, tc.wantOut, out.String())
+			}
+			if tc.name == "stat only" {
+				assert.Contains(t, out.String(), "~ dist/version")
+				assert.NotContains(t, out.String(), "+++ b/dist/version")
+			}
+		})
+	}
+}
+
+func TestDiff_JobWithoutArtifacts(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockJobs.EXPECT().ListPipelineJobs("OWNER/REPO", int64(100), gomock.Any(), gomock.Any()).Return([]*gitlab.Job{
+		{ID: 1, Name: "build"},
+	}, &gitlab.Response{}, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdDiff, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	_, err := exec("100 200 --job build")
+	assert.EqualError(t, err, "job build of pipeline 100 has no artifacts.")
+
+	_, err = exec("100 main --job build")
+	var flagErr *cmdutils.FlagError
+	assert.True(t, errors.As(err, &flagErr))
+}
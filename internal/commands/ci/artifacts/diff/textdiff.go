@@ -0,0 +1,144 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// maxDiffCells bounds the size of the table used to compare two files line
+	// by line. Longer files are shown as entirely replaced.
+	maxDiffCells = 4_000_000
+	// diffContext is the number of unchanged lines shown around changes.
+	diffContext = 3
+)
+
+type lineOp struct {
+	// Op is ' ' for an unchanged line, '-' for a removed line, and '+' for an added line.
+	Op   byte
+	Line string
+	// A and B are the indexes of the line in the old and the new file.
+	A, B int
+}
+
+// unifiedDiff compares two texts line by line, and returns the hunks of a
+// unified diff, without the file headers.
+func unifiedDiff(oldText, newText string) []string {
+	ops := lineDiff(splitLines(oldText), splitLines(newText))
+
+	var out []string
+	for start := 0; start < len(ops); {
+		// Find the next change, and the end of its hunk, where changes are
+		// separated by more than twice the context.
+		first := start
+		for first < len(ops) && ops[first].Op == ' ' {
+			first++
+		}
+		if first == len(ops) {
+			break
+		}
+		last := first
+		for i := first; i < len(ops) && i <= last+2*diffContext; i++ {
+			if ops[i].Op != ' ' {
+				last = i
+			}
+		}
+		from := max(first-diffContext, start)
+		to := min(last+diffContext+1, len(ops))
+
+		var aStart, aLen, bStart, bLen int
+		aStart, bStart = -1, -1
+		var lines []string
+		for _, op := range ops[from:to] {
+			if op.Op != '+' {
+				if aStart < 0 {
+					aStart = op.A
+				}
+				aLen++
+			}
+			if op.Op != '-' {
+				if bStart < 0 {
+					bStart = op.B
+				}
+				bLen++
+			}
+			lines = append(lines, string(op.Op)+op.Line)
+		}
+		out = append(out, fmt.Sprintf("@@ -%s +%s @@", hunkRange(aStart, aLen, ops[from].A), hunkRange(bStart, bLen, ops[from].B)))
+		out = append(out, lines...)
+		start = to
+	}
+	return out
+}
+
+// hunkRange formats the start and length of a hunk, with lines numbered from 1.
+// Empty ranges start at the line before, like in diff -u.
+func hunkRange(start, length, fallback int) string {
+	if length == 0 {
+		return fmt.Sprintf("%d,0", fallback)
+	}
+	if length == 1 {
+		return fmt.Sprint(start + 1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, length)
+}
+
+// lineDiff returns the edit script between two lists of lines, from their
+// longest common subsequence.
+func lineDiff(a, b []string) []lineOp {
+	var ops []lineOp
+	if (len(a)+1)*(len(b)+1) > maxDiffCells {
+		for i, line := range a {
+			ops = append(ops, lineOp{'-', line, i, 0})
+		}
+		for j, line := range b {
+			ops = append(ops, lineOp{'+', line, len(a), j})
+		}
+		return ops
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, lineOp{' ', a[i], i, j})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, lineOp{'-', a[i], i, j})
+			i++
+		default:
+			ops = append(ops, lineOp{'+', b[j], i, j})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		ops = append(ops, lineOp{'-', a[i], i, j})
+	}
+	for ; j < len(b); j++ {
+		ops = append(ops, lineOp{'+', b[j], i, j})
+	}
+	return ops
+}
+
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
@@ -0,0 +1,32 @@
+//go:build !integration
+
+package diff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	oldText := "a\nb\nc\nd\ne\nf\ng\nh\ni\nj\nk\nl\n"
+	newText := "a\nB\nc\nd\ne\nf\ng\nh\ni\nj\nk\nl\nm\n"
+
+	assert.Equal(t, []string{
+		"@@ -1,5 +1,5 @@",
+		" a",
+		"-b",
+		"+B",
+		" c",
+		" d",
+		" e",
+		"@@ -10,3 +10,4 @@",
+		" j",
+		" k",
+		" l",
+		"+m",
+	}, unifiedDiff(oldText, newText))
+
+	assert.Equal(t, []string{"@@ -0,0 +1 @@", "+new"}, unifiedDiff("", "new\n"))
+	assert.Empty(t, unifiedDiff("same\n", "same\n"))
+}
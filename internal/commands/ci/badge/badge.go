@@ -0,0 +1,175 @@
+package badge
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+// badgeTypes are the badges that GitLab generates for every project, in the order they are printed.
+var badgeTypes = []string{"pipeline", "coverage", "release"}
+
+type options struct {
+	branch string
+	badges []string
+	format string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+type badge struct {
+	alt      string
+	imageURL string
+	linkURL  string
+}
+
+func NewCmdBadge(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	ciBadgeCmd := &cobra.Command{
+		Use:   "badge [flags]",
+		Short: `Print the badges of a project, ready to embed in a README.`,
+		Long: heredoc.Docf(`
+			Print Markdown or HTML for the pipeline status, test coverage, and latest release
+			badges of a project.
+
+			The badges are checked before they are printed, and a warning is shown when a badge
+			would be empty: when the branch has no pipeline, when its latest pipeline reports no
+			coverage because no job sets the %[1]scoverage%[1]s keyword, or when the project has
+			no releases.
+		`, "`"),
+		Args: cobra.NoArgs,
+		Example: heredoc.Doc(`
+			# Print all badges for the default branch
+			$ glab ci badge
+
+			# Print the pipeline and coverage badges of a branch as HTML
+			$ glab ci badge --branch develop --badge pipeline,coverage --format html
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			for _, b := range opts.badges {
+				if !slices.Contains(badgeTypes, b) {
+					return &cmdutils.FlagError{Err: fmt.Errorf("invalid badge %q. Must be one of: %s.", b, strings.Join(badgeTypes, ", "))}
+				}
+			}
+			return opts.run()
+		},
+	}
+
+	fl := ciBadgeCmd.Flags()
+	fl.StringVarP(&opts.branch, "branch", "b", "", "Branch to show the badges of. Defaults to the default branch of the project.")
+	fl.StringSliceVar(&opts.badges, "badge", badgeTypes, fmt.Sprintf("Comma-separated list of badges to print: %s.", strings.Join(badgeTypes, ", ")))
+	fl.VarP(cmdutils.NewEnumValue([]string{"markdown", "html"}, "markdown", &opts.format), "format", "f", "Format of the badges: markdown, html.")
+
+	return ciBadgeCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	project, err := api.GetProject(client, repo.FullName())
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to get the project.")
+	}
+	if o.branch == "" {
+		o.branch = project.DefaultBranch
+	}
+
+	var warnings []string
+	var pipeline *gitlab.Pipeline
+	if o.wants("pipeline") || o.wants("coverage") {
+		pipeline, _, err = client.Pipelines.GetLatestPipeline(project.ID, &gitlab.GetLatestPipelineOptions{Ref: gitlab.Ptr(o.branch)})
+		if err != nil && !errors.Is(err, gitlab.ErrNotFound) {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to get the latest pipeline of %s.", o.branch))
+		}
+		if pipeline == nil {
+			warnings = append(warnings, fmt.Sprintf("%s has no pipelines, so the pipeline badge shows 'unknown'.", o.branch))
+		}
+	}
+
+	var badges []badge
+	for _, b := range badgeTypes {
+		if !o.wants(b) {
+			continue
+		}
+		switch b {
+		case "pipeline":
+			badges = append(badges, badge{
+				alt:      "pipeline status",
+				imageURL: fmt.Sprintf("%s/badges/%s/pipeline.svg", project.WebURL, o.branch),
+				linkURL:  fmt.Sprintf("%s/-/commits/%s", project.WebURL, o.branch),
+			})
+		case "coverage":
+			if pipeline != nil && pipeline.Coverage == "" {
+				warnings = append(warnings, fmt.Sprintf("The latest pipeline of %s reports no coverage. Set the coverage keyword of a job in .gitlab-ci.yml.", o.branch))
+			}
+			badges = append(badges, badge{
+				alt:      "coverage report",
+				imageURL: fmt.Sprintf("%s/badges/%s/coverage.svg", project.WebURL, o.branch),
+				linkURL:  fmt.Sprintf("%s/-/commits/%s", project.WebURL, o.branch),
+			})
+		case "release":
+			releases, _, err := client.Releases.ListReleases(project.ID, &gitlab.ListReleasesOptions{ListOptions: gitlab.ListOptions{PerPage: 1}})
+			if err != nil {
+				return cmdutils.WrapError(err, "failed to list releases.")
+			}
+			if len(releases) == 0 {
+				warnings = append(warnings, "The project has no releases, so the release badge shows 'none'.")
+			}
+			badges = append(badges, badge{
+				alt:      "latest release",
+				imageURL: fmt.Sprintf("%s/-/badges/release.svg", project.WebURL),
+				linkURL:  fmt.Sprintf("%s/-/releases", project.WebURL),
+			})
+		}
+	}
+
+	for _, b := range badges {
+		fmt.Fprintln(o.io.StdOut, b.render(o.format))
+	}
+
+	c := o.io.Color()
+	for _, w := range warnings {
+		fmt.Fprintf(o.io.StdErr, "%s %s\n", c.WarnIcon(), w)
+	}
+	return nil
+}
+
+func (o *options) wants(b string) bool {
+	return slices.Contains(o.badges, b)
+}
+
+func (b badge) render(format string) string {
+	if format == "html" {
+		return fmt.Sprintf(`<a href="%s"><img alt="%s" src="%s" /></a>`, b.linkURL, b.alt, b.imageURL)
+	}
+	return fmt.Sprintf("[![%s](%s)](%s)", b.alt, b.imageURL, b.linkURL)
+}
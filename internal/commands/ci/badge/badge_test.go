@@ -0,0 +1,93 @@
+//go:build !integration
+
+package badge
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestCIBadge(t *testing.T) {
+	project := &gitlab.Project{ID: 5, DefaultBranch: "main", WebURL: "https://gitlab.com/OWNER/REPO"}
+
+	tests := []struct {
+		name       string
+		cli        string
+		setupMock  func(tc *gitlabtesting.TestClient)
+		wantOut    string
+		wantStderr string
+		wantErr    string
+	}{
+		{
+			name: "All badges for the default branch",
+			cli:  "",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockPipelines.EXPECT().GetLatestPipeline(int64(5), &gitlab.GetLatestPipelineOptions{Ref: gitlab.Ptr("main")}).
+					Return(&gitlab.Pipeline{ID: 1, Coverage: "87.5"}, nil, nil)
+				tc.MockReleases.EXPECT().ListReleases(int64(5), gomock.Any()).Return([]*gitlab.Release{{TagName: "v1.0.0"}}, nil, nil)
+			},
+			wantOut: "[![pipeline status](https://gitlab.com/OWNER/REPO/badges/main/pipeline.svg)](https://gitlab.com/OWNER/REPO/-/commits/main)\n" +
+				"[![coverage report](https://gitlab.com/OWNER/REPO/badges/main/coverage.svg)](https://gitlab.com/OWNER/REPO/-/commits/main)\n" +
+				"[![latest release](https://gitlab.com/OWNER/REPO/-/badges/release.svg)](https://gitlab.com/OWNER/REPO/-/releases)\n",
+		},
+		{
+			name: "Warns about missing data",
+			cli:  "--branch develop",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockPipelines.EXPECT().GetLatestPipeline(int64(5), &gitlab.GetLatestPipelineOptions{Ref: gitlab.Ptr("develop")}).
+					Return(&gitlab.Pipeline{ID: 1}, nil, nil)
+				tc.MockReleases.EXPECT().ListReleases(int64(5), gomock.Any()).Return(nil, nil, nil)
+			},
+			wantOut: "[![pipeline status](https://gitlab.com/OWNER/REPO/badges/develop/pipeline.svg)](https://gitlab.com/OWNER/REPO/-/commits/develop)\n" +
+				"[![coverage report](https://gitlab.com/OWNER/REPO/badges/develop/coverage.svg)](https://gitlab.com/OWNER/REPO/-/commits/develop)\n" +
+				"[![latest release](https://gitlab.com/OWNER/REPO/-/badges/release.svg)](https://gitlab.com/OWNER/REPO/-/releases)\n",
+			wantStderr: "! The latest pipeline of develop reports no coverage. Set the coverage keyword of a job in .gitlab-ci.yml.\n" +
+				"! The project has no releases, so the release badge shows 'none'.\n",
+		},
+		{
+			name: "Pipeline badge as HTML for a branch without pipelines",
+			cli:  "--badge pipeline --format html",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockPipelines.EXPECT().GetLatestPipeline(int64(5), gomock.Any()).
+					Return(nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, gitlab.ErrNotFound)
+			},
+			wantOut:    `<a href="https://gitlab.com/OWNER/REPO/-/commits/main"><img alt="pipeline status" src="https://gitlab.com/OWNER/REPO/badges/main/pipeline.svg" /></a>` + "\n",
+			wantStderr: "! main has no pipelines, so the pipeline badge shows 'unknown'.\n",
+		},
+		{
+			name:    "Invalid badge",
+			cli:     "--badge license",
+			wantErr: `invalid badge "license". Must be one of: pipeline, coverage, release.`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tc := gitlabtesting.NewTestClient(t)
+			tc.MockProjects.EXPECT().GetProject("OWNER/REPO", gomock.Any()).Return(project, nil, nil).AnyTimes()
+			if tt.setupMock != nil {
+				tt.setupMock(tc)
+			}
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdBadge, false, cmdtest.WithGitLabClient(tc.Client))
+
+			out, err := exec(tt.cli)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOut, out.String())
+			assert.Equal(t, tt.wantStderr, out.Stderr())
+		})
+	}
+}
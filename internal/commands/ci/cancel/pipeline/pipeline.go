@@ -3,6 +3,7 @@ package pipeline
 import (
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/spf13/cobra"
@@ -15,10 +16,14 @@ import (
 	"gitlab.com/gitlab-org/cli/internal/glrepo"
 	"gitlab.com/gitlab-org/cli/internal/iostreams"
 	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+	"gitlab.com/gitlab-org/cli/internal/utils"
 )
 
 const (
-	FlagDryRun = "dry-run"
+	FlagDryRun    = "dry-run"
+	FlagOlderThan = "older-than"
+	FlagBranch    = "branch"
 )
 
 func NewCmdCancel(f cmdutils.Factory) *cobra.Command {
@@ -30,9 +35,27 @@ func NewCmdCancel(f cmdutils.Factory) *cobra.Command {
 			$ glab ci cancel pipeline 1504182795,1504182796
 			$ glab ci cancel pipeline "1504182795 1504182796"
 			$ glab ci cancel pipeline 1504182795,1504182796 --dry-run
+
+			# Cancel the running pipelines of the main branch that started more than 2 hours ago
+			$ glab ci cancel pipeline --older-than 2h --branch main
+
+			# List the running pipelines of the current branch that started more than 30 minutes ago
+			$ glab ci cancel pipeline --older-than 30m --dry-run
+		`),
+		Long: heredoc.Doc(`
+			Cancel CI/CD pipelines.
+
+			With --older-than, cancel every running or pending pipeline of a branch that
+			was created longer ago than the duration, instead of passing pipeline IDs.
 		`),
-		Long: ``,
 		Args: func(cmd *cobra.Command, args []string) error {
+			olderThan, _ := cmd.Flags().GetDuration(FlagOlderThan)
+			if olderThan > 0 {
+				if len(args) > 0 {
+					return fmt.Errorf("Pipeline IDs cannot be passed with --%s.", FlagOlderThan)
+				}
+				return nil
+			}
 			if len(args) < 1 {
 				return fmt.Errorf("You must pass a pipeline ID.")
 			}
@@ -56,6 +79,12 @@ func NewCmdCancel(f cmdutils.Factory) *cobra.Command {
 			}
 			dryRunMode, _ := cmd.Flags().GetBool(FlagDryRun)
 
+			if olderThan, _ := cmd.Flags().GetDuration(FlagOlderThan); olderThan > 0 {
+				branch, _ := cmd.Flags().GetString(FlagBranch)
+				branch = ciutils.GetBranch(branch, f.Branch, repo, client)
+				return cancelOlderThan(f.IO(), client, repo, branch, olderThan, dryRunMode)
+			}
+
 			var pipelineIDs []int
 
 			pipelineIDs, err = ciutils.IDsFromArgs(args)
@@ -72,6 +101,70 @@ func NewCmdCancel(f cmdutils.Factory) *cobra.Command {
 
 func SetupCommandFlags(flags *pflag.FlagSet) {
 	flags.BoolP(FlagDryRun, "", false, "Simulates process, but does not cancel anything.")
+	flags.Duration(FlagOlderThan, 0, "Cancel the running pipelines of the branch created longer ago than this duration, like 2h or 90m.")
+	flags.StringP(FlagBranch, "b", "", "The branch of the pipelines to cancel with --older-than. (default current branch)")
+}
+
+// cancelOlderThan cancels the running and pending pipelines of the branch that were
+// created before the duration.
+func cancelOlderThan(ios *iostreams.IOStreams, client *gitlab.Client, repo glrepo.Interface, branch string, olderThan time.Duration, dryRun bool) error {
+	c := ios.Color()
+	before := time.Now().Add(-olderThan)
+
+	var pipelines []*gitlab.PipelineInfo
+	for _, scope := range []string{"running", "pending"} {
+		opts := &gitlab.ListProjectPipelinesOptions{
+			ListOptions:   gitlab.ListOptions{PerPage: 100},
+			Scope:         gitlab.Ptr(scope),
+			Ref:           gitlab.Ptr(branch),
+			CreatedBefore: gitlab.Ptr(before),
+		}
+		found, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.PipelineInfo, *gitlab.Response, error) {
+			return client.Pipelines.ListProjectPipelines(repo.FullName(), opts, p)
+		})
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("could not list the %s pipelines.", scope))
+		}
+		pipelines = append(pipelines, found...)
+	}
+	if len(pipelines) == 0 {
+		fmt.Fprintf(ios.StdOut, "%s No running pipelines on %s older than %s.\n", c.GreenCheck(), branch, olderThan)
+		return nil
+	}
+
+	if dryRun {
+		table := tableprinter.New(ios)
+		table.AddHeader("ID", "Ref", "Status", "Created")
+		for _, pipeline := range pipelines {
+			created := ""
+			if pipeline.CreatedAt != nil {
+				created = utils.TimeToPrettyTimeAgo(*pipeline.CreatedAt)
+			}
+			table.AddRow(pipeline.ID, pipeline.Ref, pipeline.Status, created)
+		}
+		fmt.Fprintf(ios.StdOut, "%s %s would be canceled:\n\n", c.DotWarnIcon(), utils.Pluralize(len(pipelines), "pipeline"))
+		fmt.Fprint(ios.StdOut, table.Render())
+		return nil
+	}
+
+	errs := ciutils.RunBulkAction(pipelines, func(_ int, pipeline *gitlab.PipelineInfo) error {
+		_, _, err := client.Pipelines.CancelPipelineBuild(repo.FullName(), pipeline.ID)
+		return err
+	})
+
+	errCount := 0
+	for i, pipeline := range pipelines {
+		if errs[i] != nil {
+			errCount++
+			fmt.Fprintf(ios.StdErr, "%s Could not cancel pipeline #%d: %s\n", c.FailedIcon(), pipeline.ID, errs[i])
+			continue
+		}
+		fmt.Fprintf(ios.StdOut, "%s Pipeline #%d is canceled successfully.\n", c.RedCheck(), pipeline.ID)
+	}
+	if errCount > 0 {
+		return fmt.Errorf("%d of %d pipelines could not be canceled.", errCount, len(pipelines))
+	}
+	return nil
 }
 
 func runCancelation(
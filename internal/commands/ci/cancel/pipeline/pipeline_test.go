@@ -4,9 +4,14 @@ package pipeline
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
 
 	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
 )
@@ -36,3 +41,64 @@ func TestCIDryRunDeleteNothing(t *testing.T) {
 	assert.Contains(t, stdout, "Pipeline #22222222 will be canceled.")
 	assert.Empty(t, out.ErrBuf.String())
 }
+
+func TestCIPipelineCancelOlderThan(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Now().Add(-3 * time.Hour)
+	expectList := func(tc *gitlabtesting.TestClient) {
+		tc.MockPipelines.EXPECT().
+			ListProjectPipelines("OWNER/REPO", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(pid any, opts *gitlab.ListProjectPipelinesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.PipelineInfo, *gitlab.Response, error) {
+				assert.Equal(t, "main", *opts.Ref)
+				assert.WithinDuration(t, time.Now().Add(-2*time.Hour), *opts.CreatedBefore, time.Minute)
+				if *opts.Scope == "running" {
+					return []*gitlab.PipelineInfo{
+						{ID: 11, Ref: "main", Status: "running", CreatedAt: &createdAt},
+						{ID: 12, Ref: "main", Status: "running", CreatedAt: &createdAt},
+					}, &gitlab.Response{}, nil
+				}
+				return []*gitlab.PipelineInfo{}, &gitlab.Response{}, nil
+			}).
+			Times(2)
+	}
+
+	t.Run("cancels the old pipelines", func(t *testing.T) {
+		t.Parallel()
+
+		testClient := gitlabtesting.NewTestClient(t)
+		expectList(testClient)
+		testClient.MockPipelines.EXPECT().CancelPipelineBuild("OWNER/REPO", int64(11)).Return(&gitlab.Pipeline{}, nil, nil)
+		testClient.MockPipelines.EXPECT().CancelPipelineBuild("OWNER/REPO", int64(12)).Return(&gitlab.Pipeline{}, nil, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdCancel, false, cmdtest.WithGitLabClient(testClient.Client))
+
+		out, err := exec("--older-than 2h --branch main")
+		require.NoError(t, err)
+		assert.Contains(t, out.OutBuf.String(), "Pipeline #11 is canceled successfully.")
+		assert.Contains(t, out.OutBuf.String(), "Pipeline #12 is canceled successfully.")
+	})
+
+	t.Run("lists the old pipelines with --dry-run", func(t *testing.T) {
+		t.Parallel()
+
+		testClient := gitlabtesting.NewTestClient(t)
+		expectList(testClient)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdCancel, false, cmdtest.WithGitLabClient(testClient.Client))
+
+		out, err := exec("--older-than 2h --branch main --dry-run")
+		require.NoError(t, err)
+		assert.Contains(t, out.OutBuf.String(), "2 pipelines would be canceled:")
+		assert.Contains(t, out.OutBuf.String(), "11\tmain\trunning\tabout 3 hours ago")
+	})
+
+	t.Run("rejects pipeline IDs with --older-than", func(t *testing.T) {
+		t.Parallel()
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdCancel, false)
+
+		_, err := exec("11 --older-than 2h")
+		assert.EqualError(t, err, "Pipeline IDs cannot be passed with --older-than.")
+	})
+}
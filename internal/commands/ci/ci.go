@@ -8,16 +8,22 @@ import (
 
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
 	jobArtifactCmd "gitlab.com/gitlab-org/cli/internal/commands/ci/artifact"
+	ciArtifactsCmd "gitlab.com/gitlab-org/cli/internal/commands/ci/artifacts"
+	ciBadgeCmd "gitlab.com/gitlab-org/cli/internal/commands/ci/badge"
 	ciCancelCmd "gitlab.com/gitlab-org/cli/internal/commands/ci/cancel"
 	ciConfigCmd "gitlab.com/gitlab-org/cli/internal/commands/ci/config"
 	pipeDeleteCmd "gitlab.com/gitlab-org/cli/internal/commands/ci/delete"
+	ciEnvCmd "gitlab.com/gitlab-org/cli/internal/commands/ci/env"
+	ciFreezeCmd "gitlab.com/gitlab-org/cli/internal/commands/ci/freeze"
 	pipeGetCmd "gitlab.com/gitlab-org/cli/internal/commands/ci/get"
+	ciJobsCmd "gitlab.com/gitlab-org/cli/internal/commands/ci/jobs"
 	legacyCICmd "gitlab.com/gitlab-org/cli/internal/commands/ci/legacyci"
 	ciLintCmd "gitlab.com/gitlab-org/cli/internal/commands/ci/lint"
 	pipeListCmd "gitlab.com/gitlab-org/cli/internal/commands/ci/list"
 	pipeRetryCmd "gitlab.com/gitlab-org/cli/internal/commands/ci/retry"
 	pipeRunCmd "gitlab.com/gitlab-org/cli/internal/commands/ci/run"
 	pipeRunTrigCmd "gitlab.com/gitlab-org/cli/internal/commands/ci/run_trig"
+	ciSimulateCmd "gitlab.com/gitlab-org/cli/internal/commands/ci/simulate"
 	pipeStatusCmd "gitlab.com/gitlab-org/cli/internal/commands/ci/status"
 	ciTraceCmd "gitlab.com/gitlab-org/cli/internal/commands/ci/trace"
 	jobPlayCmd "gitlab.com/gitlab-org/cli/internal/commands/ci/trigger"
@@ -56,6 +62,12 @@ func NewCmdCI(f cmdutils.Factory) *cobra.Command {
 	ciCmd.AddCommand(jobArtifactCmd.NewCmdRun(f))
 	ciCmd.AddCommand(pipeGetCmd.NewCmdGet(f))
 	ciCmd.AddCommand(ciConfigCmd.NewCmdConfig(f))
+	ciCmd.AddCommand(ciBadgeCmd.NewCmdBadge(f))
+	ciCmd.AddCommand(ciFreezeCmd.NewCmdFreeze(f))
+	ciCmd.AddCommand(ciSimulateCmd.NewCmdSimulate(f))
+	ciCmd.AddCommand(ciEnvCmd.NewCmdEnv(f))
+	ciCmd.AddCommand(ciArtifactsCmd.NewCmdArtifacts(f))
+	ciCmd.AddCommand(ciJobsCmd.NewCmdJobs(f))
 
 	return ciCmd
 }
@@ -0,0 +1,26 @@
+package ciutils
+
+import (
+	"golang.org/x/sync/errgroup"
+)
+
+// bulkActionLimit is the number of requests that a bulk action sends at once.
+const bulkActionLimit = 5
+
+// RunBulkAction runs action for every item and its index, a few at a time. It returns the error
+// of every action, in the order of the items, so that callers can report all of them.
+func RunBulkAction[T any](items []T, action func(int, T) error) []error {
+	errs := make([]error, len(items))
+
+	g := new(errgroup.Group)
+	g.SetLimit(bulkActionLimit)
+	for i, item := range items {
+		g.Go(func() error {
+			errs[i] = action(i, item)
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	return errs
+}
@@ -0,0 +1,32 @@
+//go:build !integration
+
+package ciutils
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunBulkAction(t *testing.T) {
+	var calls atomic.Int32
+	errs := RunBulkAction([]int{1, 2, 3, 4, 5, 6, 7}, func(_ int, n int) error {
+		calls.Add(1)
+		if n%3 == 0 {
+			return errors.New("failed")
+		}
+		return nil
+	})
+
+	assert.Equal(t, int32(7), calls.Load())
+	assert.Len(t, errs, 7)
+	for i, err := range errs {
+		if (i+1)%3 == 0 {
+			assert.Error(t, err)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package ciutils
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+)
+
+// maxDownstreamDepth stops the walk of downstream pipelines that trigger each other
+// without end.
+const maxDownstreamDepth = 10
+
+// DownstreamPipeline is a child or multi-project pipeline triggered by a bridge job,
+// with the pipelines it triggered in turn. Pipeline is nil until the bridge triggers it.
+type DownstreamPipeline struct {
+	Bridge     *gitlab.Bridge
+	Pipeline   *gitlab.PipelineInfo
+	Downstream []*DownstreamPipeline
+}
+
+// GetDownstreamPipelines walks the bridge jobs of a pipeline, and of the pipelines they
+// triggered, and returns the tree of downstream pipelines.
+func GetDownstreamPipelines(client *gitlab.Client, pid any, pipelineID int64, options ...gitlab.RequestOptionFunc) ([]*DownstreamPipeline, error) {
+	return getDownstreamPipelines(client, pid, pipelineID, 0, options)
+}
+
+func getDownstreamPipelines(client *gitlab.Client, pid any, pipelineID int64, depth int, options []gitlab.RequestOptionFunc) ([]*DownstreamPipeline, error) {
+	if depth >= maxDownstreamDepth {
+		return nil, nil
+	}
+
+	bridges, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Bridge, *gitlab.Response, error) {
+		return client.Jobs.ListPipelineBridges(pid, pipelineID, &gitlab.ListJobsOptions{ListOptions: gitlab.ListOptions{PerPage: 100}}, append([]gitlab.RequestOptionFunc{p}, options...)...)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list bridges of pipeline %d: %w", pipelineID, err)
+	}
+
+	pipelines := make([]*DownstreamPipeline, 0, len(bridges))
+	for _, bridge := range bridges {
+		downstream := &DownstreamPipeline{Bridge: bridge, Pipeline: bridge.DownstreamPipeline}
+		if p := bridge.DownstreamPipeline; p != nil {
+			// Multi-project pipelines run in another project than their bridge.
+			downstream.Downstream, err = getDownstreamPipelines(client, p.ProjectID, p.ID, depth+1, options)
+			if err != nil {
+				return nil, err
+			}
+		}
+		pipelines = append(pipelines, downstream)
+	}
+	return pipelines, nil
+}
+
+// DisplayDownstreamPipelines writes the tree of downstream pipelines, one line each,
+// with the name of the bridge that triggered them.
+func DisplayDownstreamPipelines(w io.Writer, c *iostreams.ColorPalette, pipelines []*DownstreamPipeline, compact bool) {
+	displayDownstreamPipelines(w, c, pipelines, compact, "")
+}
+
+func displayDownstreamPipelines(w io.Writer, c *iostreams.ColorPalette, pipelines []*DownstreamPipeline, compact bool, indent string) {
+	for i, downstream := range pipelines {
+		branch, childIndent := "├─ ", "│  "
+		if i == len(pipelines)-1 {
+			branch, childIndent = "└─ ", "   "
+		}
+
+		var line strings.Builder
+		line.WriteString(indent + branch)
+		if p := downstream.Pipeline; p != nil {
+			fmt.Fprintf(&line, "(%s) • %s → pipeline #%d", downstreamStatus(c, p.Status, false), downstream.Bridge.Name, p.ID)
+			if !compact && p.WebURL != "" {
+				line.WriteString("  " + c.Gray(p.WebURL))
+			}
+		} else {
+			fmt.Fprintf(&line, "(%s) • %s → %s", downstreamStatus(c, downstream.Bridge.Status, downstream.Bridge.AllowFailure), downstream.Bridge.Name, c.Gray("not triggered"))
+		}
+		fmt.Fprintln(w, line.String())
+
+		displayDownstreamPipelines(w, c, downstream.Downstream, compact, indent+childIndent)
+	}
+}
+
+func downstreamStatus(c *iostreams.ColorPalette, status string, allowFailure bool) string {
+	switch status {
+	case "failed":
+		if allowFailure {
+			return c.Yellow(status)
+		}
+		return c.Red(status)
+	case "success":
+		return c.Green(status)
+	default:
+		return c.Gray(status)
+	}
+}
@@ -0,0 +1,53 @@
+//go:build !integration
+
+package ciutils
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestDownstreamPipelines(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+
+	tc.MockJobs.EXPECT().
+		ListPipelineBridges("OWNER/REPO", int64(1), gomock.Any(), gomock.Any()).
+		Return([]*gitlab.Bridge{
+			{Name: "child", Status: "success", DownstreamPipeline: &gitlab.PipelineInfo{ID: 2, ProjectID: 10, Status: "success", WebURL: "https://gitlab.com/OWNER/REPO/-/pipelines/2"}},
+			{Name: "deploy", Status: "created"},
+		}, &gitlab.Response{}, nil)
+	tc.MockJobs.EXPECT().
+		ListPipelineBridges(int64(10), int64(2), gomock.Any(), gomock.Any()).
+		Return([]*gitlab.Bridge{
+			{Name: "docs", Status: "running", DownstreamPipeline: &gitlab.PipelineInfo{ID: 3, ProjectID: 20, Status: "running", WebURL: "https://gitlab.com/OWNER/DOCS/-/pipelines/3"}},
+		}, &gitlab.Response{}, nil)
+	tc.MockJobs.EXPECT().
+		ListPipelineBridges(int64(20), int64(3), gomock.Any(), gomock.Any()).
+		Return([]*gitlab.Bridge{}, &gitlab.Response{}, nil)
+
+	pipelines, err := GetDownstreamPipelines(tc.Client, "OWNER/REPO", 1)
+	require.NoError(t, err)
+	require.Len(t, pipelines, 2)
+	require.Len(t, pipelines[0].Downstream, 1)
+	assert.Equal(t, int64(3), pipelines[0].Downstream[0].Pipeline.ID)
+	assert.Nil(t, pipelines[1].Pipeline)
+
+	ios, _, _, _ := cmdtest.TestIOStreams()
+	var out bytes.Buffer
+	DisplayDownstreamPipelines(&out, ios.Color(), pipelines, false)
+	assert.Equal(t, heredoc.Doc(`
+		├─ (success) • child → pipeline #2  https://gitlab.com/OWNER/REPO/-/pipelines/2
+		│  └─ (running) • docs → pipeline #3  https://gitlab.com/OWNER/DOCS/-/pipelines/3
+		└─ (created) • deploy → not triggered
+	`), out.String())
+}
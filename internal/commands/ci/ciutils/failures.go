@@ -0,0 +1,150 @@
+package ciutils
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/lunixbochs/vtclean"
+)
+
+// FailureLocation is a location in a source file that a job log reports an
+// error at.
+type FailureLocation struct {
+	File    string `json:"file"`
+	Line    int    `json:"line"`
+	Column  int    `json:"column,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// Quickfix formats the location as a line of a Vim quickfix list, which Emacs
+// compilation mode understands as well.
+func (l FailureLocation) Quickfix() string {
+	location := fmt.Sprintf("%s:%d:", l.File, l.Line)
+	if l.Column > 0 {
+		location += fmt.Sprintf("%d:", l.Column)
+	}
+	if l.Message == "" {
+		return location
+	}
+	return location + " " + l.Message
+}
+
+// FailurePatterns are the patterns of error locations of each language. Each
+// pattern has the named groups file and line, and optionally col and msg.
+var FailurePatterns = map[string][]*regexp.Regexp{
+	"go": {
+		// main.go:12:5: undefined: foo
+		regexp.MustCompile(`^\s*(?P<file>[^\s:]+\.go):(?P<line>\d+):(?P<col>\d+): (?P<msg>.+)$`),
+		// foo_test.go:34: expected 1, got 2
+		regexp.MustCompile(`^\s+(?P<file>[^\s:]+_test\.go):(?P<line>\d+): (?P<msg>.+)$`),
+	},
+	"python": {
+		// File "app/main.py", line 12, in main
+		regexp.MustCompile(`^\s*File "(?P<file>[^"]+\.py)", line (?P<line>\d+)`),
+		// app/main.py:12: AssertionError
+		regexp.MustCompile(`^(?P<file>[^\s:]+\.py):(?P<line>\d+): (?P<msg>.+)$`),
+	},
+	"javascript": {
+		// src/index.ts(12,5): error TS2304: Cannot find name 'foo'.
+		regexp.MustCompile(`^(?P<file>[^\s(]+\.[cm]?[jt]sx?)\((?P<line>\d+),(?P<col>\d+)\): (?P<msg>.+)$`),
+		// src/index.ts:12:5 - error TS2304: Cannot find name 'foo'.
+		regexp.MustCompile(`^(?P<file>[^\s:]+\.[cm]?[jt]sx?):(?P<line>\d+):(?P<col>\d+) - (?P<msg>.+)$`),
+		// at Object.<anonymous> (src/index.test.js:12:5)
+		regexp.MustCompile(`^\s*at .*\((?P<file>[^\s():]+\.[cm]?[jt]sx?):(?P<line>\d+):(?P<col>\d+)\)$`),
+	},
+	"rust": {
+		// --> src/main.rs:12:5
+		regexp.MustCompile(`^\s*--> (?P<file>[^\s:]+\.rs):(?P<line>\d+):(?P<col>\d+)$`),
+	},
+	"generic": {
+		// src/main.c:12:5: error: expected ';'
+		regexp.MustCompile(`^(?P<file>[^\s:]+\.\w+):(?P<line>\d+):(?P<col>\d+): (?P<msg>(?:fatal )?error: .+)$`),
+	},
+}
+
+// FailureLanguages returns the languages of FailurePatterns, sorted.
+func FailureLanguages() []string {
+	return slices.Sorted(maps.Keys(FailurePatterns))
+}
+
+var sectionMarker = regexp.MustCompile(`section_(?:start|end):\d+:\S+`)
+
+// ParseFailures returns the error locations in the log of a job, in the order
+// they appear, matching the patterns of the given languages, or of all the
+// languages when none are given. The prefix of absolute paths up to and
+// including projectDir, the path of the project on the runner, is removed.
+func ParseFailures(trace io.Reader, languages []string, projectDir string) ([]FailureLocation, error) {
+	if len(languages) == 0 {
+		languages = FailureLanguages()
+	}
+	var patterns []*regexp.Regexp
+	for _, lang := range languages {
+		langPatterns, ok := FailurePatterns[lang]
+		if !ok {
+			return nil, fmt.Errorf("unknown language %q. Use one of: %s.", lang, strings.Join(FailureLanguages(), ", "))
+		}
+		patterns = append(patterns, langPatterns...)
+	}
+
+	var locations []FailureLocation
+	seen := map[FailureLocation]bool{}
+	scanner := bufio.NewScanner(trace)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := sectionMarker.ReplaceAllString(vtclean.Clean(scanner.Text(), false), "")
+		for _, pattern := range patterns {
+			location, ok := matchFailure(pattern, line)
+			if !ok {
+				continue
+			}
+			location.File = trimProjectDir(location.File, projectDir)
+			if !seen[location] {
+				seen[location] = true
+				locations = append(locations, location)
+			}
+			break
+		}
+	}
+	return locations, scanner.Err()
+}
+
+func matchFailure(pattern *regexp.Regexp, line string) (FailureLocation, bool) {
+	match := pattern.FindStringSubmatch(line)
+	if match == nil {
+		return FailureLocation{}, false
+	}
+
+	var location FailureLocation
+	for i, name := range pattern.SubexpNames() {
+		switch name {
+		case "file":
+			location.File = match[i]
+		case "line":
+			location.Line, _ = strconv.Atoi(match[i])
+		case "col":
+			location.Column, _ = strconv.Atoi(match[i])
+		case "msg":
+			location.Message = strings.TrimSpace(match[i])
+		}
+	}
+	return location, location.File != "" && location.Line > 0
+}
+
+// trimProjectDir makes the path of a file in the project on the runner, like
+// /builds/group/project/main.go, relative to the project.
+func trimProjectDir(path, projectDir string) string {
+	if projectDir == "" || !strings.HasPrefix(path, "/") {
+		return path
+	}
+	marker := "/" + strings.Trim(projectDir, "/") + "/"
+	if _, rest, ok := strings.Cut(path, marker); ok {
+		return rest
+	}
+	return path
+}
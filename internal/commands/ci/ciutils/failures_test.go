@@ -0,0 +1,77 @@
+//go:build !integration
+
+package ciutils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFailures(t *testing.T) {
+	t.Parallel()
+
+	trace := strings.Join([]string{
+		"section_start:1700000000:step_script\r\x1b[0K\x1b[0K\x1b[36;1mExecuting step_script\x1b[0;m",
+		"$ go build ./...",
+		"\x1b[31;1m/builds/OWNER/REPO/cmd/main.go:12:5: undefined: foo\x1b[0;m",
+		"--- FAIL: TestFoo (0.00s)",
+		"    foo_test.go:34: expected 1, got 2",
+		`  File "/builds/OWNER/REPO/app/main.py", line 7, in main`,
+		"error[E0425]: cannot find value `x` in this scope",
+		"  --> src/main.rs:3:13",
+		"src/index.ts(4,1): error TS2304: Cannot find name 'bar'.",
+		"/builds/OWNER/REPO/cmd/main.go:12:5: undefined: foo",
+		"section_end:1700000001:step_script\r\x1b[0K",
+	}, "\n")
+
+	tests := []struct {
+		name      string
+		languages []string
+		want      []FailureLocation
+	}{
+		{
+			name: "all languages",
+			want: []FailureLocation{
+				{File: "cmd/main.go", Line: 12, Column: 5, Message: "undefined: foo"},
+				{File: "foo_test.go", Line: 34, Message: "expected 1, got 2"},
+				{File: "app/main.py", Line: 7},
+				{File: "src/main.rs", Line: 3, Column: 13},
+				{File: "src/index.ts", Line: 4, Column: 1, Message: "error TS2304: Cannot find name 'bar'."},
+			},
+		},
+		{
+			name:      "only rust",
+			languages: []string{"rust"},
+			want: []FailureLocation{
+				{File: "src/main.rs", Line: 3, Column: 13},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			locations, err := ParseFailures(strings.NewReader(trace), tc.languages, "OWNER/REPO")
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, locations)
+		})
+	}
+}
+
+func TestParseFailures_UnknownLanguage(t *testing.T) {
+	t.Parallel()
+
+	_, err := ParseFailures(strings.NewReader(""), []string{"cobol"}, "")
+	assert.EqualError(t, err, `unknown language "cobol". Use one of: generic, go, javascript, python, rust.`)
+}
+
+func TestFailureLocation_Quickfix(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "main.go:12:5: undefined: foo", FailureLocation{File: "main.go", Line: 12, Column: 5, Message: "undefined: foo"}.Quickfix())
+	assert.Equal(t, "app/main.py:7:", FailureLocation{File: "app/main.py", Line: 7}.Quickfix())
+}
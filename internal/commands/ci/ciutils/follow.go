@@ -0,0 +1,106 @@
+package ciutils
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+)
+
+// FollowJobs traces several jobs at once, until they are all finished. The lines
+// of the job logs are interleaved, each prefixed with the name of its job. It
+// returns an error if any of the jobs failed, unless the job is allowed to fail.
+func FollowJobs(ctx context.Context, client *gitlab.Client, ios *iostreams.IOStreams, pid any, jobs []*gitlab.Job) error {
+	c := ios.Color()
+	colors := []func(string) string{c.Blue, c.Green, c.Magenta, c.Cyan, c.Yellow}
+
+	width := 0
+	for _, job := range jobs {
+		width = max(width, len(job.Name))
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	finished := make([]*gitlab.Job, len(jobs))
+	errs := make([]error, len(jobs))
+	for i, job := range jobs {
+		prefix := colors[i%len(colors)](fmt.Sprintf("%-*s |", width, job.Name)) + " "
+		w := &prefixWriter{mu: &mu, w: ios.StdOut, prefix: prefix}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			finished[i], errs[i] = pollTrace(ctx, client, w, pid, job.ID)
+			w.Flush()
+		}()
+	}
+	wg.Wait()
+
+	var failed []string
+	fmt.Fprintln(ios.StdOut)
+	for i, job := range jobs {
+		switch {
+		case errs[i] != nil:
+			fmt.Fprintf(ios.StdOut, "%s %s: %s\n", c.FailedIcon(), job.Name, errs[i])
+			failed = append(failed, job.Name)
+		case finished[i] == nil:
+			fmt.Fprintf(ios.StdOut, "%s %s: stopped following\n", c.WarnIcon(), job.Name)
+		case finished[i].Status == "failed" && finished[i].AllowFailure:
+			fmt.Fprintf(ios.StdOut, "%s %s: failed (allowed to fail)\n", c.WarnIcon(), job.Name)
+		case finished[i].Status == "failed":
+			fmt.Fprintf(ios.StdOut, "%s %s: failed\n", c.FailedIcon(), job.Name)
+			failed = append(failed, job.Name)
+		default:
+			fmt.Fprintf(ios.StdOut, "%s %s: %s\n", c.GreenCheck(), job.Name, finished[i].Status)
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("%d of %d jobs failed: %s", len(failed), len(jobs), strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// prefixWriter writes whole lines to w, each starting with prefix. The mutex is
+// shared by the writers of all jobs, so that their lines don't mix.
+type prefixWriter struct {
+	mu     *sync.Mutex
+	w      io.Writer
+	prefix string
+	buf    bytes.Buffer
+}
+
+func (p *prefixWriter) Write(b []byte) (int, error) {
+	p.buf.Write(b)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for {
+		i := bytes.IndexByte(p.buf.Bytes(), '\n')
+		if i < 0 {
+			return len(b), nil
+		}
+		line := p.buf.Next(i + 1)
+		if _, err := fmt.Fprint(p.w, p.prefix, string(line)); err != nil {
+			return len(b), err
+		}
+	}
+}
+
+// Flush writes the last line, if it doesn't end with a newline.
+func (p *prefixWriter) Flush() {
+	if p.buf.Len() == 0 {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	fmt.Fprintln(p.w, p.prefix+p.buf.String())
+	p.buf.Reset()
+}
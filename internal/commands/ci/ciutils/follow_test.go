@@ -0,0 +1,26 @@
+//go:build !integration
+
+package ciutils
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrefixWriter(t *testing.T) {
+	var out bytes.Buffer
+	var mu sync.Mutex
+	lint := &prefixWriter{mu: &mu, w: &out, prefix: "lint | "}
+	unit := &prefixWriter{mu: &mu, w: &out, prefix: "unit | "}
+
+	_, _ = lint.Write([]byte("first line\nsecond "))
+	_, _ = unit.Write([]byte("running tests\n"))
+	_, _ = lint.Write([]byte("line\nlast line"))
+	lint.Flush()
+	unit.Flush()
+
+	assert.Equal(t, "lint | first line\nunit | running tests\nlint | second line\nlint | last line\n", out.String())
+}
@@ -27,14 +27,15 @@ func makeHyperlink(s *iostreams.IOStreams, pipeline *gitlab.PipelineInfo) string
 
 // GetPipelineWithFallback gets the latest pipeline for a branch, falling back to MR head pipeline
 // for merged results pipelines where the direct branch lookup may fail or returns a pipeline with no jobs.
-func GetPipelineWithFallback(client *gitlab.Client, repoName, branch string, ios *iostreams.IOStreams) (*gitlab.Pipeline, error) {
+// The options are passed to the requests of the pipeline, its jobs, and the merge request.
+func GetPipelineWithFallback(client *gitlab.Client, repoName, branch string, ios *iostreams.IOStreams, options ...gitlab.RequestOptionFunc) (*gitlab.Pipeline, error) {
 	// First try: Get pipeline by branch name
-	pipeline, _, err := client.Pipelines.GetLatestPipeline(repoName, &gitlab.GetLatestPipelineOptions{Ref: gitlab.Ptr(branch)})
+	pipeline, _, err := client.Pipelines.GetLatestPipeline(repoName, &gitlab.GetLatestPipelineOptions{Ref: gitlab.Ptr(branch)}, options...)
 	if err == nil {
 		// Check if the pipeline has jobs - some pipelines (e.g., external pipelines) may have no jobs
 		jobs, _, jobsErr := client.Jobs.ListPipelineJobs(repoName, pipeline.ID, &gitlab.ListJobsOptions{
 			ListOptions: gitlab.ListOptions{PerPage: 1},
-		})
+		}, options...)
 		if jobsErr == nil && len(jobs) > 0 {
 			// Pipeline has jobs, return it
 			return pipeline, nil
@@ -43,7 +44,7 @@ func GetPipelineWithFallback(client *gitlab.Client, repoName, branch string, ios
 	}
 
 	// Fallback: Look for MR pipeline (for merged results pipelines or when branch pipeline has no jobs)
-	mr, mrErr := getMRForBranch(client, repoName, branch, ios)
+	mr, mrErr := getMRForBranch(client, repoName, branch, ios, options...)
 	if mrErr != nil {
 		// If we had a pipeline from the branch lookup (even with no jobs), return it
 		if pipeline != nil {
@@ -61,7 +62,7 @@ func GetPipelineWithFallback(client *gitlab.Client, repoName, branch string, ios
 	}
 
 	// Get the full pipeline details using the MR's head pipeline ID
-	mrPipeline, _, pipelineErr := client.Pipelines.GetPipeline(repoName, mr.HeadPipeline.ID)
+	mrPipeline, _, pipelineErr := client.Pipelines.GetPipeline(repoName, mr.HeadPipeline.ID, options...)
 	if pipelineErr != nil {
 		// If we had a pipeline from the branch lookup, return it as fallback
 		if pipeline != nil {
@@ -74,7 +75,7 @@ func GetPipelineWithFallback(client *gitlab.Client, repoName, branch string, ios
 }
 
 // getMRForBranch finds a merge request for the given branch
-func getMRForBranch(client *gitlab.Client, repoName, branch string, ios *iostreams.IOStreams) (*gitlab.MergeRequest, error) {
+func getMRForBranch(client *gitlab.Client, repoName, branch string, ios *iostreams.IOStreams, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, error) {
 	opts := &gitlab.ListProjectMergeRequestsOptions{
 		SourceBranch: gitlab.Ptr(branch),
 	}
@@ -123,7 +124,7 @@ func getMRForBranch(client *gitlab.Client, repoName, branch string, ios *iostrea
 	}
 
 	// Fetch the full MR to get HeadPipeline
-	fullMR, _, err := client.MergeRequests.GetMergeRequest(repoName, selectedMR.IID, &gitlab.GetMergeRequestsOptions{})
+	fullMR, _, err := client.MergeRequests.GetMergeRequest(repoName, selectedMR.IID, &gitlab.GetMergeRequestsOptions{}, options...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get merge request details: %w", err)
 	}
@@ -133,8 +134,8 @@ func getMRForBranch(client *gitlab.Client, repoName, branch string, ios *iostrea
 
 func DisplaySchedules(i *iostreams.IOStreams, s []*gitlab.PipelineSchedule, projectID string) string {
 	if len(s) > 0 {
-		table := tableprinter.NewTablePrinter()
-		table.AddRow("ID", "Description", "Cron", "Owner", "Active")
+		table := tableprinter.New(i)
+		table.AddHeader("ID", "Description", "Cron", "Owner", "Active")
 		for _, schedule := range s {
 			table.AddRow(schedule.ID, schedule.Description, schedule.Cron, schedule.Owner.Username, schedule.Active)
 		}
@@ -149,10 +150,10 @@ func DisplaySchedules(i *iostreams.IOStreams, s []*gitlab.PipelineSchedule, proj
 func DisplayMultiplePipelines(s *iostreams.IOStreams, p []*gitlab.PipelineInfo, projectID string) string {
 	c := s.Color()
 
-	table := tableprinter.NewTablePrinter()
+	table := tableprinter.New(s)
 
 	if len(p) > 0 {
-		table.AddRow("State", "IID", "Ref", "Created")
+		table.AddHeader("State", "IID", "Ref", "Created")
 		for _, pipeline := range p {
 			duration := ""
 
@@ -188,17 +189,26 @@ func RunTraceSha(ctx context.Context, apiClient *gitlab.Client, w io.Writer, pid
 }
 
 func runTrace(ctx context.Context, apiClient *gitlab.Client, w io.Writer, pid any, jobId int64) error {
+	_, err := pollTrace(ctx, apiClient, w, pid, jobId)
+	return err
+}
+
+// pollTrace writes the log of a job to w as it grows, until the job is finished,
+// and returns the finished job.
+func pollTrace(ctx context.Context, apiClient *gitlab.Client, w io.Writer, pid any, jobId int64) (*gitlab.Job, error) {
 	var once sync.Once
 	var offset int64
+	var job *gitlab.Job
 
 	fmt.Fprintln(w, "Getting job trace...")
 	for range time.NewTicker(time.Second * 3).C {
 		if ctx.Err() == context.Canceled {
 			break
 		}
-		job, _, err := apiClient.Jobs.GetJob(pid, jobId)
+		var err error
+		job, _, err = apiClient.Jobs.GetJob(pid, jobId, api.WithoutCache())
 		if err != nil {
-			return errors.Wrap(err, "failed to find job")
+			return nil, errors.Wrap(err, "failed to find job")
 		}
 		switch job.Status {
 		case "pending":
@@ -213,24 +223,30 @@ func runTrace(ctx context.Context, apiClient *gitlab.Client, w io.Writer, pid an
 		once.Do(func() {
 			fmt.Fprintf(w, "Showing logs for %s job #%d.\n", job.Name, job.ID)
 		})
-		trace, _, err := apiClient.Jobs.GetTraceFile(pid, jobId)
+		trace, _, err := apiClient.Jobs.GetTraceFile(pid, jobId, api.WithoutCache())
 		if err != nil || trace == nil {
-			return errors.Wrap(err, "failed to find job")
+			return nil, errors.Wrap(err, "failed to find job")
 		}
 		_, _ = io.CopyN(io.Discard, trace, offset)
 		lenT, err := io.Copy(w, trace)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		offset += lenT
 
-		if job.Status == "success" ||
-			job.Status == "failed" ||
-			job.Status == "cancelled" {
-			return nil
+		if isFinishedJobStatus(job.Status) {
+			return job, nil
 		}
 	}
-	return nil
+	return job, nil
+}
+
+func isFinishedJobStatus(status string) bool {
+	switch status {
+	case "success", "failed", "canceled", "cancelled", "skipped":
+		return true
+	}
+	return false
 }
 
 func GetJobId(ctx context.Context, inputs *JobInputs, opts *JobOptions) (int64, error) {
@@ -304,6 +320,23 @@ func getPipelineId(inputs *JobInputs, opts *JobOptions) (int64, error) {
 	return pipeline.ID, err
 }
 
+// ListPipelineJobs returns the jobs of the pipeline selected by the inputs.
+func ListPipelineJobs(inputs *JobInputs, opts *JobOptions) ([]*gitlab.Job, error) {
+	pipelineId, err := getPipelineId(inputs, opts)
+	if err != nil {
+		return nil, fmt.Errorf("get pipeline: %w", err)
+	}
+
+	listOptions := &gitlab.ListJobsOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+		},
+	}
+	return gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Job, *gitlab.Response, error) {
+		return opts.Client.Jobs.ListPipelineJobs(opts.Repo.FullName(), pipelineId, listOptions, p)
+	})
+}
+
 // GetDefaultBranch fetches the repository's default branch from GitLab API.
 // Falls back to "main" if the API call fails or returns empty.
 func GetDefaultBranch(repo glrepo.Interface, client *gitlab.Client) string {
@@ -0,0 +1,18 @@
+package env
+
+import (
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	envPrintCmd "gitlab.com/gitlab-org/cli/internal/commands/ci/env/print"
+)
+
+func NewCmdEnv(f cmdutils.Factory) *cobra.Command {
+	envCmd := &cobra.Command{
+		Use:   "env <command> [flags]",
+		Short: `Work with the environment of CI/CD jobs.`,
+		Long:  ``,
+	}
+	envCmd.AddCommand(envPrintCmd.NewCmdPrint(f))
+	return envCmd
+}
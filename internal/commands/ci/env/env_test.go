@@ -0,0 +1,24 @@
+//go:build !integration
+
+package env
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestNewCmdEnv(t *testing.T) {
+	ios, _, _, _ := cmdtest.TestIOStreams()
+	cmd := NewCmdEnv(cmdtest.NewTestFactory(ios))
+
+	assert.Equal(t, "env <command> [flags]", cmd.Use)
+
+	subcommandNames := make([]string, 0, len(cmd.Commands()))
+	for _, subcmd := range cmd.Commands() {
+		subcommandNames = append(subcommandNames, subcmd.Name())
+	}
+	assert.ElementsMatch(t, []string{"print"}, subcommandNames)
+}
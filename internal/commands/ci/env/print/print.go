@@ -0,0 +1,422 @@
+package print
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/ci/ciutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+// maskedValue replaces the values of masked variables, like in job logs.
+const maskedValue = "[MASKED]"
+
+var nonSlugRE = regexp.MustCompile(`[^a-z0-9]+`)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	job         string
+	branch      string
+	pipelineID  int
+	environment string
+	showMasked  bool
+}
+
+// variable is a CI/CD variable of the job environment.
+type variable struct {
+	Key    string
+	Value  string
+	Masked bool
+}
+
+// scopedVariable is a project or group variable, which applies to the jobs of
+// the environments its scope matches.
+type scopedVariable struct {
+	variable
+	Scope     string
+	Protected bool
+}
+
+func NewCmdPrint(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	printCmd := &cobra.Command{
+		Use:   "print <job-id|job-name> [flags]",
+		Short: `Print the CI/CD variables a job ran with, in dotenv format.`,
+		Long: heredoc.Doc(`
+			Print the CI/CD variables a job ran with, in dotenv format, to reproduce the
+			job locally.
+
+			The environment is reconstructed from the job, its pipeline, and the
+			project. It contains the predefined variables that can be known from the
+			API, the variables of the ancestor groups and of the project, and the
+			variables the pipeline was run with, in that order of precedence. Protected
+			variables are only included when the ref of the job is protected.
+
+			Variables defined in the CI/CD configuration aren't included. The values
+			of masked variables are replaced with [MASKED], unless you use --show-masked.
+			The values of hidden variables can't be read, and are always masked.
+		`),
+		Args: cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			# Print the environment of job 224356863
+			$ glab ci env print 224356863
+
+			# Print the environment of the latest 'deploy' job on main, with the variables of the production environment
+			$ glab ci env print deploy -b main --environment production
+
+			# Load the environment of a job in the current shell
+			$ set -a; source <(glab ci env print 224356863 --show-masked); set +a
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.job = args[0]
+			return opts.run(cmd)
+		},
+	}
+
+	printCmd.Flags().StringVarP(&opts.branch, "branch", "b", "", "The branch to search for the job. (default current branch)")
+	printCmd.Flags().IntVarP(&opts.pipelineID, "pipeline-id", "p", 0, "The pipeline ID to search for the job.")
+	printCmd.Flags().StringVarP(&opts.environment, "environment", "e", "", "The environment the job deploys to. Selects the variables scoped to this environment.")
+	printCmd.Flags().BoolVar(&opts.showMasked, "show-masked", false, "Print the values of masked variables.")
+
+	return printCmd
+}
+
+func (o *options) run(cmd *cobra.Command) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	jobID, err := ciutils.GetJobId(cmd.Context(), &ciutils.JobInputs{
+		JobName:    o.job,
+		Branch:     o.branch,
+		PipelineId: o.pipelineID,
+	}, &ciutils.JobOptions{
+		Client: client,
+		IO:     o.io,
+		Repo:   repo,
+	})
+	if err != nil {
+		return err
+	}
+
+	job, _, err := client.Jobs.GetJob(repo.FullName(), jobID)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to get job %d.", jobID))
+	}
+	pipeline, _, err := client.Pipelines.GetPipeline(repo.FullName(), job.Pipeline.ID)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to get pipeline %d.", job.Pipeline.ID))
+	}
+	project, err := api.GetProject(client, repo.FullName())
+	if err != nil {
+		return err
+	}
+
+	protected := isProtectedRef(client, repo.FullName(), job)
+	env := predefinedVariables(project, pipeline, job, protected)
+	if o.environment != "" {
+		env = setVariable(env, variable{Key: "CI_ENVIRONMENT_NAME", Value: o.environment})
+	}
+
+	if project.Namespace != nil && project.Namespace.Kind == "group" {
+		for _, group := range ancestorGroups(project.Namespace.FullPath) {
+			variables, err := listGroupVariables(client, group)
+			if err != nil {
+				fmt.Fprintf(o.io.StdErr, "%s Could not read the variables of group %s: %v\n", o.io.Color().WarnIcon(), group, err)
+				continue
+			}
+			env = setScopedVariables(env, variables, o.environment, protected)
+		}
+	}
+
+	variables, err := listProjectVariables(client, repo.FullName())
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to list the project variables.")
+	}
+	env = setScopedVariables(env, variables, o.environment, protected)
+
+	pipelineVariables, _, err := client.Pipelines.GetPipelineVariables(repo.FullName(), pipeline.ID)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to get the variables of pipeline %d.", pipeline.ID))
+	}
+	for _, v := range pipelineVariables {
+		env = setVariable(env, variable{Key: v.Key, Value: v.Value})
+	}
+
+	for _, v := range env {
+		value := v.Value
+		if v.Masked && !o.showMasked {
+			value = maskedValue
+		}
+		fmt.Fprintf(o.io.StdOut, "%s=%s\n", v.Key, dotenvQuote(value))
+	}
+	return nil
+}
+
+// predefinedVariables returns the predefined CI/CD variables of a job that can
+// be known from the API.
+func predefinedVariables(project *gitlab.Project, pipeline *gitlab.Pipeline, job *gitlab.Job, protected bool) []variable {
+	// The URL of the server is the URL of the project without its path, which
+	// keeps the relative URL root of self-managed instances.
+	serverURL := strings.TrimSuffix(project.WebURL, "/"+project.PathWithNamespace)
+	var host string
+	if u, err := url.Parse(serverURL); err == nil {
+		host = u.Host
+	}
+	projectDir := path.Join("/builds", project.PathWithNamespace)
+
+	env := []variable{
+		{Key: "CI", Value: "true"},
+		{Key: "GITLAB_CI", Value: "true"},
+		{Key: "CI_SERVER", Value: "yes"},
+		{Key: "CI_SERVER_URL", Value: serverURL},
+		{Key: "CI_SERVER_HOST", Value: host},
+		{Key: "CI_API_V4_URL", Value: serverURL + "/api/v4"},
+		{Key: "CI_PROJECT_ID", Value: strconv.FormatInt(project.ID, 10)},
+		{Key: "CI_PROJECT_NAME", Value: project.Path},
+		{Key: "CI_PROJECT_TITLE", Value: project.Name},
+		{Key: "CI_PROJECT_PATH", Value: project.PathWithNamespace},
+		{Key: "CI_PROJECT_PATH_SLUG", Value: slug(project.PathWithNamespace)},
+		{Key: "CI_PROJECT_NAMESPACE", Value: path.Dir(project.PathWithNamespace)},
+		{Key: "CI_PROJECT_ROOT_NAMESPACE", Value: strings.Split(project.PathWithNamespace, "/")[0]},
+		{Key: "CI_PROJECT_URL", Value: project.WebURL},
+		{Key: "CI_PROJECT_VISIBILITY", Value: string(project.Visibility)},
+		{Key: "CI_PROJECT_DIR", Value: projectDir},
+		{Key: "CI_BUILDS_DIR", Value: "/builds"},
+		{Key: "CI_DEFAULT_BRANCH", Value: project.DefaultBranch},
+		{Key: "CI_COMMIT_SHA", Value: pipeline.SHA},
+		{Key: "CI_COMMIT_SHORT_SHA", Value: utils.ShortSHA(pipeline.SHA)},
+		{Key: "CI_COMMIT_BEFORE_SHA", Value: pipeline.BeforeSHA},
+		{Key: "CI_COMMIT_REF_NAME", Value: job.Ref},
+		{Key: "CI_COMMIT_REF_SLUG", Value: slug(job.Ref)},
+		{Key: "CI_COMMIT_REF_PROTECTED", Value: strconv.FormatBool(protected)},
+	}
+	if job.Tag {
+		env = append(env, variable{Key: "CI_COMMIT_TAG", Value: job.Ref})
+	} else if !strings.HasPrefix(job.Ref, "refs/") {
+		env = append(env, variable{Key: "CI_COMMIT_BRANCH", Value: job.Ref})
+	}
+	if commit := job.Commit; commit != nil {
+		env = append(env,
+			variable{Key: "CI_COMMIT_TITLE", Value: commit.Title},
+			variable{Key: "CI_COMMIT_MESSAGE", Value: commit.Message},
+			variable{Key: "CI_COMMIT_AUTHOR", Value: fmt.Sprintf("%s <%s>", commit.AuthorName, commit.AuthorEmail)},
+		)
+		if commit.AuthoredDate != nil {
+			env = append(env, variable{Key: "CI_COMMIT_TIMESTAMP", Value: commit.AuthoredDate.Format(time.RFC3339)})
+		}
+	}
+
+	env = append(env,
+		variable{Key: "CI_PIPELINE_ID", Value: strconv.FormatInt(pipeline.ID, 10)},
+		variable{Key: "CI_PIPELINE_IID", Value: strconv.FormatInt(pipeline.IID, 10)},
+		variable{Key: "CI_PIPELINE_SOURCE", Value: string(pipeline.Source)},
+		variable{Key: "CI_PIPELINE_URL", Value: pipeline.WebURL},
+	)
+	if pipeline.CreatedAt != nil {
+		env = append(env, variable{Key: "CI_PIPELINE_CREATED_AT", Value: pipeline.CreatedAt.UTC().Format(time.RFC3339)})
+	}
+
+	env = append(env,
+		variable{Key: "CI_JOB_ID", Value: strconv.FormatInt(job.ID, 10)},
+		variable{Key: "CI_JOB_NAME", Value: job.Name},
+		variable{Key: "CI_JOB_NAME_SLUG", Value: slug(job.Name)},
+		variable{Key: "CI_JOB_STAGE", Value: job.Stage},
+		variable{Key: "CI_JOB_URL", Value: job.WebURL},
+	)
+	if job.StartedAt != nil {
+		env = append(env, variable{Key: "CI_JOB_STARTED_AT", Value: job.StartedAt.UTC().Format(time.RFC3339)})
+	}
+	if job.Runner.ID != 0 {
+		env = append(env,
+			variable{Key: "CI_RUNNER_ID", Value: strconv.FormatInt(job.Runner.ID, 10)},
+			variable{Key: "CI_RUNNER_DESCRIPTION", Value: job.Runner.Description},
+		)
+	}
+	if job.User != nil {
+		env = append(env,
+			variable{Key: "GITLAB_USER_ID", Value: strconv.FormatInt(job.User.ID, 10)},
+			variable{Key: "GITLAB_USER_LOGIN", Value: job.User.Username},
+			variable{Key: "GITLAB_USER_NAME", Value: job.User.Name},
+		)
+		if job.User.Email != "" {
+			env = append(env, variable{Key: "GITLAB_USER_EMAIL", Value: job.User.Email})
+		}
+	}
+	return env
+}
+
+// isProtectedRef reports whether the branch or tag of a job is protected, so
+// protected variables are passed to the job. Merge request pipelines run on
+// refs/merge-requests/, which are never protected.
+func isProtectedRef(client *gitlab.Client, repo string, job *gitlab.Job) bool {
+	if job.Tag {
+		tag, _, err := client.Tags.GetTag(repo, job.Ref)
+		return err == nil && tag.Protected
+	}
+	if strings.HasPrefix(job.Ref, "refs/") {
+		return false
+	}
+	branch, _, err := client.Branches.GetBranch(repo, job.Ref)
+	return err == nil && branch.Protected
+}
+
+// ancestorGroups returns the groups a namespace belongs to, top-level group first.
+func ancestorGroups(fullPath string) []string {
+	parts := strings.Split(fullPath, "/")
+	groups := make([]string, 0, len(parts))
+	for i := range parts {
+		groups = append(groups, strings.Join(parts[:i+1], "/"))
+	}
+	return groups
+}
+
+func listGroupVariables(client *gitlab.Client, group string) ([]scopedVariable, error) {
+	variables, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.GroupVariable, *gitlab.Response, error) {
+		return client.GroupVariables.ListVariables(group, &gitlab.ListGroupVariablesOptions{ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage}}, p)
+	})
+	if err != nil {
+		return nil, err
+	}
+	scoped := make([]scopedVariable, 0, len(variables))
+	for _, v := range variables {
+		scoped = append(scoped, scopedVariable{
+			variable:  variable{Key: v.Key, Value: v.Value, Masked: v.Masked || v.Hidden},
+			Scope:     v.EnvironmentScope,
+			Protected: v.Protected,
+		})
+	}
+	return scoped, nil
+}
+
+func listProjectVariables(client *gitlab.Client, repo string) ([]scopedVariable, error) {
+	variables, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.ProjectVariable, *gitlab.Response, error) {
+		return client.ProjectVariables.ListVariables(repo, &gitlab.ListProjectVariablesOptions{ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage}}, p)
+	})
+	if err != nil {
+		var errResp *gitlab.ErrorResponse
+		if errors.As(err, &errResp) && errResp.Response.StatusCode == http.StatusForbidden {
+			return nil, errors.New("reading the project variables requires the Maintainer role.")
+		}
+		return nil, err
+	}
+	scoped := make([]scopedVariable, 0, len(variables))
+	for _, v := range variables {
+		scoped = append(scoped, scopedVariable{
+			variable:  variable{Key: v.Key, Value: v.Value, Masked: v.Masked || v.Hidden},
+			Scope:     v.EnvironmentScope,
+			Protected: v.Protected,
+		})
+	}
+	return scoped, nil
+}
+
+// setScopedVariables sets the variables that apply to a job in environment.
+// When several variables have the same key, the one with the most specific
+// scope wins.
+func setScopedVariables(env []variable, variables []scopedVariable, environment string, protected bool) []variable {
+	best := map[string]int{}
+	var selected []scopedVariable
+	for _, v := range variables {
+		if v.Protected && !protected {
+			continue
+		}
+		rank := scopeRank(v.Scope, environment)
+		if rank < 0 {
+			continue
+		}
+		if i, ok := best[v.Key]; ok {
+			if rank > scopeRank(selected[i].Scope, environment) {
+				selected[i] = v
+			}
+			continue
+		}
+		best[v.Key] = len(selected)
+		selected = append(selected, v)
+	}
+	for _, v := range selected {
+		env = setVariable(env, v.variable)
+	}
+	return env
+}
+
+// scopeRank returns how specifically an environment scope matches an environment:
+// 2 for the same name, 1 for a wildcard pattern, 0 for all environments, and -1
+// when it doesn't match.
+func scopeRank(scope, environment string) int {
+	switch {
+	case scope == "*" || scope == "":
+		return 0
+	case environment == "":
+		return -1
+	case scope == environment:
+		return 2
+	case strings.Contains(scope, "*"):
+		pattern := "^" + strings.ReplaceAll(regexp.QuoteMeta(scope), `\*`, ".*") + "$"
+		if matched, _ := regexp.MatchString(pattern, environment); matched {
+			return 1
+		}
+	}
+	return -1
+}
+
+// setVariable overrides the value of a variable, or adds it to the end.
+func setVariable(env []variable, v variable) []variable {
+	for i := range env {
+		if env[i].Key == v.Key {
+			env[i] = v
+			return env
+		}
+	}
+	return append(env, v)
+}
+
+// slug returns a value like the _SLUG variables: lowercased, with characters
+// other than letters and digits replaced with -, and at most 63 characters.
+func slug(s string) string {
+	s = nonSlugRE.ReplaceAllString(strings.ToLower(s), "-")
+	if len(s) > 63 {
+		s = s[:63]
+	}
+	return strings.Trim(s, "-")
+}
+
+// dotenvQuote quotes a value for a dotenv file. In double quotes, dotenv
+// parsers expand \n to a newline.
+func dotenvQuote(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "$", `\$`)
+	return `"` + r.Replace(s) + `"`
+}
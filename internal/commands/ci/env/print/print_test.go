@@ -0,0 +1,138 @@
+//go:build !integration
+
+package print
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestPrint(t *testing.T) {
+	created := time.Date(2025, time.March, 4, 10, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name       string
+		cli        string
+		protected  bool
+		wantOut    []string
+		notWantOut []string
+	}{
+		{
+			name: "unprotected branch",
+			cli:  "1234",
+			wantOut: []string{
+				"CI_JOB_ID=\"1234\"\n",
+				"CI_JOB_NAME=\"deploy\"\n",
+				"CI_COMMIT_BRANCH=\"main\"\n",
+				"CI_COMMIT_REF_PROTECTED=\"false\"\n",
+				"CI_PIPELINE_SOURCE=\"push\"\n",
+				"CI_PIPELINE_CREATED_AT=\"2025-03-04T10:00:00Z\"\n",
+				"CI_PROJECT_PATH_SLUG=\"group-sub-repo\"\n",
+				"CI_PROJECT_NAMESPACE=\"group/sub\"\n",
+				"CI_SERVER_HOST=\"gitlab.example.com\"\n",
+				"CI_API_V4_URL=\"https://gitlab.example.com/gitlab/api/v4\"\n",
+				"GITLAB_USER_LOGIN=\"jdoe\"\n",
+				"REGION=\"eu\"\n",
+				"TOKEN=\"[MASKED]\"\n",
+				"GREETING=\"hello \\\"world\\\"\\n\"\n",
+				"DEBUG=\"1\"\n",
+			},
+			notWantOut: []string{"DEPLOY_KEY", "URL=\"https://staging", "CI_ENVIRONMENT_NAME"},
+		},
+		{
+			name:      "protected branch in an environment",
+			cli:       "1234 --environment review/feat --show-masked",
+			protected: true,
+			wantOut: []string{
+				"CI_COMMIT_REF_PROTECTED=\"true\"\n",
+				"CI_ENVIRONMENT_NAME=\"review/feat\"\n",
+				"DEPLOY_KEY=\"s3cr3t\"\n",
+				"TOKEN=\"t0k3n\"\n",
+				"URL=\"https://review.example.com\"\n",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			testClient.MockJobs.EXPECT().GetJob("OWNER/REPO", int64(1234)).Return(&gitlab.Job{
+				ID:       1234,
+				Name:     "deploy",
+				Stage:    "deploy",
+				Ref:      "main",
+				Pipeline: gitlab.JobPipeline{ID: 55},
+				User:     &gitlab.User{ID: 7, Username: "jdoe", Name: "Jane Doe"},
+				Commit:   &gitlab.Commit{Title: "Fix the login", Message: "Fix the login\n", AuthorName: "Jane Doe", AuthorEmail: "jane@example.com"},
+			}, nil, nil)
+			testClient.MockPipelines.EXPECT().GetPipeline("OWNER/REPO", int64(55)).Return(&gitlab.Pipeline{
+				ID:        55,
+				IID:       12,
+				SHA:       "0123456789abcdef",
+				Source:    "push",
+				CreatedAt: &created,
+			}, nil, nil)
+			testClient.MockProjects.EXPECT().GetProject("OWNER/REPO", gomock.Any()).Return(&gitlab.Project{
+				ID:                42,
+				Path:              "repo",
+				PathWithNamespace: "group/sub/repo",
+				WebURL:            "https://gitlab.example.com/gitlab/group/sub/repo",
+				DefaultBranch:     "main",
+				Namespace:         &gitlab.ProjectNamespace{Kind: "group", FullPath: "group/sub"},
+			}, nil, nil)
+			testClient.MockBranches.EXPECT().GetBranch("OWNER/REPO", "main").Return(&gitlab.Branch{Name: "main", Protected: tc.protected}, nil, nil)
+			testClient.MockGroupVariables.EXPECT().ListVariables("group", gomock.Any(), gomock.Any()).Return([]*gitlab.GroupVariable{
+				{Key: "REGION", Value: "us", EnvironmentScope: "*"},
+				{Key: "DEPLOY_KEY", Value: "s3cr3t", Protected: true, EnvironmentScope: "*"},
+			}, &gitlab.Response{}, nil)
+			testClient.MockGroupVariables.EXPECT().ListVariables("group/sub", gomock.Any(), gomock.Any()).Return([]*gitlab.GroupVariable{
+				{Key: "REGION", Value: "eu", EnvironmentScope: "*"},
+			}, &gitlab.Response{}, nil)
+			testClient.MockProjectVariables.EXPECT().ListVariables("OWNER/REPO", gomock.Any(), gomock.Any()).Return([]*gitlab.ProjectVariable{
+				{Key: "TOKEN", Value: "t0k3n", Masked: true, EnvironmentScope: "*"},
+				{Key: "URL", Value: "https://review.example.com", EnvironmentScope: "review/*"},
+				{Key: "URL", Value: "https://staging.example.com", EnvironmentScope: "staging"},
+				{Key: "GREETING", Value: "hello \"world\"\n", EnvironmentScope: "*"},
+			}, &gitlab.Response{}, nil)
+			testClient.MockPipelines.EXPECT().GetPipelineVariables("OWNER/REPO", int64(55)).Return([]*gitlab.PipelineVariable{
+				{Key: "DEBUG", Value: "1"},
+			}, nil, nil)
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdPrint, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			out, err := exec(tc.cli)
+			require.NoError(t, err)
+			for _, want := range tc.wantOut {
+				assert.Contains(t, out.String(), want)
+			}
+			for _, notWant := range tc.notWantOut {
+				assert.NotContains(t, out.String(), notWant)
+			}
+			assert.Empty(t, out.Stderr())
+		})
+	}
+}
+
+func TestScopeRank(t *testing.T) {
+	assert.Equal(t, 0, scopeRank("*", ""))
+	assert.Equal(t, 0, scopeRank("*", "production"))
+	assert.Equal(t, -1, scopeRank("production", ""))
+	assert.Equal(t, 2, scopeRank("production", "production"))
+	assert.Equal(t, 1, scopeRank("review/*", "review/feat"))
+	assert.Equal(t, -1, scopeRank("review/*", "staging"))
+}
+
+func TestSlug(t *testing.T) {
+	assert.Equal(t, "feat-login-form", slug("feat/Login_Form"))
+	assert.Equal(t, "refs-merge-requests-12-head", slug("refs/merge-requests/12/head"))
+	assert.Len(t, slug("a-very-long-branch-name-that-goes-on-and-on-well-past-the-limit-of-63"), 63)
+}
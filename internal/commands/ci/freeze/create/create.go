@@ -0,0 +1,100 @@
+package create
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/ci/freeze/freezeutils"
+	"gitlab.com/gitlab-org/cli/internal/cron"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	start    string
+	end      string
+	timezone string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	freezeCreateCmd := &cobra.Command{
+		Use:   "create --start <cron> --end <cron> [flags]",
+		Short: `Create a deploy freeze period.`,
+		Long: heredoc.Doc(`
+		Create a recurring deploy freeze period. The freeze starts and ends when the cron
+		expressions of --start and --end match, in the time zone of --timezone.
+		`),
+		Args: cobra.NoArgs,
+		Example: heredoc.Doc(`
+			# Freeze deployments every weekend, from Friday 23:00 to Monday 07:00 UTC
+			$ glab ci freeze create --start "0 23 * * 5" --end "0 7 * * 1"
+
+			# Freeze deployments over the holidays in Berlin
+			$ glab ci freeze create --start "0 18 24 12 *" --end "0 8 2 1 *" --timezone Europe/Berlin
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			for _, expr := range []string{opts.start, opts.end} {
+				if _, err := cron.Parse(expr); err != nil {
+					return &cmdutils.FlagError{Err: err}
+				}
+			}
+			return opts.run()
+		},
+	}
+
+	fl := freezeCreateCmd.Flags()
+	fl.StringVar(&opts.start, "start", "", "Cron expression for the start of the freeze.")
+	fl.StringVar(&opts.end, "end", "", "Cron expression for the end of the freeze.")
+	fl.StringVar(&opts.timezone, "timezone", "UTC", "Time zone of the cron expressions, such as Europe/Berlin.")
+	cobra.CheckErr(freezeCreateCmd.MarkFlagRequired("start"))
+	cobra.CheckErr(freezeCreateCmd.MarkFlagRequired("end"))
+
+	return freezeCreateCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	fp, _, err := client.FreezePeriods.CreateFreezePeriodOptions(repo.FullName(), &gitlab.CreateFreezePeriodOptions{
+		FreezeStart:  gitlab.Ptr(o.start),
+		FreezeEnd:    gitlab.Ptr(o.end),
+		CronTimezone: gitlab.Ptr(o.timezone),
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to create the deploy freeze period.")
+	}
+
+	c := o.io.Color()
+	fmt.Fprintf(o.io.StdOut, "%s Created deploy freeze period %d.\n", c.GreenCheck(), fp.ID)
+	if w, err := freezeutils.NextWindow(fp, time.Now()); err == nil {
+		fmt.Fprintf(o.io.StdOut, "Next freeze: %s\n", w)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+//go:build !integration
+
+package create
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_FreezeCreate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		cli      string
+		wantOpts *gitlab.CreateFreezePeriodOptions
+		wantErr  string
+	}{
+		{
+			name: "Weekend freeze",
+			cli:  `--start "0 23 * * 5" --end "0 7 * * 1"`,
+			wantOpts: &gitlab.CreateFreezePeriodOptions{
+				FreezeStart:  gitlab.Ptr("0 23 * * 5"),
+				FreezeEnd:    gitlab.Ptr("0 7 * * 1"),
+				CronTimezone: gitlab.Ptr("UTC"),
+			},
+		},
+		{
+			name: "Time zone",
+			cli:  `--start "0 18 24 12 *" --end "0 8 2 1 *" --timezone Europe/Berlin`,
+			wantOpts: &gitlab.CreateFreezePeriodOptions{
+				FreezeStart:  gitlab.Ptr("0 18 24 12 *"),
+				FreezeEnd:    gitlab.Ptr("0 8 2 1 *"),
+				CronTimezone: gitlab.Ptr("Europe/Berlin"),
+			},
+		},
+		{
+			name:    "Invalid cron expression",
+			cli:     `--start "0 25 * * 5" --end "0 7 * * 1"`,
+			wantErr: `invalid cron expression "0 25 * * 5": invalid value "25" in hour field`,
+		},
+		{
+			name:    "Missing end",
+			cli:     `--start "0 23 * * 5"`,
+			wantErr: `required flag(s) "end" not set`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			if tc.wantOpts != nil {
+				testClient.MockFreezePeriods.EXPECT().CreateFreezePeriodOptions("OWNER/REPO", tc.wantOpts).
+					Return(&gitlab.FreezePeriod{ID: 4, FreezeStart: *tc.wantOpts.FreezeStart, FreezeEnd: *tc.wantOpts.FreezeEnd, CronTimezone: *tc.wantOpts.CronTimezone}, nil, nil)
+			}
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdCreate, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			out, err := exec(tc.cli)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Contains(t, out.String(), "✓ Created deploy freeze period 4.\nNext freeze: ")
+		})
+	}
+}
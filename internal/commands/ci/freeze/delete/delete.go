@@ -0,0 +1,98 @@
+package delete
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	id  int64
+	yes bool
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdDelete(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	freezeDeleteCmd := &cobra.Command{
+		Use:   "delete <id> [flags]",
+		Short: `Delete a deploy freeze period.`,
+		Args:  cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ glab ci freeze delete 3
+			$ glab ci freeze delete 3 --yes
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("freeze period ID must be a valid integer, got %q", args[0])
+			}
+			opts.id = id
+
+			if !opts.yes && !opts.io.PromptEnabled() {
+				return &cmdutils.FlagError{Err: errors.New("--yes or -y flag is required when not running interactively.")}
+			}
+
+			return opts.run(cmd.Context())
+		},
+	}
+
+	freezeDeleteCmd.Flags().BoolVarP(&opts.yes, "yes", "y", false, "Skip the confirmation prompt.")
+
+	return freezeDeleteCmd
+}
+
+func (o *options) run(ctx context.Context) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	fp, _, err := client.FreezePeriods.GetFreezePeriod(repo.FullName(), o.id)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to get deploy freeze period %d.", o.id))
+	}
+
+	if !o.yes {
+		err = o.io.Confirm(ctx, &o.yes, fmt.Sprintf("Delete the deploy freeze period from %q to %q (%s)?", fp.FreezeStart, fp.FreezeEnd, fp.CronTimezone))
+		if err != nil {
+			return cmdutils.WrapError(err, "could not prompt")
+		}
+		if !o.yes {
+			return cmdutils.CancelError()
+		}
+	}
+
+	if _, err := client.FreezePeriods.DeleteFreezePeriod(repo.FullName(), fp.ID); err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to delete deploy freeze period %d.", fp.ID))
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Deleted deploy freeze period %d.\n", o.io.Color().RedCheck(), fp.ID)
+	return nil
+}
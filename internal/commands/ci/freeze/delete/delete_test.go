@@ -0,0 +1,61 @@
+//go:build !integration
+
+package delete
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_FreezeDelete(t *testing.T) {
+	testCases := []struct {
+		name    string
+		cli     string
+		wantOut string
+		wantErr string
+	}{
+		{
+			name:    "Delete a freeze period",
+			cli:     "3 --yes",
+			wantOut: "✓ Deleted deploy freeze period 3.\n",
+		},
+		{
+			name:    "Requires --yes",
+			cli:     "3",
+			wantErr: "--yes or -y flag is required when not running interactively.",
+		},
+		{
+			name:    "Invalid ID",
+			cli:     "weekend -y",
+			wantErr: `freeze period ID must be a valid integer, got "weekend"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			if tc.wantOut != "" {
+				testClient.MockFreezePeriods.EXPECT().GetFreezePeriod("OWNER/REPO", int64(3)).
+					Return(&gitlab.FreezePeriod{ID: 3, FreezeStart: "0 23 * * 5", FreezeEnd: "0 7 * * 1", CronTimezone: "UTC"}, nil, nil)
+				testClient.MockFreezePeriods.EXPECT().DeleteFreezePeriod("OWNER/REPO", int64(3)).Return(nil, nil)
+			}
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdDelete, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			out, err := exec(tc.cli)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantOut, out.String())
+		})
+	}
+}
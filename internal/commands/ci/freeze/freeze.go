@@ -0,0 +1,28 @@
+package freeze
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	freezeCreateCmd "gitlab.com/gitlab-org/cli/internal/commands/ci/freeze/create"
+	freezeDeleteCmd "gitlab.com/gitlab-org/cli/internal/commands/ci/freeze/delete"
+	freezeListCmd "gitlab.com/gitlab-org/cli/internal/commands/ci/freeze/list"
+)
+
+func NewCmdFreeze(f cmdutils.Factory) *cobra.Command {
+	freezeCmd := &cobra.Command{
+		Use:   "freeze <command> [flags]",
+		Short: `Manage deploy freeze periods.`,
+		Long: heredoc.Doc(`
+		Deploy freeze periods are recurring windows, defined by cron expressions, when
+		deployments should not happen. During a freeze, GitLab sets the CI_DEPLOY_FREEZE
+		variable in pipelines, so deployment jobs can skip themselves.
+		`),
+	}
+
+	freezeCmd.AddCommand(freezeListCmd.NewCmdList(f))
+	freezeCmd.AddCommand(freezeCreateCmd.NewCmdCreate(f))
+	freezeCmd.AddCommand(freezeDeleteCmd.NewCmdDelete(f))
+	return freezeCmd
+}
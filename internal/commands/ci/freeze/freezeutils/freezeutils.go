@@ -0,0 +1,58 @@
+package freezeutils
+
+import (
+	"fmt"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cron"
+)
+
+// TimeFormat is the format of the start and end of freeze windows.
+const TimeFormat = "Mon Jan 2 15:04 MST"
+
+// Window is an occurrence of a freeze period.
+type Window struct {
+	Start, End time.Time
+}
+
+// Active reports whether the window has started.
+func (w Window) Active(now time.Time) bool {
+	return !w.Start.After(now)
+}
+
+// String returns the window in the time zone of its freeze period, like
+// "Fri Jan 17 23:00 UTC to Mon Jan 20 07:00 UTC".
+func (w Window) String() string {
+	return fmt.Sprintf("%s to %s", w.Start.Format(TimeFormat), w.End.Format(TimeFormat))
+}
+
+// NextWindow returns the current or next window of a freeze period. The window is
+// active when the end of the freeze comes before its next start.
+func NextWindow(fp *gitlab.FreezePeriod, now time.Time) (Window, error) {
+	start, err := cron.Parse(fp.FreezeStart)
+	if err != nil {
+		return Window{}, err
+	}
+	end, err := cron.Parse(fp.FreezeEnd)
+	if err != nil {
+		return Window{}, err
+	}
+	loc := time.UTC
+	if fp.CronTimezone != "" {
+		if loc, err = time.LoadLocation(fp.CronTimezone); err != nil {
+			return Window{}, fmt.Errorf("unknown time zone %q", fp.CronTimezone)
+		}
+	}
+
+	now = now.In(loc)
+	nextStart, nextEnd := start.Next(now), end.Next(now)
+	if nextStart.IsZero() || nextEnd.IsZero() {
+		return Window{}, fmt.Errorf("freeze period %d never starts or ends", fp.ID)
+	}
+	if nextEnd.Before(nextStart) {
+		return Window{Start: now, End: nextEnd}, nil
+	}
+	return Window{Start: nextStart, End: end.Next(nextStart)}, nil
+}
@@ -0,0 +1,50 @@
+//go:build !integration
+
+package freezeutils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestNextWindow(t *testing.T) {
+	weekend := &gitlab.FreezePeriod{ID: 1, FreezeStart: "0 23 * * 5", FreezeEnd: "0 7 * * 1", CronTimezone: "UTC"}
+
+	t.Run("Upcoming window", func(t *testing.T) {
+		// A Wednesday.
+		now := time.Date(2025, time.January, 15, 10, 0, 0, 0, time.UTC)
+		w, err := NextWindow(weekend, now)
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2025, time.January, 17, 23, 0, 0, 0, time.UTC), w.Start)
+		assert.Equal(t, time.Date(2025, time.January, 20, 7, 0, 0, 0, time.UTC), w.End)
+		assert.False(t, w.Active(now))
+		assert.Equal(t, "Fri Jan 17 23:00 UTC to Mon Jan 20 07:00 UTC", w.String())
+	})
+
+	t.Run("Active window", func(t *testing.T) {
+		// A Saturday.
+		now := time.Date(2025, time.January, 18, 12, 0, 0, 0, time.UTC)
+		w, err := NextWindow(weekend, now)
+		require.NoError(t, err)
+		assert.True(t, w.Active(now))
+		assert.Equal(t, time.Date(2025, time.January, 20, 7, 0, 0, 0, time.UTC), w.End)
+	})
+
+	t.Run("Time zone", func(t *testing.T) {
+		fp := &gitlab.FreezePeriod{ID: 2, FreezeStart: "0 18 24 12 *", FreezeEnd: "0 8 2 1 *", CronTimezone: "Europe/Berlin"}
+		w, err := NextWindow(fp, time.Date(2025, time.January, 15, 10, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.Equal(t, "Wed Dec 24 18:00 CET to Fri Jan 2 08:00 CET", w.String())
+	})
+
+	t.Run("Unknown time zone", func(t *testing.T) {
+		fp := &gitlab.FreezePeriod{ID: 3, FreezeStart: "0 23 * * 5", FreezeEnd: "0 7 * * 1", CronTimezone: "Mars/Olympus"}
+		_, err := NextWindow(fp, time.Now())
+		require.EqualError(t, err, `unknown time zone "Mars/Olympus"`)
+	})
+}
@@ -0,0 +1,143 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/ci/freeze/freezeutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+)
+
+// now is the time the upcoming freeze windows are computed from. Tests replace it.
+var now = time.Now
+
+type options struct {
+	outputFormat string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+type freezeRow struct {
+	period *gitlab.FreezePeriod
+	window freezeutils.Window
+	err    error
+}
+
+func NewCmdList(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	freezeListCmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   `List deploy freeze periods and their upcoming windows.`,
+		Long:    "List the deploy freeze periods of a project, ordered by their next window.",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Example: heredoc.Doc(`
+			$ glab ci freeze list
+			$ glab ci freeze list -F json
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+
+	freezeListCmd.Flags().VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+
+	return freezeListCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	periods, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.FreezePeriod, *gitlab.Response, error) {
+		return client.FreezePeriods.ListFreezePeriods(repo.FullName(), &gitlab.ListFreezePeriodsOptions{ListOptions: gitlab.ListOptions{PerPage: 100}}, p)
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to list deploy freeze periods.")
+	}
+
+	if o.outputFormat == "json" {
+		periodsJSON, _ := json.Marshal(periods)
+		fmt.Fprintln(o.io.StdOut, string(periodsJSON))
+		return nil
+	}
+
+	if len(periods) == 0 {
+		o.io.LogInfof("No deploy freeze periods found for %s.\n", repo.FullName())
+		return nil
+	}
+
+	t := now()
+	rows := make([]freezeRow, 0, len(periods))
+	for _, fp := range periods {
+		w, err := freezeutils.NextWindow(fp, t)
+		rows = append(rows, freezeRow{period: fp, window: w, err: err})
+	}
+	// Periods without a known window go last.
+	slices.SortStableFunc(rows, func(a, b freezeRow) int {
+		if (a.err == nil) != (b.err == nil) {
+			if a.err == nil {
+				return -1
+			}
+			return 1
+		}
+		return a.window.Start.Compare(b.window.Start)
+	})
+
+	c := o.io.Color()
+	table := tableprinter.New(o.io)
+	table.AddHeader("ID", "START", "END", "TIMEZONE", "NEXT FREEZE")
+	var active []freezeutils.Window
+	for _, r := range rows {
+		next := ""
+		switch {
+		case r.err != nil:
+			next = c.Gray(r.err.Error())
+		case r.window.Active(t):
+			next = c.Yellow(fmt.Sprintf("Now, until %s", r.window.End.Format(freezeutils.TimeFormat)))
+			active = append(active, r.window)
+		default:
+			next = r.window.String()
+		}
+		table.AddRow(r.period.ID, r.period.FreezeStart, r.period.FreezeEnd, r.period.CronTimezone, next)
+	}
+	fmt.Fprint(o.io.StdOut, table.String())
+
+	if len(active) > 0 {
+		end := active[0].End
+		for _, w := range active[1:] {
+			if w.End.After(end) {
+				end = w.End
+			}
+		}
+		fmt.Fprintf(o.io.StdErr, "%s Deployments are frozen until %s.\n", c.WarnIcon(), end.Format(freezeutils.TimeFormat))
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+//go:build !integration
+
+package list
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_FreezeList(t *testing.T) {
+	periods := []*gitlab.FreezePeriod{
+		{ID: 1, FreezeStart: "0 18 24 12 *", FreezeEnd: "0 8 2 1 *", CronTimezone: "UTC"},
+		{ID: 2, FreezeStart: "0 23 * * 5", FreezeEnd: "0 7 * * 1", CronTimezone: "UTC"},
+		{ID: 3, FreezeStart: "0 23 * * 5", FreezeEnd: "0 7 * * 1", CronTimezone: "Pacific Time (US & Canada)"},
+	}
+
+	testCases := []struct {
+		name       string
+		cli        string
+		now        time.Time
+		periods    []*gitlab.FreezePeriod
+		wantOut    []string
+		wantOrder  []string
+		wantStderr string
+	}{
+		{
+			name:    "Upcoming windows",
+			now:     time.Date(2025, time.January, 15, 10, 0, 0, 0, time.UTC),
+			periods: periods,
+			wantOut: []string{
+				"ID", "START", "END", "TIMEZONE", "NEXT FREEZE",
+				"2", "Fri Jan 17 23:00 UTC to Mon Jan 20 07:00 UTC",
+				"1", "Wed Dec 24 18:00 UTC to Fri Jan 2 08:00 UTC",
+				"3", `unknown time zone "Pacific Time (US & Canada)"`,
+			},
+			wantOrder: []string{"Fri Jan 17", "Wed Dec 24", "unknown time zone"},
+		},
+		{
+			name:       "Active window",
+			now:        time.Date(2025, time.January, 18, 12, 0, 0, 0, time.UTC),
+			periods:    periods[1:2],
+			wantOut:    []string{"Now, until Mon Jan 20 07:00 UTC"},
+			wantStderr: "! Deployments are frozen until Mon Jan 20 07:00 UTC.\n",
+		},
+		{
+			name:    "No freeze periods",
+			wantOut: []string{"No deploy freeze periods found for OWNER/REPO."},
+		},
+		{
+			name:    "JSON output",
+			cli:     "-F json",
+			periods: periods[:1],
+			wantOut: []string{`"freeze_start":"0 18 24 12 *"`, `"cron_timezone":"UTC"`},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			now = func() time.Time { return tc.now }
+			t.Cleanup(func() { now = time.Now })
+
+			testClient := gitlabtesting.NewTestClient(t)
+			testClient.MockFreezePeriods.EXPECT().ListFreezePeriods("OWNER/REPO", gomock.Any(), gomock.Any()).Return(tc.periods, &gitlab.Response{}, nil)
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdList, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			out, err := exec(tc.cli)
+			require.NoError(t, err)
+			for _, msg := range tc.wantOut {
+				assert.Contains(t, out.String(), msg)
+			}
+			assert.Equal(t, tc.wantStderr, out.Stderr())
+
+			for i := 1; i < len(tc.wantOrder); i++ {
+				assert.Less(t, strings.Index(out.String(), tc.wantOrder[i-1]), strings.Index(out.String(), tc.wantOrder[i]))
+			}
+		})
+	}
+}
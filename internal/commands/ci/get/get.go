@@ -14,6 +14,7 @@ import (
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
 	"gitlab.com/gitlab-org/cli/internal/commands/ci/ciutils"
 	"gitlab.com/gitlab-org/cli/internal/commands/mr/mrutils"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
 	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
 	"gitlab.com/gitlab-org/cli/internal/tableprinter"
 )
@@ -131,7 +132,7 @@ func NewCmdGet(f cmdutils.Factory) *cobra.Command {
 				printJSON(*mergedPipelineObject, f.IO().StdOut)
 			} else {
 				showJobDetails, _ := cmd.Flags().GetBool("with-job-details")
-				printTable(*mergedPipelineObject, f.IO().StdOut, showJobDetails)
+				printTable(*mergedPipelineObject, f.IO(), showJobDetails)
 			}
 
 			return nil
@@ -155,21 +156,21 @@ func printJSON(p PipelineMergedResponse, dest io.Writer) {
 	fmt.Fprintln(dest, string(JSONStr))
 }
 
-func printTable(p PipelineMergedResponse, dest io.Writer, showJobDetails bool) {
-	printPipelineTable(p, dest)
+func printTable(p PipelineMergedResponse, ios *iostreams.IOStreams, showJobDetails bool) {
+	printPipelineTable(p, ios)
 
 	if showJobDetails {
-		printJobTable(p, dest)
+		printJobTable(p, ios)
 	} else {
-		printJobText(p, dest)
+		printJobText(p, ios)
 	}
 
-	printVariables(p, dest)
+	printVariables(p, ios)
 }
 
-func printPipelineTable(p PipelineMergedResponse, dest io.Writer) {
-	fmt.Fprint(dest, "# Pipeline:\n")
-	pipelineTable := tableprinter.NewTablePrinter()
+func printPipelineTable(p PipelineMergedResponse, ios *iostreams.IOStreams) {
+	fmt.Fprint(ios.StdOut, "# Pipeline:\n")
+	pipelineTable := tableprinter.New(ios)
 	pipelineTable.AddRow("id:", strconv.FormatInt(p.ID, 10))
 	pipelineTable.AddRow("status:", p.Status)
 	pipelineTable.AddRow("source:", p.Source)
@@ -181,39 +182,39 @@ func printPipelineTable(p PipelineMergedResponse, dest io.Writer) {
 	pipelineTable.AddRow("created:", p.CreatedAt)
 	pipelineTable.AddRow("started:", p.StartedAt)
 	pipelineTable.AddRow("updated:", p.UpdatedAt)
-	fmt.Fprintln(dest, pipelineTable.String())
+	fmt.Fprintln(ios.StdOut, pipelineTable.String())
 }
 
-func printJobTable(p PipelineMergedResponse, dest io.Writer) {
-	fmt.Fprint(dest, "# Jobs:\n")
-	jobTable := tableprinter.NewTablePrinter()
-	jobTable.AddRow("ID", "Name", "Status", "Duration", "Failure reason")
+func printJobTable(p PipelineMergedResponse, ios *iostreams.IOStreams) {
+	fmt.Fprint(ios.StdOut, "# Jobs:\n")
+	jobTable := tableprinter.New(ios)
+	jobTable.AddHeader("ID", "Name", "Status", "Duration", "Failure reason")
 	for _, j := range p.Jobs {
 		jobTable.AddRow(j.ID, j.Name, j.Status, j.Duration, j.FailureReason)
 	}
-	fmt.Fprintln(dest, jobTable.String())
+	fmt.Fprintln(ios.StdOut, jobTable.String())
 }
 
-func printJobText(p PipelineMergedResponse, dest io.Writer) {
-	fmt.Fprint(dest, "# Jobs:\n")
-	jobTable := tableprinter.NewTablePrinter()
+func printJobText(p PipelineMergedResponse, ios *iostreams.IOStreams) {
+	fmt.Fprint(ios.StdOut, "# Jobs:\n")
+	jobTable := tableprinter.New(ios)
 	for _, j := range p.Jobs {
 		jobTable.AddRow(j.Name+":", j.Status)
 	}
-	fmt.Fprintln(dest, jobTable.String())
+	fmt.Fprintln(ios.StdOut, jobTable.String())
 }
 
-func printVariables(p PipelineMergedResponse, dest io.Writer) {
+func printVariables(p PipelineMergedResponse, ios *iostreams.IOStreams) {
 	if p.Variables != nil {
-		fmt.Fprint(dest, "# Variables:\n")
+		fmt.Fprint(ios.StdOut, "# Variables:\n")
 		if len(p.Variables) == 0 {
-			fmt.Fprint(dest, NoVariablesInPipelineMessage)
+			fmt.Fprint(ios.StdOut, NoVariablesInPipelineMessage)
 		}
 
-		varTable := tableprinter.NewTablePrinter()
+		varTable := tableprinter.New(ios)
 		for _, v := range p.Variables {
 			varTable.AddRow(v.Key+":", v.Value)
 		}
-		fmt.Fprintln(dest, varTable.String())
+		fmt.Fprintln(ios.StdOut, varTable.String())
 	}
 }
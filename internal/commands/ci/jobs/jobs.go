@@ -0,0 +1,303 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/google/shlex"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/ci/ciutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/git"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	branch       string
+	pipelineID   int
+	failed       bool
+	quickfix     bool
+	openEditor   bool
+	languages    []string
+	outputFormat string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+	config       func() config.Config
+}
+
+// runEditor runs the editor with the arguments, attached to the terminal.
+var runEditor = func(ctx context.Context, ios *iostreams.IOStreams, args []string) error {
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Stdin = ios.In
+	cmd.Stdout = ios.StdOut
+	cmd.Stderr = ios.StdErr
+	return cmd.Run()
+}
+
+func NewCmdJobs(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+		config:       f.Config,
+	}
+	jobsCmd := &cobra.Command{
+		Use:   "jobs [flags]",
+		Short: `List the jobs of a pipeline, or jump to the errors of its failed jobs.`,
+		Long: heredoc.Docf(`
+			List the jobs of the latest pipeline of a branch, or of the pipeline given
+			with --pipeline-id.
+
+			With --quickfix or --open-editor, read the logs of the failed jobs for error
+			locations like %[1]smain.go:12:5: undefined: foo%[1]s. --quickfix prints them in
+			the format of a Vim quickfix list, which Emacs compilation mode reads as well.
+			--open-editor opens the first one in your editor. Paths in the logs are made
+			relative to the project, so run the command from the top-level directory of
+			your clone, or let --open-editor find it.
+
+			Error locations are found for these languages: %[2]s. Use --language to
+			look for the errors of some of them only.
+		`, "`", joinLanguages()),
+		Args: cobra.NoArgs,
+		Example: heredoc.Doc(`
+			# List the jobs of the latest pipeline on the current branch
+			$ glab ci jobs
+
+			# List the failed jobs of a pipeline
+			$ glab ci jobs --failed -p 123
+
+			# Open the first error of the failed jobs in your editor
+			$ glab ci jobs --failed --open-editor
+
+			# Load the errors of the failed Go jobs into Vim
+			$ vim -q <(glab ci jobs --failed --quickfix --language go)
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if (opts.quickfix || opts.openEditor) && !opts.failed {
+				return &cmdutils.FlagError{Err: errors.New("--quickfix and --open-editor require --failed.")}
+			}
+			if cmd.Flags().Changed("language") && !opts.quickfix && !opts.openEditor {
+				return &cmdutils.FlagError{Err: errors.New("--language requires --quickfix or --open-editor.")}
+			}
+			for _, lang := range opts.languages {
+				if _, ok := ciutils.FailurePatterns[lang]; !ok {
+					return &cmdutils.FlagError{Err: fmt.Errorf("unknown language %q. Use one of: %s.", lang, joinLanguages())}
+				}
+			}
+			return opts.run(cmd.Context())
+		},
+	}
+
+	fl := jobsCmd.Flags()
+	fl.StringVarP(&opts.branch, "branch", "b", "", "The branch of the pipeline. (default current branch)")
+	fl.IntVarP(&opts.pipelineID, "pipeline-id", "p", 0, "The ID of the pipeline.")
+	fl.BoolVar(&opts.failed, "failed", false, "Only list the failed jobs.")
+	fl.BoolVar(&opts.quickfix, "quickfix", false, "Print the error locations in the logs of the failed jobs as a quickfix list.")
+	fl.BoolVar(&opts.openEditor, "open-editor", false, "Open the first error location in the logs of the failed jobs in your editor.")
+	fl.StringSliceVarP(&opts.languages, "language", "l", nil, "Look for the error locations of these languages only. (default all)")
+	fl.VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+	jobsCmd.MarkFlagsMutuallyExclusive("quickfix", "open-editor")
+
+	return jobsCmd
+}
+
+func joinLanguages() string {
+	return strings.Join(ciutils.FailureLanguages(), ", ")
+}
+
+func (o *options) run(ctx context.Context) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	jobs, err := ciutils.ListPipelineJobs(&ciutils.JobInputs{
+		Branch:     o.branch,
+		PipelineId: o.pipelineID,
+	}, &ciutils.JobOptions{
+		Client: client,
+		IO:     o.io,
+		Repo:   repo,
+	})
+	if err != nil {
+		return err
+	}
+	if o.failed {
+		jobs = slices.DeleteFunc(jobs, func(job *gitlab.Job) bool {
+			return job.Status != "failed"
+		})
+	}
+
+	if o.quickfix || o.openEditor {
+		if len(jobs) == 0 {
+			return errors.New("the pipeline has no failed jobs.")
+		}
+		locations, err := o.failureLocations(client, repo, jobs)
+		if err != nil {
+			return err
+		}
+		if o.openEditor {
+			if len(locations) == 0 {
+				return errors.New("no error locations found in the logs of the failed jobs.")
+			}
+			return o.edit(ctx, locations[0])
+		}
+		return o.printLocations(locations)
+	}
+
+	return o.printJobs(jobs)
+}
+
+// failureLocations returns the error locations in the logs of the jobs.
+func (o *options) failureLocations(client *gitlab.Client, repo glrepo.Interface, jobs []*gitlab.Job) ([]ciutils.FailureLocation, error) {
+	var locations []ciutils.FailureLocation
+	for _, job := range jobs {
+		trace, _, err := client.Jobs.GetTraceFile(repo.FullName(), job.ID)
+		if err != nil {
+			return nil, cmdutils.WrapError(err, fmt.Sprintf("failed to get the log of job %s (%d).", job.Name, job.ID))
+		}
+		jobLocations, err := ciutils.ParseFailures(trace, o.languages, repo.FullName())
+		if err != nil {
+			return nil, err
+		}
+		locations = append(locations, jobLocations...)
+	}
+	return locations, nil
+}
+
+func (o *options) printLocations(locations []ciutils.FailureLocation) error {
+	if o.outputFormat == "json" {
+		locationsJSON, _ := json.Marshal(locations)
+		fmt.Fprintln(o.io.StdOut, string(locationsJSON))
+		return nil
+	}
+	if len(locations) == 0 {
+		o.io.LogInfof("No error locations found in the logs of the failed jobs.\n")
+		return nil
+	}
+	for _, location := range locations {
+		fmt.Fprintln(o.io.StdOut, location.Quickfix())
+	}
+	return nil
+}
+
+// edit opens the file of the location in the editor, at the line of the
+// location.
+func (o *options) edit(ctx context.Context, location ciutils.FailureLocation) error {
+	editor, _ := cmdutils.GetEditor(o.config)
+	if editor == "" {
+		editor = os.Getenv("EDITOR")
+	}
+	if editor == "" {
+		return errors.New("no editor set. Set the editor with 'glab config set editor <editor>', or the EDITOR environment variable.")
+	}
+
+	path := location.File
+	if !filepath.IsAbs(path) {
+		if dir, err := git.ToplevelDir(); err == nil {
+			path = filepath.Join(dir, path)
+		}
+	}
+
+	args, err := editorArgs(editor, path, location)
+	if err != nil {
+		return err
+	}
+	if o.io.IsErrTTY {
+		fmt.Fprintf(o.io.StdErr, "Opening %s\n", location.Quickfix())
+	}
+	if err := runEditor(ctx, o.io, args); err != nil {
+		return fmt.Errorf("failed to run editor: %w", err)
+	}
+	return nil
+}
+
+// editorArgs returns the command that opens path in the editor at the line of
+// the location. Most editors take the line as +<line>, and VS Code and the
+// editors based on it as -g <path>:<line>:<column>.
+func editorArgs(editor, path string, location ciutils.FailureLocation) ([]string, error) {
+	args, err := shlex.Split(editor)
+	if err != nil || len(args) == 0 {
+		return nil, fmt.Errorf("failed to parse editor command %q.", editor)
+	}
+
+	switch filepath.Base(args[0]) {
+	case "code", "code-insiders", "codium", "cursor":
+		goTo := path + ":" + strconv.Itoa(location.Line)
+		if location.Column > 0 {
+			goTo += ":" + strconv.Itoa(location.Column)
+		}
+		return append(args, "-g", goTo), nil
+	default:
+		return append(args, "+"+strconv.Itoa(location.Line), path), nil
+	}
+}
+
+func (o *options) printJobs(jobs []*gitlab.Job) error {
+	if o.outputFormat == "json" {
+		jobsJSON, _ := json.Marshal(jobs)
+		fmt.Fprintln(o.io.StdOut, string(jobsJSON))
+		return nil
+	}
+	if len(jobs) == 0 {
+		if o.failed {
+			o.io.LogInfof("No failed jobs found.\n")
+		} else {
+			o.io.LogInfof("No jobs found.\n")
+		}
+		return nil
+	}
+
+	c := o.io.Color()
+	table := tableprinter.New(o.io)
+	table.AddHeader("ID", "NAME", "STAGE", "STATUS", "DURATION")
+	for _, job := range jobs {
+		var status string
+		switch job.Status {
+		case "failed":
+			if job.AllowFailure {
+				status = c.Yellow(job.Status)
+			} else {
+				status = c.Red(job.Status)
+			}
+		case "success":
+			status = c.Green(job.Status)
+		default:
+			status = c.Gray(job.Status)
+		}
+		duration := ""
+		if job.Duration > 0 {
+			duration = utils.FmtDuration(time.Duration(job.Duration * float64(time.Second)))
+		}
+		table.AddRow(job.ID, job.Name, job.Stage, status, c.Gray(duration))
+	}
+	fmt.Fprint(o.io.StdOut, table.String())
+	return nil
+}
@@ -0,0 +1,171 @@
+//go:build !integration
+
+package jobs
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/commands/ci/ciutils"
+	"gitlab.com/gitlab-org/cli/internal/git"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+var lastPageResponse = &gitlab.Response{
+	Response: &http.Response{StatusCode: http.StatusOK},
+	NextPage: 0,
+}
+
+func pipelineJobs() []*gitlab.Job {
+	return []*gitlab.Job{
+		{ID: 1, Name: "lint", Stage: "test", Status: "success", Duration: 65},
+		{ID: 2, Name: "unit", Stage: "test", Status: "failed", Duration: 12},
+		{ID: 3, Name: "build", Stage: "build", Status: "failed", AllowFailure: true},
+	}
+}
+
+func TestJobs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		args          string
+		setupMock     func(tc *gitlabtesting.TestClient)
+		expectedOut   string
+		expectedError string
+	}{
+		{
+			name: "list jobs",
+			args: "-p 123",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockJobs.EXPECT().
+					ListPipelineJobs("OWNER/REPO", int64(123), gomock.Any(), gomock.Any()).
+					Return(pipelineJobs(), lastPageResponse, nil)
+			},
+			expectedOut: "ID\tNAME\tSTAGE\tSTATUS\tDURATION\n" +
+				"1\tlint\ttest\tsuccess\t01m 05s\n" +
+				"2\tunit\ttest\tfailed\t00m 12s\n" +
+				"3\tbuild\tbuild\tfailed\t\n",
+		},
+		{
+			name: "list failed jobs",
+			args: "-p 123 --failed",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockJobs.EXPECT().
+					ListPipelineJobs("OWNER/REPO", int64(123), gomock.Any(), gomock.Any()).
+					Return(pipelineJobs(), lastPageResponse, nil)
+			},
+			expectedOut: "ID\tNAME\tSTAGE\tSTATUS\tDURATION\n" +
+				"2\tunit\ttest\tfailed\t00m 12s\n" +
+				"3\tbuild\tbuild\tfailed\t\n",
+		},
+		{
+			name: "quickfix list of the failed jobs",
+			args: "-p 123 --failed --quickfix",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockJobs.EXPECT().
+					ListPipelineJobs("OWNER/REPO", int64(123), gomock.Any(), gomock.Any()).
+					Return(pipelineJobs(), lastPageResponse, nil)
+				tc.MockJobs.EXPECT().
+					GetTraceFile("OWNER/REPO", int64(2), gomock.Any()).
+					Return(bytes.NewReader([]byte("$ go test ./...\n    foo_test.go:34: expected 1, got 2\nFAIL\n")), nil, nil)
+				tc.MockJobs.EXPECT().
+					GetTraceFile("OWNER/REPO", int64(3), gomock.Any()).
+					Return(bytes.NewReader([]byte("/builds/OWNER/REPO/cmd/main.go:12:5: undefined: foo\n")), nil, nil)
+			},
+			expectedOut: "foo_test.go:34: expected 1, got 2\ncmd/main.go:12:5: undefined: foo\n",
+		},
+		{
+			name: "quickfix list without failed jobs",
+			args: "-p 123 --failed --quickfix",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockJobs.EXPECT().
+					ListPipelineJobs("OWNER/REPO", int64(123), gomock.Any(), gomock.Any()).
+					Return(pipelineJobs()[:1], lastPageResponse, nil)
+			},
+			expectedError: "the pipeline has no failed jobs.",
+		},
+		{
+			name:          "quickfix requires failed",
+			args:          "-p 123 --quickfix",
+			expectedError: "--quickfix and --open-editor require --failed.",
+		},
+		{
+			name:          "unknown language",
+			args:          "-p 123 --failed --quickfix --language cobol",
+			expectedError: `unknown language "cobol". Use one of: generic, go, javascript, python, rust.`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			testClient := gitlabtesting.NewTestClient(t)
+			if tc.setupMock != nil {
+				tc.setupMock(testClient)
+			}
+			exec := cmdtest.SetupCmdForTest(t, NewCmdJobs, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			out, err := exec(tc.args)
+			if tc.expectedError != "" {
+				require.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedOut, out.OutBuf.String())
+		})
+	}
+}
+
+func TestJobs_OpenEditor(t *testing.T) {
+	t.Setenv("GLAB_EDITOR", "vim")
+	oldToplevelDir, oldRunEditor := git.ToplevelDir, runEditor
+	t.Cleanup(func() { git.ToplevelDir, runEditor = oldToplevelDir, oldRunEditor })
+	git.ToplevelDir = func() (string, error) { return "/src/repo", nil }
+	var gotArgs []string
+	runEditor = func(_ context.Context, _ *iostreams.IOStreams, args []string) error {
+		gotArgs = args
+		return nil
+	}
+
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockJobs.EXPECT().
+		ListPipelineJobs("OWNER/REPO", int64(123), gomock.Any(), gomock.Any()).
+		Return(pipelineJobs(), lastPageResponse, nil)
+	testClient.MockJobs.EXPECT().
+		GetTraceFile("OWNER/REPO", int64(2), gomock.Any()).
+		Return(bytes.NewReader([]byte("/builds/OWNER/REPO/cmd/main.go:12:5: undefined: foo\n")), nil, nil)
+	testClient.MockJobs.EXPECT().
+		GetTraceFile("OWNER/REPO", int64(3), gomock.Any()).
+		Return(bytes.NewReader([]byte("ok\n")), nil, nil)
+	exec := cmdtest.SetupCmdForTest(t, NewCmdJobs, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	_, err := exec("-p 123 --failed --open-editor")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"vim", "+12", "/src/repo/cmd/main.go"}, gotArgs)
+}
+
+func TestEditorArgs(t *testing.T) {
+	t.Parallel()
+
+	location := ciutils.FailureLocation{File: "main.go", Line: 12, Column: 5}
+
+	args, err := editorArgs("emacsclient -t", "main.go", location)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"emacsclient", "-t", "+12", "main.go"}, args)
+
+	args, err = editorArgs("/usr/bin/code --wait", "main.go", location)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/usr/bin/code", "--wait", "-g", "main.go:12:5"}, args)
+}
@@ -2,10 +2,12 @@ package lint
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
+	"strings"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/spf13/cobra"
@@ -24,10 +26,12 @@ type options struct {
 	gitlabClient func() (*gitlab.Client, error)
 	baseRepo     func() (glrepo.Interface, error)
 
-	path        string
-	ref         string
-	dryRun      bool
-	includeJobs bool
+	path              string
+	ref               string
+	dryRun            bool
+	includeJobs       bool
+	includeMergedYAML bool
+	outputFile        string
 }
 
 func NewCmdLint(f cmdutils.Factory) *cobra.Command {
@@ -45,12 +49,23 @@ func NewCmdLint(f cmdutils.Factory) *cobra.Command {
 			$ glab ci lint
 			$ glab ci lint .gitlab-ci.yml
 			$ glab ci lint path/to/.gitlab-ci.yml
+
+			# Print the configuration with all includes and extends resolved
+			$ glab ci lint --include-merged-yaml
+
+			# Write the merged configuration to a file
+			$ glab ci lint --include-merged-yaml --output merged.yml
 		`),
 		Annotations: map[string]string{
 			mcpannotations.Safe: "true",
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.complete(args)
+
+			if err := opts.validate(); err != nil {
+				return err
+			}
+
 			return opts.run()
 		},
 	}
@@ -58,6 +73,8 @@ func NewCmdLint(f cmdutils.Factory) *cobra.Command {
 	pipelineCILintCmd.Flags().BoolVarP(&opts.dryRun, "dry-run", "", false, "Run pipeline creation simulation.")
 	pipelineCILintCmd.Flags().BoolVarP(&opts.includeJobs, "include-jobs", "", false, "Response includes the list of jobs that would exist in a static check or pipeline simulation.")
 	pipelineCILintCmd.Flags().StringVar(&opts.ref, "ref", "", "When 'dry-run' is true, sets the branch or tag context for validating the CI/CD YAML configuration.")
+	pipelineCILintCmd.Flags().BoolVar(&opts.includeMergedYAML, "include-merged-yaml", false, "Show the merged CI/CD YAML configuration, with all includes and extends resolved.")
+	pipelineCILintCmd.Flags().StringVar(&opts.outputFile, "output", "", "When 'include-merged-yaml' is true, write the merged CI/CD YAML configuration to this file instead of the standard output.")
 
 	return pipelineCILintCmd
 }
@@ -70,6 +87,14 @@ func (o *options) complete(args []string) {
 	}
 }
 
+func (o *options) validate() error {
+	if o.outputFile != "" && !o.includeMergedYAML {
+		return &cmdutils.FlagError{Err: errors.New("--output can only be used with --include-merged-yaml.")}
+	}
+
+	return nil
+}
+
 func (o *options) run() error {
 	var err error
 	out := o.io.StdOut
@@ -144,5 +169,40 @@ func (o *options) run() error {
 		return cmdutils.SilentError
 	}
 	fmt.Fprintln(out, c.GreenCheck(), "CI/CD YAML is valid!")
+
+	if o.includeMergedYAML {
+		return o.renderMergedYAML(lint)
+	}
+	return nil
+}
+
+// renderMergedYAML prints the files included by the configuration, and writes the
+// merged configuration to the output file or, if none is set, to the standard output.
+func (o *options) renderMergedYAML(lint *gitlab.ProjectLintResult) error {
+	out := o.io.StdOut
+	c := o.io.Color()
+
+	if len(lint.Includes) > 0 {
+		fmt.Fprintln(out, c.Bold("Included files:"))
+		for _, include := range lint.Includes {
+			fmt.Fprintf(out, "  %s %s\n", c.Gray(include.Type+":"), include.Location)
+		}
+	}
+
+	mergedYAML := lint.MergedYaml
+	if !strings.HasSuffix(mergedYAML, "\n") {
+		mergedYAML += "\n"
+	}
+
+	if o.outputFile != "" {
+		if err := os.WriteFile(o.outputFile, []byte(mergedYAML), 0o644); err != nil {
+			return fmt.Errorf("could not write merged YAML to %s: %w", o.outputFile, err)
+		}
+		fmt.Fprintf(out, "%s Merged YAML written to %s.\n", c.GreenCheck(), o.outputFile)
+		return nil
+	}
+
+	fmt.Fprintln(out, c.Bold("Merged YAML:"))
+	fmt.Fprint(out, mergedYAML)
 	return nil
 }
@@ -4,7 +4,9 @@ package lint
 
 import (
 	"fmt"
+	"os"
 	"path"
+	"path/filepath"
 	"runtime"
 	"testing"
 
@@ -121,6 +123,38 @@ func Test_lintRun(t *testing.T) {
 					}, nil, nil)
 			},
 		},
+		{
+			name:             "when --include-merged-yaml is used",
+			testFile:         ".gitlab-ci.yaml",
+			cliArgs:          "--include-merged-yaml",
+			StdOut:           "Validating...\n✓ CI/CD YAML is valid!\nIncluded files:\n  local: /templates/build.yml\nMerged YAML:\nbuild:\n  script: make\n",
+			wantErr:          false,
+			errMsg:           "",
+			showHaveBaseRepo: true,
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockProjects.EXPECT().
+					GetProject("OWNER/REPO", gomock.Any()).
+					Return(&gitlab.Project{
+						ID: 123,
+					}, nil, nil)
+				tc.MockValidate.EXPECT().
+					ProjectNamespaceLint(int64(123), gomock.Any()).
+					Return(&gitlab.ProjectLintResult{
+						Valid:      true,
+						MergedYaml: "build:\n  script: make",
+						Includes:   []gitlab.Include{{Type: "local", Location: "/templates/build.yml"}},
+					}, nil, nil)
+			},
+		},
+		{
+			name:             "when --output is used without --include-merged-yaml",
+			testFile:         ".gitlab-ci.yaml",
+			cliArgs:          "--output merged.yml",
+			wantErr:          true,
+			errMsg:           "--output can only be used with --include-merged-yaml.",
+			showHaveBaseRepo: true,
+			setupMock:        func(tc *gitlabtesting.TestClient) {},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -160,3 +194,34 @@ func Test_lintRun(t *testing.T) {
 		})
 	}
 }
+
+func Test_lintRun_mergedYAMLToFile(t *testing.T) {
+	t.Parallel()
+
+	// GIVEN
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockProjects.EXPECT().
+		GetProject("OWNER/REPO", gomock.Any()).
+		Return(&gitlab.Project{ID: 123}, nil, nil)
+	testClient.MockValidate.EXPECT().
+		ProjectNamespaceLint(int64(123), gomock.Any()).
+		Return(&gitlab.ProjectLintResult{
+			Valid:      true,
+			MergedYaml: "build:\n  script: make\n",
+		}, nil, nil)
+
+	_, filename, _, _ := runtime.Caller(0)
+	outputFile := filepath.Join(t.TempDir(), "merged.yml")
+	exec := cmdtest.SetupCmdForTest(t, NewCmdLint, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	// WHEN
+	result, err := exec(path.Join(path.Dir(filename), "testdata", ".gitlab-ci.yaml") + " --include-merged-yaml --output " + outputFile)
+
+	// THEN
+	require.NoError(t, err)
+	assert.Equal(t, "Validating...\n✓ CI/CD YAML is valid!\n✓ Merged YAML written to "+outputFile+".\n", result.String())
+
+	content, err := os.ReadFile(outputFile)
+	require.NoError(t, err)
+	assert.Equal(t, "build:\n  script: make\n", string(content))
+}
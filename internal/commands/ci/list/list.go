@@ -1,8 +1,9 @@
 package list
 
 import (
-	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/MakeNowJust/heredoc/v2"
@@ -13,6 +14,7 @@ import (
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
 	"gitlab.com/gitlab-org/cli/internal/commands/ci/ciutils"
 	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/text"
 	"gitlab.com/gitlab-org/cli/internal/utils"
 )
 
@@ -23,6 +25,12 @@ func NewCmdList(f cmdutils.Factory) *cobra.Command {
 		Example: heredoc.Doc(`
 			$ glab ci list
 			$ glab ci list --status=failed
+
+			# Print the pipelines as Slack mrkdwn, for a chat bot to post
+			$ glab ci list --output slack
+
+			# Print the pipelines as JSON, with the total count and the next page
+			$ glab ci list --output json --include-meta
 		`),
 		Long: ``,
 		Args: cobra.ExactArgs(0),
@@ -51,7 +59,10 @@ func NewCmdList(f cmdutils.Factory) *cobra.Command {
 			}
 
 			format, _ := cmd.Flags().GetString("output")
-			jsonOut := format == "json"
+			includeMeta, _ := cmd.Flags().GetBool("include-meta")
+			if includeMeta && format != "json" {
+				return &cmdutils.FlagError{Err: errors.New("--include-meta requires --output json.")}
+			}
 
 			if m, _ := cmd.Flags().GetString("status"); m != "" {
 				l.Status = gitlab.Ptr(gitlab.BuildStateValue(m))
@@ -105,7 +116,7 @@ func NewCmdList(f cmdutils.Factory) *cobra.Command {
 				l.UpdatedBefore = gitlab.Ptr(updatedBeforeTime)
 			}
 
-			pipes, _, err := client.Pipelines.ListProjectPipelines(repo.FullName(), l)
+			pipes, resp, err := client.Pipelines.ListProjectPipelines(repo.FullName(), l)
 			if err != nil {
 				return err
 			}
@@ -115,10 +126,13 @@ func NewCmdList(f cmdutils.Factory) *cobra.Command {
 			title.Page = int(l.Page)
 			title.CurrentPageTotal = len(pipes)
 
-			if jsonOut {
-				pipeListJSON, _ := json.Marshal(pipes)
+			switch format {
+			case "json":
+				pipeListJSON, _ := cmdutils.ListJSON(pipes, resp, includeMeta)
 				fmt.Fprintln(f.IO().StdOut, string(pipeListJSON))
-			} else {
+			case "slack":
+				fmt.Fprint(f.IO().StdOut, slackPipelineList(title.Describe(), pipes))
+			default:
 				fmt.Fprintf(f.IO().StdOut, "%s\n%s\n", title.Describe(), ciutils.DisplayMultiplePipelines(f.IO(), pipes, repo.FullName()))
 			}
 			return nil
@@ -129,7 +143,8 @@ func NewCmdList(f cmdutils.Factory) *cobra.Command {
 	pipelineListCmd.Flags().StringP("sort", "", "desc", "Sort pipelines. Options: asc, desc.")
 	pipelineListCmd.Flags().IntP("page", "p", 1, "Page number.")
 	pipelineListCmd.Flags().IntP("per-page", "P", 30, "Number of items to list per page.")
-	pipelineListCmd.Flags().StringP("output", "F", "text", "Format output. Options: text, json.")
+	pipelineListCmd.Flags().StringP("output", "F", "text", "Format output. Options: text, json, slack.")
+	pipelineListCmd.Flags().Bool("include-meta", false, "With --output json, wrap the pipelines in an object with the pagination and rate limit state.")
 	pipelineListCmd.Flags().StringP("ref", "r", "", "Return only pipelines for given ref.")
 	pipelineListCmd.Flags().String("scope", "", "Return only pipelines with the given scope: {running|pending|finished|branches|tags}")
 	pipelineListCmd.Flags().String("source", "", "Return only pipelines triggered via the given source. See https://docs.gitlab.com/ci/jobs/job_rules/#ci_pipeline_source-predefined-variable for full list. Commonly used options: {merge_request_event|parent_pipeline|pipeline|push|trigger}")
@@ -142,3 +157,17 @@ func NewCmdList(f cmdutils.Factory) *cobra.Command {
 
 	return pipelineListCmd
 }
+
+// slackPipelineList returns the pipelines as a Slack mrkdwn list, under a bold title.
+func slackPipelineList(title string, pipes []*gitlab.PipelineInfo) string {
+	var b strings.Builder
+	b.WriteString(text.SlackBold(text.SlackEscape(title)) + "\n")
+	for _, p := range pipes {
+		fmt.Fprintf(&b, "• %s %s on `%s`", text.SlackLink(p.WebURL, fmt.Sprintf("#%d", p.ID)), p.Status, text.SlackEscape(p.Ref))
+		if p.CreatedAt != nil {
+			fmt.Fprintf(&b, " _%s_", utils.TimeToPrettyTimeAgo(*p.CreatedAt))
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
@@ -3,6 +3,7 @@
 package list
 
 import (
+	"net/http"
 	"regexp"
 	"testing"
 	"time"
@@ -173,3 +174,57 @@ func TestCiListJSON(t *testing.T) {
 	assert.JSONEq(t, expectedOut, output.String())
 	assert.Empty(t, output.Stderr())
 }
+
+func TestSlackPipelineList(t *testing.T) {
+	createdAt := time.Now().Add(-3 * time.Hour)
+	pipes := []*gitlab.PipelineInfo{
+		{ID: 1, Status: "failed", Ref: "main", WebURL: "https://gitlab.com/OWNER/REPO/-/pipelines/1", CreatedAt: &createdAt},
+		{ID: 2, Status: "success", Ref: "fix/<tag>", WebURL: "https://gitlab.com/OWNER/REPO/-/pipelines/2"},
+	}
+
+	assert.Equal(t, heredoc.Doc(`
+		*Showing 2 pipelines on OWNER/REPO. (Page 1)*
+		• <https://gitlab.com/OWNER/REPO/-/pipelines/1|#1> failed on `+"`main`"+` _about 3 hours ago_
+		• <https://gitlab.com/OWNER/REPO/-/pipelines/2|#2> success on `+"`fix/&lt;tag&gt;`"+`
+	`), slackPipelineList("Showing 2 pipelines on OWNER/REPO. (Page 1)\n", pipes))
+}
+
+func TestCiListJSONIncludeMeta(t *testing.T) {
+	t.Parallel()
+
+	testClient := gitlabtesting.NewTestClient(t)
+
+	header := http.Header{}
+	header.Set("X-Total", "31")
+	header.Set("X-Total-Pages", "2")
+	testClient.MockPipelines.EXPECT().
+		ListProjectPipelines("OWNER/REPO", gomock.Any()).
+		Return([]*gitlab.PipelineInfo{{ID: 1, IID: 338, Status: "success"}}, &gitlab.Response{
+			Response:     &http.Response{Header: header},
+			TotalItems:   31,
+			TotalPages:   2,
+			CurrentPage:  1,
+			ItemsPerPage: 30,
+			NextPage:     2,
+		}, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdList, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	output, err := exec("-F json --include-meta")
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"data": [{"id": 1, "iid": 338, "project_id": 0, "status": "success", "source": "", "ref": "", "sha": "", "web_url": "", "name": "", "created_at": null, "updated_at": null}],
+		"meta": {"total_items": 31, "total_pages": 2, "current_page": 1, "per_page": 30, "next_page": 2}
+	}`, output.String())
+}
+
+func TestCiListIncludeMetaRequiresJSON(t *testing.T) {
+	t.Parallel()
+
+	testClient := gitlabtesting.NewTestClient(t)
+	exec := cmdtest.SetupCmdForTest(t, NewCmdList, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	_, err := exec("--include-meta")
+	assert.EqualError(t, err, "--include-meta requires --output json.")
+}
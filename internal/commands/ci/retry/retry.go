@@ -1,14 +1,20 @@
 package retry
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/spf13/cobra"
 
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
 	"gitlab.com/gitlab-org/cli/internal/commands/ci/ciutils"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
 	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+	"gitlab.com/gitlab-org/cli/internal/utils"
 )
 
 func NewCmdRetry(f cmdutils.Factory) *cobra.Command {
@@ -25,8 +31,19 @@ func NewCmdRetry(f cmdutils.Factory) *cobra.Command {
 
 			# Retry job with the name 'lint'
 			$ glab ci retry lint
+
+			# Retry every failed job of the latest pipeline on the current branch
+			$ glab ci retry --failed-only
+
+			# List the failed jobs of pipeline 12345, without retrying them
+			$ glab ci retry --failed-only --pipeline-id 12345 --dry-run
+		`),
+		Long: heredoc.Doc(`
+			Retry a CI/CD job.
+
+			With --failed-only, retry every failed job of a pipeline at once. Jobs that are
+			allowed to fail are retried too.
 		`),
-		Long: ``,
 		Annotations: map[string]string{
 			mcpannotations.Destructive: "true",
 		},
@@ -48,6 +65,25 @@ func NewCmdRetry(f cmdutils.Factory) *cobra.Command {
 			}
 			branch, _ := cmd.Flags().GetString("branch")
 			pipelineId, _ := cmd.Flags().GetInt("pipeline-id")
+			failedOnly, _ := cmd.Flags().GetBool("failed-only")
+			dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+			if failedOnly {
+				if jobName != "" {
+					return &cmdutils.FlagError{Err: errors.New("a job cannot be passed with --failed-only.")}
+				}
+				return retryFailedJobs(f.IO(), &ciutils.JobInputs{
+					Branch:     branch,
+					PipelineId: pipelineId,
+				}, &ciutils.JobOptions{
+					Client: client,
+					IO:     f.IO(),
+					Repo:   repo,
+				}, dryRun)
+			}
+			if dryRun {
+				return &cmdutils.FlagError{Err: errors.New("--dry-run requires --failed-only.")}
+			}
 
 			jobID, err := ciutils.GetJobId(cmd.Context(), &ciutils.JobInputs{
 				JobName:         jobName,
@@ -83,5 +119,58 @@ func NewCmdRetry(f cmdutils.Factory) *cobra.Command {
 
 	pipelineRetryCmd.Flags().StringP("branch", "b", "", "The branch to search for the job. (default current branch)")
 	pipelineRetryCmd.Flags().IntP("pipeline-id", "p", 0, "The pipeline ID to search for the job.")
+	pipelineRetryCmd.Flags().Bool("failed-only", false, "Retry every failed job of the pipeline.")
+	pipelineRetryCmd.Flags().Bool("dry-run", false, "List the jobs that --failed-only would retry, without retrying them.")
 	return pipelineRetryCmd
 }
+
+func retryFailedJobs(ios *iostreams.IOStreams, inputs *ciutils.JobInputs, opts *ciutils.JobOptions, dryRun bool) error {
+	c := ios.Color()
+
+	jobs, err := ciutils.ListPipelineJobs(inputs, opts)
+	if err != nil {
+		return err
+	}
+	var failed []*gitlab.Job
+	for _, job := range jobs {
+		if job.Status == "failed" {
+			failed = append(failed, job)
+		}
+	}
+	if len(failed) == 0 {
+		fmt.Fprintf(ios.StdOut, "%s No failed jobs to retry.\n", c.GreenCheck())
+		return nil
+	}
+
+	if dryRun {
+		table := tableprinter.New(ios)
+		table.AddHeader("ID", "Name", "Stage", "Status")
+		for _, job := range failed {
+			table.AddRow(job.ID, job.Name, job.Stage, job.Status)
+		}
+		fmt.Fprintf(ios.StdOut, "%s %s would be retried:\n\n", c.DotWarnIcon(), utils.Pluralize(len(failed), "job"))
+		fmt.Fprint(ios.StdOut, table.Render())
+		return nil
+	}
+
+	retried := make([]*gitlab.Job, len(failed))
+	errs := ciutils.RunBulkAction(failed, func(i int, job *gitlab.Job) error {
+		var err error
+		retried[i], _, err = opts.Client.Jobs.RetryJob(opts.Repo.FullName(), job.ID)
+		return err
+	})
+
+	errCount := 0
+	for i, job := range failed {
+		if errs[i] != nil {
+			errCount++
+			fmt.Fprintf(ios.StdErr, "%s Could not retry job %s (ID: %d): %s\n", c.FailedIcon(), job.Name, job.ID, errs[i])
+			continue
+		}
+		fmt.Fprintf(ios.StdOut, "Retried job (ID: %d), status: %s, ref: %s, weburl: %s\n", retried[i].ID, retried[i].Status, retried[i].Ref, retried[i].WebURL)
+	}
+	if errCount > 0 {
+		return fmt.Errorf("%d of %d jobs could not be retried.", errCount, len(failed))
+	}
+	return nil
+}
@@ -196,3 +196,66 @@ func TestCiRetry(t *testing.T) {
 		})
 	}
 }
+
+func TestCiRetryFailedOnly(t *testing.T) {
+	t.Parallel()
+
+	lastPageResponse := &gitlab.Response{
+		Response: &http.Response{StatusCode: http.StatusOK},
+		NextPage: 0,
+	}
+	jobs := []*gitlab.Job{
+		{ID: 1, Name: "build", Stage: "build", Status: "success"},
+		{ID: 2, Name: "lint", Stage: "test", Status: "failed"},
+		{ID: 3, Name: "unit", Stage: "test", Status: "failed"},
+	}
+
+	t.Run("retries the failed jobs", func(t *testing.T) {
+		t.Parallel()
+
+		testClient := gitlabtesting.NewTestClient(t)
+		testClient.MockJobs.EXPECT().
+			ListPipelineJobs("OWNER/REPO", int64(123), gomock.Any(), gomock.Any()).
+			Return(jobs, lastPageResponse, nil)
+		testClient.MockJobs.EXPECT().
+			RetryJob("OWNER/REPO", int64(2), gomock.Any()).
+			Return(&gitlab.Job{ID: 12, Status: "pending", Ref: "main", WebURL: "https://gitlab.com/OWNER/REPO/-/jobs/12"}, nil, nil)
+		testClient.MockJobs.EXPECT().
+			RetryJob("OWNER/REPO", int64(3), gomock.Any()).
+			Return(nil, nil, fmt.Errorf("403 Forbidden"))
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdRetry, false, cmdtest.WithGitLabClient(testClient.Client))
+
+		output, err := exec("--failed-only -p 123")
+		require.EqualError(t, err, "1 of 2 jobs could not be retried.")
+		assert.Equal(t, "Retried job (ID: 12), status: pending, ref: main, weburl: https://gitlab.com/OWNER/REPO/-/jobs/12\n", output.String())
+		assert.Contains(t, output.Stderr(), "Could not retry job unit (ID: 3): 403 Forbidden")
+	})
+
+	t.Run("lists the failed jobs with --dry-run", func(t *testing.T) {
+		t.Parallel()
+
+		testClient := gitlabtesting.NewTestClient(t)
+		testClient.MockJobs.EXPECT().
+			ListPipelineJobs("OWNER/REPO", int64(123), gomock.Any(), gomock.Any()).
+			Return(jobs, lastPageResponse, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdRetry, false, cmdtest.WithGitLabClient(testClient.Client))
+
+		output, err := exec("--failed-only -p 123 --dry-run")
+		require.NoError(t, err)
+		assert.Contains(t, output.String(), "2 jobs would be retried:")
+		assert.Contains(t, output.String(), "2\tlint\ttest\tfailed")
+		assert.Contains(t, output.String(), "3\tunit\ttest\tfailed")
+		assert.NotContains(t, output.String(), "build")
+	})
+
+	t.Run("rejects a job with --failed-only", func(t *testing.T) {
+		t.Parallel()
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdRetry, false)
+
+		_, err := exec("lint --failed-only")
+		require.EqualError(t, err, "a job cannot be passed with --failed-only.")
+	})
+}
@@ -1,20 +1,27 @@
 package run
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
 
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 
+	"gitlab.com/gitlab-org/cli/internal/api"
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
 	"gitlab.com/gitlab-org/cli/internal/commands/ci/ciutils"
 	"gitlab.com/gitlab-org/cli/internal/commands/mr/mrutils"
 	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
 	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
 	"gitlab.com/gitlab-org/cli/internal/utils"
 )
@@ -181,8 +188,7 @@ func resolvePipelineVars(cmd *cobra.Command) ([]*gitlab.PipelineVariableOptions,
 		if err != nil {
 			return nil, fmt.Errorf("opening variable file: %s", vf)
 		}
-		var result []*gitlab.PipelineVariableOptions
-		err = json.Unmarshal(b, &result)
+		result, err := parseVariablesFrom(vf, b)
 		if err != nil {
 			return nil, fmt.Errorf("loading pipeline values: %w", err)
 		}
@@ -192,9 +198,147 @@ func resolvePipelineVars(cmd *cobra.Command) ([]*gitlab.PipelineVariableOptions,
 	return pipelineVars, nil
 }
 
+// parseVariablesFrom reads the pipeline variables of a --variables-from file. The format
+// depends on the file extension: a dotenv file, a YAML mapping of keys to values, or else
+// a JSON array of variables.
+func parseVariablesFrom(path string, b []byte) ([]*gitlab.PipelineVariableOptions, error) {
+	switch ext := strings.ToLower(filepath.Ext(path)); {
+	case ext == ".env" || strings.HasSuffix(strings.ToLower(filepath.Base(path)), ".env"):
+		return parseDotenvVariables(b)
+	case ext == ".yml" || ext == ".yaml":
+		return parseYAMLVariables(b)
+	}
+
+	var result []*gitlab.PipelineVariableOptions
+	if err := json.Unmarshal(b, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// parseDotenvVariables reads KEY=VALUE lines. Empty lines, comments, and a leading
+// export are ignored, and quotes around values are removed.
+func parseDotenvVariables(b []byte) ([]*gitlab.PipelineVariableOptions, error) {
+	var result []*gitlab.PipelineVariableOptions
+	for i, line := range strings.Split(string(b), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+
+		key, value, ok := strings.Cut(line, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("line %d: expected KEY=VALUE", i+1)
+		}
+		value = strings.TrimSpace(value)
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+
+		result = append(result, &gitlab.PipelineVariableOptions{
+			Key:          gitlab.Ptr(key),
+			Value:        gitlab.Ptr(value),
+			VariableType: gitlab.Ptr(gitlab.EnvVariableType),
+		})
+	}
+	return result, nil
+}
+
+// parseYAMLVariables reads a mapping of keys to scalar values, in the order of the file.
+func parseYAMLVariables(b []byte) ([]*gitlab.PipelineVariableOptions, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Content) == 0 {
+		return nil, nil
+	}
+	mapping := doc.Content[0]
+	if mapping.Kind != yaml.MappingNode {
+		return nil, errors.New("expected a mapping of variable names to values")
+	}
+
+	var result []*gitlab.PipelineVariableOptions
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		key, value := mapping.Content[i], mapping.Content[i+1]
+		if value.Kind != yaml.ScalarNode {
+			return nil, fmt.Errorf("line %d: the value of %s must be a string, number, or boolean", value.Line, key.Value)
+		}
+		result = append(result, &gitlab.PipelineVariableOptions{
+			Key:          gitlab.Ptr(key.Value),
+			Value:        gitlab.Ptr(value.Value),
+			VariableType: gitlab.Ptr(gitlab.EnvVariableType),
+		})
+	}
+	return result, nil
+}
+
+// followInterval is the time between two polls of the pipeline followed with --follow.
+var followInterval = 5 * time.Second
+
+// Exit codes of --follow for the final status of the pipeline.
+const (
+	exitPipelineFailed   = 1
+	exitPipelineCanceled = 2
+	exitPipelineOther    = 3
+)
+
+// followPipeline waits for the pipeline to finish, and reports the status changes of
+// the pipeline and its jobs. It returns an error with the exit code for the final status
+// of the pipeline, unless the pipeline succeeded.
+func followPipeline(ctx context.Context, ios *iostreams.IOStreams, client *gitlab.Client, repo glrepo.Interface, pipelineID int64) error {
+	c := ios.Color()
+	pipelineStatus := ""
+	jobStatuses := map[int64]string{}
+	for {
+		pipeline, _, err := client.Pipelines.GetPipeline(repo.FullName(), pipelineID, api.WithoutCache())
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("could not get pipeline %d.", pipelineID))
+		}
+		jobs, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Job, *gitlab.Response, error) {
+			return client.Jobs.ListPipelineJobs(repo.FullName(), pipelineID, &gitlab.ListJobsOptions{ListOptions: gitlab.ListOptions{PerPage: 100}}, p, api.WithoutCache())
+		})
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("could not list the jobs of pipeline %d.", pipelineID))
+		}
+
+		for _, job := range jobs {
+			if jobStatuses[job.ID] != job.Status {
+				jobStatuses[job.ID] = job.Status
+				fmt.Fprintf(ios.StdOut, "%s %s (%s): %s\n", c.ProgressIcon(), job.Name, job.Stage, job.Status)
+			}
+		}
+		if pipeline.Status != pipelineStatus {
+			pipelineStatus = pipeline.Status
+			fmt.Fprintf(ios.StdOut, "%s Pipeline %d: %s\n", c.ProgressIcon(), pipeline.ID, pipeline.Status)
+		}
+
+		switch pipeline.Status {
+		case "success":
+			fmt.Fprintf(ios.StdOut, "%s Pipeline %d succeeded.\n", c.GreenCheck(), pipeline.ID)
+			return nil
+		case "failed":
+			return cmdutils.WrapErrorWithCode(fmt.Errorf("pipeline %d failed.", pipeline.ID), exitPipelineFailed, "")
+		case "canceled":
+			return cmdutils.WrapErrorWithCode(fmt.Errorf("pipeline %d was canceled.", pipeline.ID), exitPipelineCanceled, "")
+		case "skipped", "manual":
+			return cmdutils.WrapErrorWithCode(fmt.Errorf("pipeline %d finished with status %q.", pipeline.ID, pipeline.Status), exitPipelineOther, "")
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(followInterval):
+		}
+	}
+}
+
 func NewCmdRun(f cmdutils.Factory) *cobra.Command {
 	openInBrowser := false
 	mr := false
+	follow := false
 
 	pipelineRunCmd := &cobra.Command{
 		Use:     "run [flags]",
@@ -213,6 +357,12 @@ func NewCmdRun(f cmdutils.Factory) *cobra.Command {
 			$ glab ci run -b main --variables-env key1:val1 --variables-env key2:val2
 			$ glab ci run -b main --variables-file MYKEY:file1 --variables KEY2:some_value
 
+			# Load CI variables from a dotenv or YAML file
+			$ glab ci run -b main --variables-from vars.env
+
+			# Wait for the pipeline to finish, and exit with its status
+			$ glab ci run -b main --follow
+
 			# Specify CI inputs
 			$ glab ci run -b main --input key1:val1 --input key2:val2
 			$ glab ci run -b main --input "replicas:int(3)" --input "debug:bool(false)" --input "regions:array(us-east,eu-west)"
@@ -227,6 +377,10 @@ func NewCmdRun(f cmdutils.Factory) *cobra.Command {
 The options for variables are incompatible with merge request pipelines.
 If used with merge request pipelines, the command fails with a message like ` + "`ERROR: if any flags in the group [output output-format] are set none of the others can be`" + `
 
+With ` + "`--follow`" + `, the command waits for the pipeline to finish and prints the status
+changes of the pipeline and its jobs. The exit code is 0 if the pipeline succeeds, 1 if it
+fails, 2 if it is canceled, and 3 for any other final status, like skipped.
+
 ` + cmdutils.PipelineInputsDescription,
 		Args: cobra.ExactArgs(0),
 		Annotations: map[string]string{
@@ -282,6 +436,10 @@ If used with merge request pipelines, the command fails with a message like ` +
 
 			output := fmt.Sprintf("Created pipeline (id: %d), status: %s, ref: %s, weburl: %s", pipe.ID, pipe.Status, pipe.Ref, pipe.WebURL)
 			fmt.Fprintln(f.IO().StdOut, output)
+
+			if follow {
+				return followPipeline(cmd.Context(), f.IO(), client, repo, pipe.ID)
+			}
 			return nil
 		},
 	}
@@ -289,9 +447,11 @@ If used with merge request pipelines, the command fails with a message like ` +
 	pipelineRunCmd.Flags().StringSliceP("variables", "", []string{}, "Pass variables to pipeline in format <key>:<value>. Cannot be used for MR pipelines.")
 	pipelineRunCmd.Flags().StringSliceP("variables-env", "", []string{}, "Pass variables to pipeline in format <key>:<value>. Cannot be used for MR pipelines.")
 	pipelineRunCmd.Flags().StringSliceP("variables-file", "", []string{}, "Pass file contents as a file variable to pipeline in format <key>:<filename>. Cannot be used for MR pipelines.")
-	pipelineRunCmd.Flags().StringP("variables-from", "f", "", "JSON file with variables for pipeline execution. Expects array of hashes, each with at least 'key' and 'value'. Cannot be used for MR pipelines.")
+	pipelineRunCmd.Flags().StringP("variables-from", "f", "", "File with variables for pipeline execution: a .env file with KEY=VALUE lines, a .yml or .yaml file with a mapping of keys to values, or else a JSON array of hashes, each with at least 'key' and 'value'. Cannot be used for MR pipelines.")
 	pipelineRunCmd.Flags().BoolVarP(&openInBrowser, "web", "w", false, "Open pipeline in a browser. Uses default browser, or browser specified in BROWSER environment variable.")
 	pipelineRunCmd.Flags().BoolVar(&mr, "mr", false, "Run merge request pipeline instead of branch pipeline.")
+	pipelineRunCmd.Flags().BoolVar(&follow, "follow", false, "Wait for the pipeline to finish, and exit with a code for its final status.")
+	pipelineRunCmd.MarkFlagsMutuallyExclusive("web", "follow")
 	cmdutils.AddPipelineInputsFlag(pipelineRunCmd)
 
 	for _, flag := range []string{"variables", "variables-env", "variables-file", "variables-from", "input"} {
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os/exec"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -16,6 +17,7 @@ import (
 	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
 
 	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
 	"gitlab.com/gitlab-org/cli/internal/run"
 	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
 	"gitlab.com/gitlab-org/cli/test"
@@ -379,3 +381,142 @@ func TestCIRunMrPipeline(t *testing.T) {
 		})
 	}
 }
+
+func TestParseVariablesFrom(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		content  string
+		expected map[string]string
+		wantErr  string
+	}{
+		{
+			name: "dotenv file",
+			path: "vars.env",
+			content: `# deploy settings
+export ENVIRONMENT=staging
+REGION = "eu-west-1"
+EMPTY=
+GREETING='hello world'
+`,
+			expected: map[string]string{"ENVIRONMENT": "staging", "REGION": "eu-west-1", "EMPTY": "", "GREETING": "hello world"},
+		},
+		{
+			name:    "invalid dotenv line",
+			path:    ".env",
+			content: "ENVIRONMENT\n",
+			wantErr: "line 1: expected KEY=VALUE",
+		},
+		{
+			name:     "yaml file",
+			path:     "vars.yaml",
+			content:  "ENVIRONMENT: staging\nREPLICAS: 3\nDEBUG: true\n",
+			expected: map[string]string{"ENVIRONMENT": "staging", "REPLICAS": "3", "DEBUG": "true"},
+		},
+		{
+			name:    "yaml file with a nested value",
+			path:    "vars.yml",
+			content: "ENVIRONMENT:\n  name: staging\n",
+			wantErr: "the value of ENVIRONMENT must be a string, number, or boolean",
+		},
+		{
+			name:     "json file",
+			path:     "vars.json",
+			content:  `[{"key": "ENVIRONMENT", "value": "staging"}]`,
+			expected: map[string]string{"ENVIRONMENT": "staging"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			vars, err := parseVariablesFrom(tc.path, []byte(tc.content))
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			got := map[string]string{}
+			for _, v := range vars {
+				got[*v.Key] = *v.Value
+			}
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestCIRunFollow(t *testing.T) {
+	followInterval = time.Millisecond
+	t.Cleanup(func() { followInterval = 5 * time.Second })
+
+	tests := []struct {
+		name         string
+		statuses     []string
+		expectedCode int
+		expectedOut  []string
+	}{
+		{
+			name:        "pipeline succeeds",
+			statuses:    []string{"running", "running", "success"},
+			expectedOut: []string{"Pipeline 123: running", "build (test): running", "build (test): success", "Pipeline 123 succeeded."},
+		},
+		{
+			name:         "pipeline fails",
+			statuses:     []string{"running", "failed"},
+			expectedCode: 1,
+			expectedOut:  []string{"Pipeline 123: failed"},
+		},
+		{
+			name:         "pipeline is canceled",
+			statuses:     []string{"canceled"},
+			expectedCode: 2,
+		},
+		{
+			name:         "pipeline is skipped",
+			statuses:     []string{"skipped"},
+			expectedCode: 3,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			testClient.MockPipelines.EXPECT().
+				CreatePipeline("OWNER/REPO", gomock.Any()).
+				Return(&gitlab.Pipeline{ID: 123, Status: "created", Ref: "main"}, nil, nil)
+
+			poll := 0
+			testClient.MockPipelines.EXPECT().
+				GetPipeline("OWNER/REPO", int64(123), gomock.Any()).
+				DoAndReturn(func(pid any, id int64, options ...gitlab.RequestOptionFunc) (*gitlab.Pipeline, *gitlab.Response, error) {
+					status := tc.statuses[poll]
+					poll++
+					return &gitlab.Pipeline{ID: 123, Status: status}, nil, nil
+				}).
+				Times(len(tc.statuses))
+			testClient.MockJobs.EXPECT().
+				ListPipelineJobs("OWNER/REPO", int64(123), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(pid any, id int64, opts *gitlab.ListJobsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Job, *gitlab.Response, error) {
+					return []*gitlab.Job{{ID: 1, Name: "build", Stage: "test", Status: tc.statuses[poll-1]}}, &gitlab.Response{}, nil
+				}).
+				Times(len(tc.statuses))
+
+			execFunc := cmdtest.SetupCmdForTest(t, NewCmdRun, true,
+				cmdtest.WithGitLabClient(testClient.Client),
+				cmdtest.WithBranch("main"),
+			)
+
+			out, err := execFunc("--follow")
+			if tc.expectedCode == 0 {
+				require.NoError(t, err)
+			} else {
+				var exitErr *cmdutils.ExitError
+				require.ErrorAs(t, err, &exitErr)
+				assert.Equal(t, tc.expectedCode, exitErr.Code)
+			}
+			for _, line := range tc.expectedOut {
+				assert.Contains(t, out.OutBuf.String(), line)
+			}
+		})
+	}
+}
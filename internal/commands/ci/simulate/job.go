@@ -0,0 +1,203 @@
+package simulate
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// reservedKeywords are the top-level keys of a CI/CD configuration that aren't jobs.
+var reservedKeywords = []string{
+	"default", "include", "stages", "variables", "workflow",
+	"image", "services", "cache", "before_script", "after_script",
+}
+
+// job is a job of a CI/CD configuration, with the defaults and global variables
+// it inherits already applied.
+type job struct {
+	Name         string
+	Stage        string
+	Image        string
+	Entrypoint   []string
+	Variables    []variable
+	BeforeScript []string
+	Script       []string
+	AfterScript  []string
+}
+
+type variable struct {
+	Key   string
+	Value string
+}
+
+// parseJob returns the job called name from a merged CI/CD configuration, where
+// includes, extends, and !reference tags are already resolved.
+func parseJob(content []byte, name string) (*job, error) {
+	var config map[string]any
+	if err := yaml.Unmarshal(content, &config); err != nil {
+		return nil, fmt.Errorf("parsing the CI/CD configuration: %w", err)
+	}
+
+	raw, ok := config[name].(map[string]any)
+	if !ok || slices.Contains(reservedKeywords, name) {
+		return nil, fmt.Errorf("job %q not found in the CI/CD configuration.", name)
+	}
+	if _, ok := raw["trigger"]; ok {
+		return nil, fmt.Errorf("job %q triggers a downstream pipeline and can't be simulated.", name)
+	}
+
+	defaults, _ := config["default"].(map[string]any)
+	if defaults == nil {
+		defaults = map[string]any{}
+	}
+	// Top-level image and scripts are the deprecated form of the defaults.
+	for _, key := range []string{"image", "before_script", "after_script"} {
+		if _, ok := defaults[key]; !ok && config[key] != nil {
+			defaults[key] = config[key]
+		}
+	}
+
+	inherit, _ := raw["inherit"].(map[string]any)
+	inherits := func(kind, key string) bool {
+		switch value := inherit[kind].(type) {
+		case bool:
+			return value
+		case []any:
+			return slices.Contains(value, any(key))
+		default:
+			return true
+		}
+	}
+	value := func(key string) any {
+		if v, ok := raw[key]; ok {
+			return v
+		}
+		if inherits("default", key) {
+			return defaults[key]
+		}
+		return nil
+	}
+
+	j := &job{Name: name, Stage: "test"}
+	if stage, ok := raw["stage"].(string); ok {
+		j.Stage = stage
+	}
+
+	switch image := value("image").(type) {
+	case string:
+		j.Image = image
+	case map[string]any:
+		j.Image, _ = image["name"].(string)
+		entrypoint, err := stringList(image["entrypoint"])
+		if err != nil {
+			return nil, fmt.Errorf("invalid image entrypoint of job %q: %w", name, err)
+		}
+		j.Entrypoint = entrypoint
+	}
+
+	globals, err := parseVariables(config["variables"])
+	if err != nil {
+		return nil, fmt.Errorf("invalid global variables: %w", err)
+	}
+	for _, v := range globals {
+		if inherits("variables", v.Key) {
+			j.Variables = append(j.Variables, v)
+		}
+	}
+	variables, err := parseVariables(raw["variables"])
+	if err != nil {
+		return nil, fmt.Errorf("invalid variables of job %q: %w", name, err)
+	}
+	j.Variables = append(j.Variables, variables...)
+
+	if j.BeforeScript, err = stringList(value("before_script")); err != nil {
+		return nil, fmt.Errorf("invalid before_script of job %q: %w", name, err)
+	}
+	if j.Script, err = stringList(raw["script"]); err != nil {
+		return nil, fmt.Errorf("invalid script of job %q: %w", name, err)
+	}
+	if j.AfterScript, err = stringList(value("after_script")); err != nil {
+		return nil, fmt.Errorf("invalid after_script of job %q: %w", name, err)
+	}
+	if len(j.Script) == 0 {
+		return nil, fmt.Errorf("job %q has no script.", name)
+	}
+
+	return j, nil
+}
+
+// parseVariables reads variables defined as KEY: value, or KEY: {value: value}.
+// They are sorted by key, because YAML mappings have no order.
+func parseVariables(raw any) ([]variable, error) {
+	if raw == nil {
+		return nil, nil
+	}
+	mapping, ok := raw.(map[string]any)
+	if !ok {
+		return nil, errors.New("variables must be a mapping.")
+	}
+
+	keys := make([]string, 0, len(mapping))
+	for key := range mapping {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	variables := make([]variable, 0, len(keys))
+	for _, key := range keys {
+		value := mapping[key]
+		if m, ok := value.(map[string]any); ok {
+			value = m["value"]
+		}
+		switch value.(type) {
+		case string, int, float64, bool:
+			variables = append(variables, variable{Key: key, Value: fmt.Sprint(value)})
+		case nil:
+			variables = append(variables, variable{Key: key})
+		default:
+			return nil, fmt.Errorf("variable %q must be a string.", key)
+		}
+	}
+	return variables, nil
+}
+
+// stringList reads a script, which is a string or a list of strings, where nested
+// lists come from !reference tags.
+func stringList(raw any) ([]string, error) {
+	switch value := raw.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []string{value}, nil
+	case []any:
+		var lines []string
+		for _, item := range value {
+			nested, err := stringList(item)
+			if err != nil {
+				return nil, err
+			}
+			lines = append(lines, nested...)
+		}
+		return lines, nil
+	default:
+		return nil, fmt.Errorf("expected a string or a list of strings, got %v.", raw)
+	}
+}
+
+// shellScript returns a script that prints and runs each line, and stops at the
+// first line that fails, like the runner does.
+func shellScript(lines []string) string {
+	var b strings.Builder
+	b.WriteString("set -e\n")
+	for _, line := range lines {
+		fmt.Fprintf(&b, "echo %s\n%s\n", shellQuote("$ "+line), line)
+	}
+	return b.String()
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
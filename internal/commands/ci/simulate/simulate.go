@@ -0,0 +1,268 @@
+package simulate
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/git"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/run"
+)
+
+var runtimes = []string{"docker", "podman"}
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	jobName   string
+	path      string
+	runtime   string
+	image     string
+	variables []string
+	shell     bool
+	dryRun    bool
+}
+
+func NewCmdSimulate(f cmdutils.Factory) *cobra.Command {
+	opts := options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	simulateCmd := &cobra.Command{
+		Use:   "simulate <job> [flags]",
+		Short: "Run a CI/CD job locally in a container.",
+		Long: heredoc.Doc(`
+			Run a job of the CI/CD configuration in a local Docker or Podman container.
+
+			The configuration is merged by GitLab, so includes and extends are resolved.
+			The job runs in its image, with its variables and a few predefined CI/CD variables,
+			and the working tree is mounted in the container as the project directory.
+
+			Services, caches, artifacts, and rules aren't simulated.
+		`),
+		Args: cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			# Run the job "test" of .gitlab-ci.yml
+			$ glab ci simulate test
+
+			# Open a shell in the environment of the job "build", after its before_script
+			$ glab ci simulate build --shell
+
+			# Run a job of another configuration file with Podman, and an extra variable
+			$ glab ci simulate lint --file ci/lint.yml --runtime podman --variable DEBUG=1
+
+			# Print the container commands without running them
+			$ glab ci simulate test --dry-run
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.jobName = args[0]
+
+			if err := opts.validate(); err != nil {
+				return err
+			}
+
+			return opts.run()
+		},
+	}
+
+	simulateCmd.Flags().StringVarP(&opts.path, "file", "f", ".gitlab-ci.yml", "Path to the CI/CD configuration.")
+	simulateCmd.Flags().StringVar(&opts.runtime, "runtime", "", fmt.Sprintf("Container runtime: %s. Defaults to the first one installed.", strings.Join(runtimes, ", ")))
+	simulateCmd.Flags().StringVar(&opts.image, "image", "", "Run the job in this image instead of the image of the job.")
+	simulateCmd.Flags().StringArrayVar(&opts.variables, "variable", nil, "Pass a variable to the job, in key=value format. Overrides the variables of the configuration.")
+	simulateCmd.Flags().BoolVar(&opts.shell, "shell", false, "Open an interactive shell in the job environment instead of running the script.")
+	simulateCmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Print the container commands instead of running them.")
+
+	return simulateCmd
+}
+
+func (o *options) validate() error {
+	if o.runtime != "" && !slices.Contains(runtimes, o.runtime) {
+		return &cmdutils.FlagError{Err: fmt.Errorf("--runtime must be one of: %s.", strings.Join(runtimes, ", "))}
+	}
+	for _, v := range o.variables {
+		if !strings.Contains(v, "=") {
+			return &cmdutils.FlagError{Err: fmt.Errorf("invalid variable %q: must be in key=value format.", v)}
+		}
+	}
+	if o.shell && o.dryRun {
+		return &cmdutils.FlagError{Err: errors.New("--shell and --dry-run can't be used together.")}
+	}
+	return nil
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return fmt.Errorf("You must be in a GitLab project repository for this action: %w", err)
+	}
+
+	project, err := repo.Project(client)
+	if err != nil {
+		return fmt.Errorf("You must be in a GitLab project repository for this action: %w", err)
+	}
+
+	content, err := os.ReadFile(o.path)
+	if err != nil {
+		return fmt.Errorf("reading CI/CD configuration at %s: %w", o.path, err)
+	}
+
+	lint, _, err := client.Validate.ProjectNamespaceLint(project.ID, &gitlab.ProjectNamespaceLintOptions{
+		Content: gitlab.Ptr(string(content)),
+		DryRun:  gitlab.Ptr(false),
+	})
+	if err != nil {
+		return err
+	}
+	if !lint.Valid {
+		return fmt.Errorf("could not compile %s: %s", o.path, strings.Join(lint.Errors, ", "))
+	}
+
+	job, err := parseJob([]byte(lint.MergedYaml), o.jobName)
+	if err != nil {
+		return err
+	}
+	if o.image != "" {
+		job.Image, job.Entrypoint = o.image, nil
+	}
+	if job.Image == "" {
+		return fmt.Errorf("job %q has no image. Use --image to choose one.", job.Name)
+	}
+
+	runtime := o.runtime
+	if runtime == "" {
+		for _, name := range runtimes {
+			if _, err := exec.LookPath(name); err == nil {
+				runtime = name
+				break
+			}
+		}
+		if runtime == "" {
+			return fmt.Errorf("no container runtime found. Install one of: %s.", strings.Join(runtimes, ", "))
+		}
+	}
+
+	workTree, err := git.ToplevelDir()
+	if err != nil || workTree == "" {
+		if workTree, err = os.Getwd(); err != nil {
+			return err
+		}
+	}
+
+	projectDir := path.Join("/builds", project.PathWithNamespace)
+	env := []variable{
+		{"CI", "true"},
+		{"GITLAB_CI", "true"},
+		{"CI_SERVER_HOST", repo.RepoHost()},
+		{"CI_PROJECT_ID", fmt.Sprint(project.ID)},
+		{"CI_PROJECT_NAME", project.Path},
+		{"CI_PROJECT_NAMESPACE", path.Dir(project.PathWithNamespace)},
+		{"CI_PROJECT_PATH", project.PathWithNamespace},
+		{"CI_PROJECT_DIR", projectDir},
+		{"CI_BUILDS_DIR", "/builds"},
+		{"CI_JOB_NAME", job.Name},
+		{"CI_JOB_STAGE", job.Stage},
+	}
+	env = append(env, job.Variables...)
+	for _, v := range o.variables {
+		key, value, _ := strings.Cut(v, "=")
+		env = append(env, variable{key, value})
+	}
+
+	c := o.io.Color()
+	if o.shell {
+		script := shellScript(job.BeforeScript) + "exec sh\n"
+		fmt.Fprintf(o.io.StdErr, "%s Opening a shell in the environment of job %s. Exit the shell to stop.\n", c.ProgressIcon(), job.Name)
+		return o.runContainer(runtime, job, workTree, projectDir, env, script, true)
+	}
+
+	script := shellScript(append(slices.Clone(job.BeforeScript), job.Script...))
+	fmt.Fprintf(o.io.StdErr, "%s Running job %s in %s with %s.\n", c.ProgressIcon(), job.Name, job.Image, runtime)
+	jobErr := o.runContainer(runtime, job, workTree, projectDir, env, script, false)
+
+	if len(job.AfterScript) > 0 {
+		status := "success"
+		if jobErr != nil {
+			status = "failed"
+		}
+		afterEnv := append(slices.Clone(env), variable{"CI_JOB_STATUS", status})
+		if err := o.runContainer(runtime, job, workTree, projectDir, afterEnv, shellScript(job.AfterScript), false); err != nil {
+			fmt.Fprintf(o.io.StdErr, "%s after_script failed: %s\n", c.WarnIcon(), err)
+		}
+	}
+
+	if o.dryRun {
+		return nil
+	}
+
+	var exitErr *exec.ExitError
+	switch {
+	case errors.As(jobErr, &exitErr):
+		return cmdutils.WrapErrorWithCode(fmt.Errorf("job %s failed with exit code %d.", job.Name, exitErr.ExitCode()), exitErr.ExitCode(), "")
+	case jobErr != nil:
+		return jobErr
+	}
+	fmt.Fprintf(o.io.StdErr, "%s Job %s succeeded.\n", c.GreenCheck(), job.Name)
+	return nil
+}
+
+// runContainer runs script in a new container of the job image, with the work tree
+// mounted as the project directory.
+func (o *options) runContainer(runtime string, job *job, workTree, projectDir string, env []variable, script string, interactive bool) error {
+	args := []string{"run", "--rm"}
+	if interactive {
+		args = append(args, "--interactive")
+		if o.io.IsInputTTY() && o.io.IsOutputTTY() {
+			args = append(args, "--tty")
+		}
+	}
+	args = append(args, "--volume", workTree+":"+projectDir, "--workdir", projectDir)
+	for _, v := range env {
+		args = append(args, "--env", v.Key+"="+v.Value)
+	}
+
+	command := []string{"sh", "-c", script}
+	if len(job.Entrypoint) > 0 {
+		args = append(args, "--entrypoint", job.Entrypoint[0])
+		command = append(slices.Clone(job.Entrypoint[1:]), command...)
+	}
+	args = append(args, job.Image)
+	args = append(args, command...)
+
+	if o.dryRun {
+		quoted := make([]string, 0, len(args)+1)
+		for _, arg := range append([]string{runtime}, args...) {
+			if strings.ContainsAny(arg, " \t\n'\"$\\;&|<>*?()[]{}#~`") || arg == "" {
+				arg = shellQuote(arg)
+			}
+			quoted = append(quoted, arg)
+		}
+		fmt.Fprintln(o.io.StdOut, strings.Join(quoted, " "))
+		return nil
+	}
+
+	cmd := exec.Command(runtime, args...)
+	cmd.Stdin = o.io.In
+	cmd.Stdout = o.io.StdOut
+	cmd.Stderr = o.io.StdErr
+	return run.PrepareCmd(cmd).Run()
+}
@@ -0,0 +1,175 @@
+//go:build !integration
+
+package simulate
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+const mergedYAML = `
+default:
+  image: alpine:3.20
+  before_script:
+    - echo default
+variables:
+  GLOBAL: "1"
+  OTHER: two
+build:
+  stage: build
+  script: make
+test:
+  image:
+    name: golang:1.24
+    entrypoint: [""]
+  inherit:
+    default: [image]
+    variables: [GLOBAL]
+  variables:
+    GOFLAGS:
+      value: -mod=mod
+      description: Go flags
+  script:
+    - go test ./...
+    - - echo nested
+  after_script: echo done
+deploy:
+  trigger: other/project
+`
+
+func TestParseJob(t *testing.T) {
+	t.Parallel()
+
+	build, err := parseJob([]byte(mergedYAML), "build")
+	require.NoError(t, err)
+	assert.Equal(t, &job{
+		Name:         "build",
+		Stage:        "build",
+		Image:        "alpine:3.20",
+		Variables:    []variable{{"GLOBAL", "1"}, {"OTHER", "two"}},
+		BeforeScript: []string{"echo default"},
+		Script:       []string{"make"},
+	}, build)
+
+	testJob, err := parseJob([]byte(mergedYAML), "test")
+	require.NoError(t, err)
+	assert.Equal(t, &job{
+		Name:        "test",
+		Stage:       "test",
+		Image:       "golang:1.24",
+		Entrypoint:  []string{""},
+		Variables:   []variable{{"GLOBAL", "1"}, {"GOFLAGS", "-mod=mod"}},
+		Script:      []string{"go test ./...", "echo nested"},
+		AfterScript: []string{"echo done"},
+	}, testJob)
+
+	_, err = parseJob([]byte(mergedYAML), "deploy")
+	assert.EqualError(t, err, `job "deploy" triggers a downstream pipeline and can't be simulated.`)
+	_, err = parseJob([]byte(mergedYAML), "variables")
+	assert.EqualError(t, err, `job "variables" not found in the CI/CD configuration.`)
+	_, err = parseJob([]byte(mergedYAML), "missing")
+	assert.EqualError(t, err, `job "missing" not found in the CI/CD configuration.`)
+}
+
+func TestShellScript(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "set -e\necho '$ echo '\\''hi'\\'''\necho 'hi'\n", shellScript([]string{"echo 'hi'"}))
+}
+
+func setupSimulate(t *testing.T) (func(string) (*test.CmdOut, error), string) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), ".gitlab-ci.yml")
+	require.NoError(t, os.WriteFile(path, []byte("include: ci.yml\n"), 0o600))
+
+	tc := gitlabtesting.NewTestClient(t)
+	tc.MockProjects.EXPECT().GetProject("OWNER/REPO", gomock.Any()).Return(&gitlab.Project{ID: 123, Path: "REPO", PathWithNamespace: "OWNER/REPO"}, nil, nil)
+	tc.MockValidate.EXPECT().ProjectNamespaceLint(int64(123), gomock.Any()).Return(&gitlab.ProjectLintResult{Valid: true, MergedYaml: mergedYAML}, nil, nil)
+
+	return cmdtest.SetupCmdForTest(t, NewCmdSimulate, false, cmdtest.WithGitLabClient(tc.Client)), path
+}
+
+func TestSimulate(t *testing.T) {
+	cs, teardown := test.InitCmdStubber()
+	t.Cleanup(teardown)
+	cs.Stub("/src/repo\n")
+	cs.Stub("")
+	cs.Stub("")
+
+	exec, path := setupSimulate(t)
+	out, err := exec("test --runtime podman --variable EXTRA=a=b --file " + path)
+	require.NoError(t, err)
+
+	require.Len(t, cs.Calls, 3)
+	assert.Equal(t, []string{
+		"podman", "run", "--rm",
+		"--volume", "/src/repo:/builds/OWNER/REPO", "--workdir", "/builds/OWNER/REPO",
+		"--env", "CI=true",
+		"--env", "GITLAB_CI=true",
+		"--env", "CI_SERVER_HOST=gitlab.com",
+		"--env", "CI_PROJECT_ID=123",
+		"--env", "CI_PROJECT_NAME=REPO",
+		"--env", "CI_PROJECT_NAMESPACE=OWNER",
+		"--env", "CI_PROJECT_PATH=OWNER/REPO",
+		"--env", "CI_PROJECT_DIR=/builds/OWNER/REPO",
+		"--env", "CI_BUILDS_DIR=/builds",
+		"--env", "CI_JOB_NAME=test",
+		"--env", "CI_JOB_STAGE=test",
+		"--env", "GLOBAL=1",
+		"--env", "GOFLAGS=-mod=mod",
+		"--env", "EXTRA=a=b",
+		"--entrypoint", "",
+		"golang:1.24",
+		"sh", "-c", "set -e\necho '$ go test ./...'\ngo test ./...\necho '$ echo nested'\necho nested\n",
+	}, cs.Calls[1].Args)
+	assert.Contains(t, cs.Calls[2].Args, "CI_JOB_STATUS=success")
+	assert.Equal(t, "set -e\necho '$ echo done'\necho done\n", cs.Calls[2].Args[len(cs.Calls[2].Args)-1])
+	assert.Contains(t, out.ErrBuf.String(), "Job test succeeded.")
+}
+
+func TestSimulate_failed(t *testing.T) {
+	cs, teardown := test.InitCmdStubber()
+	t.Cleanup(teardown)
+	cs.Stub("/src/repo\n")
+	cs.Stubs = append(cs.Stubs, &test.OutputStub{Error: exitError(t, 3)})
+
+	exec, path := setupSimulate(t)
+	_, err := exec("build --runtime docker --file " + path)
+	assert.EqualError(t, err, "job build failed with exit code 3.")
+}
+
+func TestSimulate_dryRun(t *testing.T) {
+	cs, teardown := test.InitCmdStubber()
+	t.Cleanup(teardown)
+	cs.Stub("/src/repo\n")
+
+	exec, path := setupSimulate(t)
+	out, err := exec("build --runtime docker --dry-run --image busybox --file " + path)
+	require.NoError(t, err)
+
+	assert.Len(t, cs.Calls, 1)
+	assert.Contains(t, out.String(), "docker run --rm --volume /src/repo:/builds/OWNER/REPO")
+	assert.Contains(t, out.String(), "--env CI_JOB_STAGE=build --env GLOBAL=1 --env OTHER=two busybox sh -c 'set -e")
+}
+
+func exitError(t *testing.T, code int) error {
+	t.Helper()
+
+	err := exec.Command("sh", "-c", "exit "+strconv.Itoa(code)).Run()
+	require.Error(t, err)
+	return err
+}
@@ -11,6 +11,7 @@ import (
 
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 
+	"gitlab.com/gitlab-org/cli/internal/api"
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
 	"gitlab.com/gitlab-org/cli/internal/commands/ci/ciutils"
 	"gitlab.com/gitlab-org/cli/internal/dbg"
@@ -34,6 +35,9 @@ func NewCmdStatus(f cmdutils.Factory) *cobra.Command {
 
 		       # Get the pipeline for the current branch
 		       $ glab ci status
+
+		       # Include the child and multi-project pipelines it triggered
+		       $ glab ci status --with-downstream
 	       `),
 		Long: ``,
 		Args: cobra.ExactArgs(0),
@@ -52,6 +56,7 @@ func NewCmdStatus(f cmdutils.Factory) *cobra.Command {
 			branch, _ := cmd.Flags().GetString("branch")
 			live, _ := cmd.Flags().GetBool("live")
 			compact, _ := cmd.Flags().GetBool("compact")
+			withDownstream, _ := cmd.Flags().GetBool("with-downstream")
 			repo, err := f.BaseRepo()
 			if err != nil {
 				return err
@@ -80,7 +85,7 @@ func NewCmdStatus(f cmdutils.Factory) *cobra.Command {
 			defer writer.Stop()
 			for {
 				jobs, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Job, *gitlab.Response, error) {
-					return client.Jobs.ListPipelineJobs(repoName, runningPipeline.ID, &gitlab.ListJobsOptions{ListOptions: gitlab.ListOptions{PerPage: 100}}, p)
+					return client.Jobs.ListPipelineJobs(repoName, runningPipeline.ID, &gitlab.ListJobsOptions{ListOptions: gitlab.ListOptions{PerPage: 100}}, p, api.WithoutCache())
 				})
 				if err != nil {
 					return err
@@ -116,6 +121,17 @@ func NewCmdStatus(f cmdutils.Factory) *cobra.Command {
 					}
 				}
 
+				if withDownstream {
+					downstream, err := ciutils.GetDownstreamPipelines(client, repoName, runningPipeline.ID, api.WithoutCache())
+					if err != nil {
+						return err
+					}
+					if len(downstream) > 0 {
+						fmt.Fprintln(writer, "\nDownstream pipelines:")
+						ciutils.DisplayDownstreamPipelines(writer, c, downstream, compact)
+					}
+				}
+
 				if !compact {
 					fmt.Fprintf(writer.Newline(), "\n%s\n", runningPipeline.WebURL)
 					fmt.Fprintf(writer.Newline(), "SHA: %s\n", runningPipeline.SHA)
@@ -124,10 +140,10 @@ func NewCmdStatus(f cmdutils.Factory) *cobra.Command {
 
 				if (runningPipeline.Status == "pending" || runningPipeline.Status == "running") && live {
 					// Use fallback logic for live updates
-					updatedPipeline, err := ciutils.GetPipelineWithFallback(client, repoName, branch, f.IO())
+					updatedPipeline, err := ciutils.GetPipelineWithFallback(client, repoName, branch, f.IO(), api.WithoutCache())
 					if err != nil {
 						// Final fallback: refresh current pipeline by ID
-						updatedPipeline, _, err = client.Pipelines.GetPipeline(repoName, runningPipeline.ID)
+						updatedPipeline, _, err = client.Pipelines.GetPipeline(repoName, runningPipeline.ID, api.WithoutCache())
 						if err != nil {
 							return err
 						}
@@ -185,6 +201,7 @@ func NewCmdStatus(f cmdutils.Factory) *cobra.Command {
 	pipelineStatusCmd.Flags().BoolP("live", "l", false, "Show status in real time until the pipeline ends.")
 	pipelineStatusCmd.Flags().BoolP("compact", "c", false, "Show status in compact format.")
 	pipelineStatusCmd.Flags().StringP("branch", "b", "", "Check pipeline status for a branch. (default current branch)")
+	pipelineStatusCmd.Flags().Bool("with-downstream", false, "Show the tree of child and multi-project pipelines triggered by the pipeline.")
 
 	return pipelineStatusCmd
 }
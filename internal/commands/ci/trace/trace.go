@@ -1,18 +1,34 @@
 package trace
 
 import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/spf13/cobra"
 
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
 	"gitlab.com/gitlab-org/cli/internal/commands/ci/ciutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
 	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
 )
 
 func NewCmdTrace(f cmdutils.Factory) *cobra.Command {
 	pipelineCITraceCmd := &cobra.Command{
-		Use:   "trace [<job-id>] [flags]",
+		Use:   "trace [<job-id>...] [flags]",
 		Short: `Trace a CI/CD job log in real time.`,
+		Long: heredoc.Doc(`
+			Trace a CI/CD job log in real time.
+
+			With --follow, trace several jobs of a pipeline at once: all its jobs, the jobs
+			of the stage given with --stage, or the jobs given as arguments. The logs are
+			interleaved, and each line starts with the name of its job. The command fails
+			if any of the jobs fails.
+		`),
 		Example: heredoc.Doc(`
 			# Interactively select a job to trace
 			$ glab ci trace
@@ -22,6 +38,12 @@ func NewCmdTrace(f cmdutils.Factory) *cobra.Command {
 
 			# Trace job with the name 'lint'
 			$ glab ci trace lint
+
+			# Follow all jobs of the 'test' stage of the latest pipeline on main
+			$ glab ci trace --follow --stage test -b main
+
+			# Follow the jobs 'lint' and 'unit' of a pipeline
+			$ glab ci trace lint unit --follow -p 123
 		`),
 		Annotations: map[string]string{
 			mcpannotations.Safe: "true",
@@ -36,12 +58,28 @@ func NewCmdTrace(f cmdutils.Factory) *cobra.Command {
 			if err != nil {
 				return err
 			}
+			branch, _ := cmd.Flags().GetString("branch")
+			pipelineId, _ := cmd.Flags().GetInt("pipeline-id")
+			follow, _ := cmd.Flags().GetBool("follow")
+			stage, _ := cmd.Flags().GetString("stage")
+
+			if follow {
+				return followJobs(cmd.Context(), f, client, repo, args, stage, &ciutils.JobInputs{
+					Branch:     branch,
+					PipelineId: pipelineId,
+				})
+			}
+			if stage != "" {
+				return &cmdutils.FlagError{Err: errors.New("--stage requires --follow.")}
+			}
+			if len(args) > 1 {
+				return &cmdutils.FlagError{Err: errors.New("use --follow to trace more than one job.")}
+			}
+
 			jobName := ""
 			if len(args) != 0 {
 				jobName = args[0]
 			}
-			branch, _ := cmd.Flags().GetString("branch")
-			pipelineId, _ := cmd.Flags().GetInt("pipeline-id")
 
 			return ciutils.TraceJob(cmd.Context(), &ciutils.JobInputs{
 				JobName:    jobName,
@@ -57,5 +95,39 @@ func NewCmdTrace(f cmdutils.Factory) *cobra.Command {
 
 	pipelineCITraceCmd.Flags().StringP("branch", "b", "", "The branch to search for the job. (default current branch)")
 	pipelineCITraceCmd.Flags().IntP("pipeline-id", "p", 0, "The pipeline ID to search for the job.")
+	pipelineCITraceCmd.Flags().BoolP("follow", "f", false, "Trace several jobs of the pipeline at once, and fail if any of them fails.")
+	pipelineCITraceCmd.Flags().StringP("stage", "s", "", "With --follow, trace the jobs of this stage.")
 	return pipelineCITraceCmd
 }
+
+func followJobs(ctx context.Context, f cmdutils.Factory, client *gitlab.Client, repo glrepo.Interface, names []string, stage string, inputs *ciutils.JobInputs) error {
+	jobs, err := ciutils.ListPipelineJobs(inputs, &ciutils.JobOptions{
+		Client: client,
+		IO:     f.IO(),
+		Repo:   repo,
+	})
+	if err != nil {
+		return err
+	}
+
+	var followed []*gitlab.Job
+	for _, job := range jobs {
+		if stage != "" && job.Stage != stage {
+			continue
+		}
+		if len(names) > 0 {
+			if !slices.Contains(names, job.Name) && !slices.Contains(names, strconv.FormatInt(job.ID, 10)) {
+				continue
+			}
+		} else if job.Status == "manual" {
+			// Manual jobs that nobody asked for might never start.
+			continue
+		}
+		followed = append(followed, job)
+	}
+	if len(followed) == 0 {
+		return errors.New("no jobs to follow in the pipeline.")
+	}
+
+	return ciutils.FollowJobs(ctx, client, f.IO(), repo.FullName(), followed)
+}
@@ -191,3 +191,61 @@ func TestCiTrace(t *testing.T) {
 		})
 	}
 }
+
+func TestCiTraceFollow(t *testing.T) {
+	t.Parallel()
+
+	lastPageResponse := &gitlab.Response{
+		Response: &http.Response{StatusCode: http.StatusOK},
+		NextPage: 0,
+	}
+
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockJobs.EXPECT().
+		ListPipelineJobs("OWNER/REPO", int64(123), gomock.Any(), gomock.Any()).
+		Return([]*gitlab.Job{
+			{ID: 1122, Name: "lint", Stage: "test", Status: "running"},
+			{ID: 1123, Name: "unit", Stage: "test", Status: "running"},
+			{ID: 1124, Name: "publish", Stage: "deploy", Status: "created"},
+		}, lastPageResponse, nil)
+	testClient.MockJobs.EXPECT().
+		GetJob("OWNER/REPO", int64(1122), gomock.Any()).
+		Return(&gitlab.Job{ID: 1122, Name: "lint", Status: "success"}, nil, nil)
+	testClient.MockJobs.EXPECT().
+		GetTraceFile("OWNER/REPO", int64(1122), gomock.Any()).
+		Return(bytes.NewReader([]byte("linting\nno problems")), nil, nil)
+	testClient.MockJobs.EXPECT().
+		GetJob("OWNER/REPO", int64(1123), gomock.Any()).
+		Return(&gitlab.Job{ID: 1123, Name: "unit", Status: "failed"}, nil, nil)
+	testClient.MockJobs.EXPECT().
+		GetTraceFile("OWNER/REPO", int64(1123), gomock.Any()).
+		Return(bytes.NewReader([]byte("1 test failed\n")), nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdTrace, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	output, err := exec("--follow --stage test -p 123")
+
+	require.EqualError(t, err, "1 of 2 jobs failed: unit")
+	for _, line := range []string{
+		"lint | Getting job trace...\n",
+		"lint | Showing logs for lint job #1122.\n",
+		"lint | linting\n",
+		"lint | no problems\n",
+		"unit | Showing logs for unit job #1123.\n",
+		"unit | 1 test failed\n",
+		"\n✓ lint: success\nx unit: failed\n",
+	} {
+		assert.Contains(t, output.String(), line)
+	}
+	assert.NotContains(t, output.String(), "publish")
+}
+
+func TestCiTraceStageRequiresFollow(t *testing.T) {
+	t.Parallel()
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdTrace, false, cmdtest.WithGitLabClient(gitlabtesting.NewTestClient(t).Client))
+
+	_, err := exec("--stage test")
+
+	require.EqualError(t, err, "--stage requires --follow.")
+}
@@ -39,6 +39,7 @@ type options struct {
 	gitlabClient func() (*gitlab.Client, error)
 	baseRepo     func() (glrepo.Interface, error)
 	config       func() config.Config
+	branch       func() (string, error)
 
 	refName       string
 	openInBrowser bool
@@ -107,6 +108,7 @@ func NewCmdView(f cmdutils.Factory) *cobra.Command {
 		gitlabClient: f.GitLabClient,
 		baseRepo:     f.BaseRepo,
 		config:       f.Config,
+		branch:       f.Branch,
 	}
 	pipelineCIView := &cobra.Command{
 		Use:   "view [branch/tag]",
@@ -166,7 +168,13 @@ func (o *options) complete(args []string) error {
 		if len(args) == 1 {
 			o.refName = args[0]
 		} else {
-			refName, err := git.CurrentBranch()
+			refName, err := o.branch()
+			if errors.Is(err, git.ErrNotOnAnyBranch) {
+				// Pipelines also run for tags, so the tag of a detached HEAD will do.
+				if tag, tagErr := git.DetachedHeadRef(); tagErr == nil {
+					refName, err = tag, nil
+				}
+			}
 			if err != nil {
 				return err
 			}
@@ -853,6 +861,7 @@ func updateJobs(
 			apiClient,
 			pipeline.ProjectID,
 			pipeline.ID,
+			api.WithoutCache(),
 		)
 		if err != nil {
 			app.Stop()
@@ -17,8 +17,8 @@ const (
 
 func DisplayAllAgents(io *iostreams.IOStreams, agents []*gitlab.Agent) string {
 	c := io.Color()
-	table := tableprinter.NewTablePrinter()
-	table.AddRow(c.Bold("ID"), c.Bold("Name"), c.Bold(c.Gray("Created At")))
+	table := tableprinter.New(io)
+	table.AddHeader("ID", "Name", "Created At")
 	for _, r := range agents {
 		table.AddRow(r.ID, r.Name, c.Gray(utils.TimeToPrettyTimeAgo(*r.CreatedAt)))
 	}
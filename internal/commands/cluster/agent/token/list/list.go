@@ -77,10 +77,9 @@ func (o *options) run(ctx context.Context) error {
 	}
 
 	c := o.io.Color()
-	bold := c.Bold
 
-	table := tableprinter.NewTablePrinter()
-	table.AddRow(bold("ID"), bold("Name"), bold("Status"), bold("Created At"), bold("Created By"), bold("Last Used At"), bold("Description"))
+	table := tableprinter.New(o.io)
+	table.AddHeader("ID", "Name", "Status", "Created At", "Created By", "Last Used At", "Description")
 	var username string
 	// NOTE: there can only ever be two tokens registered for an agent at once, therefore, it's safe to assume that
 	// we only ever get a maximum of two items back from the API, despite it's slice return type.
@@ -207,8 +207,8 @@ func (o *options) readTokenFromRoot(root *os.Root, name string) (*cachedToken, e
 }
 
 func (o *options) displayTokens(tokens []cachedToken) {
-	tp := tableprinter.NewTablePrinter()
-	tp.AddRow("Agent ID", "GitLab URL", "Token Name", "Source", "Expires At", "Status")
+	tp := tableprinter.New(o.io)
+	tp.AddHeader("Agent ID", "GitLab URL", "Token Name", "Source", "Expires At", "Status")
 
 	for _, token := range tokens {
 		expiresAt := "Never"
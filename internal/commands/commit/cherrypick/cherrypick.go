@@ -0,0 +1,117 @@
+package cherrypick
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/commit/commitutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	sha      string
+	branch   string
+	message  string
+	dryRun   bool
+	createMR bool
+	mrBranch string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdCherryPick(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	cherryPickCmd := &cobra.Command{
+		Use:   "cherry-pick <sha> --branch <branch> [flags]",
+		Short: `Cherry-pick a commit into a branch.`,
+		Long: heredoc.Doc(`
+			Cherry-pick a commit into a branch, on the GitLab server.
+
+			With --create-mr, the commit is cherry-picked into a new branch created from
+			--branch, and a merge request is opened from the new branch into --branch.
+		`),
+		Args: cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ glab commit cherry-pick 7e2f1c9a --branch release-1.2
+			$ glab commit cherry-pick 7e2f1c9a --branch release-1.2 --create-mr
+			$ glab commit cherry-pick 7e2f1c9a --branch release-1.2 --dry-run
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.sha = args[0]
+			if opts.mrBranch != "" && !opts.createMR {
+				return &cmdutils.FlagError{Err: errors.New("--mr-branch requires --create-mr.")}
+			}
+			return opts.run()
+		},
+	}
+
+	fl := cherryPickCmd.Flags()
+	fl.StringVarP(&opts.branch, "branch", "b", "", "Branch to cherry-pick the commit into.")
+	fl.StringVarP(&opts.message, "message", "m", "", "Commit message. Defaults to the message of the commit.")
+	fl.BoolVar(&opts.dryRun, "dry-run", false, "Check that the commit can be cherry-picked without committing it.")
+	fl.BoolVar(&opts.createMR, "create-mr", false, "Cherry-pick into a new branch, and open a merge request from it into --branch.")
+	fl.StringVar(&opts.mrBranch, "mr-branch", "", "Name of the new branch of --create-mr. Defaults to 'cherry-pick-<short SHA>'.")
+	_ = cherryPickCmd.MarkFlagRequired("branch")
+	cherryPickCmd.MarkFlagsMutuallyExclusive("dry-run", "create-mr")
+
+	return cherryPickCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	commit, mr, err := commitutils.Apply(client, repo.FullName(), &commitutils.ApplyOptions{
+		Action:   "cherry-pick",
+		SHA:      o.sha,
+		Branch:   o.branch,
+		CreateMR: o.createMR,
+		MRBranch: o.mrBranch,
+	}, func(branch string) (*gitlab.Commit, error) {
+		pickOpts := &gitlab.CherryPickCommitOptions{Branch: gitlab.Ptr(branch)}
+		if o.message != "" {
+			pickOpts.Message = gitlab.Ptr(o.message)
+		}
+		if o.dryRun {
+			pickOpts.DryRun = gitlab.Ptr(true)
+		}
+		commit, _, err := client.Commits.CherryPickCommit(repo.FullName(), o.sha, pickOpts)
+		if err != nil {
+			return nil, cmdutils.WrapError(err, fmt.Sprintf("failed to cherry-pick %s into %s.", o.sha, branch))
+		}
+		return commit, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if o.dryRun {
+		fmt.Fprintf(o.io.StdOut, "%s %s can be cherry-picked into %s.\n", o.io.Color().GreenCheck(), o.sha, o.branch)
+		return nil
+	}
+	commitutils.PrintApplied(o.io, fmt.Sprintf("Cherry-picked %s into", o.sha), commit, o.branch, mr)
+	return nil
+}
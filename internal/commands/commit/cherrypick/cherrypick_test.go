@@ -0,0 +1,126 @@
+//go:build !integration
+
+package cherrypick
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+var picked = &gitlab.Commit{
+	ID:      "9f8e7d6c5b4a39281706f5e4d3c2b1a098765432",
+	ShortID: "9f8e7d6c",
+	Title:   "Fix the build",
+	Message: "Fix the build\n\n(cherry picked from commit 7e2f1c9a)",
+	WebURL:  "https://gitlab.com/OWNER/REPO/-/commit/9f8e7d6c",
+}
+
+func Test_CherryPick(t *testing.T) {
+	testCases := []struct {
+		name        string
+		cli         string
+		setupMock   func(tc *gitlabtesting.TestClient)
+		expectedOut string
+		expectedErr string
+	}{
+		{
+			name: "Cherry-pick into a branch",
+			cli:  "7e2f1c9a0b1c --branch release -m 'Backport the fix'",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockCommits.EXPECT().CherryPickCommit("OWNER/REPO", "7e2f1c9a0b1c", &gitlab.CherryPickCommitOptions{
+					Branch:  gitlab.Ptr("release"),
+					Message: gitlab.Ptr("Backport the fix"),
+				}).Return(picked, nil, nil)
+			},
+			expectedOut: "✓ Cherry-picked 7e2f1c9a0b1c into release as 9f8e7d6c\n https://gitlab.com/OWNER/REPO/-/commit/9f8e7d6c\n",
+		},
+		{
+			name: "Dry run",
+			cli:  "7e2f1c9a --branch release --dry-run",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockCommits.EXPECT().CherryPickCommit("OWNER/REPO", "7e2f1c9a", &gitlab.CherryPickCommitOptions{
+					Branch: gitlab.Ptr("release"),
+					DryRun: gitlab.Ptr(true),
+				}).Return(&gitlab.Commit{}, nil, nil)
+			},
+			expectedOut: "✓ 7e2f1c9a can be cherry-picked into release.\n",
+		},
+		{
+			name: "Cherry-pick with a merge request",
+			cli:  "7e2f1c9a0b1c --branch release --create-mr",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				gomock.InOrder(
+					tc.MockBranches.EXPECT().CreateBranch("OWNER/REPO", &gitlab.CreateBranchOptions{
+						Branch: gitlab.Ptr("cherry-pick-7e2f1c9a"),
+						Ref:    gitlab.Ptr("release"),
+					}).Return(&gitlab.Branch{}, nil, nil),
+					tc.MockCommits.EXPECT().CherryPickCommit("OWNER/REPO", "7e2f1c9a0b1c", &gitlab.CherryPickCommitOptions{
+						Branch: gitlab.Ptr("cherry-pick-7e2f1c9a"),
+					}).Return(picked, nil, nil),
+					tc.MockMergeRequests.EXPECT().CreateMergeRequest("OWNER/REPO", &gitlab.CreateMergeRequestOptions{
+						Title:              gitlab.Ptr("Fix the build"),
+						Description:        gitlab.Ptr(picked.Message),
+						SourceBranch:       gitlab.Ptr("cherry-pick-7e2f1c9a"),
+						TargetBranch:       gitlab.Ptr("release"),
+						RemoveSourceBranch: gitlab.Ptr(true),
+					}).Return(&gitlab.MergeRequest{BasicMergeRequest: gitlab.BasicMergeRequest{
+						IID:          7,
+						SourceBranch: "cherry-pick-7e2f1c9a",
+						TargetBranch: "release",
+						WebURL:       "https://gitlab.com/OWNER/REPO/-/merge_requests/7",
+					}}, nil, nil),
+				)
+			},
+			expectedOut: "✓ Cherry-picked 7e2f1c9a0b1c into cherry-pick-7e2f1c9a as 9f8e7d6c\n" +
+				"✓ Created merge request !7 into release\n https://gitlab.com/OWNER/REPO/-/merge_requests/7\n",
+		},
+		{
+			name: "Conflict deletes the new branch",
+			cli:  "7e2f1c9a --branch release --create-mr --mr-branch backport",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockBranches.EXPECT().CreateBranch("OWNER/REPO", gomock.Any()).Return(&gitlab.Branch{}, nil, nil)
+				tc.MockCommits.EXPECT().CherryPickCommit("OWNER/REPO", "7e2f1c9a", gomock.Any()).
+					Return(nil, nil, gitlab.ErrNotFound)
+				tc.MockBranches.EXPECT().DeleteBranch("OWNER/REPO", "backport").Return(nil, nil)
+			},
+			expectedErr: "404 Not Found",
+		},
+		{
+			name:        "Branch is required",
+			cli:         "7e2f1c9a",
+			setupMock:   func(tc *gitlabtesting.TestClient) {},
+			expectedErr: `required flag(s) "branch" not set`,
+		},
+		{
+			name:        "MR branch requires create-mr",
+			cli:         "7e2f1c9a --branch release --mr-branch backport",
+			setupMock:   func(tc *gitlabtesting.TestClient) {},
+			expectedErr: "--mr-branch requires --create-mr.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			tc.setupMock(testClient)
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdCherryPick, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			out, err := exec(tc.cli)
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedOut, out.String())
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package commit
+
+import (
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	cmdCherryPick "gitlab.com/gitlab-org/cli/internal/commands/commit/cherrypick"
+	cmdList "gitlab.com/gitlab-org/cli/internal/commands/commit/list"
+	cmdRevert "gitlab.com/gitlab-org/cli/internal/commands/commit/revert"
+	cmdView "gitlab.com/gitlab-org/cli/internal/commands/commit/view"
+)
+
+func NewCmdCommit(f cmdutils.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit <command> [flags]",
+		Short: `Work with the commits of a repository.`,
+		Long:  ``,
+	}
+
+	cmdutils.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdView.NewCmdView(f))
+	cmd.AddCommand(cmdList.NewCmdList(f))
+	cmd.AddCommand(cmdCherryPick.NewCmdCherryPick(f))
+	cmd.AddCommand(cmdRevert.NewCmdRevert(f))
+
+	return cmd
+}
@@ -0,0 +1,96 @@
+package commitutils
+
+import (
+	"fmt"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+// ParseDate parses the value of a date flag, in the YYYY-MM-DD format in the
+// local time zone, or in the RFC 3339 format.
+func ParseDate(flag, value string) (*time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return &t, nil
+	}
+	t, err := time.ParseInLocation(time.DateOnly, value, time.Local)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s %q. Use the YYYY-MM-DD or the RFC 3339 format.", flag, value)
+	}
+	return &t, nil
+}
+
+// ApplyOptions are the options of a commit that is applied to a branch on the
+// server, like a cherry-pick or a revert.
+type ApplyOptions struct {
+	// Action is the name of the action, like "cherry-pick".
+	Action string
+	// SHA is the commit that is applied.
+	SHA string
+	// Branch is the target branch.
+	Branch string
+	// CreateMR applies the commit to a new branch created from Branch, and
+	// opens a merge request from it into Branch.
+	CreateMR bool
+	// MRBranch is the name of the new branch. Defaults to <action>-<short SHA>.
+	MRBranch string
+}
+
+// Apply runs apply, which creates the commit on the branch it is given, on the
+// target branch, or on a new branch with a merge request into the target
+// branch when opts.CreateMR is set. It returns the new commit and the merge
+// request, if any.
+func Apply(client *gitlab.Client, repo string, opts *ApplyOptions, apply func(branch string) (*gitlab.Commit, error)) (*gitlab.Commit, *gitlab.MergeRequest, error) {
+	if !opts.CreateMR {
+		commit, err := apply(opts.Branch)
+		return commit, nil, err
+	}
+
+	source := opts.MRBranch
+	if source == "" {
+		source = fmt.Sprintf("%s-%s", opts.Action, utils.ShortSHA(opts.SHA))
+	}
+	_, _, err := client.Branches.CreateBranch(repo, &gitlab.CreateBranchOptions{
+		Branch: gitlab.Ptr(source),
+		Ref:    gitlab.Ptr(opts.Branch),
+	})
+	if err != nil {
+		return nil, nil, cmdutils.WrapError(err, fmt.Sprintf("failed to create branch %s.", source))
+	}
+
+	commit, err := apply(source)
+	if err != nil {
+		// Don't leave the new branch behind when the commit can't be applied.
+		_, _ = client.Branches.DeleteBranch(repo, source)
+		return nil, nil, err
+	}
+
+	mr, _, err := client.MergeRequests.CreateMergeRequest(repo, &gitlab.CreateMergeRequestOptions{
+		Title:              gitlab.Ptr(commit.Title),
+		Description:        gitlab.Ptr(commit.Message),
+		SourceBranch:       gitlab.Ptr(source),
+		TargetBranch:       gitlab.Ptr(opts.Branch),
+		RemoveSourceBranch: gitlab.Ptr(true),
+	})
+	if err != nil {
+		return commit, nil, cmdutils.WrapError(err, fmt.Sprintf("failed to create a merge request from %s into %s.", source, opts.Branch))
+	}
+	return commit, mr, nil
+}
+
+// PrintApplied prints the commit that was created on a branch, and the merge
+// request that was opened for it, if any. summary tells what was done, like
+// "Reverted 7e2f1c9a on", and is followed by the branch.
+func PrintApplied(io *iostreams.IOStreams, summary string, commit *gitlab.Commit, branch string, mr *gitlab.MergeRequest) {
+	c := io.Color()
+	if mr != nil {
+		fmt.Fprintf(io.StdOut, "%s %s %s as %s\n", c.GreenCheck(), summary, c.Bold(mr.SourceBranch), commit.ShortID)
+		fmt.Fprintf(io.StdOut, "%s Created merge request !%d into %s\n %s\n", c.GreenCheck(), mr.IID, c.Bold(mr.TargetBranch), mr.WebURL)
+		return
+	}
+	fmt.Fprintf(io.StdOut, "%s %s %s as %s\n %s\n", c.GreenCheck(), summary, c.Bold(branch), commit.ShortID, commit.WebURL)
+}
@@ -0,0 +1,136 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/commit/commitutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	ref          string
+	author       string
+	since        string
+	until        string
+	path         string
+	page         int
+	perPage      int
+	outputFormat string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdList(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	commitListCmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   `List the commits on a branch or tag.`,
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Example: heredoc.Doc(`
+			$ glab commit list
+			$ glab commit list --ref release-1.2 --author "Jane Doe"
+			$ glab commit list --since 2024-01-01 --until 2024-02-01 --path docs
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+
+	fl := commitListCmd.Flags()
+	fl.StringVarP(&opts.ref, "ref", "r", "", "Branch, tag, or commit to list the commits of. Defaults to the default branch.")
+	fl.StringVarP(&opts.author, "author", "a", "", "List the commits of this author, by name or email.")
+	fl.StringVar(&opts.since, "since", "", "List the commits after this date, in the YYYY-MM-DD or the RFC 3339 format.")
+	fl.StringVar(&opts.until, "until", "", "List the commits before this date, in the YYYY-MM-DD or the RFC 3339 format.")
+	fl.StringVar(&opts.path, "path", "", "List the commits that change this file or directory.")
+	fl.IntVar(&opts.page, "page", 1, "Page number.")
+	fl.IntVarP(&opts.perPage, "per-page", "P", 30, "Number of commits to list per page.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+
+	return commitListCmd
+}
+
+func (o *options) run() error {
+	listOpts := &gitlab.ListCommitsOptions{
+		ListOptions: gitlab.ListOptions{Page: int64(o.page), PerPage: int64(o.perPage)},
+	}
+	if o.ref != "" {
+		listOpts.RefName = gitlab.Ptr(o.ref)
+	}
+	if o.author != "" {
+		listOpts.Author = gitlab.Ptr(o.author)
+	}
+	if o.path != "" {
+		listOpts.Path = gitlab.Ptr(o.path)
+	}
+	var err error
+	if o.since != "" {
+		if listOpts.Since, err = commitutils.ParseDate("since", o.since); err != nil {
+			return &cmdutils.FlagError{Err: err}
+		}
+	}
+	if o.until != "" {
+		if listOpts.Until, err = commitutils.ParseDate("until", o.until); err != nil {
+			return &cmdutils.FlagError{Err: err}
+		}
+	}
+
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	commits, _, err := client.Commits.ListCommits(repo.FullName(), listOpts)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to list commits.")
+	}
+
+	if o.outputFormat == "json" {
+		commitsJSON, _ := json.Marshal(commits)
+		fmt.Fprintln(o.io.StdOut, string(commitsJSON))
+		return nil
+	}
+
+	if len(commits) == 0 {
+		o.io.LogInfof("No commits found for %s.\n", repo.FullName())
+		return nil
+	}
+
+	c := o.io.Color()
+	table := tableprinter.New(o.io)
+	table.AddHeader("SHA", "TITLE", "AUTHOR", "DATE")
+	for _, commit := range commits {
+		date := ""
+		if commit.AuthoredDate != nil {
+			date = utils.TimeToPrettyTimeAgo(*commit.AuthoredDate)
+		}
+		table.AddRow(c.Cyan(commit.ShortID), commit.Title, commit.AuthorName, c.Gray(date))
+	}
+	fmt.Fprint(o.io.StdOut, table.String())
+
+	return nil
+}
@@ -0,0 +1,107 @@
+//go:build !integration
+
+package list
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_CommitList(t *testing.T) {
+	authored := time.Now().Add(-2 * time.Hour)
+	commit := &gitlab.Commit{
+		ID:           "7e2f1c9a0b1c2d3e4f5a6b7c8d9e0f1a2b3c4d5e",
+		ShortID:      "7e2f1c9a",
+		Title:        "Fix the build",
+		AuthorName:   "Jane Doe",
+		AuthoredDate: &authored,
+	}
+
+	testCases := []struct {
+		name        string
+		cli         string
+		setupMock   func(tc *gitlabtesting.TestClient)
+		expectedMsg []string
+		expectedErr string
+	}{
+		{
+			name: "List commits",
+			cli:  "",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockCommits.EXPECT().ListCommits("OWNER/REPO", &gitlab.ListCommitsOptions{
+					ListOptions: gitlab.ListOptions{Page: 1, PerPage: 30},
+				}).Return([]*gitlab.Commit{commit}, nil, nil)
+			},
+			expectedMsg: []string{"SHA\tTITLE\tAUTHOR\tDATE", "7e2f1c9a\tFix the build\tJane Doe\tabout 2 hours ago"},
+		},
+		{
+			name: "List commits with filters",
+			cli:  "--ref release --author jane --path docs --since 2024-01-01 --until 2024-02-01T10:00:00Z",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local)
+				until := time.Date(2024, 2, 1, 10, 0, 0, 0, time.UTC)
+				tc.MockCommits.EXPECT().ListCommits("OWNER/REPO", &gitlab.ListCommitsOptions{
+					ListOptions: gitlab.ListOptions{Page: 1, PerPage: 30},
+					RefName:     gitlab.Ptr("release"),
+					Author:      gitlab.Ptr("jane"),
+					Path:        gitlab.Ptr("docs"),
+					Since:       &since,
+					Until:       &until,
+				}).Return([]*gitlab.Commit{commit}, nil, nil)
+			},
+			expectedMsg: []string{"7e2f1c9a\tFix the build"},
+		},
+		{
+			name:        "Invalid date",
+			cli:         "--since yesterday",
+			setupMock:   func(tc *gitlabtesting.TestClient) {},
+			expectedErr: `invalid --since "yesterday". Use the YYYY-MM-DD or the RFC 3339 format.`,
+		},
+		{
+			name: "No commits",
+			cli:  "",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockCommits.EXPECT().ListCommits("OWNER/REPO", gomock.Any()).Return(nil, nil, nil)
+			},
+			expectedMsg: []string{"No commits found for OWNER/REPO."},
+		},
+		{
+			name: "JSON output",
+			cli:  "-F json",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockCommits.EXPECT().ListCommits("OWNER/REPO", gomock.Any()).Return([]*gitlab.Commit{commit}, nil, nil)
+			},
+			expectedMsg: []string{`"short_id":"7e2f1c9a"`, `"title":"Fix the build"`},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			tc.setupMock(testClient)
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdList, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			out, err := exec(tc.cli)
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			output := out.String() + out.Stderr()
+			for _, msg := range tc.expectedMsg {
+				assert.Contains(t, output, msg)
+			}
+		})
+	}
+}
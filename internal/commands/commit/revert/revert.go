@@ -0,0 +1,100 @@
+package revert
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/commit/commitutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	sha      string
+	branch   string
+	createMR bool
+	mrBranch string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdRevert(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	revertCmd := &cobra.Command{
+		Use:   "revert <sha> --branch <branch> [flags]",
+		Short: `Revert a commit on a branch.`,
+		Long: heredoc.Doc(`
+			Revert a commit on a branch, on the GitLab server.
+
+			With --create-mr, the commit is reverted on a new branch created from
+			--branch, and a merge request is opened from the new branch into --branch.
+		`),
+		Args: cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ glab commit revert 7e2f1c9a --branch main
+			$ glab commit revert 7e2f1c9a --branch main --create-mr
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.sha = args[0]
+			if opts.mrBranch != "" && !opts.createMR {
+				return &cmdutils.FlagError{Err: errors.New("--mr-branch requires --create-mr.")}
+			}
+			return opts.run()
+		},
+	}
+
+	fl := revertCmd.Flags()
+	fl.StringVarP(&opts.branch, "branch", "b", "", "Branch to revert the commit on.")
+	fl.BoolVar(&opts.createMR, "create-mr", false, "Revert on a new branch, and open a merge request from it into --branch.")
+	fl.StringVar(&opts.mrBranch, "mr-branch", "", "Name of the new branch of --create-mr. Defaults to 'revert-<short SHA>'.")
+	_ = revertCmd.MarkFlagRequired("branch")
+
+	return revertCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	commit, mr, err := commitutils.Apply(client, repo.FullName(), &commitutils.ApplyOptions{
+		Action:   "revert",
+		SHA:      o.sha,
+		Branch:   o.branch,
+		CreateMR: o.createMR,
+		MRBranch: o.mrBranch,
+	}, func(branch string) (*gitlab.Commit, error) {
+		commit, _, err := client.Commits.RevertCommit(repo.FullName(), o.sha, &gitlab.RevertCommitOptions{Branch: gitlab.Ptr(branch)})
+		if err != nil {
+			return nil, cmdutils.WrapError(err, fmt.Sprintf("failed to revert %s on %s.", o.sha, branch))
+		}
+		return commit, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	commitutils.PrintApplied(o.io, fmt.Sprintf("Reverted %s on", o.sha), commit, o.branch, mr)
+	return nil
+}
@@ -0,0 +1,67 @@
+//go:build !integration
+
+package revert
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_Revert(t *testing.T) {
+	reverted := &gitlab.Commit{
+		ShortID: "1a2b3c4d",
+		Title:   "Revert \"Fix the build\"",
+		Message: "Revert \"Fix the build\"\n\nThis reverts commit 7e2f1c9a.",
+		WebURL:  "https://gitlab.com/OWNER/REPO/-/commit/1a2b3c4d",
+	}
+
+	t.Run("Revert on a branch", func(t *testing.T) {
+		testClient := gitlabtesting.NewTestClient(t)
+		testClient.MockCommits.EXPECT().RevertCommit("OWNER/REPO", "7e2f1c9a", &gitlab.RevertCommitOptions{
+			Branch: gitlab.Ptr("main"),
+		}).Return(reverted, nil, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdRevert, false, cmdtest.WithGitLabClient(testClient.Client))
+
+		out, err := exec("7e2f1c9a --branch main")
+		require.NoError(t, err)
+		assert.Equal(t, "✓ Reverted 7e2f1c9a on main as 1a2b3c4d\n https://gitlab.com/OWNER/REPO/-/commit/1a2b3c4d\n", out.String())
+	})
+
+	t.Run("Revert with a merge request", func(t *testing.T) {
+		testClient := gitlabtesting.NewTestClient(t)
+		testClient.MockBranches.EXPECT().CreateBranch("OWNER/REPO", &gitlab.CreateBranchOptions{
+			Branch: gitlab.Ptr("revert-7e2f1c9a"),
+			Ref:    gitlab.Ptr("main"),
+		}).Return(&gitlab.Branch{}, nil, nil)
+		testClient.MockCommits.EXPECT().RevertCommit("OWNER/REPO", "7e2f1c9a", &gitlab.RevertCommitOptions{
+			Branch: gitlab.Ptr("revert-7e2f1c9a"),
+		}).Return(reverted, nil, nil)
+		testClient.MockMergeRequests.EXPECT().CreateMergeRequest("OWNER/REPO", &gitlab.CreateMergeRequestOptions{
+			Title:              gitlab.Ptr(reverted.Title),
+			Description:        gitlab.Ptr(reverted.Message),
+			SourceBranch:       gitlab.Ptr("revert-7e2f1c9a"),
+			TargetBranch:       gitlab.Ptr("main"),
+			RemoveSourceBranch: gitlab.Ptr(true),
+		}).Return(&gitlab.MergeRequest{BasicMergeRequest: gitlab.BasicMergeRequest{
+			IID:          8,
+			SourceBranch: "revert-7e2f1c9a",
+			TargetBranch: "main",
+			WebURL:       "https://gitlab.com/OWNER/REPO/-/merge_requests/8",
+		}}, nil, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdRevert, false, cmdtest.WithGitLabClient(testClient.Client))
+
+		out, err := exec("7e2f1c9a --branch main --create-mr")
+		require.NoError(t, err)
+		assert.Equal(t, "✓ Reverted 7e2f1c9a on revert-7e2f1c9a as 1a2b3c4d\n"+
+			"✓ Created merge request !8 into main\n https://gitlab.com/OWNER/REPO/-/merge_requests/8\n", out.String())
+	})
+}
@@ -0,0 +1,209 @@
+package view
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	ref          string
+	web          bool
+	outputFormat string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+	branch       func() (string, error)
+	config       func() config.Config
+}
+
+// commitWithStatuses is the JSON output of the command.
+type commitWithStatuses struct {
+	*gitlab.Commit
+	Statuses []*gitlab.CommitStatus `json:"statuses"`
+}
+
+func NewCmdView(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+		branch:       f.Branch,
+		config:       f.Config,
+	}
+	commitViewCmd := &cobra.Command{
+		Use:   "view [<sha>] [flags]",
+		Short: `Display a commit with its stats and statuses.`,
+		Long: heredoc.Doc(`
+			Display a commit with its stats and statuses.
+
+			The commit can be given as a SHA, a branch, or a tag. Defaults to the latest
+			commit of the current branch.
+		`),
+		Aliases: []string{"show"},
+		Args:    cobra.MaximumNArgs(1),
+		Example: heredoc.Doc(`
+			$ glab commit view
+			$ glab commit view 7e2f1c9a
+			$ glab commit view v1.2.0 --output json
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.web && opts.outputFormat == "json" {
+				return &cmdutils.FlagError{Err: errors.New("--web cannot be used with --output json.")}
+			}
+			if len(args) == 1 {
+				opts.ref = args[0]
+			}
+			return opts.run()
+		},
+	}
+
+	fl := commitViewCmd.Flags()
+	fl.BoolVarP(&opts.web, "web", "w", false, "Open the commit in a browser. Uses the default browser, or the browser specified in the $BROWSER variable.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+
+	return commitViewCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	if o.ref == "" {
+		o.ref, err = o.branch()
+		if err != nil {
+			return err
+		}
+	}
+
+	commit, _, err := client.Commits.GetCommit(repo.FullName(), o.ref, &gitlab.GetCommitOptions{Stats: gitlab.Ptr(true)})
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to get commit %s.", o.ref))
+	}
+
+	if o.web {
+		if o.io.IsaTTY && o.io.IsErrTTY {
+			fmt.Fprintf(o.io.StdErr, "Opening %s in your browser.\n", utils.DisplayURL(commit.WebURL))
+		}
+		browser, _ := o.config().Get(repo.RepoHost(), "browser")
+		return utils.OpenInBrowser(commit.WebURL, browser)
+	}
+
+	statuses, _, err := client.Commits.GetCommitStatuses(repo.FullName(), commit.ID, &gitlab.GetCommitStatusesOptions{
+		ListOptions: gitlab.ListOptions{PerPage: 100},
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to get the statuses of commit %s.", commit.ShortID))
+	}
+
+	switch {
+	case o.outputFormat == "json":
+		commitJSON, _ := json.Marshal(commitWithStatuses{commit, statuses})
+		fmt.Fprintln(o.io.StdOut, string(commitJSON))
+	case o.io.IsOutputTTY():
+		o.printTTYCommit(commit, statuses)
+	default:
+		o.printRawCommit(commit, statuses)
+	}
+	return nil
+}
+
+// body returns the message of the commit without its title.
+func body(commit *gitlab.Commit) string {
+	_, rest, _ := strings.Cut(commit.Message, "\n")
+	return strings.TrimSpace(rest)
+}
+
+func (o *options) printTTYCommit(commit *gitlab.Commit, statuses []*gitlab.CommitStatus) {
+	c := o.io.Color()
+	out := o.io.StdOut
+
+	fmt.Fprintln(out, c.Bold(commit.Title)+c.Gray(" "+commit.ShortID))
+	details := "by " + commit.AuthorName
+	if commit.AuthoredDate != nil {
+		details += " • authored " + utils.TimeToPrettyTimeAgo(*commit.AuthoredDate)
+	}
+	if commit.CommitterName != "" && commit.CommitterName != commit.AuthorName {
+		details += " • committed by " + commit.CommitterName
+	}
+	fmt.Fprintln(out, c.Gray(details))
+	if commit.Stats != nil {
+		fmt.Fprintf(out, "%s %s\n", c.Green(fmt.Sprintf("+%d", commit.Stats.Additions)), c.Red(fmt.Sprintf("-%d", commit.Stats.Deletions)))
+	}
+
+	if b := body(commit); b != "" {
+		fmt.Fprintln(out)
+		fmt.Fprintln(out, b)
+	}
+
+	if len(statuses) > 0 {
+		fmt.Fprintln(out)
+		fmt.Fprintln(out, c.Bold(fmt.Sprintf("Statuses (%d):", len(statuses))))
+		for _, s := range statuses {
+			fmt.Fprintf(out, "(%s) • %s\n", statusColor(c, s), s.Name)
+		}
+	}
+
+	fmt.Fprintf(out, c.Gray("\nView this commit on GitLab: %s\n"), commit.WebURL)
+}
+
+func statusColor(c *iostreams.ColorPalette, s *gitlab.CommitStatus) string {
+	switch s.Status {
+	case "failed":
+		if s.AllowFailure {
+			return c.Yellow(s.Status)
+		}
+		return c.Red(s.Status)
+	case "success":
+		return c.Green(s.Status)
+	default:
+		return c.Gray(s.Status)
+	}
+}
+
+func (o *options) printRawCommit(commit *gitlab.Commit, statuses []*gitlab.CommitStatus) {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "sha:\t%s\n", commit.ID)
+	fmt.Fprintf(&b, "title:\t%s\n", commit.Title)
+	fmt.Fprintf(&b, "author:\t%s <%s>\n", commit.AuthorName, commit.AuthorEmail)
+	if commit.AuthoredDate != nil {
+		fmt.Fprintf(&b, "date:\t%s\n", commit.AuthoredDate.Format("2006-01-02T15:04:05Z07:00"))
+	}
+	if commit.Stats != nil {
+		fmt.Fprintf(&b, "additions:\t%d\n", commit.Stats.Additions)
+		fmt.Fprintf(&b, "deletions:\t%d\n", commit.Stats.Deletions)
+	}
+	fmt.Fprintf(&b, "url:\t%s\n", commit.WebURL)
+	b.WriteString("--\n")
+	fmt.Fprintf(&b, "%s\n", body(commit))
+	b.WriteString("--\n")
+	b.WriteString("statuses:\n")
+	for _, s := range statuses {
+		fmt.Fprintf(&b, "%s\t%s\n", s.Name, s.Status)
+	}
+	fmt.Fprint(o.io.StdOut, b.String())
+}
@@ -0,0 +1,115 @@
+//go:build !integration
+
+package view
+
+import (
+	"testing"
+	"time"
+
+	"github.com/acarl005/stripansi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_CommitView(t *testing.T) {
+	authored := time.Now().Add(-2 * time.Hour)
+	commit := &gitlab.Commit{
+		ID:           "7e2f1c9a0b1c2d3e4f5a6b7c8d9e0f1a2b3c4d5e",
+		ShortID:      "7e2f1c9a",
+		Title:        "Fix the build",
+		Message:      "Fix the build\n\nThe linker needs the new flag.\n",
+		AuthorName:   "Jane Doe",
+		AuthorEmail:  "jane@example.com",
+		AuthoredDate: &authored,
+		Stats:        &gitlab.CommitStats{Additions: 12, Deletions: 3, Total: 15},
+		WebURL:       "https://gitlab.com/OWNER/REPO/-/commit/7e2f1c9a",
+	}
+	statuses := []*gitlab.CommitStatus{
+		{Name: "build", Status: "success"},
+		{Name: "lint", Status: "failed"},
+	}
+
+	testCases := []struct {
+		name        string
+		cli         string
+		isTTY       bool
+		ref         string
+		expectedMsg []string
+	}{
+		{
+			name:  "View the commit of the current branch",
+			cli:   "",
+			isTTY: true,
+			ref:   "main",
+			expectedMsg: []string{
+				"Fix the build 7e2f1c9a",
+				"by Jane Doe • authored about 2 hours ago",
+				"+12 -3",
+				"The linker needs the new flag.",
+				"Statuses (2):",
+				"(success) • build",
+				"(failed) • lint",
+				"View this commit on GitLab: https://gitlab.com/OWNER/REPO/-/commit/7e2f1c9a",
+			},
+		},
+		{
+			name: "View a commit without a TTY",
+			cli:  "7e2f1c9a",
+			ref:  "7e2f1c9a",
+			expectedMsg: []string{
+				"sha:\t7e2f1c9a0b1c2d3e4f5a6b7c8d9e0f1a2b3c4d5e\n",
+				"author:\tJane Doe <jane@example.com>\n",
+				"additions:\t12\n",
+				"--\nThe linker needs the new flag.\n--\n",
+				"statuses:\nbuild\tsuccess\nlint\tfailed\n",
+			},
+		},
+		{
+			name:        "JSON output",
+			cli:         "7e2f1c9a -F json",
+			ref:         "7e2f1c9a",
+			expectedMsg: []string{`"short_id":"7e2f1c9a"`, `"statuses":[{`, `"name":"lint"`},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			testClient.MockCommits.EXPECT().
+				GetCommit("OWNER/REPO", tc.ref, &gitlab.GetCommitOptions{Stats: gitlab.Ptr(true)}).
+				Return(commit, nil, nil)
+			testClient.MockCommits.EXPECT().
+				GetCommitStatuses("OWNER/REPO", commit.ID, gomock.Any()).
+				Return(statuses, nil, nil)
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdView, tc.isTTY, cmdtest.WithGitLabClient(testClient.Client))
+
+			out, err := exec(tc.cli)
+			require.NoError(t, err)
+
+			output := stripansi.Strip(out.String())
+			for _, msg := range tc.expectedMsg {
+				assert.Contains(t, output, msg)
+			}
+		})
+	}
+}
+
+func Test_CommitView_NotFound(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockCommits.EXPECT().
+		GetCommit("OWNER/REPO", "nope", gomock.Any()).
+		Return(nil, nil, gitlab.ErrNotFound)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdView, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	_, err := exec("nope")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, gitlab.ErrNotFound)
+}
@@ -9,6 +9,7 @@ import (
 	"gitlab.com/gitlab-org/cli/internal/browser"
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
 	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
 	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
 )
 
@@ -23,13 +24,24 @@ func NewCmdConfig(f cmdutils.Factory) *cobra.Command {
 Current respected settings:
 
 - browser: If unset, uses the default browser. Override with environment variable $BROWSER.
+- cache_stale_ttl: If set with cache_ttl, expired cached responses younger than this duration, such as '24h', are shown when GitLab can't be reached. Override with environment variable $GLAB_CACHE_STALE_TTL.
+- cache_ttl: If set, caches API responses of read commands for this duration, such as '5m'. Override with environment variable $GLAB_CACHE_TTL.
 - check_update: If true, notifies of new versions of glab. Defaults to true. Override with environment variable $GLAB_CHECK_UPDATE.
 - display_hyperlinks: If true, and using a TTY, outputs hyperlinks for issues and merge request lists. Defaults to false.
 - editor: If unset, uses the default editor. Override with environment variable $EDITOR.
 - glab_pager: Your desired pager command to use, such as 'less -R'.
 - glamour_style: Your desired Markdown renderer style. Options are dark, light, notty. Custom styles are available using [glamour](https://github.com/charmbracelet/glamour#styles).
 - host: If unset, defaults to %[1]shttps://gitlab.com%[1]s.
+- host_aliases: Short names for GitLab hosts, used in repository arguments like 'gl/OWNER/REPO' and in git remotes, such as 'gl=gitlab.example.com'.
+- issue_required_sections: Headings of issue templates that 'glab issue create' warns about when they're deleted from the description, such as 'Summary,Steps to reproduce', or 'all'.
+- monorepo_projects: Projects of the subdirectories of a monorepo, such as 'services/auth=group/auth-service'. Commands run in a subdirectory, or deeper, use its project instead of the project of the git remote. Paths are relative to the top-level directory of the repository.
+- mr_label_rules: Labels that 'glab mr create --fill-commits' adds by Conventional Commits type, such as 'feat=feature,fix=bug'.
+- pinned_cert_sha256: Per host. SHA-256 fingerprints of the certificates accepted for the instance, comma-separated. Connections fail when the instance presents another certificate, even one signed by a trusted CA. Pin the next certificate too before a renewal.
+- theme: The color theme of the output. Options are default, dracula, light, solarized. The light theme suits terminals with a light background. Themes other than default use 256 colors, and fall back to the default colors on terminals without them.
+- theme_colors: Colors that replace the colors of the theme, such as 'success=green,warning=208,hyperlink=blue+u'. Elements are magenta, cyan, red, yellow, blue, green, gray, bold, header, success, warning, error, hyperlink, diff_add, and diff_remove. Colors are names, like 'green', numbers of the 256-color palette, like '208', with '+b' for bold, '+u' for underline, or '+h' for high intensity.
 - token: Your GitLab access token. Defaults to environment variables.
+- url_rewrites: GitLab hosts of git remotes cloned through jump hosts or mirrors, such as 'bastion.example.com:2222=gitlab.example.com'. Remote hosts can have a port and '*' wildcards.
+- user_cache_ttl: If set, caches the users looked up by username, for filters like '--assignee' of 'glab mr list', for this duration, such as '24h'. Override with environment variable $GLAB_USER_CACHE_TTL.
 - visual: Takes precedence over 'editor'. If unset, uses the default editor. Override with environment variable $VISUAL.
 `, "`"),
 		Aliases: []string{"conf"},
@@ -96,6 +108,7 @@ Specifying the '--host' flag also saves in the global configuration file.
 `,
 		Example: heredoc.Doc(`
 - glab config set editor vim
+- glab config set theme dracula --global
 - glab config set token xxxxx --host gitlab.com
 - glab config set check_update false --global`),
 		Args: cobra.ExactArgs(2),
@@ -106,6 +119,16 @@ Specifying the '--host' flag also saves in the global configuration file.
 
 			key, value := args[0], args[1]
 			var err error
+			switch key {
+			case "theme":
+				_, err = iostreams.NewTheme(value, "")
+			case "theme_colors":
+				_, err = iostreams.NewTheme("", value)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to set %q to %q: %w", key, value, err)
+			}
+
 			if isGlobal || hostname != "" {
 				err = cfg.Set(hostname, key, value)
 			} else {
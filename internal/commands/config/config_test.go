@@ -169,3 +169,21 @@ func TestConfigSet(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigSet_Theme(t *testing.T) {
+	io, _, _, _ := cmdtest.TestIOStreams()
+	cfg := configStub{}
+	f := cmdtest.NewTestFactory(io, cmdtest.WithConfig(cfg))
+
+	cmd := NewCmdConfigSet(f)
+	cmd.SetArgs([]string{"theme", "dracula", "-g"})
+	_, err := cmd.ExecuteC()
+	require.NoError(t, err)
+	assert.Equal(t, "dracula", cfg["theme"])
+
+	cmd = NewCmdConfigSet(f)
+	cmd.SetArgs([]string{"theme_colors", "success=neon", "-g"})
+	_, err = cmd.ExecuteC()
+	require.EqualError(t, err, `failed to set "theme_colors" to "success=neon": invalid color "neon" for success.`)
+	assert.NotContains(t, cfg, "theme_colors")
+}
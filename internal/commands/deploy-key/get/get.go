@@ -73,8 +73,8 @@ func (o *options) run() error {
 	}
 
 	if key.ID != 0 {
-		table := tableprinter.NewTablePrinter()
-		table.AddRow("Title", "Key", "Can Push", "Created At")
+		table := tableprinter.New(o.io)
+		table.AddHeader("Title", "Key", "Can Push", "Created At")
 		table.AddRow(key.Title, key.Key, key.CanPush, key.CreatedAt)
 		o.io.LogInfo(table.String())
 	} else {
@@ -79,14 +79,14 @@ func (o *options) run() error {
 	}
 
 	cs := o.io.Color()
-	table := tableprinter.NewTablePrinter()
+	table := tableprinter.New(o.io)
 	isTTy := o.io.IsOutputTTY()
 
 	if len(keys) > 0 {
 		if o.showKeyIDs {
-			table.AddRow("ID", "Title", "Key", "Can Push", "Created At")
+			table.AddHeader("ID", "Title", "Key", "Can Push", "Created At")
 		} else {
-			table.AddRow("Title", "Key", "Can Push", "Created At")
+			table.AddHeader("Title", "Key", "Can Push", "Created At")
 		}
 	}
 
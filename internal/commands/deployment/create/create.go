@@ -0,0 +1,119 @@
+package create
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+// Statuses are the statuses that deployments can be created with or updated to.
+var Statuses = []string{"created", "running", "success", "failed", "canceled"}
+
+type options struct {
+	environment string
+	ref         string
+	sha         string
+	tag         bool
+	status      string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+	branch       func() (string, error)
+}
+
+func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+		branch:       f.Branch,
+	}
+	deploymentCreateCmd := &cobra.Command{
+		Use:   "create --environment <name> [flags]",
+		Short: `Create a deployment for an external deployment tool.`,
+		Long: heredoc.Doc(`
+		Record a deployment that was made outside of GitLab CI/CD, for example by an
+		external continuous delivery system. The environment is created if it doesn't exist.
+
+		Create the deployment with the running status when the deployment starts, then
+		update it with 'glab deployment update' when it finishes.
+		`),
+		Args: cobra.NoArgs,
+		Example: heredoc.Doc(`
+			# Record that the current branch is being deployed to staging
+			$ glab deployment create --environment staging
+
+			# Record a successful deployment of a tag
+			$ glab deployment create --environment production --ref v1.2.0 --tag --status success
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+
+	fl := deploymentCreateCmd.Flags()
+	fl.StringVarP(&opts.environment, "environment", "e", "", "Name of the environment that is deployed to.")
+	fl.StringVar(&opts.ref, "ref", "", "Branch or tag that is deployed. Defaults to the current branch.")
+	fl.StringVar(&opts.sha, "sha", "", "SHA of the commit that is deployed. Defaults to the last commit of the ref.")
+	fl.BoolVar(&opts.tag, "tag", false, "Whether the ref is a tag.")
+	fl.VarP(cmdutils.NewEnumValue(Statuses, "running", &opts.status), "status", "s", "Status of the deployment: created, running, success, failed, canceled.")
+	cobra.CheckErr(deploymentCreateCmd.MarkFlagRequired("environment"))
+
+	return deploymentCreateCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	if o.ref == "" {
+		o.ref, err = o.branch()
+		if err != nil {
+			return fmt.Errorf("could not determine the ref to deploy. Use --ref: %w", err)
+		}
+	}
+	if o.sha == "" {
+		commit, _, err := client.Commits.GetCommit(repo.FullName(), o.ref, nil)
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to get the last commit of %s.", o.ref))
+		}
+		o.sha = commit.ID
+	}
+
+	deployment, _, err := client.Deployments.CreateProjectDeployment(repo.FullName(), &gitlab.CreateProjectDeploymentOptions{
+		Environment: gitlab.Ptr(o.environment),
+		Ref:         gitlab.Ptr(o.ref),
+		SHA:         gitlab.Ptr(o.sha),
+		Tag:         gitlab.Ptr(o.tag),
+		Status:      gitlab.Ptr(gitlab.DeploymentStatusValue(o.status)),
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to create the deployment.")
+	}
+
+	c := o.io.Color()
+	fmt.Fprintf(o.io.StdOut, "%s Created deployment %d of %s to %s: %s\n", c.GreenCheck(), deployment.ID, o.ref, o.environment, deployment.Status)
+	if deployment.Status == "created" || deployment.Status == "running" {
+		o.io.LogInfof("Update its status with 'glab deployment update %d --status success'.\n", deployment.ID)
+	}
+	return nil
+}
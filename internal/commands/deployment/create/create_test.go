@@ -0,0 +1,87 @@
+//go:build !integration
+
+package create
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_DeploymentCreate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		cli       string
+		setupMock func(tc *gitlabtesting.TestClient)
+		wantOut   string
+		wantErr   string
+	}{
+		{
+			name: "Current branch",
+			cli:  "--environment staging",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockCommits.EXPECT().GetCommit("OWNER/REPO", "feature", nil).Return(&gitlab.Commit{ID: "abc123"}, nil, nil)
+				tc.MockDeployments.EXPECT().CreateProjectDeployment("OWNER/REPO", &gitlab.CreateProjectDeploymentOptions{
+					Environment: gitlab.Ptr("staging"),
+					Ref:         gitlab.Ptr("feature"),
+					SHA:         gitlab.Ptr("abc123"),
+					Tag:         gitlab.Ptr(false),
+					Status:      gitlab.Ptr(gitlab.DeploymentStatusRunning),
+				}).Return(&gitlab.Deployment{ID: 7, Status: "running"}, nil, nil)
+			},
+			wantOut: "✓ Created deployment 7 of feature to staging: running\nUpdate its status with 'glab deployment update 7 --status success'.\n",
+		},
+		{
+			name: "Tag with a status",
+			cli:  "-e production --ref v1.2.0 --sha def456 --tag --status success",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockDeployments.EXPECT().CreateProjectDeployment("OWNER/REPO", &gitlab.CreateProjectDeploymentOptions{
+					Environment: gitlab.Ptr("production"),
+					Ref:         gitlab.Ptr("v1.2.0"),
+					SHA:         gitlab.Ptr("def456"),
+					Tag:         gitlab.Ptr(true),
+					Status:      gitlab.Ptr(gitlab.DeploymentStatusSuccess),
+				}).Return(&gitlab.Deployment{ID: 8, Status: "success"}, nil, nil)
+			},
+			wantOut: "✓ Created deployment 8 of v1.2.0 to production: success\n",
+		},
+		{
+			name:    "Missing environment",
+			cli:     "--ref main",
+			wantErr: `required flag(s) "environment" not set`,
+		},
+		{
+			name:    "Invalid status",
+			cli:     "-e staging --status done",
+			wantErr: `invalid argument "done" for "-s, --status" flag: must be one of`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			if tc.setupMock != nil {
+				tc.setupMock(testClient)
+			}
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdCreate, false,
+				cmdtest.WithGitLabClient(testClient.Client),
+				cmdtest.WithBranch("feature"),
+			)
+
+			out, err := exec(tc.cli)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantOut, out.String())
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package deployment
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	deploymentCreateCmd "gitlab.com/gitlab-org/cli/internal/commands/deployment/create"
+	deploymentListCmd "gitlab.com/gitlab-org/cli/internal/commands/deployment/list"
+	deploymentUpdateCmd "gitlab.com/gitlab-org/cli/internal/commands/deployment/update"
+)
+
+func NewCmdDeployment(f cmdutils.Factory) *cobra.Command {
+	deploymentCmd := &cobra.Command{
+		Use:     "deployment <command> [flags]",
+		Short:   `Manage deployments of a project.`,
+		Aliases: []string{"deploy"},
+		Long: heredoc.Doc(`
+		A deployment is created each time a version of the code is deployed to an
+		environment. Deployments are usually created by CI/CD jobs, but external
+		deployment tools can create and update them too.
+		`),
+	}
+
+	cmdutils.EnableRepoOverride(deploymentCmd, f)
+
+	deploymentCmd.AddCommand(deploymentListCmd.NewCmdList(f))
+	deploymentCmd.AddCommand(deploymentCreateCmd.NewCmdCreate(f))
+	deploymentCmd.AddCommand(deploymentUpdateCmd.NewCmdUpdate(f))
+	return deploymentCmd
+}
@@ -0,0 +1,31 @@
+//go:build !integration
+
+package deployment
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestNewCmdDeployment(t *testing.T) {
+	ios, _, _, _ := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios)
+
+	cmd := NewCmdDeployment(factory)
+
+	assert.Equal(t, "deployment <command> [flags]", cmd.Use)
+	assert.Contains(t, cmd.Aliases, "deploy")
+	assert.True(t, cmd.HasSubCommands())
+
+	subcommandNames := make([]string, 0, len(cmd.Commands()))
+	for _, subcmd := range cmd.Commands() {
+		subcommandNames = append(subcommandNames, subcmd.Name())
+	}
+
+	assert.Contains(t, subcommandNames, "list")
+	assert.Contains(t, subcommandNames, "create")
+	assert.Contains(t, subcommandNames, "update")
+}
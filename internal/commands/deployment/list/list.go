@@ -0,0 +1,155 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	environment  string
+	status       string
+	page         int
+	perPage      int
+	outputFormat string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdList(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	deploymentListCmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   `List deployments of a project.`,
+		Long:    "List deployments of a project, most recent first.",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Example: heredoc.Doc(`
+			# List the deployments of the current project
+			$ glab deployment list
+
+			# List the failed deployments to production
+			$ glab deployment list --environment production --status failed
+
+			# List deployments as JSON
+			$ glab deployment list -F json
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+
+	fl := deploymentListCmd.Flags()
+	fl.StringVarP(&opts.environment, "environment", "e", "", "Only list deployments to this environment.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"created", "running", "success", "failed", "canceled", "blocked"}, "", &opts.status), "status", "s", "Filter deployments by status: created, running, success, failed, canceled, blocked.")
+	fl.IntVarP(&opts.page, "page", "p", 1, "Page number.")
+	fl.IntVarP(&opts.perPage, "per-page", "P", 30, "Number of deployments to list per page.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+
+	return deploymentListCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	listOpts := &gitlab.ListProjectDeploymentsOptions{
+		ListOptions: gitlab.ListOptions{Page: int64(o.page), PerPage: int64(o.perPage)},
+		OrderBy:     gitlab.Ptr("id"),
+		Sort:        gitlab.Ptr("desc"),
+	}
+	if o.environment != "" {
+		listOpts.Environment = gitlab.Ptr(o.environment)
+	}
+	if o.status != "" {
+		listOpts.Status = gitlab.Ptr(o.status)
+	}
+
+	deployments, _, err := client.Deployments.ListProjectDeployments(repo.FullName(), listOpts)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to list deployments.")
+	}
+
+	if o.outputFormat == "json" {
+		deploymentsJSON, _ := json.Marshal(deployments)
+		fmt.Fprintln(o.io.StdOut, string(deploymentsJSON))
+		return nil
+	}
+
+	if len(deployments) == 0 {
+		o.io.LogInfof("No deployments found for %s.\n", repo.FullName())
+		return nil
+	}
+
+	// Deployments don't include the URL of their job, so build it from the URL of the project.
+	webURL := ""
+	if project, err := api.GetProject(client, repo.FullName()); err == nil {
+		webURL = project.WebURL
+	}
+
+	c := o.io.Color()
+	table := tableprinter.New(o.io)
+	table.AddHeader("ID", "ENVIRONMENT", "STATUS", "REF", "SHA", "JOB", "CREATED")
+	for _, d := range deployments {
+		environment := ""
+		if d.Environment != nil {
+			environment = d.Environment.Name
+		}
+		job := ""
+		if d.Deployable.ID != 0 {
+			job = d.Deployable.Name
+			if webURL != "" {
+				job = fmt.Sprintf("%s/-/jobs/%d", webURL, d.Deployable.ID)
+			}
+		}
+		created := ""
+		if d.CreatedAt != nil {
+			created = utils.TimeToPrettyTimeAgo(*d.CreatedAt)
+		}
+		table.AddRow(d.ID, environment, statusLabel(c, d.Status), d.Ref, utils.ShortSHA(d.SHA), job, c.Gray(created))
+	}
+	fmt.Fprint(o.io.StdOut, table.String())
+
+	return nil
+}
+
+func statusLabel(c *iostreams.ColorPalette, status string) string {
+	switch status {
+	case "success":
+		return c.Green(status)
+	case "failed":
+		return c.Red(status)
+	case "running", "blocked":
+		return c.Yellow(status)
+	default:
+		return c.Gray(status)
+	}
+}
@@ -0,0 +1,97 @@
+//go:build !integration
+
+package list
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_DeploymentList(t *testing.T) {
+	created := time.Now().Add(-2 * time.Hour)
+	deployments := []*gitlab.Deployment{
+		{
+			ID: 42, Ref: "main", SHA: "a91957a858320c0e17f3a0eca7cfacbff50ea29a", Status: "success", CreatedAt: &created,
+			Environment: &gitlab.Environment{Name: "production"},
+			Deployable:  gitlab.DeploymentDeployable{ID: 1001, Name: "deploy"},
+		},
+		{
+			ID: 41, Ref: "v1.0.0", SHA: "b83d6e391c22777fca1ed3012fce84f633d7fed0", Status: "running", CreatedAt: &created,
+			Environment: &gitlab.Environment{Name: "staging"},
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		cli         string
+		wantOpts    *gitlab.ListProjectDeploymentsOptions
+		deployments []*gitlab.Deployment
+		expectedMsg []string
+	}{
+		{
+			name: "List deployments",
+			cli:  "",
+			wantOpts: &gitlab.ListProjectDeploymentsOptions{
+				ListOptions: gitlab.ListOptions{Page: 1, PerPage: 30},
+				OrderBy:     gitlab.Ptr("id"),
+				Sort:        gitlab.Ptr("desc"),
+			},
+			deployments: deployments,
+			expectedMsg: []string{
+				"ID", "ENVIRONMENT", "STATUS", "REF", "SHA", "JOB", "CREATED",
+				"42", "production", "success", "main", "a91957a8", "https://gitlab.com/OWNER/REPO/-/jobs/1001", "about 2 hours ago",
+				"41", "staging", "running", "v1.0.0", "b83d6e39",
+			},
+		},
+		{
+			name: "Filter by environment and status",
+			cli:  "--environment production --status failed",
+			wantOpts: &gitlab.ListProjectDeploymentsOptions{
+				ListOptions: gitlab.ListOptions{Page: 1, PerPage: 30},
+				OrderBy:     gitlab.Ptr("id"),
+				Sort:        gitlab.Ptr("desc"),
+				Environment: gitlab.Ptr("production"),
+				Status:      gitlab.Ptr("failed"),
+			},
+			expectedMsg: []string{"No deployments found for OWNER/REPO."},
+		},
+		{
+			name: "JSON output",
+			cli:  "-F json",
+			wantOpts: &gitlab.ListProjectDeploymentsOptions{
+				ListOptions: gitlab.ListOptions{Page: 1, PerPage: 30},
+				OrderBy:     gitlab.Ptr("id"),
+				Sort:        gitlab.Ptr("desc"),
+			},
+			deployments: deployments[:1],
+			expectedMsg: []string{`"id":42`, `"status":"success"`},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			testClient.MockDeployments.EXPECT().ListProjectDeployments("OWNER/REPO", tc.wantOpts).Return(tc.deployments, nil, nil)
+			testClient.MockProjects.EXPECT().GetProject("OWNER/REPO", gomock.Any()).
+				Return(&gitlab.Project{WebURL: "https://gitlab.com/OWNER/REPO"}, nil, nil).AnyTimes()
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdList, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			out, err := exec(tc.cli)
+			require.NoError(t, err)
+			output := out.String() + out.Stderr()
+			for _, msg := range tc.expectedMsg {
+				assert.Contains(t, output, msg)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package update
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/deployment/create"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	id     int64
+	status string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdUpdate(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	deploymentUpdateCmd := &cobra.Command{
+		Use:   "update <id> --status <status> [flags]",
+		Short: `Update the status of a deployment.`,
+		Long: heredoc.Doc(`
+		Update the status of a deployment that was created with 'glab deployment create'.
+		Deployments created by CI/CD jobs take the status of their job, and can't be updated.
+		`),
+		Args: cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ glab deployment update 1234 --status success
+			$ glab deployment update 1234 --status failed
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("deployment ID must be a valid integer, got %q", args[0])
+			}
+			opts.id = id
+
+			return opts.run()
+		},
+	}
+
+	deploymentUpdateCmd.Flags().VarP(cmdutils.NewEnumValue(create.Statuses, "", &opts.status), "status", "s", "New status of the deployment: created, running, success, failed, canceled.")
+	cobra.CheckErr(deploymentUpdateCmd.MarkFlagRequired("status"))
+
+	return deploymentUpdateCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	deployment, _, err := client.Deployments.UpdateProjectDeployment(repo.FullName(), o.id, &gitlab.UpdateProjectDeploymentOptions{
+		Status: gitlab.Ptr(gitlab.DeploymentStatusValue(o.status)),
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to update deployment %d.", o.id))
+	}
+
+	environment := ""
+	if deployment.Environment != nil {
+		environment = " to " + deployment.Environment.Name
+	}
+	fmt.Fprintf(o.io.StdOut, "%s Updated deployment %d%s: %s\n", o.io.Color().GreenCheck(), deployment.ID, environment, deployment.Status)
+	return nil
+}
@@ -0,0 +1,65 @@
+//go:build !integration
+
+package update
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_DeploymentUpdate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		cli       string
+		setupMock func(tc *gitlabtesting.TestClient)
+		wantOut   string
+		wantErr   string
+	}{
+		{
+			name: "Mark as successful",
+			cli:  "7 --status success",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockDeployments.EXPECT().UpdateProjectDeployment("OWNER/REPO", int64(7), &gitlab.UpdateProjectDeploymentOptions{
+					Status: gitlab.Ptr(gitlab.DeploymentStatusSuccess),
+				}).Return(&gitlab.Deployment{ID: 7, Status: "success", Environment: &gitlab.Environment{Name: "staging"}}, nil, nil)
+			},
+			wantOut: "✓ Updated deployment 7 to staging: success\n",
+		},
+		{
+			name:    "Missing status",
+			cli:     "7",
+			wantErr: `required flag(s) "status" not set`,
+		},
+		{
+			name:    "Invalid ID",
+			cli:     "abc --status failed",
+			wantErr: `deployment ID must be a valid integer, got "abc"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			if tc.setupMock != nil {
+				tc.setupMock(testClient)
+			}
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdUpdate, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			out, err := exec(tc.cli)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantOut, out.String())
+		})
+	}
+}
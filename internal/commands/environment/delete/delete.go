@@ -0,0 +1,102 @@
+package delete
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/environment/envutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	environment string
+	yes         bool
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdDelete(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	environmentDeleteCmd := &cobra.Command{
+		Use:   "delete <name | id> [flags]",
+		Short: `Delete a stopped environment.`,
+		Long: heredoc.Doc(`
+		Delete an environment and its deployment history. Only stopped environments
+		can be deleted. Stop it first with 'glab environment stop'.
+		`),
+		Args: cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ glab environment delete review/my-feature
+			$ glab environment delete 42 --yes
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.environment = args[0]
+
+			if !opts.yes && !opts.io.PromptEnabled() {
+				return &cmdutils.FlagError{Err: errors.New("--yes or -y flag is required when not running interactively.")}
+			}
+
+			return opts.run(cmd.Context())
+		},
+	}
+
+	environmentDeleteCmd.Flags().BoolVarP(&opts.yes, "yes", "y", false, "Skip the confirmation prompt.")
+
+	return environmentDeleteCmd
+}
+
+func (o *options) run(ctx context.Context) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	env, err := envutils.GetEnvironment(client, repo.FullName(), o.environment)
+	if err != nil {
+		return err
+	}
+
+	if env.State != "stopped" {
+		return fmt.Errorf("environment %s is %s. Stop it with 'glab environment stop %s' before deleting it.", env.Name, env.State, env.Name)
+	}
+
+	if !o.yes {
+		err = o.io.Confirm(ctx, &o.yes, fmt.Sprintf("Delete environment %s and its deployment history?", env.Name))
+		if err != nil {
+			return cmdutils.WrapError(err, "could not prompt")
+		}
+		if !o.yes {
+			return cmdutils.CancelError()
+		}
+	}
+
+	if _, err := client.Environments.DeleteEnvironment(repo.FullName(), env.ID); err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to delete environment %s.", env.Name))
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Deleted environment %s.\n", o.io.Color().RedCheck(), env.Name)
+	return nil
+}
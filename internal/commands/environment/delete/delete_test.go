@@ -0,0 +1,67 @@
+//go:build !integration
+
+package delete
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_EnvironmentDelete(t *testing.T) {
+	testCases := []struct {
+		name    string
+		cli     string
+		state   string
+		wantOut string
+		wantErr string
+	}{
+		{
+			name:    "Delete a stopped environment",
+			cli:     "5 --yes",
+			state:   "stopped",
+			wantOut: "✓ Deleted environment review/app.\n",
+		},
+		{
+			name:    "Environment is not stopped",
+			cli:     "5 -y",
+			state:   "available",
+			wantErr: "environment review/app is available. Stop it with 'glab environment stop review/app' before deleting it.",
+		},
+		{
+			name:    "Requires --yes",
+			cli:     "5",
+			wantErr: "--yes or -y flag is required when not running interactively.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			if tc.state != "" {
+				testClient.MockEnvironments.EXPECT().
+					GetEnvironment("OWNER/REPO", int64(5)).
+					Return(&gitlab.Environment{ID: 5, Name: "review/app", State: tc.state}, nil, nil)
+			}
+			if tc.wantOut != "" {
+				testClient.MockEnvironments.EXPECT().DeleteEnvironment("OWNER/REPO", int64(5)).Return(nil, nil)
+			}
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdDelete, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			out, err := exec(tc.cli)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantOut, out.String())
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package environment
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	environmentDeleteCmd "gitlab.com/gitlab-org/cli/internal/commands/environment/delete"
+	environmentListCmd "gitlab.com/gitlab-org/cli/internal/commands/environment/list"
+	environmentRollbackCmd "gitlab.com/gitlab-org/cli/internal/commands/environment/rollback"
+	environmentStopCmd "gitlab.com/gitlab-org/cli/internal/commands/environment/stop"
+	environmentViewCmd "gitlab.com/gitlab-org/cli/internal/commands/environment/view"
+)
+
+func NewCmdEnvironment(f cmdutils.Factory) *cobra.Command {
+	environmentCmd := &cobra.Command{
+		Use:     "environment <command> [flags]",
+		Short:   `Manage environments for a project.`,
+		Aliases: []string{"env"},
+		Long: heredoc.Doc(`
+		Environments describe where code is deployed. Each time GitLab CI/CD
+		deploys a version of code to an environment, a deployment is created.
+		`),
+	}
+
+	cmdutils.EnableRepoOverride(environmentCmd, f)
+
+	environmentCmd.AddCommand(environmentListCmd.NewCmdList(f))
+	environmentCmd.AddCommand(environmentViewCmd.NewCmdView(f))
+	environmentCmd.AddCommand(environmentStopCmd.NewCmdStop(f))
+	environmentCmd.AddCommand(environmentDeleteCmd.NewCmdDelete(f))
+	environmentCmd.AddCommand(environmentRollbackCmd.NewCmdRollback(f))
+	return environmentCmd
+}
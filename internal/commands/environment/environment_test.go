@@ -0,0 +1,32 @@
+package environment
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestNewCmdEnvironment(t *testing.T) {
+	ios, _, _, _ := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios)
+
+	cmd := NewCmdEnvironment(factory)
+
+	assert.NotNil(t, cmd)
+	assert.Equal(t, "environment <command> [flags]", cmd.Use)
+	assert.Contains(t, cmd.Aliases, "env")
+	assert.True(t, cmd.HasSubCommands())
+
+	subcommandNames := make([]string, 0, len(cmd.Commands()))
+	for _, subcmd := range cmd.Commands() {
+		subcommandNames = append(subcommandNames, subcmd.Name())
+	}
+
+	assert.Contains(t, subcommandNames, "list")
+	assert.Contains(t, subcommandNames, "view")
+	assert.Contains(t, subcommandNames, "stop")
+	assert.Contains(t, subcommandNames, "delete")
+	assert.Contains(t, subcommandNames, "rollback")
+}
@@ -0,0 +1,44 @@
+package envutils
+
+import (
+	"fmt"
+	"strconv"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+)
+
+// GetEnvironment returns the environment of the project with the given name or ID.
+func GetEnvironment(client *gitlab.Client, projectPath, nameOrID string) (*gitlab.Environment, error) {
+	id, err := strconv.ParseInt(nameOrID, 10, 64)
+	if err != nil {
+		envs, _, err := client.Environments.ListEnvironments(projectPath, &gitlab.ListEnvironmentsOptions{Name: gitlab.Ptr(nameOrID)})
+		if err != nil {
+			return nil, cmdutils.WrapError(err, fmt.Sprintf("failed to find environment %q.", nameOrID))
+		}
+		if len(envs) == 0 {
+			return nil, fmt.Errorf("environment %q not found in %s.", nameOrID, projectPath)
+		}
+		id = envs[0].ID
+	}
+
+	env, _, err := client.Environments.GetEnvironment(projectPath, id)
+	if err != nil {
+		return nil, cmdutils.WrapError(err, fmt.Sprintf("failed to get environment %q.", nameOrID))
+	}
+	return env, nil
+}
+
+// StateLabel returns the state of an environment, colored by state.
+func StateLabel(c *iostreams.ColorPalette, state string) string {
+	switch state {
+	case "available":
+		return c.Green(state)
+	case "stopping":
+		return c.Yellow(state)
+	default:
+		return c.Gray(state)
+	}
+}
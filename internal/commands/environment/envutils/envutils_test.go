@@ -0,0 +1,45 @@
+//go:build !integration
+
+package envutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+)
+
+func TestGetEnvironment(t *testing.T) {
+	t.Run("By ID", func(t *testing.T) {
+		tc := gitlabtesting.NewTestClient(t)
+		tc.MockEnvironments.EXPECT().GetEnvironment("OWNER/REPO", int64(42)).Return(&gitlab.Environment{ID: 42, Name: "production"}, nil, nil)
+
+		env, err := GetEnvironment(tc.Client, "OWNER/REPO", "42")
+		require.NoError(t, err)
+		assert.Equal(t, "production", env.Name)
+	})
+
+	t.Run("By name", func(t *testing.T) {
+		tc := gitlabtesting.NewTestClient(t)
+		tc.MockEnvironments.EXPECT().
+			ListEnvironments("OWNER/REPO", &gitlab.ListEnvironmentsOptions{Name: gitlab.Ptr("review/app")}).
+			Return([]*gitlab.Environment{{ID: 7, Name: "review/app"}}, nil, nil)
+		tc.MockEnvironments.EXPECT().GetEnvironment("OWNER/REPO", int64(7)).Return(&gitlab.Environment{ID: 7, Name: "review/app"}, nil, nil)
+
+		env, err := GetEnvironment(tc.Client, "OWNER/REPO", "review/app")
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), env.ID)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		tc := gitlabtesting.NewTestClient(t)
+		tc.MockEnvironments.EXPECT().ListEnvironments("OWNER/REPO", gomock.Any()).Return(nil, nil, nil)
+
+		_, err := GetEnvironment(tc.Client, "OWNER/REPO", "staging")
+		require.EqualError(t, err, `environment "staging" not found in OWNER/REPO.`)
+	})
+}
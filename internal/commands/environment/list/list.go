@@ -0,0 +1,127 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/environment/envutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	state        string
+	search       string
+	page         int
+	perPage      int
+	outputFormat string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdList(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	environmentListCmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   `List environments of a project.`,
+		Long:    "List environments of a project. By default, only available environments are listed.",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Example: heredoc.Doc(`
+			# List the available environments of the current project
+			$ glab environment list
+
+			# List stopped review apps
+			$ glab environment list --state stopped --search review/
+
+			# List all environments as JSON
+			$ glab environment list --state all -F json
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+
+	fl := environmentListCmd.Flags()
+	fl.VarP(cmdutils.NewEnumValue([]string{"available", "stopping", "stopped", "all"}, "available", &opts.state), "state", "s", "Filter environments by state: available, stopping, stopped, all.")
+	fl.StringVar(&opts.search, "search", "", "Search environments by name.")
+	fl.IntVarP(&opts.page, "page", "p", 1, "Page number.")
+	fl.IntVarP(&opts.perPage, "per-page", "P", 30, "Number of environments to list per page.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+
+	return environmentListCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	listOpts := &gitlab.ListEnvironmentsOptions{
+		ListOptions: gitlab.ListOptions{Page: int64(o.page), PerPage: int64(o.perPage)},
+	}
+	if o.state != "all" {
+		listOpts.States = gitlab.Ptr(o.state)
+	}
+	if o.search != "" {
+		listOpts.Search = gitlab.Ptr(o.search)
+	}
+
+	envs, _, err := client.Environments.ListEnvironments(repo.FullName(), listOpts)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to list environments.")
+	}
+
+	if o.outputFormat == "json" {
+		envsJSON, _ := json.Marshal(envs)
+		fmt.Fprintln(o.io.StdOut, string(envsJSON))
+		return nil
+	}
+
+	if len(envs) == 0 {
+		if o.state == "all" {
+			o.io.LogInfof("No environments found for %s.\n", repo.FullName())
+		} else {
+			o.io.LogInfof("No %s environments found for %s.\n", o.state, repo.FullName())
+		}
+		return nil
+	}
+
+	c := o.io.Color()
+	table := tableprinter.New(o.io)
+	table.AddHeader("ID", "NAME", "STATE", "TIER", "EXTERNAL URL", "UPDATED")
+	for _, env := range envs {
+		updated := ""
+		if env.UpdatedAt != nil {
+			updated = utils.TimeToPrettyTimeAgo(*env.UpdatedAt)
+		}
+		table.AddRow(env.ID, env.Name, envutils.StateLabel(c, env.State), env.Tier, env.ExternalURL, c.Gray(updated))
+	}
+	fmt.Fprint(o.io.StdOut, table.String())
+
+	return nil
+}
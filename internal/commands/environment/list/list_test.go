@@ -0,0 +1,92 @@
+//go:build !integration
+
+package list
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_EnvironmentList(t *testing.T) {
+	updated := time.Now().Add(-3 * time.Hour)
+	envs := []*gitlab.Environment{
+		{ID: 1, Name: "production", State: "available", Tier: "production", ExternalURL: "https://example.com", UpdatedAt: &updated},
+		{ID: 2, Name: "review/app", State: "available", Tier: "development", UpdatedAt: &updated},
+	}
+
+	testCases := []struct {
+		name        string
+		cli         string
+		wantOpts    *gitlab.ListEnvironmentsOptions
+		envs        []*gitlab.Environment
+		expectedMsg []string
+	}{
+		{
+			name: "List available environments",
+			cli:  "",
+			wantOpts: &gitlab.ListEnvironmentsOptions{
+				ListOptions: gitlab.ListOptions{Page: 1, PerPage: 30},
+				States:      gitlab.Ptr("available"),
+			},
+			envs: envs,
+			expectedMsg: []string{
+				"ID", "NAME", "STATE", "TIER", "EXTERNAL URL", "UPDATED",
+				"1", "production", "available", "https://example.com", "about 3 hours ago",
+				"2", "review/app", "development",
+			},
+		},
+		{
+			name: "List all environments matching a search",
+			cli:  "--state all --search review",
+			wantOpts: &gitlab.ListEnvironmentsOptions{
+				ListOptions: gitlab.ListOptions{Page: 1, PerPage: 30},
+				Search:      gitlab.Ptr("review"),
+			},
+			envs:        envs[1:],
+			expectedMsg: []string{"review/app"},
+		},
+		{
+			name: "No stopped environments",
+			cli:  "-s stopped",
+			wantOpts: &gitlab.ListEnvironmentsOptions{
+				ListOptions: gitlab.ListOptions{Page: 1, PerPage: 30},
+				States:      gitlab.Ptr("stopped"),
+			},
+			expectedMsg: []string{"No stopped environments found for OWNER/REPO."},
+		},
+		{
+			name: "JSON output",
+			cli:  "-F json",
+			wantOpts: &gitlab.ListEnvironmentsOptions{
+				ListOptions: gitlab.ListOptions{Page: 1, PerPage: 30},
+				States:      gitlab.Ptr("available"),
+			},
+			envs:        envs[:1],
+			expectedMsg: []string{`"name":"production"`, `"external_url":"https://example.com"`},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			testClient.MockEnvironments.EXPECT().ListEnvironments("OWNER/REPO", tc.wantOpts).Return(tc.envs, nil, nil)
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdList, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			out, err := exec(tc.cli)
+			require.NoError(t, err)
+			output := out.String() + out.Stderr()
+			for _, msg := range tc.expectedMsg {
+				assert.Contains(t, output, msg)
+			}
+		})
+	}
+}
@@ -0,0 +1,264 @@
+package rollback
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+// watchInterval is the time between two polls of the deployments of the environment.
+var watchInterval = 5 * time.Second
+
+type options struct {
+	environment   string
+	deploymentID  int64
+	forceRollback bool
+	noWatch       bool
+	watchInterval time.Duration
+	watchTimeout  time.Duration
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdRollback(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:            f.IO(),
+		gitlabClient:  f.GitLabClient,
+		baseRepo:      f.BaseRepo,
+		watchInterval: watchInterval,
+	}
+	environmentRollbackCmd := &cobra.Command{
+		Use:   "rollback <environment> [flags]",
+		Short: `Roll back an environment to a previous successful deployment.`,
+		Long: heredoc.Doc(`
+		Roll back an environment by re-running the deployment job of a previous successful deployment.
+
+		By default, the last successful deployment of a commit other than the current one is used.
+		Use --to to select a specific deployment instead. After the deployment job is
+		restarted, the new deployment is watched until it finishes.
+		`),
+		Args: cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			# Roll back the production environment to the previous successful deployment
+			$ glab environment rollback production
+
+			# Roll back to a specific deployment, without a confirmation prompt
+			$ glab environment rollback production --to 1234 --yes
+
+			# Start the rollback, but don't wait for the new deployment to finish
+			$ glab environment rollback staging --no-watch
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.complete(args)
+
+			if err := opts.validate(); err != nil {
+				return err
+			}
+
+			return opts.run(cmd.Context())
+		},
+	}
+
+	fl := environmentRollbackCmd.Flags()
+	fl.Int64Var(&opts.deploymentID, "to", 0, "ID of the deployment to roll back to. Defaults to the last successful deployment of another commit.")
+	fl.BoolVarP(&opts.forceRollback, "yes", "y", false, "Skip the confirmation prompt.")
+	fl.BoolVar(&opts.noWatch, "no-watch", false, "Don't wait for the rollback deployment to finish.")
+	fl.DurationVar(&opts.watchTimeout, "timeout", 30*time.Minute, "Maximum time to wait for the rollback deployment to finish.")
+
+	return environmentRollbackCmd
+}
+
+func (o *options) complete(args []string) {
+	o.environment = args[0]
+}
+
+func (o *options) validate() error {
+	if !o.forceRollback && !o.io.PromptEnabled() {
+		return &cmdutils.FlagError{Err: errors.New("--yes or -y flag is required when not running interactively.")}
+	}
+
+	if o.deploymentID < 0 {
+		return &cmdutils.FlagError{Err: errors.New("--to must be a valid deployment ID.")}
+	}
+
+	return nil
+}
+
+func (o *options) run(ctx context.Context) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	current, target, err := o.resolveDeployments(client, repo)
+	if err != nil {
+		return err
+	}
+
+	if target.Deployable.ID == 0 {
+		return fmt.Errorf("deployment %d has no deployment job that can be re-run.", target.ID)
+	}
+
+	color := o.io.Color()
+	o.io.LogInfof("Environment:       %s\n", color.Bold(o.environment))
+	o.io.LogInfof("Current:           %s\n", describeDeployment(current))
+	o.io.LogInfof("Rolling back to:   %s\n\n", describeDeployment(target))
+
+	if !o.forceRollback && o.io.PromptEnabled() {
+		err = o.io.Confirm(ctx, &o.forceRollback, fmt.Sprintf("Re-run job %q to roll back %s?", target.Deployable.Name, o.environment))
+		if err != nil {
+			return cmdutils.WrapError(err, "could not prompt")
+		}
+	}
+
+	if !o.forceRollback {
+		return cmdutils.CancelError()
+	}
+
+	job, _, err := client.Jobs.RetryJob(repo.FullName(), target.Deployable.ID)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("Could not re-run deployment job with ID: %d", target.Deployable.ID))
+	}
+
+	o.io.LogInfof("%s Started rollback job %d (%s).\n", color.ProgressIcon(), job.ID, job.WebURL)
+
+	if o.noWatch {
+		return nil
+	}
+
+	return o.watch(ctx, client, repo, job.ID)
+}
+
+// resolveDeployments returns the current deployment of the environment and the deployment to roll back to.
+func (o *options) resolveDeployments(client *gitlab.Client, repo glrepo.Interface) (*gitlab.Deployment, *gitlab.Deployment, error) {
+	deployments, _, err := client.Deployments.ListProjectDeployments(repo.FullName(), &gitlab.ListProjectDeploymentsOptions{
+		ListOptions: gitlab.ListOptions{PerPage: 100},
+		Environment: gitlab.Ptr(o.environment),
+		Status:      gitlab.Ptr("success"),
+		OrderBy:     gitlab.Ptr("id"),
+		Sort:        gitlab.Ptr("desc"),
+	})
+	if err != nil {
+		return nil, nil, cmdutils.WrapError(err, fmt.Sprintf("Could not list deployments for environment %q", o.environment))
+	}
+
+	if len(deployments) == 0 {
+		return nil, nil, fmt.Errorf("environment %q has no successful deployments.", o.environment)
+	}
+
+	current := deployments[0]
+
+	if o.deploymentID == 0 {
+		// Skip redeployments of the current commit, for example a previous rollback,
+		// because re-running them would not change the environment.
+		for _, d := range deployments[1:] {
+			if d.SHA != current.SHA {
+				return current, d, nil
+			}
+		}
+		return nil, nil, fmt.Errorf("environment %q has no successful deployment of another commit prior to the current one.", o.environment)
+	}
+
+	target, _, err := client.Deployments.GetProjectDeployment(repo.FullName(), o.deploymentID)
+	if err != nil {
+		return nil, nil, cmdutils.WrapError(err, fmt.Sprintf("Could not get deployment with ID: %d", o.deploymentID))
+	}
+
+	if target.Environment != nil && target.Environment.Name != o.environment {
+		return nil, nil, fmt.Errorf("deployment %d belongs to environment %q, not %q.", target.ID, target.Environment.Name, o.environment)
+	}
+	if target.Status != "success" {
+		return nil, nil, fmt.Errorf("deployment %d has status %q. Only successful deployments can be rolled back to.", target.ID, target.Status)
+	}
+	if target.ID == current.ID {
+		return nil, nil, fmt.Errorf("deployment %d is already the current deployment of environment %q.", target.ID, o.environment)
+	}
+
+	return current, target, nil
+}
+
+// watch polls the deployments of the environment until the deployment created
+// by the job with the given ID has finished.
+func (o *options) watch(ctx context.Context, client *gitlab.Client, repo glrepo.Interface, jobID int64) error {
+	color := o.io.Color()
+
+	ctx, cancel := context.WithTimeout(ctx, o.watchTimeout)
+	defer cancel()
+
+	lastStatus := ""
+	for {
+		deployments, _, err := client.Deployments.ListProjectDeployments(repo.FullName(), &gitlab.ListProjectDeploymentsOptions{
+			ListOptions: gitlab.ListOptions{PerPage: 20},
+			Environment: gitlab.Ptr(o.environment),
+			OrderBy:     gitlab.Ptr("id"),
+			Sort:        gitlab.Ptr("desc"),
+		}, api.WithoutCache())
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("Could not list deployments for environment %q", o.environment))
+		}
+
+		for _, d := range deployments {
+			if d.Deployable.ID != jobID {
+				continue
+			}
+
+			if d.Status != lastStatus {
+				lastStatus = d.Status
+				o.io.LogInfof("%s Deployment %d: %s\n", color.ProgressIcon(), d.ID, d.Status)
+			}
+
+			switch d.Status {
+			case "success":
+				o.io.LogInfof("%s Environment %s rolled back to %s.\n", color.GreenCheck(), color.Bold(o.environment), utils.ShortSHA(d.SHA))
+				return nil
+			case "failed", "canceled":
+				return fmt.Errorf("rollback deployment %d finished with status %q.", d.ID, d.Status)
+			case "blocked":
+				o.io.LogInfof("%s Deployment %d is blocked and waits for approval.\n", color.WarnIcon(), d.ID)
+				return nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for the rollback deployment of environment %q: %w", o.environment, ctx.Err())
+		case <-time.After(o.watchInterval):
+		}
+	}
+}
+
+func describeDeployment(d *gitlab.Deployment) string {
+	s := fmt.Sprintf("deployment %d (%s on %s", d.ID, utils.ShortSHA(d.SHA), d.Ref)
+	if d.CreatedAt != nil {
+		s += ", " + d.CreatedAt.Format(time.RFC3339)
+	}
+	s += ")"
+	if d.Deployable.Name != "" {
+		s += " by job " + strconv.Quote(d.Deployable.Name)
+	}
+	return s
+}
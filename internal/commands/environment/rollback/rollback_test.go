@@ -0,0 +1,265 @@
+//go:build !integration
+
+package rollback
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func deployment(id, jobID int64, sha, status string) *gitlab.Deployment {
+	return &gitlab.Deployment{
+		ID:          id,
+		SHA:         sha,
+		Ref:         "main",
+		Status:      status,
+		Environment: &gitlab.Environment{Name: "production"},
+		Deployable:  gitlab.DeploymentDeployable{ID: jobID, Name: "deploy"},
+	}
+}
+
+func Test_EnvironmentRollback(t *testing.T) {
+	defaultWatchInterval := watchInterval
+	watchInterval = time.Millisecond
+	t.Cleanup(func() { watchInterval = defaultWatchInterval })
+
+	type testCase struct {
+		name        string
+		cli         string
+		expectedMsg []string
+		wantErr     bool
+		wantStderr  string
+		setupMock   func(tc *gitlabtesting.TestClient)
+	}
+
+	testCases := []testCase{
+		{
+			name: "Roll back to the previous successful deployment",
+			cli:  "production -y --no-watch",
+			expectedMsg: []string{
+				"Current:           deployment 3 (33333333 on main) by job \"deploy\"",
+				"Rolling back to:   deployment 2 (22222222 on main) by job \"deploy\"",
+				"Started rollback job 40 (https://gitlab.com/OWNER/REPO/-/jobs/40).",
+			},
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockDeployments.EXPECT().
+					ListProjectDeployments("OWNER/REPO", gomock.Any(), gomock.Any()).
+					Return([]*gitlab.Deployment{deployment(3, 30, "33333333cccccccc", "success"), deployment(2, 20, "22222222bbbbbbbb", "success")}, nil, nil)
+				tc.MockJobs.EXPECT().
+					RetryJob("OWNER/REPO", int64(20)).
+					Return(&gitlab.Job{ID: 40, WebURL: "https://gitlab.com/OWNER/REPO/-/jobs/40"}, nil, nil)
+			},
+		},
+		{
+			name: "Roll back to a specific deployment and watch it",
+			cli:  "production -y --to 1",
+			expectedMsg: []string{
+				"Rolling back to:   deployment 1 (11111111 on main) by job \"deploy\"",
+				"Deployment 4: success",
+				"Environment production rolled back to 11111111.",
+			},
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockDeployments.EXPECT().
+					ListProjectDeployments("OWNER/REPO", gomock.Any(), gomock.Any()).
+					Return([]*gitlab.Deployment{deployment(3, 30, "33333333cccccccc", "success"), deployment(2, 20, "22222222bbbbbbbb", "success")}, nil, nil)
+				tc.MockDeployments.EXPECT().
+					GetProjectDeployment("OWNER/REPO", int64(1)).
+					Return(deployment(1, 10, "11111111aaaaaaaa", "success"), nil, nil)
+				tc.MockJobs.EXPECT().
+					RetryJob("OWNER/REPO", int64(10)).
+					Return(&gitlab.Job{ID: 40}, nil, nil)
+				tc.MockDeployments.EXPECT().
+					ListProjectDeployments("OWNER/REPO", gomock.Any(), gomock.Any()).
+					Return([]*gitlab.Deployment{deployment(4, 40, "11111111aaaaaaaa", "success"), deployment(3, 30, "33333333cccccccc", "success")}, nil, nil)
+			},
+		},
+		{
+			name: "Skip earlier deployments of the current commit",
+			cli:  "production -y --no-watch",
+			expectedMsg: []string{
+				"Current:           deployment 5 (33333333 on main)",
+				"Rolling back to:   deployment 2 (22222222 on main)",
+				"Started rollback job 40",
+			},
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockDeployments.EXPECT().
+					ListProjectDeployments("OWNER/REPO", gomock.Any(), gomock.Any()).
+					Return([]*gitlab.Deployment{
+						deployment(5, 50, "33333333cccccccc", "success"),
+						deployment(3, 30, "33333333cccccccc", "success"),
+						deployment(2, 20, "22222222bbbbbbbb", "success"),
+					}, nil, nil)
+				tc.MockJobs.EXPECT().
+					RetryJob("OWNER/REPO", int64(20)).
+					Return(&gitlab.Job{ID: 40}, nil, nil)
+			},
+		},
+		{
+			name: "Watch polls until the rollback deployment succeeds",
+			cli:  "production -y",
+			expectedMsg: []string{
+				"Deployment 4: running",
+				"Deployment 4: success",
+				"Environment production rolled back to 22222222.",
+			},
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockDeployments.EXPECT().
+					ListProjectDeployments("OWNER/REPO", gomock.Any(), gomock.Any()).
+					Return([]*gitlab.Deployment{deployment(3, 30, "33333333cccccccc", "success"), deployment(2, 20, "22222222bbbbbbbb", "success")}, nil, nil)
+				tc.MockJobs.EXPECT().
+					RetryJob("OWNER/REPO", int64(20)).
+					Return(&gitlab.Job{ID: 40}, nil, nil)
+				gomock.InOrder(
+					tc.MockDeployments.EXPECT().
+						ListProjectDeployments("OWNER/REPO", gomock.Any(), gomock.Any()).
+						Return([]*gitlab.Deployment{deployment(3, 30, "33333333cccccccc", "success")}, nil, nil),
+					tc.MockDeployments.EXPECT().
+						ListProjectDeployments("OWNER/REPO", gomock.Any(), gomock.Any()).
+						Return([]*gitlab.Deployment{deployment(4, 40, "22222222bbbbbbbb", "running")}, nil, nil).
+						Times(2),
+					tc.MockDeployments.EXPECT().
+						ListProjectDeployments("OWNER/REPO", gomock.Any(), gomock.Any()).
+						Return([]*gitlab.Deployment{deployment(4, 40, "22222222bbbbbbbb", "success")}, nil, nil),
+				)
+			},
+		},
+		{
+			name: "Watch stops when the rollback deployment is blocked",
+			cli:  "production -y",
+			expectedMsg: []string{
+				"Deployment 4: blocked",
+				"Deployment 4 is blocked and waits for approval.",
+			},
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockDeployments.EXPECT().
+					ListProjectDeployments("OWNER/REPO", gomock.Any(), gomock.Any()).
+					Return([]*gitlab.Deployment{deployment(3, 30, "33333333cccccccc", "success"), deployment(2, 20, "22222222bbbbbbbb", "success")}, nil, nil)
+				tc.MockJobs.EXPECT().
+					RetryJob("OWNER/REPO", int64(20)).
+					Return(&gitlab.Job{ID: 40}, nil, nil)
+				tc.MockDeployments.EXPECT().
+					ListProjectDeployments("OWNER/REPO", gomock.Any(), gomock.Any()).
+					Return([]*gitlab.Deployment{deployment(4, 40, "22222222bbbbbbbb", "blocked")}, nil, nil)
+			},
+		},
+		{
+			name: "Watch times out",
+			cli:  "production -y --timeout 50ms",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockDeployments.EXPECT().
+					ListProjectDeployments("OWNER/REPO", gomock.Any(), gomock.Any()).
+					Return([]*gitlab.Deployment{deployment(3, 30, "33333333cccccccc", "success"), deployment(2, 20, "22222222bbbbbbbb", "success")}, nil, nil)
+				tc.MockJobs.EXPECT().
+					RetryJob("OWNER/REPO", int64(20)).
+					Return(&gitlab.Job{ID: 40}, nil, nil)
+				tc.MockDeployments.EXPECT().
+					ListProjectDeployments("OWNER/REPO", gomock.Any(), gomock.Any()).
+					Return([]*gitlab.Deployment{deployment(4, 40, "22222222bbbbbbbb", "running")}, nil, nil).
+					MinTimes(1)
+			},
+			wantErr:    true,
+			wantStderr: "timed out waiting for the rollback deployment of environment \"production\"",
+		},
+		{
+			name: "Watch reports a failed rollback deployment",
+			cli:  "production -y",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockDeployments.EXPECT().
+					ListProjectDeployments("OWNER/REPO", gomock.Any(), gomock.Any()).
+					Return([]*gitlab.Deployment{deployment(3, 30, "33333333cccccccc", "success"), deployment(2, 20, "22222222bbbbbbbb", "success")}, nil, nil)
+				tc.MockJobs.EXPECT().
+					RetryJob("OWNER/REPO", int64(20)).
+					Return(&gitlab.Job{ID: 40}, nil, nil)
+				tc.MockDeployments.EXPECT().
+					ListProjectDeployments("OWNER/REPO", gomock.Any(), gomock.Any()).
+					Return([]*gitlab.Deployment{deployment(4, 40, "22222222bbbbbbbb", "failed")}, nil, nil)
+			},
+			wantErr:    true,
+			wantStderr: "rollback deployment 4 finished with status \"failed\".",
+		},
+		{
+			name: "Environment without a previous successful deployment",
+			cli:  "production -y",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockDeployments.EXPECT().
+					ListProjectDeployments("OWNER/REPO", gomock.Any(), gomock.Any()).
+					Return([]*gitlab.Deployment{deployment(3, 30, "33333333cccccccc", "success")}, nil, nil)
+			},
+			wantErr:    true,
+			wantStderr: "environment \"production\" has no successful deployment of another commit prior to the current one.",
+		},
+		{
+			name: "Target deployment belongs to another environment",
+			cli:  "staging -y --to 1",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockDeployments.EXPECT().
+					ListProjectDeployments("OWNER/REPO", gomock.Any(), gomock.Any()).
+					Return([]*gitlab.Deployment{deployment(3, 30, "33333333cccccccc", "success")}, nil, nil)
+				tc.MockDeployments.EXPECT().
+					GetProjectDeployment("OWNER/REPO", int64(1)).
+					Return(deployment(1, 10, "11111111aaaaaaaa", "success"), nil, nil)
+			},
+			wantErr:    true,
+			wantStderr: "deployment 1 belongs to environment \"production\", not \"staging\".",
+		},
+		{
+			name: "Retrying the deployment job fails",
+			cli:  "production -y --no-watch",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockDeployments.EXPECT().
+					ListProjectDeployments("OWNER/REPO", gomock.Any(), gomock.Any()).
+					Return([]*gitlab.Deployment{deployment(3, 30, "33333333cccccccc", "success"), deployment(2, 20, "22222222bbbbbbbb", "success")}, nil, nil)
+				tc.MockJobs.EXPECT().
+					RetryJob("OWNER/REPO", int64(20)).
+					Return(nil, nil, errors.New("403 Forbidden"))
+			},
+			wantErr:    true,
+			wantStderr: "403 Forbidden",
+		},
+		{
+			name:       "Rollback without confirmation when not running interactively",
+			cli:        "production",
+			setupMock:  func(tc *gitlabtesting.TestClient) {},
+			wantErr:    true,
+			wantStderr: "--yes or -y flag is required when not running interactively.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// GIVEN
+			testClient := gitlabtesting.NewTestClient(t)
+			tc.setupMock(testClient)
+			exec := cmdtest.SetupCmdForTest(
+				t,
+				NewCmdRollback,
+				false,
+				cmdtest.WithGitLabClient(testClient.Client),
+			)
+
+			// WHEN
+			out, err := exec(tc.cli)
+
+			// THEN
+			if tc.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantStderr)
+				return
+			}
+			require.NoError(t, err)
+			for _, msg := range tc.expectedMsg {
+				assert.Contains(t, out.String(), msg)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package stop
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/environment/envutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	environment string
+	force       bool
+	yes         bool
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdStop(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	environmentStopCmd := &cobra.Command{
+		Use:   "stop <name | id> [flags]",
+		Short: `Stop an environment.`,
+		Long: heredoc.Doc(`
+		Stop an environment. If the environment has an on_stop action, its job is run
+		to tear down the deployment. Use --force to stop the environment without it.
+		`),
+		Args: cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ glab environment stop review/my-feature
+			$ glab environment stop 42 --force --yes
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.environment = args[0]
+
+			if !opts.yes && !opts.io.PromptEnabled() {
+				return &cmdutils.FlagError{Err: errors.New("--yes or -y flag is required when not running interactively.")}
+			}
+
+			return opts.run(cmd.Context())
+		},
+	}
+
+	fl := environmentStopCmd.Flags()
+	fl.BoolVar(&opts.force, "force", false, "Stop the environment without running its on_stop action.")
+	fl.BoolVarP(&opts.yes, "yes", "y", false, "Skip the confirmation prompt.")
+
+	return environmentStopCmd
+}
+
+func (o *options) run(ctx context.Context) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	env, err := envutils.GetEnvironment(client, repo.FullName(), o.environment)
+	if err != nil {
+		return err
+	}
+
+	if env.State == "stopped" {
+		return fmt.Errorf("environment %s is already stopped.", env.Name)
+	}
+
+	if !o.yes {
+		err = o.io.Confirm(ctx, &o.yes, fmt.Sprintf("Stop environment %s?", env.Name))
+		if err != nil {
+			return cmdutils.WrapError(err, "could not prompt")
+		}
+		if !o.yes {
+			return cmdutils.CancelError()
+		}
+	}
+
+	stopOpts := &gitlab.StopEnvironmentOptions{}
+	if o.force {
+		stopOpts.Force = gitlab.Ptr(true)
+	}
+	env, _, err = client.Environments.StopEnvironment(repo.FullName(), env.ID, stopOpts)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to stop environment %s.", o.environment))
+	}
+
+	c := o.io.Color()
+	if env.State == "stopping" {
+		fmt.Fprintf(o.io.StdOut, "%s Stopping environment %s. Its on_stop job is running.\n", c.ProgressIcon(), env.Name)
+		return nil
+	}
+	fmt.Fprintf(o.io.StdOut, "%s Stopped environment %s.\n", c.GreenCheck(), env.Name)
+	return nil
+}
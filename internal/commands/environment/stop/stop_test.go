@@ -0,0 +1,84 @@
+//go:build !integration
+
+package stop
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_EnvironmentStop(t *testing.T) {
+	testCases := []struct {
+		name      string
+		cli       string
+		state     string
+		setupMock func(tc *gitlabtesting.TestClient)
+		wantOut   string
+		wantErr   string
+	}{
+		{
+			name:  "Stop with on_stop action",
+			cli:   "5 --yes",
+			state: "available",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockEnvironments.EXPECT().
+					StopEnvironment("OWNER/REPO", int64(5), &gitlab.StopEnvironmentOptions{}).
+					Return(&gitlab.Environment{ID: 5, Name: "review/app", State: "stopping"}, nil, nil)
+			},
+			wantOut: "• Stopping environment review/app. Its on_stop job is running.\n",
+		},
+		{
+			name:  "Force stop",
+			cli:   "5 --force -y",
+			state: "available",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockEnvironments.EXPECT().
+					StopEnvironment("OWNER/REPO", int64(5), &gitlab.StopEnvironmentOptions{Force: gitlab.Ptr(true)}).
+					Return(&gitlab.Environment{ID: 5, Name: "review/app", State: "stopped"}, nil, nil)
+			},
+			wantOut: "✓ Stopped environment review/app.\n",
+		},
+		{
+			name:    "Already stopped",
+			cli:     "5 -y",
+			state:   "stopped",
+			wantErr: "environment review/app is already stopped.",
+		},
+		{
+			name:    "Requires --yes",
+			cli:     "5",
+			wantErr: "--yes or -y flag is required when not running interactively.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			if tc.state != "" {
+				testClient.MockEnvironments.EXPECT().
+					GetEnvironment("OWNER/REPO", int64(5)).
+					Return(&gitlab.Environment{ID: 5, Name: "review/app", State: tc.state}, nil, nil)
+			}
+			if tc.setupMock != nil {
+				tc.setupMock(testClient)
+			}
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdStop, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			out, err := exec(tc.cli)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantOut, out.String())
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/environment/envutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	environment  string
+	outputFormat string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdView(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	environmentViewCmd := &cobra.Command{
+		Use:   "view <name | id> [flags]",
+		Short: `Show the details of an environment.`,
+		Long:  "Show the state, external URL, and last deployment of an environment.",
+		Args:  cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ glab environment view production
+			$ glab environment view 42 -F json
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.environment = args[0]
+			return opts.run()
+		},
+	}
+
+	environmentViewCmd.Flags().VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+
+	return environmentViewCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	env, err := envutils.GetEnvironment(client, repo.FullName(), o.environment)
+	if err != nil {
+		return err
+	}
+
+	if o.outputFormat == "json" {
+		envJSON, _ := json.Marshal(env)
+		fmt.Fprintln(o.io.StdOut, string(envJSON))
+		return nil
+	}
+
+	c := o.io.Color()
+	var out strings.Builder
+	fmt.Fprintf(&out, "%s (ID %d)\n\n", c.Bold(env.Name), env.ID)
+	fmt.Fprintf(&out, "State:           %s\n", envutils.StateLabel(c, env.State))
+	if env.Tier != "" {
+		fmt.Fprintf(&out, "Tier:            %s\n", env.Tier)
+	}
+	if env.ExternalURL != "" {
+		fmt.Fprintf(&out, "External URL:    %s\n", env.ExternalURL)
+	}
+	if env.AutoStopAt != nil {
+		fmt.Fprintf(&out, "Auto stop:       %s\n", utils.TimeToPrettyTimeAgo(*env.AutoStopAt))
+	}
+	if env.UpdatedAt != nil {
+		fmt.Fprintf(&out, "Updated:         %s\n", utils.TimeToPrettyTimeAgo(*env.UpdatedAt))
+	}
+	fmt.Fprintf(&out, "Last deployment: %s\n", describeDeployment(env.LastDeployment))
+
+	fmt.Fprint(o.io.StdOut, out.String())
+	return nil
+}
+
+func describeDeployment(d *gitlab.Deployment) string {
+	if d == nil {
+		return "none"
+	}
+
+	s := fmt.Sprintf("#%d %s (%s on %s", d.IID, d.Status, utils.ShortSHA(d.SHA), d.Ref)
+	if d.User != nil && d.User.Username != "" {
+		s += " by @" + d.User.Username
+	}
+	if d.CreatedAt != nil {
+		s += ", " + utils.TimeToPrettyTimeAgo(*d.CreatedAt)
+	}
+	return s + ")"
+}
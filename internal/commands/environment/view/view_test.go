@@ -0,0 +1,84 @@
+//go:build !integration
+
+package view
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_EnvironmentView(t *testing.T) {
+	deployed := time.Now().Add(-2 * time.Hour)
+	env := &gitlab.Environment{
+		ID:          1,
+		Name:        "production",
+		State:       "available",
+		Tier:        "production",
+		ExternalURL: "https://example.com",
+		LastDeployment: &gitlab.Deployment{
+			IID:       12,
+			Status:    "success",
+			SHA:       "0123456789abcdef",
+			Ref:       "main",
+			User:      &gitlab.ProjectUser{Username: "alice"},
+			CreatedAt: &deployed,
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		cli         string
+		env         *gitlab.Environment
+		expectedMsg []string
+	}{
+		{
+			name: "View an environment",
+			cli:  "production",
+			env:  env,
+			expectedMsg: []string{
+				"production (ID 1)",
+				"State:           available",
+				"Tier:            production",
+				"External URL:    https://example.com",
+				"Last deployment: #12 success (01234567 on main by @alice, about 2 hours ago)",
+			},
+		},
+		{
+			name:        "Without deployments",
+			cli:         "review",
+			env:         &gitlab.Environment{ID: 1, Name: "review", State: "stopped"},
+			expectedMsg: []string{"State:           stopped", "Last deployment: none"},
+		},
+		{
+			name:        "JSON output",
+			cli:         "production -F json",
+			env:         env,
+			expectedMsg: []string{`"name":"production"`, `"last_deployment":{`},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			testClient.MockEnvironments.EXPECT().ListEnvironments("OWNER/REPO", gomock.Any()).Return([]*gitlab.Environment{{ID: 1}}, nil, nil)
+			testClient.MockEnvironments.EXPECT().GetEnvironment("OWNER/REPO", int64(1)).Return(tc.env, nil, nil)
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdView, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			out, err := exec(tc.cli)
+			require.NoError(t, err)
+			for _, msg := range tc.expectedMsg {
+				assert.Contains(t, out.String(), msg)
+			}
+		})
+	}
+}
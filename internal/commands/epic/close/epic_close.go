@@ -0,0 +1,86 @@
+package close
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/epic/epicutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	group string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdClose(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	epicCloseCmd := &cobra.Command{
+		Use:   "close <id> [flags]",
+		Short: `Close an epic.`,
+		Long: heredoc.Doc(`
+			Close an epic. Its child issues stay open.
+		`),
+		Example: heredoc.Doc(`
+			$ glab epic close 5
+			$ glab epic close https://gitlab.com/groups/GROUP/-/epics/5
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run(args[0])
+		},
+	}
+
+	epicCloseCmd.Flags().StringVarP(&opts.group, "group", "g", "", "Group of the epic. Defaults to the group of the current repository.")
+
+	return epicCloseCmd
+}
+
+func (o *options) run(arg string) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	group, iid, err := epicutils.EpicFromArg(arg, o.group, o.baseRepo)
+	if err != nil {
+		return err
+	}
+
+	c := o.io.Color()
+	epic, _, err := client.Epics.GetEpic(group, iid) //nolint:staticcheck
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to get epic &%d of %s.", iid, group))
+	}
+	if epic.State == "closed" {
+		fmt.Fprintf(o.io.StdOut, "%s Epic &%d is already closed.\n", c.WarnIcon(), iid)
+		return nil
+	}
+
+	epic, _, err = client.Epics.UpdateEpic(group, iid, &gitlab.UpdateEpicOptions{StateEvent: gitlab.Ptr("close")}) //nolint:staticcheck
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to close epic &%d.", iid))
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Closed epic &%d\n", c.RedCheck(), epic.IID)
+	fmt.Fprintln(o.io.StdOut, epicutils.DisplayEpic(c, epic, o.io.IsOutputTTY()))
+	return nil
+}
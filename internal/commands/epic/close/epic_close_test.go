@@ -0,0 +1,50 @@
+//go:build !integration
+
+package close
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestEpicClose(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockEpics.EXPECT().
+		GetEpic("OWNER", int64(5)).
+		Return(&gitlab.Epic{IID: 5, State: "opened"}, nil, nil)
+	testClient.MockEpics.EXPECT().
+		UpdateEpic("OWNER", int64(5), &gitlab.UpdateEpicOptions{StateEvent: gitlab.Ptr("close")}).
+		Return(&gitlab.Epic{IID: 5, State: "closed", WebURL: "https://gitlab.com/groups/OWNER/-/epics/5"}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdClose, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	out, err := exec("5")
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Closed epic &5\nhttps://gitlab.com/groups/OWNER/-/epics/5\n", out.String())
+}
+
+func TestEpicCloseAlreadyClosed(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockEpics.EXPECT().
+		GetEpic("mygroup", int64(5)).
+		Return(&gitlab.Epic{IID: 5, State: "closed"}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdClose, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	out, err := exec("https://gitlab.com/groups/mygroup/-/epics/5")
+	require.NoError(t, err)
+
+	assert.Equal(t, "! Epic &5 is already closed.\n", out.String())
+}
@@ -0,0 +1,127 @@
+package create
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/epic/epicutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	group        string
+	title        string
+	description  string
+	labels       []string
+	confidential bool
+	startDate    string
+	dueDate      string
+	parent       int64
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	epicCreateCmd := &cobra.Command{
+		Use:     "create [flags]",
+		Short:   `Create an epic.`,
+		Aliases: []string{"new"},
+		Example: heredoc.Doc(`
+			$ glab epic create --title "Faster search"
+			$ glab epic create --title "Q3 roadmap" --label roadmap --start-date 2025-07-01 --due-date 2025-09-30
+			$ glab epic create --title "Indexing" --parent 5 --group mygroup
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+
+	fl := epicCreateCmd.Flags()
+	fl.StringVarP(&opts.group, "group", "g", "", "Group to create the epic in. Defaults to the group of the current repository.")
+	fl.StringVarP(&opts.title, "title", "t", "", "Title of the epic.")
+	fl.StringVarP(&opts.description, "description", "d", "", "Description of the epic.")
+	fl.StringSliceVarP(&opts.labels, "label", "l", nil, "Add labels to the epic. Multiple labels can be comma-separated or specified by repeating the flag.")
+	fl.BoolVarP(&opts.confidential, "confidential", "c", false, "Make the epic confidential.")
+	fl.StringVar(&opts.startDate, "start-date", "", "Fixed start date of the epic, in the YYYY-MM-DD format.")
+	fl.StringVar(&opts.dueDate, "due-date", "", "Fixed due date of the epic, in the YYYY-MM-DD format.")
+	fl.Int64Var(&opts.parent, "parent", 0, "ID of the parent epic, in the same group.")
+
+	cobra.CheckErr(epicCreateCmd.MarkFlagRequired("title"))
+
+	return epicCreateCmd
+}
+
+func (o *options) run() error {
+	createOpts := &gitlab.CreateEpicOptions{
+		Title: gitlab.Ptr(o.title),
+	}
+	if o.description != "" {
+		createOpts.Description = gitlab.Ptr(o.description)
+	}
+	if len(o.labels) > 0 {
+		createOpts.Labels = (*gitlab.LabelOptions)(&o.labels)
+	}
+	if o.confidential {
+		createOpts.Confidential = gitlab.Ptr(true)
+	}
+	if o.startDate != "" {
+		date, err := epicutils.ParseDate("start-date", o.startDate)
+		if err != nil {
+			return &cmdutils.FlagError{Err: err}
+		}
+		createOpts.StartDateIsFixed = gitlab.Ptr(true)
+		createOpts.StartDateFixed = date
+	}
+	if o.dueDate != "" {
+		date, err := epicutils.ParseDate("due-date", o.dueDate)
+		if err != nil {
+			return &cmdutils.FlagError{Err: err}
+		}
+		createOpts.DueDateIsFixed = gitlab.Ptr(true)
+		createOpts.DueDateFixed = date
+	}
+
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	group, err := epicutils.Group(o.group, o.baseRepo)
+	if err != nil {
+		return err
+	}
+
+	if o.parent != 0 {
+		createOpts.ParentID, err = epicutils.ParentID(client, group, o.parent)
+		if err != nil {
+			return err
+		}
+	}
+
+	epic, _, err := client.Epics.CreateEpic(group, createOpts) //nolint:staticcheck
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to create the epic in %s.", group))
+	}
+
+	fmt.Fprintln(o.io.StdOut, epicutils.DisplayEpic(o.io.Color(), epic, o.io.IsOutputTTY()))
+	return nil
+}
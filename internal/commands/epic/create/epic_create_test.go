@@ -0,0 +1,63 @@
+//go:build !integration
+
+package create
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestEpicCreate(t *testing.T) {
+	startDate, _ := gitlab.ParseISOTime("2025-07-01")
+
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockEpics.EXPECT().
+		GetEpic("mygroup", int64(2)).
+		Return(&gitlab.Epic{ID: 200, IID: 2}, nil, nil)
+	testClient.MockEpics.EXPECT().
+		CreateEpic("mygroup", &gitlab.CreateEpicOptions{
+			Title:            gitlab.Ptr("Faster search"),
+			Description:      gitlab.Ptr("Make search faster."),
+			Labels:           &gitlab.LabelOptions{"backend", "performance"},
+			Confidential:     gitlab.Ptr(true),
+			StartDateIsFixed: gitlab.Ptr(true),
+			StartDateFixed:   &startDate,
+			ParentID:         gitlab.Ptr(int64(200)),
+		}).
+		Return(&gitlab.Epic{IID: 5, WebURL: "https://gitlab.com/groups/mygroup/-/epics/5"}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdCreate, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	out, err := exec(`--group mygroup --title "Faster search" -d "Make search faster." --label backend,performance --confidential --start-date 2025-07-01 --parent 2`)
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://gitlab.com/groups/mygroup/-/epics/5\n", out.String())
+}
+
+func TestEpicCreateErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		wantErr string
+	}{
+		{name: "no title", args: "", wantErr: `required flag(s) "title" not set`},
+		{name: "invalid date", args: "--title x --due-date 30/09/2025", wantErr: `invalid --due-date "30/09/2025". Use the YYYY-MM-DD format.`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			exec := cmdtest.SetupCmdForTest(t, NewCmdCreate, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			_, err := exec(tt.args)
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
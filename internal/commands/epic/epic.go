@@ -0,0 +1,50 @@
+package epic
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	epicCloseCmd "gitlab.com/gitlab-org/cli/internal/commands/epic/close"
+	epicCreateCmd "gitlab.com/gitlab-org/cli/internal/commands/epic/create"
+	epicListCmd "gitlab.com/gitlab-org/cli/internal/commands/epic/list"
+	epicRoadmapCmd "gitlab.com/gitlab-org/cli/internal/commands/epic/roadmap"
+	epicUpdateCmd "gitlab.com/gitlab-org/cli/internal/commands/epic/update"
+	epicViewCmd "gitlab.com/gitlab-org/cli/internal/commands/epic/view"
+)
+
+func NewCmdEpic(f cmdutils.Factory) *cobra.Command {
+	epicCmd := &cobra.Command{
+		Use:   "epic <command> [flags]",
+		Short: `Work with GitLab group epics.`,
+		Long: heredoc.Doc(`
+			Epics group related issues of the projects of a group. Epics are only
+			available in GitLab Premium and Ultimate.
+
+			The epics of the group of the current repository are used, unless
+			--group is set.
+		`),
+		Example: heredoc.Doc(`
+			$ glab epic list --label backend
+			$ glab epic view 5
+			$ glab epic create --title "Search" --group mygroup
+		`),
+		Annotations: map[string]string{
+			"help:arguments": heredoc.Doc(`
+				An epic can be supplied as argument in any of the following formats:
+				- by number, like "5" or "&5"
+				- by URL, like "https://gitlab.com/groups/GROUP/-/epics/5"
+			`),
+		},
+	}
+
+	cmdutils.EnableRepoOverride(epicCmd, f)
+
+	epicCmd.AddCommand(epicListCmd.NewCmdList(f))
+	epicCmd.AddCommand(epicViewCmd.NewCmdView(f))
+	epicCmd.AddCommand(epicCreateCmd.NewCmdCreate(f))
+	epicCmd.AddCommand(epicUpdateCmd.NewCmdUpdate(f))
+	epicCmd.AddCommand(epicCloseCmd.NewCmdClose(f))
+	epicCmd.AddCommand(epicRoadmapCmd.NewCmdRoadmap(f))
+	return epicCmd
+}
@@ -0,0 +1,26 @@
+package epic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestNewCmdEpic(t *testing.T) {
+	ios, _, _, _ := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios)
+
+	cmd := NewCmdEpic(factory)
+
+	assert.Equal(t, "epic <command> [flags]", cmd.Use)
+	assert.True(t, cmd.HasSubCommands())
+
+	subcommandNames := make([]string, 0, len(cmd.Commands()))
+	for _, subcmd := range cmd.Commands() {
+		subcommandNames = append(subcommandNames, subcmd.Name())
+	}
+
+	assert.ElementsMatch(t, []string{"list", "view", "create", "update", "close", "roadmap"}, subcommandNames)
+}
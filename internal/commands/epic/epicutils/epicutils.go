@@ -0,0 +1,95 @@
+package epicutils
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+var epicURLPathRE = regexp.MustCompile(`^/groups/(.+)/-/epics/(\d+)`)
+
+// Group returns the group of the --group flag, or the group of the current
+// repository.
+func Group(group string, baseRepo func() (glrepo.Interface, error)) (string, error) {
+	if group != "" {
+		return group, nil
+	}
+	repo, err := baseRepo()
+	if err != nil {
+		return "", errors.New("could not find the group of the epics. Use --group, or run the command in a repository.")
+	}
+	return repo.RepoOwner(), nil
+}
+
+// EpicFromArg parses an epic given as "5", "&5", or its URL, and returns its
+// group and IID. The group is the --group flag or the group of the current
+// repository, unless the argument is a URL.
+func EpicFromArg(arg, group string, baseRepo func() (glrepo.Interface, error)) (string, int64, error) {
+	if u, err := url.Parse(arg); err == nil && (u.Scheme == "https" || u.Scheme == "http") {
+		m := epicURLPathRE.FindStringSubmatch(u.Path)
+		if m == nil {
+			return "", 0, fmt.Errorf("invalid epic URL: %q", arg)
+		}
+		iid, _ := strconv.ParseInt(m[2], 10, 64)
+		return m[1], iid, nil
+	}
+
+	iid, err := strconv.ParseInt(strings.TrimPrefix(arg, "&"), 10, 64)
+	if err != nil || iid <= 0 {
+		return "", 0, fmt.Errorf("invalid epic format: %q", arg)
+	}
+	group, err = Group(group, baseRepo)
+	if err != nil {
+		return "", 0, err
+	}
+	return group, iid, nil
+}
+
+// EpicState returns the reference of an epic, like "&5", colored by state.
+func EpicState(c *iostreams.ColorPalette, e *gitlab.Epic) string {
+	if e.State == "opened" {
+		return c.Green(fmt.Sprintf("&%d", e.IID))
+	}
+	return c.Red(fmt.Sprintf("&%d", e.IID))
+}
+
+// DisplayEpic returns a one-line summary of an epic and its URL on TTYs, or only
+// its URL otherwise.
+func DisplayEpic(c *iostreams.ColorPalette, e *gitlab.Epic, isTTY bool) string {
+	if !isTTY {
+		return e.WebURL
+	}
+	created := ""
+	if e.CreatedAt != nil {
+		created = " (" + utils.TimeToPrettyTimeAgo(*e.CreatedAt) + ")"
+	}
+	return fmt.Sprintf("%s %s%s\n %s\n", EpicState(c, e), e.Title, created, e.WebURL)
+}
+
+// ParseDate parses the value of a date flag in the YYYY-MM-DD format.
+func ParseDate(flag, value string) (*gitlab.ISOTime, error) {
+	date, err := gitlab.ParseISOTime(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s %q. Use the YYYY-MM-DD format.", flag, value)
+	}
+	return &date, nil
+}
+
+// ParentID returns the ID of the parent epic with the IID iid, which the API
+// needs to set the parent of an epic.
+func ParentID(client *gitlab.Client, group string, iid int64) (*int64, error) {
+	parent, _, err := client.Epics.GetEpic(group, iid) //nolint:staticcheck
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the parent epic &%d: %w", iid, err)
+	}
+	return &parent.ID, nil
+}
@@ -0,0 +1,55 @@
+//go:build !integration
+
+package epicutils
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+)
+
+func TestEpicFromArg(t *testing.T) {
+	baseRepo := func() (glrepo.Interface, error) {
+		return glrepo.FromFullName("group/sub/project", "gitlab.com")
+	}
+
+	tests := []struct {
+		name      string
+		arg       string
+		group     string
+		wantGroup string
+		wantIID   int64
+		wantErr   string
+	}{
+		{name: "number", arg: "5", wantGroup: "group/sub", wantIID: 5},
+		{name: "reference", arg: "&5", wantGroup: "group/sub", wantIID: 5},
+		{name: "group flag", arg: "5", group: "other", wantGroup: "other", wantIID: 5},
+		{name: "URL", arg: "https://gitlab.com/groups/other/team/-/epics/7", group: "ignored", wantGroup: "other/team", wantIID: 7},
+		{name: "invalid", arg: "abc", wantErr: `invalid epic format: "abc"`},
+		{name: "invalid URL", arg: "https://gitlab.com/group/project/-/issues/7", wantErr: `invalid epic URL: "https://gitlab.com/group/project/-/issues/7"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			group, iid, err := EpicFromArg(tt.arg, tt.group, baseRepo)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantGroup, group)
+			assert.Equal(t, tt.wantIID, iid)
+		})
+	}
+}
+
+func TestGroupWithoutRepository(t *testing.T) {
+	_, err := Group("", func() (glrepo.Interface, error) {
+		return nil, errors.New("not a git repository")
+	})
+	assert.EqualError(t, err, "could not find the group of the epics. Use --group, or run the command in a repository.")
+}
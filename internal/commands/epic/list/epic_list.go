@@ -0,0 +1,146 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/epic/epicutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	group        string
+	state        string
+	labels       []string
+	author       string
+	search       string
+	page         int
+	perPage      int
+	outputFormat string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdList(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	epicListCmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   `List the epics of a group.`,
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			$ glab epic list
+			$ glab epic list --group mygroup --state all
+			$ glab epic list --label backend,performance --output json
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+
+	fl := epicListCmd.Flags()
+	fl.StringVarP(&opts.group, "group", "g", "", "Group of the epics. Defaults to the group of the current repository.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"opened", "closed", "all"}, "opened", &opts.state), "state", "s", "List the epics in this state: opened, closed, all.")
+	fl.StringSliceVarP(&opts.labels, "label", "l", nil, "List the epics with all these labels. Multiple labels can be comma-separated or specified by repeating the flag.")
+	fl.StringVar(&opts.author, "author", "", "List the epics created by this username.")
+	fl.StringVar(&opts.search, "search", "", "List the epics with this string in their title or description.")
+	fl.IntVarP(&opts.page, "page", "p", 1, "Page number.")
+	fl.IntVarP(&opts.perPage, "per-page", "P", 30, "Number of items to list per page.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+
+	return epicListCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	group, err := epicutils.Group(o.group, o.baseRepo)
+	if err != nil {
+		return err
+	}
+
+	listOpts := &gitlab.ListGroupEpicsOptions{
+		ListOptions: gitlab.ListOptions{Page: int64(o.page), PerPage: int64(o.perPage)},
+	}
+	if o.state != "all" {
+		listOpts.State = gitlab.Ptr(o.state)
+	}
+	if len(o.labels) > 0 {
+		listOpts.Labels = (*gitlab.LabelOptions)(&o.labels)
+	}
+	if o.search != "" {
+		listOpts.Search = gitlab.Ptr(o.search)
+	}
+	if o.author != "" {
+		user, err := api.UserByName(client, o.author)
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to find the user %s.", o.author))
+		}
+		listOpts.AuthorID = gitlab.Ptr(user.ID)
+	}
+
+	epics, _, err := client.Epics.ListGroupEpics(group, listOpts) //nolint:staticcheck
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to list the epics of %s.", group))
+	}
+
+	if o.outputFormat == "json" {
+		epicsJSON, _ := json.Marshal(epics)
+		fmt.Fprintln(o.io.StdOut, string(epicsJSON))
+		return nil
+	}
+
+	if len(epics) == 0 {
+		o.io.LogInfof("No epics match your search in %s.\n", group)
+		return nil
+	}
+
+	c := o.io.Color()
+	table := tableprinter.New(o.io)
+	table.SetIsTTY(o.io.IsOutputTTY())
+	table.AddHeader("ID", "Title", "Labels", "Created at")
+	for _, epic := range epics {
+		table.AddCell(o.io.Hyperlink(epicutils.EpicState(c, epic), epic.WebURL))
+		table.AddCell(epic.Title)
+		if len(epic.Labels) > 0 {
+			table.AddCellf("(%s)", c.Cyan(strings.Join(epic.Labels, ", ")))
+		} else {
+			table.AddCell("")
+		}
+		created := ""
+		if epic.CreatedAt != nil {
+			created = utils.TimeToPrettyTimeAgo(*epic.CreatedAt)
+		}
+		table.AddCell(c.Gray(created))
+		table.EndRow()
+	}
+
+	fmt.Fprintf(o.io.StdOut, "Showing %s in %s.\n\n", utils.Pluralize(len(epics), "epic"), group)
+	fmt.Fprint(o.io.StdOut, table.Render())
+	return nil
+}
@@ -0,0 +1,102 @@
+//go:build !integration
+
+package list
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestEpicList(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	createdAt := time.Now().Add(-2 * time.Hour)
+	epics := []*gitlab.Epic{
+		{IID: 5, Title: "Faster search", State: "opened", Labels: []string{"backend", "performance"}, CreatedAt: &createdAt, WebURL: "https://gitlab.com/groups/OWNER/-/epics/5"},
+		{IID: 6, Title: "Dark mode", State: "opened", CreatedAt: &createdAt, WebURL: "https://gitlab.com/groups/OWNER/-/epics/6"},
+	}
+
+	tests := []struct {
+		name      string
+		args      string
+		setupMock func(tc *gitlabtesting.TestClient)
+		wantOut   []string
+	}{
+		{
+			name: "group of the repository",
+			args: "",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockEpics.EXPECT().
+					ListGroupEpics("OWNER", &gitlab.ListGroupEpicsOptions{
+						ListOptions: gitlab.ListOptions{Page: 1, PerPage: 30},
+						State:       gitlab.Ptr("opened"),
+					}).
+					Return(epics, nil, nil)
+			},
+			wantOut: []string{"Showing 2 epics in OWNER.", "&5\tFaster search\t(backend, performance)\t", "&6\tDark mode\t\t"},
+		},
+		{
+			name: "filters",
+			args: "--group mygroup --state all --label backend --author alice --search search",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockUsers.EXPECT().
+					ListUsers(gomock.Any()).
+					Return([]*gitlab.User{{ID: 42, Username: "alice"}}, nil, nil)
+				tc.MockEpics.EXPECT().
+					ListGroupEpics("mygroup", &gitlab.ListGroupEpicsOptions{
+						ListOptions: gitlab.ListOptions{Page: 1, PerPage: 30},
+						Labels:      &gitlab.LabelOptions{"backend"},
+						Search:      gitlab.Ptr("search"),
+						AuthorID:    gitlab.Ptr(int64(42)),
+					}).
+					Return(epics[:1], nil, nil)
+			},
+			wantOut: []string{"Showing 1 epic in mygroup.", "&5\tFaster search"},
+		},
+		{
+			name: "json",
+			args: "--output json",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockEpics.EXPECT().
+					ListGroupEpics("OWNER", gomock.Any()).
+					Return(epics[:1], nil, nil)
+			},
+			wantOut: []string{`"iid":5`, `"title":"Faster search"`},
+		},
+		{
+			name: "no epics",
+			args: "",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockEpics.EXPECT().
+					ListGroupEpics("OWNER", gomock.Any()).
+					Return([]*gitlab.Epic{}, nil, nil)
+			},
+			wantOut: []string{"No epics match your search in OWNER."},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			tt.setupMock(testClient)
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdList, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			out, err := exec(tt.args)
+			require.NoError(t, err)
+
+			for _, want := range tt.wantOut {
+				assert.Contains(t, out.String(), want)
+			}
+		})
+	}
+}
@@ -0,0 +1,312 @@
+package roadmap
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/epic/epicutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/text"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+const (
+	titleWidth    = 30
+	minChartWidth = 20
+)
+
+type options struct {
+	group   string
+	state   string
+	labels  []string
+	from    string
+	to      string
+	width   int
+	mermaid bool
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+// scheduledEpic is an epic with the dates of its bar in the roadmap.
+type scheduledEpic struct {
+	*gitlab.Epic
+	start time.Time
+	due   time.Time
+}
+
+func NewCmdRoadmap(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	epicRoadmapCmd := &cobra.Command{
+		Use:     "roadmap [flags]",
+		Short:   `Display the epics of a group on a timeline.`,
+		Aliases: []string{"board"},
+		Long: heredoc.Doc(`
+			Display the epics of a group as a Gantt chart, with a bar from the
+			start date to the due date of each epic. Closed epics are grayed out.
+
+			An epic with only a start or a due date is shown on that day. Epics
+			without dates aren't shown.
+
+			The timeline goes from the earliest start date to the latest due date
+			of the epics, unless --from or --to is set. Then only the epics in the
+			timeframe are shown. Use --mermaid to print the chart in the Mermaid
+			gantt syntax instead, to render it in Markdown.
+		`),
+		Example: heredoc.Doc(`
+			$ glab epic roadmap
+			$ glab epic roadmap --group mygroup --label backend --from 2025-01-01 --to 2025-06-30
+
+			# Add the roadmap to a Markdown file
+			$ glab epic roadmap --state opened --mermaid >> ROADMAP.md
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+
+	fl := epicRoadmapCmd.Flags()
+	fl.StringVarP(&opts.group, "group", "g", "", "Group of the epics. Defaults to the group of the current repository.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"opened", "closed", "all"}, "all", &opts.state), "state", "s", "Show the epics in this state: opened, closed, all.")
+	fl.StringSliceVarP(&opts.labels, "label", "l", nil, "Show the epics with all these labels. Multiple labels can be comma-separated or specified by repeating the flag.")
+	fl.StringVar(&opts.from, "from", "", "Start of the timeframe, in the YYYY-MM-DD format.")
+	fl.StringVar(&opts.to, "to", "", "End of the timeframe, in the YYYY-MM-DD format.")
+	fl.IntVar(&opts.width, "width", 0, "Width of the chart, in columns. Defaults to the width of the terminal.")
+	fl.BoolVar(&opts.mermaid, "mermaid", false, "Print the roadmap in the Mermaid gantt syntax.")
+
+	return epicRoadmapCmd
+}
+
+func (o *options) run() error {
+	var from, to time.Time
+	if o.from != "" {
+		date, err := epicutils.ParseDate("from", o.from)
+		if err != nil {
+			return &cmdutils.FlagError{Err: err}
+		}
+		from = time.Time(*date)
+	}
+	if o.to != "" {
+		date, err := epicutils.ParseDate("to", o.to)
+		if err != nil {
+			return &cmdutils.FlagError{Err: err}
+		}
+		to = time.Time(*date)
+	}
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		return &cmdutils.FlagError{Err: fmt.Errorf("--to %s is before --from %s.", o.to, o.from)}
+	}
+
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	group, err := epicutils.Group(o.group, o.baseRepo)
+	if err != nil {
+		return err
+	}
+
+	listOpts := &gitlab.ListGroupEpicsOptions{
+		ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage},
+	}
+	if o.state != "all" {
+		listOpts.State = gitlab.Ptr(o.state)
+	}
+	if len(o.labels) > 0 {
+		listOpts.Labels = (*gitlab.LabelOptions)(&o.labels)
+	}
+	epics, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Epic, *gitlab.Response, error) {
+		return client.Epics.ListGroupEpics(group, listOpts, p) //nolint:staticcheck
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to list the epics of %s.", group))
+	}
+
+	scheduled := schedule(epics, from, to)
+	if len(scheduled) == 0 {
+		o.io.LogInfof("No epics with dates match your search in %s.\n", group)
+		return nil
+	}
+	if from.IsZero() {
+		from = slices.MinFunc(scheduled, func(a, b *scheduledEpic) int { return a.start.Compare(b.start) }).start
+	}
+	if to.IsZero() {
+		to = slices.MaxFunc(scheduled, func(a, b *scheduledEpic) int { return a.due.Compare(b.due) }).due
+	}
+
+	if o.mermaid {
+		fmt.Fprint(o.io.StdOut, mermaidGantt(group, scheduled))
+		return nil
+	}
+
+	width := o.width
+	if width <= 0 {
+		width = o.io.TerminalWidth() - labelWidth(scheduled)
+	}
+	width = max(width, minChartWidth)
+
+	c := o.io.Color()
+	fmt.Fprintf(o.io.StdOut, "Roadmap of %s, %s to %s: %s\n\n", group, from.Format(time.DateOnly), to.Format(time.DateOnly), utils.Pluralize(len(scheduled), "epic"))
+	refColumn := refWidth(scheduled)
+	fmt.Fprintln(o.io.StdOut, strings.Repeat(" ", labelWidth(scheduled))+c.Gray(timelineAxis(from, to, width)))
+	for _, e := range scheduled {
+		bar := chartBar(e.start, e.due, from, to, width)
+		if e.State == "closed" {
+			bar = c.Gray(bar)
+		} else {
+			bar = c.Green(bar)
+		}
+		fmt.Fprintf(o.io.StdOut, "%s  %s  %s  %s  %s\n",
+			text.PadRight(o.io.Hyperlink(epicutils.EpicState(c, e.Epic), e.WebURL), refColumn, ' '),
+			text.Truncate(e.Title, titleWidth),
+			c.Gray(e.start.Format(time.DateOnly)),
+			c.Gray(e.due.Format(time.DateOnly)),
+			bar)
+	}
+	return nil
+}
+
+// refWidth returns the width of the longest reference of the epics.
+func refWidth(epics []*scheduledEpic) int {
+	width := 0
+	for _, e := range epics {
+		width = max(width, len(fmt.Sprintf("&%d", e.IID)))
+	}
+	return width
+}
+
+// labelWidth returns the width of the columns before the chart.
+func labelWidth(epics []*scheduledEpic) int {
+	return refWidth(epics) + titleWidth + 2*len(time.DateOnly) + 4*2
+}
+
+// schedule returns the epics with dates that overlap the timeframe, sorted by
+// start date. from and to are ignored when they're zero.
+func schedule(epics []*gitlab.Epic, from, to time.Time) []*scheduledEpic {
+	var scheduled []*scheduledEpic
+	for _, e := range epics {
+		if e.StartDate == nil && e.DueDate == nil {
+			continue
+		}
+		s := &scheduledEpic{Epic: e}
+		if e.StartDate != nil {
+			s.start = time.Time(*e.StartDate)
+		}
+		if e.DueDate != nil {
+			s.due = time.Time(*e.DueDate)
+		}
+		if s.start.IsZero() {
+			s.start = s.due
+		}
+		if s.due.IsZero() || s.due.Before(s.start) {
+			s.due = s.start
+		}
+		if (!from.IsZero() && s.due.Before(from)) || (!to.IsZero() && s.start.After(to)) {
+			continue
+		}
+		scheduled = append(scheduled, s)
+	}
+	slices.SortStableFunc(scheduled, func(a, b *scheduledEpic) int {
+		if n := a.start.Compare(b.start); n != 0 {
+			return n
+		}
+		if n := a.due.Compare(b.due); n != 0 {
+			return n
+		}
+		return int(a.IID - b.IID)
+	})
+	return scheduled
+}
+
+// column returns the column of the chart of the day date, from 0 to width-1.
+func column(date, from, to time.Time, width int) int {
+	days := to.Sub(from).Hours()/24 + 1
+	col := int(date.Sub(from).Hours() / 24 * float64(width) / days)
+	return min(max(col, 0), width-1)
+}
+
+// chartBar returns the bar of an epic from start to due, clipped to the
+// timeframe from-to.
+func chartBar(start, due, from, to time.Time, width int) string {
+	first, last := column(start, from, to, width), column(due, from, to, width)
+	var b strings.Builder
+	for i := range width {
+		if i >= first && i <= last {
+			b.WriteString("█")
+		} else {
+			b.WriteString("·")
+		}
+	}
+	return b.String()
+}
+
+// timelineAxis returns the months of the timeframe from-to, at their column in
+// the chart. Months are skipped when there isn't room for their name.
+func timelineAxis(from, to time.Time, width int) string {
+	axis := []rune(strings.Repeat(" ", width))
+	next := 0
+	month := time.Date(from.Year(), from.Month(), 1, 0, 0, 0, 0, from.Location())
+	for ; !month.After(to); month = month.AddDate(0, 1, 0) {
+		date := month
+		if date.Before(from) {
+			date = from
+		}
+		label := month.Format("Jan")
+		if month.Month() == time.January || next == 0 {
+			label = month.Format("Jan 2006")
+		}
+		col := column(date, from, to, width)
+		if col < next || col+len(label) > width {
+			continue
+		}
+		copy(axis[col:], []rune(label))
+		next = col + len(label) + 1
+	}
+	return strings.TrimRight(string(axis), " ")
+}
+
+// mermaidGantt returns the epics as a Mermaid gantt chart.
+func mermaidGantt(group string, epics []*scheduledEpic) string {
+	var b strings.Builder
+	b.WriteString("gantt\n")
+	fmt.Fprintf(&b, "    title Roadmap of %s\n", group)
+	b.WriteString("    dateFormat YYYY-MM-DD\n")
+	fmt.Fprintf(&b, "    section %s\n", group)
+	for _, e := range epics {
+		tags := ""
+		if e.State == "closed" {
+			tags = "done, "
+		}
+		// The end dates of Mermaid tasks are exclusive.
+		fmt.Fprintf(&b, "    %s &%d :%sepic%d, %s, %s\n", mermaidText(e.Title), e.IID, tags, e.IID, e.start.Format(time.DateOnly), e.due.AddDate(0, 0, 1).Format(time.DateOnly))
+	}
+	return b.String()
+}
+
+// mermaidText removes the characters of a title that end task names in the
+// Mermaid syntax.
+func mermaidText(s string) string {
+	return strings.Join(strings.Fields(strings.NewReplacer(":", " ", ";", " ", "#", " ").Replace(s)), " ")
+}
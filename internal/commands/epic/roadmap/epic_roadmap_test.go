@@ -0,0 +1,92 @@
+//go:build !integration
+
+package roadmap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func isoDate(t *testing.T, s string) *gitlab.ISOTime {
+	t.Helper()
+	date, err := gitlab.ParseISOTime(s)
+	require.NoError(t, err)
+	return &date
+}
+
+func testEpics(t *testing.T) []*gitlab.Epic {
+	return []*gitlab.Epic{
+		{IID: 6, Title: "Dark mode", State: "closed", StartDate: isoDate(t, "2025-03-01"), DueDate: isoDate(t, "2025-04-30")},
+		{IID: 5, Title: "Faster search", State: "opened", StartDate: isoDate(t, "2025-01-01"), DueDate: isoDate(t, "2025-02-28")},
+		{IID: 7, Title: "Undated", State: "opened"},
+		{IID: 8, Title: "Release: 2.0 #final", State: "opened", DueDate: isoDate(t, "2025-06-30")},
+	}
+}
+
+func TestEpicRoadmap(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockEpics.EXPECT().
+		ListGroupEpics("OWNER", &gitlab.ListGroupEpicsOptions{
+			ListOptions: gitlab.ListOptions{PerPage: 100},
+			Labels:      &gitlab.LabelOptions{"backend"},
+		}, gomock.Any()).
+		Return(testEpics(t), &gitlab.Response{}, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdRoadmap, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	out, err := exec("--label backend --from 2025-01-01 --to 2025-06-30 --width 24")
+	require.NoError(t, err)
+
+	assert.Equal(t, "Roadmap of OWNER, 2025-01-01 to 2025-06-30: 3 epics\n\n"+
+		"                                                            Jan 2025   Apr May  Jun\n"+
+		"&5  Faster search                   2025-01-01  2025-02-28  ████████················\n"+
+		"&6  Dark mode                       2025-03-01  2025-04-30  ·······█████████········\n"+
+		"&8  Release: 2.0 #final             2025-06-30  2025-06-30  ·······················█\n", out.String())
+}
+
+func TestEpicRoadmapTimeframe(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockEpics.EXPECT().
+		ListGroupEpics("mygroup", gomock.Any(), gomock.Any()).
+		Return(testEpics(t), &gitlab.Response{}, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdRoadmap, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	out, err := exec("--group mygroup --from 2025-04-01 --mermaid")
+	require.NoError(t, err)
+
+	assert.Equal(t, "gantt\n"+
+		"    title Roadmap of mygroup\n"+
+		"    dateFormat YYYY-MM-DD\n"+
+		"    section mygroup\n"+
+		"    Dark mode &6 :done, epic6, 2025-03-01, 2025-05-01\n"+
+		"    Release 2.0 final &8 :epic8, 2025-06-30, 2025-07-01\n", out.String())
+}
+
+func TestEpicRoadmapInvalidTimeframe(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+	exec := cmdtest.SetupCmdForTest(t, NewCmdRoadmap, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	_, err := exec("--from 2025-06-01 --to 2025-01-01")
+	require.EqualError(t, err, "--to 2025-01-01 is before --from 2025-06-01.")
+}
+
+func TestTimelineAxis(t *testing.T) {
+	from := time.Date(2024, time.November, 15, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, time.February, 28, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "Nov 2024         Jan 2025    Feb", timelineAxis(from, to, 40))
+}
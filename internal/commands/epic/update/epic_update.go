@@ -0,0 +1,152 @@
+package update
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/epic/epicutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	group        string
+	title        string
+	description  string
+	labels       []string
+	unlabels     []string
+	confidential bool
+	public       bool
+	startDate    string
+	dueDate      string
+	parent       int64
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdUpdate(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	epicUpdateCmd := &cobra.Command{
+		Use:   "update <id> [flags]",
+		Short: `Update an epic.`,
+		Example: heredoc.Doc(`
+			$ glab epic update 5 --title "Faster search" --label performance
+			$ glab epic update 5 --unlabel draft --due-date 2025-12-31
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.confidential && opts.public {
+				return &cmdutils.FlagError{Err: errors.New("--public and --confidential can't be used together.")}
+			}
+			updateOpts, err := opts.updateOptions()
+			if err != nil {
+				return err
+			}
+			if *updateOpts == (gitlab.UpdateEpicOptions{}) && opts.parent == 0 {
+				return &cmdutils.FlagError{Err: errors.New("specify at least one change to make to the epic.")}
+			}
+			return opts.run(args[0], updateOpts)
+		},
+	}
+
+	fl := epicUpdateCmd.Flags()
+	fl.StringVarP(&opts.group, "group", "g", "", "Group of the epic. Defaults to the group of the current repository.")
+	fl.StringVarP(&opts.title, "title", "t", "", "Title of the epic.")
+	fl.StringVarP(&opts.description, "description", "d", "", "Description of the epic.")
+	fl.StringSliceVarP(&opts.labels, "label", "l", nil, "Add labels.")
+	fl.StringSliceVarP(&opts.unlabels, "unlabel", "u", nil, "Remove labels.")
+	fl.BoolVarP(&opts.confidential, "confidential", "c", false, "Make the epic confidential.")
+	fl.BoolVarP(&opts.public, "public", "p", false, "Make the epic public.")
+	fl.StringVar(&opts.startDate, "start-date", "", "Fixed start date of the epic, in the YYYY-MM-DD format.")
+	fl.StringVar(&opts.dueDate, "due-date", "", "Fixed due date of the epic, in the YYYY-MM-DD format.")
+	fl.Int64Var(&opts.parent, "parent", 0, "ID of the parent epic, in the same group.")
+
+	return epicUpdateCmd
+}
+
+// updateOptions returns the changes of the flags, without the parent, which
+// needs the API to be resolved.
+func (o *options) updateOptions() (*gitlab.UpdateEpicOptions, error) {
+	updateOpts := &gitlab.UpdateEpicOptions{}
+	if o.title != "" {
+		updateOpts.Title = gitlab.Ptr(o.title)
+	}
+	if o.description != "" {
+		updateOpts.Description = gitlab.Ptr(o.description)
+	}
+	if len(o.labels) > 0 {
+		updateOpts.AddLabels = (*gitlab.LabelOptions)(&o.labels)
+	}
+	if len(o.unlabels) > 0 {
+		updateOpts.RemoveLabels = (*gitlab.LabelOptions)(&o.unlabels)
+	}
+	if o.confidential {
+		updateOpts.Confidential = gitlab.Ptr(true)
+	}
+	if o.public {
+		updateOpts.Confidential = gitlab.Ptr(false)
+	}
+	if o.startDate != "" {
+		date, err := epicutils.ParseDate("start-date", o.startDate)
+		if err != nil {
+			return nil, &cmdutils.FlagError{Err: err}
+		}
+		updateOpts.StartDateIsFixed = gitlab.Ptr(true)
+		updateOpts.StartDateFixed = date
+	}
+	if o.dueDate != "" {
+		date, err := epicutils.ParseDate("due-date", o.dueDate)
+		if err != nil {
+			return nil, &cmdutils.FlagError{Err: err}
+		}
+		updateOpts.DueDateIsFixed = gitlab.Ptr(true)
+		updateOpts.DueDateFixed = date
+	}
+	return updateOpts, nil
+}
+
+func (o *options) run(arg string, updateOpts *gitlab.UpdateEpicOptions) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	group, iid, err := epicutils.EpicFromArg(arg, o.group, o.baseRepo)
+	if err != nil {
+		return err
+	}
+
+	if o.parent != 0 {
+		updateOpts.ParentID, err = epicutils.ParentID(client, group, o.parent)
+		if err != nil {
+			return err
+		}
+	}
+
+	epic, _, err := client.Epics.UpdateEpic(group, iid, updateOpts) //nolint:staticcheck
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to update epic &%d.", iid))
+	}
+
+	c := o.io.Color()
+	fmt.Fprintf(o.io.StdOut, "%s Updated epic &%d\n", c.GreenCheck(), epic.IID)
+	fmt.Fprintln(o.io.StdOut, epicutils.DisplayEpic(c, epic, o.io.IsOutputTTY()))
+	return nil
+}
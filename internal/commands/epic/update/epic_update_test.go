@@ -0,0 +1,61 @@
+//go:build !integration
+
+package update
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestEpicUpdate(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	dueDate, _ := gitlab.ParseISOTime("2025-12-31")
+
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockEpics.EXPECT().
+		UpdateEpic("OWNER", int64(5), &gitlab.UpdateEpicOptions{
+			Title:          gitlab.Ptr("Faster search"),
+			AddLabels:      &gitlab.LabelOptions{"performance"},
+			RemoveLabels:   &gitlab.LabelOptions{"draft"},
+			Confidential:   gitlab.Ptr(false),
+			DueDateIsFixed: gitlab.Ptr(true),
+			DueDateFixed:   &dueDate,
+		}).
+		Return(&gitlab.Epic{IID: 5, WebURL: "https://gitlab.com/groups/OWNER/-/epics/5"}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdUpdate, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	out, err := exec(`5 --title "Faster search" --label performance --unlabel draft --public --due-date 2025-12-31`)
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Updated epic &5\nhttps://gitlab.com/groups/OWNER/-/epics/5\n", out.String())
+}
+
+func TestEpicUpdateErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		wantErr string
+	}{
+		{name: "no changes", args: "5", wantErr: "specify at least one change to make to the epic."},
+		{name: "confidential and public", args: "5 --confidential --public", wantErr: "--public and --confidential can't be used together."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			exec := cmdtest.SetupCmdForTest(t, NewCmdUpdate, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			_, err := exec(tt.args)
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
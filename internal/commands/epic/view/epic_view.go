@@ -0,0 +1,226 @@
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/epic/epicutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/issuable/issuableutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+// EpicWithIssues is the JSON output of the command.
+type EpicWithIssues struct {
+	*gitlab.Epic
+	Issues []*gitlab.Issue `json:"issues"`
+}
+
+type options struct {
+	group        string
+	milestone    string
+	web          bool
+	outputFormat string
+
+	epic   *gitlab.Epic
+	issues []*gitlab.Issue
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+	config       func() config.Config
+}
+
+func NewCmdView(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+		config:       f.Config,
+	}
+
+	epicViewCmd := &cobra.Command{
+		Use:     "view <id> [flags]",
+		Short:   `Display an epic and its child issues.`,
+		Aliases: []string{"show"},
+		Example: heredoc.Doc(`
+			$ glab epic view 5
+			$ glab epic view 5 --milestone 17.0
+			$ glab epic view https://gitlab.com/groups/GROUP/-/epics/5 --output json
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run(args[0])
+		},
+	}
+
+	fl := epicViewCmd.Flags()
+	fl.StringVarP(&opts.group, "group", "g", "", "Group of the epic. Defaults to the group of the current repository.")
+	fl.StringVarP(&opts.milestone, "milestone", "m", "", "Only show the child issues of the milestone with this title.")
+	fl.BoolVarP(&opts.web, "web", "w", false, "Open the epic in a browser. Uses the default browser, or the browser specified in the $BROWSER variable.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+
+	return epicViewCmd
+}
+
+func (o *options) run(arg string) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	group, iid, err := epicutils.EpicFromArg(arg, o.group, o.baseRepo)
+	if err != nil {
+		return err
+	}
+
+	o.epic, _, err = client.Epics.GetEpic(group, iid) //nolint:staticcheck
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to get epic &%d of %s.", iid, group))
+	}
+
+	if o.web {
+		if o.io.IsaTTY && o.io.IsErrTTY {
+			fmt.Fprintf(o.io.StdErr, "Opening %s in your browser.\n", utils.DisplayURL(o.epic.WebURL))
+		}
+		browser, _ := o.config().Get(client.BaseURL().Hostname(), "browser")
+		return utils.OpenInBrowser(o.epic.WebURL, browser)
+	}
+
+	issues, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Issue, *gitlab.Response, error) {
+		return client.EpicIssues.ListEpicIssues(group, iid, &gitlab.ListOptions{PerPage: api.MaxPerPage}, p) //nolint:staticcheck
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to list the issues of epic &%d.", iid))
+	}
+	// The API doesn't filter the issues of epics.
+	for _, issue := range issues {
+		if o.milestone == "" || (issue.Milestone != nil && issue.Milestone.Title == o.milestone) {
+			o.issues = append(o.issues, issue)
+		}
+	}
+
+	switch {
+	case o.outputFormat == "json":
+		epicJSON, _ := json.Marshal(EpicWithIssues{Epic: o.epic, Issues: o.issues})
+		fmt.Fprintln(o.io.StdOut, string(epicJSON))
+	case o.io.IsOutputTTY():
+		o.printTTYEpic()
+	default:
+		fmt.Fprint(o.io.StdOut, o.rawEpic())
+	}
+	return nil
+}
+
+func (o *options) printTTYEpic() {
+	c := o.io.Color()
+	out := o.io.StdOut
+
+	state := c.Green("open")
+	if o.epic.State == "closed" {
+		state = c.Red(o.epic.State)
+	}
+	fmt.Fprint(out, state)
+	if o.epic.Author != nil && o.epic.CreatedAt != nil {
+		fmt.Fprint(out, c.Gray(fmt.Sprintf(" • opened by %s %s", o.epic.Author.Username, utils.TimeToPrettyTimeAgo(*o.epic.CreatedAt))))
+	}
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, c.Bold(o.epic.Title)+c.Gray(fmt.Sprintf(" &%d", o.epic.IID)))
+
+	if o.epic.Description != "" {
+		description, _ := utils.RenderMarkdown(o.epic.Description, o.io.BackgroundColor())
+		fmt.Fprintln(out, description)
+	}
+
+	fmt.Fprintln(out)
+	if len(o.epic.Labels) > 0 {
+		fmt.Fprintln(out, c.Bold("Labels: ")+strings.Join(o.epic.Labels, ", "))
+	}
+	if o.epic.StartDate != nil {
+		fmt.Fprintln(out, c.Bold("Start date: ")+o.epic.StartDate.String())
+	}
+	if o.epic.DueDate != nil {
+		fmt.Fprintln(out, c.Bold("Due date: ")+o.epic.DueDate.String())
+	}
+
+	fmt.Fprintln(out)
+	if len(o.issues) == 0 {
+		fmt.Fprintln(out, "There are no child issues"+o.milestoneSuffix()+".")
+	} else {
+		fmt.Fprintln(out, c.Bold(fmt.Sprintf("Child issues%s (%d):", o.milestoneSuffix(), len(o.issues))))
+		table := tableprinter.New(o.io)
+		table.SetIsTTY(true)
+		for _, issue := range o.issues {
+			ref := issuableutils.IssueReference(issue)
+			if issue.State == "opened" {
+				ref = c.Green(ref)
+			} else {
+				ref = c.Red(ref)
+			}
+			table.AddCell(o.io.Hyperlink(ref, issue.WebURL))
+			table.AddCell(issue.Title)
+			table.AddCell(c.Gray(issueMilestone(issue)))
+			table.EndRow()
+		}
+		fmt.Fprint(out, table.Render())
+	}
+
+	fmt.Fprintf(out, c.Gray("\nView this epic on GitLab: %s\n"), o.epic.WebURL)
+}
+
+func (o *options) rawEpic() string {
+	var b strings.Builder
+
+	author := ""
+	if o.epic.Author != nil {
+		author = o.epic.Author.Username
+	}
+	fmt.Fprintf(&b, "title:\t%s\n", o.epic.Title)
+	fmt.Fprintf(&b, "state:\t%s\n", o.epic.State)
+	fmt.Fprintf(&b, "author:\t%s\n", author)
+	fmt.Fprintf(&b, "labels:\t%s\n", strings.Join(o.epic.Labels, ", "))
+	if o.epic.StartDate != nil {
+		fmt.Fprintf(&b, "start date:\t%s\n", o.epic.StartDate.String())
+	}
+	if o.epic.DueDate != nil {
+		fmt.Fprintf(&b, "due date:\t%s\n", o.epic.DueDate.String())
+	}
+	fmt.Fprintf(&b, "url:\t%s\n", o.epic.WebURL)
+	b.WriteString("--\n")
+	fmt.Fprintf(&b, "%s\n", o.epic.Description)
+	b.WriteString("--\n")
+	fmt.Fprintf(&b, "issues:\n")
+	for _, issue := range o.issues {
+		fmt.Fprintf(&b, "%s\t%s\t%s\t%s\n", issuableutils.IssueReference(issue), issue.State, issue.Title, issueMilestone(issue))
+	}
+	return b.String()
+}
+
+func (o *options) milestoneSuffix() string {
+	if o.milestone == "" {
+		return ""
+	}
+	return fmt.Sprintf(" in milestone %q", o.milestone)
+}
+
+func issueMilestone(issue *gitlab.Issue) string {
+	if issue.Milestone == nil {
+		return ""
+	}
+	return issue.Milestone.Title
+}
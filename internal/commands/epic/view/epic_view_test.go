@@ -0,0 +1,103 @@
+//go:build !integration
+
+package view
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func setupMocks(tc *gitlabtesting.TestClient, group string) {
+	createdAt := time.Now().Add(-2 * time.Hour)
+	dueDate, _ := gitlab.ParseISOTime("2025-09-30")
+	tc.MockEpics.EXPECT().
+		GetEpic(group, int64(5)).
+		Return(&gitlab.Epic{
+			IID:         5,
+			Title:       "Faster search",
+			Description: "Make search faster.",
+			State:       "opened",
+			Labels:      []string{"backend"},
+			Author:      &gitlab.EpicAuthor{Username: "alice"},
+			CreatedAt:   &createdAt,
+			DueDate:     &dueDate,
+			WebURL:      "https://gitlab.com/groups/" + group + "/-/epics/5",
+		}, nil, nil)
+	tc.MockEpicIssues.EXPECT().
+		ListEpicIssues(group, int64(5), &gitlab.ListOptions{PerPage: 100}, gomock.Any()).
+		Return([]*gitlab.Issue{
+			{IID: 12, Title: "Add an index", State: "closed", References: &gitlab.IssueReferences{Full: group + "/api#12"}, Milestone: &gitlab.Milestone{Title: "17.0"}},
+			{IID: 3, Title: "Cache results", State: "opened", References: &gitlab.IssueReferences{Full: group + "/web#3"}, Milestone: &gitlab.Milestone{Title: "17.1"}},
+		}, &gitlab.Response{}, nil)
+}
+
+func TestEpicViewRaw(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+	setupMocks(testClient, "OWNER")
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdView, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	out, err := exec("5")
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		title:	Faster search
+		state:	opened
+		author:	alice
+		labels:	backend
+		due date:	2025-09-30
+		url:	https://gitlab.com/groups/OWNER/-/epics/5
+		--
+		Make search faster.
+		--
+		issues:
+		OWNER/api#12	closed	Add an index	17.0
+		OWNER/web#3	opened	Cache results	17.1
+	`), out.String())
+}
+
+func TestEpicViewTTY(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	testClient := gitlabtesting.NewTestClient(t)
+	setupMocks(testClient, "mygroup")
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdView, true, cmdtest.WithGitLabClient(testClient.Client))
+
+	out, err := exec("&5 --group mygroup --milestone 17.1")
+	require.NoError(t, err)
+
+	assert.Contains(t, out.String(), "open • opened by alice about 2 hours ago")
+	assert.Contains(t, out.String(), "Faster search &5")
+	assert.Contains(t, out.String(), "Due date: 2025-09-30")
+	assert.Contains(t, out.String(), `Child issues in milestone "17.1" (1):`)
+	assert.Contains(t, out.String(), "mygroup/web#3")
+	assert.NotContains(t, out.String(), "mygroup/api#12")
+	assert.Contains(t, out.String(), "View this epic on GitLab: https://gitlab.com/groups/mygroup/-/epics/5")
+}
+
+func TestEpicViewJSON(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+	setupMocks(testClient, "OWNER")
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdView, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	out, err := exec("5 --output json")
+	require.NoError(t, err)
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal([]byte(out.String()), &got))
+	assert.Equal(t, "Faster search", got["title"])
+	assert.Len(t, got["issues"], 2)
+}
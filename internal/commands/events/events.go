@@ -0,0 +1,21 @@
+package events
+
+import (
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	eventsTailCmd "gitlab.com/gitlab-org/cli/internal/commands/events/tail"
+)
+
+func NewCmdEvents(f cmdutils.Factory) *cobra.Command {
+	eventsCmd := &cobra.Command{
+		Use:   "events <command> [flags]",
+		Short: `Follow the activity of a project.`,
+		Long:  ``,
+	}
+
+	cmdutils.EnableRepoOverride(eventsCmd, f)
+
+	eventsCmd.AddCommand(eventsTailCmd.NewCmdTail(f))
+	return eventsCmd
+}
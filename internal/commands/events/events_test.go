@@ -0,0 +1,21 @@
+//go:build !integration
+
+package events
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestNewCmdEvents(t *testing.T) {
+	ios, _, _, _ := cmdtest.TestIOStreams()
+	cmd := NewCmdEvents(cmdtest.NewTestFactory(ios))
+
+	assert.Equal(t, "events <command> [flags]", cmd.Use)
+	require.Len(t, cmd.Commands(), 1)
+	assert.Equal(t, "tail", cmd.Commands()[0].Name())
+}
@@ -0,0 +1,310 @@
+package tail
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+// eventTypes are the types that events can be filtered by.
+var eventTypes = []string{"push", "merge_request", "issue", "note", "milestone", "wiki", "member", "other"}
+
+const (
+	perPage = 100
+	// maxPages limits how far back a poll looks for events it has not seen.
+	maxPages = 5
+)
+
+type options struct {
+	filters      []string
+	interval     time.Duration
+	lines        int
+	outputFormat string
+
+	types   []string
+	authors []string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdTail(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	eventsTailCmd := &cobra.Command{
+		Use:   "tail [flags]",
+		Short: `Print the events of a project as they happen.`,
+		Long: heredoc.Docf(`
+			Print the most recent events of a project, such as pushes, merge requests, and
+			comments, then poll for new events until the command is interrupted.
+
+			Filter the events with --filter, as %[1]stype=<types>%[1]s or %[1]sauthor=<usernames>%[1]s,
+			with comma-separated values. Event types are: %[2]s. Repeat --filter to
+			combine filters: an event must match all of them.
+
+			With %[1]s--output json%[1]s, every event is printed as a JSON object on its own line,
+			so scripts can react to the activity of a project without a webhook.
+		`, "`", strings.Join(eventTypes, ", ")),
+		Args: cobra.NoArgs,
+		Example: heredoc.Doc(`
+			# Follow the events of the current project
+			$ glab events tail
+
+			# Follow pushes and merge request events of another project
+			$ glab events tail -R gitlab-org/cli --filter type=push,merge_request
+
+			# Follow the comments of two users, as JSON lines
+			$ glab events tail --filter type=note --filter author=alice,bob --output json
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.complete(); err != nil {
+				return err
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			return opts.run(ctx)
+		},
+	}
+
+	fl := eventsTailCmd.Flags()
+	fl.StringArrayVar(&opts.filters, "filter", nil, "Only print events that match a filter: type=<types> or author=<usernames>. Can be repeated.")
+	fl.DurationVar(&opts.interval, "interval", 10*time.Second, "Time between polls for new events.")
+	fl.IntVarP(&opts.lines, "lines", "n", 10, "Number of recent events to print before the new ones.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, or json for every event on its own line.")
+
+	return eventsTailCmd
+}
+
+func (o *options) complete() error {
+	for _, filter := range o.filters {
+		key, value, ok := strings.Cut(filter, "=")
+		if !ok || value == "" {
+			return &cmdutils.FlagError{Err: fmt.Errorf("invalid filter %q. Use type=<types> or author=<usernames>.", filter)}
+		}
+		values := strings.Split(value, ",")
+		switch key {
+		case "type":
+			for _, t := range values {
+				if !slices.Contains(eventTypes, t) {
+					return &cmdutils.FlagError{Err: fmt.Errorf("invalid event type %q. Must be one of: %s.", t, strings.Join(eventTypes, ", "))}
+				}
+			}
+			o.types = append(o.types, values...)
+		case "author":
+			for _, a := range values {
+				o.authors = append(o.authors, strings.TrimPrefix(a, "@"))
+			}
+		default:
+			return &cmdutils.FlagError{Err: fmt.Errorf("invalid filter %q. Use type=<types> or author=<usernames>.", filter)}
+		}
+	}
+	if o.interval < time.Second {
+		return &cmdutils.FlagError{Err: fmt.Errorf("--interval must be at least 1s, got %s.", o.interval)}
+	}
+	if o.lines < 0 {
+		return &cmdutils.FlagError{Err: fmt.Errorf("--lines must not be negative, got %d.", o.lines)}
+	}
+	return nil
+}
+
+func (o *options) run(ctx context.Context) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	events, err := listEvents(client, repo.FullName(), 0)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to list the events of the project.")
+	}
+	var lastID int64
+	if len(events) > 0 {
+		lastID = events[0].ID
+	}
+	recent := o.match(events)
+	if err := o.print(recent[:min(o.lines, len(recent))]); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(o.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		events, err := listEvents(client, repo.FullName(), lastID)
+		if err != nil {
+			// Keep following, the next poll might succeed.
+			fmt.Fprintf(o.io.StdErr, "%s Could not list the events of the project: %v\n", o.io.Color().WarnIcon(), err)
+			continue
+		}
+		if len(events) == 0 {
+			continue
+		}
+		lastID = events[0].ID
+		if err := o.print(o.match(events)); err != nil {
+			return err
+		}
+	}
+}
+
+// listEvents returns the events of a project that are newer than the event with
+// the ID after, newest first. If after is 0, it returns the first page of events.
+func listEvents(client *gitlab.Client, repo string, after int64) ([]*gitlab.ProjectEvent, error) {
+	var events []*gitlab.ProjectEvent
+	opts := &gitlab.ListProjectVisibleEventsOptions{ListOptions: gitlab.ListOptions{PerPage: perPage, Page: 1}}
+	for page := 1; page <= maxPages; page++ {
+		opts.Page = int64(page)
+		list, resp, err := client.Events.ListProjectVisibleEvents(repo, opts, api.WithoutCache())
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range list {
+			if e.ID <= after {
+				return events, nil
+			}
+			events = append(events, e)
+		}
+		if after == 0 || resp.NextPage == 0 {
+			break
+		}
+	}
+	return events, nil
+}
+
+// match returns the events that match the filters.
+func (o *options) match(events []*gitlab.ProjectEvent) []*gitlab.ProjectEvent {
+	var matched []*gitlab.ProjectEvent
+	for _, e := range events {
+		if len(o.types) > 0 && !slices.Contains(o.types, eventType(e)) {
+			continue
+		}
+		if len(o.authors) > 0 && !slices.Contains(o.authors, e.AuthorUsername) {
+			continue
+		}
+		matched = append(matched, e)
+	}
+	return matched
+}
+
+// print prints the events, which are newest first, in the order they happened.
+func (o *options) print(events []*gitlab.ProjectEvent) error {
+	enc := json.NewEncoder(o.io.StdOut)
+	for _, e := range slices.Backward(events) {
+		if o.outputFormat == "json" {
+			if err := enc.Encode(e); err != nil {
+				return err
+			}
+			continue
+		}
+
+		c := o.io.Color()
+		fmt.Fprintf(o.io.StdOut, "%s %s %s\n", c.Gray(eventTime(e)), c.Bold("@"+e.AuthorUsername), describe(e))
+	}
+	return nil
+}
+
+func eventType(e *gitlab.ProjectEvent) string {
+	switch {
+	case e.PushData.Ref != "":
+		return "push"
+	case e.ActionName == "joined" || e.ActionName == "left":
+		return "member"
+	}
+	switch e.TargetType {
+	case "MergeRequest":
+		return "merge_request"
+	case "Issue", "WorkItem":
+		return "issue"
+	case "Note", "DiffNote", "DiscussionNote":
+		return "note"
+	case "Milestone":
+		return "milestone"
+	case "WikiPage::Meta":
+		return "wiki"
+	}
+	return "other"
+}
+
+func eventTime(e *gitlab.ProjectEvent) string {
+	t, err := time.Parse(time.RFC3339, e.CreatedAt)
+	if err != nil {
+		return e.CreatedAt
+	}
+	return t.Local().Format(time.DateTime)
+}
+
+// describe returns what happened in the event, like "opened merge request !12: Add a flag".
+func describe(e *gitlab.ProjectEvent) string {
+	switch eventType(e) {
+	case "push":
+		s := fmt.Sprintf("%s %s %s", e.ActionName, e.PushData.RefType, e.PushData.Ref)
+		if e.PushData.CommitTitle != "" {
+			s += ": " + e.PushData.CommitTitle
+		}
+		return s
+	case "note":
+		body, _, _ := strings.Cut(e.Note.Body, "\n")
+		return fmt.Sprintf("%s %s: %s", e.ActionName, reference(e.Note.NoteableType, e.Note.NoteableIID), body)
+	case "member":
+		return e.ActionName + " the project"
+	}
+
+	target := reference(e.TargetType, e.TargetIID)
+	if e.TargetTitle != "" {
+		target += ": " + e.TargetTitle
+	}
+	return strings.TrimSpace(e.ActionName + " " + target)
+}
+
+// reference returns a readable reference to the target of an event, like "merge request !12".
+func reference(targetType string, iid int64) string {
+	switch targetType {
+	case "MergeRequest":
+		return fmt.Sprintf("merge request !%d", iid)
+	case "Issue", "WorkItem":
+		return fmt.Sprintf("issue #%d", iid)
+	case "Milestone":
+		return "milestone"
+	case "WikiPage::Meta":
+		return "wiki page"
+	case "Snippet":
+		return "snippet"
+	case "Commit":
+		return "commit"
+	}
+	return strings.ToLower(targetType)
+}
@@ -0,0 +1,158 @@
+//go:build !integration
+
+package tail
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+var (
+	pushEvent = &gitlab.ProjectEvent{
+		ID: 1, ActionName: "pushed to", AuthorUsername: "alice", CreatedAt: "2026-10-16T10:00:00Z",
+		PushData: gitlab.ProjectEventPushData{RefType: "branch", Ref: "main", CommitTitle: "Fix a typo"},
+	}
+	mrEvent = &gitlab.ProjectEvent{
+		ID: 2, ActionName: "opened", AuthorUsername: "bob", CreatedAt: "2026-10-16T10:01:00Z",
+		TargetType: "MergeRequest", TargetIID: 12, TargetTitle: "Add a flag",
+	}
+	noteEvent = &gitlab.ProjectEvent{
+		ID: 3, ActionName: "commented on", AuthorUsername: "alice", CreatedAt: "2026-10-16T10:02:00Z",
+		TargetType: "DiffNote", Note: gitlab.ProjectEventNote{Body: "Looks good.\nThanks!", NoteableType: "MergeRequest", NoteableIID: 12},
+	}
+	issueEvent = &gitlab.ProjectEvent{
+		ID: 4, ActionName: "closed", AuthorUsername: "bob", CreatedAt: "2026-10-16T10:03:00Z",
+		TargetType: "Issue", TargetIID: 7, TargetTitle: "Crash on start",
+	}
+)
+
+func TestEventsTail(t *testing.T) {
+	t.Setenv("TZ", "UTC")
+
+	tests := []struct {
+		name         string
+		types        []string
+		authors      []string
+		lines        int
+		outputFormat string
+		wantOut      string
+	}{
+		{
+			name:         "recent and new events",
+			lines:        10,
+			outputFormat: "text",
+			wantOut: "2026-10-16 10:00:00 @alice pushed to branch main: Fix a typo\n" +
+				"2026-10-16 10:01:00 @bob opened merge request !12: Add a flag\n" +
+				"2026-10-16 10:02:00 @alice commented on merge request !12: Looks good.\n" +
+				"2026-10-16 10:03:00 @bob closed issue #7: Crash on start\n",
+		},
+		{
+			name:         "limits the recent events",
+			lines:        1,
+			outputFormat: "text",
+			wantOut: "2026-10-16 10:01:00 @bob opened merge request !12: Add a flag\n" +
+				"2026-10-16 10:02:00 @alice commented on merge request !12: Looks good.\n" +
+				"2026-10-16 10:03:00 @bob closed issue #7: Crash on start\n",
+		},
+		{
+			name:         "filters by type and author",
+			types:        []string{"push", "note"},
+			authors:      []string{"alice"},
+			lines:        10,
+			outputFormat: "text",
+			wantOut: "2026-10-16 10:00:00 @alice pushed to branch main: Fix a typo\n" +
+				"2026-10-16 10:02:00 @alice commented on merge request !12: Looks good.\n",
+		},
+		{
+			name:         "json lines",
+			types:        []string{"issue"},
+			lines:        10,
+			outputFormat: "json",
+			wantOut:      `{"id":4,"title":"","project_id":0,"action_name":"closed","target_id":0,"target_iid":7,`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(t.Context())
+			defer cancel()
+
+			tc := gitlabtesting.NewTestClient(t)
+			gomock.InOrder(
+				tc.MockEvents.EXPECT().ListProjectVisibleEvents("OWNER/REPO", gomock.Any(), gomock.Any()).
+					Return([]*gitlab.ProjectEvent{mrEvent, pushEvent}, &gitlab.Response{}, nil),
+				tc.MockEvents.EXPECT().ListProjectVisibleEvents("OWNER/REPO", gomock.Any(), gomock.Any()).
+					Return([]*gitlab.ProjectEvent{issueEvent, noteEvent, mrEvent}, &gitlab.Response{}, nil),
+				tc.MockEvents.EXPECT().ListProjectVisibleEvents("OWNER/REPO", gomock.Any(), gomock.Any()).
+					DoAndReturn(func(any, *gitlab.ListProjectVisibleEventsOptions, ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectEvent, *gitlab.Response, error) {
+						cancel()
+						return []*gitlab.ProjectEvent{issueEvent}, &gitlab.Response{}, nil
+					}),
+			)
+
+			ios, _, stdout, _ := cmdtest.TestIOStreams()
+			opts := &options{
+				interval:     time.Millisecond,
+				lines:        tt.lines,
+				outputFormat: tt.outputFormat,
+				types:        tt.types,
+				authors:      tt.authors,
+				io:           ios,
+				gitlabClient: func() (*gitlab.Client, error) { return tc.Client, nil },
+				baseRepo:     func() (glrepo.Interface, error) { return glrepo.New("OWNER", "REPO", "gitlab.com"), nil },
+			}
+
+			require.NoError(t, opts.run(ctx))
+			if tt.outputFormat == "json" {
+				assert.Contains(t, stdout.String(), tt.wantOut)
+				assert.Equal(t, 1, strings.Count(stdout.String(), "\n"))
+				return
+			}
+			assert.Equal(t, tt.wantOut, stdout.String())
+		})
+	}
+}
+
+func TestEventsTailFlags(t *testing.T) {
+	tests := []struct {
+		name    string
+		cli     string
+		wantErr string
+	}{
+		{
+			name:    "invalid type",
+			cli:     "--filter type=push,deploy",
+			wantErr: `invalid event type "deploy". Must be one of: push, merge_request, issue, note, milestone, wiki, member, other.`,
+		},
+		{
+			name:    "invalid filter",
+			cli:     "--filter label=bug",
+			wantErr: `invalid filter "label=bug". Use type=<types> or author=<usernames>.`,
+		},
+		{
+			name:    "short interval",
+			cli:     "--interval 100ms",
+			wantErr: "--interval must be at least 1s, got 100ms.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exec := cmdtest.SetupCmdForTest(t, NewCmdTail, false)
+			_, err := exec(tt.cli)
+			require.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,361 @@
+package bump
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+	"text/template"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+const (
+	defaultTitle       = "Update {{.Component}} to {{.To}}"
+	defaultDescription = `This merge request updates the includes of {{.Component}} from {{.Versions}} to {{.To}} in:
+
+{{.Files}}
+
+Created with 'glab fleet ci-config bump'.`
+)
+
+type options struct {
+	component   string
+	to          string
+	from        string
+	group       string
+	branch      string
+	title       string
+	description string
+	jobs        int
+	dryRun      bool
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+
+	titleTmpl       *template.Template
+	descriptionTmpl *template.Template
+}
+
+// TemplateData is the data available to the placeholders of the title and
+// description of the merge requests, like {{.To}}.
+type TemplateData struct {
+	Component string
+	To        string
+	Project   string
+	Files     TemplateFiles
+	Versions  TemplateVersions
+}
+
+// TemplateFiles are the updated files of a project. In a template, they're
+// shown as a Markdown list.
+type TemplateFiles []string
+
+func (f TemplateFiles) String() string {
+	var b strings.Builder
+	for _, file := range f {
+		b.WriteString("- `" + file + "`\n")
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// TemplateVersions are the versions that were replaced. In a template, they're
+// shown separated by commas.
+type TemplateVersions []string
+
+func (v TemplateVersions) String() string {
+	return strings.Join(v, ", ")
+}
+
+// result is the outcome of the update of a project.
+type result struct {
+	project string
+	status  string
+	mrURL   string
+	failed  bool
+}
+
+func NewCmdBump(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+	}
+
+	bumpCmd := &cobra.Command{
+		Use:   "bump <component> --to <version> [flags]",
+		Short: `Update the version of a CI/CD component or template in every project that includes it.`,
+		Long: heredoc.Doc(`
+			Find the CI/CD configuration files that include a CI/CD component, or
+			a template of a project, with code search. Then, in every project that
+			includes an old version, create a branch that updates the version, and
+			open a merge request.
+
+			The component is the path of the project of the components, like
+			"my-org/components", or of one of its components, like
+			"my-org/components/sast". The "component" includes with this path, and
+			the "project" includes of the project with a "ref", are updated.
+
+			The title and description of the merge requests can use these
+			placeholders, in the syntax of Go templates:
+
+			- {{.Component}}: the component, as given.
+			- {{.To}}: the new version.
+			- {{.Versions}}: the replaced versions, separated by commas.
+			- {{.Files}}: the updated files, as a Markdown list.
+			- {{.Project}}: the full path of the project.
+
+			Code search finds files in the default branch of projects. On
+			GitLab.com and instances with advanced search, use --group to search
+			the projects of a group.
+		`),
+		Example: heredoc.Doc(`
+			# Preview the projects of a group to update
+			$ glab fleet ci-config bump my-org/components --to 2.0.0 --group my-org --dry-run
+
+			# Update only the includes at 1.4.0, 8 projects at a time
+			$ glab fleet ci-config bump my-org/components/sast --from 1.4.0 --to 1.5.0 --jobs 8
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.component = strings.Trim(args[0], "/")
+			if opts.jobs < 1 {
+				return &cmdutils.FlagError{Err: errors.New("--jobs must be at least 1.")}
+			}
+			var err error
+			if opts.titleTmpl, err = template.New("title").Option("missingkey=error").Parse(opts.title); err != nil {
+				return &cmdutils.FlagError{Err: fmt.Errorf("invalid --title template: %w", err)}
+			}
+			if opts.descriptionTmpl, err = template.New("description").Option("missingkey=error").Parse(opts.description); err != nil {
+				return &cmdutils.FlagError{Err: fmt.Errorf("invalid --description template: %w", err)}
+			}
+			if opts.branch == "" {
+				opts.branch = fmt.Sprintf("bump-%s-%s", path.Base(opts.component), opts.to)
+			}
+			return opts.run()
+		},
+	}
+
+	fl := bumpCmd.Flags()
+	fl.StringVar(&opts.to, "to", "", "Version to update the includes to.")
+	fl.StringVar(&opts.from, "from", "", "Only update the includes at this version. Defaults to all the other versions.")
+	fl.StringVarP(&opts.group, "group", "g", "", "Only update the projects of this group.")
+	fl.StringVarP(&opts.branch, "branch", "b", "", "Name of the branches to create. Defaults to 'bump-<component>-<version>'.")
+	fl.StringVarP(&opts.title, "title", "t", defaultTitle, "Title of the merge requests and commits.")
+	fl.StringVarP(&opts.description, "description", "d", defaultDescription, "Description of the merge requests.")
+	fl.IntVarP(&opts.jobs, "jobs", "j", 4, "Number of projects to update in parallel.")
+	fl.BoolVar(&opts.dryRun, "dry-run", false, "List the files to update, without creating branches or merge requests.")
+
+	cobra.CheckErr(bumpCmd.MarkFlagRequired("to"))
+
+	return bumpCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	files, err := o.search(client)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to search for %s.", o.component))
+	}
+	if len(files) == 0 {
+		fmt.Fprintf(o.io.StdErr, "No CI/CD configuration files include %s.\n", o.component)
+		return nil
+	}
+
+	projectIDs := slices.Sorted(maps.Keys(files))
+
+	c := o.io.Color()
+	var mu sync.Mutex
+	results := make([]*result, len(projectIDs))
+	g := new(errgroup.Group)
+	g.SetLimit(o.jobs)
+	for i, id := range projectIDs {
+		g.Go(func() error {
+			r := o.update(client, id, files[id])
+			results[i] = r
+
+			mu.Lock()
+			defer mu.Unlock()
+			icon := c.GreenCheck()
+			if r.failed {
+				icon = c.FailedIcon()
+			}
+			fmt.Fprintf(o.io.StdErr, "%s %s: %s\n", icon, r.project, r.status)
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	return o.printSummary(results)
+}
+
+// search returns the CI/CD configuration files that mention the component, by
+// project ID.
+func (o *options) search(client *gitlab.Client) (map[int64][]string, error) {
+	searchOpts := &gitlab.SearchOptions{ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage}}
+	blobs, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Blob, *gitlab.Response, error) {
+		if o.group != "" {
+			return client.Search.BlobsByGroup(o.group, o.component, searchOpts, p)
+		}
+		return client.Search.Blobs(o.component, searchOpts, p)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[int64][]string{}
+	for _, blob := range blobs {
+		if !strings.HasSuffix(blob.Path, ".yml") && !strings.HasSuffix(blob.Path, ".yaml") {
+			continue
+		}
+		if !slices.Contains(files[blob.ProjectID], blob.Path) {
+			files[blob.ProjectID] = append(files[blob.ProjectID], blob.Path)
+		}
+	}
+	return files, nil
+}
+
+// update updates the files of a project on a new branch, and opens a merge
+// request.
+func (o *options) update(client *gitlab.Client, projectID int64, files []string) *result {
+	r := &result{project: fmt.Sprintf("project %d", projectID)}
+	fail := func(err error) *result {
+		r.status = "failed: " + err.Error()
+		r.failed = true
+		return r
+	}
+
+	project, _, err := client.Projects.GetProject(projectID, nil)
+	if err != nil {
+		return fail(err)
+	}
+	r.project = project.PathWithNamespace
+	if project.Archived {
+		r.status = "skipped, archived"
+		return r
+	}
+
+	data := TemplateData{Component: o.component, To: o.to, Project: project.PathWithNamespace}
+	var actions []*gitlab.CommitActionOptions
+	for _, file := range files {
+		raw, _, err := client.RepositoryFiles.GetRawFile(projectID, file, &gitlab.GetRawFileOptions{Ref: gitlab.Ptr(project.DefaultBranch)})
+		if err != nil {
+			return fail(err)
+		}
+		content, replaced, err := bumpIncludes(string(raw), o.component, o.from, o.to)
+		if err != nil {
+			return fail(fmt.Errorf("could not parse %s: %w", file, err))
+		}
+		if len(replaced) == 0 {
+			continue
+		}
+		actions = append(actions, &gitlab.CommitActionOptions{
+			Action:   gitlab.Ptr(gitlab.FileUpdate),
+			FilePath: gitlab.Ptr(file),
+			Content:  gitlab.Ptr(content),
+		})
+		data.Files = append(data.Files, file)
+		for _, v := range replaced {
+			if !slices.Contains(data.Versions, v) {
+				data.Versions = append(data.Versions, v)
+			}
+		}
+	}
+	if len(actions) == 0 {
+		r.status = "up to date"
+		return r
+	}
+	slices.Sort(data.Versions)
+
+	if o.dryRun {
+		r.status = fmt.Sprintf("would update %s from %s", strings.Join(data.Files, ", "), data.Versions)
+		return r
+	}
+
+	title, err := render(o.titleTmpl, data)
+	if err != nil {
+		return fail(err)
+	}
+	description, err := render(o.descriptionTmpl, data)
+	if err != nil {
+		return fail(err)
+	}
+
+	_, _, err = client.Commits.CreateCommit(projectID, &gitlab.CreateCommitOptions{
+		Branch:        gitlab.Ptr(o.branch),
+		StartBranch:   gitlab.Ptr(project.DefaultBranch),
+		CommitMessage: gitlab.Ptr(title),
+		Actions:       actions,
+	})
+	if err != nil {
+		return fail(fmt.Errorf("could not create branch %s: %w", o.branch, err))
+	}
+
+	mr, _, err := client.MergeRequests.CreateMergeRequest(projectID, &gitlab.CreateMergeRequestOptions{
+		Title:              gitlab.Ptr(title),
+		Description:        gitlab.Ptr(description),
+		SourceBranch:       gitlab.Ptr(o.branch),
+		TargetBranch:       gitlab.Ptr(project.DefaultBranch),
+		RemoveSourceBranch: gitlab.Ptr(true),
+	})
+	if err != nil {
+		return fail(fmt.Errorf("could not create the merge request: %w", err))
+	}
+	r.status = fmt.Sprintf("created !%d", mr.IID)
+	r.mrURL = mr.WebURL
+	return r
+}
+
+func (o *options) printSummary(results []*result) error {
+	created, failed := 0, 0
+	table := tableprinter.New(o.io)
+	table.AddHeader("PROJECT", "STATUS", "MERGE REQUEST")
+	for _, r := range results {
+		table.AddRow(r.project, r.status, r.mrURL)
+		if r.failed {
+			failed++
+		} else if r.mrURL != "" {
+			created++
+		}
+	}
+	fmt.Fprint(o.io.StdOut, table.String())
+
+	if o.dryRun {
+		fmt.Fprintf(o.io.StdErr, "Checked %s. Run again without --dry-run to create the merge requests.\n", utils.Pluralize(len(results), "project"))
+	} else {
+		fmt.Fprintf(o.io.StdErr, "Created %s in %s.\n", utils.Pluralize(created, "merge request"), utils.Pluralize(len(results), "project"))
+	}
+	if failed > 0 {
+		fmt.Fprintf(o.io.StdErr, "%s failed.\n", utils.Pluralize(failed, "project"))
+		return cmdutils.SilentError
+	}
+	return nil
+}
+
+func render(tmpl *template.Template, data TemplateData) (string, error) {
+	var b strings.Builder
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
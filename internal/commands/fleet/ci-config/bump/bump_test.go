@@ -0,0 +1,139 @@
+//go:build !integration
+
+package bump
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+const (
+	oldConfig = "include:\n  - component: $CI_SERVER_FQDN/org/components/sast@1.0.0\n"
+	newConfig = "include:\n  - component: $CI_SERVER_FQDN/org/components/sast@2.0.0\n"
+)
+
+func setupSearch(tc *gitlabtesting.TestClient) {
+	tc.MockSearch.EXPECT().
+		BlobsByGroup("org", "org/components", gomock.Any(), gomock.Any()).
+		Return([]*gitlab.Blob{
+			{ProjectID: 1, Path: ".gitlab-ci.yml"},
+			{ProjectID: 1, Path: ".gitlab-ci.yml"},
+			{ProjectID: 1, Path: "README.md"},
+			{ProjectID: 2, Path: "ci/build.yaml"},
+		}, &gitlab.Response{}, nil)
+	tc.MockProjects.EXPECT().
+		GetProject(int64(1), gomock.Any()).
+		Return(&gitlab.Project{ID: 1, PathWithNamespace: "org/api", DefaultBranch: "main"}, nil, nil)
+	tc.MockProjects.EXPECT().
+		GetProject(int64(2), gomock.Any()).
+		Return(&gitlab.Project{ID: 2, PathWithNamespace: "org/web", DefaultBranch: "master"}, nil, nil)
+	tc.MockRepositoryFiles.EXPECT().
+		GetRawFile(int64(1), ".gitlab-ci.yml", &gitlab.GetRawFileOptions{Ref: gitlab.Ptr("main")}).
+		Return([]byte(oldConfig), nil, nil)
+	tc.MockRepositoryFiles.EXPECT().
+		GetRawFile(int64(2), "ci/build.yaml", &gitlab.GetRawFileOptions{Ref: gitlab.Ptr("master")}).
+		Return([]byte(newConfig), nil, nil)
+}
+
+func TestBump(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	testClient := gitlabtesting.NewTestClient(t)
+	setupSearch(testClient)
+	testClient.MockCommits.EXPECT().
+		CreateCommit(int64(1), &gitlab.CreateCommitOptions{
+			Branch:        gitlab.Ptr("bump-components-2.0.0"),
+			StartBranch:   gitlab.Ptr("main"),
+			CommitMessage: gitlab.Ptr("Update org/components to 2.0.0"),
+			Actions: []*gitlab.CommitActionOptions{{
+				Action:   gitlab.Ptr(gitlab.FileUpdate),
+				FilePath: gitlab.Ptr(".gitlab-ci.yml"),
+				Content:  gitlab.Ptr(newConfig),
+			}},
+		}).
+		Return(&gitlab.Commit{}, nil, nil)
+	testClient.MockMergeRequests.EXPECT().
+		CreateMergeRequest(int64(1), &gitlab.CreateMergeRequestOptions{
+			Title: gitlab.Ptr("Update org/components to 2.0.0"),
+			Description: gitlab.Ptr("This merge request updates the includes of org/components from 1.0.0 to 2.0.0 in:\n\n" +
+				"- `.gitlab-ci.yml`\n\nCreated with 'glab fleet ci-config bump'."),
+			SourceBranch:       gitlab.Ptr("bump-components-2.0.0"),
+			TargetBranch:       gitlab.Ptr("main"),
+			RemoveSourceBranch: gitlab.Ptr(true),
+		}).
+		Return(&gitlab.MergeRequest{BasicMergeRequest: gitlab.BasicMergeRequest{IID: 7, WebURL: "https://gitlab.com/org/api/-/merge_requests/7"}}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdBump, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	out, err := exec("org/components --to 2.0.0 --group org --jobs 1")
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		PROJECT	STATUS	MERGE REQUEST
+		org/api	created !7	https://gitlab.com/org/api/-/merge_requests/7
+		org/web	up to date	
+	`), out.String())
+	assert.Equal(t, heredoc.Doc(`
+		✓ org/api: created !7
+		✓ org/web: up to date
+		Created 1 merge request in 2 projects.
+	`), out.Stderr())
+}
+
+func TestBumpDryRun(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	testClient := gitlabtesting.NewTestClient(t)
+	setupSearch(testClient)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdBump, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	out, err := exec("org/components --to 2.0.0 --group org --dry-run")
+	require.NoError(t, err)
+
+	assert.Contains(t, out.String(), "org/api\twould update .gitlab-ci.yml from 1.0.0\t\n")
+	assert.Contains(t, out.Stderr(), "Checked 2 projects. Run again without --dry-run to create the merge requests.\n")
+}
+
+func TestBumpFailure(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	testClient := gitlabtesting.NewTestClient(t)
+	setupSearch(testClient)
+	testClient.MockCommits.EXPECT().
+		CreateCommit(int64(1), gomock.Any()).
+		Return(nil, nil, errors.New("branch already exists"))
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdBump, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	out, err := exec("org/components --to 2.0.0 --group org --branch update-components")
+	assert.ErrorIs(t, err, cmdutils.SilentError)
+
+	assert.Contains(t, out.String(), "org/api\tfailed: could not create branch update-components: branch already exists\t\n")
+	assert.Contains(t, out.Stderr(), "1 project failed.\n")
+}
+
+func TestBumpNoResults(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockSearch.EXPECT().
+		Blobs("org/components", gomock.Any(), gomock.Any()).
+		Return([]*gitlab.Blob{}, &gitlab.Response{}, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdBump, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	out, err := exec("org/components --to 2.0.0")
+	require.NoError(t, err)
+
+	assert.Equal(t, "No CI/CD configuration files include org/components.\n", out.Stderr())
+}
@@ -0,0 +1,126 @@
+package bump
+
+import (
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// versionEdit is a version to replace in a CI configuration file, at the line
+// and column of the YAML scalar that contains it.
+type versionEdit struct {
+	line      int
+	column    int
+	old       string
+	component bool
+}
+
+// bumpIncludes updates the version of the includes of path in a CI
+// configuration file: components like "$CI_SERVER_FQDN/<path>/<name>@<version>"
+// or "<host>/<path>@<version>", and project includes with "project: <path>" and
+// "ref: <version>". Only the includes at version from are updated, or all of
+// them when from is empty. The rest of the file is kept as is. It returns the
+// updated content and the versions that were replaced.
+func bumpIncludes(content, path, from, to string) (string, []string, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(content), &doc); err != nil {
+		return "", nil, err
+	}
+
+	path = strings.Trim(path, "/")
+	matches := func(version string) bool {
+		return version != to && (from == "" || version == from)
+	}
+
+	var edits []versionEdit
+	var walk func(n *yaml.Node)
+	walk = func(n *yaml.Node) {
+		if n.Kind == yaml.MappingNode {
+			var project, ref *yaml.Node
+			for i := 0; i+1 < len(n.Content); i += 2 {
+				key, value := n.Content[i], n.Content[i+1]
+				if value.Kind != yaml.ScalarNode {
+					continue
+				}
+				switch key.Value {
+				case "component":
+					if version, ok := componentVersion(value.Value, path); ok && matches(version) {
+						edits = append(edits, versionEdit{line: value.Line, column: value.Column, old: version, component: true})
+					}
+				case "project":
+					project = value
+				case "ref":
+					ref = value
+				}
+			}
+			if project != nil && ref != nil && strings.Trim(project.Value, "/") == path && matches(ref.Value) {
+				edits = append(edits, versionEdit{line: ref.Line, column: ref.Column, old: ref.Value})
+			}
+		}
+		for _, child := range n.Content {
+			walk(child)
+		}
+	}
+	walk(&doc)
+
+	if len(edits) == 0 {
+		return content, nil, nil
+	}
+
+	// Replace from the end, so that the columns of the other edits of a line
+	// stay valid.
+	slices.SortFunc(edits, func(a, b versionEdit) int {
+		if a.line != b.line {
+			return b.line - a.line
+		}
+		return b.column - a.column
+	})
+	lines := strings.Split(content, "\n")
+	var replaced []string
+	for _, e := range edits {
+		line := lines[e.line-1]
+		start := min(e.column-1, len(line))
+		// The version of a component is after its last "@", and a ref is the
+		// whole scalar.
+		i := strings.Index(line[start:], e.old)
+		if e.component {
+			if i = strings.LastIndex(line[start:], "@"+e.old); i >= 0 {
+				i++
+			}
+		}
+		if i < 0 {
+			continue
+		}
+		i += start
+		lines[e.line-1] = line[:i] + to + line[i+len(e.old):]
+		if !slices.Contains(replaced, e.old) {
+			replaced = append(replaced, e.old)
+		}
+	}
+	slices.Sort(replaced)
+	return strings.Join(lines, "\n"), replaced, nil
+}
+
+// componentVersion returns the version of a component reference like
+// "gitlab.com/<path>/<name>@<version>" when it's a component of path, which is
+// either the project of the component or the component itself.
+func componentVersion(ref, path string) (string, bool) {
+	at := strings.LastIndex(ref, "@")
+	if at < 0 {
+		return "", false
+	}
+	// Strip the host, like gitlab.com or $CI_SERVER_FQDN.
+	_, component, ok := strings.Cut(ref[:at], "/")
+	if !ok {
+		return "", false
+	}
+	project := component
+	if i := strings.LastIndex(component, "/"); i >= 0 {
+		project = component[:i]
+	}
+	if component != path && project != path {
+		return "", false
+	}
+	return ref[at+1:], true
+}
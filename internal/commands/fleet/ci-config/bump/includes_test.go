@@ -0,0 +1,107 @@
+//go:build !integration
+
+package bump
+
+import (
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBumpIncludes(t *testing.T) {
+	content := heredoc.Doc(`
+		# Shared configuration
+		include:
+		  - component: $CI_SERVER_FQDN/org/components/sast@1.2.0
+		    inputs:
+		      stage: test
+		  - component: "gitlab.com/org/components/secrets@1.0.0"
+		  - component: gitlab.com/other/components/sast@1.2.0
+		  - project: 'org/components'
+		    ref: 1.2.0
+		    file: '/templates/lint.yml'
+		  - ref: v1.0.0
+		    project: org/components
+		    file: build.yml
+
+		lint:
+		  script: echo "org/components@1.2.0"
+	`)
+
+	tests := []struct {
+		name         string
+		path         string
+		from         string
+		wantReplaced []string
+		want         string
+	}{
+		{
+			name:         "all versions of a project",
+			path:         "org/components",
+			wantReplaced: []string{"1.0.0", "1.2.0", "v1.0.0"},
+			want: heredoc.Doc(`
+				# Shared configuration
+				include:
+				  - component: $CI_SERVER_FQDN/org/components/sast@2.0.0
+				    inputs:
+				      stage: test
+				  - component: "gitlab.com/org/components/secrets@2.0.0"
+				  - component: gitlab.com/other/components/sast@1.2.0
+				  - project: 'org/components'
+				    ref: 2.0.0
+				    file: '/templates/lint.yml'
+				  - ref: 2.0.0
+				    project: org/components
+				    file: build.yml
+
+				lint:
+				  script: echo "org/components@1.2.0"
+			`),
+		},
+		{
+			name:         "one version of a component",
+			path:         "org/components/sast",
+			from:         "1.2.0",
+			wantReplaced: []string{"1.2.0"},
+			want: heredoc.Doc(`
+				# Shared configuration
+				include:
+				  - component: $CI_SERVER_FQDN/org/components/sast@2.0.0
+				    inputs:
+				      stage: test
+				  - component: "gitlab.com/org/components/secrets@1.0.0"
+				  - component: gitlab.com/other/components/sast@1.2.0
+				  - project: 'org/components'
+				    ref: 1.2.0
+				    file: '/templates/lint.yml'
+				  - ref: v1.0.0
+				    project: org/components
+				    file: build.yml
+
+				lint:
+				  script: echo "org/components@1.2.0"
+			`),
+		},
+		{
+			name: "not included",
+			path: "org/templates",
+			want: content,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, replaced, err := bumpIncludes(content, tt.path, tt.from, "2.0.0")
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantReplaced, replaced)
+		})
+	}
+}
+
+func TestBumpIncludesInvalidYAML(t *testing.T) {
+	_, _, err := bumpIncludes("include: [", "org/components", "", "2.0.0")
+	assert.Error(t, err)
+}
@@ -0,0 +1,19 @@
+package ciconfig
+
+import (
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	bumpCmd "gitlab.com/gitlab-org/cli/internal/commands/fleet/ci-config/bump"
+)
+
+func NewCmdCIConfig(f cmdutils.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ci-config <command> [flags]",
+		Short: `Update the CI/CD configuration of many projects.`,
+	}
+
+	cmd.AddCommand(bumpCmd.NewCmdBump(f))
+
+	return cmd
+}
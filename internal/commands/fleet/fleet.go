@@ -0,0 +1,24 @@
+package fleet
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	ciConfigCmd "gitlab.com/gitlab-org/cli/internal/commands/fleet/ci-config"
+)
+
+func NewCmdFleet(f cmdutils.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fleet <command> [flags]",
+		Short: `Make the same change across many projects.`,
+		Long: heredoc.Doc(`
+			Automate changes across the projects of a group or an instance, with a
+			merge request in each project, so that their maintainers can review them.
+		`),
+	}
+
+	cmd.AddCommand(ciConfigCmd.NewCmdCIConfig(f))
+
+	return cmd
+}
@@ -70,7 +70,7 @@ func (o *options) run() error {
 	o.io.LogInfof("Showing GPG key with ID %d\n", key.ID)
 
 	if key.ID != 0 {
-		table := tableprinter.NewTablePrinter()
+		table := tableprinter.New(o.io)
 		table.AddRow("ID", key.ID)
 		table.AddRow("Key", key.Key)
 		table.AddRow("Created At", utils.TimeToPrettyTimeAgo(*key.CreatedAt))
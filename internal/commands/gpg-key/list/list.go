@@ -60,14 +60,14 @@ func (o *options) run() error {
 	}
 
 	cs := o.io.Color()
-	table := tableprinter.NewTablePrinter()
+	table := tableprinter.New(o.io)
 	isTTy := o.io.IsOutputTTY()
 
 	if len(keys) > 0 {
 		if o.showKeyIDs {
-			table.AddRow("ID", "Key", "Created At")
+			table.AddHeader("ID", "Key", "Created At")
 		} else {
-			table.AddRow("Key", "Created At")
+			table.AddHeader("Key", "Created At")
 		}
 	}
 
@@ -0,0 +1,44 @@
+package inbox
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	inboxListCmd "gitlab.com/gitlab-org/cli/internal/commands/inbox/list"
+	inboxReadCmd "gitlab.com/gitlab-org/cli/internal/commands/inbox/read"
+	inboxUnreadCmd "gitlab.com/gitlab-org/cli/internal/commands/inbox/unread"
+)
+
+func NewCmdInbox(f cmdutils.Factory) *cobra.Command {
+	inboxCmd := &cobra.Command{
+		Use:   "inbox <command> [flags]",
+		Short: `See what needs your attention: review threads, questions, and failed pipelines.`,
+		Long: heredoc.Doc(`
+			Your inbox combines:
+
+			- Unresolved threads of open merge requests that mention you.
+			- Unanswered questions on your merge requests: the threads whose last
+			  comment asks a question, and mentions you or nobody.
+			- Failed pipelines you triggered, when no later pipeline of yours ran on
+			  the same branch. The current project and the projects of your merge
+			  requests are checked.
+
+			Items disappear once they're handled, like when you reply to a thread, or
+			when you mark them as read. Items marked as read come back when they're
+			updated. The read state is stored locally, in the glab configuration
+			directory.
+		`),
+		Example: heredoc.Doc(`
+			$ glab inbox list
+			$ glab inbox read 3f2a9c1
+		`),
+	}
+
+	cmdutils.EnableRepoOverride(inboxCmd, f)
+
+	inboxCmd.AddCommand(inboxListCmd.NewCmdList(f))
+	inboxCmd.AddCommand(inboxReadCmd.NewCmdRead(f))
+	inboxCmd.AddCommand(inboxUnreadCmd.NewCmdUnread(f))
+	return inboxCmd
+}
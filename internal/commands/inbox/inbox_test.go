@@ -0,0 +1,25 @@
+package inbox
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestNewCmdInbox(t *testing.T) {
+	ios, _, _, _ := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios)
+
+	cmd := NewCmdInbox(factory)
+
+	assert.Equal(t, "inbox <command> [flags]", cmd.Use)
+
+	subcommandNames := make([]string, 0, len(cmd.Commands()))
+	for _, subcmd := range cmd.Commands() {
+		subcommandNames = append(subcommandNames, subcmd.Name())
+	}
+
+	assert.ElementsMatch(t, []string{"list", "read", "unread"}, subcommandNames)
+}
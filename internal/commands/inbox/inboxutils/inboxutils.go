@@ -0,0 +1,345 @@
+package inboxutils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+)
+
+// Kinds of inbox items.
+const (
+	// KindThread is an unresolved thread of a merge request that mentions the user.
+	KindThread = "thread"
+	// KindQuestion is an unanswered question on a merge request of the user.
+	KindQuestion = "question"
+	// KindPipeline is a failed pipeline that the user triggered.
+	KindPipeline = "pipeline"
+)
+
+// Item is something that needs the attention of the user.
+type Item struct {
+	// ID identifies the item across runs, like "3f2a9c1".
+	ID        string    `json:"id"`
+	Kind      string    `json:"kind"`
+	Reference string    `json:"reference"`
+	Title     string    `json:"title"`
+	From      string    `json:"from,omitempty"`
+	Summary   string    `json:"summary,omitempty"`
+	WebURL    string    `json:"web_url"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Read      bool      `json:"read"`
+}
+
+// itemID returns the ID of the item identified by key.
+func itemID(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])[:7]
+}
+
+// mergeRequest is a merge request whose discussions are checked.
+type mergeRequest struct {
+	projectID       int64
+	sourceProjectID int64
+	iid             int64
+	reference       string
+	title           string
+	webURL          string
+	// mine is set for the merge requests of the user, where questions are
+	// addressed to them.
+	mine bool
+}
+
+// Collect returns the items of the user updated since a time, newest first.
+// Failed pipelines are looked for in projects, and in the source projects of
+// the merge requests of the user.
+func Collect(client *gitlab.Client, user *gitlab.User, since time.Time, projects []string) ([]*Item, error) {
+	mrs, err := mergeRequests(client, user, since)
+	if err != nil {
+		return nil, err
+	}
+
+	mention := mentionRegexp(user.Username)
+	var items []*Item
+	pipelineProjects := make([]any, 0, len(projects)+len(mrs))
+	for _, p := range projects {
+		pipelineProjects = append(pipelineProjects, p)
+	}
+	for _, mr := range mrs {
+		mrItems, err := discussionItems(client, user, mr, mention, since)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the discussions of %s: %w", mr.reference, err)
+		}
+		items = append(items, mrItems...)
+		if mr.mine && mr.sourceProjectID != 0 && !slices.Contains(pipelineProjects, any(mr.sourceProjectID)) {
+			pipelineProjects = append(pipelineProjects, mr.sourceProjectID)
+		}
+	}
+
+	seen := map[int64]bool{}
+	for _, project := range pipelineProjects {
+		pipelines, err := failedPipelines(client, user, project, since)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the pipelines of %v: %w", project, err)
+		}
+		for _, p := range pipelines {
+			if seen[p.ID] {
+				continue
+			}
+			seen[p.ID] = true
+			items = append(items, pipelineItem(p))
+		}
+	}
+
+	slices.SortStableFunc(items, func(a, b *Item) int {
+		return b.UpdatedAt.Compare(a.UpdatedAt)
+	})
+	return items, nil
+}
+
+// mergeRequests returns the open merge requests that the user authored or
+// reviews, or was mentioned in.
+func mergeRequests(client *gitlab.Client, user *gitlab.User, since time.Time) ([]*mergeRequest, error) {
+	var mrs []*mergeRequest
+	seen := map[string]*mergeRequest{}
+	add := func(mr *mergeRequest) {
+		key := fmt.Sprintf("%d!%d", mr.projectID, mr.iid)
+		if existing, ok := seen[key]; ok {
+			existing.mine = existing.mine || mr.mine
+			return
+		}
+		seen[key] = mr
+		mrs = append(mrs, mr)
+	}
+
+	for _, search := range []struct {
+		opts *gitlab.ListMergeRequestsOptions
+		mine bool
+	}{
+		{opts: &gitlab.ListMergeRequestsOptions{Scope: gitlab.Ptr("created_by_me")}, mine: true},
+		{opts: &gitlab.ListMergeRequestsOptions{Scope: gitlab.Ptr("all"), ReviewerUsername: gitlab.Ptr(user.Username)}},
+	} {
+		opts := search.opts
+		opts.State = gitlab.Ptr("opened")
+		opts.UpdatedAfter = gitlab.Ptr(since)
+		opts.PerPage = api.MaxPerPage
+		list, _, err := client.MergeRequests.ListMergeRequests(opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list merge requests: %w", err)
+		}
+		for _, mr := range list {
+			reference := fmt.Sprintf("!%d", mr.IID)
+			if mr.References != nil && mr.References.Full != "" {
+				reference = mr.References.Full
+			}
+			add(&mergeRequest{
+				projectID:       mr.ProjectID,
+				sourceProjectID: mr.SourceProjectID,
+				iid:             mr.IID,
+				reference:       reference,
+				title:           mr.Title,
+				webURL:          mr.WebURL,
+				mine:            search.mine,
+			})
+		}
+	}
+
+	// To-do items find the merge requests where the user was mentioned
+	// without being an author or reviewer.
+	todos, _, err := client.Todos.ListTodos(&gitlab.ListTodosOptions{
+		ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage},
+		State:       gitlab.Ptr("pending"),
+		Type:        gitlab.Ptr(string(gitlab.TodoTargetMergeRequest)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list to-do items: %w", err)
+	}
+	for _, todo := range todos {
+		if todo.ActionName != gitlab.TodoMentioned && todo.ActionName != gitlab.TodoDirectlyAddressed {
+			continue
+		}
+		if todo.Target == nil || todo.Target.State != "opened" {
+			continue
+		}
+		reference := fmt.Sprintf("!%d", todo.Target.IID)
+		if todo.Project != nil {
+			reference = todo.Project.PathWithNamespace + reference
+		}
+		add(&mergeRequest{
+			projectID: todo.Target.ProjectID,
+			iid:       todo.Target.IID,
+			reference: reference,
+			title:     todo.Target.Title,
+			webURL:    todo.Target.WebURL,
+		})
+	}
+	return mrs, nil
+}
+
+// mentionRegexp matches mentions of username in notes.
+func mentionRegexp(username string) *regexp.Regexp {
+	return regexp.MustCompile(`(?i)(?:^|[^\w.-])@` + regexp.QuoteMeta(username) + `(?:$|[^\w.-]|\.(?:$|\s))`)
+}
+
+var anyMentionRE = regexp.MustCompile(`(?:^|[^\w.-])@[\w.-]+`)
+
+// discussionItems returns the threads of a merge request that mention the
+// user, and the questions addressed to them, that the user didn't reply to
+// last.
+func discussionItems(client *gitlab.Client, user *gitlab.User, mr *mergeRequest, mention *regexp.Regexp, since time.Time) ([]*Item, error) {
+	discussions, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Discussion, *gitlab.Response, error) {
+		return client.Discussions.ListMergeRequestDiscussions(mr.projectID, mr.iid, &gitlab.ListMergeRequestDiscussionsOptions{ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage}}, p)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*Item
+	for _, d := range discussions {
+		notes := slices.DeleteFunc(slices.Clone(d.Notes), func(n *gitlab.Note) bool { return n.System })
+		if len(notes) == 0 {
+			continue
+		}
+		last := notes[len(notes)-1]
+		if last.Author.Username == user.Username {
+			continue
+		}
+		updated := noteTime(last)
+		if updated.Before(since) {
+			continue
+		}
+
+		resolvable, unresolved := false, false
+		mentioned := false
+		for _, n := range notes {
+			resolvable = resolvable || n.Resolvable
+			unresolved = unresolved || (n.Resolvable && !n.Resolved)
+			mentioned = mentioned || (n.Author.Username != user.Username && mention.MatchString(n.Body))
+		}
+
+		var kind string
+		switch {
+		case unresolved && mentioned:
+			kind = KindThread
+		case mr.mine && (unresolved || !resolvable) && strings.Contains(last.Body, "?") &&
+			(mention.MatchString(last.Body) || !anyMentionRE.MatchString(last.Body)):
+			kind = KindQuestion
+		default:
+			continue
+		}
+
+		items = append(items, &Item{
+			ID:        itemID(fmt.Sprintf("discussion:%d!%d:%s", mr.projectID, mr.iid, d.ID)),
+			Kind:      kind,
+			Reference: mr.reference,
+			Title:     mr.title,
+			From:      last.Author.Username,
+			Summary:   summary(last.Body),
+			WebURL:    fmt.Sprintf("%s#note_%d", mr.webURL, last.ID),
+			UpdatedAt: updated,
+		})
+	}
+	return items, nil
+}
+
+// failedPipelines returns the pipelines of the user that failed, and that are
+// the last one the user ran on their ref.
+func failedPipelines(client *gitlab.Client, user *gitlab.User, project any, since time.Time) ([]*gitlab.PipelineInfo, error) {
+	pipelines, _, err := client.Pipelines.ListProjectPipelines(project, &gitlab.ListProjectPipelinesOptions{
+		ListOptions:  gitlab.ListOptions{PerPage: api.MaxPerPage},
+		Username:     gitlab.Ptr(user.Username),
+		UpdatedAfter: gitlab.Ptr(since),
+		OrderBy:      gitlab.Ptr("id"),
+		Sort:         gitlab.Ptr("desc"),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var failed []*gitlab.PipelineInfo
+	refs := map[string]bool{}
+	for _, p := range pipelines {
+		if refs[p.Ref] {
+			continue
+		}
+		refs[p.Ref] = true
+		if p.Status == "failed" {
+			failed = append(failed, p)
+		}
+	}
+	return failed, nil
+}
+
+func pipelineItem(p *gitlab.PipelineInfo) *Item {
+	item := &Item{
+		ID:        itemID(fmt.Sprintf("pipeline:%d", p.ID)),
+		Kind:      KindPipeline,
+		Reference: fmt.Sprintf("#%d", p.ID),
+		Title:     "Pipeline failed on " + p.Ref,
+		WebURL:    p.WebURL,
+	}
+	// The reference includes the project, which is only in the URL.
+	if u, err := url.Parse(p.WebURL); err == nil {
+		if project, _, ok := strings.Cut(strings.TrimPrefix(u.Path, "/"), "/-/"); ok {
+			item.Reference = project + item.Reference
+		}
+	}
+	if p.UpdatedAt != nil {
+		item.UpdatedAt = *p.UpdatedAt
+	}
+	return item
+}
+
+func noteTime(n *gitlab.Note) time.Time {
+	if n.UpdatedAt != nil {
+		return *n.UpdatedAt
+	}
+	if n.CreatedAt != nil {
+		return *n.CreatedAt
+	}
+	return time.Time{}
+}
+
+// summary returns the first line of a note.
+func summary(body string) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(body), "\n")
+	return line
+}
+
+// Load returns the items of the current user updated in the last days, with
+// their read state. The current repository, if any, is checked for failed
+// pipelines.
+func Load(client *gitlab.Client, baseRepo func() (glrepo.Interface, error), days int) ([]*Item, ReadState, error) {
+	state, err := LoadReadState()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user, _, err := client.Users.CurrentUser()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get the current user: %w", err)
+	}
+
+	var projects []string
+	if repo, err := baseRepo(); err == nil {
+		projects = append(projects, repo.FullName())
+	}
+
+	items, err := Collect(client, user, time.Now().AddDate(0, 0, -days), projects)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, item := range items {
+		item.Read = state.IsRead(item)
+	}
+	return items, state, nil
+}
@@ -0,0 +1,158 @@
+//go:build !integration
+
+package inboxutils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+)
+
+var (
+	since = time.Date(2025, time.May, 1, 0, 0, 0, 0, time.UTC)
+	day1  = time.Date(2025, time.May, 10, 0, 0, 0, 0, time.UTC)
+	day2  = time.Date(2025, time.May, 11, 0, 0, 0, 0, time.UTC)
+	day3  = time.Date(2025, time.May, 12, 0, 0, 0, 0, time.UTC)
+)
+
+func note(id int64, author, body string, resolvable, resolved bool, at time.Time) *gitlab.Note {
+	return &gitlab.Note{ID: id, Author: gitlab.NoteAuthor{Username: author}, Body: body, Resolvable: resolvable, Resolved: resolved, CreatedAt: &at}
+}
+
+func TestCollect(t *testing.T) {
+	user := &gitlab.User{ID: 1, Username: "alice"}
+	tc := gitlabtesting.NewTestClient(t)
+
+	gomock.InOrder(
+		tc.MockMergeRequests.EXPECT().
+			ListMergeRequests(&gitlab.ListMergeRequestsOptions{
+				ListOptions:  gitlab.ListOptions{PerPage: 100},
+				Scope:        gitlab.Ptr("created_by_me"),
+				State:        gitlab.Ptr("opened"),
+				UpdatedAfter: gitlab.Ptr(since),
+			}).
+			Return([]*gitlab.BasicMergeRequest{
+				{ProjectID: 10, SourceProjectID: 10, IID: 1, Title: "Add search", WebURL: "https://gitlab.com/group/app/-/merge_requests/1", References: &gitlab.IssueReferences{Full: "group/app!1"}},
+			}, nil, nil),
+		tc.MockMergeRequests.EXPECT().
+			ListMergeRequests(&gitlab.ListMergeRequestsOptions{
+				ListOptions:      gitlab.ListOptions{PerPage: 100},
+				Scope:            gitlab.Ptr("all"),
+				ReviewerUsername: gitlab.Ptr("alice"),
+				State:            gitlab.Ptr("opened"),
+				UpdatedAfter:     gitlab.Ptr(since),
+			}).
+			Return([]*gitlab.BasicMergeRequest{
+				{ProjectID: 20, IID: 2, Title: "Fix login", WebURL: "https://gitlab.com/group/lib/-/merge_requests/2", References: &gitlab.IssueReferences{Full: "group/lib!2"}},
+			}, nil, nil),
+	)
+	tc.MockTodos.EXPECT().
+		ListTodos(&gitlab.ListTodosOptions{
+			ListOptions: gitlab.ListOptions{PerPage: 100},
+			State:       gitlab.Ptr("pending"),
+			Type:        gitlab.Ptr("MergeRequest"),
+		}).
+		Return([]*gitlab.Todo{
+			// Already found as a merge request under review.
+			{ActionName: gitlab.TodoMentioned, Target: &gitlab.TodoTarget{ProjectID: 20, IID: 2, State: "opened"}},
+			{ActionName: gitlab.TodoAssigned, Target: &gitlab.TodoTarget{ProjectID: 30, IID: 3, State: "opened"}},
+			{ActionName: gitlab.TodoDirectlyAddressed, Target: &gitlab.TodoTarget{ProjectID: 40, IID: 4, State: "merged"}},
+		}, nil, nil)
+
+	tc.MockDiscussions.EXPECT().
+		ListMergeRequestDiscussions(int64(10), int64(1), gomock.Any(), gomock.Any()).
+		Return([]*gitlab.Discussion{
+			{ID: "q1", IndividualNote: true, Notes: []*gitlab.Note{note(101, "bob", "Why a new index?", false, false, day1)}},
+			{ID: "q2", Notes: []*gitlab.Note{note(102, "bob", "Should @carol check this?", true, false, day1)}},
+			{ID: "q3", Notes: []*gitlab.Note{
+				note(103, "bob", "Does it scale?", true, false, day1),
+				note(104, "alice", "Yes.", true, false, day2),
+			}},
+			{ID: "s1", IndividualNote: true, Notes: []*gitlab.Note{{ID: 105, System: true, Body: "added 1 commit", CreatedAt: &day3}}},
+		}, &gitlab.Response{}, nil)
+	tc.MockDiscussions.EXPECT().
+		ListMergeRequestDiscussions(int64(20), int64(2), gomock.Any(), gomock.Any()).
+		Return([]*gitlab.Discussion{
+			{ID: "t1", Notes: []*gitlab.Note{
+				note(201, "bob", "@alice can you confirm the token format", true, false, day1),
+				note(202, "carol", "I think it's fine.", true, false, day2),
+			}},
+			{ID: "t2", Notes: []*gitlab.Note{note(203, "bob", "@alice.smith see this", true, false, day1)}},
+			{ID: "t3", Notes: []*gitlab.Note{note(204, "bob", "Thanks @alice.", true, true, day1)}},
+		}, &gitlab.Response{}, nil)
+
+	tc.MockPipelines.EXPECT().
+		ListProjectPipelines("group/app", gomock.Any()).
+		Return([]*gitlab.PipelineInfo{
+			{ID: 503, Ref: "main", Status: "success"},
+			{ID: 502, Ref: "feature", Status: "failed", UpdatedAt: &day3, WebURL: "https://gitlab.com/group/app/-/pipelines/502"},
+			{ID: 501, Ref: "main", Status: "failed"},
+		}, nil, nil)
+	tc.MockPipelines.EXPECT().
+		ListProjectPipelines(int64(10), gomock.Any()).
+		Return([]*gitlab.PipelineInfo{
+			{ID: 502, Ref: "feature", Status: "failed", UpdatedAt: &day3, WebURL: "https://gitlab.com/group/app/-/pipelines/502"},
+		}, nil, nil)
+
+	items, err := Collect(tc.Client, user, since, []string{"group/app"})
+	require.NoError(t, err)
+
+	type summary struct{ kind, reference, from, text, url string }
+	got := make([]summary, 0, len(items))
+	for _, item := range items {
+		text := item.Summary
+		if text == "" {
+			text = item.Title
+		}
+		got = append(got, summary{item.Kind, item.Reference, item.From, text, item.WebURL})
+		assert.Len(t, item.ID, 7)
+	}
+	assert.Equal(t, []summary{
+		{KindPipeline, "group/app#502", "", "Pipeline failed on feature", "https://gitlab.com/group/app/-/pipelines/502"},
+		{KindThread, "group/lib!2", "carol", "I think it's fine.", "https://gitlab.com/group/lib/-/merge_requests/2#note_202"},
+		{KindQuestion, "group/app!1", "bob", "Why a new index?", "https://gitlab.com/group/app/-/merge_requests/1#note_101"},
+	}, got)
+}
+
+func TestMentionRegexp(t *testing.T) {
+	re := mentionRegexp("alice")
+
+	for body, want := range map[string]bool{
+		"@alice please check":  true,
+		"cc @Alice.":           true,
+		"(@alice)":             true,
+		"@alice.smith please":  false,
+		"@alice-bob please":    false,
+		"mail alice@alice.com": false,
+	} {
+		assert.Equal(t, want, re.MatchString(body), body)
+	}
+}
+
+func TestReadState(t *testing.T) {
+	t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+
+	state, err := LoadReadState()
+	require.NoError(t, err)
+	assert.Empty(t, state)
+
+	now := time.Now()
+	item := &Item{ID: "3f2a9c1", UpdatedAt: now}
+	state.MarkRead(item)
+	state["0ld0ld0"] = now.AddDate(-1, 0, 0)
+	require.NoError(t, state.Save())
+
+	state, err = LoadReadState()
+	require.NoError(t, err)
+	assert.Len(t, state, 1)
+	assert.True(t, state.IsRead(item))
+
+	item.UpdatedAt = now.Add(time.Minute)
+	assert.False(t, state.IsRead(item))
+}
@@ -0,0 +1,72 @@
+package inboxutils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"gitlab.com/gitlab-org/cli/internal/config"
+)
+
+const stateFileName = "inbox-read.json"
+
+// stateTTL is how long an item stays marked as read. Older items have left the
+// inbox.
+const stateTTL = 90 * 24 * time.Hour
+
+// ReadState stores the items marked as read, with the time they were last
+// updated when they were marked. An item updated since then is unread again.
+type ReadState map[string]time.Time
+
+// StatePath returns the file the read state is stored in.
+func StatePath() string {
+	return filepath.Join(config.ConfigDir(), stateFileName)
+}
+
+// LoadReadState reads the items marked as read.
+func LoadReadState() (ReadState, error) {
+	state := ReadState{}
+	data, err := os.ReadFile(StatePath())
+	if errors.Is(err, os.ErrNotExist) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading the inbox state: %w", err)
+	}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("could not decode %s: %w", StatePath(), err)
+	}
+	return state, nil
+}
+
+// Save writes the read state, without the items marked long ago.
+func (s ReadState) Save() error {
+	for id, updated := range s {
+		if time.Since(updated) > stateTTL {
+			delete(s, id)
+		}
+	}
+
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(config.ConfigDir(), 0o750); err != nil {
+		return fmt.Errorf("creating config directory: %w", err)
+	}
+	return config.WriteFile(StatePath(), data, 0o600)
+}
+
+// IsRead returns whether the item was marked as read, and wasn't updated since.
+func (s ReadState) IsRead(item *Item) bool {
+	updated, ok := s[item.ID]
+	return ok && !item.UpdatedAt.After(updated)
+}
+
+// MarkRead marks the item as read.
+func (s ReadState) MarkRead(item *Item) {
+	s[item.ID] = item.UpdatedAt
+}
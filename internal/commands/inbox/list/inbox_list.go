@@ -0,0 +1,132 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/inbox/inboxutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	all          bool
+	days         int
+	outputFormat string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdList(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	inboxListCmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   `List the unread items of your inbox.`,
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			$ glab inbox list
+			$ glab inbox list --all --days 30
+			$ glab inbox list --output json
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+
+	fl := inboxListCmd.Flags()
+	fl.BoolVarP(&opts.all, "all", "a", false, "Also list the items marked as read.")
+	fl.IntVar(&opts.days, "days", 14, "List the items updated in this number of days.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+
+	return inboxListCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	items, _, err := inboxutils.Load(client, o.baseRepo, o.days)
+	if err != nil {
+		return err
+	}
+	if !o.all {
+		items = slices.DeleteFunc(items, func(item *inboxutils.Item) bool { return item.Read })
+	}
+
+	if o.outputFormat == "json" {
+		itemsJSON, _ := json.Marshal(items)
+		fmt.Fprintln(o.io.StdOut, string(itemsJSON))
+		return nil
+	}
+
+	if len(items) == 0 {
+		o.io.LogInfof("Your inbox is empty.\n")
+		return nil
+	}
+
+	c := o.io.Color()
+	table := tableprinter.New(o.io)
+	table.SetIsTTY(o.io.IsOutputTTY())
+	table.AddHeader("ID", "Type", "Item", "From", "Summary", "Updated")
+	for _, item := range items {
+		id := item.ID
+		if item.Read {
+			id = c.Gray(id)
+		} else {
+			id = c.Bold(id)
+		}
+		table.AddCell(id)
+		table.AddCell(kindText(c, item.Kind))
+		table.AddCell(o.io.Hyperlink(c.Cyan(item.Reference), item.WebURL))
+		from := ""
+		if item.From != "" {
+			from = "@" + item.From
+		}
+		table.AddCell(from)
+		summary := item.Summary
+		if summary == "" {
+			summary = item.Title
+		}
+		table.AddCell(summary)
+		table.AddCell(c.Gray(utils.TimeToPrettyTimeAgo(item.UpdatedAt)))
+		table.EndRow()
+	}
+
+	fmt.Fprintf(o.io.StdOut, "Showing %s. Mark them as read with 'glab inbox read <id>'.\n\n", utils.Pluralize(len(items), "item"))
+	fmt.Fprint(o.io.StdOut, table.Render())
+	return nil
+}
+
+func kindText(c *iostreams.ColorPalette, kind string) string {
+	switch kind {
+	case inboxutils.KindPipeline:
+		return c.Red(kind)
+	case inboxutils.KindQuestion:
+		return c.Yellow(kind)
+	default:
+		return c.Blue(kind)
+	}
+}
@@ -0,0 +1,89 @@
+//go:build !integration
+
+package list
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/commands/inbox/inboxutils"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+var (
+	asked  = time.Now().Add(-2 * time.Hour)
+	failed = time.Now().Add(-time.Hour)
+)
+
+func setupInbox(tc *gitlabtesting.TestClient) {
+	tc.MockUsers.EXPECT().CurrentUser().Return(&gitlab.User{ID: 1, Username: "alice"}, nil, nil)
+	gomock.InOrder(
+		tc.MockMergeRequests.EXPECT().ListMergeRequests(gomock.Any()).
+			Return([]*gitlab.BasicMergeRequest{{ProjectID: 10, IID: 1, Title: "Add search", WebURL: "https://gitlab.com/OWNER/REPO/-/merge_requests/1", References: &gitlab.IssueReferences{Full: "OWNER/REPO!1"}}}, nil, nil),
+		tc.MockMergeRequests.EXPECT().ListMergeRequests(gomock.Any()).Return(nil, nil, nil),
+	)
+	tc.MockTodos.EXPECT().ListTodos(gomock.Any()).Return(nil, nil, nil)
+	tc.MockDiscussions.EXPECT().ListMergeRequestDiscussions(int64(10), int64(1), gomock.Any(), gomock.Any()).
+		Return([]*gitlab.Discussion{{ID: "d1", IndividualNote: true, Notes: []*gitlab.Note{
+			{ID: 101, Author: gitlab.NoteAuthor{Username: "bob"}, Body: "Why a new index?", CreatedAt: &asked},
+		}}}, &gitlab.Response{}, nil)
+	tc.MockPipelines.EXPECT().ListProjectPipelines("OWNER/REPO", gomock.Any()).
+		Return([]*gitlab.PipelineInfo{{ID: 502, Ref: "feature", Status: "failed", UpdatedAt: &failed, WebURL: "https://gitlab.com/OWNER/REPO/-/pipelines/502"}}, nil, nil)
+}
+
+func TestInboxList(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+	t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+
+	tc := gitlabtesting.NewTestClient(t)
+	setupInbox(tc)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdList, false, cmdtest.WithGitLabClient(tc.Client))
+
+	out, err := exec("")
+	require.NoError(t, err)
+
+	assert.Contains(t, out.String(), "Showing 2 items.")
+	assert.Regexp(t, `\tpipeline\tOWNER/REPO#502\t\tPipeline failed on feature\tabout 1 hour ago\n`, out.String())
+	assert.Regexp(t, `\tquestion\tOWNER/REPO!1\t@bob\tWhy a new index\?\tabout 2 hours ago\n`, out.String())
+}
+
+func TestInboxListHidesReadItems(t *testing.T) {
+	t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+
+	list := func(args string) []*inboxutils.Item {
+		tc := gitlabtesting.NewTestClient(t)
+		setupInbox(tc)
+		exec := cmdtest.SetupCmdForTest(t, NewCmdList, false, cmdtest.WithGitLabClient(tc.Client))
+
+		out, err := exec(args)
+		require.NoError(t, err)
+
+		var items []*inboxutils.Item
+		require.NoError(t, json.Unmarshal([]byte(out.String()), &items))
+		return items
+	}
+
+	items := list("--output json")
+	require.Len(t, items, 2)
+	state := inboxutils.ReadState{}
+	state.MarkRead(items[0])
+	require.NoError(t, state.Save())
+
+	items = list("--output json")
+	require.Len(t, items, 1)
+	assert.Equal(t, inboxutils.KindQuestion, items[0].Kind)
+
+	items = list("--all --output json")
+	require.Len(t, items, 2)
+	assert.True(t, items[0].Read)
+	assert.False(t, items[1].Read)
+}
@@ -0,0 +1,113 @@
+package read
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/inbox/inboxutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	ids  []string
+	all  bool
+	days int
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdRead(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	inboxReadCmd := &cobra.Command{
+		Use:   "read [<id>...] [flags]",
+		Short: `Mark inbox items as read.`,
+		Long: heredoc.Doc(`
+			Mark inbox items as read, so 'glab inbox list' doesn't list them anymore.
+			An item is unread again when it's updated, like when a thread gets a
+			new reply.
+		`),
+		Example: heredoc.Doc(`
+			$ glab inbox read 3f2a9c1 8e01b44
+			$ glab inbox read --all
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 && !opts.all {
+				return &cmdutils.FlagError{Err: errors.New("specify the IDs of the items to mark as read, or use --all.")}
+			}
+			if len(args) > 0 && opts.all {
+				return &cmdutils.FlagError{Err: errors.New("specify either item IDs or --all.")}
+			}
+			opts.ids = args
+			return opts.run()
+		},
+	}
+
+	fl := inboxReadCmd.Flags()
+	fl.BoolVarP(&opts.all, "all", "a", false, "Mark all the items of the inbox as read.")
+	fl.IntVar(&opts.days, "days", 14, "Look for the items updated in this number of days.")
+
+	return inboxReadCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	items, state, err := inboxutils.Load(client, o.baseRepo, o.days)
+	if err != nil {
+		return err
+	}
+
+	byID := make(map[string]*inboxutils.Item, len(items))
+	for _, item := range items {
+		byID[item.ID] = item
+	}
+	var marked []*inboxutils.Item
+	if o.all {
+		for _, item := range items {
+			if !item.Read {
+				marked = append(marked, item)
+			}
+		}
+	} else {
+		for _, id := range o.ids {
+			item, ok := byID[id]
+			if !ok {
+				return fmt.Errorf("no item %s in your inbox. Run 'glab inbox list' to see the IDs of the items.", id)
+			}
+			marked = append(marked, item)
+		}
+	}
+
+	for _, item := range marked {
+		state.MarkRead(item)
+	}
+	if err := state.Save(); err != nil {
+		return err
+	}
+
+	c := o.io.Color()
+	fmt.Fprintf(o.io.StdOut, "%s Marked %s as read.\n", c.GreenCheck(), utils.Pluralize(len(marked), "item"))
+	return nil
+}
@@ -0,0 +1,70 @@
+//go:build !integration
+
+package read
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/commands/inbox/inboxutils"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+// setupInbox mocks an inbox with a failed pipeline.
+func setupInbox(tc *gitlabtesting.TestClient) {
+	failed := time.Now().Add(-time.Hour)
+
+	tc.MockUsers.EXPECT().CurrentUser().Return(&gitlab.User{ID: 1, Username: "alice"}, nil, nil)
+	tc.MockMergeRequests.EXPECT().ListMergeRequests(gomock.Any()).Return(nil, nil, nil).Times(2)
+	tc.MockTodos.EXPECT().ListTodos(gomock.Any()).Return(nil, nil, nil)
+	tc.MockPipelines.EXPECT().ListProjectPipelines("OWNER/REPO", gomock.Any()).
+		Return([]*gitlab.PipelineInfo{{ID: 502, Ref: "feature", Status: "failed", UpdatedAt: &failed}}, nil, nil)
+}
+
+func TestInboxReadAll(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+	t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+
+	tc := gitlabtesting.NewTestClient(t)
+	setupInbox(tc)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdRead, false, cmdtest.WithGitLabClient(tc.Client))
+
+	out, err := exec("--all")
+	require.NoError(t, err)
+	assert.Equal(t, "✓ Marked 1 item as read.\n", out.String())
+
+	state, err := inboxutils.LoadReadState()
+	require.NoError(t, err)
+	assert.Len(t, state, 1)
+}
+
+func TestInboxReadUnknownItem(t *testing.T) {
+	t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+
+	tc := gitlabtesting.NewTestClient(t)
+	setupInbox(tc)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdRead, false, cmdtest.WithGitLabClient(tc.Client))
+
+	_, err := exec("0000000")
+	require.EqualError(t, err, "no item 0000000 in your inbox. Run 'glab inbox list' to see the IDs of the items.")
+}
+
+func TestInboxReadArgs(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+	exec := cmdtest.SetupCmdForTest(t, NewCmdRead, false, cmdtest.WithGitLabClient(tc.Client))
+
+	_, err := exec("")
+	require.EqualError(t, err, "specify the IDs of the items to mark as read, or use --all.")
+
+	_, err = exec("3f2a9c1 --all")
+	require.EqualError(t, err, "specify either item IDs or --all.")
+}
@@ -0,0 +1,66 @@
+package unread
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/inbox/inboxutils"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	ids []string
+
+	io *iostreams.IOStreams
+}
+
+func NewCmdUnread(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io: f.IO(),
+	}
+
+	inboxUnreadCmd := &cobra.Command{
+		Use:   "unread <id>... [flags]",
+		Short: `Mark inbox items as unread.`,
+		Example: heredoc.Doc(`
+			$ glab inbox unread 3f2a9c1
+		`),
+		Args: cobra.MinimumNArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ids = args
+			return opts.run()
+		},
+	}
+
+	return inboxUnreadCmd
+}
+
+func (o *options) run() error {
+	state, err := inboxutils.LoadReadState()
+	if err != nil {
+		return err
+	}
+
+	marked := 0
+	for _, id := range o.ids {
+		if _, ok := state[id]; ok {
+			delete(state, id)
+			marked++
+		}
+	}
+	if err := state.Save(); err != nil {
+		return err
+	}
+
+	c := o.io.Color()
+	fmt.Fprintf(o.io.StdOut, "%s Marked %s as unread.\n", c.GreenCheck(), utils.Pluralize(marked, "item"))
+	return nil
+}
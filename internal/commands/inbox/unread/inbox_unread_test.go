@@ -0,0 +1,40 @@
+//go:build !integration
+
+package unread
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/commands/inbox/inboxutils"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestInboxUnread(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+	t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+
+	state := inboxutils.ReadState{"3f2a9c1": time.Now(), "8e01b44": time.Now()}
+	require.NoError(t, state.Save())
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdUnread, false)
+
+	out, err := exec("3f2a9c1 0000000")
+	require.NoError(t, err)
+	assert.Equal(t, "✓ Marked 1 item as unread.\n", out.String())
+
+	state, err = inboxutils.LoadReadState()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"8e01b44"}, keys(state))
+}
+
+func keys(state inboxutils.ReadState) []string {
+	ids := make([]string, 0, len(state))
+	for id := range state {
+		ids = append(ids, id)
+	}
+	return ids
+}
@@ -0,0 +1,165 @@
+package escalate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/issuable"
+	"gitlab.com/gitlab-org/cli/internal/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	incident string
+	policy   string
+	remove   bool
+
+	io              *iostreams.IOStreams
+	apiClient       func(repoHost string) (*api.Client, error)
+	gitlabClient    func() (*gitlab.Client, error)
+	baseRepo        func() (glrepo.Interface, error)
+	defaultHostname func() string
+}
+
+func NewCmdEscalate(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:              f.IO(),
+		apiClient:       f.ApiClient,
+		gitlabClient:    f.GitLabClient,
+		baseRepo:        f.BaseRepo,
+		defaultHostname: f.DefaultHostname,
+	}
+	incidentEscalateCmd := &cobra.Command{
+		Use:   "escalate [<id> | <url>] [flags]",
+		Short: `Set the escalation policy of an incident.`,
+		Long: heredoc.Doc(`
+		Set the escalation policy of an incident. The policy pages the on-call
+		responders of the project until the incident is acknowledged or resolved.
+
+		Run 'glab oncall policies' to list the escalation policies of a project.
+		`),
+		Args: cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			# Escalate incident 123 using the "Default" escalation policy
+			$ glab incident escalate 123 --policy Default
+
+			# Select the escalation policy interactively
+			$ glab incident escalate https://gitlab.com/NAMESPACE/REPO/-/issues/incident/123
+
+			# Stop escalating incident 123
+			$ glab incident escalate 123 --remove
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.complete(args)
+
+			if err := opts.validate(); err != nil {
+				return err
+			}
+
+			return opts.run(cmd.Context())
+		},
+	}
+
+	fl := incidentEscalateCmd.Flags()
+	fl.StringVarP(&opts.policy, "policy", "p", "", "Name or ID of the escalation policy.")
+	fl.BoolVar(&opts.remove, "remove", false, "Remove the escalation policy from the incident.")
+	incidentEscalateCmd.MarkFlagsMutuallyExclusive("policy", "remove")
+
+	return incidentEscalateCmd
+}
+
+func (o *options) complete(args []string) {
+	o.incident = args[0]
+}
+
+func (o *options) validate() error {
+	if o.policy == "" && !o.remove && !o.io.PromptEnabled() {
+		return &cmdutils.FlagError{Err: errors.New("--policy or --remove is required when not running interactively.")}
+	}
+
+	return nil
+}
+
+func (o *options) run(ctx context.Context) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	issue, repo, err := issueutils.IssueFromArg(o.apiClient, client, o.baseRepo, o.defaultHostname(), o.incident)
+	if err != nil {
+		return err
+	}
+
+	if issue.IssueType == nil || *issue.IssueType != string(issuable.TypeIncident) {
+		return fmt.Errorf("issue #%d is not an incident; escalation policies only apply to incidents.", issue.IID)
+	}
+
+	c := o.io.Color()
+
+	if o.remove {
+		if err := api.SetIncidentEscalationPolicy(client, repo.FullName(), issue.IID, ""); err != nil {
+			return err
+		}
+		fmt.Fprintf(o.io.StdOut, "%s Removed the escalation policy from incident #%d.\n", c.GreenCheck(), issue.IID)
+		return nil
+	}
+
+	policies, err := api.ListEscalationPolicies(client, repo.FullName())
+	if err != nil {
+		return err
+	}
+	if len(policies) == 0 {
+		return fmt.Errorf("no escalation policies found for %s.", repo.FullName())
+	}
+
+	policy, err := o.selectPolicy(ctx, policies)
+	if err != nil {
+		return err
+	}
+
+	if err := api.SetIncidentEscalationPolicy(client, repo.FullName(), issue.IID, policy.ID); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Escalating incident #%d with policy %s.\n", c.GreenCheck(), issue.IID, c.Bold(policy.Name))
+	fmt.Fprintln(o.io.StdOut, issueutils.DisplayIssue(c, issue, o.io.IsaTTY))
+
+	return nil
+}
+
+func (o *options) selectPolicy(ctx context.Context, policies []*api.EscalationPolicy) (*api.EscalationPolicy, error) {
+	if o.policy == "" {
+		names := make([]string, 0, len(policies))
+		for _, p := range policies {
+			names = append(names, p.Name)
+		}
+		if err := o.io.Select(ctx, &o.policy, "Select an escalation policy:", names); err != nil {
+			return nil, cmdutils.WrapError(err, "could not prompt")
+		}
+	}
+
+	id, _ := strconv.ParseInt(o.policy, 10, 64)
+	for _, p := range policies {
+		if strings.EqualFold(p.Name, o.policy) || (id != 0 && p.NumericID() == id) {
+			return p, nil
+		}
+	}
+
+	return nil, fmt.Errorf("escalation policy %q not found.", o.policy)
+}
@@ -0,0 +1,158 @@
+//go:build !integration
+
+package escalate
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+const (
+	policiesResponse = `{"data": {"project": {"incidentManagementEscalationPolicies": {"nodes": [
+		{"id": "gid://gitlab/IncidentManagement::EscalationPolicy/7", "name": "Default"},
+		{"id": "gid://gitlab/IncidentManagement::EscalationPolicy/9", "name": "Out of hours"}
+	]}}}}`
+	mutationResponse = `{"data": {"issueSetEscalationPolicy": {"errors": []}}}`
+)
+
+// expectGraphQL expects a GraphQL request and answers it with body.
+// If wantPolicyID is not nil, the escalationPolicyId variable of the request must match it.
+func expectGraphQL(t *testing.T, tc *gitlabtesting.TestClient, body string, wantPolicyID any) {
+	tc.MockGraphQL.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(query gitlab.GraphQLQuery, response any, _ ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+			if wantPolicyID != nil {
+				policyID, ok := query.Variables["escalationPolicyId"]
+				assert.True(t, ok)
+				assert.Equal(t, wantPolicyID, policyID)
+			}
+			return nil, json.Unmarshal([]byte(body), response)
+		})
+}
+
+func expectIssue(tc *gitlabtesting.TestClient, issueType string) {
+	tc.MockIssues.EXPECT().
+		GetIssue("OWNER/REPO", int64(12)).
+		Return(&gitlab.Issue{
+			IID:       12,
+			Title:     "Database is down",
+			State:     "opened",
+			CreatedAt: gitlab.Ptr(time.Now()),
+			IssueType: gitlab.Ptr(issueType),
+			WebURL:    "https://gitlab.com/OWNER/REPO/-/issues/incident/12",
+		}, nil, nil)
+}
+
+func Test_IncidentEscalate(t *testing.T) {
+	type testCase struct {
+		name        string
+		cli         string
+		expectedMsg []string
+		wantErr     bool
+		wantStderr  string
+		setupMock   func(t *testing.T, tc *gitlabtesting.TestClient)
+	}
+
+	testCases := []testCase{
+		{
+			name:        "Escalate with a policy selected by name",
+			cli:         "12 --policy default",
+			expectedMsg: []string{"Escalating incident #12 with policy Default."},
+			setupMock: func(t *testing.T, tc *gitlabtesting.TestClient) {
+				expectIssue(tc, "incident")
+				expectGraphQL(t, tc, policiesResponse, nil)
+				expectGraphQL(t, tc, mutationResponse, "gid://gitlab/IncidentManagement::EscalationPolicy/7")
+			},
+		},
+		{
+			name:        "Escalate with a policy selected by ID",
+			cli:         "12 -p 9",
+			expectedMsg: []string{"Escalating incident #12 with policy Out of hours."},
+			setupMock: func(t *testing.T, tc *gitlabtesting.TestClient) {
+				expectIssue(tc, "incident")
+				expectGraphQL(t, tc, policiesResponse, nil)
+				expectGraphQL(t, tc, mutationResponse, "gid://gitlab/IncidentManagement::EscalationPolicy/9")
+			},
+		},
+		{
+			name:       "Unknown policy",
+			cli:        "12 --policy 8",
+			wantErr:    true,
+			wantStderr: `escalation policy "8" not found.`,
+			setupMock: func(t *testing.T, tc *gitlabtesting.TestClient) {
+				expectIssue(tc, "incident")
+				expectGraphQL(t, tc, policiesResponse, nil)
+			},
+		},
+		{
+			name:        "Remove the escalation policy",
+			cli:         "12 --remove",
+			expectedMsg: []string{"Removed the escalation policy from incident #12."},
+			setupMock: func(t *testing.T, tc *gitlabtesting.TestClient) {
+				expectIssue(tc, "incident")
+				tc.MockGraphQL.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(query gitlab.GraphQLQuery, response any, _ ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						policyID, ok := query.Variables["escalationPolicyId"]
+						assert.True(t, ok)
+						assert.Nil(t, policyID)
+						return nil, json.Unmarshal([]byte(mutationResponse), response)
+					})
+			},
+		},
+		{
+			name:       "Issue that is not an incident",
+			cli:        "12 --policy Default",
+			wantErr:    true,
+			wantStderr: "issue #12 is not an incident; escalation policies only apply to incidents.",
+			setupMock: func(t *testing.T, tc *gitlabtesting.TestClient) {
+				expectIssue(tc, "issue")
+			},
+		},
+		{
+			name:       "Neither --policy nor --remove when not running interactively",
+			cli:        "12",
+			wantErr:    true,
+			wantStderr: "--policy or --remove is required when not running interactively.",
+			setupMock:  func(t *testing.T, tc *gitlabtesting.TestClient) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// GIVEN
+			testClient := gitlabtesting.NewTestClient(t)
+			tc.setupMock(t, testClient)
+			exec := cmdtest.SetupCmdForTest(
+				t,
+				NewCmdEscalate,
+				false,
+				cmdtest.WithGitLabClient(testClient.Client),
+			)
+
+			// WHEN
+			out, err := exec(tc.cli)
+
+			// THEN
+			if tc.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantStderr)
+				return
+			}
+			require.NoError(t, err)
+			for _, msg := range tc.expectedMsg {
+				assert.Contains(t, out.String(), msg)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
 	incidentCloseCmd "gitlab.com/gitlab-org/cli/internal/commands/incident/close"
+	incidentEscalateCmd "gitlab.com/gitlab-org/cli/internal/commands/incident/escalate"
 	incidentListCmd "gitlab.com/gitlab-org/cli/internal/commands/incident/list"
 	incidentNoteCmd "gitlab.com/gitlab-org/cli/internal/commands/incident/note"
 	incidentReopenCmd "gitlab.com/gitlab-org/cli/internal/commands/incident/reopen"
@@ -39,6 +40,7 @@ func NewCmdIncident(f cmdutils.Factory) *cobra.Command {
 	incidentCmd.AddCommand(incidentViewCmd.NewCmdView(f))
 	incidentCmd.AddCommand(incidentCloseCmd.NewCmdClose(f))
 	incidentCmd.AddCommand(incidentReopenCmd.NewCmdReopen(f))
+	incidentCmd.AddCommand(incidentEscalateCmd.NewCmdEscalate(f))
 	incidentCmd.AddCommand(incidentSubscribeCmd.NewCmdSubscribe(f))
 	incidentCmd.AddCommand(incidentUnsubscribeCmd.NewCmdUnsubscribe(f))
 	return incidentCmd
@@ -0,0 +1,57 @@
+// Package issuableutils holds the helpers shared by the views of epics,
+// milestones, and iterations, which show the progress of a set of issues.
+package issuableutils
+
+import (
+	"fmt"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// IssueReference returns the full reference of an issue, like
+// "group/project#12", since the issues of an epic, milestone, or iteration can
+// be in any project of its group.
+func IssueReference(issue *gitlab.Issue) string {
+	if issue.References != nil && issue.References.Full != "" {
+		return issue.References.Full
+	}
+	return fmt.Sprintf("#%d", issue.IID)
+}
+
+// DaysUntil returns the number of calendar days from now until the due date,
+// which is negative once the due date has passed.
+func DaysUntil(due, now time.Time) int {
+	year, month, day := now.Date()
+	today := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	year, month, day = due.Date()
+	dueDay := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	return int(dueDay.Sub(today).Hours() / 24)
+}
+
+// Remaining describes the days left until the due date, like " (3 days left)".
+// The deadline word, like "due" or "ends", is used on the due date itself.
+func Remaining(days *int, deadline string) string {
+	switch {
+	case days == nil:
+		return ""
+	case *days == 0:
+		return fmt.Sprintf(" (%s today)", deadline)
+	case *days == 1:
+		return " (1 day left)"
+	case *days > 0:
+		return fmt.Sprintf(" (%d days left)", *days)
+	case *days == -1:
+		return " (1 day overdue)"
+	default:
+		return fmt.Sprintf(" (%d days overdue)", -*days)
+	}
+}
+
+// Percent returns part as a percentage of total, rounded down.
+func Percent(part, total int64) int64 {
+	if total == 0 {
+		return 0
+	}
+	return part * 100 / total
+}
@@ -0,0 +1,48 @@
+//go:build !integration
+
+package issuableutils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestIssueReference(t *testing.T) {
+	assert.Equal(t, "group/project#12", IssueReference(&gitlab.Issue{IID: 12, References: &gitlab.IssueReferences{Full: "group/project#12"}}))
+	assert.Equal(t, "#12", IssueReference(&gitlab.Issue{IID: 12}))
+}
+
+func TestDaysUntil(t *testing.T) {
+	due := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 0, DaysUntil(due, time.Date(2025, 3, 31, 23, 59, 0, 0, time.UTC)))
+	assert.Equal(t, 1, DaysUntil(due, time.Date(2025, 3, 30, 8, 0, 0, 0, time.UTC)))
+	assert.Equal(t, -2, DaysUntil(due, time.Date(2025, 4, 2, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestRemaining(t *testing.T) {
+	tests := []struct {
+		days *int
+		want string
+	}{
+		{nil, ""},
+		{gitlab.Ptr(0), " (ends today)"},
+		{gitlab.Ptr(1), " (1 day left)"},
+		{gitlab.Ptr(4), " (4 days left)"},
+		{gitlab.Ptr(-1), " (1 day overdue)"},
+		{gitlab.Ptr(-3), " (3 days overdue)"},
+	}
+	for _, tc := range tests {
+		assert.Equal(t, tc.want, Remaining(tc.days, "ends"))
+	}
+	assert.Equal(t, " (due today)", Remaining(gitlab.Ptr(0), "due"))
+}
+
+func TestPercent(t *testing.T) {
+	assert.Equal(t, int64(0), Percent(3, 0))
+	assert.Equal(t, int64(33), Percent(1, 3))
+	assert.Equal(t, int64(100), Percent(4, 4))
+}
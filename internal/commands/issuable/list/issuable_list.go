@@ -5,8 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/spf13/cobra"
@@ -17,6 +19,7 @@ import (
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
 	"gitlab.com/gitlab-org/cli/internal/commands/issuable"
 	"gitlab.com/gitlab-org/cli/internal/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/snooze/snoozeutils"
 	"gitlab.com/gitlab-org/cli/internal/glrepo"
 	"gitlab.com/gitlab-org/cli/internal/iostreams"
 	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
@@ -37,7 +40,7 @@ type ListOptions struct {
 	Group       string
 	Epic        int
 	IssueType   string
-	Iteration   int
+	Iteration   string
 
 	// issue states
 	State        string
@@ -60,11 +63,17 @@ type ListOptions struct {
 	Output         string
 	OrderBy        string
 	Sort           string
+	Fields         []string
+	IncludeMeta    bool
+	ShowSnoozed    bool
 
 	IO        *iostreams.IOStreams
 	BaseRepo  func() (glrepo.Interface, error)
 	apiClient func(repoHost string) (*api.Client, error)
 
+	// hideSnoozed hides the issues snoozed with 'glab issue snooze' from the details format.
+	hideSnoozed bool
+
 	JSONOutput bool
 }
 
@@ -86,6 +95,8 @@ func NewCmdList(f cmdutils.Factory, runE func(opts *ListOptions) error, issueTyp
 			$ glab %[1]s ls --all
 			$ glab %[1]s list --assignee=@me
 			$ glab %[1]s list --milestone release-2.0.0 --opened
+			$ glab %[1]s list --output json --fields iid,title,web_url
+			$ glab %[1]s list --output json --include-meta --page 2
 		`, issueType)),
 		Args: cobra.ExactArgs(0),
 		Annotations: map[string]string{
@@ -142,6 +153,44 @@ func NewCmdList(f cmdutils.Factory, runE func(opts *ListOptions) error, issueTyp
 				}
 			}
 
+			if opts.Iteration != "" && opts.Iteration != api.CurrentIterationArg {
+				if _, err := strconv.ParseInt(opts.Iteration, 10, 64); err != nil {
+					return cmdutils.FlagError{
+						Err: fmt.Errorf("invalid iteration %q. Use an iteration ID or %s.", opts.Iteration, api.CurrentIterationArg),
+					}
+				}
+			}
+
+			if opts.IncludeMeta {
+				if opts.Output != "json" {
+					return cmdutils.FlagError{
+						Err: errors.New("--include-meta requires --output json."),
+					}
+				}
+				if opts.Epic != 0 {
+					return cmdutils.FlagError{
+						Err: errors.New("--epic does not support the --include-meta flag."),
+					}
+				}
+			}
+
+			if len(opts.Fields) > 0 {
+				if opts.Output != "json" {
+					return cmdutils.FlagError{
+						Err: errors.New("--fields requires --output json."),
+					}
+				}
+				for _, field := range opts.Fields {
+					if !slices.Contains(api.IssueFields(), field) {
+						return cmdutils.FlagError{
+							Err: fmt.Errorf("invalid field %q. Must be one of: %s.", field, strings.Join(api.IssueFields(), ", ")),
+						}
+					}
+				}
+			}
+
+			opts.hideSnoozed = issueType == issuable.TypeIssue && !opts.ShowSnoozed
+
 			if runE != nil {
 				return runE(opts)
 			}
@@ -163,7 +212,7 @@ func NewCmdList(f cmdutils.Factory, runE func(opts *ListOptions) error, issueTyp
 	issueListCmd.Flags().BoolVarP(&opts.Closed, "closed", "c", false, fmt.Sprintf("Get only closed %ss.", issueType))
 	issueListCmd.Flags().BoolVarP(&opts.Confidential, "confidential", "C", false, fmt.Sprintf("Filter by confidential %ss.", issueType))
 	issueListCmd.Flags().StringVarP(&opts.OutputFormat, "output-format", "F", "details", "Options: 'details', 'ids', 'urls'.")
-	issueListCmd.Flags().StringVarP(&opts.Output, "output", "O", "text", "Options: 'text' or 'json'.")
+	issueListCmd.Flags().StringVarP(&opts.Output, "output", "O", "text", "Options: 'text', 'json', or 'slack'. 'slack' prints Slack mrkdwn for chat bots.")
 	issueListCmd.Flags().Int64VarP(&opts.Page, "page", "p", 1, "Page number.")
 	issueListCmd.Flags().Int64VarP(&opts.PerPage, "per-page", "P", 30, "Number of items to list per page.")
 	issueListCmd.PersistentFlags().StringP("group", "g", "", "Select a group or subgroup. Ignored if a repo argument is set.")
@@ -171,10 +220,13 @@ func NewCmdList(f cmdutils.Factory, runE func(opts *ListOptions) error, issueTyp
 	issueListCmd.MarkFlagsMutuallyExclusive("output", "output-format")
 	issueListCmd.Flags().StringVar(&opts.OrderBy, "order", "created_at", fmt.Sprintf("Order %s by <field>. Order options: created_at, updated_at, priority, due_date, relative_position, label_priority, milestone_due, popularity, weight.", issueType))
 	issueListCmd.Flags().StringVar(&opts.Sort, "sort", "desc", fmt.Sprintf("Return %s sorted in asc or desc order.", issueType))
+	issueListCmd.Flags().BoolVar(&opts.IncludeMeta, "include-meta", false, fmt.Sprintf("With --output json, wrap the %ss in an object with the pagination and rate limit state.", issueType))
+	issueListCmd.Flags().StringSliceVar(&opts.Fields, "fields", []string{}, fmt.Sprintf("Only output these fields of the %ss with --output json. Options: %s.", issueType, strings.Join(api.IssueFields(), ", ")))
 
 	if issueType == issuable.TypeIssue {
 		issueListCmd.Flags().StringVarP(&opts.IssueType, "issue-type", "t", "", "Filter issue by its type. Options: issue, incident, test_case.")
-		issueListCmd.Flags().IntVarP(&opts.Iteration, "iteration", "i", 0, "Filter issue by iteration <id>.")
+		issueListCmd.Flags().StringVarP(&opts.Iteration, "iteration", "i", "", fmt.Sprintf("Filter issue by iteration <id>, or %s for the current iteration.", api.CurrentIterationArg))
+		issueListCmd.Flags().BoolVar(&opts.ShowSnoozed, "show-snoozed", false, "Show the issues snoozed with 'glab issue snooze', which the details format hides until they're due.")
 	}
 
 	issueListCmd.Flags().BoolP("opened", "o", false, fmt.Sprintf("Get only open %ss.", issueType))
@@ -202,6 +254,14 @@ func listRun(opts *ListOptions) error {
 	}
 	client := apiClient.Lab()
 
+	// Formats that need only a few fields are fetched with GraphQL, which returns
+	// just those fields. REST is still used when GraphQL fails, like on old instances.
+	if fields := sparseFields(opts); fields != nil {
+		if issues, err := listSparseIssues(client, opts, fields); err == nil {
+			return printSparseIssues(opts, issues, nil)
+		}
+	}
+
 	listOpts := &gitlab.ListProjectIssuesOptions{
 		State:   gitlab.Ptr(opts.State),
 		In:      gitlab.Ptr(opts.In),
@@ -216,7 +276,7 @@ func listRun(opts *ListOptions) error {
 	}
 
 	if opts.Assignee != "" {
-		uid, err := userID(client, opts.Assignee)
+		uid, err := userID(apiClient, opts.Assignee)
 		if err != nil {
 			return err
 		}
@@ -225,7 +285,7 @@ func listRun(opts *ListOptions) error {
 	}
 
 	if opts.NotAssignee != "" {
-		uid, err := userID(client, opts.NotAssignee)
+		uid, err := userID(apiClient, opts.NotAssignee)
 		if err != nil {
 			return err
 		}
@@ -233,7 +293,7 @@ func listRun(opts *ListOptions) error {
 	}
 
 	if opts.Author != "" {
-		uid, err := userID(client, opts.Author)
+		uid, err := userID(apiClient, opts.Author)
 		if err != nil {
 			return err
 		}
@@ -241,7 +301,7 @@ func listRun(opts *ListOptions) error {
 	}
 
 	if opts.NotAuthor != "" {
-		uid, err := userID(client, opts.NotAuthor)
+		uid, err := userID(apiClient, opts.NotAuthor)
 		if err != nil {
 			return err
 		}
@@ -285,11 +345,16 @@ func listRun(opts *ListOptions) error {
 		opts.ListType = "search"
 		issueType = opts.IssueType
 	}
-	if issueType == "issue" && opts.Iteration != 0 {
-		listOpts.IterationID = gitlab.Ptr(int64(opts.Iteration))
+	if issueType == "issue" && opts.Iteration != "" {
+		id, err := iterationID(client, opts)
+		if err != nil {
+			return err
+		}
+		listOpts.IterationID = gitlab.Ptr(id)
 	}
 
 	var issues []*gitlab.Issue
+	var resp *gitlab.Response
 	title := utils.NewListTitle(fmt.Sprintf("%s %s", opts.TitleQualifier, issueType))
 	switch {
 	case opts.Epic != 0:
@@ -300,7 +365,7 @@ func listRun(opts *ListOptions) error {
 		title.RepoName = fmt.Sprintf("%s&%d", opts.Group, opts.Epic)
 
 	case opts.Group != "":
-		issues, _, err = client.Issues.ListGroupIssues(opts.Group, projectListIssueOptionsToGroup(listOpts))
+		issues, resp, err = client.Issues.ListGroupIssues(opts.Group, projectListIssueOptionsToGroup(listOpts))
 		if err != nil {
 			return err
 		}
@@ -312,7 +377,7 @@ func listRun(opts *ListOptions) error {
 			return err
 		}
 
-		issues, _, err = client.Issues.ListProjectIssues(repo.FullName(), listOpts)
+		issues, resp, err = client.Issues.ListProjectIssues(repo.FullName(), listOpts)
 		if err != nil {
 			return err
 		}
@@ -323,12 +388,21 @@ func listRun(opts *ListOptions) error {
 	title.ListActionType = opts.ListType
 	title.CurrentPageTotal = len(issues)
 
+	if opts.Output == "json" && len(opts.Fields) > 0 {
+		return printSparseIssues(opts, issues, resp)
+	}
+
 	if opts.Output == "json" {
-		issueListJSON, _ := json.Marshal(issues)
+		issueListJSON, _ := cmdutils.ListJSON(issues, resp, opts.IncludeMeta)
 		fmt.Fprintln(opts.IO.StdOut, string(issueListJSON))
 		return nil
 	}
 
+	if opts.Output == "slack" {
+		fmt.Fprint(opts.IO.StdOut, issueutils.SlackIssueList(title.Describe(), issues))
+		return nil
+	}
+
 	if opts.OutputFormat == "ids" {
 		for _, i := range issues {
 			fmt.Fprintf(opts.IO.StdOut, "%d\n", i.IID)
@@ -343,25 +417,169 @@ func listRun(opts *ListOptions) error {
 		return nil
 	}
 
+	snoozed := 0
+	if opts.hideSnoozed {
+		store, err := snoozeutils.Load()
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		shown := make([]*gitlab.Issue, 0, len(issues))
+		for _, issue := range issues {
+			if store.IsHidden(issue, now) {
+				snoozed++
+				continue
+			}
+			shown = append(shown, issue)
+		}
+		issues = shown
+		title.CurrentPageTotal = len(issues)
+	}
+
 	if opts.IO.StartPager() != nil {
 		return fmt.Errorf("failed to start pager: %q", err)
 	}
 	defer opts.IO.StopPager()
 
 	fmt.Fprintf(opts.IO.StdOut, "%s\n%s\n", title.Describe(), issueutils.DisplayIssueList(opts.IO, issues, title.RepoName))
+	if snoozed > 0 {
+		fmt.Fprintf(opts.IO.StdOut, "%s hidden. Use --show-snoozed to list them.\n", utils.Pluralize(snoozed, "snoozed issue"))
+	}
 	return nil
 }
 
-func userID(client *gitlab.Client, username string) (int64, error) {
-	if username == "@me" {
-		me, _, err := client.Users.CurrentUser()
+// graphQLIssueSorts maps the --order and --sort values that GraphQL supports to its sort values.
+var graphQLIssueSorts = map[string]string{
+	"created_at asc":  "CREATED_ASC",
+	"created_at desc": "CREATED_DESC",
+	"updated_at asc":  "UPDATED_ASC",
+	"updated_at desc": "UPDATED_DESC",
+	"due_date asc":    "DUE_DATE_ASC",
+	"due_date desc":   "DUE_DATE_DESC",
+}
+
+// sparseFields returns the issue fields needed by the output format, or nil if the
+// issues can't be listed with GraphQL.
+// iterationID returns the ID of the --iteration flag, and looks up the ID of
+// the current iteration of the group or project for @current.
+func iterationID(client *gitlab.Client, opts *ListOptions) (int64, error) {
+	if opts.Iteration != api.CurrentIterationArg {
+		return strconv.ParseInt(opts.Iteration, 10, 64)
+	}
+
+	var project string
+	if opts.Group == "" {
+		repo, err := opts.BaseRepo()
 		if err != nil {
 			return 0, err
 		}
-		return me.ID, nil
+		project = repo.FullName()
 	}
+	iteration, err := api.CurrentIteration(client, project, opts.Group)
+	if err != nil {
+		return 0, err
+	}
+	return iteration.ID, nil
+}
+
+func sparseFields(opts *ListOptions) []string {
+	var fields []string
+	switch {
+	case opts.Output == "json" && len(opts.Fields) > 0:
+		fields = opts.Fields
+	case opts.Output == "text" && opts.OutputFormat == "ids":
+		fields = []string{"iid"}
+	case opts.Output == "text" && opts.OutputFormat == "urls":
+		fields = []string{"web_url"}
+	default:
+		return nil
+	}
+
+	// GraphQL pages with cursors, and filters issues by usernames instead of user IDs.
+	// The pagination state of --include-meta comes from the REST response headers.
+	if opts.IncludeMeta || opts.Epic != 0 || opts.Iteration != "" || opts.Page > 1 || opts.PerPage > api.MaxPerPage {
+		return nil
+	}
+	if opts.Mine || opts.Assignee == "@me" || opts.NotAssignee == "@me" || opts.Author == "@me" || opts.NotAuthor == "@me" {
+		return nil
+	}
+	if _, ok := graphQLIssueSorts[opts.OrderBy+" "+opts.Sort]; !ok {
+		return nil
+	}
+	return fields
+}
+
+func listSparseIssues(client *gitlab.Client, opts *ListOptions, fields []string) ([]*gitlab.Issue, error) {
+	filter := &api.IssueFieldsFilter{
+		State:            opts.State,
+		Labels:           opts.Labels,
+		NotLabels:        opts.NotLabels,
+		Milestone:        opts.Milestone,
+		Search:           opts.Search,
+		In:               strings.Split(opts.In, ","),
+		AssigneeUsername: opts.Assignee,
+		NotAssignee:      opts.NotAssignee,
+		AuthorUsername:   opts.Author,
+		NotAuthor:        opts.NotAuthor,
+		Confidential:     opts.Confidential,
+		IssueType:        opts.IssueType,
+		Sort:             graphQLIssueSorts[opts.OrderBy+" "+opts.Sort],
+		First:            opts.PerPage,
+	}
+	if filter.First == 0 {
+		filter.First = api.DefaultListLimit
+	}
+
+	if opts.Group != "" {
+		return api.ListIssueFields(client, opts.Group, true, fields, filter)
+	}
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return nil, err
+	}
+	return api.ListIssueFields(client, repo.FullName(), false, fields, filter)
+}
+
+// printSparseIssues prints the issues in the formats that need only some of their fields.
+func printSparseIssues(opts *ListOptions, issues []*gitlab.Issue, resp *gitlab.Response) error {
+	switch {
+	case opts.Output == "json":
+		items := make([]map[string]any, 0, len(issues))
+		for _, issue := range issues {
+			var all map[string]any
+			b, err := json.Marshal(issue)
+			if err != nil {
+				return err
+			}
+			if err := json.Unmarshal(b, &all); err != nil {
+				return err
+			}
+
+			item := make(map[string]any, len(opts.Fields))
+			for _, field := range opts.Fields {
+				item[field] = all[field]
+			}
+			items = append(items, item)
+		}
+		issueListJSON, err := cmdutils.ListJSON(items, resp, opts.IncludeMeta)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(opts.IO.StdOut, string(issueListJSON))
+	case opts.OutputFormat == "ids":
+		for _, i := range issues {
+			fmt.Fprintf(opts.IO.StdOut, "%d\n", i.IID)
+		}
+	case opts.OutputFormat == "urls":
+		for _, i := range issues {
+			fmt.Fprintf(opts.IO.StdOut, "%s\n", i.WebURL)
+		}
+	}
+	return nil
+}
 
-	u, err := api.UserByName(client, username)
+func userID(apiClient *api.Client, username string) (int64, error) {
+	u, err := apiClient.UserByName(username)
 	if err != nil {
 		return 0, err
 	}
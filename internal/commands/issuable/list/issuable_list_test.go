@@ -4,6 +4,8 @@ package list
 
 import (
 	"bufio"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
@@ -26,6 +28,7 @@ import (
 	"gitlab.com/gitlab-org/cli/internal/api"
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
 	"gitlab.com/gitlab-org/cli/internal/commands/issuable"
+	"gitlab.com/gitlab-org/cli/internal/commands/snooze/snoozeutils"
 	"gitlab.com/gitlab-org/cli/internal/iostreams"
 	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
 )
@@ -157,6 +160,10 @@ func TestIssueList_ids(t *testing.T) {
 	createdAt := time.Date(2016, 1, 4, 15, 31, 51, 0, time.UTC)
 	incidentType := "incident"
 
+	// GraphQL is unavailable, so the issues are listed with REST.
+	testClient.MockGraphQL.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("404 Not Found"))
 	testClient.MockIssues.EXPECT().
 		ListProjectIssues("OWNER/REPO", gomock.Any()).
 		Return([]*gitlab.Issue{
@@ -224,6 +231,10 @@ func TestIssueList_urls(t *testing.T) {
 	createdAt := time.Date(2016, 1, 4, 15, 31, 51, 0, time.UTC)
 	incidentType := "incident"
 
+	// GraphQL is unavailable, so the issues are listed with REST.
+	testClient.MockGraphQL.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("404 Not Found"))
 	testClient.MockIssues.EXPECT().
 		ListProjectIssues("OWNER/REPO", gomock.Any()).
 		Return([]*gitlab.Issue{
@@ -290,9 +301,11 @@ func TestIssueList_urls(t *testing.T) {
 }
 
 func TestIssueList_tty_withFlags(t *testing.T) {
-	// NOTE: These subtests cannot run in parallel because they use cmdutils.GroupOverride()
-	// which modifies global viper state (SetEnvPrefix, BindEnv).
+	t.Parallel()
+
 	t.Run("project", func(t *testing.T) {
+		t.Parallel()
+
 		testClient := gitlabtesting.NewTestClient(t)
 
 		testClient.MockUsers.EXPECT().
@@ -341,6 +354,8 @@ func TestIssueList_tty_withFlags(t *testing.T) {
 `, output.String())
 	})
 	t.Run("group", func(t *testing.T) {
+		t.Parallel()
+
 		testClient := gitlabtesting.NewTestClient(t)
 
 		testClient.MockIssues.EXPECT().
@@ -409,6 +424,36 @@ func TestIssueList_filterByIteration(t *testing.T) {
 `, output.String())
 }
 
+func TestIssueList_filterByCurrentIteration(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+
+	testClient.MockProjectIterations.EXPECT().
+		ListProjectIterations("OWNER/REPO", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(pid any, opts *gitlab.ListProjectIterationsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectIteration, *gitlab.Response, error) {
+			assert.Equal(t, "current", *opts.State)
+			return []*gitlab.ProjectIteration{{ID: 12, Title: "Sprint 4", State: 2}}, &gitlab.Response{}, nil
+		})
+	testClient.MockIssues.EXPECT().
+		ListProjectIssues("OWNER/REPO", gomock.Any()).
+		DoAndReturn(func(pid any, opts *gitlab.ListProjectIssuesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Issue, *gitlab.Response, error) {
+			assert.Equal(t, int64(12), *opts.IterationID)
+			return []*gitlab.Issue{}, nil, nil
+		})
+
+	exec := cmdtest.SetupCmdForTest(t, func(f cmdutils.Factory) *cobra.Command {
+		return NewCmdList(f, nil, issuable.TypeIssue)
+	}, true,
+		cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(testClient.Client))),
+		cmdtest.WithBaseRepo("OWNER", "REPO", ""),
+	)
+
+	_, err := exec("--iteration @current")
+	require.NoError(t, err)
+
+	_, err = exec("--iteration next")
+	assert.EqualError(t, err, `invalid iteration "next". Use an iteration ID or @current.`)
+}
+
 func TestIssueList_tty_withIssueType(t *testing.T) {
 	// NOTE: we need to force disable colors, otherwise we'd need ANSI sequences in our test output assertions.
 	t.Setenv("NO_COLOR", "true")
@@ -801,8 +846,7 @@ func TestIssueListMutualOutputFlags(t *testing.T) {
 }
 
 func TestIssueList_epicIssues(t *testing.T) {
-	// NOTE: This test cannot run in parallel because it uses cmdutils.GroupOverride()
-	// which modifies global viper state (SetEnvPrefix, BindEnv).
+	t.Parallel()
 
 	testdata := []*gitlab.Issue{
 		{
@@ -941,6 +985,8 @@ func TestIssueList_epicIssues(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
 			testClient := gitlabtesting.NewTestClient(t)
 
 			if tt.user != nil {
@@ -996,8 +1042,7 @@ func TestIssueList_epicIssues(t *testing.T) {
 }
 
 func TestIssueList_filterByLabel(t *testing.T) {
-	// NOTE: This test cannot run in parallel because it uses cmdutils.GroupOverride()
-	// which modifies global viper state (SetEnvPrefix, BindEnv).
+	t.Parallel()
 
 	tests := map[string]struct {
 		respBody []*gitlab.Issue
@@ -1128,6 +1173,8 @@ func TestIssueList_filterByLabel(t *testing.T) {
 
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
 			testClient := gitlabtesting.NewTestClient(t)
 
 			testClient.MockEpicIssues.EXPECT().
@@ -1183,3 +1230,144 @@ func strToIntSlice(s string) ([]int, error) {
 
 	return ret, nil
 }
+
+func TestIssueList_fields(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+
+	testClient.MockGraphQL.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(query gitlab.GraphQLQuery, response any, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+			assert.Contains(t, query.Query, "project(fullPath: $fullPath)")
+			assert.Contains(t, query.Query, "iid\n        webUrl\n        labels { nodes { title } }")
+			assert.NotContains(t, query.Query, "description")
+			assert.Equal(t, "OWNER/REPO", query.Variables["fullPath"])
+			assert.Equal(t, "opened", query.Variables["state"])
+			assert.Equal(t, []string{"bug"}, query.Variables["labelName"])
+			assert.Equal(t, "CREATED_DESC", query.Variables["sort"])
+			assert.Equal(t, int64(30), query.Variables["first"])
+
+			return nil, json.Unmarshal([]byte(`{"data": {"namespace": {"issues": {"nodes": [
+				{"iid": "6", "webUrl": "http://gitlab.com/OWNER/REPO/issues/6", "labels": {"nodes": [{"title": "bug"}]}},
+				{"iid": "7", "webUrl": "http://gitlab.com/OWNER/REPO/issues/7", "labels": {"nodes": [{"title": "bug"}, {"title": "ui"}]}}
+			]}}}}`), response)
+		})
+
+	apiClient, err := api.NewClient(
+		func(*http.Client) (gitlab.AuthSource, error) {
+			return gitlab.AccessTokenAuthSource{Token: "test-token"}, nil
+		},
+		api.WithGitLabClient(testClient.Client),
+	)
+	require.NoError(t, err)
+
+	exec := cmdtest.SetupCmdForTest(t, func(f cmdutils.Factory) *cobra.Command {
+		return NewCmdList(f, nil, issuable.TypeIssue)
+	}, true,
+		cmdtest.WithApiClient(apiClient),
+		cmdtest.WithBaseRepo("OWNER", "REPO", ""),
+	)
+
+	output, err := exec("--label bug --output json --fields iid,web_url,labels")
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `[
+		{"iid": 6, "web_url": "http://gitlab.com/OWNER/REPO/issues/6", "labels": ["bug"]},
+		{"iid": 7, "web_url": "http://gitlab.com/OWNER/REPO/issues/7", "labels": ["bug", "ui"]}
+	]`, output.String())
+	assert.Empty(t, output.Stderr())
+}
+
+func TestIssueList_fieldsFallback(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+
+	testClient.MockGraphQL.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("404 Not Found"))
+	testClient.MockIssues.EXPECT().
+		ListProjectIssues("OWNER/REPO", gomock.Any()).
+		Return([]*gitlab.Issue{
+			{ID: 76, IID: 6, Title: "Issue one", State: "opened", WebURL: "http://gitlab.com/OWNER/REPO/issues/6"},
+		}, nil, nil)
+
+	apiClient, err := api.NewClient(
+		func(*http.Client) (gitlab.AuthSource, error) {
+			return gitlab.AccessTokenAuthSource{Token: "test-token"}, nil
+		},
+		api.WithGitLabClient(testClient.Client),
+	)
+	require.NoError(t, err)
+
+	exec := cmdtest.SetupCmdForTest(t, func(f cmdutils.Factory) *cobra.Command {
+		return NewCmdList(f, nil, issuable.TypeIssue)
+	}, true,
+		cmdtest.WithApiClient(apiClient),
+		cmdtest.WithBaseRepo("OWNER", "REPO", ""),
+	)
+
+	output, err := exec("--output json --fields iid,title")
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `[{"iid": 6, "title": "Issue one"}]`, output.String())
+}
+
+func TestIssueList_fieldsRequireJSON(t *testing.T) {
+	exec := cmdtest.SetupCmdForTest(t, func(f cmdutils.Factory) *cobra.Command {
+		return NewCmdList(f, nil, issuable.TypeIssue)
+	}, true)
+
+	_, err := exec("--fields iid")
+	require.EqualError(t, err, "--fields requires --output json.")
+
+	_, err = exec("--output json --fields iid,author")
+	require.ErrorContains(t, err, `invalid field "author".`)
+}
+
+func TestIssueList_hidesSnoozed(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+	t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+
+	createdAt := time.Date(2016, 1, 4, 15, 31, 51, 0, time.UTC)
+	issues := []*gitlab.Issue{
+		{IID: 6, Title: "Issue one", State: "opened", WebURL: "http://gitlab.com/OWNER/REPO/issues/6", CreatedAt: &createdAt},
+		{IID: 7, Title: "Issue two", State: "opened", WebURL: "http://gitlab.com/OWNER/REPO/issues/7", CreatedAt: &createdAt},
+		{IID: 8, Title: "Issue three", State: "opened", WebURL: "http://gitlab.com/OWNER/REPO/issues/8", CreatedAt: &createdAt},
+	}
+	store := snoozeutils.Store{}
+	store.Snooze(issues[0], time.Now().Add(time.Hour))
+	store.Snooze(issues[1], time.Now().Add(-time.Hour))
+	require.NoError(t, store.Save())
+
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockIssues.EXPECT().
+		ListProjectIssues("OWNER/REPO", gomock.Any()).
+		Return(issues, nil, nil).
+		Times(2)
+
+	apiClient, err := api.NewClient(
+		func(*http.Client) (gitlab.AuthSource, error) {
+			return gitlab.AccessTokenAuthSource{Token: "test-token"}, nil
+		},
+		api.WithGitLabClient(testClient.Client),
+	)
+	require.NoError(t, err)
+
+	setup := func() cmdtest.CmdExecFunc {
+		return cmdtest.SetupCmdForTest(t, func(f cmdutils.Factory) *cobra.Command {
+			return NewCmdList(f, nil, issuable.TypeIssue)
+		}, false,
+			cmdtest.WithApiClient(apiClient),
+			cmdtest.WithBaseRepo("OWNER", "REPO", ""),
+		)
+	}
+
+	output, err := setup()("")
+	require.NoError(t, err)
+	assert.NotContains(t, output.String(), "Issue one")
+	assert.Contains(t, output.String(), "Issue two")
+	assert.Contains(t, output.String(), "1 snoozed issue hidden. Use --show-snoozed to list them.")
+
+	output, err = setup()("--show-snoozed")
+	require.NoError(t, err)
+	assert.Contains(t, output.String(), "Issue one")
+	assert.NotContains(t, output.String(), "hidden")
+}
@@ -45,6 +45,9 @@ func NewCmdNote(f cmdutils.Factory, issueType issuable.IssueType) *cobra.Command
 				return nil
 			}
 
+			if err := cmdutils.ApplyBodyFile(cmd, f.IO(), "message"); err != nil {
+				return err
+			}
 			body, _ := cmd.Flags().GetString("message")
 
 			if strings.TrimSpace(body) == "" {
@@ -73,6 +76,7 @@ func NewCmdNote(f cmdutils.Factory, issueType issuable.IssueType) *cobra.Command
 		},
 	}
 	issueNoteCreateCmd.Flags().StringP("message", "m", "", "Message text.")
+	cmdutils.AddBodyFileFlag(issueNoteCreateCmd, "message")
 
 	return issueNoteCreateCmd
 }
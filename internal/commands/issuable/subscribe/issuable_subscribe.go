@@ -31,21 +31,53 @@ func NewCmdSubscribe(f cmdutils.Factory, issueType issuable.IssueType) *cobra.Co
 		examplePath = "issues/incident/123"
 	}
 
+	var labels []string
+	var group string
+
+	use := "subscribe <id>"
+	short := fmt.Sprintf(`Subscribe to an %s.`, issueType)
+	args := cobra.ExactArgs(1)
+	example := heredoc.Doc(fmt.Sprintf(`
+		$ glab %[1]s subscribe 123
+		$ glab %[1]s sub 123
+		$ glab %[1]s subscribe https://gitlab.com/OWNER/REPO/-/%[2]s
+	`, issueType, examplePath))
+	// Label subscriptions notify about issues, merge requests, and epics, so they're
+	// only offered by the issue command.
+	if issueType == issuable.TypeIssue {
+		use = "subscribe {<id> | --label <name>}"
+		short = `Subscribe to an issue, or to labels.`
+		args = cobra.MaximumNArgs(1)
+		example += heredoc.Doc(`
+			$ glab issue subscribe --label bug,security
+			$ glab issue subscribe --label frontend --group mygroup
+		`)
+	}
+
 	issueSubscribeCmd := &cobra.Command{
-		Use:     "subscribe <id>",
-		Short:   fmt.Sprintf(`Subscribe to an %s.`, issueType),
+		Use:     use,
+		Short:   short,
 		Long:    ``,
 		Aliases: []string{"sub"},
-		Example: heredoc.Doc(fmt.Sprintf(`
-			$ glab %[1]s subscribe 123
-			$ glab %[1]s sub 123
-			$ glab %[1]s subscribe https://gitlab.com/OWNER/REPO/-/%[2]s
-		`, issueType, examplePath)),
-		Args: cobra.ExactArgs(1),
+		Example: example,
+		Args:    args,
 		Annotations: map[string]string{
 			mcpannotations.Destructive: "true",
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(labels) > 0 {
+				if len(args) > 0 {
+					return &cmdutils.FlagError{Err: errors.New("specify either an issue or --label, not both.")}
+				}
+				return subscribeLabels(f, labels, group)
+			}
+			if group != "" {
+				return &cmdutils.FlagError{Err: errors.New("the --group flag requires --label.")}
+			}
+			if len(args) == 0 {
+				return &cmdutils.FlagError{Err: errors.New("specify an issue, or labels with --label.")}
+			}
+
 			c := f.IO().Color()
 			client, err := f.GitLabClient()
 			if err != nil {
@@ -95,6 +127,11 @@ func NewCmdSubscribe(f cmdutils.Factory, issueType issuable.IssueType) *cobra.Co
 		},
 	}
 
+	if issueType == issuable.TypeIssue {
+		issueSubscribeCmd.Flags().StringSliceVarP(&labels, "label", "l", nil, "Subscribe to labels instead of an issue. Multiple labels can be comma-separated or specified by repeating the flag.")
+		issueSubscribeCmd.Flags().StringVarP(&group, "group", "g", "", "Subscribe to labels of a group instead of the project.")
+	}
+
 	return issueSubscribeCmd
 }
 
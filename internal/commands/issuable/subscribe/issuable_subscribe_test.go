@@ -243,3 +243,45 @@ func Test_IssuableSubscribe(t *testing.T) {
 		})
 	}
 }
+
+func Test_IssueSubscribeLabels(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	testClient := gitlabtesting.NewTestClient(t)
+	notModifiedResponse := &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotModified}}
+	gomock.InOrder(
+		testClient.MockLabels.EXPECT().
+			SubscribeToLabel("OWNER/REPO", "bug").
+			Return(&gitlab.Label{Name: "bug", Subscribed: true}, nil, nil),
+		testClient.MockLabels.EXPECT().
+			SubscribeToLabel("OWNER/REPO", "security").
+			Return(nil, notModifiedResponse, fmt.Errorf("304 Not Modified")),
+	)
+	testClient.MockGroupLabels.EXPECT().
+		SubscribeToGroupLabel("mygroup", "frontend").
+		Return(&gitlab.GroupLabel{Name: "frontend", Subscribed: true}, nil, nil)
+
+	cmdFunc := func(f cmdutils.Factory) *cobra.Command {
+		return NewCmdSubscribe(f, issuable.TypeIssue)
+	}
+
+	exec := cmdtest.SetupCmdForTest(t, cmdFunc, true, cmdtest.WithGitLabClient(testClient.Client))
+	out, err := exec("--label bug,security")
+	require.NoError(t, err)
+	assert.Equal(t, heredoc.Doc(`
+		✓ Subscribed to label "bug" in OWNER/REPO
+		x You are already subscribed to label "security".
+	`), out.String())
+
+	exec = cmdtest.SetupCmdForTest(t, cmdFunc, true, cmdtest.WithGitLabClient(testClient.Client))
+	out, err = exec("--label frontend --group mygroup")
+	require.NoError(t, err)
+	assert.Equal(t, "✓ Subscribed to label \"frontend\" in mygroup\n", out.String())
+
+	exec = cmdtest.SetupCmdForTest(t, cmdFunc, true, cmdtest.WithGitLabClient(testClient.Client))
+	_, err = exec("1 --label bug")
+	require.EqualError(t, err, "specify either an issue or --label, not both.")
+
+	_, err = exec("--group mygroup")
+	require.EqualError(t, err, "the --group flag requires --label.")
+}
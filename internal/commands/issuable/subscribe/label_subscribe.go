@@ -0,0 +1,52 @@
+package subscribe
+
+import (
+	"fmt"
+	"net/http"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+)
+
+// subscribeLabels subscribes the user to labels of the project, or of group when set.
+func subscribeLabels(f cmdutils.Factory, labels []string, group string) error {
+	c := f.IO().Color()
+	client, err := f.GitLabClient()
+	if err != nil {
+		return err
+	}
+
+	target := group
+	if group == "" {
+		repo, err := f.BaseRepo()
+		if err != nil {
+			return err
+		}
+		target = repo.FullName()
+	}
+
+	for _, label := range labels {
+		var resp *gitlab.Response
+		if group != "" {
+			_, resp, err = client.GroupLabels.SubscribeToGroupLabel(group, label)
+		} else {
+			_, resp, err = client.Labels.SubscribeToLabel(target, label)
+		}
+		if err != nil {
+			switch {
+			case resp != nil && resp.StatusCode == http.StatusNotModified:
+				// The status code 304 is returned when the user is already subscribed.
+				fmt.Fprintf(f.IO().StdOut, "%s You are already subscribed to label %q.\n", c.FailedIcon(), label)
+				continue
+			case resp != nil && resp.StatusCode == http.StatusNotFound:
+				return cmdutils.WrapError(err, fmt.Sprintf("label %q not found in %s.", label, target))
+			default:
+				return cmdutils.WrapError(err, fmt.Sprintf("failed to subscribe to label %q.", label))
+			}
+		}
+
+		fmt.Fprintf(f.IO().StdOut, "%s Subscribed to label %q in %s\n", c.GreenCheck(), label, c.Cyan(target))
+	}
+	return nil
+}
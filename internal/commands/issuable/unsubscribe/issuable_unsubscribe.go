@@ -31,21 +31,53 @@ func NewCmdUnsubscribe(f cmdutils.Factory, issueType issuable.IssueType) *cobra.
 		examplePath = "issues/incident/123"
 	}
 
+	var labels []string
+	var group string
+
+	use := "unsubscribe <id>"
+	short := fmt.Sprintf(`Unsubscribe from an %s.`, issueType)
+	args := cobra.ExactArgs(1)
+	example := heredoc.Doc(fmt.Sprintf(`
+		$ glab %[1]s unsubscribe 123
+		$ glab %[1]s unsub 123
+		$ glab %[1]s unsubscribe https://gitlab.com/OWNER/REPO/-/%[2]s
+	`, issueType, examplePath))
+	// Label subscriptions notify about issues, merge requests, and epics, so they're
+	// only offered by the issue command.
+	if issueType == issuable.TypeIssue {
+		use = "unsubscribe {<id> | --label <name>}"
+		short = `Unsubscribe from an issue, or from labels.`
+		args = cobra.MaximumNArgs(1)
+		example += heredoc.Doc(`
+			$ glab issue unsubscribe --label bug,security
+			$ glab issue unsubscribe --label frontend --group mygroup
+		`)
+	}
+
 	issueUnsubscribeCmd := &cobra.Command{
-		Use:     "unsubscribe <id>",
-		Short:   fmt.Sprintf(`Unsubscribe from an %s.`, issueType),
+		Use:     use,
+		Short:   short,
 		Long:    ``,
 		Aliases: []string{"unsub"},
-		Example: heredoc.Doc(fmt.Sprintf(`
-			$ glab %[1]s unsubscribe 123
-			$ glab %[1]s unsub 123
-			$ glab %[1]s unsubscribe https://gitlab.com/OWNER/REPO/-/%[2]s
-		`, issueType, examplePath)),
-		Args: cobra.ExactArgs(1),
+		Example: example,
+		Args:    args,
 		Annotations: map[string]string{
 			mcpannotations.Destructive: "true",
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(labels) > 0 {
+				if len(args) > 0 {
+					return &cmdutils.FlagError{Err: errors.New("specify either an issue or --label, not both.")}
+				}
+				return unsubscribeLabels(f, labels, group)
+			}
+			if group != "" {
+				return &cmdutils.FlagError{Err: errors.New("the --group flag requires --label.")}
+			}
+			if len(args) == 0 {
+				return &cmdutils.FlagError{Err: errors.New("specify an issue, or labels with --label.")}
+			}
+
 			c := f.IO().Color()
 			client, err := f.GitLabClient()
 			if err != nil {
@@ -95,6 +127,11 @@ func NewCmdUnsubscribe(f cmdutils.Factory, issueType issuable.IssueType) *cobra.
 		},
 	}
 
+	if issueType == issuable.TypeIssue {
+		issueUnsubscribeCmd.Flags().StringSliceVarP(&labels, "label", "l", nil, "Unsubscribe from labels instead of an issue. Multiple labels can be comma-separated or specified by repeating the flag.")
+		issueUnsubscribeCmd.Flags().StringVarP(&group, "group", "g", "", "Unsubscribe from labels of a group instead of the project.")
+	}
+
 	return issueUnsubscribeCmd
 }
 
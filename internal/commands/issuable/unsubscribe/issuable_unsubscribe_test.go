@@ -243,3 +243,31 @@ func Test_IssuableUnsubscribe(t *testing.T) {
 		})
 	}
 }
+
+func Test_IssueUnsubscribeLabels(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	testClient := gitlabtesting.NewTestClient(t)
+	notFoundResponse := &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}
+	testClient.MockLabels.EXPECT().
+		UnsubscribeFromLabel("OWNER/REPO", "bug").
+		Return(nil, nil)
+	testClient.MockLabels.EXPECT().
+		UnsubscribeFromLabel("OWNER/REPO", "missing").
+		Return(notFoundResponse, fmt.Errorf("404 Not Found"))
+
+	cmdFunc := func(f cmdutils.Factory) *cobra.Command {
+		return NewCmdUnsubscribe(f, issuable.TypeIssue)
+	}
+
+	exec := cmdtest.SetupCmdForTest(t, cmdFunc, true, cmdtest.WithGitLabClient(testClient.Client))
+	out, err := exec("--label bug")
+	require.NoError(t, err)
+	assert.Equal(t, "✓ Unsubscribed from label \"bug\" in OWNER/REPO\n", out.String())
+
+	exec = cmdtest.SetupCmdForTest(t, cmdFunc, true, cmdtest.WithGitLabClient(testClient.Client))
+	_, err = exec("--label missing")
+	var exitErr *cmdutils.ExitError
+	require.ErrorAs(t, err, &exitErr)
+	assert.Equal(t, `label "missing" not found in OWNER/REPO.`, exitErr.Details)
+}
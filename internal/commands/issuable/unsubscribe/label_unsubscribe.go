@@ -0,0 +1,52 @@
+package unsubscribe
+
+import (
+	"fmt"
+	"net/http"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+)
+
+// unsubscribeLabels unsubscribes the user from labels of the project, or of group when set.
+func unsubscribeLabels(f cmdutils.Factory, labels []string, group string) error {
+	c := f.IO().Color()
+	client, err := f.GitLabClient()
+	if err != nil {
+		return err
+	}
+
+	target := group
+	if group == "" {
+		repo, err := f.BaseRepo()
+		if err != nil {
+			return err
+		}
+		target = repo.FullName()
+	}
+
+	for _, label := range labels {
+		var resp *gitlab.Response
+		if group != "" {
+			resp, err = client.GroupLabels.UnsubscribeFromGroupLabel(group, label)
+		} else {
+			resp, err = client.Labels.UnsubscribeFromLabel(target, label)
+		}
+		if err != nil {
+			switch {
+			case resp != nil && resp.StatusCode == http.StatusNotModified:
+				// The status code 304 is returned when the user is not subscribed.
+				fmt.Fprintf(f.IO().StdOut, "%s You are not subscribed to label %q.\n", c.FailedIcon(), label)
+				continue
+			case resp != nil && resp.StatusCode == http.StatusNotFound:
+				return cmdutils.WrapError(err, fmt.Sprintf("label %q not found in %s.", label, target))
+			default:
+				return cmdutils.WrapError(err, fmt.Sprintf("failed to unsubscribe from label %q.", label))
+			}
+		}
+
+		fmt.Fprintf(f.IO().StdOut, "%s Unsubscribed from label %q in %s\n", c.GreenCheck(), label, c.Cyan(target))
+	}
+	return nil
+}
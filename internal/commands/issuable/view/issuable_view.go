@@ -18,6 +18,7 @@ import (
 	"gitlab.com/gitlab-org/cli/internal/glrepo"
 	"gitlab.com/gitlab-org/cli/internal/iostreams"
 	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/text"
 	"gitlab.com/gitlab-org/cli/internal/utils"
 )
 
@@ -32,6 +33,22 @@ var listIssueNotes = func(client *gitlab.Client, projectID any, issueID int64, o
 	return notes, nil
 }
 
+var listIssueRelations = func(client *gitlab.Client, projectID any, issueID int64) ([]*gitlab.IssueRelation, error) {
+	relations, _, err := client.IssueLinks.ListIssueRelations(projectID, issueID)
+	if err != nil {
+		return nil, err
+	}
+	return relations, nil
+}
+
+var listIssueTasks = func(client *gitlab.Client, projectPath string, issueID int64) ([]*api.WorkItem, error) {
+	workItem, err := api.GetWorkItem(client, projectPath, issueID)
+	if err != nil {
+		return nil, err
+	}
+	return workItem.Children, nil
+}
+
 type IssueWithNotes struct {
 	*gitlab.Issue
 	Notes []*gitlab.Note
@@ -46,15 +63,17 @@ type options struct {
 	commentPageNumber int
 	commentLimit      int
 
-	notes []*gitlab.Note
-	issue *gitlab.Issue
+	notes     []*gitlab.Note
+	relations []*gitlab.IssueRelation
+	tasks     []*api.WorkItem
+	issue     *gitlab.Issue
 
 	io              *iostreams.IOStreams
 	apiClient       func(repoHost string) (*api.Client, error)
 	gitlabClient    func() (*gitlab.Client, error)
 	config          func() config.Config
 	baseRepo        func() (glrepo.Interface, error)
-	defaultHostname string
+	defaultHostname func() string
 }
 
 func NewCmdView(f cmdutils.Factory, issueType issuable.IssueType) *cobra.Command {
@@ -70,7 +89,7 @@ func NewCmdView(f cmdutils.Factory, issueType issuable.IssueType) *cobra.Command
 		gitlabClient:    f.GitLabClient,
 		config:          f.Config,
 		baseRepo:        f.BaseRepo,
-		defaultHostname: f.DefaultHostname(),
+		defaultHostname: f.DefaultHostname,
 	}
 	issueViewCmd := &cobra.Command{
 		Use:     "view <id>",
@@ -98,7 +117,7 @@ func NewCmdView(f cmdutils.Factory, issueType issuable.IssueType) *cobra.Command
 	issueViewCmd.Flags().BoolVarP(&opts.web, "web", "w", false, fmt.Sprintf("Open %s in a browser. Uses the default browser, or the browser specified in the $BROWSER variable.", issueType))
 	issueViewCmd.Flags().IntVarP(&opts.commentPageNumber, "page", "p", 1, "Page number.")
 	issueViewCmd.Flags().IntVarP(&opts.commentLimit, "per-page", "P", 20, "Number of items to list per page.")
-	issueViewCmd.Flags().StringVarP(&opts.outputFormat, "output", "F", "text", "Format output as: text, json.")
+	issueViewCmd.Flags().StringVarP(&opts.outputFormat, "output", "F", "text", "Format output as: text, json, slack.")
 
 	return issueViewCmd
 }
@@ -110,7 +129,7 @@ func (o *options) run(issueType issuable.IssueType, args []string) error {
 	}
 	cfg := o.config()
 
-	issue, baseRepo, err := issueutils.IssueFromArg(o.apiClient, client, o.baseRepo, o.defaultHostname, args[0])
+	issue, baseRepo, err := issueutils.IssueFromArg(o.apiClient, client, o.baseRepo, o.defaultHostname(), args[0])
 	if err != nil {
 		return err
 	}
@@ -149,6 +168,13 @@ func (o *options) run(issueType issuable.IssueType, args []string) error {
 		}
 	}
 
+	if o.outputFormat == "text" {
+		// The links and tasks are extra information: the issue is shown
+		// without them when they can't be listed.
+		o.relations, _ = listIssueRelations(client, baseRepo.FullName(), o.issue.IID)
+		o.tasks, _ = listIssueTasks(client, baseRepo.FullName(), o.issue.IID)
+	}
+
 	glamourStyle, _ := cfg.Get(baseRepo.RepoHost(), "glamour_style")
 	o.io.ResolveBackgroundColor(glamourStyle)
 	err = o.io.StartPager()
@@ -160,6 +186,8 @@ func (o *options) run(issueType issuable.IssueType, args []string) error {
 	switch {
 	case o.outputFormat == "json":
 		printJSONIssue(o)
+	case o.outputFormat == "slack":
+		fmt.Fprint(o.io.StdOut, slackIssuePreview(o.issue))
 	case o.io.IsErrTTY && o.io.IsaTTY:
 		printTTYIssuePreview(o)
 	default:
@@ -222,6 +250,27 @@ func printTTYIssuePreview(opts *options) {
 		fmt.Fprint(opts.io.StdOut, c.Bold("Milestone: "))
 		fmt.Fprintln(opts.io.StdOut, opts.issue.Milestone.Title)
 	}
+	if len(opts.relations) > 0 {
+		fmt.Fprintln(opts.io.StdOut, c.Bold("Linked issues:"))
+		for _, r := range opts.relations {
+			state := c.Green("open")
+			if r.State == "closed" {
+				state = c.Red(r.State)
+			}
+			fmt.Fprintf(opts.io.StdOut, "  %s %s %s (%s)\n", issueutils.LinkTypeText(r.LinkType), c.Cyan(issueutils.RelationReference(r, opts.issue.ProjectID)), r.Title, state)
+		}
+	}
+	if len(opts.tasks) > 0 {
+		fmt.Fprint(opts.io.StdOut, c.Bold("Tasks: "))
+		fmt.Fprintf(opts.io.StdOut, "%d of %d completed\n", completedTasks(opts.tasks), len(opts.tasks))
+		for _, task := range opts.tasks {
+			check := c.Gray("[ ]")
+			if task.Closed() {
+				check = c.Green("[x]")
+			}
+			fmt.Fprintf(opts.io.StdOut, "  %s %s %s\n", check, c.Cyan(fmt.Sprintf("#%d", task.IID)), task.Title)
+		}
+	}
 	if opts.issue.State == "closed" {
 		fmt.Fprintf(opts.io.StdOut, "Closed by: %s %s\n", opts.issue.ClosedBy.Username, issueTimeAgo)
 	}
@@ -278,6 +327,21 @@ func rawIssuePreview(opts *options) string {
 	if opts.issue.Milestone != nil {
 		out += fmt.Sprintf("milestone:\t%s\n", opts.issue.Milestone.Title)
 	}
+	if len(opts.relations) > 0 {
+		links := utils.Map(opts.relations, func(r *gitlab.IssueRelation) string {
+			return issueutils.LinkTypeText(r.LinkType) + " " + issueutils.RelationReference(r, opts.issue.ProjectID)
+		})
+		out += fmt.Sprintf("linked issues:\t%s\n", strings.Join(links, ", "))
+	}
+	if len(opts.tasks) > 0 {
+		tasks := utils.Map(opts.tasks, func(task *api.WorkItem) string {
+			if task.Closed() {
+				return fmt.Sprintf("[x] #%d", task.IID)
+			}
+			return fmt.Sprintf("[ ] #%d", task.IID)
+		})
+		out += fmt.Sprintf("tasks:\t%s\n", strings.Join(tasks, ", "))
+	}
 
 	out += "--\n"
 	out += fmt.Sprintf("%s\n", opts.issue.Description)
@@ -287,6 +351,43 @@ func rawIssuePreview(opts *options) string {
 	return out
 }
 
+// completedTasks returns the number of closed tasks.
+func completedTasks(tasks []*api.WorkItem) int {
+	n := 0
+	for _, task := range tasks {
+		if task.Closed() {
+			n++
+		}
+	}
+	return n
+}
+
+// slackIssuePreview returns the issue in Slack mrkdwn, for chat bots to post.
+func slackIssuePreview(issue *gitlab.Issue) string {
+	var b strings.Builder
+	b.WriteString(text.SlackBold(text.SlackLink(issue.WebURL, fmt.Sprintf("#%d %s", issue.IID, issue.Title))) + "\n")
+
+	state := issue.State
+	if state == "opened" {
+		state = "open"
+	}
+	fmt.Fprintf(&b, "*State:* %s · *Author:* @%s · *Comments:* %d\n", state, issue.Author.Username, issue.UserNotesCount)
+	if len(issue.Labels) > 0 {
+		fmt.Fprintf(&b, "*Labels:* %s\n", text.SlackEscape(strings.Join(issue.Labels, ", ")))
+	}
+	if len(issue.Assignees) > 0 {
+		assignees := utils.Map(issue.Assignees, func(a *gitlab.IssueAssignee) string { return "@" + a.Username })
+		fmt.Fprintf(&b, "*Assignees:* %s\n", strings.Join(assignees, ", "))
+	}
+	if issue.Milestone != nil {
+		fmt.Fprintf(&b, "*Milestone:* %s\n", text.SlackEscape(issue.Milestone.Title))
+	}
+	if issue.Description != "" {
+		b.WriteString(text.SlackQuote(issue.Description, text.SlackPreviewLines))
+	}
+	return b.String()
+}
+
 // RawIssuableNotes returns a list of comments/notes in a raw format
 func RawIssuableNotes(notes []*gitlab.Note, showComments bool, showSystemLogs bool, issuableName string) string {
 	var out strings.Builder
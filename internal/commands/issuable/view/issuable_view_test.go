@@ -125,6 +125,18 @@ func TestMain(m *testing.M) {
 			IssueType:      &issueType,
 		}, nil
 	}
+	listIssueRelations = func(client *gitlab.Client, projectID any, issueID int64) ([]*gitlab.IssueRelation, error) {
+		return []*gitlab.IssueRelation{
+			{IID: 20, Title: "Blocking issue", State: "opened", LinkType: "is_blocked_by"},
+			{IID: 21, Title: "Other issue", State: "closed", LinkType: "relates_to", ProjectID: 2, References: &gitlab.IssueReferences{Full: "group/other#21"}},
+		}, nil
+	}
+	listIssueTasks = func(client *gitlab.Client, projectPath string, issueID int64) ([]*api.WorkItem, error) {
+		return []*api.WorkItem{
+			{IID: 30, Title: "Write docs", State: "CLOSED"},
+			{IID: 31, Title: "Add tests", State: "OPEN"},
+		}, nil
+	}
 	cmdtest.InitTest(m, "mr_view_test")
 }
 
@@ -210,6 +222,9 @@ func TestNewCmdView(t *testing.T) {
 					require.Contains(t, out, testIssuable.description)
 					assert.Contains(t, out, fmt.Sprintf("https://gitlab.com/cli-automated-testing/test/-/issues/%d", tt.issueID))
 					assert.Contains(t, out, fmt.Sprintf("johnwick Marked %s as stale", testIssuable.issueType))
+					assert.Contains(t, out, "is blocked by #20 Blocking issue (open)")
+					assert.Contains(t, out, "relates to group/other#21 Other issue (closed)")
+					assert.Contains(t, out, "Tasks: 1 of 2 completed\n  [x] #30 Write docs\n  [ ] #31 Add tests\n")
 				}
 			} else {
 				if viewIncidentWithIssueID {
@@ -223,6 +238,8 @@ func TestNewCmdView(t *testing.T) {
 						`comments:\t2`,
 						fmt.Sprintf(`labels:\t%s`, strings.Join([]string(testIssuable.labels), ", ")),
 						`milestone:\tMilestoneTitle\n`,
+						`linked issues:\tis blocked by #20, relates to group/other#21\n`,
+						`tasks:\t\[x\] #30, \[ \] #31\n`,
 						`--`,
 						testIssuable.description,
 					}
@@ -501,3 +518,24 @@ func TestIssueViewJSON(t *testing.T) {
 	assert.True(t, json.Valid([]byte(output.String())))
 	assert.Empty(t, output.Stderr())
 }
+
+func Test_slackIssuePreview(t *testing.T) {
+	issue := &gitlab.Issue{
+		IID:            1,
+		Title:          "Crash on <Enter>",
+		State:          "closed",
+		Author:         &gitlab.IssueAuthor{Username: "alice"},
+		Assignees:      []*gitlab.IssueAssignee{{Username: "bob"}},
+		Milestone:      &gitlab.Milestone{Title: "v1.0"},
+		UserNotesCount: 4,
+		Description:    "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11",
+		WebURL:         "https://gitlab.com/OWNER/REPO/-/issues/1",
+	}
+
+	want := "*<https://gitlab.com/OWNER/REPO/-/issues/1|#1 Crash on &lt;Enter&gt;>*\n" +
+		"*State:* closed · *Author:* @alice · *Comments:* 4\n" +
+		"*Assignees:* @bob\n" +
+		"*Milestone:* v1.0\n" +
+		"> 1\n> 2\n> 3\n> 4\n> 5\n> 6\n> 7\n> 8\n> 9\n> 10\n> …\n"
+	assert.Equal(t, want, slackIssuePreview(issue))
+}
@@ -0,0 +1,193 @@
+package auditconfidential
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	labels       []string
+	group        string
+	expect       string
+	all          bool
+	fix          bool
+	outputFormat string
+
+	io      *iostreams.IOStreams
+	factory cmdutils.Factory
+}
+
+func NewCmdAuditConfidential(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:      f.IO(),
+		factory: f,
+	}
+
+	issueAuditConfidentialCmd := &cobra.Command{
+		Use:   "audit-confidential --label <name> [flags]",
+		Short: `List issues with labels whose confidentiality doesn't match a policy.`,
+		Long: heredoc.Doc(`
+			List the issues with any of the given labels that aren't confidential, or with
+			--expect public, that are confidential.
+
+			Use it to find security issues that were made public by mistake. The command exits
+			with a non-zero status when it finds issues, so it can run in a scheduled pipeline.
+			Use --fix to change the confidentiality of the issues found.
+
+			Only open issues are checked, unless --all is set.
+		`),
+		Example: heredoc.Doc(`
+			# List the public issues labeled "security"
+			$ glab issue audit-confidential --label security
+
+			# Check all the projects of a group, including closed issues
+			$ glab issue audit-confidential --label security --label vulnerability --group my-group --all
+
+			# Make the public issues labeled "security" confidential
+			$ glab issue audit-confidential --label security --fix
+
+			# List the confidential issues labeled "documentation"
+			$ glab issue audit-confidential --label documentation --expect public
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+
+	fl := issueAuditConfidentialCmd.Flags()
+	fl.StringSliceVarP(&opts.labels, "label", "l", nil, "Check the issues with any of these labels. Can be repeated.")
+	fl.StringVarP(&opts.group, "group", "g", "", "Check the issues of all the projects of a group.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"confidential", "public"}, "confidential", &opts.expect), "expect", "e", "Expected confidentiality of the issues: confidential, public.")
+	fl.BoolVarP(&opts.all, "all", "A", false, "Check closed issues too.")
+	fl.BoolVar(&opts.fix, "fix", false, "Change the confidentiality of the issues found to the expected one.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+	_ = issueAuditConfidentialCmd.MarkFlagRequired("label")
+
+	return issueAuditConfidentialCmd
+}
+
+func (o *options) run() error {
+	client, err := o.factory.GitLabClient()
+	if err != nil {
+		return err
+	}
+
+	target := o.group
+	if target == "" {
+		repo, err := o.factory.BaseRepo()
+		if err != nil {
+			return err
+		}
+		target = repo.FullName()
+	}
+
+	wantConfidential := o.expect == "confidential"
+	issues, err := o.listViolations(client, target, !wantConfidential)
+	if err != nil {
+		return err
+	}
+
+	if o.outputFormat == "json" {
+		out, err := json.Marshal(issues)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(o.io.StdOut, string(out))
+	} else if len(issues) > 0 {
+		fmt.Fprintln(o.io.StdOut, issueutils.DisplayIssueList(o.io, issues, target))
+	}
+
+	c := o.io.Color()
+	actual := "public"
+	if !wantConfidential {
+		actual = "confidential"
+	}
+
+	if len(issues) == 0 {
+		if o.outputFormat == "text" {
+			fmt.Fprintf(o.io.StdOut, "%s No %s issues found in %s.\n", c.GreenCheck(), actual, target)
+		}
+		return nil
+	}
+
+	if !o.fix {
+		fmt.Fprintf(o.io.StdErr, "%s Found %s labeled %s. Use --fix to make them %s.\n", c.FailedIcon(), utils.Pluralize(len(issues), actual+" issue"), strings.Join(o.labels, ", "), o.expect)
+		return cmdutils.SilentError
+	}
+
+	for _, issue := range issues {
+		_, _, err := client.Issues.UpdateIssue(issue.ProjectID, issue.IID, &gitlab.UpdateIssueOptions{
+			Confidential: gitlab.Ptr(wantConfidential),
+		})
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to make issue %s %s.", reference(issue), o.expect))
+		}
+		fmt.Fprintf(o.io.StdErr, "%s Made issue %s %s.\n", c.GreenCheck(), reference(issue), o.expect)
+	}
+	return nil
+}
+
+// listViolations lists the issues with any of the labels, and with the given
+// confidentiality. Issues with several of the labels are listed once.
+func (o *options) listViolations(client *gitlab.Client, target string, confidential bool) ([]*gitlab.Issue, error) {
+	state := gitlab.Ptr("opened")
+	if o.all {
+		state = nil
+	}
+
+	var issues []*gitlab.Issue
+	for _, label := range o.labels {
+		labelIssues, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Issue, *gitlab.Response, error) {
+			if o.group != "" {
+				return client.Issues.ListGroupIssues(o.group, &gitlab.ListGroupIssuesOptions{
+					ListOptions:  gitlab.ListOptions{PerPage: api.MaxPerPage},
+					Labels:       &gitlab.LabelOptions{label},
+					Confidential: gitlab.Ptr(confidential),
+					State:        state,
+				}, p)
+			}
+			return client.Issues.ListProjectIssues(target, &gitlab.ListProjectIssuesOptions{
+				ListOptions:  gitlab.ListOptions{PerPage: api.MaxPerPage},
+				Labels:       &gitlab.LabelOptions{label},
+				Confidential: gitlab.Ptr(confidential),
+				State:        state,
+			}, p)
+		})
+		if err != nil {
+			return nil, cmdutils.WrapError(err, fmt.Sprintf("failed to list the issues labeled %q in %s.", label, target))
+		}
+
+		for _, issue := range labelIssues {
+			if !slices.ContainsFunc(issues, func(i *gitlab.Issue) bool { return i.ID == issue.ID }) {
+				issues = append(issues, issue)
+			}
+		}
+	}
+	return issues, nil
+}
+
+// reference returns the reference of the issue in its group, or its number.
+func reference(issue *gitlab.Issue) string {
+	if issue.References != nil && issue.References.Full != "" {
+		return issue.References.Full
+	}
+	return fmt.Sprintf("#%d", issue.IID)
+}
@@ -0,0 +1,103 @@
+//go:build !integration
+
+package auditconfidential
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestAuditConfidential(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	createdAt := time.Now().Add(-time.Hour)
+	leak := &gitlab.Issue{ID: 11, IID: 1, ProjectID: 5, Title: "Token leak", Labels: []string{"security"}, State: "opened", CreatedAt: &createdAt}
+	xss := &gitlab.Issue{ID: 12, IID: 2, ProjectID: 5, Title: "XSS", Labels: []string{"security", "vulnerability"}, State: "opened", CreatedAt: &createdAt}
+
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockIssues.EXPECT().
+		ListProjectIssues("OWNER/REPO", &gitlab.ListProjectIssuesOptions{
+			ListOptions:  gitlab.ListOptions{PerPage: 100},
+			Labels:       &gitlab.LabelOptions{"security"},
+			Confidential: gitlab.Ptr(false),
+			State:        gitlab.Ptr("opened"),
+		}, gomock.Any()).
+		Return([]*gitlab.Issue{leak, xss}, &gitlab.Response{}, nil).
+		Times(2)
+	testClient.MockIssues.EXPECT().
+		ListProjectIssues("OWNER/REPO", &gitlab.ListProjectIssuesOptions{
+			ListOptions:  gitlab.ListOptions{PerPage: 100},
+			Labels:       &gitlab.LabelOptions{"vulnerability"},
+			Confidential: gitlab.Ptr(false),
+			State:        gitlab.Ptr("opened"),
+		}, gomock.Any()).
+		Return([]*gitlab.Issue{xss}, &gitlab.Response{}, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdAuditConfidential, false, cmdtest.WithGitLabClient(testClient.Client))
+	out, err := exec("--label security,vulnerability")
+	require.ErrorIs(t, err, cmdutils.SilentError)
+	assert.Contains(t, out.String(), "#1\tToken leak\t(security)")
+	assert.Contains(t, out.String(), "#2\tXSS\t(security, vulnerability)")
+	assert.Equal(t, "x Found 2 public issues labeled security, vulnerability. Use --fix to make them confidential.\n", out.Stderr())
+
+	exec = cmdtest.SetupCmdForTest(t, NewCmdAuditConfidential, false, cmdtest.WithGitLabClient(testClient.Client))
+	out, err = exec("--label security --output json")
+	require.ErrorIs(t, err, cmdutils.SilentError)
+	var issues []*gitlab.Issue
+	require.NoError(t, json.Unmarshal(out.OutBuf.Bytes(), &issues))
+	assert.Len(t, issues, 2)
+}
+
+func TestAuditConfidentialFix(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockIssues.EXPECT().
+		ListGroupIssues("mygroup", &gitlab.ListGroupIssuesOptions{
+			ListOptions:  gitlab.ListOptions{PerPage: 100},
+			Labels:       &gitlab.LabelOptions{"security"},
+			Confidential: gitlab.Ptr(false),
+		}, gomock.Any()).
+		Return([]*gitlab.Issue{{
+			ID: 11, IID: 1, ProjectID: 5, Title: "Token leak", CreatedAt: gitlab.Ptr(time.Now()),
+			References: &gitlab.IssueReferences{Full: "mygroup/api#1"},
+		}}, &gitlab.Response{}, nil)
+	testClient.MockIssues.EXPECT().
+		UpdateIssue(int64(5), int64(1), &gitlab.UpdateIssueOptions{Confidential: gitlab.Ptr(true)}).
+		Return(&gitlab.Issue{IID: 1, Confidential: true}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdAuditConfidential, false, cmdtest.WithGitLabClient(testClient.Client))
+	out, err := exec("--label security --group mygroup --all --fix")
+	require.NoError(t, err)
+	assert.Equal(t, "✓ Made issue mygroup/api#1 confidential.\n", out.Stderr())
+}
+
+func TestAuditConfidentialNone(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockIssues.EXPECT().
+		ListProjectIssues("OWNER/REPO", &gitlab.ListProjectIssuesOptions{
+			ListOptions:  gitlab.ListOptions{PerPage: 100},
+			Labels:       &gitlab.LabelOptions{"documentation"},
+			Confidential: gitlab.Ptr(true),
+			State:        gitlab.Ptr("opened"),
+		}, gomock.Any()).
+		Return([]*gitlab.Issue{}, &gitlab.Response{}, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdAuditConfidential, false, cmdtest.WithGitLabClient(testClient.Client))
+	out, err := exec("--label documentation --expect public")
+	require.NoError(t, err)
+	assert.Equal(t, "✓ No confidential issues found in OWNER/REPO.\n", out.String())
+}
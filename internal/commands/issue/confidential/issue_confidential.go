@@ -0,0 +1,78 @@
+package confidential
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+func NewCmdConfidential(f cmdutils.Factory) *cobra.Command {
+	var on, off bool
+
+	issueConfidentialCmd := &cobra.Command{
+		Use:   "confidential <id>... {--on | --off}",
+		Short: `Make issues confidential, or public.`,
+		Long: heredoc.Doc(`
+			Make issues confidential, or public.
+
+			Confidential issues are visible only to members of the project with at least the
+			Planner role, and to the author and assignees of the issue.
+		`),
+		Example: heredoc.Doc(`
+			$ glab issue confidential 42 --on
+			$ glab issue confidential 42 43 --off
+			$ glab issue confidential https://gitlab.com/OWNER/REPO/-/issues/42 --on
+		`),
+		Args: cobra.MinimumNArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c := f.IO().Color()
+			client, err := f.GitLabClient()
+			if err != nil {
+				return err
+			}
+
+			issues, repo, err := issueutils.IssuesFromArgs(f.ApiClient, client, f.BaseRepo, f.DefaultHostname(), args)
+			if err != nil {
+				return err
+			}
+
+			visibility := "public"
+			if on {
+				visibility = "confidential"
+			}
+
+			for _, issue := range issues {
+				if issue.Confidential == on {
+					fmt.Fprintf(f.IO().StdOut, "Issue #%d is already %s.\n", issue.IID, visibility)
+					continue
+				}
+
+				_, _, err := client.Issues.UpdateIssue(repo.FullName(), issue.IID, &gitlab.UpdateIssueOptions{
+					Confidential: gitlab.Ptr(on),
+				})
+				if err != nil {
+					return cmdutils.WrapError(err, fmt.Sprintf("failed to make issue #%d %s.", issue.IID, visibility))
+				}
+				fmt.Fprintf(f.IO().StdOut, "%s Made issue #%d %s.\n", c.GreenCheck(), issue.IID, visibility)
+			}
+			return nil
+		},
+	}
+
+	issueConfidentialCmd.Flags().BoolVar(&on, "on", false, "Make the issues confidential.")
+	issueConfidentialCmd.Flags().BoolVar(&off, "off", false, "Make the issues public.")
+	issueConfidentialCmd.MarkFlagsOneRequired("on", "off")
+	issueConfidentialCmd.MarkFlagsMutuallyExclusive("on", "off")
+
+	return issueConfidentialCmd
+}
@@ -0,0 +1,67 @@
+//go:build !integration
+
+package confidential
+
+import (
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestIssueConfidential(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockIssues.EXPECT().
+		GetIssue("OWNER/REPO", int64(1), gomock.Any()).
+		Return(&gitlab.Issue{IID: 1, Confidential: false}, nil, nil)
+	testClient.MockIssues.EXPECT().
+		GetIssue("OWNER/REPO", int64(2), gomock.Any()).
+		Return(&gitlab.Issue{IID: 2, Confidential: true}, nil, nil)
+	testClient.MockIssues.EXPECT().
+		UpdateIssue("OWNER/REPO", int64(1), &gitlab.UpdateIssueOptions{Confidential: gitlab.Ptr(true)}).
+		Return(&gitlab.Issue{IID: 1, Confidential: true}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdConfidential, true, cmdtest.WithGitLabClient(testClient.Client))
+	out, err := exec("1 2 --on")
+	require.NoError(t, err)
+	assert.Equal(t, heredoc.Doc(`
+		✓ Made issue #1 confidential.
+		Issue #2 is already confidential.
+	`), out.String())
+}
+
+func TestIssueConfidentialOff(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockIssues.EXPECT().
+		GetIssue("OWNER/REPO", int64(1), gomock.Any()).
+		Return(&gitlab.Issue{IID: 1, Confidential: true}, nil, nil)
+	testClient.MockIssues.EXPECT().
+		UpdateIssue("OWNER/REPO", int64(1), &gitlab.UpdateIssueOptions{Confidential: gitlab.Ptr(false)}).
+		Return(&gitlab.Issue{IID: 1}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdConfidential, true, cmdtest.WithGitLabClient(testClient.Client))
+	out, err := exec("1 --off")
+	require.NoError(t, err)
+	assert.Equal(t, "✓ Made issue #1 public.\n", out.String())
+}
+
+func TestIssueConfidentialFlags(t *testing.T) {
+	exec := cmdtest.SetupCmdForTest(t, NewCmdConfidential, true)
+
+	_, err := exec("1")
+	require.ErrorContains(t, err, "at least one of the flags in the group [on off] is required")
+
+	_, err = exec("1 --on --off")
+	require.ErrorContains(t, err, "if any flags in the group [on off] are set none of the others can be")
+}
@@ -60,6 +60,9 @@ type options struct {
 	yes           bool
 	web           bool
 	recover       bool
+	template      string
+
+	requiredSections []string
 
 	io           *iostreams.IOStreams
 	baseRepo     func() (glrepo.Interface, error)
@@ -89,6 +92,7 @@ func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
 			$ glab issue create -m release-2.0.0 -t "we need this feature" --label important
 			$ glab issue new -t "Fix CVE-YYYY-XXXX" -l security --linked-mr 123
 			$ glab issue create -m release-1.0.1 -t "security fix" --label security --web --recover
+			$ glab issue create --template Bug --required-sections "Steps to reproduce,Expected behavior"
 		`),
 		Args: cobra.ExactArgs(0),
 		Annotations: map[string]string{
@@ -104,9 +108,21 @@ func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
 			if err != nil {
 				return err
 			}
+			if err := cmdutils.ApplyBodyFile(cmd, opts.io, "description"); err != nil {
+				return err
+			}
 			hasTitle := cmd.Flags().Changed("title")
 			hasDescription := cmd.Flags().Changed("description")
 
+			if opts.template != "" && hasDescription {
+				return &cmdutils.FlagError{Err: errors.New("'--template' can't be used with '--description'.")}
+			}
+			if !cmd.Flags().Changed("required-sections") {
+				setting, _ := opts.config().Get(repo.RepoHost(), "issue_required_sections")
+				opts.requiredSections = splitSections(setting)
+			}
+			hasDescription = hasDescription || opts.template != ""
+
 			// disable interactive mode if title and description are explicitly defined
 			opts.isInteractive = !(hasTitle && hasDescription)
 
@@ -147,6 +163,7 @@ func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
 	}
 	issueCreateCmd.Flags().StringVarP(&opts.Title, "title", "t", "", "Issue title.")
 	issueCreateCmd.Flags().StringVarP(&opts.Description, "description", "d", "", "Issue description.")
+	cmdutils.AddBodyFileFlag(issueCreateCmd, "description")
 	issueCreateCmd.Flags().StringSliceVarP(&opts.Labels, "label", "l", []string{}, "Add label by name. Multiple labels can be comma-separated or specified by repeating the flag.")
 	issueCreateCmd.Flags().StringSliceVarP(&opts.Assignees, "assignee", "a", []string{}, "Assign issue to people by their `usernames`. Multiple usernames can be comma-separated or specified by repeating the flag.")
 	issueCreateCmd.Flags().StringVarP(&opts.MilestoneFlag, "milestone", "m", "", "The global ID or title of a milestone to assign.")
@@ -163,6 +180,8 @@ func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
 	issueCreateCmd.Flags().BoolVar(&opts.recover, "recover", false, "Save the options to a file if the issue fails to be created. If the file exists, the options will be loaded from the recovery file. (EXPERIMENTAL)")
 	issueCreateCmd.Flags().Int64VarP(&opts.EpicID, "epic", "", 0, "ID of the epic to add the issue to.")
 	issueCreateCmd.Flags().StringVarP(&opts.DueDate, "due-date", "", "", "A date in 'YYYY-MM-DD' format.")
+	issueCreateCmd.Flags().StringVar(&opts.template, "template", "", "Use a description template from .gitlab/issue_templates/ of the default branch by name, such as 'Bug'.")
+	issueCreateCmd.Flags().StringSliceVar(&opts.requiredSections, "required-sections", nil, "Warn before submitting when these headings of the template were deleted from the description. Use 'all' for every heading. Defaults to the issue_required_sections setting.")
 
 	return issueCreateCmd
 }
@@ -201,10 +220,18 @@ var createRun = func(opts *options) error {
 		}
 	}
 
+	if opts.template != "" {
+		templateName = opts.template
+		templateContents, err = opts.loadTemplate(templateName)
+		if err != nil {
+			return err
+		}
+	}
+
 	if opts.isInteractive {
 		// Step 1: Template selection (if not using --no-editor and description is empty)
-		if opts.Description == "" && !opts.noEditor {
-			templateNames, err := cmdutils.ListGitLabTemplates(cmdutils.IssueTemplate)
+		if opts.Description == "" && !opts.noEditor && templateName == "" {
+			templateNames, err := opts.listTemplates()
 			if err != nil {
 				return fmt.Errorf("error getting templates: %w", err)
 			}
@@ -219,9 +246,9 @@ var createRun = func(opts *options) error {
 
 			if selectedTemplate != blankIssueOption {
 				templateName = selectedTemplate
-				templateContents, err = cmdutils.LoadGitLabTemplate(cmdutils.IssueTemplate, templateName)
+				templateContents, err = opts.loadTemplate(templateName)
 				if err != nil {
-					return fmt.Errorf("failed to get template contents: %w", err)
+					return err
 				}
 			}
 		}
@@ -248,6 +275,11 @@ var createRun = func(opts *options) error {
 
 			// Add description field if needed
 			if needsDescription {
+				// Set initial value from template
+				if templateContents != "" {
+					opts.Description = templateContents
+				}
+
 				if opts.noEditor {
 					// Use multiline text input
 					fields = append(fields, huh.NewText().
@@ -260,11 +292,6 @@ var createRun = func(opts *options) error {
 						return err
 					}
 
-					// Set initial value from template
-					if templateContents != "" {
-						opts.Description = templateContents
-					}
-
 					textField := huh.NewText().
 						Title("Description").
 						Value(&opts.Description).
@@ -289,6 +316,13 @@ var createRun = func(opts *options) error {
 		}
 	} else if opts.Title == "" {
 		return fmt.Errorf("title can't be blank")
+	} else if templateContents != "" {
+		opts.Description = templateContents
+	}
+
+	if missing := cmdutils.MissingSections(templateContents, opts.Description, opts.requiredSections); len(missing) > 0 {
+		fmt.Fprintf(opts.io.StdErr, "%s The description is missing required sections of template %q: %s.\n",
+			opts.io.Color().WarnIcon(), templateName, strings.Join(missing, ", "))
 	}
 
 	var action cmdutils.Action
@@ -422,6 +456,53 @@ var createRun = func(opts *options) error {
 	return errors.New("expected to cancel, preview in browser, add metadata, or submit")
 }
 
+// listTemplates lists the issue templates of the default branch of the project,
+// or of the working tree if they can't be fetched.
+func (opts *options) listTemplates() ([]string, error) {
+	client, err := opts.gitlabClient()
+	if err != nil {
+		return nil, err
+	}
+	names, err := cmdutils.ListProjectTemplates(client, opts.baseProject.PathWithNamespace, cmdutils.IssueTemplate, opts.baseProject.DefaultBranch)
+	if err != nil {
+		fmt.Fprintf(opts.io.StdErr, "%s Could not fetch the issue templates of the project, using the local ones: %v\n", opts.io.Color().WarnIcon(), err)
+		return cmdutils.ListGitLabTemplates(cmdutils.IssueTemplate)
+	}
+	return names, nil
+}
+
+// loadTemplate loads an issue template from the default branch of the project,
+// or from the working tree if it can't be fetched.
+func (opts *options) loadTemplate(name string) (string, error) {
+	client, err := opts.gitlabClient()
+	if err != nil {
+		return "", err
+	}
+	content, err := cmdutils.LoadProjectTemplate(client, opts.baseProject.PathWithNamespace, cmdutils.IssueTemplate, name, opts.baseProject.DefaultBranch)
+	if err != nil {
+		fmt.Fprintf(opts.io.StdErr, "%s Could not fetch the issue template %q of the project, using the local one: %v\n", opts.io.Color().WarnIcon(), name, err)
+		content, err = cmdutils.LoadGitLabTemplate(cmdutils.IssueTemplate, name)
+		if err != nil {
+			return "", fmt.Errorf("failed to get template contents: %w", err)
+		}
+	}
+	if content == "" {
+		return "", fmt.Errorf("template %q not found in .gitlab/%s/.", name, cmdutils.IssueTemplate)
+	}
+	return content, nil
+}
+
+// splitSections splits the comma-separated headings of the issue_required_sections setting.
+func splitSections(setting string) []string {
+	var sections []string
+	for section := range strings.SplitSeq(setting, ",") {
+		if section = strings.TrimSpace(section); section != "" {
+			sections = append(sections, section)
+		}
+	}
+	return sections
+}
+
 func postCreateActions(apiClient *gitlab.Client, issue *gitlab.Issue, opts *options, repo glrepo.Interface) error {
 	if len(opts.LinkedIssues) > 0 {
 		for _, targetIssueIID := range opts.LinkedIssues {
@@ -4,6 +4,7 @@ package create
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -76,3 +77,39 @@ func TestIssueCreateWhenIssuesDisabled(t *testing.T) {
 		"Make sure issues are enabled for the \"OWNER/REPO\" project, and if required, you are a member of the project.\n",
 		output.Stderr())
 }
+
+func TestIssueCreateWithTemplate(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockProjects.EXPECT().
+		GetProject("OWNER/REPO", gomock.Any()).
+		Return(&gitlab.Project{
+			ID:                37777023,
+			PathWithNamespace: "OWNER/REPO",
+			DefaultBranch:     "main",
+			WebURL:            "https://gitlab.com/OWNER/REPO",
+			IssuesEnabled:     true,
+		}, nil, nil)
+	testClient.MockRepositoryFiles.EXPECT().
+		GetRawFile("OWNER/REPO", ".gitlab/issue_templates/Bug.md", &gitlab.GetRawFileOptions{Ref: gitlab.Ptr("main")}).
+		Return([]byte("## Summary\n\n## Steps to reproduce\n"), nil, nil)
+	testClient.MockIssues.EXPECT().
+		CreateIssue("OWNER/REPO", gomock.Any()).
+		DoAndReturn(func(pid any, opts *gitlab.CreateIssueOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error) {
+			assert.Equal(t, "## Summary\n\n## Steps to reproduce", *opts.Description)
+			return &gitlab.Issue{IID: 1, Title: *opts.Title, CreatedAt: gitlab.Ptr(time.Now()), WebURL: "https://gitlab.com/OWNER/REPO/-/issues/1"}, nil, nil
+		})
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdCreate, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	output, err := exec(`--title "test title" --template Bug --required-sections all --yes`)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://gitlab.com/OWNER/REPO/-/issues/1\n", output.String())
+	assert.NotContains(t, output.Stderr(), "missing required sections")
+}
+
+func TestIssueCreateTemplateWithDescription(t *testing.T) {
+	exec := cmdtest.SetupCmdForTest(t, NewCmdCreate, false)
+
+	_, err := exec(`--title "test title" --template Bug --description "test"`)
+	assert.EqualError(t, err, "'--template' can't be used with '--description'.")
+}
@@ -0,0 +1,246 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/pagination"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	format    string
+	file      string
+	state     string
+	labels    []string
+	milestone string
+	assignee  string
+	author    string
+	search    string
+
+	resume     bool
+	maxRetries int
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdExport(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	issueExportCmd := &cobra.Command{
+		Use:   "export [flags]",
+		Short: `Export the issues of a project to CSV or JSON.`,
+		Long: heredoc.Doc(`
+			Export all the issues of a project that match the filters, with their
+			assignees, labels, milestone, weight, and time tracking.
+
+			Unlike 'glab issue list', every page of issues is fetched. The exported
+			file can be imported into another project with 'glab issue import'.
+
+			With '--resume', the issues of each page are recorded as it completes.
+			When a run is interrupted, running the same command again continues
+			from the last completed page. Pages that fail with HTTP 429 or a server
+			error are retried up to '--max-retries' times.
+		`),
+		Example: heredoc.Doc(`
+			# Export all the issues to a CSV file
+			$ glab issue export --file issues.csv
+
+			# Export the open issues labeled "bug" as JSON
+			$ glab issue export --format json --state opened --label bug > bugs.json
+
+			# Export the issues of a large project, continuing an interrupted run
+			$ glab issue export --resume --file issues.csv
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.maxRetries < 0 {
+				return &cmdutils.FlagError{Err: errors.New("the '--max-retries' option can't be negative.")}
+			}
+			return opts.run(cmd.Context())
+		},
+	}
+
+	fl := issueExportCmd.Flags()
+	fl.VarP(cmdutils.NewEnumValue([]string{"csv", "json"}, "csv", &opts.format), "format", "F", "Format of the export: csv, json.")
+	fl.StringVarP(&opts.file, "file", "f", "", "Write the export to this file instead of the standard output.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"opened", "closed", "all"}, "all", &opts.state), "state", "s", "Export the issues in this state: opened, closed, all.")
+	fl.StringSliceVarP(&opts.labels, "label", "l", nil, "Export the issues with all these labels. Multiple labels can be comma-separated or specified by repeating the flag.")
+	fl.StringVarP(&opts.milestone, "milestone", "m", "", "Export the issues of the milestone with this title.")
+	fl.StringVarP(&opts.assignee, "assignee", "a", "", "Export the issues assigned to this username.")
+	fl.StringVar(&opts.author, "author", "", "Export the issues created by this username.")
+	fl.StringVar(&opts.search, "search", "", "Export the issues with this string in their title or description.")
+	fl.BoolVar(&opts.resume, "resume", false, "Record the progress of the export, and continue an interrupted run of the same export from the last completed page.")
+	fl.IntVar(&opts.maxRetries, "max-retries", 3, "Maximum number of retries of a page that fails with HTTP 429 or a server error.")
+
+	return issueExportCmd
+}
+
+func (o *options) run(ctx context.Context) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	listOpts := &gitlab.ListProjectIssuesOptions{
+		ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage},
+		OrderBy:     gitlab.Ptr("created_at"),
+		Sort:        gitlab.Ptr("asc"),
+	}
+	if o.state != "all" {
+		listOpts.State = gitlab.Ptr(o.state)
+	}
+	if len(o.labels) > 0 {
+		listOpts.Labels = (*gitlab.LabelOptions)(&o.labels)
+	}
+	if o.milestone != "" {
+		listOpts.Milestone = gitlab.Ptr(o.milestone)
+	}
+	if o.assignee != "" {
+		listOpts.AssigneeUsername = gitlab.Ptr(o.assignee)
+	}
+	if o.author != "" {
+		listOpts.AuthorUsername = gitlab.Ptr(o.author)
+	}
+	if o.search != "" {
+		listOpts.Search = gitlab.Ptr(o.search)
+	}
+
+	records, err := o.listRecords(ctx, client, repo, listOpts)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to list the issues of %s.", repo.FullName()))
+	}
+
+	var out io.Writer = o.io.StdOut
+	if o.file != "" {
+		file, err := os.Create(o.file)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		out = file
+	}
+
+	if o.format == "json" {
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(records)
+	} else {
+		err = issueutils.WriteRecordsCSV(out, records)
+	}
+	if err != nil {
+		return err
+	}
+
+	if o.file != "" {
+		fmt.Fprintf(o.io.StdErr, "%s Exported %s of %s to %s.\n", o.io.Color().GreenCheck(), utils.Pluralize(len(records), "issue"), repo.FullName(), o.file)
+	}
+	return nil
+}
+
+// listRecords fetches every page of issues. With --resume, it continues from the
+// journal of an interrupted run, and records each completed page in it.
+func (o *options) listRecords(ctx context.Context, client *gitlab.Client, repo glrepo.Interface, listOpts *gitlab.ListProjectIssuesOptions) ([]*issueutils.Record, error) {
+	records := []*issueutils.Record{}
+	listOpts.Page = 1
+
+	var j *pagination.Journal
+	if o.resume {
+		key, err := pagination.JournalKey("issue export", struct {
+			Host    string                           `json:"host"`
+			Project string                           `json:"project"`
+			Options *gitlab.ListProjectIssuesOptions `json:"options"`
+		}{repo.RepoHost(), repo.FullName(), listOpts})
+		if err != nil {
+			return nil, err
+		}
+		j, err = pagination.OpenJournal(key, "issue export "+repo.FullName())
+		if err != nil {
+			return nil, err
+		}
+		if j.Next != "" {
+			if listOpts.Page, err = strconv.ParseInt(j.Next, 10, 64); err != nil {
+				return nil, fmt.Errorf("invalid journal: %w", err)
+			}
+			if records, err = pagination.LoadItems[*issueutils.Record](j); err != nil {
+				return nil, err
+			}
+			fmt.Fprintf(o.io.StdErr, "Resuming after page %d.\n", j.Pages)
+		}
+	}
+
+	for {
+		var issues []*gitlab.Issue
+		var resp *gitlab.Response
+		_, err := pagination.Retry(ctx, o.io.StdErr, o.maxRetries, func() (*http.Response, error) {
+			var err error
+			// Failed pages are retried by pagination.Retry, up to --max-retries times.
+			issues, resp, err = client.Issues.ListProjectIssues(repo.FullName(), listOpts, gitlab.WithContext(ctx), gitlab.WithRequestRetry(noRetry))
+			if resp == nil {
+				return nil, err
+			}
+			return resp.Response, err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		page := make([]any, 0, len(issues))
+		for _, issue := range issues {
+			record := issueutils.NewRecord(issue)
+			records = append(records, record)
+			page = append(page, record)
+		}
+
+		if resp.NextPage == 0 {
+			if j != nil {
+				if err := j.Remove(); err != nil {
+					return nil, fmt.Errorf("failed to remove the journal: %w", err)
+				}
+			}
+			return records, nil
+		}
+
+		listOpts.Page = resp.NextPage
+		if j != nil {
+			if err := j.Save(strconv.FormatInt(listOpts.Page, 10), page...); err != nil {
+				return nil, fmt.Errorf("failed to update the journal: %w", err)
+			}
+		}
+	}
+}
+
+func noRetry(context.Context, *http.Response, error) (bool, error) {
+	return false, nil
+}
@@ -0,0 +1,230 @@
+//go:build !integration
+
+package export
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func testIssues() []*gitlab.Issue {
+	created := time.Date(2025, time.March, 4, 10, 0, 0, 0, time.UTC)
+	dueDate := gitlab.ISOTime(time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC))
+	return []*gitlab.Issue{
+		{
+			IID:          1,
+			Title:        "Login fails",
+			Description:  "It fails, \"sometimes\".\nOften.",
+			State:        "opened",
+			Author:       &gitlab.IssueAuthor{Username: "jdoe"},
+			Assignees:    []*gitlab.IssueAssignee{{Username: "alice"}, {Username: "bob"}},
+			Labels:       gitlab.Labels{"bug", "backend"},
+			Milestone:    &gitlab.Milestone{Title: "1.0"},
+			Weight:       3,
+			DueDate:      &dueDate,
+			TimeStats:    &gitlab.TimeStats{TimeEstimate: 7200, TotalTimeSpent: 1800},
+			CreatedAt:    &created,
+			UpdatedAt:    &created,
+			WebURL:       "https://gitlab.com/OWNER/REPO/-/issues/1",
+			Confidential: true,
+		},
+		{
+			IID:       2,
+			Title:     "Add dark mode",
+			State:     "closed",
+			Author:    &gitlab.IssueAuthor{Username: "jdoe"},
+			CreatedAt: &created,
+			UpdatedAt: &created,
+			ClosedAt:  &created,
+			WebURL:    "https://gitlab.com/OWNER/REPO/-/issues/2",
+		},
+	}
+}
+
+func TestIssueExport(t *testing.T) {
+	testCases := []struct {
+		name    string
+		cli     string
+		wantOut string
+	}{
+		{
+			name: "csv",
+			cli:  "",
+			wantOut: "iid,title,description,state,author,assignees,labels,milestone,weight,due_date,confidential,time_estimate,total_time_spent,created_at,updated_at,closed_at,web_url\n" +
+				"1,Login fails,\"It fails, \"\"sometimes\"\".\nOften.\",opened,jdoe,\"alice,bob\",\"bug,backend\",1.0,3,2025-04-01,true,7200,1800,2025-03-04T10:00:00Z,2025-03-04T10:00:00Z,,https://gitlab.com/OWNER/REPO/-/issues/1\n" +
+				"2,Add dark mode,,closed,jdoe,,,,0,,false,0,0,2025-03-04T10:00:00Z,2025-03-04T10:00:00Z,2025-03-04T10:00:00Z,https://gitlab.com/OWNER/REPO/-/issues/2\n",
+		},
+		{
+			name: "json",
+			cli:  "--format json",
+			wantOut: `[
+  {
+    "iid": 1,
+    "title": "Login fails",
+    "description": "It fails, \"sometimes\".\nOften.",
+    "state": "opened",
+    "author": "jdoe",
+    "assignees": [
+      "alice",
+      "bob"
+    ],
+    "labels": [
+      "bug",
+      "backend"
+    ],
+    "milestone": "1.0",
+    "weight": 3,
+    "due_date": "2025-04-01",
+    "confidential": true,
+    "time_estimate": 7200,
+    "total_time_spent": 1800,
+    "created_at": "2025-03-04T10:00:00Z",
+    "updated_at": "2025-03-04T10:00:00Z",
+    "closed_at": "",
+    "web_url": "https://gitlab.com/OWNER/REPO/-/issues/1"
+  },
+  {
+    "iid": 2,
+    "title": "Add dark mode",
+    "description": "",
+    "state": "closed",
+    "author": "jdoe",
+    "assignees": [],
+    "labels": [],
+    "milestone": "",
+    "weight": 0,
+    "due_date": "",
+    "confidential": false,
+    "time_estimate": 0,
+    "total_time_spent": 0,
+    "created_at": "2025-03-04T10:00:00Z",
+    "updated_at": "2025-03-04T10:00:00Z",
+    "closed_at": "2025-03-04T10:00:00Z",
+    "web_url": "https://gitlab.com/OWNER/REPO/-/issues/2"
+  }
+]
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			testClient.MockIssues.EXPECT().ListProjectIssues("OWNER/REPO", gomock.Any(), gomock.Any()).
+				DoAndReturn(func(pid any, opts *gitlab.ListProjectIssuesOptions, _ ...gitlab.RequestOptionFunc) ([]*gitlab.Issue, *gitlab.Response, error) {
+					assert.Nil(t, opts.State)
+					return testIssues(), &gitlab.Response{}, nil
+				})
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdExport, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			out, err := exec(tc.cli)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantOut, out.String())
+		})
+	}
+}
+
+func TestIssueExport_FiltersToFile(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockIssues.EXPECT().ListProjectIssues("OWNER/REPO", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(pid any, opts *gitlab.ListProjectIssuesOptions, _ ...gitlab.RequestOptionFunc) ([]*gitlab.Issue, *gitlab.Response, error) {
+			assert.Equal(t, "opened", *opts.State)
+			assert.Equal(t, gitlab.LabelOptions{"bug"}, *opts.Labels)
+			assert.Equal(t, "1.0", *opts.Milestone)
+			assert.Equal(t, "alice", *opts.AssigneeUsername)
+			return testIssues()[:1], &gitlab.Response{}, nil
+		})
+
+	file := filepath.Join(t.TempDir(), "issues.csv")
+	exec := cmdtest.SetupCmdForTest(t, NewCmdExport, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	out, err := exec("--state opened --label bug --milestone 1.0 --assignee alice --file " + file)
+	require.NoError(t, err)
+	assert.Empty(t, out.String())
+	assert.Equal(t, "✓ Exported 1 issue of OWNER/REPO to "+file+".\n", out.Stderr())
+
+	content, err := os.ReadFile(file)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "1,Login fails,")
+}
+
+func TestIssueExport_Resume(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	expectPage := func(testClient *gitlabtesting.TestClient, page int64, issues []*gitlab.Issue, resp *gitlab.Response, err error) {
+		testClient.MockIssues.EXPECT().ListProjectIssues("OWNER/REPO", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(pid any, opts *gitlab.ListProjectIssuesOptions, _ ...gitlab.RequestOptionFunc) ([]*gitlab.Issue, *gitlab.Response, error) {
+				assert.Equal(t, page, opts.Page)
+				return issues, resp, err
+			})
+	}
+
+	// The first run is interrupted by a server error on the second page.
+	testClient := gitlabtesting.NewTestClient(t)
+	expectPage(testClient, 1, testIssues()[:1], &gitlab.Response{NextPage: 2}, nil)
+	expectPage(testClient, 2, nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusServiceUnavailable}}, errors.New("503 Service Unavailable"))
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdExport, false, cmdtest.WithGitLabClient(testClient.Client))
+	out, err := exec("--format json --resume --max-retries 0")
+	require.Error(t, err)
+	assert.Empty(t, out.String())
+
+	// The second run continues from the second page, and exports the issues of both runs.
+	testClient = gitlabtesting.NewTestClient(t)
+	expectPage(testClient, 2, testIssues()[1:], &gitlab.Response{}, nil)
+
+	exec = cmdtest.SetupCmdForTest(t, NewCmdExport, false, cmdtest.WithGitLabClient(testClient.Client))
+	out, err = exec("--format json --resume --max-retries 0")
+	require.NoError(t, err)
+	assert.Equal(t, "Resuming after page 1.\n", out.Stderr())
+
+	var records []struct {
+		IID int64 `json:"iid"`
+	}
+	require.NoError(t, json.Unmarshal(out.OutBuf.Bytes(), &records))
+	require.Len(t, records, 2)
+	assert.Equal(t, int64(1), records[0].IID)
+	assert.Equal(t, int64(2), records[1].IID)
+
+	// Once completed, the next run starts from the first page again.
+	testClient = gitlabtesting.NewTestClient(t)
+	expectPage(testClient, 1, testIssues(), &gitlab.Response{}, nil)
+
+	exec = cmdtest.SetupCmdForTest(t, NewCmdExport, false, cmdtest.WithGitLabClient(testClient.Client))
+	_, err = exec("--format json --resume")
+	require.NoError(t, err)
+}
+
+func TestIssueExport_RetriesFailedPages(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+	gomock.InOrder(
+		testClient.MockIssues.EXPECT().ListProjectIssues("OWNER/REPO", gomock.Any(), gomock.Any()).
+			Return(nil, &gitlab.Response{Response: &http.Response{
+				StatusCode: http.StatusTooManyRequests,
+				Header:     http.Header{"Retry-After": []string{"0"}},
+			}}, errors.New("429 Too Many Requests")),
+		testClient.MockIssues.EXPECT().ListProjectIssues("OWNER/REPO", gomock.Any(), gomock.Any()).
+			Return(testIssues(), &gitlab.Response{}, nil),
+	)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdExport, false, cmdtest.WithGitLabClient(testClient.Client))
+	out, err := exec("--max-retries 1")
+	require.NoError(t, err)
+	assert.Equal(t, "glab: HTTP 429, retrying in 0s (1/1)\n", out.Stderr())
+	assert.Contains(t, out.String(), "2,Add dark mode,")
+}
@@ -0,0 +1,293 @@
+package issueimport
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+// sleep waits between two created issues. Tests replace it.
+var sleep = time.Sleep
+
+type options struct {
+	file      string
+	format    string
+	dryRun    bool
+	rateLimit int
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+// pendingIssue is a record of the file, with the options to create its issue.
+type pendingIssue struct {
+	record *issueutils.Record
+	create *gitlab.CreateIssueOptions
+}
+
+func NewCmdImport(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	issueImportCmd := &cobra.Command{
+		Use:   "import <file> [flags]",
+		Short: `Create issues from a CSV or JSON file.`,
+		Long: heredoc.Doc(`
+			Create issues from a CSV or JSON file, like the ones written by
+			'glab issue export'.
+
+			Only the title of the issues is required. The description, assignees,
+			labels, milestone, weight, due date, confidentiality, time estimate, and
+			time spent are imported too, and issues in the closed state are closed
+			after they're created. In CSV files, assignees and labels are comma-separated.
+			The author, dates, and IDs of the issues are ignored.
+
+			Assignees and milestones are checked before any issue is created. Use
+			--dry-run to preview the issues without creating them.
+		`),
+		Example: heredoc.Doc(`
+			# Preview the issues of a CSV file
+			$ glab issue import issues.csv --dry-run
+
+			# Copy the issues of a project to another one
+			$ glab issue export -R group/old --format json --file issues.json
+			$ glab issue import issues.json -R group/new
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.file = args[0]
+			if opts.format == "" {
+				switch strings.ToLower(filepath.Ext(opts.file)) {
+				case ".csv":
+					opts.format = "csv"
+				case ".json":
+					opts.format = "json"
+				default:
+					return &cmdutils.FlagError{Err: fmt.Errorf("can't tell the format of %s. Use --format.", opts.file)}
+				}
+			}
+			if opts.rateLimit < 0 {
+				return &cmdutils.FlagError{Err: errors.New("--rate-limit can't be negative.")}
+			}
+			return opts.run()
+		},
+	}
+
+	fl := issueImportCmd.Flags()
+	fl.VarP(cmdutils.NewEnumValue([]string{"csv", "json"}, "", &opts.format), "format", "F", "Format of the file: csv, json. Defaults to the extension of the file.")
+	fl.BoolVar(&opts.dryRun, "dry-run", false, "Print the issues that would be created, without creating them.")
+	fl.IntVar(&opts.rateLimit, "rate-limit", 60, "Maximum number of issues to create per minute. Use 0 for no limit.")
+
+	return issueImportCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(o.file)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var records []*issueutils.Record
+	if o.format == "json" {
+		records, err = issueutils.ReadRecordsJSON(file)
+	} else {
+		records, err = issueutils.ReadRecordsCSV(file)
+	}
+	if err != nil {
+		return fmt.Errorf("could not read %s: %w", o.file, err)
+	}
+	if len(records) == 0 {
+		fmt.Fprintf(o.io.StdErr, "No issues to import in %s.\n", o.file)
+		return nil
+	}
+
+	pending, err := prepare(client, repo, records)
+	if err != nil {
+		return err
+	}
+
+	c := o.io.Color()
+	if o.dryRun {
+		for _, p := range pending {
+			fmt.Fprintf(o.io.StdOut, "Would create issue %q%s\n", p.record.Title, describe(p.record))
+		}
+		fmt.Fprintf(o.io.StdErr, "%s in %s. Run again without --dry-run to create them.\n", utils.Pluralize(len(pending), "issue"), repo.FullName())
+		return nil
+	}
+
+	for i, p := range pending {
+		if i > 0 && o.rateLimit > 0 {
+			sleep(time.Minute / time.Duration(o.rateLimit))
+		}
+		issue, err := create(client, repo.FullName(), p)
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to create issue %q after creating %s.", p.record.Title, utils.Pluralize(i, "issue")))
+		}
+		fmt.Fprintf(o.io.StdOut, "%s Created issue #%d %s\n", c.GreenCheck(), issue.IID, issue.Title)
+	}
+	fmt.Fprintf(o.io.StdErr, "Imported %s into %s.\n", utils.Pluralize(len(pending), "issue"), repo.FullName())
+	return nil
+}
+
+// prepare checks the records and resolves their assignees and milestones, so
+// that no issue is created when one of them is invalid.
+func prepare(client *gitlab.Client, repo glrepo.Interface, records []*issueutils.Record) ([]*pendingIssue, error) {
+	users := map[string]int64{}
+	milestones := map[string]int64{}
+
+	pending := make([]*pendingIssue, 0, len(records))
+	for i, r := range records {
+		if strings.TrimSpace(r.Title) == "" {
+			return nil, fmt.Errorf("issue %d has no title.", i+1)
+		}
+		create := &gitlab.CreateIssueOptions{
+			Title:       gitlab.Ptr(r.Title),
+			Description: gitlab.Ptr(r.Description),
+		}
+		if len(r.Labels) > 0 {
+			create.Labels = (*gitlab.LabelOptions)(&r.Labels)
+		}
+		if r.Weight > 0 {
+			create.Weight = gitlab.Ptr(r.Weight)
+		}
+		if r.Confidential {
+			create.Confidential = gitlab.Ptr(true)
+		}
+		if r.DueDate != "" {
+			dueDate, err := gitlab.ParseISOTime(r.DueDate)
+			if err != nil {
+				return nil, fmt.Errorf("issue %q has an invalid due date %q. Use the YYYY-MM-DD format.", r.Title, r.DueDate)
+			}
+			create.DueDate = gitlab.Ptr(dueDate)
+		}
+
+		if len(r.Assignees) > 0 {
+			ids := make([]int64, 0, len(r.Assignees))
+			for _, username := range r.Assignees {
+				if _, ok := users[username]; !ok {
+					user, err := api.UserByName(client, username)
+					if err != nil {
+						return nil, cmdutils.WrapError(err, fmt.Sprintf("failed to find the assignee %s of issue %q.", username, r.Title))
+					}
+					users[username] = user.ID
+				}
+				ids = append(ids, users[username])
+			}
+			create.AssigneeIDs = &ids
+		}
+
+		if r.Milestone != "" {
+			if _, ok := milestones[r.Milestone]; !ok {
+				id, err := cmdutils.ParseMilestone(client, repo, r.Milestone)
+				if err != nil {
+					return nil, cmdutils.WrapError(err, fmt.Sprintf("failed to find the milestone %s of issue %q.", r.Milestone, r.Title))
+				}
+				milestones[r.Milestone] = id
+			}
+			create.MilestoneID = gitlab.Ptr(milestones[r.Milestone])
+		}
+
+		pending = append(pending, &pendingIssue{record: r, create: create})
+	}
+	return pending, nil
+}
+
+// create creates the issue of a record, then sets its time tracking and state,
+// which can't be set on creation.
+func create(client *gitlab.Client, repo string, p *pendingIssue) (*gitlab.Issue, error) {
+	issue, _, err := client.Issues.CreateIssue(repo, p.create)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.record.TimeEstimate > 0 {
+		_, _, err := client.Issues.SetTimeEstimate(repo, issue.IID, &gitlab.SetTimeEstimateOptions{
+			Duration: gitlab.Ptr(fmt.Sprintf("%ds", p.record.TimeEstimate)),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if p.record.TotalTimeSpent > 0 {
+		_, _, err := client.Issues.AddSpentTime(repo, issue.IID, &gitlab.AddSpentTimeOptions{
+			Duration: gitlab.Ptr(fmt.Sprintf("%ds", p.record.TotalTimeSpent)),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if p.record.State == "closed" {
+		issue, _, err = client.Issues.UpdateIssue(repo, issue.IID, &gitlab.UpdateIssueOptions{
+			StateEvent: gitlab.Ptr("close"),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return issue, nil
+}
+
+// describe returns the metadata of a record for the dry run, like
+// " (labels: bug, backend; milestone: 1.0)".
+func describe(r *issueutils.Record) string {
+	var parts []string
+	if len(r.Labels) > 0 {
+		parts = append(parts, "labels: "+strings.Join(r.Labels, ", "))
+	}
+	if len(r.Assignees) > 0 {
+		parts = append(parts, "assignees: "+strings.Join(r.Assignees, ", "))
+	}
+	if r.Milestone != "" {
+		parts = append(parts, "milestone: "+r.Milestone)
+	}
+	if r.Weight > 0 {
+		parts = append(parts, fmt.Sprintf("weight: %d", r.Weight))
+	}
+	if r.DueDate != "" {
+		parts = append(parts, "due: "+r.DueDate)
+	}
+	if r.Confidential {
+		parts = append(parts, "confidential")
+	}
+	if r.State == "closed" {
+		parts = append(parts, "closed")
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return " (" + strings.Join(parts, "; ") + ")"
+}
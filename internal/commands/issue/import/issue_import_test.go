@@ -0,0 +1,155 @@
+//go:build !integration
+
+package issueimport
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+const testCSV = `title,description,labels,assignees,milestone,weight,due_date,state,time_estimate,total_time_spent,author
+Login fails,"It fails, often.","bug,backend",alice,1.0,3,2025-04-01,opened,7200,1800,jdoe
+Add dark mode,,,,,,,closed,,,jdoe
+`
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func mockLookups(testClient *gitlabtesting.TestClient) {
+	testClient.MockUsers.EXPECT().ListUsers(gomock.Any()).Return([]*gitlab.User{{ID: 11, Username: "alice"}}, nil, nil)
+	testClient.MockMilestones.EXPECT().ListMilestones("OWNER/REPO", gomock.Any()).Return([]*gitlab.Milestone{{ID: 5, Title: "1.0"}}, nil, nil)
+}
+
+func TestIssueImport(t *testing.T) {
+	var slept []time.Duration
+	sleep = func(d time.Duration) { slept = append(slept, d) }
+	t.Cleanup(func() { sleep = time.Sleep })
+
+	testClient := gitlabtesting.NewTestClient(t)
+	mockLookups(testClient)
+	gomock.InOrder(
+		testClient.MockIssues.EXPECT().CreateIssue("OWNER/REPO", gomock.Any()).
+			DoAndReturn(func(pid any, opts *gitlab.CreateIssueOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error) {
+				assert.Equal(t, "Login fails", *opts.Title)
+				assert.Equal(t, "It fails, often.", *opts.Description)
+				assert.Equal(t, gitlab.LabelOptions{"bug", "backend"}, *opts.Labels)
+				assert.Equal(t, []int64{11}, *opts.AssigneeIDs)
+				assert.Equal(t, int64(5), *opts.MilestoneID)
+				assert.Equal(t, int64(3), *opts.Weight)
+				assert.Equal(t, "2025-04-01", opts.DueDate.String())
+				return &gitlab.Issue{IID: 7, Title: *opts.Title}, nil, nil
+			}),
+		testClient.MockIssues.EXPECT().SetTimeEstimate("OWNER/REPO", int64(7), &gitlab.SetTimeEstimateOptions{Duration: gitlab.Ptr("7200s")}).
+			Return(&gitlab.TimeStats{}, nil, nil),
+		testClient.MockIssues.EXPECT().AddSpentTime("OWNER/REPO", int64(7), &gitlab.AddSpentTimeOptions{Duration: gitlab.Ptr("1800s")}).
+			Return(&gitlab.TimeStats{}, nil, nil),
+		testClient.MockIssues.EXPECT().CreateIssue("OWNER/REPO", gomock.Any()).
+			Return(&gitlab.Issue{IID: 8, Title: "Add dark mode"}, nil, nil),
+		testClient.MockIssues.EXPECT().UpdateIssue("OWNER/REPO", int64(8), &gitlab.UpdateIssueOptions{StateEvent: gitlab.Ptr("close")}).
+			Return(&gitlab.Issue{IID: 8, Title: "Add dark mode", State: "closed"}, nil, nil),
+	)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdImport, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	out, err := exec(writeFile(t, "issues.csv", testCSV) + " --rate-limit 30")
+	require.NoError(t, err)
+	assert.Equal(t, "✓ Created issue #7 Login fails\n✓ Created issue #8 Add dark mode\n", out.String())
+	assert.Equal(t, "Imported 2 issues into OWNER/REPO.\n", out.Stderr())
+	assert.Equal(t, []time.Duration{2 * time.Second}, slept)
+}
+
+func TestIssueImport_DryRun(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+	mockLookups(testClient)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdImport, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	out, err := exec(writeFile(t, "issues.csv", testCSV) + " --dry-run")
+	require.NoError(t, err)
+	assert.Equal(t, "Would create issue \"Login fails\" (labels: bug, backend; assignees: alice; milestone: 1.0; weight: 3; due: 2025-04-01)\n"+
+		"Would create issue \"Add dark mode\" (closed)\n", out.String())
+	assert.Equal(t, "2 issues in OWNER/REPO. Run again without --dry-run to create them.\n", out.Stderr())
+}
+
+func TestIssueImport_JSON(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockIssues.EXPECT().CreateIssue("OWNER/REPO", gomock.Any()).
+		DoAndReturn(func(pid any, opts *gitlab.CreateIssueOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error) {
+			assert.Equal(t, "From JSON", *opts.Title)
+			assert.True(t, *opts.Confidential)
+			return &gitlab.Issue{IID: 1, Title: *opts.Title}, nil, nil
+		})
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdImport, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	file := writeFile(t, "issues.txt", `[{"iid": 12, "title": "From JSON", "confidential": true, "labels": []}]`)
+	out, err := exec(file + " --format json")
+	require.NoError(t, err)
+	assert.Equal(t, "✓ Created issue #1 From JSON\n", out.String())
+}
+
+func TestIssueImport_Errors(t *testing.T) {
+	testCases := []struct {
+		name    string
+		file    string
+		content string
+		wantErr string
+	}{
+		{
+			name:    "unknown format",
+			file:    "issues.txt",
+			wantErr: "can't tell the format of",
+		},
+		{
+			name:    "no title column",
+			file:    "issues.csv",
+			content: "description\nfoo\n",
+			wantErr: "the CSV header has no title column",
+		},
+		{
+			name:    "missing title",
+			file:    "issues.csv",
+			content: "title,labels\nfirst,\n,bug\n",
+			wantErr: "issue 2 has no title.",
+		},
+		{
+			name:    "invalid weight",
+			file:    "issues.csv",
+			content: "title,weight\nfirst,heavy\n",
+			wantErr: `line 2: invalid weight "heavy"`,
+		},
+		{
+			name:    "invalid due date",
+			file:    "issues.json",
+			content: `[{"title": "first", "due_date": "tomorrow"}]`,
+			wantErr: `issue "first" has an invalid due date "tomorrow". Use the YYYY-MM-DD format.`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			exec := cmdtest.SetupCmdForTest(t, NewCmdImport, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			_, err := exec(writeFile(t, tc.file, tc.content))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+}
@@ -5,17 +5,26 @@ import (
 	"github.com/spf13/cobra"
 
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	issueAuditConfidentialCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/auditconfidential"
 	issueBoardCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/board"
 	issueCloseCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/close"
+	issueConfidentialCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/confidential"
 	issueCreateCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/create"
 	issueDeleteCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/delete"
+	issueExportCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/export"
+	issueImportCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/import"
+	issueLinkCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/link"
+	issueLinksCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/links"
 	issueListCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/list"
 	issueNoteCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/note"
 	issueReopenCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/reopen"
+	issueSnoozeCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/snooze"
 	issueSubscribeCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/subscribe"
+	issueUnsnoozeCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/unsnooze"
 	issueUnsubscribeCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/unsubscribe"
 	issueUpdateCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/update"
 	issueViewCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/view"
+	issueWeightCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/weight"
 )
 
 func NewCmdIssue(f cmdutils.Factory) *cobra.Command {
@@ -42,14 +51,23 @@ func NewCmdIssue(f cmdutils.Factory) *cobra.Command {
 
 	issueCmd.AddCommand(issueCloseCmd.NewCmdClose(f))
 	issueCmd.AddCommand(issueBoardCmd.NewCmdBoard(f))
+	issueCmd.AddCommand(issueConfidentialCmd.NewCmdConfidential(f))
+	issueCmd.AddCommand(issueAuditConfidentialCmd.NewCmdAuditConfidential(f))
 	issueCmd.AddCommand(issueCreateCmd.NewCmdCreate(f))
 	issueCmd.AddCommand(issueDeleteCmd.NewCmdDelete(f))
+	issueCmd.AddCommand(issueExportCmd.NewCmdExport(f))
+	issueCmd.AddCommand(issueImportCmd.NewCmdImport(f))
+	issueCmd.AddCommand(issueLinkCmd.NewCmdLink(f))
+	issueCmd.AddCommand(issueLinksCmd.NewCmdLinks(f))
 	issueCmd.AddCommand(issueListCmd.NewCmdList(f, nil))
 	issueCmd.AddCommand(issueNoteCmd.NewCmdNote(f))
 	issueCmd.AddCommand(issueReopenCmd.NewCmdReopen(f))
 	issueCmd.AddCommand(issueViewCmd.NewCmdView(f))
+	issueCmd.AddCommand(issueSnoozeCmd.NewCmdSnooze(f))
+	issueCmd.AddCommand(issueUnsnoozeCmd.NewCmdUnsnooze(f))
 	issueCmd.AddCommand(issueSubscribeCmd.NewCmdSubscribe(f))
 	issueCmd.AddCommand(issueUnsubscribeCmd.NewCmdUnsubscribe(f))
 	issueCmd.AddCommand(issueUpdateCmd.NewCmdUpdate(f))
+	issueCmd.AddCommand(issueWeightCmd.NewCmdWeight(f))
 	return issueCmd
 }
@@ -0,0 +1,48 @@
+package issueutils
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// LinkTypes are the types of links between issues.
+var LinkTypes = []string{"relates_to", "blocks", "is_blocked_by"}
+
+var issueRefRE = regexp.MustCompile(`^([\w.-]+(?:/[\w.-]+)+)?#?(\d+)$`)
+
+// ParseIssueRef parses a reference to an issue: "123", "#123",
+// "group/project#123", or the URL of the issue. The project is empty for an
+// issue of the current project.
+func ParseIssueRef(arg, defaultHostname string) (string, int64, error) {
+	if iid, repo := issueMetadataFromURL(arg, defaultHostname); iid != 0 {
+		return repo.FullName(), iid, nil
+	}
+
+	m := issueRefRE.FindStringSubmatch(arg)
+	if m == nil || (m[1] != "" && !strings.Contains(arg, "#")) {
+		return "", 0, fmt.Errorf("invalid issue format: %q", arg)
+	}
+	iid, err := strconv.ParseInt(m[2], 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid issue format: %q", arg)
+	}
+	return m[1], iid, nil
+}
+
+// LinkTypeText returns the link type in words, like "is blocked by".
+func LinkTypeText(linkType string) string {
+	return strings.ReplaceAll(linkType, "_", " ")
+}
+
+// RelationReference returns the reference of a linked issue, with its project
+// when it's not in the project of the issue it's linked to.
+func RelationReference(r *gitlab.IssueRelation, projectID int64) string {
+	if r.ProjectID != projectID && r.References != nil && r.References.Full != "" {
+		return r.References.Full
+	}
+	return fmt.Sprintf("#%d", r.IID)
+}
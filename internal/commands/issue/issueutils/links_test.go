@@ -0,0 +1,50 @@
+//go:build !integration
+
+package issueutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestParseIssueRef(t *testing.T) {
+	tests := []struct {
+		arg         string
+		wantProject string
+		wantIID     int64
+		wantErr     bool
+	}{
+		{arg: "12", wantIID: 12},
+		{arg: "#12", wantIID: 12},
+		{arg: "group/project#12", wantProject: "group/project", wantIID: 12},
+		{arg: "group/sub/project#12", wantProject: "group/sub/project", wantIID: 12},
+		{arg: "https://gitlab.com/group/project/-/issues/12", wantProject: "group/project", wantIID: 12},
+		{arg: "group/project12", wantErr: true},
+		{arg: "project#12", wantErr: true},
+		{arg: "abc", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.arg, func(t *testing.T) {
+			project, iid, err := ParseIssueRef(tt.arg, "gitlab.com")
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantProject, project)
+			assert.Equal(t, tt.wantIID, iid)
+		})
+	}
+}
+
+func TestRelationReference(t *testing.T) {
+	r := &gitlab.IssueRelation{IID: 7, ProjectID: 2, References: &gitlab.IssueReferences{Full: "group/other#7"}}
+
+	assert.Equal(t, "#7", RelationReference(r, 2))
+	assert.Equal(t, "group/other#7", RelationReference(r, 1))
+}
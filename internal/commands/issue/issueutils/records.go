@@ -0,0 +1,234 @@
+package issueutils
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// Record is an issue as exported by 'glab issue export' and imported by
+// 'glab issue import'. In CSV, the columns have the names of the JSON fields,
+// and lists are comma-separated.
+type Record struct {
+	IID            int64    `json:"iid,omitempty"`
+	Title          string   `json:"title"`
+	Description    string   `json:"description"`
+	State          string   `json:"state"`
+	Author         string   `json:"author"`
+	Assignees      []string `json:"assignees"`
+	Labels         []string `json:"labels"`
+	Milestone      string   `json:"milestone"`
+	Weight         int64    `json:"weight"`
+	DueDate        string   `json:"due_date"`
+	Confidential   bool     `json:"confidential"`
+	TimeEstimate   int64    `json:"time_estimate"`
+	TotalTimeSpent int64    `json:"total_time_spent"`
+	CreatedAt      string   `json:"created_at"`
+	UpdatedAt      string   `json:"updated_at"`
+	ClosedAt       string   `json:"closed_at"`
+	WebURL         string   `json:"web_url"`
+}
+
+// RecordColumns are the CSV columns of records, in order.
+var RecordColumns = []string{
+	"iid", "title", "description", "state", "author", "assignees", "labels", "milestone", "weight",
+	"due_date", "confidential", "time_estimate", "total_time_spent", "created_at", "updated_at", "closed_at", "web_url",
+}
+
+// NewRecord returns the record of an issue.
+func NewRecord(issue *gitlab.Issue) *Record {
+	r := &Record{
+		IID:          issue.IID,
+		Title:        issue.Title,
+		Description:  issue.Description,
+		State:        issue.State,
+		Assignees:    []string{},
+		Labels:       []string(issue.Labels),
+		Weight:       issue.Weight,
+		Confidential: issue.Confidential,
+		WebURL:       issue.WebURL,
+		CreatedAt:    formatTime(issue.CreatedAt),
+		UpdatedAt:    formatTime(issue.UpdatedAt),
+		ClosedAt:     formatTime(issue.ClosedAt),
+	}
+	if r.Labels == nil {
+		r.Labels = []string{}
+	}
+	if issue.Author != nil {
+		r.Author = issue.Author.Username
+	}
+	for _, assignee := range issue.Assignees {
+		r.Assignees = append(r.Assignees, assignee.Username)
+	}
+	if issue.Milestone != nil {
+		r.Milestone = issue.Milestone.Title
+	}
+	if issue.DueDate != nil {
+		r.DueDate = issue.DueDate.String()
+	}
+	if issue.TimeStats != nil {
+		r.TimeEstimate = issue.TimeStats.TimeEstimate
+		r.TotalTimeSpent = issue.TimeStats.TotalTimeSpent
+	}
+	return r
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// WriteRecordsCSV writes records as CSV, with a header row.
+func WriteRecordsCSV(w io.Writer, records []*Record) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(RecordColumns); err != nil {
+		return err
+	}
+	for _, r := range records {
+		row := []string{
+			strconv.FormatInt(r.IID, 10),
+			r.Title,
+			r.Description,
+			r.State,
+			r.Author,
+			strings.Join(r.Assignees, ","),
+			strings.Join(r.Labels, ","),
+			r.Milestone,
+			strconv.FormatInt(r.Weight, 10),
+			r.DueDate,
+			strconv.FormatBool(r.Confidential),
+			strconv.FormatInt(r.TimeEstimate, 10),
+			strconv.FormatInt(r.TotalTimeSpent, 10),
+			r.CreatedAt,
+			r.UpdatedAt,
+			r.ClosedAt,
+			r.WebURL,
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ReadRecordsCSV reads records from CSV with a header row. Only the title column
+// is required, and unknown columns are ignored.
+func ReadRecordsCSV(r io.Reader) ([]*Record, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for i := range header {
+		header[i] = strings.ToLower(strings.TrimSpace(header[i]))
+	}
+	if !slices.Contains(header, "title") {
+		return nil, errors.New("the CSV header has no title column")
+	}
+
+	var records []*Record
+	for {
+		row, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := cr.FieldPos(0)
+		record := &Record{}
+		for i, value := range row {
+			if err := record.set(header[i], strings.TrimSpace(value)); err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+		}
+		records = append(records, record)
+	}
+}
+
+// ReadRecordsJSON reads records from a JSON array.
+func ReadRecordsJSON(r io.Reader) ([]*Record, error) {
+	var records []*Record
+	if err := json.NewDecoder(r).Decode(&records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+func (r *Record) set(column, value string) error {
+	var err error
+	switch column {
+	case "iid":
+		if value != "" {
+			r.IID, err = strconv.ParseInt(value, 10, 64)
+		}
+	case "title":
+		r.Title = value
+	case "description":
+		r.Description = value
+	case "state":
+		r.State = value
+	case "author":
+		r.Author = value
+	case "assignees":
+		r.Assignees = splitList(value)
+	case "labels":
+		r.Labels = splitList(value)
+	case "milestone":
+		r.Milestone = value
+	case "weight":
+		if value != "" {
+			r.Weight, err = strconv.ParseInt(value, 10, 64)
+		}
+	case "due_date":
+		r.DueDate = value
+	case "confidential":
+		if value != "" {
+			r.Confidential, err = strconv.ParseBool(value)
+		}
+	case "time_estimate":
+		if value != "" {
+			r.TimeEstimate, err = strconv.ParseInt(value, 10, 64)
+		}
+	case "total_time_spent":
+		if value != "" {
+			r.TotalTimeSpent, err = strconv.ParseInt(value, 10, 64)
+		}
+	case "created_at":
+		r.CreatedAt = value
+	case "updated_at":
+		r.UpdatedAt = value
+	case "closed_at":
+		r.ClosedAt = value
+	case "web_url":
+		r.WebURL = value
+	}
+	if err != nil {
+		return fmt.Errorf("invalid %s %q", column, value)
+	}
+	return nil
+}
+
+func splitList(value string) []string {
+	var items []string
+	for item := range strings.SplitSeq(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
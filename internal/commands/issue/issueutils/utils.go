@@ -16,16 +16,18 @@ import (
 	"gitlab.com/gitlab-org/cli/internal/glrepo"
 	"gitlab.com/gitlab-org/cli/internal/iostreams"
 	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+	"gitlab.com/gitlab-org/cli/internal/text"
 	"gitlab.com/gitlab-org/cli/internal/utils"
 )
 
 func DisplayIssueList(streams *iostreams.IOStreams, issues []*gitlab.Issue, projectID string) string {
 	c := streams.Color()
-	table := tableprinter.NewTablePrinter()
+	table := tableprinter.New(streams)
 	table.SetIsTTY(streams.IsOutputTTY())
+	table.SetWrapColumns(1)
 
 	if len(issues) > 0 {
-		table.AddRow("ID", "Title", "Labels", "Created at")
+		table.AddHeader("ID", "Title", "Labels", "Created at")
 	}
 
 	for _, issue := range issues {
@@ -45,6 +47,23 @@ func DisplayIssueList(streams *iostreams.IOStreams, issues []*gitlab.Issue, proj
 	return table.Render()
 }
 
+// SlackIssueList returns the issues as a Slack mrkdwn list, under a bold title.
+func SlackIssueList(title string, issues []*gitlab.Issue) string {
+	var b strings.Builder
+	b.WriteString(text.SlackBold(text.SlackEscape(title)) + "\n")
+	for _, issue := range issues {
+		fmt.Fprintf(&b, "• %s %s", text.SlackLink(issue.WebURL, fmt.Sprintf("#%d", issue.IID)), text.SlackEscape(issue.Title))
+		for _, label := range issue.Labels {
+			fmt.Fprintf(&b, " `%s`", text.SlackEscape(label))
+		}
+		if issue.State == "closed" {
+			b.WriteString(" _(closed)_")
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
 func DisplayIssue(c *iostreams.ColorPalette, i *gitlab.Issue, isTTY bool) string {
 	duration := utils.TimeToPrettyTimeAgo(*i.CreatedAt)
 	issueID := IssueState(c, i)
@@ -141,7 +160,8 @@ func IssueFromArg(apiClientFunc func(repoHost string) (*api.Client, error), clie
 //	including nested subgroups:
 //		GROUP/SUBGROUP/../../REPO/-/issues/id
 //		GROUP/SUBGROUP/../../REPO/-/issues/incident/id
-var issueURLPathRE = regexp.MustCompile(`^(/(?:[^-][^/]+/){2,})+(?:-/)?issues/(?:incident/)?(\d+)$`)
+//		GROUP/SUBGROUP/../../REPO/-/work_items/id
+var issueURLPathRE = regexp.MustCompile(`^(/(?:[^-][^/]+/){2,})+(?:-/)?(?:issues/(?:incident/)?|work_items/)(\d+)$`)
 
 func issueMetadataFromURL(s, defaultHostname string) (int64, glrepo.Interface) {
 	u, err := url.Parse(s)
@@ -5,8 +5,11 @@ package issueutils
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
 	"gitlab.com/gitlab-org/cli/internal/glinstance"
 	"gitlab.com/gitlab-org/cli/internal/glrepo"
 )
@@ -54,6 +57,12 @@ func Test_issueMetadataFromURL(t *testing.T) {
 			want: 1,
 			path: "https://gitlab.com/namespace/project/subproject/repo/",
 		},
+		{
+			name: "valid work item URL",
+			str:  "https://gitlab.com/namespace/repo/-/work_items/7",
+			want: 7,
+			path: "https://gitlab.com/namespace/repo/",
+		},
 		{
 			name: "invalid URL with no issue number",
 			str:  "https://gitlab.com/namespace/project/subproject/repo/issues",
@@ -104,3 +113,15 @@ func Test_issueMetadataFromURL(t *testing.T) {
 		})
 	}
 }
+
+func TestSlackIssueList(t *testing.T) {
+	issues := []*gitlab.Issue{
+		{IID: 1, Title: "Crash on <Enter>", State: "opened", Labels: []string{"bug", "P1"}, WebURL: "https://gitlab.com/OWNER/REPO/-/issues/1"},
+		{IID: 2, Title: "Update docs", State: "closed", WebURL: "https://gitlab.com/OWNER/REPO/-/issues/2"},
+	}
+
+	want := "*Showing 2 issues on OWNER/REPO.*\n" +
+		"• <https://gitlab.com/OWNER/REPO/-/issues/1|#1> Crash on &lt;Enter&gt; `bug` `P1`\n" +
+		"• <https://gitlab.com/OWNER/REPO/-/issues/2|#2> Update docs _(closed)_\n"
+	assert.Equal(t, want, SlackIssueList("Showing 2 issues on OWNER/REPO.\n", issues))
+}
@@ -0,0 +1,147 @@
+package link
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	linkType  string
+	blocks    []string
+	blockedBy []string
+
+	io              *iostreams.IOStreams
+	apiClient       func(repoHost string) (*api.Client, error)
+	gitlabClient    func() (*gitlab.Client, error)
+	baseRepo        func() (glrepo.Interface, error)
+	defaultHostname func() string
+}
+
+// target is an issue to link to, with the type of the link.
+type target struct {
+	// ref is the reference of the issue in messages, like "#12" or "group/project#12".
+	ref      string
+	project  string
+	iid      int64
+	linkType string
+}
+
+func NewCmdLink(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:              f.IO(),
+		apiClient:       f.ApiClient,
+		gitlabClient:    f.GitLabClient,
+		baseRepo:        f.BaseRepo,
+		defaultHostname: f.DefaultHostname,
+	}
+
+	issueLinkCmd := &cobra.Command{
+		Use:   "link <id> [<issue>...] [flags]",
+		Short: `Link an issue to other issues.`,
+		Long: heredoc.Doc(`
+			Link an issue to other issues, to mark them as related, or to track
+			which issues block others.
+
+			The issues to link to are given by number, like "123", by reference to
+			another project, like "group/project#123", or by URL. Issues given as
+			arguments are linked with the type of '--type'. Use '--blocks' and
+			'--blocked-by' to add blocking links in the same command.
+
+			To see or delete the links of an issue, use 'glab issue links'.
+		`),
+		Example: heredoc.Doc(`
+			# Mark issue 12 as related to issues 34 and 56
+			$ glab issue link 12 34 56
+
+			# Issue 12 blocks issue 34, and is blocked by an issue of another project
+			$ glab issue link 12 --blocks 34 --blocked-by group/project#7
+		`),
+		Args: cobra.MinimumNArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 1 && len(opts.blocks) == 0 && len(opts.blockedBy) == 0 {
+				return &cmdutils.FlagError{Err: errors.New("specify the issues to link to as arguments, or with --blocks or --blocked-by.")}
+			}
+			return opts.run(args[0], args[1:])
+		},
+	}
+
+	fl := issueLinkCmd.Flags()
+	fl.VarP(cmdutils.NewEnumValue(issueutils.LinkTypes, "relates_to", &opts.linkType), "type", "t", "Type of the links to the issues given as arguments: relates_to, blocks, is_blocked_by.")
+	fl.StringSliceVar(&opts.blocks, "blocks", nil, "Issues that this issue blocks. Multiple issues can be comma-separated or specified by repeating the flag.")
+	fl.StringSliceVar(&opts.blockedBy, "blocked-by", nil, "Issues that block this issue. Multiple issues can be comma-separated or specified by repeating the flag.")
+
+	return issueLinkCmd
+}
+
+func (o *options) run(issueArg string, args []string) error {
+	var targets []target
+	add := func(refs []string, linkType string) error {
+		for _, ref := range refs {
+			project, iid, err := issueutils.ParseIssueRef(ref, o.defaultHostname())
+			if err != nil {
+				return &cmdutils.FlagError{Err: err}
+			}
+			display := fmt.Sprintf("#%d", iid)
+			if project != "" {
+				display = project + display
+			}
+			targets = append(targets, target{ref: display, project: project, iid: iid, linkType: linkType})
+		}
+		return nil
+	}
+	if err := add(args, o.linkType); err != nil {
+		return err
+	}
+	if err := add(o.blocks, "blocks"); err != nil {
+		return err
+	}
+	if err := add(o.blockedBy, "is_blocked_by"); err != nil {
+		return err
+	}
+
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	issue, repo, err := issueutils.IssueFromArg(o.apiClient, client, o.baseRepo, o.defaultHostname(), issueArg)
+	if err != nil {
+		return err
+	}
+
+	c := o.io.Color()
+	for _, t := range targets {
+		createOpts := &gitlab.CreateIssueLinkOptions{
+			TargetIssueIID: gitlab.Ptr(strconv.FormatInt(t.iid, 10)),
+			LinkType:       gitlab.Ptr(t.linkType),
+		}
+		targetProject := repo.FullName()
+		if t.project != "" {
+			targetProject = t.project
+		}
+		createOpts.TargetProjectID = gitlab.Ptr(targetProject)
+
+		_, _, err := client.IssueLinks.CreateIssueLink(repo.FullName(), issue.IID, createOpts)
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to link issue #%d to %s.", issue.IID, t.ref))
+		}
+		fmt.Fprintf(o.io.StdOut, "%s Issue #%d %s %s\n", c.GreenCheck(), issue.IID, issueutils.LinkTypeText(t.linkType), t.ref)
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+//go:build !integration
+
+package link
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestIssueLink(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockIssues.EXPECT().
+		GetIssue("OWNER/REPO", int64(12), gomock.Any()).
+		Return(&gitlab.Issue{IID: 12, ProjectID: 1, CreatedAt: &createdAt}, nil, nil)
+	testClient.MockIssueLinks.EXPECT().
+		CreateIssueLink("OWNER/REPO", int64(12), &gitlab.CreateIssueLinkOptions{
+			TargetProjectID: gitlab.Ptr("OWNER/REPO"),
+			TargetIssueIID:  gitlab.Ptr("34"),
+			LinkType:        gitlab.Ptr("relates_to"),
+		}).
+		Return(&gitlab.IssueLink{}, nil, nil)
+	testClient.MockIssueLinks.EXPECT().
+		CreateIssueLink("OWNER/REPO", int64(12), &gitlab.CreateIssueLinkOptions{
+			TargetProjectID: gitlab.Ptr("OWNER/REPO"),
+			TargetIssueIID:  gitlab.Ptr("56"),
+			LinkType:        gitlab.Ptr("blocks"),
+		}).
+		Return(&gitlab.IssueLink{}, nil, nil)
+	testClient.MockIssueLinks.EXPECT().
+		CreateIssueLink("OWNER/REPO", int64(12), &gitlab.CreateIssueLinkOptions{
+			TargetProjectID: gitlab.Ptr("group/other"),
+			TargetIssueIID:  gitlab.Ptr("7"),
+			LinkType:        gitlab.Ptr("is_blocked_by"),
+		}).
+		Return(&gitlab.IssueLink{}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdLink, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	out, err := exec("12 34 --blocks 56 --blocked-by group/other#7")
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Issue #12 relates to #34\n✓ Issue #12 blocks #56\n✓ Issue #12 is blocked by group/other#7\n", out.String())
+}
+
+func TestIssueLinkErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		wantErr string
+	}{
+		{
+			name:    "no issues to link to",
+			args:    "12",
+			wantErr: "specify the issues to link to as arguments, or with --blocks or --blocked-by.",
+		},
+		{
+			name:    "invalid issue",
+			args:    "12 --blocks abc",
+			wantErr: `invalid issue format: "abc"`,
+		},
+		{
+			name:    "invalid type",
+			args:    "12 34 --type duplicates",
+			wantErr: `invalid argument "duplicates" for "-t, --type" flag: must be one of [blocks is_blocked_by relates_to]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			exec := cmdtest.SetupCmdForTest(t, NewCmdLink, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			_, err := exec(tt.args)
+			require.Error(t, err)
+			assert.EqualError(t, err, tt.wantErr)
+			if tt.name != "invalid type" {
+				var flagErr *cmdutils.FlagError
+				assert.ErrorAs(t, err, &flagErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package delete
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	io              *iostreams.IOStreams
+	apiClient       func(repoHost string) (*api.Client, error)
+	gitlabClient    func() (*gitlab.Client, error)
+	baseRepo        func() (glrepo.Interface, error)
+	defaultHostname func() string
+}
+
+func NewCmdDelete(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:              f.IO(),
+		apiClient:       f.ApiClient,
+		gitlabClient:    f.GitLabClient,
+		baseRepo:        f.BaseRepo,
+		defaultHostname: f.DefaultHostname,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "delete <id> <issue>... [flags]",
+		Short:   `Delete the links between an issue and other issues.`,
+		Aliases: []string{"del"},
+		Long: heredoc.Doc(`
+			Delete the links between an issue and other issues, whatever their type.
+			The linked issues themselves aren't changed.
+		`),
+		Example: heredoc.Doc(`
+			$ glab issue links delete 12 34
+			$ glab issue links delete 12 group/project#7
+		`),
+		Args: cobra.MinimumNArgs(2),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run(args[0], args[1:])
+		},
+	}
+
+	return cmd
+}
+
+func (o *options) run(issueArg string, refs []string) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	issue, repo, err := issueutils.IssueFromArg(o.apiClient, client, o.baseRepo, o.defaultHostname(), issueArg)
+	if err != nil {
+		return err
+	}
+
+	relations, _, err := client.IssueLinks.ListIssueRelations(repo.FullName(), issue.IID)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to list the links of issue #%d.", issue.IID))
+	}
+
+	// Find all the links first, so that nothing is deleted when one is missing.
+	links := make([]*gitlab.IssueRelation, 0, len(refs))
+	for _, ref := range refs {
+		project, iid, err := issueutils.ParseIssueRef(ref, o.defaultHostname())
+		if err != nil {
+			return &cmdutils.FlagError{Err: err}
+		}
+		relation := findRelation(relations, issue.ProjectID, project, iid)
+		if relation == nil {
+			return fmt.Errorf("issue #%d isn't linked to %s.", issue.IID, ref)
+		}
+		links = append(links, relation)
+	}
+
+	c := o.io.Color()
+	for _, r := range links {
+		reference := issueutils.RelationReference(r, issue.ProjectID)
+		_, _, err := client.IssueLinks.DeleteIssueLink(repo.FullName(), issue.IID, r.IssueLinkID)
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to delete the link to %s.", reference))
+		}
+		fmt.Fprintf(o.io.StdOut, "%s Deleted the link between issue #%d and %s.\n", c.GreenCheck(), issue.IID, reference)
+	}
+	return nil
+}
+
+// findRelation returns the issue linked with the IID iid in project, or in the
+// project of the issue when project is empty.
+func findRelation(relations []*gitlab.IssueRelation, projectID int64, project string, iid int64) *gitlab.IssueRelation {
+	for _, r := range relations {
+		if r.IID != iid {
+			continue
+		}
+		if project == "" && r.ProjectID == projectID {
+			return r
+		}
+		if project != "" && r.References != nil && r.References.Full == fmt.Sprintf("%s#%d", project, iid) {
+			return r
+		}
+	}
+	return nil
+}
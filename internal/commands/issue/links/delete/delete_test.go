@@ -0,0 +1,63 @@
+//go:build !integration
+
+package delete
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func setupMocks(t *testing.T) *gitlabtesting.TestClient {
+	t.Helper()
+
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockIssues.EXPECT().
+		GetIssue("OWNER/REPO", int64(12), gomock.Any()).
+		Return(&gitlab.Issue{IID: 12, ProjectID: 1, CreatedAt: &createdAt}, nil, nil)
+	testClient.MockIssueLinks.EXPECT().
+		ListIssueRelations("OWNER/REPO", int64(12)).
+		Return([]*gitlab.IssueRelation{
+			{IID: 34, ProjectID: 1, IssueLinkID: 100, LinkType: "blocks"},
+			{IID: 7, ProjectID: 2, IssueLinkID: 101, LinkType: "relates_to", References: &gitlab.IssueReferences{Full: "group/other#7"}},
+		}, nil, nil)
+	return testClient
+}
+
+func TestIssueLinksDelete(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	testClient := setupMocks(t)
+	testClient.MockIssueLinks.EXPECT().
+		DeleteIssueLink("OWNER/REPO", int64(12), int64(100)).
+		Return(&gitlab.IssueLink{}, nil, nil)
+	testClient.MockIssueLinks.EXPECT().
+		DeleteIssueLink("OWNER/REPO", int64(12), int64(101)).
+		Return(&gitlab.IssueLink{}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdDelete, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	out, err := exec("12 34 group/other#7")
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Deleted the link between issue #12 and #34.\n✓ Deleted the link between issue #12 and group/other#7.\n", out.String())
+}
+
+func TestIssueLinksDeleteNotLinked(t *testing.T) {
+	testClient := setupMocks(t)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdDelete, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	// Nothing is deleted when one of the issues isn't linked.
+	_, err := exec("12 34 7")
+	assert.EqualError(t, err, "issue #12 isn't linked to 7.")
+}
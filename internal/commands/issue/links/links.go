@@ -0,0 +1,22 @@
+package links
+
+import (
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	cmdDelete "gitlab.com/gitlab-org/cli/internal/commands/issue/links/delete"
+	cmdList "gitlab.com/gitlab-org/cli/internal/commands/issue/links/list"
+)
+
+func NewCmdLinks(f cmdutils.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "links <command> [flags]",
+		Short: `List and delete the links between issues.`,
+		Long:  "To link issues, use 'glab issue link'.\n",
+	}
+
+	cmd.AddCommand(cmdList.NewCmdList(f))
+	cmd.AddCommand(cmdDelete.NewCmdDelete(f))
+
+	return cmd
+}
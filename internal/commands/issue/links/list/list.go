@@ -0,0 +1,102 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+)
+
+type options struct {
+	outputFormat string
+
+	io              *iostreams.IOStreams
+	apiClient       func(repoHost string) (*api.Client, error)
+	gitlabClient    func() (*gitlab.Client, error)
+	baseRepo        func() (glrepo.Interface, error)
+	defaultHostname func() string
+}
+
+func NewCmdList(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:              f.IO(),
+		apiClient:       f.ApiClient,
+		gitlabClient:    f.GitLabClient,
+		baseRepo:        f.BaseRepo,
+		defaultHostname: f.DefaultHostname,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list <id> [flags]",
+		Short:   `List the issues linked to an issue.`,
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			$ glab issue links list 12
+			$ glab issue links list 12 --output json
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run(args[0])
+		},
+	}
+
+	cmd.Flags().VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+
+	return cmd
+}
+
+func (o *options) run(issueArg string) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	issue, repo, err := issueutils.IssueFromArg(o.apiClient, client, o.baseRepo, o.defaultHostname(), issueArg)
+	if err != nil {
+		return err
+	}
+
+	relations, _, err := client.IssueLinks.ListIssueRelations(repo.FullName(), issue.IID)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to list the links of issue #%d.", issue.IID))
+	}
+
+	if o.outputFormat == "json" {
+		relationsJSON, _ := json.Marshal(relations)
+		fmt.Fprintln(o.io.StdOut, string(relationsJSON))
+		return nil
+	}
+
+	if len(relations) == 0 {
+		o.io.LogInfof("No issues linked to issue #%d.\n", issue.IID)
+		return nil
+	}
+
+	c := o.io.Color()
+	table := tableprinter.New(o.io)
+	table.AddHeader("LINK", "ISSUE", "STATE", "TITLE")
+	for _, r := range relations {
+		state := c.Green(r.State)
+		if r.State == "closed" {
+			state = c.Red(r.State)
+		}
+		table.AddRow(issueutils.LinkTypeText(r.LinkType), issueutils.RelationReference(r, issue.ProjectID), state, r.Title)
+	}
+	fmt.Fprint(o.io.StdOut, table.String())
+
+	return nil
+}
@@ -0,0 +1,72 @@
+//go:build !integration
+
+package list
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestIssueLinksList(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	relations := []*gitlab.IssueRelation{
+		{IID: 34, ProjectID: 1, Title: "Blocked issue", State: "opened", LinkType: "blocks"},
+		{IID: 7, ProjectID: 2, Title: "Other issue", State: "closed", LinkType: "relates_to", References: &gitlab.IssueReferences{Full: "group/other#7"}},
+	}
+
+	tests := []struct {
+		name      string
+		args      string
+		relations []*gitlab.IssueRelation
+		wantOut   string
+	}{
+		{
+			name:      "table",
+			args:      "12",
+			relations: relations,
+			wantOut:   "LINK\tISSUE\tSTATE\tTITLE\nblocks\t#34\topened\tBlocked issue\nrelates to\tgroup/other#7\tclosed\tOther issue\n",
+		},
+		{
+			name:      "json",
+			args:      "12 --output json",
+			relations: relations[:1],
+			wantOut:   `"link_type":"blocks"`,
+		},
+		{
+			name:    "no links",
+			args:    "12",
+			wantOut: "No issues linked to issue #12.\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+			testClient := gitlabtesting.NewTestClient(t)
+			testClient.MockIssues.EXPECT().
+				GetIssue("OWNER/REPO", int64(12), gomock.Any()).
+				Return(&gitlab.Issue{IID: 12, ProjectID: 1, CreatedAt: &createdAt}, nil, nil)
+			testClient.MockIssueLinks.EXPECT().
+				ListIssueRelations("OWNER/REPO", int64(12)).
+				Return(tt.relations, nil, nil)
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdList, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			out, err := exec(tt.args)
+			require.NoError(t, err)
+
+			assert.Contains(t, out.String(), tt.wantOut)
+			assert.Empty(t, out.Stderr())
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package snooze
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/snooze/snoozeutils"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+func NewCmdSnooze(f cmdutils.Factory) *cobra.Command {
+	var until string
+
+	issueSnoozeCmd := &cobra.Command{
+		Use:   "snooze <id>... --until <time>",
+		Short: `Hide issues from 'glab issue list' until a time.`,
+		Long: heredoc.Doc(`
+			Hide issues from the text output of 'glab issue list' until a time, to
+			follow up on them later. 'glab snooze list' lists the snoozed issues, and
+			reminds you of the ones that are due.
+
+			The time is a date like 2026-10-30, a weekday like friday, tomorrow, or a
+			duration like 3d, 2w, or 12h. Dates and weekdays start at midnight.
+
+			Snoozed issues are stored locally, in the glab configuration directory.
+			Nothing changes on GitLab.
+		`),
+		Example: heredoc.Doc(`
+			$ glab issue snooze 123 --until friday
+			$ glab issue snooze 123 124 --until 2w
+			$ glab issue snooze https://gitlab.com/OWNER/REPO/-/issues/123 --until 2026-11-02
+		`),
+		Args: cobra.MinimumNArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			untilTime, err := snoozeutils.ParseUntil(until, time.Now())
+			if err != nil {
+				return &cmdutils.FlagError{Err: fmt.Errorf("--until: %w", err)}
+			}
+
+			client, err := f.GitLabClient()
+			if err != nil {
+				return err
+			}
+
+			issues, _, err := issueutils.IssuesFromArgs(f.ApiClient, client, f.BaseRepo, f.DefaultHostname(), args)
+			if err != nil {
+				return err
+			}
+
+			store, err := snoozeutils.Load()
+			if err != nil {
+				return err
+			}
+			for _, issue := range issues {
+				store.Snooze(issue, untilTime)
+			}
+			if err := store.Save(); err != nil {
+				return cmdutils.WrapError(err, "failed to save the snoozed issues.")
+			}
+
+			c := f.IO().Color()
+			for _, issue := range issues {
+				fmt.Fprintf(f.IO().StdOut, "%s Snoozed issue #%d until %s.\n", c.GreenCheck(), issue.IID, snoozeutils.FormatUntil(untilTime))
+			}
+			return nil
+		},
+	}
+
+	issueSnoozeCmd.Flags().StringVarP(&until, "until", "u", "", "Time to show the issues again: a date, a weekday, tomorrow, or a duration.")
+	cobra.CheckErr(issueSnoozeCmd.MarkFlagRequired("until"))
+
+	return issueSnoozeCmd
+}
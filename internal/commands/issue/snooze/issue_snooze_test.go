@@ -0,0 +1,56 @@
+//go:build !integration
+
+package snooze
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/commands/snooze/snoozeutils"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestIssueSnooze(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+	t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockIssues.EXPECT().
+		GetIssue("OWNER/REPO", int64(1), gomock.Any()).
+		Return(&gitlab.Issue{
+			IID:        1,
+			Title:      "Flaky test",
+			WebURL:     "https://gitlab.com/OWNER/REPO/-/issues/1",
+			References: &gitlab.IssueReferences{Full: "OWNER/REPO#1"},
+		}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdSnooze, true, cmdtest.WithGitLabClient(testClient.Client))
+	out, err := exec("1 --until 2099-01-02")
+	require.NoError(t, err)
+	assert.Equal(t, "✓ Snoozed issue #1 until Fri, 02 Jan 2099.\n", out.String())
+
+	store, err := snoozeutils.Load()
+	require.NoError(t, err)
+	require.Len(t, store, 1)
+	snooze := store["https://gitlab.com/OWNER/REPO/-/issues/1"]
+	assert.Equal(t, "OWNER/REPO#1", snooze.Reference)
+	assert.Equal(t, "Flaky test", snooze.Title)
+	assert.Equal(t, time.Date(2099, 1, 2, 0, 0, 0, 0, time.Local), snooze.Until.Local())
+}
+
+func TestIssueSnoozeInvalidUntil(t *testing.T) {
+	exec := cmdtest.SetupCmdForTest(t, NewCmdSnooze, true)
+
+	_, err := exec("1 --until someday")
+	assert.ErrorContains(t, err, `--until: invalid time "someday".`)
+
+	_, err = exec("1")
+	assert.ErrorContains(t, err, `required flag(s) "until" not set`)
+}
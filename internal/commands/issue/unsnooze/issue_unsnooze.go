@@ -0,0 +1,58 @@
+package unsnooze
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/snooze/snoozeutils"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+func NewCmdUnsnooze(f cmdutils.Factory) *cobra.Command {
+	issueUnsnoozeCmd := &cobra.Command{
+		Use:   "unsnooze <id>...",
+		Short: `Show snoozed issues again, and remove their reminders.`,
+		Example: heredoc.Doc(`
+			$ glab issue unsnooze 123
+			$ glab issue unsnooze 123 124
+		`),
+		Args: cobra.MinimumNArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.GitLabClient()
+			if err != nil {
+				return err
+			}
+
+			issues, _, err := issueutils.IssuesFromArgs(f.ApiClient, client, f.BaseRepo, f.DefaultHostname(), args)
+			if err != nil {
+				return err
+			}
+
+			store, err := snoozeutils.Load()
+			if err != nil {
+				return err
+			}
+			c := f.IO().Color()
+			for _, issue := range issues {
+				if !store.Unsnooze(issue) {
+					fmt.Fprintf(f.IO().StdOut, "Issue #%d isn't snoozed.\n", issue.IID)
+					continue
+				}
+				fmt.Fprintf(f.IO().StdOut, "%s Unsnoozed issue #%d.\n", c.GreenCheck(), issue.IID)
+			}
+			if err := store.Save(); err != nil {
+				return cmdutils.WrapError(err, "failed to save the snoozed issues.")
+			}
+			return nil
+		},
+	}
+
+	return issueUnsnoozeCmd
+}
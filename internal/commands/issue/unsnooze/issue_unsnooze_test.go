@@ -0,0 +1,49 @@
+//go:build !integration
+
+package unsnooze
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/commands/snooze/snoozeutils"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestIssueUnsnooze(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+	t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+
+	snoozed := &gitlab.Issue{IID: 1, WebURL: "https://gitlab.com/OWNER/REPO/-/issues/1"}
+	store := snoozeutils.Store{}
+	store.Snooze(snoozed, time.Now().Add(time.Hour))
+	require.NoError(t, store.Save())
+
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockIssues.EXPECT().
+		GetIssue("OWNER/REPO", int64(1), gomock.Any()).
+		Return(snoozed, nil, nil)
+	testClient.MockIssues.EXPECT().
+		GetIssue("OWNER/REPO", int64(2), gomock.Any()).
+		Return(&gitlab.Issue{IID: 2, WebURL: "https://gitlab.com/OWNER/REPO/-/issues/2"}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdUnsnooze, true, cmdtest.WithGitLabClient(testClient.Client))
+	out, err := exec("1 2")
+	require.NoError(t, err)
+	assert.Equal(t, heredoc.Doc(`
+		✓ Unsnoozed issue #1.
+		Issue #2 isn't snoozed.
+	`), out.String())
+
+	store, err = snoozeutils.Load()
+	require.NoError(t, err)
+	assert.Empty(t, store)
+}
@@ -36,6 +36,10 @@ func NewCmdUpdate(f cmdutils.Factory) *cobra.Command {
 			out := f.IO().StdOut
 			c := f.IO().Color()
 
+			if err := cmdutils.ApplyBodyFile(cmd, f.IO(), "description"); err != nil {
+				return err
+			}
+
 			if cmd.Flags().Changed("unassign") && cmd.Flags().Changed("assignee") {
 				return &cmdutils.FlagError{Err: fmt.Errorf("--assignee and --unassign are mutually exclusive.")}
 			}
@@ -204,6 +208,7 @@ func NewCmdUpdate(f cmdutils.Factory) *cobra.Command {
 	issueUpdateCmd.Flags().BoolP("lock-discussion", "", false, "Lock discussion on issue.")
 	issueUpdateCmd.Flags().BoolP("unlock-discussion", "", false, "Unlock discussion on issue.")
 	issueUpdateCmd.Flags().StringP("description", "d", "", "Issue description. Set to \"-\" to open an editor.")
+	cmdutils.AddBodyFileFlag(issueUpdateCmd, "description")
 	issueUpdateCmd.Flags().StringSliceP("label", "l", []string{}, "Add labels.")
 	issueUpdateCmd.Flags().StringSliceP("unlabel", "u", []string{}, "Remove labels.")
 	issueUpdateCmd.Flags().BoolP("public", "p", false, "Make issue public.")
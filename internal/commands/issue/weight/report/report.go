@@ -0,0 +1,310 @@
+package report
+
+import (
+	"cmp"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+)
+
+const (
+	noPeriod   = "(none)"
+	unassigned = "(unassigned)"
+)
+
+type options struct {
+	group        string
+	by           string
+	state        string
+	milestone    string
+	iteration    int64
+	capacity     int64
+	outputFormat string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+// row is the work of one assignee in one milestone or iteration.
+type row struct {
+	period     string
+	assignee   string
+	issues     int
+	unweighted int
+	weight     int64
+}
+
+func NewCmdReport(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	reportCmd := &cobra.Command{
+		Use:   "report [flags]",
+		Short: `Sum the weights of issues per assignee and milestone or iteration.`,
+		Long: heredoc.Doc(`
+			Sum the weights of the issues of a group or project per assignee, and per
+			milestone or iteration.
+
+			An issue with several assignees counts in full for each of them. Issues
+			without a weight are counted in the UNWEIGHTED column.
+
+			With --capacity, assignees with more weight than the capacity in a
+			milestone or iteration are reported as over capacity.
+		`),
+		Example: heredoc.Doc(`
+			# Report the open work of a group per milestone
+			$ glab issue weight report --group my-group
+
+			# Highlight the people with more than 20 weight in an iteration
+			$ glab issue weight report --group my-group --by iteration --capacity 20
+
+			# Export the report to a spreadsheet
+			$ glab issue weight report --group my-group --milestone 17.0 --output csv > capacity.csv
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.capacity < 0 {
+				return &cmdutils.FlagError{Err: errors.New("--capacity must not be negative.")}
+			}
+			return opts.run()
+		},
+	}
+
+	fl := reportCmd.Flags()
+	fl.StringVarP(&opts.group, "group", "g", "", "Report on the issues of this group and its subgroups, instead of the current project.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"milestone", "iteration"}, "milestone", &opts.by), "by", "b", "Group the weights by: milestone, iteration.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"opened", "closed", "all"}, "opened", &opts.state), "state", "s", "Report on the issues in this state: opened, closed, all.")
+	fl.StringVarP(&opts.milestone, "milestone", "m", "", "Only report on the issues of the milestone with this title.")
+	fl.Int64VarP(&opts.iteration, "iteration", "i", 0, "Only report on the issues of the iteration with this ID.")
+	fl.Int64VarP(&opts.capacity, "capacity", "c", 0, "Weight that each assignee can take in a milestone or iteration. 0 doesn't check the capacity.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"text", "csv"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, csv.")
+
+	return reportCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	issues, source, err := o.listIssues(client)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to list the issues of %s.", source))
+	}
+
+	rows := o.summarize(issues)
+	if o.outputFormat == "csv" {
+		return o.writeCSV(rows)
+	}
+
+	if len(rows) == 0 {
+		fmt.Fprintf(o.io.StdOut, "No issues match in %s.\n", source)
+		return nil
+	}
+	o.printTable(rows)
+	return nil
+}
+
+func (o *options) listIssues(client *gitlab.Client) ([]*gitlab.Issue, string, error) {
+	var state, milestone *string
+	var iteration *int64
+	if o.state != "all" {
+		state = gitlab.Ptr(o.state)
+	}
+	if o.milestone != "" {
+		milestone = gitlab.Ptr(o.milestone)
+	}
+	if o.iteration != 0 {
+		iteration = gitlab.Ptr(o.iteration)
+	}
+
+	if o.group != "" {
+		listOpts := &gitlab.ListGroupIssuesOptions{
+			ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage},
+			State:       state,
+			Milestone:   milestone,
+			IterationID: iteration,
+		}
+		issues, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Issue, *gitlab.Response, error) {
+			return client.Issues.ListGroupIssues(o.group, listOpts, p)
+		})
+		return issues, o.group, err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return nil, "", err
+	}
+	listOpts := &gitlab.ListProjectIssuesOptions{
+		ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage},
+		State:       state,
+		Milestone:   milestone,
+		IterationID: iteration,
+	}
+	issues, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Issue, *gitlab.Response, error) {
+		return client.Issues.ListProjectIssues(repo.FullName(), listOpts, p)
+	})
+	return issues, repo.FullName(), err
+}
+
+// summarize returns the rows of the report, sorted by milestone or iteration,
+// and then by assignee. The issues without one come last.
+func (o *options) summarize(issues []*gitlab.Issue) []*row {
+	byKey := map[[2]string]*row{}
+	var rows []*row
+	for _, issue := range issues {
+		period := o.period(issue)
+
+		assignees := make([]string, 0, len(issue.Assignees))
+		for _, a := range issue.Assignees {
+			assignees = append(assignees, a.Username)
+		}
+		if len(assignees) == 0 {
+			assignees = append(assignees, unassigned)
+		}
+
+		for _, assignee := range assignees {
+			r, ok := byKey[[2]string{period, assignee}]
+			if !ok {
+				r = &row{period: period, assignee: assignee}
+				byKey[[2]string{period, assignee}] = r
+				rows = append(rows, r)
+			}
+			r.issues++
+			r.weight += issue.Weight
+			if issue.Weight == 0 {
+				r.unweighted++
+			}
+		}
+	}
+
+	slices.SortFunc(rows, func(a, b *row) int {
+		return cmp.Or(
+			compareLast(a.period, b.period, noPeriod),
+			compareLast(a.assignee, b.assignee, unassigned),
+		)
+	})
+	return rows
+}
+
+// compareLast compares a and b, with last after any other value.
+func compareLast(a, b, last string) int {
+	switch {
+	case a == b:
+		return 0
+	case a == last:
+		return 1
+	case b == last:
+		return -1
+	}
+	return strings.Compare(a, b)
+}
+
+func (o *options) period(issue *gitlab.Issue) string {
+	if o.by == "iteration" {
+		return iterationName(issue.Iteration)
+	}
+	if issue.Milestone == nil {
+		return noPeriod
+	}
+	return issue.Milestone.Title
+}
+
+// iterationName returns the title of an iteration, or its dates for the
+// iterations of cadences that don't have titles.
+func iterationName(it *gitlab.GroupIteration) string {
+	switch {
+	case it == nil:
+		return noPeriod
+	case it.Title != "":
+		return it.Title
+	case it.StartDate != nil && it.DueDate != nil:
+		return it.StartDate.String() + " - " + it.DueDate.String()
+	}
+	return fmt.Sprintf("Iteration %d", it.ID)
+}
+
+func (o *options) overCapacity(r *row) bool {
+	return o.capacity > 0 && r.assignee != unassigned && r.weight > o.capacity
+}
+
+func (o *options) printTable(rows []*row) {
+	c := o.io.Color()
+
+	header := []any{strings.ToUpper(o.by), "ASSIGNEE", "ISSUES", "UNWEIGHTED", "WEIGHT"}
+	if o.capacity > 0 {
+		header = append(header, "LOAD")
+	}
+	table := tableprinter.New(o.io)
+	table.AddHeader(header...)
+
+	var over []string
+	for _, r := range rows {
+		cells := []any{r.period, r.assignee, r.issues, r.unweighted, r.weight}
+		if o.capacity > 0 {
+			load := ""
+			if r.assignee != unassigned {
+				load = fmt.Sprintf("%d%%", r.weight*100/o.capacity)
+				if o.overCapacity(r) {
+					load = c.Red(load)
+					over = append(over, fmt.Sprintf("%s (%s)", r.assignee, r.period))
+				} else {
+					load = c.Green(load)
+				}
+			}
+			cells = append(cells, load)
+		}
+		table.AddRow(cells...)
+	}
+	fmt.Fprint(o.io.StdOut, table.String())
+
+	if len(over) > 0 {
+		fmt.Fprintf(o.io.StdErr, "%s Over the capacity of %d: %s.\n", c.WarnIcon(), o.capacity, strings.Join(over, ", "))
+	}
+}
+
+func (o *options) writeCSV(rows []*row) error {
+	w := csv.NewWriter(o.io.StdOut)
+	if err := w.Write([]string{o.by, "assignee", "issues", "unweighted_issues", "weight", "capacity", "over_capacity"}); err != nil {
+		return err
+	}
+	for _, r := range rows {
+		record := []string{
+			r.period,
+			r.assignee,
+			strconv.Itoa(r.issues),
+			strconv.Itoa(r.unweighted),
+			strconv.FormatInt(r.weight, 10),
+			strconv.FormatInt(o.capacity, 10),
+			strconv.FormatBool(o.overCapacity(r)),
+		}
+		if err := w.Write(record); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
@@ -0,0 +1,90 @@
+//go:build !integration
+
+package report
+
+import (
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func testIssues() []*gitlab.Issue {
+	milestone := &gitlab.Milestone{Title: "17.0"}
+	alice := &gitlab.IssueAssignee{Username: "alice"}
+	bob := &gitlab.IssueAssignee{Username: "bob"}
+	return []*gitlab.Issue{
+		{IID: 1, Weight: 8, Milestone: milestone, Assignees: []*gitlab.IssueAssignee{alice}},
+		{IID: 2, Weight: 5, Milestone: milestone, Assignees: []*gitlab.IssueAssignee{alice, bob}},
+		{IID: 3, Milestone: milestone, Assignees: []*gitlab.IssueAssignee{bob}},
+		{IID: 4, Weight: 3, Milestone: milestone},
+		{IID: 5, Weight: 2, Assignees: []*gitlab.IssueAssignee{alice}},
+		{IID: 6, Weight: 1, Iteration: &gitlab.GroupIteration{ID: 9}, Assignees: []*gitlab.IssueAssignee{bob}},
+	}
+}
+
+func TestReport(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	tc := gitlabtesting.NewTestClient(t)
+	tc.MockIssues.EXPECT().
+		ListGroupIssues("my-group", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, opts *gitlab.ListGroupIssuesOptions, _ ...gitlab.RequestOptionFunc) ([]*gitlab.Issue, *gitlab.Response, error) {
+			assert.Equal(t, "opened", *opts.State)
+			return testIssues(), &gitlab.Response{}, nil
+		})
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdReport, false, cmdtest.WithGitLabClient(tc.Client))
+
+	out, err := exec("--group my-group --capacity 10")
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		MILESTONE	ASSIGNEE	ISSUES	UNWEIGHTED	WEIGHT	LOAD
+		17.0	alice	2	0	13	130%
+		17.0	bob	2	1	5	50%
+		17.0	(unassigned)	1	0	3	
+		(none)	alice	1	0	2	20%
+		(none)	bob	1	0	1	10%
+	`), out.String())
+	assert.Equal(t, "! Over the capacity of 10: alice (17.0).\n", out.Stderr())
+}
+
+func TestReportByIterationCSV(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+	tc.MockIssues.EXPECT().
+		ListProjectIssues("OWNER/REPO", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, opts *gitlab.ListProjectIssuesOptions, _ ...gitlab.RequestOptionFunc) ([]*gitlab.Issue, *gitlab.Response, error) {
+			assert.Nil(t, opts.State)
+			assert.Equal(t, int64(9), *opts.IterationID)
+			return testIssues()[5:], &gitlab.Response{}, nil
+		})
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdReport, false, cmdtest.WithGitLabClient(tc.Client))
+
+	out, err := exec("--by iteration --iteration 9 --state all --output csv")
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		iteration,assignee,issues,unweighted_issues,weight,capacity,over_capacity
+		Iteration 9,bob,1,0,1,0,false
+	`), out.String())
+}
+
+func TestIterationName(t *testing.T) {
+	start, err := gitlab.ParseISOTime("2026-10-05")
+	require.NoError(t, err)
+	due, err := gitlab.ParseISOTime("2026-10-18")
+	require.NoError(t, err)
+
+	assert.Equal(t, "(none)", iterationName(nil))
+	assert.Equal(t, "Sprint 4", iterationName(&gitlab.GroupIteration{Title: "Sprint 4"}))
+	assert.Equal(t, "2026-10-05 - 2026-10-18", iterationName(&gitlab.GroupIteration{StartDate: &start, DueDate: &due}))
+}
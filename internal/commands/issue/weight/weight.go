@@ -0,0 +1,19 @@
+package weight
+
+import (
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	cmdReport "gitlab.com/gitlab-org/cli/internal/commands/issue/weight/report"
+)
+
+func NewCmdWeight(f cmdutils.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "weight <command> [flags]",
+		Short: `Report on the weights of issues.`,
+	}
+
+	cmd.AddCommand(cmdReport.NewCmdReport(f))
+
+	return cmd
+}
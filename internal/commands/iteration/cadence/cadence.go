@@ -0,0 +1,19 @@
+package cadence
+
+import (
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	cadenceListCmd "gitlab.com/gitlab-org/cli/internal/commands/iteration/cadence/list"
+)
+
+func NewCmdCadence(f cmdutils.Factory) *cobra.Command {
+	cadenceCmd := &cobra.Command{
+		Use:   "cadence <command> [flags]",
+		Short: `Retrieve iteration cadence information.`,
+		Long:  ``,
+	}
+
+	cadenceCmd.AddCommand(cadenceListCmd.NewCmdList(f))
+	return cadenceCmd
+}
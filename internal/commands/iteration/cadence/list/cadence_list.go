@@ -0,0 +1,100 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	apiClient    func(repoHost string) (*api.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+	group        string
+	outputFormat string
+}
+
+func NewCmdList(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:        f.IO(),
+		apiClient: f.ApiClient,
+		baseRepo:  f.BaseRepo,
+	}
+
+	cadenceListCmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   `List the iteration cadences of a group.`,
+		Long:    "Lists the iteration cadences of a group and its ancestor groups. Defaults to the group of the current project.\n",
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			- glab iteration cadence list
+			- glab iteration cadence list -g mygroup --output json
+		`),
+		Args: cobra.ExactArgs(0),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+
+	cadenceListCmd.Flags().StringVarP(&opts.group, "group", "g", "", "List the iteration cadences of a group.")
+	cadenceListCmd.Flags().VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+	return cadenceListCmd
+}
+
+func (o *options) run() error {
+	var repoHost string
+	group := o.group
+	if baseRepo, err := o.baseRepo(); err == nil {
+		repoHost = baseRepo.RepoHost()
+		if group == "" {
+			group = baseRepo.RepoOwner()
+		}
+	} else if group == "" {
+		return err
+	}
+	apiClient, err := o.apiClient(repoHost)
+	if err != nil {
+		return err
+	}
+
+	cadences, err := api.ListIterationCadences(apiClient.Lab(), group)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to list the iteration cadences of %s.", group))
+	}
+
+	if o.outputFormat == "json" {
+		cadencesJSON, _ := json.Marshal(cadences)
+		fmt.Fprintln(o.io.StdOut, string(cadencesJSON))
+		return nil
+	}
+
+	if len(cadences) == 0 {
+		fmt.Fprintf(o.io.StdOut, "No iteration cadences found for group %s.\n", group)
+		return nil
+	}
+
+	table := tableprinter.New(o.io)
+	table.AddHeader("TITLE", "START DATE", "DURATION", "AUTOMATIC", "ACTIVE")
+	for _, cadence := range cadences {
+		duration := ""
+		if cadence.DurationInWeeks > 0 {
+			duration = fmt.Sprintf("%d weeks", cadence.DurationInWeeks)
+		}
+		table.AddRow(cadence.Title, cadence.StartDate, duration, strconv.FormatBool(cadence.Automatic), strconv.FormatBool(cadence.Active))
+	}
+	fmt.Fprint(o.io.StdOut, table.String())
+	return nil
+}
@@ -0,0 +1,49 @@
+//go:build !integration
+
+package list
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestCadenceList(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var query gitlab.GraphQLQuery
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&query))
+		assert.Equal(t, map[string]any{"fullPath": "OWNER"}, query.Variables)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": {"group": {"iterationCadences": {"nodes": [
+			{"id": "gid://gitlab/Iterations::Cadence/3", "title": "Sprints", "automatic": true, "active": true, "startDate": "2026-01-05", "durationInWeeks": 2}
+		]}}}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := gitlab.NewClient("test-token", gitlab.WithBaseURL(server.URL+"/api/v4"))
+	require.NoError(t, err)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdList, false,
+		cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(client))),
+	)
+
+	output, err := exec("")
+	require.NoError(t, err)
+	assert.Equal(t, heredoc.Doc(`
+		TITLE	START DATE	DURATION	AUTOMATIC	ACTIVE
+		Sprints	2026-01-05	2 weeks	true	true
+	`), output.String())
+}
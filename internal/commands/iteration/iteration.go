@@ -4,7 +4,9 @@ import (
 	"github.com/spf13/cobra"
 
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	iterationCadenceCmd "gitlab.com/gitlab-org/cli/internal/commands/iteration/cadence"
 	iterationListCmd "gitlab.com/gitlab-org/cli/internal/commands/iteration/list"
+	iterationViewCmd "gitlab.com/gitlab-org/cli/internal/commands/iteration/view"
 )
 
 func NewCmdIteration(f cmdutils.Factory) *cobra.Command {
@@ -17,5 +19,7 @@ func NewCmdIteration(f cmdutils.Factory) *cobra.Command {
 	cmdutils.EnableRepoOverride(iterationCmd, f)
 
 	iterationCmd.AddCommand(iterationListCmd.NewCmdList(f))
+	iterationCmd.AddCommand(iterationViewCmd.NewCmdView(f))
+	iterationCmd.AddCommand(iterationCadenceCmd.NewCmdCadence(f))
 	return iterationCmd
 }
@@ -23,6 +23,7 @@ type options struct {
 	apiClient    func(repoHost string) (*api.Client, error)
 	baseRepo     func() (glrepo.Interface, error)
 	group        string
+	state        string
 	page         int
 	perPage      int
 	outputFormat string
@@ -45,6 +46,7 @@ func NewCmdList(f cmdutils.Factory) *cobra.Command {
 			- glab iteration ls
 			- glab iteration list -R owner/repository
 			- glab iteration list -g mygroup
+			- glab iteration list --state current
 		`),
 		Args: cobra.ExactArgs(0),
 		Annotations: map[string]string{
@@ -59,11 +61,13 @@ func NewCmdList(f cmdutils.Factory) *cobra.Command {
 	iterationListCmd.Flags().IntVarP(&opts.perPage, "per-page", "P", 30, "Number of items to list per page.")
 	iterationListCmd.Flags().StringVarP(&opts.outputFormat, "output", "F", "text", "Format output as: text, json.")
 	iterationListCmd.Flags().StringVarP(&opts.group, "group", "g", "", "List iterations for a group.")
+	iterationListCmd.Flags().VarP(cmdutils.NewEnumValue([]string{"opened", "upcoming", "current", "closed", "all"}, "all", &opts.state), "state", "s", "List iterations in this state: opened, upcoming, current, closed, all. Opened iterations are upcoming or current.")
 	return iterationListCmd
 }
 
 type listProjectIterationsOptions struct {
 	IncludeAncestors *bool
+	State            *string
 	PerPage          int64
 	Page             int64
 }
@@ -71,6 +75,7 @@ type listProjectIterationsOptions struct {
 func (opts *listProjectIterationsOptions) listProjectIterationsOptions() *gitlab.ListProjectIterationsOptions {
 	projectOpts := &gitlab.ListProjectIterationsOptions{}
 	projectOpts.IncludeAncestors = opts.IncludeAncestors
+	projectOpts.State = opts.State
 	projectOpts.PerPage = opts.PerPage
 	projectOpts.Page = opts.Page
 	return projectOpts
@@ -79,6 +84,7 @@ func (opts *listProjectIterationsOptions) listProjectIterationsOptions() *gitlab
 func (opts *listProjectIterationsOptions) listGroupIterationsOptions() *gitlab.ListGroupIterationsOptions {
 	groupOpts := &gitlab.ListGroupIterationsOptions{}
 	groupOpts.IncludeAncestors = opts.IncludeAncestors
+	groupOpts.State = opts.State
 	groupOpts.PerPage = opts.PerPage
 	groupOpts.Page = opts.Page
 	return groupOpts
@@ -100,6 +106,9 @@ func (o *options) run() error {
 
 	iterationApiOpts := &listProjectIterationsOptions{}
 	iterationApiOpts.IncludeAncestors = gitlab.Ptr(true)
+	if o.state != "all" {
+		iterationApiOpts.State = gitlab.Ptr(o.state)
+	}
 
 	if o.page != 0 {
 		iterationApiOpts.Page = int64(o.page)
@@ -178,3 +178,25 @@ func TestIterationListEmpty(t *testing.T) {
 	assert.Equal(t, "Showing iteration 0 of 0 on OWNER/REPO.\n\n\n", output.String())
 	assert.Empty(t, output.Stderr())
 }
+
+func TestIterationListState(t *testing.T) {
+	t.Parallel()
+
+	testClient := gitlabtesting.NewTestClient(t)
+
+	testClient.MockGroupIterations.EXPECT().
+		ListGroupIterations("my-group", gomock.Any()).
+		DoAndReturn(func(_ any, opts *gitlab.ListGroupIterationsOptions, _ ...gitlab.RequestOptionFunc) ([]*gitlab.GroupIteration, *gitlab.Response, error) {
+			assert.Equal(t, "current", *opts.State)
+			return []*gitlab.GroupIteration{{ID: 53, Title: "Iteration II", WebURL: "http://gitlab.example.com/groups/my-group/-/iterations/53"}}, nil, nil
+		})
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdList, true,
+		cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(testClient.Client))),
+		cmdtest.WithBaseRepo("OWNER", "REPO", ""),
+	)
+
+	output, err := exec("--group my-group --state current")
+	require.NoError(t, err)
+	assert.Contains(t, output.String(), "Iteration II")
+}
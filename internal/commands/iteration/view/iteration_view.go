@@ -0,0 +1,233 @@
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/issuable/issuableutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+// now is replaced in tests to get a stable number of days until the due date.
+var now = time.Now
+
+// Summary is the progress of an iteration, and the JSON output of the command.
+type Summary struct {
+	ID            int64           `json:"id"`
+	IID           int64           `json:"iid"`
+	Title         string          `json:"title"`
+	Description   string          `json:"description"`
+	State         string          `json:"state"`
+	StartDate     *gitlab.ISOTime `json:"start_date"`
+	DueDate       *gitlab.ISOTime `json:"due_date"`
+	WebURL        string          `json:"web_url"`
+	OpenIssues    int             `json:"open_issues"`
+	ClosedIssues  int             `json:"closed_issues"`
+	TotalWeight   int64           `json:"total_weight"`
+	ClosedWeight  int64           `json:"closed_weight"`
+	DaysRemaining *int            `json:"days_remaining,omitempty"`
+	Issues        []*gitlab.Issue `json:"issues"`
+}
+
+type options struct {
+	io        *iostreams.IOStreams
+	apiClient func(repoHost string) (*api.Client, error)
+	baseRepo  func() (glrepo.Interface, error)
+
+	group        string
+	outputFormat string
+}
+
+func NewCmdView(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:        f.IO(),
+		apiClient: f.ApiClient,
+		baseRepo:  f.BaseRepo,
+	}
+
+	iterationViewCmd := &cobra.Command{
+		Use:   "view <id | @current> [flags]",
+		Short: `View the dates, progress, and issues of an iteration.`,
+		Long: heredoc.Doc(`
+			View the dates and state of an iteration, how many of its issues are
+			closed, how much of their weight is completed, and its issues.
+
+			Use @current to view the current iteration of the project or group.
+		`),
+		Example: heredoc.Doc(`
+			- glab iteration view 53
+			- glab iteration view @current
+			- glab iteration view @current -g mygroup --output json
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run(args[0])
+		},
+	}
+
+	iterationViewCmd.Flags().StringVarP(&opts.group, "group", "g", "", "View an iteration of a group.")
+	iterationViewCmd.Flags().VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+	return iterationViewCmd
+}
+
+func (o *options) run(arg string) error {
+	var repoHost, project string
+	if baseRepo, err := o.baseRepo(); err == nil {
+		repoHost = baseRepo.RepoHost()
+		project = baseRepo.FullName()
+	} else if o.group == "" {
+		return err
+	}
+	apiClient, err := o.apiClient(repoHost)
+	if err != nil {
+		return err
+	}
+	client := apiClient.Lab()
+
+	iteration, err := findIteration(client, project, o.group, arg)
+	if err != nil {
+		return err
+	}
+
+	issues, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Issue, *gitlab.Response, error) {
+		return client.Issues.ListGroupIssues(iteration.GroupID, &gitlab.ListGroupIssuesOptions{
+			ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage},
+			IterationID: gitlab.Ptr(iteration.ID),
+		}, p)
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to list the issues of iteration %d.", iteration.ID))
+	}
+
+	summary := newSummary(iteration, issues)
+	if o.outputFormat == "json" {
+		summaryJSON, _ := json.Marshal(summary)
+		fmt.Fprintln(o.io.StdOut, string(summaryJSON))
+		return nil
+	}
+
+	fmt.Fprint(o.io.StdOut, summary.Render(o.io))
+	return nil
+}
+
+// findIteration returns the iteration with the ID in arg, or the current
+// iteration for @current.
+func findIteration(client *gitlab.Client, project, group, arg string) (*gitlab.GroupIteration, error) {
+	if arg == api.CurrentIterationArg {
+		return api.CurrentIteration(client, project, group)
+	}
+
+	id, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid iteration %q. Use an iteration ID or %s.", arg, api.CurrentIterationArg)
+	}
+	iterations, err := api.ListIterations(client, project, group, "all")
+	if err != nil {
+		return nil, err
+	}
+	for _, it := range iterations {
+		if it.ID == id {
+			return it, nil
+		}
+	}
+	return nil, fmt.Errorf("iteration %d not found.", id)
+}
+
+func newSummary(it *gitlab.GroupIteration, issues []*gitlab.Issue) *Summary {
+	s := &Summary{
+		ID:          it.ID,
+		IID:         it.IID,
+		Title:       it.Title,
+		Description: it.Description,
+		State:       api.IterationState(it.State),
+		StartDate:   it.StartDate,
+		DueDate:     it.DueDate,
+		WebURL:      it.WebURL,
+		Issues:      issues,
+	}
+	if s.Issues == nil {
+		s.Issues = []*gitlab.Issue{}
+	}
+	for _, issue := range issues {
+		s.TotalWeight += issue.Weight
+		if issue.State == "closed" {
+			s.ClosedIssues++
+			s.ClosedWeight += issue.Weight
+		} else {
+			s.OpenIssues++
+		}
+	}
+	if s.DueDate != nil && s.State == "current" {
+		days := issuableutils.DaysUntil(time.Time(*s.DueDate), now())
+		s.DaysRemaining = &days
+	}
+	return s
+}
+
+// name returns the title of the iteration, or its dates for the iterations of
+// cadences that don't have titles.
+func (s *Summary) name() string {
+	if s.Title != "" {
+		return s.Title
+	}
+	return fmt.Sprintf("%s - %s", utils.FormatDueDate(s.StartDate), utils.FormatDueDate(s.DueDate))
+}
+
+// Render returns the summary as text, with the issues in a table for the output of ios.
+func (s *Summary) Render(ios *iostreams.IOStreams) string {
+	var b strings.Builder
+
+	total := s.OpenIssues + s.ClosedIssues
+
+	fmt.Fprintf(&b, "Title: %s\n", s.name())
+	fmt.Fprintf(&b, "State: %s\n", s.State)
+	if s.StartDate != nil {
+		fmt.Fprintf(&b, "Start Date: %s\n", utils.FormatDueDate(s.StartDate))
+	}
+	if s.DueDate != nil {
+		fmt.Fprintf(&b, "Due Date: %s%s\n", utils.FormatDueDate(s.DueDate), issuableutils.Remaining(s.DaysRemaining, "ends"))
+	}
+	fmt.Fprintf(&b, "URL: %s\n", s.WebURL)
+	if s.Description != "" {
+		fmt.Fprintf(&b, "\n%s\n", s.Description)
+	}
+
+	b.WriteString("\n")
+	if total == 0 {
+		b.WriteString("There are no issues in this iteration.\n")
+		return b.String()
+	}
+	fmt.Fprintf(&b, "Issues: %d of %d closed (%d%%), %d open\n", s.ClosedIssues, total, issuableutils.Percent(int64(s.ClosedIssues), int64(total)), s.OpenIssues)
+	if s.TotalWeight > 0 {
+		fmt.Fprintf(&b, "Weight: %d of %d completed (%d%%)\n", s.ClosedWeight, s.TotalWeight, issuableutils.Percent(s.ClosedWeight, s.TotalWeight))
+	}
+
+	b.WriteString("\n")
+	table := tableprinter.New(ios)
+	for _, issue := range s.Issues {
+		assignees := make([]string, 0, len(issue.Assignees))
+		for _, a := range issue.Assignees {
+			assignees = append(assignees, "@"+a.Username)
+		}
+		table.AddRow(issuableutils.IssueReference(issue), issue.State, issue.Title, strings.Join(assignees, ", "))
+	}
+	b.WriteString(table.String())
+	return b.String()
+}
@@ -0,0 +1,103 @@
+//go:build !integration
+
+package view
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func isoDate(t *testing.T, s string) *gitlab.ISOTime {
+	t.Helper()
+	date, err := gitlab.ParseISOTime(s)
+	require.NoError(t, err)
+	return &date
+}
+
+func TestIterationView(t *testing.T) {
+	now = func() time.Time { return time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC) }
+	t.Cleanup(func() { now = time.Now })
+
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockProjectIterations.EXPECT().
+		ListProjectIterations("OWNER/REPO", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, opts *gitlab.ListProjectIterationsOptions, _ ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectIteration, *gitlab.Response, error) {
+			assert.Equal(t, "current", *opts.State)
+			return []*gitlab.ProjectIteration{{
+				ID:        53,
+				GroupID:   5,
+				State:     2,
+				StartDate: isoDate(t, "2026-10-05"),
+				DueDate:   isoDate(t, "2026-10-18"),
+				WebURL:    "https://gitlab.example.com/groups/my-group/-/iterations/53",
+			}}, &gitlab.Response{}, nil
+		})
+	testClient.MockIssues.EXPECT().
+		ListGroupIssues(int64(5), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, opts *gitlab.ListGroupIssuesOptions, _ ...gitlab.RequestOptionFunc) ([]*gitlab.Issue, *gitlab.Response, error) {
+			assert.Equal(t, int64(53), *opts.IterationID)
+			return []*gitlab.Issue{
+				{IID: 1, State: "closed", Title: "Fix login", Weight: 3, References: &gitlab.IssueReferences{Full: "my-group/app#1"}},
+				{IID: 2, State: "opened", Title: "Add logout", Weight: 5, References: &gitlab.IssueReferences{Full: "my-group/app#2"}, Assignees: []*gitlab.IssueAssignee{{Username: "alice"}}},
+			}, &gitlab.Response{}, nil
+		})
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdView, false,
+		cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(testClient.Client))),
+	)
+
+	output, err := exec("@current")
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		Title: 2026-10-05 - 2026-10-18
+		State: current
+		Start Date: 2026-10-05
+		Due Date: 2026-10-18 (4 days left)
+		URL: https://gitlab.example.com/groups/my-group/-/iterations/53
+
+		Issues: 1 of 2 closed (50%), 1 open
+		Weight: 3 of 8 completed (37%)
+
+		my-group/app#1	closed	Fix login	
+		my-group/app#2	opened	Add logout	@alice
+	`), output.String())
+}
+
+func TestIterationViewByID(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockGroupIterations.EXPECT().
+		ListGroupIterations("my-group", gomock.Any(), gomock.Any()).
+		Return([]*gitlab.GroupIteration{{ID: 53, GroupID: 5, Title: "Sprint 4", State: 3}}, &gitlab.Response{}, nil).
+		Times(2)
+	testClient.MockIssues.EXPECT().
+		ListGroupIssues(int64(5), gomock.Any(), gomock.Any()).
+		Return([]*gitlab.Issue{}, &gitlab.Response{}, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdView, false,
+		cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(testClient.Client))),
+	)
+
+	output, err := exec("53 --group my-group --output json")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"id": 53, "iid": 0, "title": "Sprint 4", "description": "", "state": "closed",
+		"start_date": null, "due_date": null, "web_url": "",
+		"open_issues": 0, "closed_issues": 0, "total_weight": 0, "closed_weight": 0,
+		"issues": []
+	}`, output.String())
+
+	_, err = exec("54 --group my-group")
+	assert.EqualError(t, err, "iteration 54 not found.")
+}
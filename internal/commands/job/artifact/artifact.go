@@ -1,6 +1,8 @@
 package artifact
 
 import (
+	"errors"
+
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/spf13/cobra"
 
@@ -9,17 +11,28 @@ import (
 )
 
 func NewCmdArtifact(f cmdutils.Factory) *cobra.Command {
+	opts := &DownloadOptions{}
+
 	jobArtifactCmd := &cobra.Command{
-		Use:     "artifact <refName> <jobName> [flags]",
+		Use:     "artifact <refName> [<jobName>] [flags]",
 		Short:   `Download all artifacts from the last pipeline.`,
 		Aliases: []string{"push"},
 		Example: heredoc.Doc(`
 			$ glab job artifact main build
 			$ glab job artifact main deploy --path="artifacts/"
 			$ glab job artifact main deploy --list-paths
+
+			# Extract only the dist directory
+			$ glab job artifact main build --include "dist/**"
+
+			# Download the artifacts of every build job of the latest pipeline
+			$ glab job artifact main --job-glob "build-*"
+
+			# Print a test report without writing anything to disk
+			$ glab job artifact main test --print reports/junit.xml
 		`),
 		Long: ``,
-		Args: cobra.ExactArgs(2),
+		Args: ValidateArgs(opts),
 		Annotations: map[string]string{
 			mcpannotations.Safe: "true",
 		},
@@ -32,18 +45,32 @@ func NewCmdArtifact(f cmdutils.Factory) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			path, err := cmd.Flags().GetString("path")
-			if err != nil {
-				return err
-			}
-			listPaths, err := cmd.Flags().GetBool("list-paths")
-			if err != nil {
-				return err
+
+			opts.Out = f.IO().StdOut
+			jobName := ""
+			if len(args) > 1 {
+				jobName = args[1]
 			}
-			return DownloadArtifacts(client, repo, path, listPaths, args[0], args[1])
+			return DownloadArtifacts(client, repo, args[0], jobName, opts)
 		},
 	}
-	jobArtifactCmd.Flags().StringP("path", "p", "./", "Path to download the artifact files.")
-	jobArtifactCmd.Flags().BoolP("list-paths", "l", false, "Print the paths of downloaded artifacts.")
+	jobArtifactCmd.Flags().StringVarP(&opts.Path, "path", "p", "./", "Path to download the artifact files.")
+	jobArtifactCmd.Flags().BoolVarP(&opts.ListPaths, "list-paths", "l", false, "Print the paths of downloaded artifacts.")
+	AddDownloadFlags(jobArtifactCmd.Flags(), opts)
+	jobArtifactCmd.MarkFlagsMutuallyExclusive("print", "job-glob")
+	jobArtifactCmd.MarkFlagsMutuallyExclusive("print", "include")
 	return jobArtifactCmd
 }
+
+// ValidateArgs requires a job name, unless the jobs are selected with --job-glob.
+func ValidateArgs(opts *DownloadOptions) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		if opts.JobGlob != "" {
+			if len(args) != 1 {
+				return cmdutils.FlagError{Err: errors.New("pass only the ref name with --job-glob.")}
+			}
+			return nil
+		}
+		return cobra.ExactArgs(2)(cmd, args)
+	}
+}
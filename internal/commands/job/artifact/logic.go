@@ -6,9 +6,13 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path"
 	"path/filepath"
+	"regexp"
 	"strings"
 
+	"github.com/spf13/pflag"
+
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 
 	"gitlab.com/gitlab-org/cli/internal/config"
@@ -35,7 +39,69 @@ func ensurePathIsCreated(filename string) error {
 	return nil
 }
 
-func readZip(artifact *bytes.Reader, path string, listPaths bool, zipReadLimit int64, zipFileLimit int) error {
+// DownloadOptions selects the artifacts to download, and where to write them.
+type DownloadOptions struct {
+	// Path is the directory to extract the artifacts to.
+	Path      string
+	ListPaths bool
+	// Include only extracts the files that match one of these globs, if set.
+	Include []string
+	// JobGlob downloads the artifacts of every job of the latest pipeline with a
+	// matching name, each in its own directory, instead of a single job.
+	JobGlob string
+	// Print writes this file of the artifacts to Out, instead of extracting anything.
+	Print string
+	Out   io.Writer
+}
+
+// AddDownloadFlags adds the flags that select which artifacts to download.
+func AddDownloadFlags(flags *pflag.FlagSet, opts *DownloadOptions) {
+	flags.StringSliceVar(&opts.Include, "include", nil, "Only extract the files that match these globs, like 'dist/**'. Multiple globs can be comma-separated or specified by repeating the flag.")
+	flags.StringVar(&opts.JobGlob, "job-glob", "", "Download the artifacts of every job of the latest pipeline with a name that matches this glob, like 'build-*', each to a directory named after the job.")
+	flags.StringVar(&opts.Print, "print", "", "Print this file of the artifacts, like 'reports/junit.xml', instead of extracting them.")
+}
+
+// matchArtifactPath reports whether the file name matches the glob. Like in
+// .gitlab-ci.yml, * matches within a directory and ** matches across directories.
+func matchArtifactPath(glob, name string) bool {
+	var expr strings.Builder
+	expr.WriteString("^")
+	for i := 0; i < len(glob); i++ {
+		switch {
+		case strings.HasPrefix(glob[i:], "**/"):
+			expr.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(glob[i:], "**"):
+			expr.WriteString(".*")
+			i++
+		case glob[i] == '*':
+			expr.WriteString("[^/]*")
+		case glob[i] == '?':
+			expr.WriteString("[^/]")
+		default:
+			expr.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+		}
+	}
+	expr.WriteString("$")
+
+	matched, err := regexp.MatchString(expr.String(), name)
+	return err == nil && matched
+}
+
+func isIncluded(include []string, name string) bool {
+	if len(include) == 0 {
+		return true
+	}
+	name = strings.TrimPrefix(name, "./")
+	for _, glob := range include {
+		if matchArtifactPath(strings.TrimPrefix(glob, "./"), name) {
+			return true
+		}
+	}
+	return false
+}
+
+func readZip(artifact *bytes.Reader, path string, listPaths bool, include []string, zipReadLimit int64, zipFileLimit int) error {
 	zipReader, err := zip.NewReader(artifact, artifact.Size())
 	if err != nil {
 		return err
@@ -57,6 +123,11 @@ func readZip(artifact *bytes.Reader, path string, listPaths bool, zipReadLimit i
 	}
 
 	for _, v := range zipReader.File {
+		// Directories of included files are created with the files.
+		if len(include) > 0 && (v.FileInfo().IsDir() || !isIncluded(include, v.Name)) {
+			continue
+		}
+
 		sanitizedAssetName := utils.SanitizePathName(v.Name)
 
 		destDir, err := filepath.Abs(path)
@@ -130,11 +201,67 @@ func friendlyPath(path string) string {
 	return rel
 }
 
-func DownloadArtifacts(apiClient *gitlab.Client, repo glrepo.Interface, path string, listPaths bool, refName string, jobName string) error {
+// DownloadArtifacts downloads the artifacts of the latest job with the name on the ref,
+// or of the jobs that match opts.JobGlob.
+func DownloadArtifacts(apiClient *gitlab.Client, repo glrepo.Interface, refName string, jobName string, opts *DownloadOptions) error {
+	if opts.JobGlob != "" {
+		return downloadMatchingJobs(apiClient, repo, refName, opts)
+	}
+
+	if opts.Print != "" {
+		file, _, err := apiClient.Jobs.DownloadSingleArtifactsFileByTagOrBranch(repo.FullName(), refName, opts.Print, &gitlab.DownloadArtifactsFileOptions{Job: &jobName})
+		if err != nil {
+			return fmt.Errorf("could not get %s from the artifacts of job %s: %w", opts.Print, jobName, err)
+		}
+		_, err = io.Copy(opts.Out, io.LimitReader(file, defaultZIPReadLimit))
+		return err
+	}
+
 	artifact, _, err := apiClient.Jobs.DownloadArtifactsFile(repo.FullName(), refName, &gitlab.DownloadArtifactsFileOptions{Job: &jobName}, nil)
 	if err != nil {
 		return err
 	}
 
-	return readZip(artifact, path, listPaths, defaultZIPReadLimit, defaultZIPFileLimit)
+	return readZip(artifact, opts.Path, opts.ListPaths, opts.Include, defaultZIPReadLimit, defaultZIPFileLimit)
+}
+
+func downloadMatchingJobs(apiClient *gitlab.Client, repo glrepo.Interface, refName string, opts *DownloadOptions) error {
+	pipeline, _, err := apiClient.Pipelines.GetLatestPipeline(repo.FullName(), &gitlab.GetLatestPipelineOptions{Ref: gitlab.Ptr(refName)})
+	if err != nil {
+		return fmt.Errorf("could not get the latest pipeline of %s: %w", refName, err)
+	}
+	jobs, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Job, *gitlab.Response, error) {
+		return apiClient.Jobs.ListPipelineJobs(repo.FullName(), pipeline.ID, &gitlab.ListJobsOptions{ListOptions: gitlab.ListOptions{PerPage: 100}}, p)
+	})
+	if err != nil {
+		return fmt.Errorf("could not list the jobs of pipeline %d: %w", pipeline.ID, err)
+	}
+
+	var matched []*gitlab.Job
+	for _, job := range jobs {
+		if ok, _ := path.Match(opts.JobGlob, job.Name); ok && job.ArtifactsFile.Filename != "" {
+			matched = append(matched, job)
+		}
+	}
+	if len(matched) == 0 {
+		return fmt.Errorf("no jobs with artifacts match %q in pipeline %d.", opts.JobGlob, pipeline.ID)
+	}
+
+	for _, job := range matched {
+		artifact, _, err := apiClient.Jobs.GetJobArtifacts(repo.FullName(), job.ID)
+		if err != nil {
+			return fmt.Errorf("could not download the artifacts of job %s: %w", job.Name, err)
+		}
+
+		// Job names can contain slashes, like "test 1/3" of parallel jobs.
+		name := strings.ReplaceAll(job.Name, "/", "_")
+		if name == "." || name == ".." {
+			name = "_" + name
+		}
+		dir := filepath.Join(opts.Path, name)
+		if err := readZip(artifact, dir, opts.ListPaths, opts.Include, defaultZIPReadLimit, defaultZIPFileLimit); err != nil {
+			return fmt.Errorf("could not extract the artifacts of job %s: %w", job.Name, err)
+		}
+	}
+	return nil
 }
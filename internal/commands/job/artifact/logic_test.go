@@ -11,7 +11,12 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
 	"gitlab.com/gitlab-org/cli/test"
 )
 
@@ -89,7 +94,7 @@ func TestAcceptableZipFile(t *testing.T) {
 	os.Stdout = w
 
 	listPaths := true
-	err = readZip(reader, targetDir, listPaths, defaultZIPReadLimit, defaultZIPFileLimit)
+	err = readZip(reader, targetDir, listPaths, nil, defaultZIPReadLimit, defaultZIPFileLimit)
 	stdout := test.ReturnBuffer(old, r, w)
 	require.NoError(t, err)
 
@@ -112,7 +117,7 @@ func TestFileLimitExceeded(t *testing.T) {
 	reader, err := toByteReader(zipName)
 	require.NoError(t, err)
 
-	err = readZip(reader, t.TempDir(), false, defaultZIPReadLimit, 50)
+	err = readZip(reader, t.TempDir(), false, nil, defaultZIPReadLimit, 50)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "zip archive includes too many files")
 }
@@ -123,7 +128,111 @@ func TestReadLimitExceeded(t *testing.T) {
 	reader, err := toByteReader(zipName)
 	require.NoError(t, err)
 
-	err = readZip(reader, t.TempDir(), false, 50, defaultZIPFileLimit)
+	err = readZip(reader, t.TempDir(), false, nil, 50, defaultZIPFileLimit)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "extracted zip too large")
 }
+
+func TestMatchArtifactPath(t *testing.T) {
+	tests := []struct {
+		glob    string
+		name    string
+		matches bool
+	}{
+		{glob: "dist/**", name: "dist/app.js", matches: true},
+		{glob: "dist/**", name: "dist/assets/logo.png", matches: true},
+		{glob: "dist/**", name: "src/app.js", matches: false},
+		{glob: "dist/*.js", name: "dist/app.js", matches: true},
+		{glob: "dist/*.js", name: "dist/vendor/lib.js", matches: false},
+		{glob: "**/*.xml", name: "junit.xml", matches: true},
+		{glob: "**/*.xml", name: "reports/unit/junit.xml", matches: true},
+		{glob: "report-?.txt", name: "report-1.txt", matches: true},
+		{glob: "coverage.out", name: "coverage_out", matches: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.glob+" "+tt.name, func(t *testing.T) {
+			require.Equal(t, tt.matches, matchArtifactPath(tt.glob, tt.name))
+		})
+	}
+}
+
+func createArtifactZip(t *testing.T, files map[string]string) *bytes.Reader {
+	t.Helper()
+
+	buf := new(bytes.Buffer)
+	zipWriter := zip.NewWriter(buf)
+	for name, content := range files {
+		w, err := zipWriter.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zipWriter.Close())
+
+	return bytes.NewReader(buf.Bytes())
+}
+
+func TestReadZipInclude(t *testing.T) {
+	reader := createArtifactZip(t, map[string]string{
+		"dist/app.js":         "app",
+		"dist/assets/app.css": "css",
+		"src/app.ts":          "source",
+	})
+	targetDir := t.TempDir()
+
+	err := readZip(reader, targetDir, false, []string{"dist/**"}, defaultZIPReadLimit, defaultZIPFileLimit)
+	require.NoError(t, err)
+
+	require.FileExists(t, filepath.Join(targetDir, "dist", "app.js"))
+	require.FileExists(t, filepath.Join(targetDir, "dist", "assets", "app.css"))
+	require.NoFileExists(t, filepath.Join(targetDir, "src", "app.ts"))
+}
+
+func TestDownloadArtifacts_jobGlob(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+	repo := glrepo.New("OWNER", "REPO", "gitlab.com")
+
+	tc.MockPipelines.EXPECT().
+		GetLatestPipeline("OWNER/REPO", &gitlab.GetLatestPipelineOptions{Ref: gitlab.Ptr("main")}).
+		Return(&gitlab.Pipeline{ID: 5}, nil, nil)
+	tc.MockJobs.EXPECT().
+		ListPipelineJobs("OWNER/REPO", int64(5), gomock.Any(), gomock.Any()).
+		Return([]*gitlab.Job{
+			{ID: 1, Name: "build-linux", ArtifactsFile: gitlab.JobArtifactsFile{Filename: "artifacts.zip"}},
+			{ID: 2, Name: "build-darwin", ArtifactsFile: gitlab.JobArtifactsFile{Filename: "artifacts.zip"}},
+			{ID: 3, Name: "build-docs"},
+			{ID: 4, Name: "test", ArtifactsFile: gitlab.JobArtifactsFile{Filename: "artifacts.zip"}},
+		}, &gitlab.Response{}, nil)
+	tc.MockJobs.EXPECT().
+		GetJobArtifacts("OWNER/REPO", int64(1)).
+		Return(createArtifactZip(t, map[string]string{"bin/glab": "linux"}), nil, nil)
+	tc.MockJobs.EXPECT().
+		GetJobArtifacts("OWNER/REPO", int64(2)).
+		Return(createArtifactZip(t, map[string]string{"bin/glab": "darwin"}), nil, nil)
+
+	targetDir := t.TempDir()
+	err := DownloadArtifacts(tc.Client, repo, "main", "", &DownloadOptions{Path: targetDir, JobGlob: "build-*"})
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(filepath.Join(targetDir, "build-linux", "bin", "glab"))
+	require.NoError(t, err)
+	require.Equal(t, "linux", string(content))
+	content, err = os.ReadFile(filepath.Join(targetDir, "build-darwin", "bin", "glab"))
+	require.NoError(t, err)
+	require.Equal(t, "darwin", string(content))
+}
+
+func TestDownloadArtifacts_print(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+	repo := glrepo.New("OWNER", "REPO", "gitlab.com")
+
+	tc.MockJobs.EXPECT().
+		DownloadSingleArtifactsFileByTagOrBranch("OWNER/REPO", "main", "reports/junit.xml", &gitlab.DownloadArtifactsFileOptions{Job: gitlab.Ptr("test")}).
+		Return(bytes.NewReader([]byte("<testsuites/>")), nil, nil)
+
+	var out bytes.Buffer
+	err := DownloadArtifacts(tc.Client, repo, "main", "test", &DownloadOptions{Print: "reports/junit.xml", Out: &out})
+	require.NoError(t, err)
+	require.Equal(t, "<testsuites/>", out.String())
+}
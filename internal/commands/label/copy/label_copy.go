@@ -0,0 +1,285 @@
+package copy
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/label/labelutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+const (
+	conflictAsk       = "ask"
+	conflictSkip      = "skip"
+	conflictOverwrite = "overwrite"
+)
+
+// destination is the project or group that the labels are copied to.
+type destination struct {
+	name   string
+	labels []*gitlab.Label
+	create func(label *gitlab.Label) error
+	update func(id int64, label *gitlab.Label) error
+}
+
+type options struct {
+	toProject  string
+	toGroup    string
+	prefix     string
+	names      []string
+	onConflict string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdCopy(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	labelCopyCmd := &cobra.Command{
+		Use:   "copy {--to <project> | --to-group <group>} [flags]",
+		Short: `Copy the labels of a project to another project or to a group.`,
+		Long: heredoc.Doc(`
+			Copy the labels of a project, with their colors and descriptions, to another
+			project or to a group. Labels that the project inherits from its groups are
+			not copied.
+
+			When a label with the same name exists with another color or description,
+			you are asked whether to overwrite it. Use --on-conflict to choose without
+			a prompt.
+		`),
+		Args: cobra.NoArgs,
+		Example: heredoc.Doc(`
+			# Copy the labels of the current project to another project
+			$ glab label copy --to my-group/new-project
+
+			# Move the labels of a project up to its group
+			$ glab label copy -R my-group/monorepo --to-group my-group
+
+			# Copy some labels, with a prefix, and overwrite the existing labels
+			$ glab label copy --to my-group/api --label bug --label feature --prefix 'api::' --on-conflict overwrite
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run(cmd.Context())
+		},
+	}
+
+	fl := labelCopyCmd.Flags()
+	fl.StringVar(&opts.toProject, "to", "", "Project to copy the labels to.")
+	fl.StringVar(&opts.toGroup, "to-group", "", "Group to copy the labels to.")
+	fl.StringVar(&opts.prefix, "prefix", "", "Prefix to add to the names of the copied labels.")
+	fl.StringSliceVarP(&opts.names, "label", "l", nil, "Copy only the labels with these names. Defaults to all labels of the project.")
+	fl.Var(cmdutils.NewEnumValue([]string{conflictAsk, conflictSkip, conflictOverwrite}, conflictAsk, &opts.onConflict), "on-conflict", "What to do with existing labels that have another color or description: ask, skip, overwrite. Without a prompt, ask skips them.")
+	labelCopyCmd.MarkFlagsOneRequired("to", "to-group")
+	labelCopyCmd.MarkFlagsMutuallyExclusive("to", "to-group")
+
+	return labelCopyCmd
+}
+
+func (o *options) run(ctx context.Context) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	labels, err := labelutils.ListProjectLabels(client, repo.FullName())
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to list the labels of %s.", repo.FullName()))
+	}
+	labels, err = o.selectLabels(labels)
+	if err != nil {
+		return err
+	}
+
+	dest, err := o.destination(client)
+	if err != nil {
+		return err
+	}
+	existing := make(map[string]*gitlab.Label, len(dest.labels))
+	for _, label := range dest.labels {
+		existing[label.Name] = label
+	}
+
+	c := o.io.Color()
+	copied, skipped := 0, 0
+	onConflict := o.onConflict
+	if onConflict == conflictAsk && !o.io.PromptEnabled() {
+		onConflict = conflictSkip
+	}
+
+	for _, label := range labels {
+		label := &gitlab.Label{Name: o.prefix + label.Name, Color: label.Color, Description: label.Description}
+
+		current, ok := existing[label.Name]
+		if !ok {
+			if err := dest.create(label); err != nil {
+				return cmdutils.WrapError(err, fmt.Sprintf("failed to create label %q in %s.", label.Name, dest.name))
+			}
+			fmt.Fprintf(o.io.StdOut, "  create %s\n", label.Name)
+			copied++
+			continue
+		}
+		if strings.EqualFold(current.Color, label.Color) && current.Description == label.Description {
+			continue
+		}
+
+		overwrite := onConflict == conflictOverwrite
+		if onConflict == conflictAsk {
+			overwrite, onConflict, err = o.askConflict(ctx, dest, current, label)
+			if err != nil {
+				return err
+			}
+		}
+		if !overwrite {
+			fmt.Fprintf(o.io.StdOut, "  skip %s: exists with another color or description\n", label.Name)
+			skipped++
+			continue
+		}
+
+		if err := dest.update(current.ID, label); err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to update label %q in %s.", label.Name, dest.name))
+		}
+		fmt.Fprintf(o.io.StdOut, "  update %s\n", label.Name)
+		copied++
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Copied %s to %s.\n", c.GreenCheck(), utils.Pluralize(copied, "label"), dest.name)
+	if skipped > 0 {
+		fmt.Fprintf(o.io.StdErr, "%s Skipped %s with another color or description in %s. Use --on-conflict overwrite to overwrite them.\n", c.WarnIcon(), utils.Pluralize(skipped, "label"), dest.name)
+	}
+	return nil
+}
+
+// selectLabels returns the labels named with --label, or all labels.
+func (o *options) selectLabels(labels []*gitlab.Label) ([]*gitlab.Label, error) {
+	if len(o.names) == 0 {
+		return labels, nil
+	}
+
+	var selected []*gitlab.Label
+	for _, name := range o.names {
+		i := slices.IndexFunc(labels, func(label *gitlab.Label) bool { return label.Name == name })
+		if i < 0 {
+			return nil, fmt.Errorf("label %q not found in the project.", name)
+		}
+		selected = append(selected, labels[i])
+	}
+	return selected, nil
+}
+
+func (o *options) destination(client *gitlab.Client) (*destination, error) {
+	if o.toGroup != "" {
+		groupLabels, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.GroupLabel, *gitlab.Response, error) {
+			return client.GroupLabels.ListGroupLabels(o.toGroup, &gitlab.ListGroupLabelsOptions{
+				ListOptions:           gitlab.ListOptions{PerPage: api.MaxPerPage},
+				IncludeAncestorGroups: gitlab.Ptr(false),
+			}, p)
+		})
+		if err != nil {
+			return nil, cmdutils.WrapError(err, fmt.Sprintf("failed to list the labels of group %s.", o.toGroup))
+		}
+
+		labels := make([]*gitlab.Label, 0, len(groupLabels))
+		for _, label := range groupLabels {
+			labels = append(labels, (*gitlab.Label)(label))
+		}
+		return &destination{
+			name:   "group " + o.toGroup,
+			labels: labels,
+			create: func(label *gitlab.Label) error {
+				_, _, err := client.GroupLabels.CreateGroupLabel(o.toGroup, &gitlab.CreateGroupLabelOptions{
+					Name:        gitlab.Ptr(label.Name),
+					Color:       gitlab.Ptr(label.Color),
+					Description: gitlab.Ptr(label.Description),
+				})
+				return err
+			},
+			update: func(id int64, label *gitlab.Label) error {
+				_, _, err := client.GroupLabels.UpdateGroupLabel(o.toGroup, id, &gitlab.UpdateGroupLabelOptions{
+					Color:       gitlab.Ptr(label.Color),
+					Description: gitlab.Ptr(label.Description),
+				})
+				return err
+			},
+		}, nil
+	}
+
+	labels, err := labelutils.ListProjectLabels(client, o.toProject)
+	if err != nil {
+		return nil, cmdutils.WrapError(err, fmt.Sprintf("failed to list the labels of %s.", o.toProject))
+	}
+	return &destination{
+		name:   o.toProject,
+		labels: labels,
+		create: func(label *gitlab.Label) error {
+			_, _, err := client.Labels.CreateLabel(o.toProject, &gitlab.CreateLabelOptions{
+				Name:        gitlab.Ptr(label.Name),
+				Color:       gitlab.Ptr(label.Color),
+				Description: gitlab.Ptr(label.Description),
+			})
+			return err
+		},
+		update: func(id int64, label *gitlab.Label) error {
+			_, _, err := client.Labels.UpdateLabel(o.toProject, id, &gitlab.UpdateLabelOptions{
+				Color:       gitlab.Ptr(label.Color),
+				Description: gitlab.Ptr(label.Description),
+			})
+			return err
+		},
+	}, nil
+}
+
+// askConflict asks whether to overwrite a label that exists with another color
+// or description. It returns whether to overwrite it, and how to resolve the
+// next conflicts.
+func (o *options) askConflict(ctx context.Context, dest *destination, current, label *gitlab.Label) (bool, string, error) {
+	const (
+		skip         = "Skip"
+		overwrite    = "Overwrite"
+		skipAll      = "Skip all conflicts"
+		overwriteAll = "Overwrite all conflicts"
+	)
+
+	title := fmt.Sprintf("Label %q exists in %s with color %s and description %q. Overwrite it with color %s and description %q?",
+		label.Name, dest.name, current.Color, current.Description, label.Color, label.Description)
+	answer := skip
+	if err := o.io.Select(ctx, &answer, title, []string{skip, overwrite, skipAll, overwriteAll}); err != nil {
+		return false, "", err
+	}
+
+	switch answer {
+	case overwrite:
+		return true, conflictAsk, nil
+	case skipAll:
+		return false, conflictSkip, nil
+	case overwriteAll:
+		return true, conflictOverwrite, nil
+	default:
+		return false, conflictAsk, nil
+	}
+}
@@ -0,0 +1,93 @@
+//go:build !integration
+
+package copy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+var sourceLabels = []*gitlab.Label{
+	{ID: 1, Name: "bug", Color: "#dc143c", Description: "Something isn't working."},
+	{ID: 2, Name: "feature", Color: "#009966"},
+	{ID: 3, Name: "docs", Color: "#6699cc"},
+}
+
+func TestLabelCopyToProject(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	tc := gitlabtesting.NewTestClient(t)
+	tc.MockLabels.EXPECT().ListLabels("OWNER/REPO", gomock.Any(), gomock.Any()).Return(sourceLabels, &gitlab.Response{}, nil)
+	tc.MockLabels.EXPECT().ListLabels("group/api", gomock.Any(), gomock.Any()).Return([]*gitlab.Label{
+		{ID: 10, Name: "api::bug", Color: "#DC143C", Description: "Something isn't working."},
+		{ID: 11, Name: "api::feature", Color: "#ff0000"},
+	}, &gitlab.Response{}, nil)
+	tc.MockLabels.EXPECT().CreateLabel("group/api", &gitlab.CreateLabelOptions{
+		Name:        gitlab.Ptr("api::docs"),
+		Color:       gitlab.Ptr("#6699cc"),
+		Description: gitlab.Ptr(""),
+	}).Return(&gitlab.Label{}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdCopy, false, cmdtest.WithGitLabClient(tc.Client))
+
+	out, err := exec("--to group/api --prefix api::")
+	require.NoError(t, err)
+	assert.Equal(t, "  skip api::feature: exists with another color or description\n"+
+		"  create api::docs\n"+
+		"✓ Copied 1 label to group/api.\n", out.String())
+	assert.Equal(t, "! Skipped 1 label with another color or description in group/api. Use --on-conflict overwrite to overwrite them.\n", out.Stderr())
+}
+
+func TestLabelCopyToGroup(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	tc := gitlabtesting.NewTestClient(t)
+	tc.MockLabels.EXPECT().ListLabels("OWNER/REPO", gomock.Any(), gomock.Any()).Return(sourceLabels, &gitlab.Response{}, nil)
+	tc.MockGroupLabels.EXPECT().ListGroupLabels("group", gomock.Any(), gomock.Any()).Return([]*gitlab.GroupLabel{
+		{ID: 20, Name: "feature", Color: "#ff0000"},
+	}, &gitlab.Response{}, nil)
+	tc.MockGroupLabels.EXPECT().CreateGroupLabel("group", &gitlab.CreateGroupLabelOptions{
+		Name:        gitlab.Ptr("bug"),
+		Color:       gitlab.Ptr("#dc143c"),
+		Description: gitlab.Ptr("Something isn't working."),
+	}).Return(&gitlab.GroupLabel{}, nil, nil)
+	tc.MockGroupLabels.EXPECT().UpdateGroupLabel("group", int64(20), &gitlab.UpdateGroupLabelOptions{
+		Color:       gitlab.Ptr("#009966"),
+		Description: gitlab.Ptr(""),
+	}).Return(&gitlab.GroupLabel{}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdCopy, false, cmdtest.WithGitLabClient(tc.Client))
+
+	out, err := exec("--to-group group --label bug --label feature --on-conflict overwrite")
+	require.NoError(t, err)
+	assert.Equal(t, "  create bug\n"+
+		"  update feature\n"+
+		"✓ Copied 2 labels to group group.\n", out.String())
+	assert.Empty(t, out.Stderr())
+}
+
+func TestLabelCopyUnknownLabel(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+	tc.MockLabels.EXPECT().ListLabels("OWNER/REPO", gomock.Any(), gomock.Any()).Return(sourceLabels, &gitlab.Response{}, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdCopy, false, cmdtest.WithGitLabClient(tc.Client))
+
+	_, err := exec("--to group/api --label wontfix")
+	require.EqualError(t, err, `label "wontfix" not found in the project.`)
+}
+
+func TestLabelCopyRequiresDestination(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+	exec := cmdtest.SetupCmdForTest(t, NewCmdCopy, false, cmdtest.WithGitLabClient(tc.Client))
+
+	_, err := exec("")
+	require.ErrorContains(t, err, "at least one of the flags in the group [to to-group] is required")
+}
@@ -7,6 +7,7 @@ import (
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/label/labelutils"
 	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
 )
 
@@ -20,6 +21,7 @@ func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
 			$ glab label create
 			$ glab label new
 			$ glab label create -R owner/repo
+			$ glab label create --name bug --generate-color
 		`),
 		Args: cobra.ExactArgs(0),
 		Annotations: map[string]string{
@@ -47,6 +49,13 @@ func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
 			if s, _ := cmd.Flags().GetString("color"); s != "" {
 				l.Color = gitlab.Ptr(s)
 			}
+			if generate, _ := cmd.Flags().GetBool("generate-color"); generate {
+				labels, err := labelutils.ListProjectLabels(client, repo.FullName())
+				if err != nil {
+					return err
+				}
+				l.Color = gitlab.Ptr(labelutils.GenerateColor(labels))
+			}
 			if s, _ := cmd.Flags().GetString("description"); s != "" {
 				l.Description = gitlab.Ptr(s)
 			}
@@ -70,6 +79,8 @@ func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
 	labelCreateCmd.Flags().StringP("name", "n", "", "Name of the label.")
 	_ = labelCreateCmd.MarkFlagRequired("name")
 	labelCreateCmd.Flags().StringP("color", "c", "#428BCA", "Color of the label, in plain or HEX code.")
+	labelCreateCmd.Flags().Bool("generate-color", false, "Pick a color from the palette of suggested colors that no other label of the project uses.")
+	labelCreateCmd.MarkFlagsMutuallyExclusive("color", "generate-color")
 	labelCreateCmd.Flags().StringP("description", "d", "", "Label description.")
 	labelCreateCmd.Flags().IntP("priority", "p", 0, "Label priority.")
 
@@ -44,6 +44,27 @@ func Test_LabelCreate(t *testing.T) {
 			expectedMsg: []string{""},
 			setupMock:   func(tc *gitlabtesting.TestClient) {},
 		},
+		{
+			name:        "Label created with generated color",
+			cli:         "--name foo --generate-color",
+			expectedMsg: []string{"Created label: foo\nWith color: #8fbc8f"},
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockLabels.EXPECT().
+					ListLabels("OWNER/REPO", gomock.Any(), gomock.Any()).
+					Return([]*gitlab.Label{{Name: "bar", Color: "#009966"}}, &gitlab.Response{}, nil)
+				tc.MockLabels.EXPECT().
+					CreateLabel("OWNER/REPO", &gitlab.CreateLabelOptions{Name: gitlab.Ptr("foo"), Color: gitlab.Ptr("#8fbc8f")}).
+					Return(&gitlab.Label{Name: "foo", Color: "#8fbc8f"}, nil, nil)
+			},
+		},
+		{
+			name:        "Label not created because of color and generated color",
+			cli:         "--name foo --color red --generate-color",
+			wantErr:     true,
+			wantStderr:  "if any flags in the group [color generate-color] are set none of the others can be",
+			expectedMsg: []string{""},
+			setupMock:   func(tc *gitlabtesting.TestClient) {},
+		},
 		{
 			name:        "Label created with description",
 			cli:         "--name foo --color red --description foo_desc",
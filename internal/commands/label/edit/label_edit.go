@@ -9,6 +9,7 @@ import (
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/label/labelutils"
 	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
 )
 
@@ -16,12 +17,14 @@ func NewCmdEdit(f cmdutils.Factory) *cobra.Command {
 	var labelID int
 
 	LabelUpdateCmd := &cobra.Command{
-		Use:   "edit [flags]",
-		Short: `Edit group or project label.`,
-		Long:  ``,
+		Use:     "edit [flags]",
+		Short:   `Edit group or project label.`,
+		Long:    ``,
+		Aliases: []string{"update"},
 		Example: heredoc.Doc(`
 			$ glab label edit
 			$ glab label edit -R owner/repo
+			$ glab label update --label-id 123 --generate-color
 		`),
 		Args: cobra.ExactArgs(0),
 		Annotations: map[string]string{
@@ -51,6 +54,15 @@ func NewCmdEdit(f cmdutils.Factory) *cobra.Command {
 				l.Color = gitlab.Ptr(s)
 				change += fmt.Sprintf("Updated color: %s\n", s)
 			}
+			if generate, _ := cmd.Flags().GetBool("generate-color"); generate {
+				labels, err := labelutils.ListProjectLabels(client, repo.FullName())
+				if err != nil {
+					return err
+				}
+				color := labelutils.GenerateColor(labels)
+				l.Color = gitlab.Ptr(color)
+				change += fmt.Sprintf("Updated color: %s\n", color)
+			}
 			if s, _ := cmd.Flags().GetString("description"); s != "" {
 				l.Description = gitlab.Ptr(s)
 				change += fmt.Sprintf("Updated description: %s\n", s)
@@ -80,7 +92,9 @@ func NewCmdEdit(f cmdutils.Factory) *cobra.Command {
 
 	LabelUpdateCmd.Flags().StringP("new-name", "n", "", "The new name of the label.")
 	LabelUpdateCmd.Flags().StringP("color", "c", "", "The color of the label given in 6-digit hex notation with leading ‘#’ sign.")
-	LabelUpdateCmd.MarkFlagsOneRequired("new-name", "color")
+	LabelUpdateCmd.Flags().Bool("generate-color", false, "Pick a color from the palette of suggested colors that no other label of the project uses.")
+	LabelUpdateCmd.MarkFlagsMutuallyExclusive("color", "generate-color")
+	LabelUpdateCmd.MarkFlagsOneRequired("new-name", "color", "generate-color")
 	LabelUpdateCmd.Flags().StringP("description", "d", "", "Label description.")
 	LabelUpdateCmd.Flags().IntP("priority", "p", 0, "Label priority.")
 
@@ -42,6 +42,16 @@ func Test_UpdateLabel(t *testing.T) {
 				tc.MockLabels.EXPECT().UpdateLabel("OWNER/REPO", 123, gomock.Any()).Return(testLabel, nil, nil)
 			},
 		},
+		{
+			Name:        "Update label with generated color",
+			ExpectedMsg: []string{"Updating \"Example label\" label\nUpdated color: #3cb371\n"},
+			cli:         "--label-id=123 --generate-color",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockLabels.EXPECT().ListLabels("OWNER/REPO", gomock.Any(), gomock.Any()).
+					Return([]*gitlab.Label{{Color: "#009966"}, {Color: "#8fbc8f"}}, &gitlab.Response{}, nil)
+				tc.MockLabels.EXPECT().UpdateLabel("OWNER/REPO", 123, &gitlab.UpdateLabelOptions{Color: gitlab.Ptr("#3cb371")}).Return(testLabel, nil, nil)
+			},
+		},
 		{
 			Name:       "Get label without ID",
 			cli:        "",
@@ -60,7 +70,7 @@ func Test_UpdateLabel(t *testing.T) {
 		{
 			Name:        "Require at least new-name or color",
 			cli:         "--label-id=123",
-			ExpectedMsg: []string{"at least one of the flags in the group [new-name color] is required"},
+			ExpectedMsg: []string{"at least one of the flags in the group [new-name color generate-color] is required"},
 			wantErr:     true,
 			setupMock:   func(tc *gitlabtesting.TestClient) {},
 		},
@@ -74,7 +74,7 @@ func (o *options) run(f cmdutils.Factory) error {
 		return cmdutils.WrapError(err, "failed to get label")
 	}
 
-	table := tableprinter.NewTablePrinter()
+	table := tableprinter.New(o.io)
 	table.AddRow("Label ID", label.ID)
 	table.AddRow("Name", label.Name)
 	table.AddRow("Description", label.Description)
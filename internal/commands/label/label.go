@@ -4,11 +4,13 @@ import (
 	"github.com/spf13/cobra"
 
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	labelCopyCmd "gitlab.com/gitlab-org/cli/internal/commands/label/copy"
 	labelCreateCmd "gitlab.com/gitlab-org/cli/internal/commands/label/create"
 	labelDeleteCmd "gitlab.com/gitlab-org/cli/internal/commands/label/delete"
 	labelUpdateCmd "gitlab.com/gitlab-org/cli/internal/commands/label/edit"
 	labelGetCmd "gitlab.com/gitlab-org/cli/internal/commands/label/get"
 	labelListCmd "gitlab.com/gitlab-org/cli/internal/commands/label/list"
+	labelSyncCmd "gitlab.com/gitlab-org/cli/internal/commands/label/sync"
 )
 
 func NewCmdLabel(f cmdutils.Factory) *cobra.Command {
@@ -25,6 +27,8 @@ func NewCmdLabel(f cmdutils.Factory) *cobra.Command {
 	labelCmd.AddCommand(labelDeleteCmd.NewCmdDelete(f))
 	labelCmd.AddCommand(labelUpdateCmd.NewCmdEdit(f))
 	labelCmd.AddCommand(labelGetCmd.NewCmdGet(f))
+	labelCmd.AddCommand(labelSyncCmd.NewCmdSync(f))
+	labelCmd.AddCommand(labelCopyCmd.NewCmdCopy(f))
 
 	return labelCmd
 }
@@ -0,0 +1,44 @@
+package labelutils
+
+import (
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+)
+
+// Palette is the list of colors that GitLab suggests for new labels.
+var Palette = []string{
+	"#009966", "#8fbc8f", "#3cb371", "#00b140", "#013220",
+	"#6699cc", "#0000ff", "#e6e6fa", "#9400d3", "#330066",
+	"#808080", "#36454f", "#f7e7ce", "#c21e56", "#cc338b",
+	"#dc143c", "#ff0000", "#cd5b45", "#eee600", "#ed9121",
+	"#c39953",
+}
+
+// GenerateColor returns the first color of the palette that no label uses yet,
+// so that new labels are easy to tell apart. When every color is used, it cycles
+// through the palette.
+func GenerateColor(labels []*gitlab.Label) string {
+	used := make(map[string]bool, len(labels))
+	for _, label := range labels {
+		used[strings.ToLower(label.Color)] = true
+	}
+	for _, color := range Palette {
+		if !used[color] {
+			return color
+		}
+	}
+	return Palette[len(labels)%len(Palette)]
+}
+
+// ListProjectLabels returns the labels of a project, without the labels it inherits from its groups.
+func ListProjectLabels(client *gitlab.Client, projectID string) ([]*gitlab.Label, error) {
+	return gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Label, *gitlab.Response, error) {
+		return client.Labels.ListLabels(projectID, &gitlab.ListLabelsOptions{
+			ListOptions:           gitlab.ListOptions{PerPage: api.MaxPerPage},
+			IncludeAncestorGroups: gitlab.Ptr(false),
+		}, p)
+	})
+}
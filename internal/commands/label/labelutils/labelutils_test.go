@@ -0,0 +1,23 @@
+//go:build !integration
+
+package labelutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestGenerateColor(t *testing.T) {
+	assert.Equal(t, "#009966", GenerateColor(nil))
+	assert.Equal(t, "#3cb371", GenerateColor([]*gitlab.Label{{Color: "#009966"}, {Color: "#8FBC8F"}}))
+
+	var labels []*gitlab.Label
+	for _, color := range Palette {
+		labels = append(labels, &gitlab.Label{Color: color})
+	}
+	labels = append(labels, &gitlab.Label{Color: "#000000"})
+	assert.Equal(t, Palette[1], GenerateColor(labels))
+}
@@ -3,6 +3,7 @@ package list
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strconv"
 
 	"github.com/MakeNowJust/heredoc/v2"
@@ -30,6 +31,7 @@ type options struct {
 	apiClient    func(repoHost string) (*api.Client, error)
 	baseRepo     func() (glrepo.Interface, error)
 	group        string
+	subscribed   bool
 	page         int
 	perPage      int
 	outputFormat string
@@ -52,6 +54,7 @@ func NewCmdList(f cmdutils.Factory) *cobra.Command {
 			$ glab label ls
 			$ glab label list -R owner/repository
 			$ glab label list -g mygroup
+			$ glab label list --subscribed
 		`),
 		Args: cobra.ExactArgs(0),
 		Annotations: map[string]string{
@@ -66,6 +69,7 @@ func NewCmdList(f cmdutils.Factory) *cobra.Command {
 	labelListCmd.Flags().IntVarP(&opts.perPage, "per-page", "P", 30, "Number of items to list per page.")
 	labelListCmd.Flags().StringVarP(&opts.outputFormat, "output", "F", "text", "Format output as: text, json.")
 	labelListCmd.Flags().StringVarP(&opts.group, "group", "g", "", "List labels for a group.")
+	labelListCmd.Flags().BoolVar(&opts.subscribed, "subscribed", false, "List only the labels you are subscribed to, from all pages.")
 
 	return labelListCmd
 }
@@ -122,7 +126,16 @@ func (o *options) run() error {
 	}
 
 	if o.group != "" {
-		labels, _, err := client.GroupLabels.ListGroupLabels(o.group, labelApiOpts.listGroupLabelsOptions())
+		var labels []*gitlab.GroupLabel
+		if o.subscribed {
+			labelApiOpts.perPage = api.MaxPerPage
+			labels, err = gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.GroupLabel, *gitlab.Response, error) {
+				return client.GroupLabels.ListGroupLabels(o.group, labelApiOpts.listGroupLabelsOptions(), p)
+			})
+			labels = slices.DeleteFunc(labels, func(l *gitlab.GroupLabel) bool { return !l.Subscribed })
+		} else {
+			labels, _, err = client.GroupLabels.ListGroupLabels(o.group, labelApiOpts.listGroupLabelsOptions())
+		}
 		if err != nil {
 			return err
 		}
@@ -142,7 +155,16 @@ func (o *options) run() error {
 			return err
 		}
 
-		labels, _, err := client.Labels.ListLabels(repo.FullName(), labelApiOpts.listLabelsOptions())
+		var labels []*gitlab.Label
+		if o.subscribed {
+			labelApiOpts.perPage = api.MaxPerPage
+			labels, err = gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Label, *gitlab.Response, error) {
+				return client.Labels.ListLabels(repo.FullName(), labelApiOpts.listLabelsOptions(), p)
+			})
+			labels = slices.DeleteFunc(labels, func(l *gitlab.Label) bool { return !l.Subscribed })
+		} else {
+			labels, _, err = client.Labels.ListLabels(repo.FullName(), labelApiOpts.listLabelsOptions())
+		}
 		if err != nil {
 			return err
 		}
@@ -163,10 +185,10 @@ func (o *options) run() error {
 }
 
 func printLabels(label []printLabel, io *iostreams.IOStreams) {
-	table := tableprinter.NewTablePrinter()
+	table := tableprinter.New(io)
 
 	if len(label) > 0 {
-		table.AddRow("ID", "Name", "Description", "Color")
+		table.AddHeader("ID", "Name", "Description", "Color")
 	}
 
 	for _, l := range label {
@@ -18,6 +18,8 @@ import (
 )
 
 func TestLabelList(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
 	type testCase struct {
 		name        string
 		cli         string
@@ -145,6 +147,8 @@ func TestLabelListJSON(t *testing.T) {
 }
 
 func TestGroupLabelList(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
 	testLabels := []*gitlab.GroupLabel{
 		{
 			ID:          1,
@@ -189,3 +193,34 @@ func TestGroupLabelList(t *testing.T) {
 	assert.Equal(t, expectedOut, output.OutBuf.String())
 	assert.Empty(t, output.ErrBuf.String())
 }
+
+func TestLabelListSubscribed(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockLabels.EXPECT().
+		ListLabels("OWNER/REPO", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(pid any, opts *gitlab.ListLabelsOptions, _ ...gitlab.RequestOptionFunc) ([]*gitlab.Label, *gitlab.Response, error) {
+			assert.Equal(t, int64(api.MaxPerPage), opts.PerPage)
+			return []*gitlab.Label{
+				{ID: 1, Name: "bug", Color: "#6699cc", Subscribed: true},
+				{ID: 2, Name: "ux", Color: "#3cb371"},
+			}, &gitlab.Response{}, nil
+		})
+
+	exec := cmdtest.SetupCmdForTest(
+		t,
+		NewCmdList,
+		true,
+		cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(testClient.Client))),
+	)
+
+	out, err := exec("--subscribed")
+	require.NoError(t, err)
+	assert.Equal(t, heredoc.Doc(`Showing label 1 of 1 on OWNER/REPO.
+
+	ID	Name	Description	Color
+	1	bug		#6699cc
+
+	`), out.OutBuf.String())
+}
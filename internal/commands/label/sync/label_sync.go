@@ -0,0 +1,267 @@
+package sync
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/label/labelutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+// labelSpec is a label of the labels file. Attributes that aren't set are
+// kept as they are in the project.
+type labelSpec struct {
+	Name        string  `yaml:"name"`
+	Color       string  `yaml:"color"`
+	Description *string `yaml:"description"`
+	Priority    *int64  `yaml:"priority"`
+}
+
+// change is a label to create, update, or delete to match the labels file.
+type change struct {
+	action  string
+	name    string
+	details []string
+	apply   func() error
+}
+
+type options struct {
+	fromFile string
+	dryRun   bool
+	delete   bool
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdSync(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	labelSyncCmd := &cobra.Command{
+		Use:   "sync --from-file <file> [flags]",
+		Short: `Create and update the labels of a project from a file.`,
+		Long: heredoc.Docf(`
+			Create and update the labels of a project to match a YAML file, to use the
+			same labels in many projects.
+
+			The file is a list of labels. Only the name of a label is required. Labels
+			without a color get a color from the palette of suggested colors.
+
+			%[1]syaml
+			- name: bug
+			  color: "#dc143c"
+			  description: Something isn't working.
+			  priority: 1
+			- name: documentation
+			%[1]s
+
+			Labels of the project that aren't in the file are kept, unless you use --delete.
+			Labels that the project inherits from its groups are never changed.
+		`, "```"),
+		Args: cobra.NoArgs,
+		Example: heredoc.Doc(`
+			$ glab label sync --from-file labels.yml
+
+			# Show what would change in another project
+			$ glab label sync --from-file labels.yml -R my-group/my-project --dry-run
+
+			# Also delete the labels that aren't in the file
+			$ glab label sync --from-file labels.yml --delete
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+
+	fl := labelSyncCmd.Flags()
+	fl.StringVarP(&opts.fromFile, "from-file", "f", "", "YAML file with the labels of the project.")
+	fl.BoolVar(&opts.dryRun, "dry-run", false, "Show the labels that would change, without changing them.")
+	fl.BoolVar(&opts.delete, "delete", false, "Delete the labels of the project that aren't in the file.")
+	cobra.CheckErr(labelSyncCmd.MarkFlagRequired("from-file"))
+
+	return labelSyncCmd
+}
+
+func (o *options) run() error {
+	specs, err := readLabelFile(o.fromFile)
+	if err != nil {
+		return err
+	}
+
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	labels, err := labelutils.ListProjectLabels(client, repo.FullName())
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to list the labels of %s.", repo.FullName()))
+	}
+
+	changes := planChanges(client, repo.FullName(), labels, specs, o.delete)
+
+	c := o.io.Color()
+	if len(changes) == 0 {
+		fmt.Fprintf(o.io.StdOut, "%s Labels are up to date with %s.\n", c.GreenCheck(), o.fromFile)
+		return nil
+	}
+
+	for _, ch := range changes {
+		line := fmt.Sprintf("  %s %s", ch.action, ch.name)
+		if len(ch.details) > 0 {
+			line += ": " + strings.Join(ch.details, ", ")
+		}
+		fmt.Fprintln(o.io.StdOut, line)
+	}
+	if o.dryRun {
+		fmt.Fprintf(o.io.StdOut, "%s would change. Run again without --dry-run to apply the changes.\n", utils.Pluralize(len(changes), "label"))
+		return nil
+	}
+
+	for _, ch := range changes {
+		if err := ch.apply(); err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to %s label %q.", ch.action, ch.name))
+		}
+	}
+	fmt.Fprintf(o.io.StdOut, "%s Synced %s with %s.\n", c.GreenCheck(), utils.Pluralize(len(changes), "label"), o.fromFile)
+	return nil
+}
+
+func readLabelFile(path string) ([]*labelSpec, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var specs []*labelSpec
+	if err := yaml.Unmarshal(content, &specs); err != nil {
+		return nil, fmt.Errorf("invalid labels file %s: %w", path, err)
+	}
+
+	seen := make(map[string]bool, len(specs))
+	for i, spec := range specs {
+		if spec.Name == "" {
+			return nil, fmt.Errorf("label %d of %s has no name.", i+1, path)
+		}
+		if seen[spec.Name] {
+			return nil, fmt.Errorf("label %q is in %s more than once.", spec.Name, path)
+		}
+		seen[spec.Name] = true
+	}
+	return specs, nil
+}
+
+// planChanges compares the labels of the project with the labels file, and
+// returns the changes that make them match.
+func planChanges(client *gitlab.Client, projectID string, labels []*gitlab.Label, specs []*labelSpec, deleteOthers bool) []*change {
+	byName := make(map[string]*gitlab.Label, len(labels))
+	for _, label := range labels {
+		byName[label.Name] = label
+	}
+
+	var changes []*change
+	// Labels created by the sync count as used colors too.
+	used := labels
+	for _, spec := range specs {
+		label, ok := byName[spec.Name]
+		if !ok {
+			createOpts := &gitlab.CreateLabelOptions{
+				Name:        gitlab.Ptr(spec.Name),
+				Color:       gitlab.Ptr(spec.Color),
+				Description: spec.Description,
+				Priority:    spec.Priority,
+			}
+			if spec.Color == "" {
+				createOpts.Color = gitlab.Ptr(labelutils.GenerateColor(used))
+			}
+			used = append(used, &gitlab.Label{Name: spec.Name, Color: *createOpts.Color})
+
+			changes = append(changes, &change{
+				action: "create",
+				name:   spec.Name,
+				apply: func() error {
+					_, _, err := client.Labels.CreateLabel(projectID, createOpts)
+					return err
+				},
+			})
+			continue
+		}
+
+		updateOpts := &gitlab.UpdateLabelOptions{}
+		var details []string
+		if spec.Color != "" && !strings.EqualFold(spec.Color, label.Color) {
+			updateOpts.Color = gitlab.Ptr(spec.Color)
+			details = append(details, fmt.Sprintf("color %s → %s", label.Color, spec.Color))
+		}
+		if spec.Description != nil && *spec.Description != label.Description {
+			updateOpts.Description = spec.Description
+			details = append(details, "description")
+		}
+		if spec.Priority != nil && *spec.Priority != label.Priority {
+			updateOpts.Priority = spec.Priority
+			details = append(details, fmt.Sprintf("priority %d → %d", label.Priority, *spec.Priority))
+		}
+		if len(details) == 0 {
+			continue
+		}
+
+		labelID := label.ID
+		changes = append(changes, &change{
+			action:  "update",
+			name:    spec.Name,
+			details: details,
+			apply: func() error {
+				_, _, err := client.Labels.UpdateLabel(projectID, labelID, updateOpts)
+				return err
+			},
+		})
+	}
+
+	if !deleteOthers {
+		return changes
+	}
+
+	inFile := make(map[string]bool, len(specs))
+	for _, spec := range specs {
+		inFile[spec.Name] = true
+	}
+	for _, label := range labels {
+		if inFile[label.Name] {
+			continue
+		}
+		labelID := label.ID
+		changes = append(changes, &change{
+			action: "delete",
+			name:   label.Name,
+			apply: func() error {
+				_, err := client.Labels.DeleteLabel(projectID, labelID, &gitlab.DeleteLabelOptions{})
+				return err
+			},
+		})
+	}
+	return changes
+}
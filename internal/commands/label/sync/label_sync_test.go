@@ -0,0 +1,106 @@
+//go:build !integration
+
+package sync
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+const labelsFile = `
+- name: bug
+  color: "#dc143c"
+  description: Something isn't working.
+- name: documentation
+- name: feature
+  priority: 2
+`
+
+func writeLabelsFile(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "labels.yml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func setupLabels(tc *gitlabtesting.TestClient) {
+	tc.MockLabels.EXPECT().ListLabels("OWNER/REPO", gomock.Any(), gomock.Any()).Return([]*gitlab.Label{
+		{ID: 1, Name: "bug", Color: "#FF0000", Description: "Something isn't working."},
+		{ID: 2, Name: "feature", Color: "#009966", Priority: 2},
+		{ID: 3, Name: "wontfix", Color: "#808080"},
+	}, &gitlab.Response{}, nil)
+}
+
+func TestLabelSync(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	tc := gitlabtesting.NewTestClient(t)
+	setupLabels(tc)
+	tc.MockLabels.EXPECT().UpdateLabel("OWNER/REPO", int64(1), &gitlab.UpdateLabelOptions{Color: gitlab.Ptr("#dc143c")}).
+		Return(&gitlab.Label{}, nil, nil)
+	tc.MockLabels.EXPECT().CreateLabel("OWNER/REPO", &gitlab.CreateLabelOptions{Name: gitlab.Ptr("documentation"), Color: gitlab.Ptr("#8fbc8f")}).
+		Return(&gitlab.Label{}, nil, nil)
+	tc.MockLabels.EXPECT().DeleteLabel("OWNER/REPO", int64(3), gomock.Any()).Return(nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdSync, false, cmdtest.WithGitLabClient(tc.Client))
+
+	path := writeLabelsFile(t, labelsFile)
+	out, err := exec("--from-file " + path + " --delete")
+	require.NoError(t, err)
+	assert.Equal(t, "  update bug: color #FF0000 → #dc143c\n"+
+		"  create documentation\n"+
+		"  delete wontfix\n"+
+		"✓ Synced 3 labels with "+path+".\n", out.String())
+}
+
+func TestLabelSyncDryRun(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	tc := gitlabtesting.NewTestClient(t)
+	setupLabels(tc)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdSync, false, cmdtest.WithGitLabClient(tc.Client))
+
+	out, err := exec("--from-file " + writeLabelsFile(t, labelsFile) + " --dry-run")
+	require.NoError(t, err)
+	assert.Equal(t, "  update bug: color #FF0000 → #dc143c\n"+
+		"  create documentation\n"+
+		"2 labels would change. Run again without --dry-run to apply the changes.\n", out.String())
+}
+
+func TestLabelSyncUpToDate(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	tc := gitlabtesting.NewTestClient(t)
+	setupLabels(tc)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdSync, false, cmdtest.WithGitLabClient(tc.Client))
+
+	path := writeLabelsFile(t, "- name: bug\n  color: '#ff0000'\n- name: feature\n")
+	out, err := exec("--from-file " + path)
+	require.NoError(t, err)
+	assert.Equal(t, "✓ Labels are up to date with "+path+".\n", out.String())
+}
+
+func TestReadLabelFile(t *testing.T) {
+	_, err := readLabelFile(writeLabelsFile(t, "- color: '#ff0000'\n"))
+	assert.ErrorContains(t, err, "label 1 of")
+	assert.ErrorContains(t, err, "has no name.")
+
+	_, err = readLabelFile(writeLabelsFile(t, "- name: bug\n- name: bug\n"))
+	assert.ErrorContains(t, err, `label "bug" is in`)
+
+	_, err = readLabelFile(writeLabelsFile(t, "name: bug\n"))
+	assert.ErrorContains(t, err, "invalid labels file")
+}
@@ -0,0 +1,103 @@
+package close
+
+import (
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	apiClient func(repoHost string) (*api.Client, error)
+	io        *iostreams.IOStreams
+	baseRepo  func() (glrepo.Interface, error)
+
+	projectID   string
+	groupID     string
+	milestoneID int64
+}
+
+func NewCmdClose(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:        f.IO(),
+		apiClient: f.ApiClient,
+		baseRepo:  f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "close",
+		Short: "Close a group or project milestone.",
+		Long:  "",
+		Example: heredoc.Doc(`
+			# Close milestone for the current project
+			$ glab milestone close 123
+
+			# Close milestone for the specified project
+			$ glab milestone close 123 --project example-path/project-path
+
+			# Close milestone for the specified group
+			$ glab milestone close 123 --group 789
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "false",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			milestoneIDInt, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+			opts.milestoneID = int64(milestoneIDInt)
+			return opts.run()
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.projectID, "project", "", "The ID or URL-encoded path of the project.")
+	cmd.Flags().StringVar(&opts.groupID, "group", "", "The ID or URL-encoded path of the group.")
+	cmd.MarkFlagsMutuallyExclusive("project", "group")
+
+	return cmd
+}
+
+func (o *options) run() error {
+	c, err := o.apiClient("")
+	if err != nil {
+		return err
+	}
+	client := c.Lab()
+
+	stateEvent := "close"
+
+	if o.groupID != "" {
+		milestone, _, err := client.GroupMilestones.UpdateGroupMilestone(o.groupID, o.milestoneID, &gitlab.UpdateGroupMilestoneOptions{StateEvent: &stateEvent})
+		if err != nil {
+			return err
+		}
+		o.io.LogInfof("Closed group milestone %s (ID: %d)\n", milestone.Title, milestone.ID)
+		return nil
+	}
+
+	projectID := o.projectID
+	if projectID == "" {
+		repo, err := o.baseRepo()
+		if err != nil {
+			return err
+		}
+		projectID = repo.FullName()
+	}
+
+	milestone, _, err := client.Milestones.UpdateMilestone(projectID, o.milestoneID, &gitlab.UpdateMilestoneOptions{StateEvent: &stateEvent})
+	if err != nil {
+		return err
+	}
+	o.io.LogInfof("Closed project milestone %s (ID: %d)\n", milestone.Title, milestone.ID)
+	return nil
+}
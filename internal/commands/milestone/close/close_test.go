@@ -0,0 +1,101 @@
+package close
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_CloseMilestone(t *testing.T) {
+	type testCase struct {
+		Name        string
+		ExpectedMsg string
+		wantErr     bool
+		cli         string
+		wantStderr  string
+		setupMock   func(tc *gitlabtesting.TestClient)
+	}
+
+	closeOptions := &gitlab.UpdateMilestoneOptions{StateEvent: gitlab.Ptr("close")}
+
+	testCases := []testCase{
+		{
+			Name:        "Close milestone of the current project",
+			ExpectedMsg: "Closed project milestone Example (ID: 123)\n",
+			cli:         "123",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockMilestones.EXPECT().UpdateMilestone("OWNER/REPO", int64(123), closeOptions).
+					Return(&gitlab.Milestone{ID: 123, Title: "Example", State: "closed"}, nil, nil)
+			},
+		},
+		{
+			Name:        "Close project milestone",
+			ExpectedMsg: "Closed project milestone Example (ID: 123)\n",
+			cli:         "123 --project 456",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockMilestones.EXPECT().UpdateMilestone("456", int64(123), closeOptions).
+					Return(&gitlab.Milestone{ID: 123, Title: "Example", State: "closed"}, nil, nil)
+			},
+		},
+		{
+			Name:        "Close group milestone",
+			ExpectedMsg: "Closed group milestone Example (ID: 123)\n",
+			cli:         "123 --group 789",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockGroupMilestones.EXPECT().UpdateGroupMilestone("789", int64(123), &gitlab.UpdateGroupMilestoneOptions{StateEvent: gitlab.Ptr("close")}).
+					Return(&gitlab.GroupMilestone{ID: 123, Title: "Example", State: "closed"}, nil, nil)
+			},
+		},
+		{
+			Name:       "When milestone is not found returns an error",
+			wantErr:    true,
+			wantStderr: "404 Not found",
+			cli:        "111 --project 456",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockMilestones.EXPECT().UpdateMilestone("456", int64(111), gomock.Any()).Return(nil, nil, errors.New("404 Not found"))
+			},
+		},
+		{
+			Name:       "When both project and group are set returns an error",
+			wantErr:    true,
+			wantStderr: "if any flags in the group [project group] are set none of the others can be",
+			cli:        "123 --project 456 --group 789",
+			setupMock:  func(tc *gitlabtesting.TestClient) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			// GIVEN
+			testClient := gitlabtesting.NewTestClient(t)
+			tc.setupMock(testClient)
+			exec := cmdtest.SetupCmdForTest(
+				t,
+				NewCmdClose,
+				false,
+				cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(testClient.Client))),
+			)
+
+			// WHEN
+			out, err := exec(tc.cli)
+
+			// THEN
+			if tc.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantStderr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.ExpectedMsg, out.OutBuf.String())
+		})
+	}
+}
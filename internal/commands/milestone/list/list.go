@@ -84,11 +84,11 @@ func (o *options) run(cmd *cobra.Command) error {
 		return err
 	}
 	client := c.Lab()
-	table := tableprinter.NewTablePrinter()
+	table := tableprinter.New(o.io)
 	if o.showIDs {
-		table.AddRow("ID", "Title", "Description", "State", "Due Date")
+		table.AddHeader("ID", "Title", "Description", "State", "Due Date")
 	} else {
-		table.AddRow("Title", "Description", "State", "Due Date")
+		table.AddHeader("Title", "Description", "State", "Due Date")
 	}
 
 	if o.projectID != "" { // list project milestones
@@ -4,11 +4,13 @@ import (
 	"github.com/spf13/cobra"
 
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	cmdClose "gitlab.com/gitlab-org/cli/internal/commands/milestone/close"
 	cmdCreate "gitlab.com/gitlab-org/cli/internal/commands/milestone/create"
 	cmdDelete "gitlab.com/gitlab-org/cli/internal/commands/milestone/delete"
 	cmdEdit "gitlab.com/gitlab-org/cli/internal/commands/milestone/edit"
 	cmdGet "gitlab.com/gitlab-org/cli/internal/commands/milestone/get"
 	cmdList "gitlab.com/gitlab-org/cli/internal/commands/milestone/list"
+	cmdView "gitlab.com/gitlab-org/cli/internal/commands/milestone/view"
 )
 
 func NewCmdMilestone(f cmdutils.Factory) *cobra.Command {
@@ -20,11 +22,13 @@ func NewCmdMilestone(f cmdutils.Factory) *cobra.Command {
 
 	cmdutils.EnableRepoOverride(cmd, f)
 
+	cmd.AddCommand(cmdClose.NewCmdClose(f))
 	cmd.AddCommand(cmdCreate.NewCmdCreate(f))
 	cmd.AddCommand(cmdDelete.NewCmdDelete(f))
 	cmd.AddCommand(cmdEdit.NewCmdEdit(f))
 	cmd.AddCommand(cmdGet.NewCmdGet(f))
 	cmd.AddCommand(cmdList.NewCmdList(f))
+	cmd.AddCommand(cmdView.NewCmdView(f))
 
 	return cmd
 }
@@ -26,7 +26,7 @@ func TestNewCmdMilestone(t *testing.T) {
 		subcommandNames[i] = subcmd.Use
 	}
 
-	expectedSubcommands := []string{"get", "list", "create", "edit", "delete"}
+	expectedSubcommands := []string{"get", "list", "create", "edit", "delete", "close", "view"}
 	for _, expected := range expectedSubcommands {
 		assert.Contains(t, subcommandNames, expected)
 	}
@@ -0,0 +1,230 @@
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/issuable/issuableutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+// now is replaced in tests to get a stable number of days until the due date.
+var now = time.Now
+
+const progressWidth = 20
+
+// Summary is the progress of a milestone, and the JSON output of the command.
+type Summary struct {
+	ID            int64           `json:"id"`
+	Title         string          `json:"title"`
+	Description   string          `json:"description"`
+	State         string          `json:"state"`
+	StartDate     *gitlab.ISOTime `json:"start_date"`
+	DueDate       *gitlab.ISOTime `json:"due_date"`
+	WebURL        string          `json:"web_url,omitempty"`
+	OpenIssues    int             `json:"open_issues"`
+	ClosedIssues  int             `json:"closed_issues"`
+	TotalWeight   int64           `json:"total_weight"`
+	ClosedWeight  int64           `json:"closed_weight"`
+	DaysRemaining *int            `json:"days_remaining,omitempty"`
+}
+
+type options struct {
+	apiClient func(repoHost string) (*api.Client, error)
+	io        *iostreams.IOStreams
+	baseRepo  func() (glrepo.Interface, error)
+
+	projectID    string
+	groupID      string
+	milestoneID  int64
+	outputFormat string
+}
+
+func NewCmdView(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:        f.IO(),
+		apiClient: f.ApiClient,
+		baseRepo:  f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view",
+		Short: "View the progress of a group or project milestone.",
+		Long: heredoc.Doc(`
+			View the progress of a group or project milestone.
+
+			Shows how many of the issues of the milestone are closed, how much of
+			their weight is completed, and the time left until the due date.
+		`),
+		Example: heredoc.Doc(`
+			# View milestone for the current project
+			$ glab milestone view 123
+
+			# View milestone for the specified project
+			$ glab milestone view 123 --project example-path/project-path
+
+			# View milestone for the specified group, as JSON
+			$ glab milestone view 123 --group example-group --output json
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			milestoneIDInt, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+			opts.milestoneID = int64(milestoneIDInt)
+			return opts.run()
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.projectID, "project", "", "The ID or URL-encoded path of the project.")
+	cmd.Flags().StringVar(&opts.groupID, "group", "", "The ID or URL-encoded path of the group.")
+	cmd.Flags().VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+	cmd.MarkFlagsMutuallyExclusive("project", "group")
+
+	return cmd
+}
+
+func (o *options) run() error {
+	c, err := o.apiClient("")
+	if err != nil {
+		return err
+	}
+	client := c.Lab()
+
+	var summary *Summary
+	var issues []*gitlab.Issue
+
+	if o.groupID != "" {
+		milestone, _, err := client.GroupMilestones.GetGroupMilestone(o.groupID, o.milestoneID)
+		if err != nil {
+			return err
+		}
+		summary = &Summary{
+			ID:          milestone.ID,
+			Title:       milestone.Title,
+			Description: milestone.Description,
+			State:       milestone.State,
+			StartDate:   milestone.StartDate,
+			DueDate:     milestone.DueDate,
+		}
+		issues, err = gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Issue, *gitlab.Response, error) {
+			return client.GroupMilestones.GetGroupMilestoneIssues(o.groupID, o.milestoneID, &gitlab.GetGroupMilestoneIssuesOptions{ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage}}, p)
+		})
+		if err != nil {
+			return err
+		}
+	} else {
+		projectID := o.projectID
+		if projectID == "" {
+			repo, err := o.baseRepo()
+			if err != nil {
+				return err
+			}
+			projectID = repo.FullName()
+		}
+
+		milestone, _, err := client.Milestones.GetMilestone(projectID, o.milestoneID)
+		if err != nil {
+			return err
+		}
+		summary = &Summary{
+			ID:          milestone.ID,
+			Title:       milestone.Title,
+			Description: milestone.Description,
+			State:       milestone.State,
+			StartDate:   milestone.StartDate,
+			DueDate:     milestone.DueDate,
+			WebURL:      milestone.WebURL,
+		}
+		issues, err = gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Issue, *gitlab.Response, error) {
+			return client.Milestones.GetMilestoneIssues(projectID, o.milestoneID, &gitlab.GetMilestoneIssuesOptions{ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage}}, p)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	summary.addIssues(issues)
+	if summary.DueDate != nil && summary.State == "active" {
+		days := issuableutils.DaysUntil(time.Time(*summary.DueDate), now())
+		summary.DaysRemaining = &days
+	}
+
+	if o.outputFormat == "json" {
+		summaryJSON, _ := json.Marshal(summary)
+		fmt.Fprintln(o.io.StdOut, string(summaryJSON))
+		return nil
+	}
+
+	fmt.Fprint(o.io.StdOut, summary.String())
+	return nil
+}
+
+func (s *Summary) addIssues(issues []*gitlab.Issue) {
+	for _, issue := range issues {
+		s.TotalWeight += issue.Weight
+		if issue.State == "closed" {
+			s.ClosedIssues++
+			s.ClosedWeight += issue.Weight
+		} else {
+			s.OpenIssues++
+		}
+	}
+}
+
+func (s *Summary) String() string {
+	var b strings.Builder
+
+	total := s.OpenIssues + s.ClosedIssues
+
+	fmt.Fprintf(&b, "Title: %s\n", s.Title)
+	fmt.Fprintf(&b, "State: %s\n", s.State)
+	if s.StartDate != nil {
+		fmt.Fprintf(&b, "Start Date: %s\n", utils.FormatDueDate(s.StartDate))
+	}
+	if s.DueDate != nil {
+		fmt.Fprintf(&b, "Due Date: %s%s\n", utils.FormatDueDate(s.DueDate), issuableutils.Remaining(s.DaysRemaining, "due"))
+	}
+	if s.WebURL != "" {
+		fmt.Fprintf(&b, "URL: %s\n", s.WebURL)
+	}
+	if s.Description != "" {
+		fmt.Fprintf(&b, "\n%s\n", s.Description)
+	}
+
+	b.WriteString("\n")
+	if total == 0 {
+		b.WriteString("There are no issues in this milestone.\n")
+		return b.String()
+	}
+	fmt.Fprintf(&b, "Issues: %d of %d closed (%d%%), %d open\n", s.ClosedIssues, total, issuableutils.Percent(int64(s.ClosedIssues), int64(total)), s.OpenIssues)
+	if s.TotalWeight > 0 {
+		fmt.Fprintf(&b, "Weight: %d of %d completed (%d%%)\n", s.ClosedWeight, s.TotalWeight, issuableutils.Percent(s.ClosedWeight, s.TotalWeight))
+		fmt.Fprintf(&b, "Progress: %s\n", progressBar(s.ClosedWeight, s.TotalWeight))
+	} else {
+		fmt.Fprintf(&b, "Progress: %s\n", progressBar(int64(s.ClosedIssues), int64(total)))
+	}
+	return b.String()
+}
+
+func progressBar(part, total int64) string {
+	filled := int(issuableutils.Percent(part, total) * progressWidth / 100)
+	return "[" + strings.Repeat("#", filled) + strings.Repeat("-", progressWidth-filled) + "]"
+}
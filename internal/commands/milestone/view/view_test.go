@@ -0,0 +1,156 @@
+package view
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_ViewMilestone(t *testing.T) {
+	now = func() time.Time { return time.Date(2025, 1, 5, 15, 0, 0, 0, time.UTC) }
+	t.Cleanup(func() { now = time.Now })
+
+	type testCase struct {
+		Name        string
+		ExpectedMsg string
+		wantErr     bool
+		cli         string
+		wantStderr  string
+		setupMock   func(tc *gitlabtesting.TestClient)
+	}
+
+	dueDate := gitlab.Ptr(gitlab.ISOTime(time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)))
+	issues := []*gitlab.Issue{
+		{IID: 1, State: "closed", Weight: 3},
+		{IID: 2, State: "closed", Weight: 2},
+		{IID: 3, State: "closed"},
+		{IID: 4, State: "opened", Weight: 5},
+		{IID: 5, State: "opened", Weight: 3},
+	}
+
+	testCases := []testCase{
+		{
+			Name: "View milestone of the current project",
+			ExpectedMsg: "Title: Release 1.0\n" +
+				"State: active\n" +
+				"Due Date: 2025-01-15 (10 days left)\n" +
+				"URL: https://gitlab.com/OWNER/REPO/-/milestones/4\n" +
+				"\nFirst release.\n" +
+				"\nIssues: 3 of 5 closed (60%), 2 open\n" +
+				"Weight: 5 of 13 completed (38%)\n" +
+				"Progress: [#######-------------]\n",
+			cli: "123",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockMilestones.EXPECT().GetMilestone("OWNER/REPO", int64(123)).Return(&gitlab.Milestone{
+					ID:          123,
+					Title:       "Release 1.0",
+					Description: "First release.",
+					State:       "active",
+					DueDate:     dueDate,
+					WebURL:      "https://gitlab.com/OWNER/REPO/-/milestones/4",
+				}, nil, nil)
+				tc.MockMilestones.EXPECT().GetMilestoneIssues("OWNER/REPO", int64(123), gomock.Any(), gomock.Any()).
+					Return(issues, &gitlab.Response{}, nil)
+			},
+		},
+		{
+			Name: "View overdue group milestone without weights",
+			ExpectedMsg: "Title: Q1\n" +
+				"State: active\n" +
+				"Start Date: 2024-12-01\n" +
+				"Due Date: 2025-01-02 (3 days overdue)\n" +
+				"\nIssues: 1 of 4 closed (25%), 3 open\n" +
+				"Progress: [#####---------------]\n",
+			cli: "7 --group example-group",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockGroupMilestones.EXPECT().GetGroupMilestone("example-group", int64(7)).Return(&gitlab.GroupMilestone{
+					ID:        7,
+					Title:     "Q1",
+					State:     "active",
+					StartDate: gitlab.Ptr(gitlab.ISOTime(time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC))),
+					DueDate:   gitlab.Ptr(gitlab.ISOTime(time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC))),
+				}, nil, nil)
+				tc.MockGroupMilestones.EXPECT().GetGroupMilestoneIssues("example-group", int64(7), gomock.Any(), gomock.Any()).
+					Return([]*gitlab.Issue{{State: "closed"}, {State: "opened"}, {State: "opened"}, {State: "opened"}}, &gitlab.Response{}, nil)
+			},
+		},
+		{
+			Name: "View closed milestone without issues",
+			ExpectedMsg: "Title: Release 0.9\n" +
+				"State: closed\n" +
+				"Due Date: 2025-01-15\n" +
+				"\nThere are no issues in this milestone.\n",
+			cli: "122 --project 456",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockMilestones.EXPECT().GetMilestone("456", int64(122)).Return(&gitlab.Milestone{
+					ID:      122,
+					Title:   "Release 0.9",
+					State:   "closed",
+					DueDate: dueDate,
+				}, nil, nil)
+				tc.MockMilestones.EXPECT().GetMilestoneIssues("456", int64(122), gomock.Any(), gomock.Any()).
+					Return(nil, &gitlab.Response{}, nil)
+			},
+		},
+		{
+			Name:        "View milestone as JSON",
+			ExpectedMsg: `{"id":123,"title":"Release 1.0","description":"","state":"active","start_date":null,"due_date":"2025-01-15","open_issues":2,"closed_issues":3,"total_weight":13,"closed_weight":5,"days_remaining":10}` + "\n",
+			cli:         "123 --project 456 --output json",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockMilestones.EXPECT().GetMilestone("456", int64(123)).Return(&gitlab.Milestone{
+					ID:      123,
+					Title:   "Release 1.0",
+					State:   "active",
+					DueDate: dueDate,
+				}, nil, nil)
+				tc.MockMilestones.EXPECT().GetMilestoneIssues("456", int64(123), gomock.Any(), gomock.Any()).
+					Return(issues, &gitlab.Response{}, nil)
+			},
+		},
+		{
+			Name:       "When milestone is not found returns an error",
+			wantErr:    true,
+			wantStderr: "404 Not found",
+			cli:        "111 --project 456",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockMilestones.EXPECT().GetMilestone("456", int64(111)).Return(nil, nil, errors.New("404 Not found"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			// GIVEN
+			testClient := gitlabtesting.NewTestClient(t)
+			tc.setupMock(testClient)
+			exec := cmdtest.SetupCmdForTest(
+				t,
+				NewCmdView,
+				false,
+				cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(testClient.Client))),
+			)
+
+			// WHEN
+			out, err := exec(tc.cli)
+
+			// THEN
+			if tc.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantStderr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.ExpectedMsg, out.OutBuf.String())
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package apply
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"mime"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var patchPrefixRE = regexp.MustCompile(`^\[PATCH[^\]]*\]\s*`)
+
+// readMailbox reads the patches of the files, or of the *.patch files of a
+// directory, in order. The argument "-" reads them from in.
+func readMailbox(args []string, in io.Reader) ([]byte, error) {
+	var mbox bytes.Buffer
+	for _, arg := range args {
+		if arg == "-" {
+			if _, err := io.Copy(&mbox, in); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		files := []string{arg}
+		if info, err := os.Stat(arg); err != nil {
+			return nil, err
+		} else if info.IsDir() {
+			// Glob sorts the names, which git format-patch numbers.
+			files, err = filepath.Glob(filepath.Join(arg, "*.patch"))
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for _, file := range files {
+			content, err := os.ReadFile(file)
+			if err != nil {
+				return nil, err
+			}
+			mbox.Write(content)
+			if len(content) > 0 && !bytes.HasSuffix(content, []byte("\n")) {
+				mbox.WriteString("\n")
+			}
+		}
+	}
+	return mbox.Bytes(), nil
+}
+
+// patchSubjects returns the subjects of the patches of a mailbox, without their
+// [PATCH n/m] prefix.
+func patchSubjects(mbox []byte) []string {
+	var subjects []string
+	var decoder mime.WordDecoder
+	var subject string
+	inHeader, inSubject := false, false
+
+	add := func() {
+		if decoded, err := decoder.DecodeHeader(subject); err == nil {
+			subject = decoded
+		}
+		subjects = append(subjects, patchPrefixRE.ReplaceAllString(subject, ""))
+		inSubject = false
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(mbox))
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case inSubject && strings.HasPrefix(line, " "):
+			// A long subject is folded over several lines.
+			subject += line
+			continue
+		case inSubject:
+			add()
+		}
+
+		switch {
+		case strings.HasPrefix(line, "From "):
+			inHeader = true
+		case line == "":
+			inHeader = false
+		case inHeader && strings.HasPrefix(line, "Subject: "):
+			subject = strings.TrimPrefix(line, "Subject: ")
+			inSubject = true
+		}
+	}
+	if inSubject {
+		add()
+	}
+	return subjects
+}
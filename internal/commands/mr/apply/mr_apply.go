@@ -0,0 +1,177 @@
+package apply
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/mr/mrutils"
+	"gitlab.com/gitlab-org/cli/internal/git"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	args         []string
+	branch       string
+	targetBranch string
+	title        string
+	description  string
+	draft        bool
+
+	io      *iostreams.IOStreams
+	factory cmdutils.Factory
+}
+
+func NewCmdApply(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:      f.IO(),
+		factory: f,
+	}
+
+	mrApplyCmd := &cobra.Command{
+		Use:   "apply {<file>... | <dir> | -} [flags]",
+		Short: `Create a merge request from a series of email-style patches.`,
+		Long: heredoc.Doc(`
+			Create a merge request from a series of patches in mailbox format, like the ones
+			created by glab mr patch or git format-patch.
+
+			The patches are applied with git am onto a new branch, created from the target
+			branch, which is then pushed. When a patch doesn't apply, the new branch is deleted
+			and the current branch is checked out again.
+
+			The title of the merge request defaults to the subject of the first patch, and the
+			name of the branch to the title.
+		`),
+		Example: heredoc.Doc(`
+			$ glab mr apply mr-123.mbox
+			$ glab mr apply patches/ --branch fix-login --target-branch release-1.2
+			$ glab mr patch 123 -R other/project | glab mr apply - --draft
+		`),
+		Args: cobra.MinimumNArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.args = args
+
+			return opts.run()
+		},
+	}
+
+	fl := mrApplyCmd.Flags()
+	fl.StringVarP(&opts.branch, "branch", "b", "", "Name of the branch to create. Defaults to the title of the merge request.")
+	fl.StringVarP(&opts.targetBranch, "target-branch", "t", "", "Branch to apply the patches onto, and to merge into. Defaults to the default branch of the project.")
+	fl.StringVar(&opts.title, "title", "", "Title of the merge request. Defaults to the subject of the first patch.")
+	fl.StringVarP(&opts.description, "description", "d", "", "Description of the merge request. Defaults to the list of the patches.")
+	fl.BoolVar(&opts.draft, "draft", false, "Mark the merge request as a draft.")
+
+	return mrApplyCmd
+}
+
+func (o *options) run() error {
+	c := o.io.Color()
+
+	mbox, err := readMailbox(o.args, o.io.In)
+	if err != nil {
+		return err
+	}
+	subjects := patchSubjects(mbox)
+	if len(subjects) == 0 {
+		return errors.New("no patches found.")
+	}
+
+	if o.title == "" {
+		o.title = subjects[0]
+	}
+	if o.branch == "" {
+		o.branch = mrutils.TitleSlug(o.title)
+		if o.branch == "" {
+			return &cmdutils.FlagError{Err: errors.New("can't name the branch after the title. Use --branch.")}
+		}
+	}
+	if o.description == "" && len(subjects) > 1 {
+		o.description = "- " + strings.Join(subjects, "\n- ")
+	}
+
+	if n, err := git.UncommittedChangeCount(); err != nil {
+		return err
+	} else if n > 0 {
+		return fmt.Errorf("you have %s. Commit or stash them before applying patches.", utils.Pluralize(n, "uncommitted change"))
+	}
+
+	client, err := o.factory.GitLabClient()
+	if err != nil {
+		return err
+	}
+	repo, err := o.factory.BaseRepo()
+	if err != nil {
+		return err
+	}
+	remotes, err := o.factory.Remotes()
+	if err != nil {
+		return err
+	}
+	remote, err := remotes.FindByRepo(repo.RepoOwner(), repo.RepoName())
+	if err != nil {
+		return fmt.Errorf("no git remote found for %s.", repo.FullName())
+	}
+
+	if o.targetBranch == "" {
+		project, err := api.GetProject(client, repo.FullName())
+		if err != nil {
+			return err
+		}
+		o.targetBranch = project.DefaultBranch
+	}
+
+	if err := git.RunCmd([]string{"fetch", remote.Name, o.targetBranch}); err != nil {
+		return err
+	}
+	if err := git.RunCmd([]string{"checkout", "-b", o.branch, "FETCH_HEAD"}); err != nil {
+		return err
+	}
+
+	patches := "patches"
+	if len(subjects) == 1 {
+		patches = "patch"
+	}
+	fmt.Fprintf(o.io.StdErr, "Applying %d %s onto %s\n", len(subjects), patches, c.Cyan(o.targetBranch))
+	if err := git.ApplyMailbox(bytes.NewReader(mbox), o.io.StdErr, o.io.StdErr); err != nil {
+		if err := git.RunCmd([]string{"checkout", "-"}); err == nil {
+			_ = git.DeleteLocalBranch(o.branch, git.StandardGitCommand{})
+		}
+		return err
+	}
+
+	if err := git.Push(remote.Name, o.branch, o.io.StdErr, o.io.StdErr); err != nil {
+		return err
+	}
+	_ = git.SetUpstream(remote.Name, o.branch, o.io.StdErr, o.io.StdErr)
+
+	title := o.title
+	if o.draft {
+		title = "Draft: " + title
+	}
+	mr, _, err := client.MergeRequests.CreateMergeRequest(repo.FullName(), &gitlab.CreateMergeRequestOptions{
+		Title:        gitlab.Ptr(title),
+		Description:  gitlab.Ptr(o.description),
+		SourceBranch: gitlab.Ptr(o.branch),
+		TargetBranch: gitlab.Ptr(o.targetBranch),
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to create the merge request.")
+	}
+
+	fmt.Fprintln(o.io.StdOut, mrutils.DisplayMR(c, &mr.BasicMergeRequest, o.io.IsOutputTTY()))
+	return nil
+}
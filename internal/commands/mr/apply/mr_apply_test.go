@@ -0,0 +1,171 @@
+//go:build !integration
+
+package apply
+
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/git"
+	"gitlab.com/gitlab-org/cli/internal/glinstance"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+const mbox = `From 1111111111111111111111111111111111111111 Mon Sep 17 00:00:00 2001
+From: Jane Doe <jane@example.com>
+Subject: [PATCH 1/2] Add greeting
+
+---
+diff --git a/hello.txt b/hello.txt
+-- 
+glab
+
+From 2222222222222222222222222222222222222222 Mon Sep 17 00:00:00 2001
+From: =?utf-8?q?Jos=C3=A9?= <jose@example.com>
+Subject: [PATCH 2/2] =?utf-8?q?Fix_caf=C3=A9_menu?=
+
+Subject: not a header
+---
+-- 
+glab
+`
+
+func setupTest(t *testing.T, testClient *gitlabtesting.TestClient, opts ...cmdtest.FactoryOption) func(string) (*test.CmdOut, error) {
+	t.Helper()
+
+	pu, _ := url.Parse("https://gitlab.com/OWNER/REPO.git")
+	defaultOpts := []cmdtest.FactoryOption{
+		cmdtest.WithGitLabClient(testClient.Client),
+		func(f *cmdtest.Factory) {
+			f.RemotesStub = func() (glrepo.Remotes, error) {
+				return glrepo.Remotes{
+					{
+						Remote: &git.Remote{Name: "origin", Resolved: "base", PushURL: pu},
+						Repo:   glrepo.New("OWNER", "REPO", glinstance.DefaultHostname),
+					},
+				}, nil
+			}
+		},
+	}
+	return cmdtest.SetupCmdForTest(t, NewCmdApply, false, append(defaultOpts, opts...)...)
+}
+
+func TestPatchSubjects(t *testing.T) {
+	assert.Equal(t, []string{"Add greeting", "Fix café menu"}, patchSubjects([]byte(mbox)))
+
+	folded := "From 1111111111111111111111111111111111111111 Mon Sep 17 00:00:00 2001\n" +
+		"Subject: [PATCH] Make the subject of this patch long enough to be\n" +
+		" folded\n" +
+		"\n"
+	assert.Equal(t, []string{"Make the subject of this patch long enough to be folded"}, patchSubjects([]byte(folded)))
+}
+
+func TestReadMailbox(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "0002-b.patch"), []byte("second"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "0001-a.patch"), []byte("first\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("ignored\n"), 0o644))
+
+	got, err := readMailbox([]string{dir, "-"}, strings.NewReader("third\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "first\nsecond\nthird\n", string(got))
+}
+
+func TestMrApply(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockProjects.EXPECT().
+		GetProject("OWNER/REPO", gomock.Any()).
+		Return(&gitlab.Project{DefaultBranch: "main"}, nil, nil)
+	testClient.MockMergeRequests.EXPECT().
+		CreateMergeRequest("OWNER/REPO", &gitlab.CreateMergeRequestOptions{
+			Title:        gitlab.Ptr("Draft: Add greeting"),
+			Description:  gitlab.Ptr("- Add greeting\n- Fix café menu"),
+			SourceBranch: gitlab.Ptr("add-greeting"),
+			TargetBranch: gitlab.Ptr("main"),
+		}).
+		Return(&gitlab.MergeRequest{BasicMergeRequest: gitlab.BasicMergeRequest{
+			IID:    7,
+			WebURL: "https://gitlab.com/OWNER/REPO/-/merge_requests/7",
+		}}, nil, nil)
+
+	cs, csTeardown := test.InitCmdStubber()
+	defer csTeardown()
+	for range 6 {
+		cs.Stub("")
+	}
+
+	exec := setupTest(t, testClient, cmdtest.WithStdin(mbox))
+	out, err := exec("- --draft")
+	require.NoError(t, err)
+	assert.Equal(t, "https://gitlab.com/OWNER/REPO/-/merge_requests/7\n", out.String())
+	assert.Contains(t, out.Stderr(), "Applying 2 patches onto main\n")
+
+	expectedShellouts := []string{
+		"git status --porcelain",
+		"git fetch origin main",
+		"git checkout -b add-greeting FETCH_HEAD",
+		"git am --3way",
+		"git push origin add-greeting",
+		"git branch --set-upstream-to origin/add-greeting",
+	}
+	assert.Equal(t, len(expectedShellouts), cs.Count)
+	for idx, expectedShellout := range expectedShellouts {
+		assert.Equal(t, expectedShellout, strings.Join(cs.Calls[idx].Args, " "))
+	}
+}
+
+func TestMrApplyFailure(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+
+	cs, csTeardown := test.InitCmdStubber()
+	defer csTeardown()
+	cs.Stub("")
+	cs.Stub("")
+	cs.Stub("")
+	cs.StubError("patch does not apply")
+	cs.Stub("")
+	cs.Stub("")
+	cs.Stub("")
+
+	exec := setupTest(t, testClient, cmdtest.WithStdin(mbox))
+	_, err := exec("- --branch fix --target-branch stable")
+	require.ErrorContains(t, err, "could not apply the patches")
+
+	expectedShellouts := []string{
+		"git status --porcelain",
+		"git fetch origin stable",
+		"git checkout -b fix FETCH_HEAD",
+		"git am --3way",
+		"git am --abort",
+		"git checkout -",
+		"git branch -D fix",
+	}
+	assert.Equal(t, len(expectedShellouts), cs.Count)
+	for idx, expectedShellout := range expectedShellouts {
+		assert.Equal(t, expectedShellout, strings.Join(cs.Calls[idx].Args, " "))
+	}
+}
+
+func TestMrApplyUncommittedChanges(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+
+	cs, csTeardown := test.InitCmdStubber()
+	defer csTeardown()
+	cs.Stub(" M README.md\n")
+
+	exec := setupTest(t, testClient, cmdtest.WithStdin(mbox))
+	_, err := exec("-")
+	require.EqualError(t, err, "you have 1 uncommitted change. Commit or stash them before applying patches.")
+}
@@ -1,7 +1,9 @@
 package approve
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/spf13/cobra"
@@ -15,9 +17,17 @@ import (
 
 func NewCmdApprove(f cmdutils.Factory) *cobra.Command {
 	mrApproveCmd := &cobra.Command{
-		Use:   "approve {<id> | <branch>}",
+		Use:   "approve {<id> | <branch> | -}",
 		Short: `Approve merge requests.`,
-		Long:  ``,
+		Long: heredoc.Doc(`
+			Approve merge requests.
+
+			Pass - to read the IDs of the merge requests from standard input, like the
+			output of glab mr list --output ids.
+
+			With --sha, the merge request is approved only if its HEAD commit is the reviewed
+			one, so changes pushed after the review aren't approved by mistake.
+		`),
 		Example: heredoc.Doc(`
 			$ glab mr approve 235
 			$ glab mr approve 123 345
@@ -26,6 +36,12 @@ func NewCmdApprove(f cmdutils.Factory) *cobra.Command {
 
 			# Finds open merge request from current branch and approves it
 			$ glab mr approve
+
+			# Approve the reviewed commit only, with a comment
+			$ glab mr approve 235 --sha 4e1b2f3 --message "LGTM, thanks!"
+
+			# Approve all merge requests of a renovate bot
+			$ glab mr list --author renovate-bot --output ids | glab mr approve -
 		`),
 		Annotations: map[string]string{
 			mcpannotations.Destructive: "true",
@@ -39,11 +55,22 @@ func NewCmdApprove(f cmdutils.Factory) *cobra.Command {
 				return err
 			}
 
+			args, err = mrutils.IDsFromStdin(args, f.IO().In)
+			if err != nil {
+				return err
+			}
+
 			mrs, repo, err := mrutils.MRsFromArgs(f, args, "opened")
 			if err != nil {
 				return err
 			}
 
+			sha, _ := cmd.Flags().GetString("sha")
+			if sha != "" && len(mrs) > 1 {
+				return &cmdutils.FlagError{Err: errors.New("--sha can only be used to approve a single merge request.")}
+			}
+			message, _ := cmd.Flags().GetString("message")
+
 			for _, mr := range mrs {
 				if err = mrutils.MRCheckErrors(mr, mrutils.MRCheckErrOptions{
 					Closed: true,
@@ -53,8 +80,12 @@ func NewCmdApprove(f cmdutils.Factory) *cobra.Command {
 				}
 
 				opts := &gitlab.ApproveMergeRequestOptions{}
-				if s, _ := cmd.Flags().GetString("sha"); s != "" {
-					opts.SHA = gitlab.Ptr(s)
+				if sha != "" {
+					// Abbreviated SHAs are accepted, but the API needs the full one.
+					if !strings.HasPrefix(mr.SHA, sha) {
+						return fmt.Errorf("the HEAD commit of merge request !%d is %s, not %s. Review the new changes before approving.", mr.IID, mr.SHA, sha)
+					}
+					opts.SHA = gitlab.Ptr(mr.SHA)
 				}
 
 				fmt.Fprintf(f.IO().StdOut, "- Approving merge request !%d\n", mr.IID)
@@ -63,6 +94,13 @@ func NewCmdApprove(f cmdutils.Factory) *cobra.Command {
 					return err
 				}
 				fmt.Fprintln(f.IO().StdOut, c.GreenCheck(), "Approved")
+
+				if message != "" {
+					_, _, err := client.Notes.CreateMergeRequestNote(repo.FullName(), mr.IID, &gitlab.CreateMergeRequestNoteOptions{Body: gitlab.Ptr(message)})
+					if err != nil {
+						return cmdutils.WrapError(err, fmt.Sprintf("merge request !%d was approved, but the comment couldn't be added.", mr.IID))
+					}
+				}
 			}
 
 			return nil
@@ -70,6 +108,7 @@ func NewCmdApprove(f cmdutils.Factory) *cobra.Command {
 	}
 
 	// mrApproveCmd.Flags().StringP("password", "p", "", "Current user’s password. Required if 'Require user password to approve' is enabled in the project settings.")
-	mrApproveCmd.Flags().StringP("sha", "s", "", "SHA, which must match the SHA of the HEAD commit of the merge request.")
+	mrApproveCmd.Flags().StringP("sha", "s", "", "SHA, which must match the SHA of the HEAD commit of the merge request. Abbreviated SHAs are accepted.")
+	mrApproveCmd.Flags().StringP("message", "m", "", "Add a comment to the merge requests with the approval.")
 	return mrApproveCmd
 }
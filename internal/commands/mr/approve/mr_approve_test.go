@@ -95,3 +95,82 @@ func TestMrApproveDraft(t *testing.T) {
 		`), output.String())
 	assert.Empty(t, output.Stderr())
 }
+
+func TestMrApproveSHAAndMessage(t *testing.T) {
+	t.Parallel()
+
+	tc := gitlabtesting.NewTestClient(t)
+	tc.MockMergeRequests.EXPECT().
+		GetMergeRequest("OWNER/REPO", int64(123), gomock.Any(), gomock.Any()).
+		Return(&gitlab.MergeRequest{
+			BasicMergeRequest: gitlab.BasicMergeRequest{
+				IID:   123,
+				State: "opened",
+				SHA:   "4e1b2f3a5c6d7e8f9a0b1c2d3e4f5a6b7c8d9e0f",
+			},
+		}, nil, nil).
+		Times(2)
+	tc.MockMergeRequestApprovals.EXPECT().
+		ApproveMergeRequest("OWNER/REPO", int64(123), &gitlab.ApproveMergeRequestOptions{
+			SHA: gitlab.Ptr("4e1b2f3a5c6d7e8f9a0b1c2d3e4f5a6b7c8d9e0f"),
+		}).
+		Return(&gitlab.MergeRequestApprovals{}, nil, nil)
+	tc.MockNotes.EXPECT().
+		CreateMergeRequestNote("OWNER/REPO", int64(123), &gitlab.CreateMergeRequestNoteOptions{Body: gitlab.Ptr("LGTM")}).
+		Return(&gitlab.Note{}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdApprove, false,
+		cmdtest.WithGitLabClient(tc.Client),
+		cmdtest.WithBaseRepo("OWNER", "REPO", glinstance.DefaultHostname),
+	)
+	output, err := exec("123 --sha 4e1b2f3 --message LGTM")
+	require.NoError(t, err)
+	assert.Equal(t, heredoc.Doc(`
+		- Approving merge request !123
+		✓ Approved
+		`), output.String())
+
+	exec = cmdtest.SetupCmdForTest(t, NewCmdApprove, false,
+		cmdtest.WithGitLabClient(tc.Client),
+		cmdtest.WithBaseRepo("OWNER", "REPO", glinstance.DefaultHostname),
+	)
+	_, err = exec("123 --sha 0000000")
+	require.EqualError(t, err, "the HEAD commit of merge request !123 is 4e1b2f3a5c6d7e8f9a0b1c2d3e4f5a6b7c8d9e0f, not 0000000. Review the new changes before approving.")
+}
+
+func TestMrApproveFromStdin(t *testing.T) {
+	t.Parallel()
+
+	tc := gitlabtesting.NewTestClient(t)
+	for _, iid := range []int64{4, 7} {
+		tc.MockMergeRequests.EXPECT().
+			GetMergeRequest("OWNER/REPO", iid, gomock.Any(), gomock.Any()).
+			Return(&gitlab.MergeRequest{BasicMergeRequest: gitlab.BasicMergeRequest{IID: iid, State: "opened"}}, nil, nil).
+			Times(2)
+		tc.MockMergeRequestApprovals.EXPECT().
+			ApproveMergeRequest("OWNER/REPO", iid, gomock.Any()).
+			Return(&gitlab.MergeRequestApprovals{}, nil, nil)
+	}
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdApprove, false,
+		cmdtest.WithGitLabClient(tc.Client),
+		cmdtest.WithBaseRepo("OWNER", "REPO", glinstance.DefaultHostname),
+		cmdtest.WithStdin("4\n7\n"),
+	)
+	output, err := exec("-")
+	require.NoError(t, err)
+	assert.Equal(t, heredoc.Doc(`
+		- Approving merge request !4
+		✓ Approved
+		- Approving merge request !7
+		✓ Approved
+		`), output.String())
+
+	exec = cmdtest.SetupCmdForTest(t, NewCmdApprove, false,
+		cmdtest.WithGitLabClient(tc.Client),
+		cmdtest.WithBaseRepo("OWNER", "REPO", glinstance.DefaultHostname),
+		cmdtest.WithStdin("4 7"),
+	)
+	_, err = exec("- --sha 4e1b2f3")
+	require.EqualError(t, err, "--sha can only be used to approve a single merge request.")
+}
@@ -1,50 +1,158 @@
 package approvers
 
 import (
+	"encoding/json"
 	"fmt"
 
+	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/spf13/cobra"
 
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
 	"gitlab.com/gitlab-org/cli/internal/commands/mr/mrutils"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
 	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
 )
 
+type options struct {
+	args         []string
+	outputFormat string
+
+	io      *iostreams.IOStreams
+	factory cmdutils.Factory
+}
+
+// approvalStatus is the JSON output of the command.
+type approvalStatus struct {
+	IID                      int64                              `json:"iid"`
+	State                    string                             `json:"state"`
+	Approved                 bool                               `json:"approved"`
+	ApprovalsRequired        int64                              `json:"approvals_required"`
+	ApprovalsLeft            int64                              `json:"approvals_left"`
+	ApprovedBy               []string                           `json:"approved_by"`
+	UserCanApprove           bool                               `json:"user_can_approve"`
+	UserHasApproved          bool                               `json:"user_has_approved"`
+	ApprovalRulesOverwritten bool                               `json:"approval_rules_overwritten"`
+	Rules                    []*gitlab.MergeRequestApprovalRule `json:"rules"`
+}
+
 func NewCmdApprovers(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:      f.IO(),
+		factory: f,
+	}
+
 	mrApproversCmd := &cobra.Command{
-		Use:     "approvers [<id> | <branch>] [flags]",
-		Short:   `List eligible approvers for merge requests in any state.`,
-		Long:    ``,
+		Use:   "approvers [<id> | <branch>] [flags]",
+		Short: `List eligible approvers for merge requests in any state.`,
+		Long: heredoc.Doc(`
+			List the approval rules of a merge request, with the eligible approvers and the
+			approvals of each rule, and whether the merge request is approved.
+
+			Use --output json in automation, for example to check the approval state of a merge
+			request before merging it.
+		`),
+		Example: heredoc.Doc(`
+			$ glab mr approvers 123
+			$ glab mr approvers 123 --output json | jq '.approved'
+		`),
 		Aliases: []string{},
 		Args:    cobra.MaximumNArgs(1),
 		Annotations: map[string]string{
 			mcpannotations.Safe: "true",
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			client, err := f.GitLabClient()
-			if err != nil {
-				return err
-			}
+			opts.args = args
 
-			// Obtain the MR from the positional arguments, but allow users to find approvers for
-			// merge requests in any valid state
-			mr, repo, err := mrutils.MRFromArgs(f, args, "any")
-			if err != nil {
-				return err
-			}
+			return opts.run()
+		},
+	}
 
-			fmt.Fprintf(f.IO().StdOut, "\nListing merge request !%d eligible approvers:\n", mr.IID)
+	mrApproversCmd.Flags().VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
 
-			mrApprovals, _, err := client.MergeRequestApprovals.GetApprovalState(repo.FullName(), mr.IID)
-			if err != nil {
-				return err
+	return mrApproversCmd
+}
+
+func (o *options) run() error {
+	client, err := o.factory.GitLabClient()
+	if err != nil {
+		return err
+	}
+
+	// Obtain the MR from the positional arguments, but allow users to find approvers for
+	// merge requests in any valid state
+	mr, repo, err := mrutils.MRFromArgs(o.factory, o.args, "any")
+	if err != nil {
+		return err
+	}
+
+	mrApprovals, _, err := client.MergeRequestApprovals.GetApprovalState(repo.FullName(), mr.IID)
+	if err != nil {
+		return err
+	}
+
+	approvals, _, err := client.MergeRequestApprovals.GetConfiguration(repo.FullName(), mr.IID)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to get the approvals of the merge request.")
+	}
+
+	if o.outputFormat == "json" {
+		status := approvalStatus{
+			IID:                      mr.IID,
+			State:                    mr.State,
+			Approved:                 approvals.Approved,
+			ApprovalsRequired:        approvals.ApprovalsRequired,
+			ApprovalsLeft:            approvals.ApprovalsLeft,
+			ApprovedBy:               []string{},
+			UserCanApprove:           approvals.UserCanApprove,
+			UserHasApproved:          approvals.UserHasApproved,
+			ApprovalRulesOverwritten: mrApprovals.ApprovalRulesOverwritten,
+			Rules:                    mrApprovals.Rules,
+		}
+		for _, approver := range approvals.ApprovedBy {
+			if approver.User != nil {
+				status.ApprovedBy = append(status.ApprovedBy, approver.User.Username)
 			}
+		}
+		if status.Rules == nil {
+			status.Rules = []*gitlab.MergeRequestApprovalRule{}
+		}
+
+		out, err := json.Marshal(status)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(o.io.StdOut, string(out))
+		return nil
+	}
 
-			mrutils.PrintMRApprovalState(f.IO(), mrApprovals)
+	fmt.Fprintf(o.io.StdOut, "\nListing merge request !%d eligible approvers:\n", mr.IID)
 
-			return nil
-		},
+	mrutils.PrintMRApprovalState(o.io, mrApprovals)
+	o.printSummary(approvals)
+
+	return nil
+}
+
+// printSummary prints whether the merge request is approved, and whether the
+// current user can approve it.
+func (o *options) printSummary(approvals *gitlab.MergeRequestApprovals) {
+	c := o.io.Color()
+
+	switch {
+	case approvals.Approved:
+		fmt.Fprintf(o.io.StdOut, "%s Approved (%d/%d required).\n", c.GreenCheck(), len(approvals.ApprovedBy), approvals.ApprovalsRequired)
+	default:
+		fmt.Fprintf(o.io.StdOut, "%s Not approved: %d more required.\n", c.WarnIcon(), approvals.ApprovalsLeft)
 	}
 
-	return mrApproversCmd
+	switch {
+	case approvals.UserHasApproved:
+		fmt.Fprintln(o.io.StdOut, "You have approved this merge request.")
+	case approvals.UserCanApprove:
+		fmt.Fprintln(o.io.StdOut, "You can approve this merge request.")
+	default:
+		fmt.Fprintln(o.io.StdOut, "You can't approve this merge request.")
+	}
 }
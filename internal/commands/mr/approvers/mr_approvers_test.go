@@ -3,6 +3,7 @@
 package approvers
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -71,6 +72,14 @@ func TestMrApprovers(t *testing.T) {
 		},
 	}
 
+	approvals := &gitlab.MergeRequestApprovals{
+		Approved:          true,
+		ApprovalsRequired: 1,
+		ApprovedBy: []*gitlab.MergeRequestApproverUser{
+			{User: &gitlab.BasicUser{ID: 1232, Username: "foo_reviewer"}},
+		},
+	}
+
 	testCases := []testCase{
 		{
 			name: "List approvers by MR ID",
@@ -82,7 +91,9 @@ func TestMrApprovers(t *testing.T) {
 				"Name\tUsername\tApproved\n" +
 				"Abc Approver\tapprover_1\t-\t\n" +
 				"Bar Approver\tapprover_2\t-\t\n" +
-				"Foo Reviewer\tfoo_reviewer\t👍\t\n\n",
+				"Foo Reviewer\tfoo_reviewer\t👍\t\n\n" +
+				"✓ Approved (1/1 required).\n" +
+				"You can't approve this merge request.\n",
 			setupMock: func(tc *gitlabtesting.TestClient) {
 				tc.MockMergeRequests.EXPECT().
 					GetMergeRequest("OWNER/REPO", int64(123), gomock.Any()).
@@ -90,6 +101,27 @@ func TestMrApprovers(t *testing.T) {
 				tc.MockMergeRequestApprovals.EXPECT().
 					GetApprovalState("OWNER/REPO", int64(123), gomock.Any()).
 					Return(approvalState, nil, nil)
+				tc.MockMergeRequestApprovals.EXPECT().
+					GetConfiguration("OWNER/REPO", int64(123), gomock.Any()).
+					Return(approvals, nil, nil)
+			},
+		},
+		{
+			name: "Not approved",
+			cli:  "123",
+			expectedOut: "\nListing merge request !123 eligible approvers:\n" +
+				"! Not approved: 2 more required.\n" +
+				"You can approve this merge request.\n",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockMergeRequests.EXPECT().
+					GetMergeRequest("OWNER/REPO", int64(123), gomock.Any()).
+					Return(testMR, nil, nil)
+				tc.MockMergeRequestApprovals.EXPECT().
+					GetApprovalState("OWNER/REPO", int64(123), gomock.Any()).
+					Return(&gitlab.MergeRequestApprovalState{}, nil, nil)
+				tc.MockMergeRequestApprovals.EXPECT().
+					GetConfiguration("OWNER/REPO", int64(123), gomock.Any()).
+					Return(&gitlab.MergeRequestApprovals{ApprovalsRequired: 2, ApprovalsLeft: 2, UserCanApprove: true}, nil, nil)
 			},
 		},
 	}
@@ -121,3 +153,47 @@ func TestMrApprovers(t *testing.T) {
 		})
 	}
 }
+
+func TestMrApproversJSON(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockMergeRequests.EXPECT().
+		GetMergeRequest("OWNER/REPO", int64(123), gomock.Any()).
+		Return(&gitlab.MergeRequest{BasicMergeRequest: gitlab.BasicMergeRequest{IID: 123, State: "opened"}}, nil, nil)
+	testClient.MockMergeRequestApprovals.EXPECT().
+		GetApprovalState("OWNER/REPO", int64(123), gomock.Any()).
+		Return(&gitlab.MergeRequestApprovalState{
+			Rules: []*gitlab.MergeRequestApprovalRule{{
+				ID:                239,
+				Name:              "Security",
+				ApprovalsRequired: 2,
+				EligibleApprovers: []*gitlab.BasicUser{{Username: "approver_1"}, {Username: "approver_2"}},
+				ApprovedBy:        []*gitlab.BasicUser{{Username: "approver_1"}},
+			}},
+		}, nil, nil)
+	testClient.MockMergeRequestApprovals.EXPECT().
+		GetConfiguration("OWNER/REPO", int64(123), gomock.Any()).
+		Return(&gitlab.MergeRequestApprovals{
+			ApprovalsRequired: 2,
+			ApprovalsLeft:     1,
+			UserCanApprove:    true,
+			ApprovedBy: []*gitlab.MergeRequestApproverUser{
+				{User: &gitlab.BasicUser{Username: "approver_1"}},
+			},
+		}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdApprovers, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	out, err := exec("123 --output json")
+	require.NoError(t, err)
+
+	var status approvalStatus
+	require.NoError(t, json.Unmarshal(out.OutBuf.Bytes(), &status))
+	assert.Equal(t, int64(123), status.IID)
+	assert.False(t, status.Approved)
+	assert.Equal(t, int64(1), status.ApprovalsLeft)
+	assert.Equal(t, []string{"approver_1"}, status.ApprovedBy)
+	assert.True(t, status.UserCanApprove)
+	require.Len(t, status.Rules, 1)
+	assert.Equal(t, "Security", status.Rules[0].Name)
+	assert.Len(t, status.Rules[0].EligibleApprovers, 2)
+}
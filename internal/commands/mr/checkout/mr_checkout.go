@@ -1,7 +1,10 @@
 package checkout
 
 import (
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/MakeNowJust/heredoc/v2"
@@ -21,6 +24,8 @@ type mrCheckoutConfig struct {
 	branch   string
 	track    bool
 	upstream string
+	worktree string
+	detach   bool
 }
 
 var mrCheckoutCfg mrCheckoutConfig
@@ -29,7 +34,14 @@ func NewCmdCheckout(f cmdutils.Factory) *cobra.Command {
 	mrCheckoutCmd := &cobra.Command{
 		Use:   "checkout [<id> | <branch> | <url>]",
 		Short: "Check out an open merge request.",
-		Long:  ``,
+		Long: heredoc.Doc(`
+			Check out an open merge request.
+
+			By default, the source branch of the merge request is fetched into a local branch,
+			and the current branch is switched to it. Use --worktree to check it out in a new
+			git worktree instead, and keep working on the current branch. Use --detach to review
+			the merge request without creating a local branch.
+		`),
 		Example: heredoc.Doc(`
 			$ glab mr checkout 1
 			$ glab mr checkout branch
@@ -37,6 +49,13 @@ func NewCmdCheckout(f cmdutils.Factory) *cobra.Command {
 			$ glab mr checkout new-feature --set-upstream-to=upstream/main
 			$ glab mr checkout https://gitlab.com/gitlab-org/cli/-/merge_requests/1234
 
+			# Check out in a new worktree, next to the repository
+			$ glab mr checkout 12 --worktree ../review-12
+
+			# Review without creating a local branch
+			$ glab mr checkout 12 --detach
+			$ glab mr checkout 12 --detach --worktree ../review-12
+
 			# Uses the checked-out branch
 			$ glab mr checkout
 		`),
@@ -48,6 +67,15 @@ func NewCmdCheckout(f cmdutils.Factory) *cobra.Command {
 			var err error
 			var upstream string
 
+			if mrCheckoutCfg.detach {
+				if mrCheckoutCfg.branch != "" {
+					return &cmdutils.FlagError{Err: errors.New("the --branch flag can't be used with --detach.")}
+				}
+				if mrCheckoutCfg.upstream != "" {
+					return &cmdutils.FlagError{Err: errors.New("the --set-upstream-to flag can't be used with --detach.")}
+				}
+			}
+
 			if mrCheckoutCfg.upstream != "" {
 				upstream = mrCheckoutCfg.upstream
 
@@ -108,6 +136,29 @@ func NewCmdCheckout(f cmdutils.Factory) *cobra.Command {
 			}
 			repoURL := glrepo.RemoteURL(mrProject, gitProtocol)
 
+			var worktreePath string
+			if mrCheckoutCfg.worktree != "" {
+				worktreePath, err = filepath.Abs(mrCheckoutCfg.worktree)
+				if err != nil {
+					return err
+				}
+
+				done, err := checkWorktree(f, mr, worktreePath)
+				if err != nil || done {
+					return err
+				}
+			}
+
+			if mrCheckoutCfg.detach {
+				if err := git.RunCmd([]string{"fetch", repoURL, mrRef}); err != nil {
+					return err
+				}
+				if worktreePath != "" {
+					return addWorktree(f, mr, worktreePath, "FETCH_HEAD", true)
+				}
+				return git.RunCmd([]string{"checkout", "--detach", "FETCH_HEAD"})
+			}
+
 			fetchRefSpec := fmt.Sprintf("%s:%s", mrRef, mrCheckoutCfg.branch)
 			if err := git.RunCmd([]string{"fetch", repoURL, fetchRefSpec}); err != nil {
 				// the remote may have diverged from local after git operations
@@ -132,6 +183,16 @@ func NewCmdCheckout(f cmdutils.Factory) *cobra.Command {
 				return err
 			}
 
+			if worktreePath != "" {
+				if err := addWorktree(f, mr, worktreePath, mrCheckoutCfg.branch, false); err != nil {
+					return err
+				}
+				if upstream != "" {
+					return git.RunCmd([]string{"branch", "--set-upstream-to", upstream, mrCheckoutCfg.branch})
+				}
+				return nil
+			}
+
 			// Check out branch
 			var gr git.StandardGitCommand
 
@@ -152,5 +213,59 @@ func NewCmdCheckout(f cmdutils.Factory) *cobra.Command {
 	mrCheckoutCmd.Flags().BoolVarP(&mrCheckoutCfg.track, "track", "t", true, "Set checked out branch to track the remote branch.")
 	_ = mrCheckoutCmd.Flags().MarkDeprecated("track", "Now enabled by default")
 	mrCheckoutCmd.Flags().StringVarP(&mrCheckoutCfg.upstream, "set-upstream-to", "u", "", "Set tracking of checked-out branch to [REMOTE/]BRANCH.")
+	mrCheckoutCmd.Flags().StringVarP(&mrCheckoutCfg.worktree, "worktree", "w", "", "Check out the merge request in a new git worktree at <dir>, instead of switching the current branch.")
+	mrCheckoutCmd.Flags().BoolVarP(&mrCheckoutCfg.detach, "detach", "d", false, "Check out the merge request with a detached HEAD, without creating a local branch.")
 	return mrCheckoutCmd
 }
+
+// checkWorktree checks that the merge request can be checked out in a new
+// worktree at path. It returns true when the merge request is already checked
+// out there, and there is nothing left to do.
+func checkWorktree(f cmdutils.Factory, mr *gitlab.MergeRequest, path string) (bool, error) {
+	worktrees, err := git.ListWorktrees()
+	if err != nil {
+		return false, err
+	}
+
+	for _, wt := range worktrees {
+		switch {
+		case samePath(wt.Path, path):
+			if !mrCheckoutCfg.detach && wt.Branch == mrCheckoutCfg.branch {
+				fmt.Fprintf(f.IO().StdErr, "Merge request !%d is already checked out in %s.\n", mr.IID, path)
+				return true, nil
+			}
+			checkedOut := "a detached HEAD"
+			if wt.Branch != "" {
+				checkedOut = fmt.Sprintf("branch %q", wt.Branch)
+			}
+			return false, fmt.Errorf("%s is already a worktree, with %s checked out. Choose another directory, or remove it with 'git worktree remove'.", path, checkedOut)
+		case !mrCheckoutCfg.detach && wt.Branch == mrCheckoutCfg.branch:
+			return false, fmt.Errorf("branch %q is already checked out in %s. Use --branch to choose another name, or --detach.", wt.Branch, wt.Path)
+		}
+	}
+
+	if entries, err := os.ReadDir(path); err == nil && len(entries) > 0 {
+		return false, fmt.Errorf("%s already exists and isn't empty.", path)
+	}
+	return false, nil
+}
+
+func addWorktree(f cmdutils.Factory, mr *gitlab.MergeRequest, path, ref string, detach bool) error {
+	if err := git.AddWorktree(path, ref, detach); err != nil {
+		return err
+	}
+	fmt.Fprintf(f.IO().StdErr, "%s Checked out merge request !%d in %s\n", f.IO().Color().GreenCheck(), mr.IID, path)
+	return nil
+}
+
+// samePath reports whether a and b are the same directory, resolving symbolic
+// links when the directories exist.
+func samePath(a, b string) bool {
+	if resolved, err := filepath.EvalSymlinks(a); err == nil {
+		a = resolved
+	}
+	if resolved, err := filepath.EvalSymlinks(b); err == nil {
+		b = resolved
+	}
+	return filepath.Clean(a) == filepath.Clean(b)
+}
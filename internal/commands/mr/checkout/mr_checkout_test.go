@@ -4,11 +4,13 @@ package checkout
 
 import (
 	"net/url"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	gitlab "gitlab.com/gitlab-org/api/client-go"
@@ -306,3 +308,140 @@ func TestMrCheckout_HTTPSProtocolConfiguration(t *testing.T) {
 		assert.Equal(t, expectedShellout, strings.Join(cs.Calls[idx].Args, " "))
 	}
 }
+
+func mockCheckoutMR(testClient *gitlabtesting.TestClient) {
+	testClient.MockMergeRequests.EXPECT().
+		GetMergeRequest("OWNER/REPO", int64(123), gomock.Any(), gomock.Any()).
+		Return(&gitlab.MergeRequest{
+			BasicMergeRequest: gitlab.BasicMergeRequest{
+				ID:              123,
+				IID:             123,
+				ProjectID:       3,
+				SourceProjectID: 3,
+				SourceBranch:    "feat-new-mr",
+				State:           "opened",
+			},
+		}, nil, nil)
+
+	testClient.MockProjects.EXPECT().
+		GetProject(gomock.Any(), gomock.Any()).
+		Return(&gitlab.Project{
+			ID:           3,
+			SSHURLToRepo: "git@gitlab.com:OWNER/REPO.git",
+		}, nil, nil)
+}
+
+func TestMrCheckout_Worktree(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+	dir := filepath.Join(t.TempDir(), "review")
+
+	tests := []struct {
+		name              string
+		args              string
+		worktrees         string
+		expectedShellouts []string
+		wantStderr        string
+		wantErr           string
+	}{
+		{
+			name:      "new worktree",
+			args:      "123 --worktree " + dir,
+			worktrees: "worktree /src/repo\nHEAD deadbeef\nbranch refs/heads/main\n",
+			expectedShellouts: []string{
+				"git worktree list --porcelain",
+				"git fetch git@gitlab.com:OWNER/REPO.git refs/heads/feat-new-mr:feat-new-mr",
+				"git config branch.feat-new-mr.remote git@gitlab.com:OWNER/REPO.git",
+				"git config branch.feat-new-mr.merge refs/heads/feat-new-mr",
+				"git worktree add " + dir + " feat-new-mr",
+			},
+			wantStderr: "✓ Checked out merge request !123 in " + dir + "\n",
+		},
+		{
+			name:      "new detached worktree",
+			args:      "123 --detach --worktree " + dir,
+			worktrees: "worktree /src/repo\nHEAD deadbeef\nbranch refs/heads/feat-new-mr\n",
+			expectedShellouts: []string{
+				"git worktree list --porcelain",
+				"git fetch git@gitlab.com:OWNER/REPO.git refs/heads/feat-new-mr",
+				"git worktree add --detach " + dir + " FETCH_HEAD",
+			},
+			wantStderr: "✓ Checked out merge request !123 in " + dir + "\n",
+		},
+		{
+			name:              "already checked out in the worktree",
+			args:              "123 --worktree " + dir,
+			worktrees:         "worktree " + dir + "\nHEAD deadbeef\nbranch refs/heads/feat-new-mr\n",
+			expectedShellouts: []string{"git worktree list --porcelain"},
+			wantStderr:        "Merge request !123 is already checked out in " + dir + ".\n",
+		},
+		{
+			name:              "another branch checked out in the worktree",
+			args:              "123 --worktree " + dir,
+			worktrees:         "worktree " + dir + "\nHEAD deadbeef\nbranch refs/heads/other\n",
+			expectedShellouts: []string{"git worktree list --porcelain"},
+			wantErr:           dir + ` is already a worktree, with branch "other" checked out. Choose another directory, or remove it with 'git worktree remove'.`,
+		},
+		{
+			name:              "branch checked out in another worktree",
+			args:              "123 --worktree " + dir,
+			worktrees:         "worktree /src/repo\nHEAD deadbeef\nbranch refs/heads/feat-new-mr\n",
+			expectedShellouts: []string{"git worktree list --porcelain"},
+			wantErr:           `branch "feat-new-mr" is already checked out in /src/repo. Use --branch to choose another name, or --detach.`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			mockCheckoutMR(testClient)
+
+			cs, csTeardown := test.InitCmdStubber()
+			defer csTeardown()
+			cs.Stub(tc.worktrees)
+			for range len(tc.expectedShellouts) - 1 {
+				cs.Stub("")
+			}
+
+			exec := setupTest(t, testClient)
+			output, err := exec(tc.args)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.wantStderr, output.Stderr())
+			}
+
+			assert.Equal(t, len(tc.expectedShellouts), cs.Count)
+			for idx, expectedShellout := range tc.expectedShellouts {
+				assert.Equal(t, expectedShellout, strings.Join(cs.Calls[idx].Args, " "))
+			}
+		})
+	}
+}
+
+func TestMrCheckout_Detach(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+	mockCheckoutMR(testClient)
+
+	cs, csTeardown := test.InitCmdStubber()
+	defer csTeardown()
+	cs.Stub("")
+	cs.Stub("")
+
+	exec := setupTest(t, testClient)
+	_, err := exec("123 --detach")
+	require.NoError(t, err)
+
+	expectedShellouts := []string{
+		"git fetch git@gitlab.com:OWNER/REPO.git refs/heads/feat-new-mr",
+		"git checkout --detach FETCH_HEAD",
+	}
+	assert.Equal(t, len(expectedShellouts), cs.Count)
+	for idx, expectedShellout := range expectedShellouts {
+		assert.Equal(t, expectedShellout, strings.Join(cs.Calls[idx].Args, " "))
+	}
+
+	exec = setupTest(t, testClient)
+	_, err = exec("123 --detach --branch foo")
+	require.EqualError(t, err, "the --branch flag can't be used with --detach.")
+}
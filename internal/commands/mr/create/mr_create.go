@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -49,8 +50,10 @@ type options struct {
 	AllowCollaboration bool `json:"allow_collaboration,omitempty"`
 	SquashBeforeMerge  bool `json:"squash_before_merge,omitempty"`
 
-	Autofill       bool `json:"autofill,omitempty"`
+	Fill           bool `json:"fill,omitempty"`
 	FillCommitBody bool `json:"fill_commit_body,omitempty"`
+	Autofill       bool `json:"autofill,omitempty"`
+	FillCommits    bool `json:"fill_commits,omitempty"`
 	IsDraft        bool `json:"is_draft,omitempty"`
 	IsWIP          bool `json:"is_wip,omitempty"`
 	ShouldPush     bool `json:"should_push,omitempty"`
@@ -61,6 +64,9 @@ type options struct {
 	web           bool
 	recover       bool
 	signoff       bool
+	template      string
+	maxDiffLines  int
+	closes        []string
 
 	io              *iostreams.IOStreams             `json:"-"`
 	branch          func() (string, error)           `json:"-"`
@@ -70,7 +76,7 @@ type options struct {
 	baseRepo        func() (glrepo.Interface, error) `json:"-"`
 	headRepo        func() (glrepo.Interface, error) `json:"-"`
 	apiClient       func(repoHost string) (*api.Client, error)
-	defaultHostname string
+	defaultHostname func() string
 
 	// SourceProject is the Project we create the merge request in and where we push our branch
 	// it is the project we have permission to push so most likely one's fork
@@ -90,7 +96,7 @@ func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
 		baseRepo:        f.BaseRepo,
 		headRepo:        ResolvedHeadRepo(f),
 		apiClient:       f.ApiClient,
-		defaultHostname: f.DefaultHostname(),
+		defaultHostname: f.DefaultHostname,
 	}
 
 	mrCreateCmd := &cobra.Command{
@@ -104,6 +110,11 @@ func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
 			$ glab mr create -f --draft --label RFC
 			$ glab mr create --fill --web
 			$ glab mr create --fill --fill-commit-body --yes
+			$ glab mr create --autofill --yes
+			$ glab mr create --fill-commits --yes
+			$ glab mr create --fill --max-diff-lines 400 --yes
+			$ glab mr create --fill --template bugfix --yes
+			$ glab mr create --fill --closes 12,34 --yes
 		`),
 		Args: cobra.ExactArgs(0),
 		PreRun: func(cmd *cobra.Command, args []string) {
@@ -119,6 +130,9 @@ func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
 			mcpannotations.Destructive: "true",
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmdutils.ApplyBodyFile(cmd, opts.io, "description"); err != nil {
+				return err
+			}
 			opts.complete(cmd)
 
 			if err := opts.validate(cmd); err != nil {
@@ -137,13 +151,16 @@ func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
 			return nil
 		},
 	}
-	mrCreateCmd.Flags().BoolVarP(&opts.Autofill, "fill", "f", false, "Do not prompt for title or description, and just use commit info. Sets `push` to `true`, and pushes the branch.")
+	mrCreateCmd.Flags().BoolVarP(&opts.Fill, "fill", "f", false, "Do not prompt for title or description, and just use commit info. Sets `push` to `true`, and pushes the branch.")
 	mrCreateCmd.Flags().BoolVarP(&opts.FillCommitBody, "fill-commit-body", "", false, "Fill description with each commit body when multiple commits. Can only be used with --fill.")
+	mrCreateCmd.Flags().BoolVar(&opts.Autofill, "autofill", false, "Like --fill, but group the commits in the description by Conventional Commits type, and warn about commits that don't follow the conventions of the project.")
+	mrCreateCmd.Flags().BoolVar(&opts.FillCommits, "fill-commits", false, "Like --autofill, but use the subject of the first Conventional Commit as the title, and add the labels mapped from the commit types by the mr_label_rules setting.")
 	mrCreateCmd.Flags().BoolVarP(&opts.IsDraft, "draft", "", false, "Mark merge request as a draft.")
 	mrCreateCmd.Flags().BoolVarP(&opts.IsWIP, "wip", "", false, "Mark merge request as a draft. Alternative to --draft.")
 	mrCreateCmd.Flags().BoolVarP(&opts.ShouldPush, "push", "", false, "Push committed changes after creating merge request. Make sure you have committed changes.")
 	mrCreateCmd.Flags().StringVarP(&opts.Title, "title", "t", "", "Supply a title for the merge request.")
 	mrCreateCmd.Flags().StringVarP(&opts.Description, "description", "d", "", "Supply a description for the merge request.")
+	cmdutils.AddBodyFileFlag(mrCreateCmd, "description")
 	mrCreateCmd.Flags().StringSliceVarP(&opts.Labels, "label", "l", []string{}, "Add label by name. Multiple labels can be comma-separated or specified by repeating the flag.")
 	mrCreateCmd.Flags().StringSliceVarP(&opts.Assignees, "assignee", "a", []string{}, "Assign merge request to people by their `usernames`. Multiple usernames can be comma-separated or specified by repeating the flag.")
 	mrCreateCmd.Flags().StringSliceVarP(&opts.Reviewers, "reviewer", "", []string{}, "Request review from users by their `usernames`. Multiple usernames can be comma-separated or specified by repeating the flag.")
@@ -162,38 +179,51 @@ func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
 	mrCreateCmd.Flags().StringVarP(&opts.RelatedIssue, "related-issue", "i", "", "Create a merge request for an issue. If --title is not provided, uses the issue title.")
 	mrCreateCmd.Flags().BoolVar(&opts.recover, "recover", false, "Save the options to a file if the merge request creation fails. If the file exists, the options are loaded from the recovery file. (EXPERIMENTAL)")
 	mrCreateCmd.Flags().BoolVar(&opts.signoff, "signoff", false, "Append a DCO signoff to the merge request description.")
+	mrCreateCmd.Flags().StringSliceVar(&opts.closes, "closes", []string{}, "Add a 'Closes #<id>' line to the description for each of these issue IDs. Multiple IDs can be comma-separated or specified by repeating the flag.")
+	mrCreateCmd.Flags().IntVar(&opts.maxDiffLines, "max-diff-lines", 0, "Fail when the merge request adds and removes more lines than this. Use 0 for no limit.")
+	mrCreateCmd.Flags().StringVar(&opts.template, "template", "", "Use a description template from .gitlab/merge_request_templates/ by name. Placeholders like {{.SourceBranch}}, {{.TargetBranch}}, {{.Commits}}, and {{.IssueRefs}} are filled in from the local git history.")
 
 	mrCreateCmd.Flags().StringVarP(&opts.MRCreateTargetProject, "target-project", "", "", "Add target project by id, OWNER/REPO, or GROUP/NAMESPACE/REPO.")
 	_ = mrCreateCmd.Flags().MarkHidden("target-project")
 	_ = mrCreateCmd.Flags().MarkDeprecated("target-project", "Use --repo instead.")
 
+	// --fill-commits implies --autofill, which implies --fill.
+	mrCreateCmd.MarkFlagsMutuallyExclusive("fill", "autofill", "fill-commits")
+
 	return mrCreateCmd
 }
 
 func (o *options) complete(cmd *cobra.Command) {
 	hasTitle := cmd.Flags().Changed("title")
-	hasDescription := cmd.Flags().Changed("description")
+	hasDescription := cmd.Flags().Changed("description") || o.template != ""
 
 	// disable interactive mode if title and description are explicitly defined
 	o.isInteractive = !(hasTitle && hasDescription)
+
+	if o.FillCommits {
+		o.Autofill = true
+	}
+	if o.Autofill {
+		o.Fill = true
+	}
 }
 
 func (o *options) validate(cmd *cobra.Command) error {
 	hasTitle := cmd.Flags().Changed("title")
 	hasDescription := cmd.Flags().Changed("description")
 
-	if hasTitle && hasDescription && o.Autofill {
+	if hasTitle && hasDescription && o.Fill {
 		return &cmdutils.FlagError{
 			Err: errors.New("usage of --title and --description overrides --fill."),
 		}
 	}
-	if o.isInteractive && !o.io.PromptEnabled() && !o.Autofill {
+	if o.isInteractive && !o.io.PromptEnabled() && !o.Fill {
 		return &cmdutils.FlagError{Err: errors.New("--title or --fill required for non-interactive mode.")}
 	}
 	if cmd.Flags().Changed("wip") && cmd.Flags().Changed("draft") {
 		return &cmdutils.FlagError{Err: errors.New("specify --draft.")}
 	}
-	if !o.Autofill && o.FillCommitBody {
+	if !o.Fill && o.FillCommitBody {
 		return &cmdutils.FlagError{Err: errors.New("--fill-commit-body should be used with --fill.")}
 	}
 	// Remove this once --yes does more than just skip the prompts that --web happen to skip
@@ -202,15 +232,25 @@ func (o *options) validate(cmd *cobra.Command) error {
 		return &cmdutils.FlagError{Err: errors.New("--web already skips all prompts currently skipped by --yes.")}
 	}
 
+	if o.template != "" && hasDescription {
+		return &cmdutils.FlagError{Err: errors.New("--template can't be used with --description.")}
+	}
+
 	if o.CopyIssueLabels && o.RelatedIssue == "" {
 		return &cmdutils.FlagError{Err: errors.New("--copy-issue-labels can only be used with --related-issue.")}
 	}
 
+	closes, err := mrutils.ParseIssueRefs(o.closes)
+	if err != nil {
+		return &cmdutils.FlagError{Err: fmt.Errorf("--closes: %w", err)}
+	}
+	o.closes = closes
+
 	return nil
 }
 
 func parseIssue(apiClientFunc func(repoHost string) (*api.Client, error), gitlabClient *gitlab.Client, opts *options) (*gitlab.Issue, error) {
-	issue, _, err := issueutils.IssueFromArg(apiClientFunc, gitlabClient, opts.baseRepo, opts.defaultHostname, opts.RelatedIssue)
+	issue, _, err := issueutils.IssueFromArg(apiClientFunc, gitlabClient, opts.baseRepo, opts.defaultHostname(), opts.RelatedIssue)
 	if err != nil {
 		return nil, err
 	}
@@ -329,6 +369,13 @@ func (o *options) run() error {
 		o.TargetBranch = getTargetBranch(baseRepoRemote)
 	}
 
+	if o.template != "" {
+		o.Description, err = o.loadTemplate(o.template, fmt.Sprintf("%s/%s", baseRepoRemote.Name, o.TargetBranch))
+		if err != nil {
+			return err
+		}
+	}
+
 	if o.RelatedIssue != "" {
 		issue, err := parseIssue(o.apiClient, client, o)
 		if err != nil {
@@ -373,7 +420,11 @@ func (o *options) run() error {
 			return cmdutils.SilentError
 		}
 
-		if o.Autofill {
+		if err := o.checkDiffSize(); err != nil {
+			return err
+		}
+
+		if o.Fill {
 			if err = mrBodyAndTitle(o); err != nil {
 				return err
 			}
@@ -400,22 +451,29 @@ func (o *options) run() error {
 					}
 
 					const mrWithCommitsTemplate = "Open a merge request with commit messages."
+					const mrWithGroupedCommitsTemplate = "Open a merge request with commit messages grouped by type."
 					const mrEmptyTemplate = "Open a blank merge request."
 
 					templateNames = append(templateNames, mrWithCommitsTemplate)
+					templateNames = append(templateNames, mrWithGroupedCommitsTemplate)
 					templateNames = append(templateNames, mrEmptyTemplate)
 
 					if err := o.io.Select(context.Background(), &templateName, "Choose a template:", templateNames); err != nil {
 						return fmt.Errorf("could not prompt: %w", err)
 					}
 					switch templateName {
-					case mrWithCommitsTemplate:
+					case mrWithCommitsTemplate, mrWithGroupedCommitsTemplate:
 						// templateContents should be filled from commit messages
 						commits, err := git.Commits(o.TargetTrackingBranch, o.SourceBranch)
 						if err != nil {
 							return fmt.Errorf("failed to get commits: %w", err)
 						}
-						templateContents, err = mrutils.GenerateMRCommitListBody(commits, true)
+						if templateName == mrWithGroupedCommitsTemplate {
+							o.warnUnconventionalCommits(commits)
+							templateContents, err = mrutils.GenerateGroupedMRBody(commits, true)
+						} else {
+							templateContents, err = mrutils.GenerateMRCommitListBody(commits, true)
+						}
 						if err != nil {
 							return err
 						}
@@ -430,9 +488,9 @@ func (o *options) run() error {
 							templateContents += "Signed-off-by: " + u.Name + "<" + u.Email + ">"
 						}
 					default:
-						templateContents, err = cmdutils.LoadGitLabTemplate(cmdutils.MergeRequestTemplate, templateName)
+						templateContents, err = o.loadTemplate(templateName, o.TargetTrackingBranch)
 						if err != nil {
-							return fmt.Errorf("failed to get template contents: %w", err)
+							return err
 						}
 					}
 				}
@@ -491,8 +549,13 @@ func (o *options) run() error {
 					return err
 				}
 			}
+
+			if err := o.offerClosingIssues(); err != nil {
+				return err
+			}
 		}
 	}
+	o.Description = mrutils.AppendClosingRefs(o.Description, o.closes)
 
 	if o.Title == "" {
 		return fmt.Errorf("title can't be blank.")
@@ -679,6 +742,12 @@ func mrBodyAndTitle(opts *options) error {
 	if err != nil {
 		return err
 	}
+	if opts.Autofill {
+		opts.warnUnconventionalCommits(commits)
+	}
+	if opts.FillCommits {
+		return opts.fillFromCommits(commits)
+	}
 	if len(commits) == 1 {
 		if opts.Title == "" {
 			opts.Title = commits[0].Title
@@ -696,7 +765,11 @@ func mrBodyAndTitle(opts *options) error {
 		}
 
 		if opts.Description == "" {
-			description, err := mrutils.GenerateMRCommitListBody(commits, opts.FillCommitBody)
+			generate := mrutils.GenerateMRCommitListBody
+			if opts.Autofill {
+				generate = mrutils.GenerateGroupedMRBody
+			}
+			description, err := generate(commits, opts.FillCommitBody)
 			if err != nil {
 				return err
 			}
@@ -706,6 +779,178 @@ func mrBodyAndTitle(opts *options) error {
 	return nil
 }
 
+// fillFromCommits fills the title from the first Conventional Commit, the description
+// with the commits grouped by type, and adds the labels mapped from the commit types.
+func (o *options) fillFromCommits(commits []*git.Commit) error {
+	if o.Title == "" {
+		o.Title = mrutils.FirstConventionalSubject(commits)
+	}
+	if o.Title == "" {
+		o.Title = utils.Humanize(o.SourceBranch)
+	}
+	if o.Description == "" {
+		description, err := mrutils.GenerateGroupedMRBody(commits, o.FillCommitBody)
+		if err != nil {
+			return err
+		}
+		o.Description = description
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+	setting, _ := o.config().Get(repo.RepoHost(), "mr_label_rules")
+	rules, err := mrutils.ParseLabelRules(setting)
+	if err != nil {
+		return fmt.Errorf("could not parse the mr_label_rules setting: %w", err)
+	}
+	for _, label := range rules.Labels(commits) {
+		if !slices.Contains(o.Labels, label) {
+			o.Labels = append(o.Labels, label)
+		}
+	}
+	return nil
+}
+
+// loadTemplate returns a description template of the project, with its
+// placeholders filled in from the commits between the tracking branch of the
+// target branch and the source branch.
+func (o *options) loadTemplate(name, targetTrackingBranch string) (string, error) {
+	content, err := cmdutils.LoadGitLabTemplate(cmdutils.MergeRequestTemplate, name)
+	if err != nil {
+		return "", fmt.Errorf("failed to get template contents: %w", err)
+	}
+	if content == "" {
+		return "", fmt.Errorf("template %q not found in .gitlab/%s/.", name, cmdutils.MergeRequestTemplate)
+	}
+	if !strings.Contains(content, "{{") {
+		return content, nil
+	}
+
+	data := mrutils.DescriptionTemplateData{
+		SourceBranch: o.SourceBranch,
+		TargetBranch: o.TargetBranch,
+	}
+	if o.SourceBranch != "" {
+		// Without commits, for example when the source branch isn't pushed yet,
+		// the placeholders are left empty.
+		commits, messages := commitMessages(targetTrackingBranch, o.SourceBranch)
+		data.Commits = commits
+		data.IssueRefs = mrutils.IssueRefs(o.SourceBranch, messages)
+	}
+
+	rendered, err := mrutils.RenderDescriptionTemplate(content, data)
+	if err != nil {
+		fmt.Fprintf(o.io.StdErr, "%s Could not fill in the placeholders of template %q: %v\n", o.io.Color().WarnIcon(), name, err)
+		return content, nil
+	}
+	return rendered, nil
+}
+
+// commitMessages returns the commits between two refs, oldest first, and the
+// titles and bodies of their messages.
+func commitMessages(baseRef, headRef string) ([]*git.Commit, []string) {
+	commits, _ := git.Commits(baseRef, headRef)
+	slices.Reverse(commits)
+	messages := make([]string, 0, 2*len(commits))
+	for _, commit := range commits {
+		body, _ := git.CommitBody(commit.Sha)
+		messages = append(messages, commit.Title, body)
+	}
+	return commits, messages
+}
+
+// offerClosingIssues asks whether to close the issues referenced by the name of
+// the source branch and by the commit messages, when the description doesn't
+// close them yet.
+func (o *options) offerClosingIssues() error {
+	if !o.io.PromptEnabled() || o.yes {
+		return nil
+	}
+	_, messages := commitMessages(o.TargetTrackingBranch, o.SourceBranch)
+	refs := mrutils.UnclosedIssueRefs(o.Description, mrutils.IssueRefs(o.SourceBranch, messages))
+	refs = slices.DeleteFunc(refs, func(ref string) bool { return slices.Contains(o.closes, ref) })
+	if len(refs) == 0 {
+		return nil
+	}
+
+	var add bool
+	err := o.io.Confirm(context.Background(), &add, fmt.Sprintf("Close the referenced issues %s when the merge request is merged?", strings.Join(refs, ", ")))
+	if err != nil {
+		return fmt.Errorf("could not prompt: %w", err)
+	}
+	if add {
+		o.closes = append(o.closes, refs...)
+	}
+	return nil
+}
+
+// checkDiffSize warns when the changes of the source branch exceed the limits of
+// the project, as configured for 'glab mr lint', and fails when they exceed
+// --max-diff-lines.
+func (o *options) checkDiffSize() error {
+	c := o.io.Color()
+	stat, err := git.DiffStats(o.TargetTrackingBranch, o.SourceBranch)
+	if err != nil {
+		if o.maxDiffLines > 0 {
+			return err
+		}
+		// The source branch might only exist on the remote.
+		return nil
+	}
+	const stackHint = "Consider splitting it into a stack of smaller merge requests with 'glab stack'."
+
+	if o.maxDiffLines > 0 && stat.Lines() > o.maxDiffLines {
+		fmt.Fprintf(o.io.StdErr, "%s The merge request changes %d lines in %s, more than --max-diff-lines %d.\n",
+			c.FailedIcon(), stat.Lines(), utils.Pluralize(stat.Files, "file"), o.maxDiffLines)
+		fmt.Fprintln(o.io.StdErr, stackHint)
+		return cmdutils.SilentError
+	}
+
+	rules, err := mrutils.LoadLintRules("")
+	if err != nil {
+		fmt.Fprintf(o.io.StdErr, "%s Could not check the size of the merge request: %v\n", c.WarnIcon(), err)
+		return nil
+	}
+	var problems []string
+	if rules.MaxChangedFiles > 0 && stat.Files > rules.MaxChangedFiles {
+		problems = append(problems, fmt.Sprintf("%d files changed. The maximum is %d.", stat.Files, rules.MaxChangedFiles))
+	}
+	if rules.MaxDiffLines > 0 && stat.Lines() > rules.MaxDiffLines {
+		problems = append(problems, fmt.Sprintf("%d lines changed (+%d, -%d). The maximum is %d.", stat.Lines(), stat.Additions, stat.Deletions, rules.MaxDiffLines))
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	fmt.Fprintf(o.io.StdErr, "%s The merge request is larger than the project allows:\n", c.WarnIcon())
+	for _, problem := range problems {
+		fmt.Fprintf(o.io.StdErr, "  - %s\n", problem)
+	}
+	fmt.Fprintln(o.io.StdErr, stackHint)
+	return nil
+}
+
+// warnUnconventionalCommits lists the commits that don't follow the Conventional Commits
+// rules of the project, as configured for 'glab mr lint'.
+func (o *options) warnUnconventionalCommits(commits []*git.Commit) {
+	c := o.io.Color()
+	rules, err := mrutils.LoadLintRules("")
+	if err != nil {
+		fmt.Fprintf(o.io.StdErr, "%s Could not check the commit messages: %v\n", c.WarnIcon(), err)
+		return
+	}
+
+	unconventional := mrutils.UnconventionalCommits(commits, rules)
+	if len(unconventional) == 0 {
+		return
+	}
+	fmt.Fprintf(o.io.StdErr, "%s Some commits don't follow Conventional Commits, such as \"feat(scope): description\":\n", c.WarnIcon())
+	for _, commit := range unconventional {
+		fmt.Fprintf(o.io.StdErr, "  - %s\n", commit.Title)
+	}
+}
+
 func handlePush(opts *options, remote *glrepo.Remote) error {
 	if opts.ShouldPush {
 		sourceRemote := remote
@@ -824,7 +1069,7 @@ func ResolvedHeadRepo(f cmdutils.Factory) func() (glrepo.Interface, error) {
 
 func headRepoOverride(opts *options, repo string) {
 	opts.headRepo = func() (glrepo.Interface, error) {
-		return glrepo.FromFullName(repo, opts.defaultHostname)
+		return glrepo.FromFullName(repo, opts.defaultHostname())
 	}
 }
 
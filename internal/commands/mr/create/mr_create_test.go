@@ -5,6 +5,8 @@ package create
 import (
 	"errors"
 	"net/url"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -86,6 +88,8 @@ func TestNewCmdCreate_tty(t *testing.T) {
 	cs, csTeardown := test.InitCmdStubber()
 	defer csTeardown()
 	cs.Stub("HEAD branch: master\n")
+	cs.Stub("3\t1\tREADME.md\n") // git diff --numstat
+	cs.Stub(t.TempDir())         // git rev-parse --show-toplevel
 	cs.Stub(heredoc.Doc(`
 		deadbeef HEAD
 		deadb00f refs/remotes/upstream/feat-new-mr
@@ -148,6 +152,74 @@ func TestNewCmdCreate_tty(t *testing.T) {
 	assert.Contains(t, output.String(), "https://gitlab.com/OWNER/REPO/-/merge_requests/12")
 }
 
+func TestNewCmdCreate_Closes(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockProjects.EXPECT().
+		GetProject("OWNER/REPO", gomock.Any()).
+		Return(&gitlab.Project{
+			ID:                   1,
+			DefaultBranch:        "master",
+			MergeRequestsEnabled: true,
+			PathWithNamespace:    "OWNER/REPO",
+		}, nil, nil)
+	testClient.MockMergeRequests.EXPECT().
+		CreateMergeRequest("OWNER/REPO", gomock.Any()).
+		DoAndReturn(func(_ any, opts *gitlab.CreateMergeRequestOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error) {
+			assert.Equal(t, "Fixes #12\n\nCloses #34", *opts.Description)
+			return &gitlab.MergeRequest{
+				BasicMergeRequest: gitlab.BasicMergeRequest{
+					IID:          12,
+					Title:        *opts.Title,
+					State:        "opened",
+					SourceBranch: "feat-new-mr",
+					WebURL:       "https://gitlab.com/OWNER/REPO/-/merge_requests/12",
+				},
+			}, nil, nil
+		})
+
+	cs, csTeardown := test.InitCmdStubber()
+	defer csTeardown()
+	cs.Stub("HEAD branch: master\n")
+	cs.Stub("3\t1\tREADME.md\n") // git diff --numstat
+	cs.Stub(t.TempDir())         // git rev-parse --show-toplevel
+	cs.Stub(heredoc.Doc(`
+		deadbeef HEAD
+		deadb00f refs/remotes/upstream/feat-new-mr
+		deadbeef refs/remotes/origin/feat-new-mr
+	`))
+
+	pu, _ := url.Parse("https://gitlab.com/OWNER/REPO.git")
+	exec := cmdtest.SetupCmdForTest(t, NewCmdCreate, true,
+		cmdtest.WithGitLabClient(testClient.Client),
+		func(f *cmdtest.Factory) {
+			f.RemotesStub = func() (glrepo.Remotes, error) {
+				return glrepo.Remotes{
+					{
+						Remote: &git.Remote{Name: "upstream", Resolved: "head", PushURL: pu},
+						Repo:   glrepo.New("OWNER", "REPO", glinstance.DefaultHostname),
+					},
+					{
+						Remote: &git.Remote{Name: "origin", Resolved: "base", PushURL: pu},
+						Repo:   glrepo.New("monalisa", "REPO", glinstance.DefaultHostname),
+					},
+				}, nil
+			}
+			f.BranchStub = func() (string, error) {
+				return "feat-new-mr", nil
+			}
+		},
+	)
+
+	output, err := exec(`-t "Fix the login" -d "Fixes #12" --closes 12,#34`)
+	require.NoError(t, err)
+	assert.Contains(t, output.String(), "!12 Fix the login (feat-new-mr)")
+
+	_, err = exec(`-t "Fix the login" -d "Fixes #12" --closes twelve`)
+	assert.EqualError(t, err, `--closes: invalid issue ID "twelve".`)
+}
+
 func TestNewCmdCreate_RelatedIssue(t *testing.T) {
 	// NOTE: we need to force disable colors, otherwise we'd need ANSI sequences in our test output assertions.
 	t.Setenv("NO_COLOR", "true")
@@ -304,6 +376,8 @@ func TestNewCmdCreate_TemplateFromCommitMessages(t *testing.T) {
 	defer csTeardown()
 
 	cs.Stub("HEAD branch: main\n") // git remote show <name>
+	cs.Stub("3\t1\tREADME.md\n")   // git diff --numstat
+	cs.Stub(t.TempDir())           // git rev-parse --show-toplevel
 	cs.Stub("/")                   // git rev-parse --show-toplevel
 
 	// git -c log.ShowSignature=false log --pretty=format:%H,%s --cherry upstream/main...feat-new-mr
@@ -537,6 +611,41 @@ func TestMRCreate_nontty_insufficient_flags(t *testing.T) {
 	assert.Equal(t, "--title or --fill required for non-interactive mode.", err.Error())
 }
 
+func TestMRCreate_FillFlagsMutuallyExclusive(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		cli     string
+		wantErr string
+	}{
+		{
+			cli:     "--fill --autofill",
+			wantErr: "if any flags in the group [fill autofill fill-commits] are set none of the others can be; [autofill fill] were all set",
+		},
+		{
+			cli:     "--autofill --fill-commits",
+			wantErr: "if any flags in the group [fill autofill fill-commits] are set none of the others can be; [autofill fill-commits] were all set",
+		},
+		{
+			cli:     "--fill --autofill --fill-commits",
+			wantErr: "if any flags in the group [fill autofill fill-commits] are set none of the others can be; [autofill fill fill-commits] were all set",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.cli, func(t *testing.T) {
+			t.Parallel()
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdCreate, false,
+				cmdtest.WithGitLabClient(gitlabtesting.NewTestClient(t).Client),
+			)
+
+			_, err := exec(tc.cli)
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
+
 func TestMrBodyAndTitle(t *testing.T) {
 	opts := &options{
 		SourceBranch:         "mr-autofill-test-br",
@@ -629,6 +738,138 @@ resolves #1
 	})
 }
 
+func TestMrBodyAndTitle_Autofill(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".glab.yml"), []byte("mr_lint:\n  conventional_title: true\n  title_types: [feat, fix]\n"), 0o644))
+	origToplevelDir := git.ToplevelDir
+	git.ToplevelDir = func() (string, error) { return dir, nil }
+	t.Cleanup(func() { git.ToplevelDir = origToplevelDir })
+
+	cs, csTeardown := test.InitCmdStubber()
+	defer csTeardown()
+	cs.Stub("d1sd2e,Update README\nd2asa3,fix(api): handle nil\nd3asa4,feat: add lint\nd4asa5,chore: tidy")
+
+	ios, _, _, stderr := cmdtest.TestIOStreams()
+	opts := &options{
+		SourceBranch:         "add-lint",
+		TargetBranch:         "master",
+		TargetTrackingBranch: "origin/master",
+		Autofill:             true,
+		io:                   ios,
+	}
+	require.NoError(t, mrBodyAndTitle(opts))
+
+	assert.Equal(t, "add lint", opts.Title)
+	assert.Equal(t, "### Features\n\n- add lint\n\n### Bug fixes\n\n- **api:** handle nil\n\n### Chores\n\n- tidy\n\n### Other changes\n\n- Update README\n", opts.Description)
+	assert.Equal(t, "! Some commits don't follow Conventional Commits, such as \"feat(scope): description\":\n  - Update README\n  - chore: tidy\n", stderr.String())
+}
+
+func TestMrBodyAndTitle_FillCommits(t *testing.T) {
+	dir := t.TempDir()
+	origToplevelDir := git.ToplevelDir
+	git.ToplevelDir = func() (string, error) { return dir, nil }
+	t.Cleanup(func() { git.ToplevelDir = origToplevelDir })
+
+	cs, csTeardown := test.InitCmdStubber()
+	defer csTeardown()
+	cs.Stub("d1sd2e,docs: update README\nd2asa3,fix(api): handle nil\nd3asa4,feat: add lint\nd4asa5,Tidy up")
+
+	ios, _, _, stderr := cmdtest.TestIOStreams()
+	opts := &options{
+		SourceBranch:         "add-lint",
+		TargetBranch:         "master",
+		TargetTrackingBranch: "origin/master",
+		Labels:               []string{"bug"},
+		Autofill:             true,
+		FillCommits:          true,
+		io:                   ios,
+		baseRepo: func() (glrepo.Interface, error) {
+			return glrepo.New("OWNER", "REPO", glinstance.DefaultHostname), nil
+		},
+		config: func() config.Config {
+			return config.NewFromString("mr_label_rules: feat=feature,fix=bug,fix=type::fix")
+		},
+	}
+	require.NoError(t, mrBodyAndTitle(opts))
+
+	assert.Equal(t, "feat: add lint", opts.Title)
+	assert.Equal(t, "### Features\n\n- add lint\n\n### Bug fixes\n\n- **api:** handle nil\n\n### Documentation\n\n- update README\n\n### Other changes\n\n- Tidy up\n", opts.Description)
+	assert.Equal(t, []string{"bug", "feature", "type::fix"}, opts.Labels)
+	assert.Equal(t, "! Some commits don't follow Conventional Commits, such as \"feat(scope): description\":\n  - Tidy up\n", stderr.String())
+
+	t.Run("invalid rules", func(t *testing.T) {
+		cs.Stub("d1sd2e,feat: add lint")
+
+		opts.config = func() config.Config {
+			return config.NewFromString("mr_label_rules: feature")
+		}
+		err := mrBodyAndTitle(opts)
+		assert.EqualError(t, err, `could not parse the mr_label_rules setting: invalid rule "feature": expected type=label`)
+	})
+}
+
+func TestCheckDiffSize(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".glab.yml"), []byte("mr_lint:\n  max_diff_lines: 100\n  max_changed_files: 2\n"), 0o644))
+	numstat := "80\t20\tREADME.md\n5\t0\tmain.go\n-\t-\tlogo.png\n"
+
+	t.Run("within limits", func(t *testing.T) {
+		cs, csTeardown := test.InitCmdStubber()
+		defer csTeardown()
+		cs.Stub("10\t2\tREADME.md\n") // git diff --numstat
+		cs.Stub(dir)                  // git rev-parse --show-toplevel
+
+		ios, _, _, stderr := cmdtest.TestIOStreams()
+		opts := &options{SourceBranch: "feat", TargetTrackingBranch: "origin/main", io: ios}
+		require.NoError(t, opts.checkDiffSize())
+		assert.Equal(t, "diff --numstat origin/main...feat", strings.Join(cs.Calls[0].Args[1:], " "))
+		assert.Empty(t, stderr.String())
+	})
+	t.Run("over the project limits", func(t *testing.T) {
+		cs, csTeardown := test.InitCmdStubber()
+		defer csTeardown()
+		cs.Stub(numstat)
+		cs.Stub(dir)
+
+		ios, _, _, stderr := cmdtest.TestIOStreams()
+		opts := &options{SourceBranch: "feat", TargetTrackingBranch: "origin/main", io: ios}
+		require.NoError(t, opts.checkDiffSize())
+		assert.Equal(t, heredoc.Doc(`
+			! The merge request is larger than the project allows:
+			  - 3 files changed. The maximum is 2.
+			  - 105 lines changed (+85, -20). The maximum is 100.
+			Consider splitting it into a stack of smaller merge requests with 'glab stack'.
+		`), stderr.String())
+	})
+	t.Run("over --max-diff-lines", func(t *testing.T) {
+		cs, csTeardown := test.InitCmdStubber()
+		defer csTeardown()
+		cs.Stub(numstat)
+
+		ios, _, _, stderr := cmdtest.TestIOStreams()
+		opts := &options{SourceBranch: "feat", TargetTrackingBranch: "origin/main", maxDiffLines: 50, io: ios}
+		require.ErrorIs(t, opts.checkDiffSize(), cmdutils.SilentError)
+		assert.Equal(t, heredoc.Doc(`
+			x The merge request changes 105 lines in 3 files, more than --max-diff-lines 50.
+			Consider splitting it into a stack of smaller merge requests with 'glab stack'.
+		`), stderr.String())
+	})
+	t.Run("unknown source branch", func(t *testing.T) {
+		cs, csTeardown := test.InitCmdStubber()
+		defer csTeardown()
+		cs.StubError("fatal: ambiguous argument 'origin/main...feat': unknown revision")
+
+		ios, _, _, stderr := cmdtest.TestIOStreams()
+		opts := &options{SourceBranch: "feat", TargetTrackingBranch: "origin/main", io: ios}
+		require.NoError(t, opts.checkDiffSize())
+		assert.Empty(t, stderr.String())
+
+		cs.StubError("fatal: ambiguous argument 'origin/main...feat': unknown revision")
+		opts.maxDiffLines = 50
+		require.ErrorContains(t, opts.checkDiffSize(), "could not get the changes between origin/main and feat")
+	})
+}
+
 func TestGenerateMRCompareURL(t *testing.T) {
 	opts := &options{
 		Labels:        []string{"backend", "frontend"},
@@ -860,3 +1101,71 @@ func TestMRCreate_RemotesError_PropagatesError(t *testing.T) {
 	assert.Contains(t, err.Error(), "not a git repository", "error should mention git repository")
 	assert.NotContains(t, output.String(), "!12", "should not have created a merge request")
 }
+
+func TestNewCmdCreate_Template(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, ".gitlab", "merge_request_templates"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".gitlab", "merge_request_templates", "bugfix.md"), []byte(heredoc.Doc(`
+		Merges {{.SourceBranch}} into {{.TargetBranch}}.
+
+		## Changes
+
+		{{.Commits}}
+
+		Closes {{.IssueRefs}}
+	`)), 0o644))
+
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockProjects.EXPECT().
+		GetProject("OWNER/REPO", gomock.Any()).
+		Return(&gitlab.Project{ID: 1, DefaultBranch: "main", PathWithNamespace: "OWNER/REPO", MergeRequestsEnabled: true}, nil, nil)
+	testClient.MockMergeRequests.EXPECT().
+		CreateMergeRequest("OWNER/REPO", gomock.Any()).
+		DoAndReturn(func(pid any, opts *gitlab.CreateMergeRequestOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error) {
+			assert.Equal(t, heredoc.Doc(`
+				Merges 12-fix-login into main.
+
+				## Changes
+
+				- Fix the login form
+				- Fix the logout button
+
+				Closes #12, #34`), *opts.Description)
+			return &gitlab.MergeRequest{BasicMergeRequest: gitlab.BasicMergeRequest{IID: 7, WebURL: "https://gitlab.com/OWNER/REPO/-/merge_requests/7"}}, nil, nil
+		})
+
+	cs, csTeardown := test.InitCmdStubber()
+	defer csTeardown()
+	cs.Stub(dir) // git rev-parse --show-toplevel
+	cs.Stub(heredoc.Doc(`
+		deadb00f,Fix the logout button
+		deadbeef,Fix the login form
+	`))
+	cs.Stub("")                  // git show deadbeef
+	cs.Stub("Fixes #34\n")       // git show deadb00f
+	cs.Stub("3\t1\tREADME.md\n") // git diff --numstat
+	cs.Stub(dir)                 // git rev-parse --show-toplevel
+
+	pu, _ := url.Parse("https://gitlab.com/OWNER/REPO.git")
+	exec := cmdtest.SetupCmdForTest(t, NewCmdCreate, false,
+		cmdtest.WithGitLabClient(testClient.Client),
+		func(f *cmdtest.Factory) {
+			f.RemotesStub = func() (glrepo.Remotes, error) {
+				return glrepo.Remotes{
+					{
+						Remote: &git.Remote{Name: "origin", Resolved: "head", PushURL: pu},
+						Repo:   glrepo.New("OWNER", "REPO", glinstance.DefaultHostname),
+					},
+				}, nil
+			}
+		},
+	)
+
+	_, err := exec("--title 'Fix login' --template bugfix --source-branch 12-fix-login --target-branch main --yes")
+	require.NoError(t, err)
+	assert.Equal(t, "git -c log.ShowSignature=false log --pretty=format:%H,%s --cherry origin/main...12-fix-login", strings.Join(cs.Calls[1].Args, " "))
+
+	exec = cmdtest.SetupCmdForTest(t, NewCmdCreate, false, cmdtest.WithGitLabClient(testClient.Client))
+	_, err = exec("--title 'Fix login' --template bugfix --description done")
+	require.EqualError(t, err, "--template can't be used with --description.")
+}
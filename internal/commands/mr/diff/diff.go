@@ -2,11 +2,11 @@
 package diff
 
 import (
-	"bufio"
 	"bytes"
 	"errors"
 	"fmt"
 	"io"
+	"path"
 	"strings"
 	"syscall"
 
@@ -28,6 +28,10 @@ type options struct {
 	args     []string
 	useColor string
 	rawDiff  bool
+	files    []string
+	stat     bool
+	wordDiff bool
+	syntax   bool
 }
 
 func NewCmdDiff(f cmdutils.Factory, runF func(*options) error) *cobra.Command {
@@ -47,6 +51,18 @@ func NewCmdDiff(f cmdutils.Factory, runF func(*options) error) *cobra.Command {
 			$ glab mr diff
 
 			$ glab mr diff 123 --color=never
+
+			# Show the changes of Go files only
+			$ glab mr diff 123 --file '*.go'
+
+			# Show a summary of the changed files
+			$ glab mr diff 123 --stat
+
+			# Show changed words instead of changed lines
+			$ glab mr diff 123 --word-diff
+
+			# Highlight the syntax of the changed code
+			$ glab mr diff 123 --syntax-highlight
 		`),
 		Args: cobra.MaximumNArgs(1),
 		Annotations: map[string]string{
@@ -68,6 +84,11 @@ func NewCmdDiff(f cmdutils.Factory, runF func(*options) error) *cobra.Command {
 
 	cmd.Flags().StringVar(&opts.useColor, "color", "auto", "Use color in diff output: always, never, auto.")
 	cmd.Flags().BoolVar(&opts.rawDiff, "raw", false, "Use raw diff format that can be piped to commands")
+	cmd.Flags().StringArrayVar(&opts.files, "file", nil, "Show only the changes of files matching the glob. A glob without a slash matches file names in any directory. Can be repeated.")
+	cmd.Flags().BoolVar(&opts.stat, "stat", false, "Show a summary of the changed files instead of the diff.")
+	cmd.Flags().BoolVar(&opts.wordDiff, "word-diff", false, "Show changed words instead of changed lines.")
+	cmd.Flags().BoolVar(&opts.syntax, "syntax-highlight", false, "Highlight the syntax of the changed code, when the output is colored.")
+	cmd.MarkFlagsMutuallyExclusive("stat", "word-diff")
 
 	return cmd
 }
@@ -87,6 +108,12 @@ func (o *options) validate(cmd *cobra.Command) error {
 		return &cmdutils.FlagError{Err: errors.New("argument required when using the --repo flag.")}
 	}
 
+	for _, glob := range o.files {
+		if _, err := path.Match(glob, ""); err != nil {
+			return &cmdutils.FlagError{Err: fmt.Errorf("invalid --file glob %q: %w.", glob, err)}
+		}
+	}
+
 	if !validColorFlag(o.useColor) {
 		return &cmdutils.FlagError{Err: fmt.Errorf("did not understand color: %q. Expected one of 'always', 'never', or 'auto'.", o.useColor)}
 	}
@@ -104,15 +131,14 @@ func (o *options) run() error {
 		return err
 	}
 
-	diffOut := &bytes.Buffer{}
-
+	var files []fileDiff
 	if o.rawDiff {
 		rawDiff, _, err := client.MergeRequests.ShowMergeRequestRawDiffs(baseRepo.FullName(), mr.IID, nil)
 		if err != nil {
 			return fmt.Errorf("could not obtain raw diff: %w", err)
 		}
 
-		diffOut.Write(rawDiff)
+		files = splitRawDiff(string(rawDiff))
 	} else {
 		diffs, _, err := client.MergeRequests.GetMergeRequestDiffVersions(baseRepo.FullName(), mr.IID, &gitlab.GetMergeRequestDiffVersionsOptions{})
 		if err != nil {
@@ -130,15 +156,25 @@ func (o *options) run() error {
 		if err != nil {
 			return fmt.Errorf("could not find merge request diff: %w", err)
 		}
-		for _, diffLine := range diffVersion.Diffs {
-			// output the unified diff header
-			diffOut.WriteString("--- " + diffLine.OldPath + "\n")
-			diffOut.WriteString("+++ " + diffLine.NewPath + "\n")
+		files = apiFileDiffs(diffVersion.Diffs)
+	}
 
-			diffOut.WriteString(diffLine.Diff)
-		}
+	files = filterFiles(files, o.files)
+	if len(o.files) > 0 && len(files) == 0 {
+		return fmt.Errorf("no changed files match %s.", strings.Join(o.files, ", "))
+	}
 
-		defer diffOut.Reset()
+	diffOut := &bytes.Buffer{}
+	var theme *iostreams.Theme
+	if o.useColor != "never" {
+		theme = o.io.Theme()
+	}
+	if o.stat {
+		writeStat(diffOut, files, theme)
+	} else {
+		for _, f := range files {
+			o.writeFile(diffOut, f, theme)
+		}
 	}
 
 	err = o.io.StartPager()
@@ -147,45 +183,62 @@ func (o *options) run() error {
 	}
 	defer o.io.StopPager()
 
-	if o.useColor == "never" {
-		_, err = io.Copy(o.io.StdOut, diffOut)
-		if errors.Is(err, syscall.EPIPE) {
-			return nil
-		}
-		return err
+	_, err = io.Copy(o.io.StdOut, diffOut)
+	if errors.Is(err, syscall.EPIPE) {
+		return nil
 	}
+	return err
+}
 
-	diffLines := bufio.NewScanner(diffOut)
-	for diffLines.Scan() {
-		diffLine := diffLines.Text()
-		switch {
-		case isHeaderLine(diffLine):
-			fmt.Fprintf(o.io.StdOut, "\x1b[1;38m%s\x1b[m\n", diffLine)
-		case isAdditionLine(diffLine):
-			fmt.Fprintf(o.io.StdOut, "\x1b[32m%s\x1b[m\n", diffLine)
-		case isRemovalLine(diffLine):
-			fmt.Fprintf(o.io.StdOut, "\x1b[31m%s\x1b[m\n", diffLine)
-		default:
-			fmt.Fprintln(o.io.StdOut, diffLine)
-		}
+// writeFile writes the diff of a file, as a unified diff or word by word, and
+// colored with the theme when there is one.
+func (o *options) writeFile(w *bytes.Buffer, f fileDiff, theme *iostreams.Theme) {
+	if theme == nil && !o.wordDiff {
+		w.WriteString(f.Header)
+		w.WriteString(f.Hunks)
+		return
 	}
 
-	if err := diffLines.Err(); err != nil {
-		return fmt.Errorf("error reading merge request diff: %w", err)
+	for _, line := range strings.SplitAfter(f.Header, "\n") {
+		if line != "" && theme != nil {
+			line = theme.Paint("header", strings.TrimSuffix(line, "\n")) + "\n"
+		}
+		w.WriteString(line)
 	}
 
-	return nil
-}
+	if o.wordDiff {
+		w.WriteString(wordDiffHunks(f.Hunks, theme))
+		return
+	}
 
-var diffHeaderPrefixes = []string{"+++", "---", "diff", "index"}
+	var h *highlighter
+	if o.syntax {
+		name := f.NewPath
+		if name == "" {
+			name = f.OldPath
+		}
+		h = newHighlighter(path.Base(name))
+	}
 
-func isHeaderLine(dl string) bool {
-	for _, p := range diffHeaderPrefixes {
-		if strings.HasPrefix(dl, p) {
-			return true
+	if f.Hunks == "" {
+		return
+	}
+	for _, line := range strings.Split(strings.TrimSuffix(f.Hunks, "\n"), "\n") {
+		switch {
+		case isAdditionLine(line) && h != nil:
+			fmt.Fprintf(w, "%s%s\n", theme.Paint("diff_add", "+"), h.line(line[1:]))
+		case isRemovalLine(line) && h != nil:
+			fmt.Fprintf(w, "%s%s\n", theme.Paint("diff_remove", "-"), h.line(line[1:]))
+		case strings.HasPrefix(line, " ") && h != nil:
+			fmt.Fprintf(w, " %s\n", h.line(line[1:]))
+		case isAdditionLine(line):
+			fmt.Fprintln(w, theme.Paint("diff_add", line))
+		case isRemovalLine(line):
+			fmt.Fprintln(w, theme.Paint("diff_remove", line))
+		default:
+			fmt.Fprintln(w, line)
 		}
 	}
-	return false
 }
 
 func isAdditionLine(dl string) bool {
@@ -19,6 +19,7 @@ import (
 	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
 
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
 	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
 )
 
@@ -367,7 +368,7 @@ func TestMRDiff_tty(t *testing.T) {
 	output, err := exec("")
 	require.NoError(t, err)
 	// TTY output should have color codes
-	assert.Contains(t, output.String(), "\x1b[m\n\x1b[32m+FITNESS")
+	assert.Contains(t, output.String(), "\x1b[0m\n\x1b[0;32m+FITNESS")
 }
 
 func TestMRDiff_no_diffs_found(t *testing.T) {
@@ -416,3 +417,114 @@ func TestMRDiff_no_diffs_found(t *testing.T) {
 	require.Error(t, err)
 	assert.Equal(t, "no merge request diffs found", err.Error())
 }
+
+func Test_splitRawDiff(t *testing.T) {
+	raw := heredoc.Doc(`
+		diff --git a/main.go b/main.go
+		index 123..456 100644
+		--- a/main.go
+		+++ b/main.go
+		@@ -1,2 +1,2 @@
+		 package main
+		-var a = 1
+		+var a = 2
+		diff --git a/docs/old.md b/docs/new.md
+		similarity index 90%
+		rename from docs/old.md
+		rename to docs/new.md
+		--- a/docs/old.md
+		+++ b/docs/new.md
+		@@ -1 +1 @@
+		-old
+		+new
+	`)
+
+	files := splitRawDiff(raw)
+	require.Len(t, files, 2)
+	assert.Equal(t, "main.go", files[0].name())
+	assert.Equal(t, "docs/old.md => docs/new.md", files[1].name())
+	assert.Equal(t, "@@ -1 +1 @@\n-old\n+new\n", files[1].Hunks)
+	assert.Equal(t, raw, files[0].Header+files[0].Hunks+files[1].Header+files[1].Hunks)
+
+	assert.Equal(t, files[:1], filterFiles(files, []string{"*.go"}))
+	assert.Equal(t, files[1:], filterFiles(files, []string{"docs/*"}))
+	assert.Equal(t, files[1:], filterFiles(files, []string{"old.md"}))
+	assert.Empty(t, filterFiles(files, []string{"*.txt"}))
+}
+
+func Test_writeStat(t *testing.T) {
+	files := []fileDiff{
+		{OldPath: "main.go", NewPath: "main.go", Hunks: "@@ -1,2 +1,3 @@\n package main\n-var a = 1\n+var a = 2\n+var b = 3\n"},
+		{OldPath: "README.md", NewPath: "README.md", Hunks: "@@ -1 +0,0 @@\n-readme\n"},
+	}
+
+	out := &bytes.Buffer{}
+	writeStat(out, files, nil)
+	assert.Equal(t, " main.go   | 3 ++-\n"+
+		" README.md | 1 -\n"+
+		" 2 files changed, 2 insertions(+), 2 deletions(-)\n", out.String())
+}
+
+func Test_wordDiffHunks(t *testing.T) {
+	hunks := "@@ -1,3 +1,3 @@\n package main\n-var name = \"old\"\n+var name = \"new\"\n-// removed\n"
+
+	assert.Equal(t, heredoc.Doc(`
+		@@ -1,3 +1,3 @@
+		package main
+		var name = "[-old-]{+new+}"
+		[-// removed-]
+	`), wordDiffHunks(hunks, nil))
+	assert.Equal(t, "@@ -1,3 +1,3 @@\npackage main\nvar name = \"\x1b[0;31mold\x1b[0m\x1b[0;32mnew\x1b[0m\"\n\x1b[0;31m// removed\x1b[0m\n", wordDiffHunks(hunks, iostreams.DefaultTheme()))
+}
+
+func TestMRDiff_fileAndStat(t *testing.T) {
+	t.Parallel()
+
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockMergeRequests.EXPECT().
+		GetMergeRequest("OWNER/REPO", int64(123), gomock.Any()).
+		Return(&gitlab.MergeRequest{BasicMergeRequest: gitlab.BasicMergeRequest{IID: 123}}, nil, nil).
+		Times(2)
+	testClient.MockMergeRequests.EXPECT().
+		GetMergeRequestDiffVersions("OWNER/REPO", int64(123), gomock.Any()).
+		Return([]*gitlab.MergeRequestDiffVersion{{ID: 110}}, nil, nil).
+		Times(2)
+	testClient.MockMergeRequests.EXPECT().
+		GetSingleMergeRequestDiffVersion("OWNER/REPO", int64(123), int64(110), gomock.Any()).
+		Return(&gitlab.MergeRequestDiffVersion{
+			ID: 110,
+			Diffs: []*gitlab.Diff{
+				{OldPath: "main.go", NewPath: "main.go", Diff: "@@ -1 +1 @@\n-var a = 1\n+var a = 2\n"},
+				{OldPath: "README.md", NewPath: "README.md", Diff: "@@ -1 +1 @@\n-old\n+new\n"},
+			},
+		}, nil, nil).
+		Times(2)
+
+	exec := cmdtest.SetupCmdForTest(t, newCmdDiffWrapper, false,
+		cmdtest.WithGitLabClient(testClient.Client),
+		cmdtest.WithBaseRepo("OWNER", "REPO", ""),
+	)
+	output, err := exec("123 --file '*.go'")
+	require.NoError(t, err)
+	assert.Equal(t, "--- main.go\n+++ main.go\n@@ -1 +1 @@\n-var a = 1\n+var a = 2\n", output.String())
+
+	exec = cmdtest.SetupCmdForTest(t, newCmdDiffWrapper, false,
+		cmdtest.WithGitLabClient(testClient.Client),
+		cmdtest.WithBaseRepo("OWNER", "REPO", ""),
+	)
+	output, err = exec("123 --stat")
+	require.NoError(t, err)
+	assert.Equal(t, " main.go   | 2 +-\n"+
+		" README.md | 2 +-\n"+
+		" 2 files changed, 2 insertions(+), 2 deletions(-)\n", output.String())
+}
+
+func Test_highlighter(t *testing.T) {
+	assert.Nil(t, newHighlighter("LICENSE"))
+
+	h := newHighlighter("main.go")
+	require.NotNil(t, h)
+	line := h.line("var a = 1")
+	assert.Contains(t, line, "\x1b[")
+	assert.NotContains(t, line, "\n")
+}
@@ -0,0 +1,115 @@
+package diff
+
+import (
+	"path"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// fileDiff is the diff of a file: its header lines, like "--- a/file", and its hunks.
+type fileDiff struct {
+	OldPath string
+	NewPath string
+	Header  string
+	Hunks   string
+}
+
+// name returns the path of the file, with its old path when it was renamed.
+func (f fileDiff) name() string {
+	switch {
+	case f.NewPath == "":
+		return f.OldPath
+	case f.OldPath == "" || f.OldPath == f.NewPath:
+		return f.NewPath
+	default:
+		return f.OldPath + " => " + f.NewPath
+	}
+}
+
+// apiFileDiffs converts the diffs of a merge request diff version, adding the
+// unified diff header the API doesn't include.
+func apiFileDiffs(diffs []*gitlab.Diff) []fileDiff {
+	files := make([]fileDiff, 0, len(diffs))
+	for _, d := range diffs {
+		files = append(files, fileDiff{
+			OldPath: d.OldPath,
+			NewPath: d.NewPath,
+			Header:  "--- " + d.OldPath + "\n" + "+++ " + d.NewPath + "\n",
+			Hunks:   d.Diff,
+		})
+	}
+	return files
+}
+
+// splitRawDiff splits a raw diff into the diffs of its files. The content is kept
+// as is, so joining the files gives the raw diff back.
+func splitRawDiff(raw string) []fileDiff {
+	var files []fileDiff
+	inHunks := false
+	for _, line := range strings.SplitAfter(raw, "\n") {
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "diff --git ") || len(files) == 0 {
+			files = append(files, fileDiff{})
+			inHunks = false
+		}
+		f := &files[len(files)-1]
+
+		if strings.HasPrefix(line, "@@") {
+			inHunks = true
+		}
+		if inHunks {
+			f.Hunks += line
+			continue
+		}
+		f.Header += line
+
+		text := strings.TrimRight(line, "\n")
+		switch {
+		case strings.HasPrefix(text, "diff --git a/"):
+			if oldPath, newPath, ok := strings.Cut(strings.TrimPrefix(text, "diff --git a/"), " b/"); ok {
+				f.OldPath, f.NewPath = oldPath, newPath
+			}
+		case strings.HasPrefix(text, "--- a/"):
+			f.OldPath = strings.TrimPrefix(text, "--- a/")
+		case strings.HasPrefix(text, "+++ b/"):
+			f.NewPath = strings.TrimPrefix(text, "+++ b/")
+		}
+	}
+	return files
+}
+
+// filterFiles returns the files with an old or new path matching one of the globs.
+// A glob without a slash matches the file name in any directory.
+func filterFiles(files []fileDiff, globs []string) []fileDiff {
+	if len(globs) == 0 {
+		return files
+	}
+
+	var filtered []fileDiff
+	for _, f := range files {
+		if matchesAny(globs, f.OldPath) || matchesAny(globs, f.NewPath) {
+			filtered = append(filtered, f)
+		}
+	}
+	return filtered
+}
+
+func matchesAny(globs []string, p string) bool {
+	if p == "" {
+		return false
+	}
+	for _, glob := range globs {
+		if ok, _ := path.Match(glob, p); ok {
+			return true
+		}
+		if !strings.Contains(glob, "/") {
+			if ok, _ := path.Match(glob, path.Base(p)); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
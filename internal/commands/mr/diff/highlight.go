@@ -0,0 +1,45 @@
+package diff
+
+import (
+	"strings"
+
+	"github.com/alecthomas/chroma/v2"
+	"github.com/alecthomas/chroma/v2/formatters"
+	"github.com/alecthomas/chroma/v2/lexers"
+	"github.com/alecthomas/chroma/v2/styles"
+)
+
+// highlighter highlights the syntax of the lines of a file.
+type highlighter struct {
+	lexer     chroma.Lexer
+	style     *chroma.Style
+	formatter chroma.Formatter
+}
+
+// newHighlighter returns a highlighter for the language of the file, or nil when
+// the language isn't recognized from the file name.
+func newHighlighter(filename string) *highlighter {
+	lexer := lexers.Match(filename)
+	if lexer == nil {
+		return nil
+	}
+	return &highlighter{
+		lexer:     chroma.Coalesce(lexer),
+		style:     styles.Get("monokai"),
+		formatter: formatters.Get("terminal256"),
+	}
+}
+
+// line highlights a line of code. Lines of a diff are highlighted one by one, so
+// constructs spanning several lines, like block comments, aren't recognized.
+func (h *highlighter) line(text string) string {
+	iterator, err := h.lexer.Tokenise(nil, text)
+	if err != nil {
+		return text
+	}
+	var out strings.Builder
+	if err := h.formatter.Format(&out, h.style, iterator); err != nil {
+		return text
+	}
+	return strings.TrimSuffix(out.String(), "\n")
+}
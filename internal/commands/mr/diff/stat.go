@@ -0,0 +1,73 @@
+package diff
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+// statBarWidth is the maximum width of the bar of +/- signs of a file.
+const statBarWidth = 50
+
+// countChanges returns the number of added and removed lines of the hunks.
+func countChanges(hunks string) (added, removed int) {
+	for _, line := range strings.Split(hunks, "\n") {
+		switch {
+		case strings.HasPrefix(line, "+"):
+			added++
+		case strings.HasPrefix(line, "-"):
+			removed++
+		}
+	}
+	return added, removed
+}
+
+// writeStat writes a summary of the changed files, like git diff --stat.
+func writeStat(w io.Writer, files []fileDiff, theme *iostreams.Theme) {
+	type stat struct {
+		name           string
+		added, removed int
+	}
+	stats := make([]stat, 0, len(files))
+	nameWidth, maxChanges, totalAdded, totalRemoved := 0, 0, 0, 0
+	for _, f := range files {
+		added, removed := countChanges(f.Hunks)
+		stats = append(stats, stat{f.name(), added, removed})
+		nameWidth = max(nameWidth, len(f.name()))
+		maxChanges = max(maxChanges, added+removed)
+		totalAdded += added
+		totalRemoved += removed
+	}
+	countWidth := len(fmt.Sprint(maxChanges))
+
+	for _, s := range stats {
+		plus, minus := s.added, s.removed
+		if maxChanges > statBarWidth {
+			// Scale the bars, keeping at least one sign for any change.
+			plus = scale(s.added, maxChanges)
+			minus = scale(s.removed, maxChanges)
+		}
+		plusBar := strings.Repeat("+", plus)
+		minusBar := strings.Repeat("-", minus)
+		if theme != nil {
+			plusBar = theme.Paint("diff_add", plusBar)
+			minusBar = theme.Paint("diff_remove", minusBar)
+		}
+		fmt.Fprintf(w, " %-*s | %*d %s%s\n", nameWidth, s.name, countWidth, s.added+s.removed, plusBar, minusBar)
+	}
+
+	fmt.Fprintf(w, " %s changed, %s(+), %s(-)\n",
+		utils.Pluralize(len(files), "file"),
+		utils.Pluralize(totalAdded, "insertion"),
+		utils.Pluralize(totalRemoved, "deletion"))
+}
+
+func scale(n, total int) int {
+	if n == 0 {
+		return 0
+	}
+	return max(1, n*statBarWidth/total)
+}
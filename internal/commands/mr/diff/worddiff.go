@@ -0,0 +1,149 @@
+package diff
+
+import (
+	"regexp"
+	"strings"
+
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+)
+
+// maxWordDiffCells bounds the size of the table used to compare two lines word by
+// word. Longer lines are shown as entirely replaced.
+const maxWordDiffCells = 1_000_000
+
+var wordRE = regexp.MustCompile(`\s+|\w+|[^\w\s]`)
+
+type wordOp int
+
+const (
+	wordEqual wordOp = iota
+	wordRemoved
+	wordAdded
+)
+
+type wordChange struct {
+	Op   wordOp
+	Text string
+}
+
+// wordDiff compares two lines word by word. Consecutive words with the same
+// change are merged.
+func wordDiff(oldLine, newLine string) []wordChange {
+	a := wordRE.FindAllString(oldLine, -1)
+	b := wordRE.FindAllString(newLine, -1)
+
+	var changes []wordChange
+	add := func(op wordOp, text string) {
+		if n := len(changes); n > 0 && changes[n-1].Op == op {
+			changes[n-1].Text += text
+			return
+		}
+		changes = append(changes, wordChange{op, text})
+	}
+
+	if (len(a)+1)*(len(b)+1) > maxWordDiffCells {
+		add(wordRemoved, oldLine)
+		add(wordAdded, newLine)
+		return changes
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			add(wordEqual, a[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			add(wordRemoved, a[i])
+			i++
+		default:
+			add(wordAdded, b[j])
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		add(wordRemoved, a[i])
+	}
+	for ; j < len(b); j++ {
+		add(wordAdded, b[j])
+	}
+	return changes
+}
+
+// wordDiffHunks rewrites hunks to show changes word by word, like git diff
+// --word-diff: removed lines followed by added lines are merged, line by line,
+// into a single line. Hunk headers are kept, and the +/- column is dropped.
+func wordDiffHunks(hunks string, theme *iostreams.Theme) string {
+	if hunks == "" {
+		return ""
+	}
+
+	var out strings.Builder
+	var removed, added []string
+
+	formatChange := func(c wordChange) string {
+		switch {
+		case c.Op == wordRemoved && theme != nil:
+			return theme.Paint("diff_remove", c.Text)
+		case c.Op == wordRemoved:
+			return "[-" + c.Text + "-]"
+		case c.Op == wordAdded && theme != nil:
+			return theme.Paint("diff_add", c.Text)
+		case c.Op == wordAdded:
+			return "{+" + c.Text + "+}"
+		default:
+			return c.Text
+		}
+	}
+	flush := func() {
+		for k := 0; k < max(len(removed), len(added)); k++ {
+			var oldLine, newLine string
+			if k < len(removed) {
+				oldLine = removed[k]
+			}
+			if k < len(added) {
+				newLine = added[k]
+			}
+			for _, c := range wordDiff(oldLine, newLine) {
+				out.WriteString(formatChange(c))
+			}
+			out.WriteString("\n")
+		}
+		removed, added = nil, nil
+	}
+
+	for _, line := range strings.Split(strings.TrimSuffix(hunks, "\n"), "\n") {
+		switch {
+		case strings.HasPrefix(line, "-"):
+			if len(added) > 0 {
+				flush()
+			}
+			removed = append(removed, line[1:])
+		case strings.HasPrefix(line, "+"):
+			added = append(added, line[1:])
+		case strings.HasPrefix(line, `\`):
+			// "\ No newline at end of file" has no meaning without the +/- column.
+		default:
+			flush()
+			out.WriteString(strings.TrimPrefix(line, " ") + "\n")
+		}
+	}
+	flush()
+	return out.String()
+}
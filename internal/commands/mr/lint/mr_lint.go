@@ -0,0 +1,149 @@
+package lint
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/mr/mrutils"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	configPath string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+}
+
+func NewCmdLint(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+	}
+
+	mrLintCmd := &cobra.Command{
+		Use:   "lint [<id> | <branch>...] [flags]",
+		Short: `Check merge requests against the title and description rules of the project.`,
+		Long: heredoc.Docf(`
+			Check the title, description, linked issues, and size of merge requests, and exit
+			with a non-zero status if any rule is violated. Use it in CI to enforce the conventions
+			of a project.
+
+			The rules are read from the %[1]smr_lint%[1]s section of the %[1]s%[2]s%[1]s file in the
+			root of the repository:
+
+			%[1]s%[1]s%[1]syaml
+			mr_lint:
+			  # Require titles like "feat(cli): add a flag". Enabled when there is no mr_lint section.
+			  conventional_title: true
+			  title_types: [feat, fix, docs, refactor, test, chore]
+			  max_title_length: 72
+			  # Markdown headings the description must contain.
+			  required_sections: ["Summary", "Test plan"]
+			  # Require an issue that the merge request closes.
+			  require_linked_issue: true
+			  # Maximum number of added and removed lines, and of changed files.
+			  max_diff_lines: 1000
+			  max_changed_files: 50
+			%[1]s%[1]s%[1]s
+		`, "`", mrutils.ConventionsFile),
+		Example: heredoc.Doc(`
+			# Check the merge request of the current branch
+			$ glab mr lint
+
+			# Check several merge requests
+			$ glab mr lint 12 15 21
+
+			# Use rules from another file
+			$ glab mr lint 12 --config ci/mr-rules.yml
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			mrs, repo, err := mrutils.MRsFromArgs(f, args, "any")
+			if err != nil {
+				return err
+			}
+			return opts.run(mrs, repo.FullName())
+		},
+	}
+
+	mrLintCmd.Flags().StringVar(&opts.configPath, "config", "", fmt.Sprintf("Path to the file with the rules. Defaults to %s in the root of the repository.", mrutils.ConventionsFile))
+
+	return mrLintCmd
+}
+
+func (o *options) run(mrs []*gitlab.MergeRequest, repo string) error {
+	r, err := mrutils.LoadLintRules(o.configPath)
+	if err != nil {
+		return err
+	}
+
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	c := o.io.Color()
+	failed := 0
+	for _, mr := range mrs {
+		problems, err := o.lint(client, r, mr, repo)
+		if err != nil {
+			return err
+		}
+
+		if len(problems) == 0 {
+			fmt.Fprintf(o.io.StdOut, "%s !%d %s\n", c.GreenCheck(), mr.IID, mr.Title)
+			continue
+		}
+
+		failed++
+		fmt.Fprintf(o.io.StdOut, "%s !%d %s\n", c.FailedIcon(), mr.IID, mr.Title)
+		for _, p := range problems {
+			fmt.Fprintf(o.io.StdOut, "  - %s\n", p)
+		}
+	}
+
+	if failed > 0 {
+		if len(mrs) > 1 {
+			fmt.Fprintf(o.io.StdErr, "%d of %s failed the checks.\n", failed, utils.Pluralize(len(mrs), "merge request"))
+		}
+		return cmdutils.SilentError
+	}
+	return nil
+}
+
+func (o *options) lint(client *gitlab.Client, r *mrutils.LintRules, mr *gitlab.MergeRequest, repo string) ([]string, error) {
+	problems := checkTitle(r, mr.Title)
+	problems = append(problems, checkDescription(r, mr.Description)...)
+
+	if r.RequireLinkedIssue {
+		issues, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Issue, *gitlab.Response, error) {
+			return client.MergeRequests.GetIssuesClosedOnMerge(repo, mr.IID, &gitlab.GetIssuesClosedOnMergeOptions{}, p)
+		})
+		if err != nil {
+			return nil, cmdutils.WrapError(err, fmt.Sprintf("failed to get the issues closed by !%d.", mr.IID))
+		}
+		problems = append(problems, checkLinkedIssues(r, issues)...)
+	}
+
+	if needsDiff(r) {
+		diffs, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.MergeRequestDiff, *gitlab.Response, error) {
+			return client.MergeRequests.ListMergeRequestDiffs(repo, mr.IID, &gitlab.ListMergeRequestDiffsOptions{ListOptions: gitlab.ListOptions{PerPage: 100}}, p)
+		})
+		if err != nil {
+			return nil, cmdutils.WrapError(err, fmt.Sprintf("failed to get the changes of !%d.", mr.IID))
+		}
+		problems = append(problems, checkDiffs(r, diffs)...)
+	}
+
+	return problems, nil
+}
@@ -0,0 +1,107 @@
+//go:build !integration
+
+package lint
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+const rulesFile = `mr_lint:
+  conventional_title: true
+  required_sections: [Summary]
+  require_linked_issue: true
+  max_diff_lines: 2
+`
+
+func mergeRequest(iid int64, title, description string) *gitlab.MergeRequest {
+	return &gitlab.MergeRequest{
+		BasicMergeRequest: gitlab.BasicMergeRequest{
+			IID:         iid,
+			Title:       title,
+			Description: description,
+		},
+	}
+}
+
+func TestMRLint(t *testing.T) {
+	tests := []struct {
+		name       string
+		cli        string
+		setupMock  func(tc *gitlabtesting.TestClient)
+		wantOut    string
+		wantStderr string
+		wantErr    bool
+	}{
+		{
+			name: "Passing merge request",
+			cli:  "12",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockMergeRequests.EXPECT().GetMergeRequest("OWNER/REPO", int64(12), gomock.Any()).
+					Return(mergeRequest(12, "feat: add lint", "## Summary\nAdds lint.\n\nCloses #3"), nil, nil)
+				tc.MockMergeRequests.EXPECT().GetIssuesClosedOnMerge("OWNER/REPO", int64(12), gomock.Any(), gomock.Any()).
+					Return([]*gitlab.Issue{{IID: 3}}, &gitlab.Response{}, nil)
+				tc.MockMergeRequests.EXPECT().ListMergeRequestDiffs("OWNER/REPO", int64(12), gomock.Any(), gomock.Any()).
+					Return([]*gitlab.MergeRequestDiff{{Diff: "@@ -1 +1 @@\n-a\n+b\n"}}, &gitlab.Response{}, nil)
+			},
+			wantOut: "✓ !12 feat: add lint\n",
+		},
+		{
+			name: "Several merge requests with violations",
+			cli:  "12 15",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockMergeRequests.EXPECT().GetMergeRequest("OWNER/REPO", int64(12), gomock.Any()).
+					Return(mergeRequest(12, "feat: add lint", "## Summary\nAdds lint."), nil, nil)
+				tc.MockMergeRequests.EXPECT().GetMergeRequest("OWNER/REPO", int64(15), gomock.Any()).
+					Return(mergeRequest(15, "Update things", "Some changes."), nil, nil)
+				tc.MockMergeRequests.EXPECT().GetIssuesClosedOnMerge("OWNER/REPO", int64(12), gomock.Any(), gomock.Any()).
+					Return([]*gitlab.Issue{{IID: 3}}, &gitlab.Response{}, nil)
+				tc.MockMergeRequests.EXPECT().GetIssuesClosedOnMerge("OWNER/REPO", int64(15), gomock.Any(), gomock.Any()).
+					Return([]*gitlab.Issue{}, &gitlab.Response{}, nil)
+				tc.MockMergeRequests.EXPECT().ListMergeRequestDiffs("OWNER/REPO", int64(12), gomock.Any(), gomock.Any()).
+					Return([]*gitlab.MergeRequestDiff{{Diff: "@@ -1 +1 @@\n-a\n+b\n"}}, &gitlab.Response{}, nil)
+				tc.MockMergeRequests.EXPECT().ListMergeRequestDiffs("OWNER/REPO", int64(15), gomock.Any(), gomock.Any()).
+					Return([]*gitlab.MergeRequestDiff{{Diff: "@@ -1 +1,2 @@\n-a\n+b\n+c\n"}}, &gitlab.Response{}, nil)
+			},
+			wantOut: "✓ !12 feat: add lint\n" +
+				"x !15 Update things\n" +
+				`  - Title doesn't follow Conventional Commits, such as "feat(scope): description".` + "\n" +
+				`  - Description is missing the "Summary" section.` + "\n" +
+				`  - No linked issue. Add "Closes #<issue>" to the description.` + "\n" +
+				"  - 3 lines changed. The maximum is 2.\n",
+			wantStderr: "1 of 2 merge requests failed the checks.\n",
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "rules.yml")
+			require.NoError(t, os.WriteFile(path, []byte(rulesFile), 0o644))
+
+			tc := gitlabtesting.NewTestClient(t)
+			tt.setupMock(tc)
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdLint, false, cmdtest.WithGitLabClient(tc.Client))
+			out, err := exec(tt.cli + " --config " + path)
+			if tt.wantErr {
+				require.ErrorIs(t, err, cmdutils.SilentError)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantOut, out.String())
+			assert.Equal(t, tt.wantStderr, out.Stderr())
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/commands/mr/mrutils"
+)
+
+var (
+	draftPrefixRE    = regexp.MustCompile(`(?i)^(draft:|\[draft]|\(draft\))\s*`)
+	sectionHeadingRE = regexp.MustCompile(`^#{1,6}\s+(.+?)\s*#*\s*$`)
+)
+
+// needsDiff reports whether the rules check the changes of the merge request.
+func needsDiff(r *mrutils.LintRules) bool {
+	return r.MaxDiffLines > 0 || r.MaxChangedFiles > 0
+}
+
+func checkTitle(r *mrutils.LintRules, title string) []string {
+	var problems []string
+	title = draftPrefixRE.ReplaceAllString(title, "")
+
+	if r.ConventionalTitle {
+		cc := mrutils.ParseConventionalCommit(title)
+		switch {
+		case cc == nil:
+			problems = append(problems, `Title doesn't follow Conventional Commits, such as "feat(scope): description".`)
+		case !r.AllowsType(cc.Type):
+			problems = append(problems, fmt.Sprintf("Title type %q is not one of: %s.", cc.Type, strings.Join(r.TitleTypes, ", ")))
+		}
+	}
+	if r.MaxTitleLength > 0 && len([]rune(title)) > r.MaxTitleLength {
+		problems = append(problems, fmt.Sprintf("Title is %d characters long. The maximum is %d.", len([]rune(title)), r.MaxTitleLength))
+	}
+	return problems
+}
+
+func checkDescription(r *mrutils.LintRules, description string) []string {
+	headings := map[string]bool{}
+	for line := range strings.SplitSeq(description, "\n") {
+		if m := sectionHeadingRE.FindStringSubmatch(strings.TrimSpace(line)); m != nil {
+			headings[strings.ToLower(m[1])] = true
+		}
+	}
+
+	var problems []string
+	for _, section := range r.RequiredSections {
+		name := strings.TrimSpace(strings.TrimLeft(section, "#"))
+		if !headings[strings.ToLower(name)] {
+			problems = append(problems, fmt.Sprintf("Description is missing the %q section.", name))
+		}
+	}
+	return problems
+}
+
+func checkLinkedIssues(r *mrutils.LintRules, issues []*gitlab.Issue) []string {
+	if r.RequireLinkedIssue && len(issues) == 0 {
+		return []string{`No linked issue. Add "Closes #<issue>" to the description.`}
+	}
+	return nil
+}
+
+func checkDiffs(r *mrutils.LintRules, diffs []*gitlab.MergeRequestDiff) []string {
+	var problems []string
+	if r.MaxChangedFiles > 0 && len(diffs) > r.MaxChangedFiles {
+		problems = append(problems, fmt.Sprintf("%d files changed. The maximum is %d.", len(diffs), r.MaxChangedFiles))
+	}
+	if r.MaxDiffLines > 0 {
+		if n := changedLines(diffs); n > r.MaxDiffLines {
+			problems = append(problems, fmt.Sprintf("%d lines changed. The maximum is %d.", n, r.MaxDiffLines))
+		}
+	}
+	return problems
+}
+
+// changedLines counts the added and removed lines of the diffs.
+func changedLines(diffs []*gitlab.MergeRequestDiff) int {
+	n := 0
+	for _, d := range diffs {
+		for line := range strings.SplitSeq(d.Diff, "\n") {
+			if strings.HasPrefix(line, "+") || strings.HasPrefix(line, "-") {
+				n++
+			}
+		}
+	}
+	return n
+}
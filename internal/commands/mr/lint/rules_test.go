@@ -0,0 +1,64 @@
+//go:build !integration
+
+package lint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/commands/mr/mrutils"
+)
+
+func TestCheckTitle(t *testing.T) {
+	r := &mrutils.LintRules{ConventionalTitle: true, TitleTypes: []string{"feat", "fix"}, MaxTitleLength: 30}
+
+	tests := []struct {
+		title string
+		want  []string
+	}{
+		{title: "feat: add lint command"},
+		{title: "fix(mr)!: handle empty titles"},
+		{title: "Draft: feat(ci): add badge"},
+		{
+			title: "Add lint command",
+			want:  []string{`Title doesn't follow Conventional Commits, such as "feat(scope): description".`},
+		},
+		{
+			title: "chore: bump deps",
+			want:  []string{`Title type "chore" is not one of: feat, fix.`},
+		},
+		{
+			title: "feat: add a very long title to the merge request",
+			want:  []string{"Title is 48 characters long. The maximum is 30."},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			assert.Equal(t, tt.want, checkTitle(r, tt.title))
+		})
+	}
+}
+
+func TestCheckDescription(t *testing.T) {
+	r := &mrutils.LintRules{RequiredSections: []string{"## Summary", "Test plan"}}
+
+	assert.Empty(t, checkDescription(r, "## Summary\nText\n\n### test plan ###\nRan it."))
+	assert.Equal(t, []string{`Description is missing the "Test plan" section.`}, checkDescription(r, "# Summary\nSee the Test plan below."))
+}
+
+func TestCheckDiffs(t *testing.T) {
+	r := &mrutils.LintRules{MaxDiffLines: 2, MaxChangedFiles: 1}
+	diffs := []*gitlab.MergeRequestDiff{
+		{Diff: "@@ -1,2 +1,2 @@\n-old\n+new\n context\n"},
+		{Diff: "@@ -0,0 +1 @@\n+added\n"},
+	}
+
+	assert.Equal(t, []string{
+		"2 files changed. The maximum is 1.",
+		"3 lines changed. The maximum is 2.",
+	}, checkDiffs(r, diffs))
+}
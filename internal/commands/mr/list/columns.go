@@ -0,0 +1,345 @@
+package list
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/commands/mr/mrutils"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+// defaultColumns are the columns shown when --columns is not set.
+var defaultColumns = []string{"iid", "reference", "title", "branches"}
+
+// maxConcurrentFetches limits the number of parallel API calls made for columns that
+// need more data than the list endpoint returns.
+const maxConcurrentFetches = 5
+
+// mrRow holds a merge request and the extra data fetched for the requested columns.
+type mrRow struct {
+	mr        *gitlab.BasicMergeRequest
+	pipeline  *gitlab.Pipeline
+	approvals *gitlab.MergeRequestApprovals
+}
+
+// fetchRequirement describes the additional API data a column needs.
+type fetchRequirement int
+
+const (
+	fetchNone fetchRequirement = iota
+	fetchPipeline
+	fetchApprovals
+)
+
+type column struct {
+	fetch   fetchRequirement
+	render  func(streams *iostreams.IOStreams, row *mrRow) string
+	compare func(a, b *mrRow) int
+}
+
+var columnRegistry = map[string]column{
+	"iid": {
+		render: func(streams *iostreams.IOStreams, row *mrRow) string {
+			return streams.Hyperlink(mrutils.MRState(streams.Color(), row.mr), row.mr.WebURL)
+		},
+		compare: func(a, b *mrRow) int { return cmp.Compare(a.mr.IID, b.mr.IID) },
+	},
+	"reference": {
+		render:  func(_ *iostreams.IOStreams, row *mrRow) string { return reference(row.mr) },
+		compare: func(a, b *mrRow) int { return cmp.Compare(reference(a.mr), reference(b.mr)) },
+	},
+	"title": {
+		render: func(_ *iostreams.IOStreams, row *mrRow) string { return row.mr.Title },
+		compare: func(a, b *mrRow) int {
+			return strings.Compare(strings.ToLower(a.mr.Title), strings.ToLower(b.mr.Title))
+		},
+	},
+	"branches": {
+		render: func(streams *iostreams.IOStreams, row *mrRow) string {
+			return streams.Color().Cyan(fmt.Sprintf("(%s) ← (%s)", row.mr.TargetBranch, row.mr.SourceBranch))
+		},
+		compare: func(a, b *mrRow) int {
+			return cmp.Or(cmp.Compare(a.mr.TargetBranch, b.mr.TargetBranch), cmp.Compare(a.mr.SourceBranch, b.mr.SourceBranch))
+		},
+	},
+	"author": {
+		render:  func(_ *iostreams.IOStreams, row *mrRow) string { return username(row.mr.Author) },
+		compare: func(a, b *mrRow) int { return cmp.Compare(username(a.mr.Author), username(b.mr.Author)) },
+	},
+	"state": {
+		render:  func(_ *iostreams.IOStreams, row *mrRow) string { return row.mr.State },
+		compare: func(a, b *mrRow) int { return cmp.Compare(a.mr.State, b.mr.State) },
+	},
+	"draft": {
+		render: func(_ *iostreams.IOStreams, row *mrRow) string {
+			if row.mr.Draft {
+				return "draft"
+			}
+			return ""
+		},
+		compare: func(a, b *mrRow) int { return compareBool(a.mr.Draft, b.mr.Draft) },
+	},
+	"labels": {
+		render:  func(_ *iostreams.IOStreams, row *mrRow) string { return strings.Join(row.mr.Labels, ", ") },
+		compare: func(a, b *mrRow) int { return cmp.Compare(len(a.mr.Labels), len(b.mr.Labels)) },
+	},
+	"assignees": {
+		render:  func(_ *iostreams.IOStreams, row *mrRow) string { return usernames(row.mr.Assignees) },
+		compare: func(a, b *mrRow) int { return cmp.Compare(usernames(a.mr.Assignees), usernames(b.mr.Assignees)) },
+	},
+	"reviewers": {
+		render:  func(_ *iostreams.IOStreams, row *mrRow) string { return usernames(row.mr.Reviewers) },
+		compare: func(a, b *mrRow) int { return cmp.Compare(usernames(a.mr.Reviewers), usernames(b.mr.Reviewers)) },
+	},
+	"created": {
+		render: func(streams *iostreams.IOStreams, row *mrRow) string {
+			if row.mr.CreatedAt == nil {
+				return ""
+			}
+			return streams.Color().Gray(utils.TimeToPrettyTimeAgo(*row.mr.CreatedAt))
+		},
+		compare: func(a, b *mrRow) int { return compareTime(a.mr.CreatedAt, b.mr.CreatedAt) },
+	},
+	"updated": {
+		render: func(streams *iostreams.IOStreams, row *mrRow) string {
+			if row.mr.UpdatedAt == nil {
+				return ""
+			}
+			return streams.Color().Gray(utils.TimeToPrettyTimeAgo(*row.mr.UpdatedAt))
+		},
+		compare: func(a, b *mrRow) int { return compareTime(a.mr.UpdatedAt, b.mr.UpdatedAt) },
+	},
+	"pipeline": {
+		fetch: fetchPipeline,
+		render: func(streams *iostreams.IOStreams, row *mrRow) string {
+			if row.pipeline == nil {
+				return "-"
+			}
+			c := streams.Color()
+			switch row.pipeline.Status {
+			case "success":
+				return c.Green(row.pipeline.Status)
+			case "failed":
+				return c.Red(row.pipeline.Status)
+			case "running", "pending", "created":
+				return c.Yellow(row.pipeline.Status)
+			default:
+				return row.pipeline.Status
+			}
+		},
+		compare: func(a, b *mrRow) int { return cmp.Compare(pipelineStatus(a), pipelineStatus(b)) },
+	},
+	"approvals": {
+		fetch: fetchApprovals,
+		render: func(_ *iostreams.IOStreams, row *mrRow) string {
+			if row.approvals == nil {
+				return "-"
+			}
+			if row.approvals.ApprovalsRequired > 0 {
+				return fmt.Sprintf("%d/%d", len(row.approvals.ApprovedBy), row.approvals.ApprovalsRequired)
+			}
+			return fmt.Sprintf("%d", len(row.approvals.ApprovedBy))
+		},
+		compare: func(a, b *mrRow) int { return cmp.Compare(approvalCount(a), approvalCount(b)) },
+	},
+}
+
+// columnNames returns the names of all registered columns, sorted alphabetically.
+func columnNames() []string {
+	names := make([]string, 0, len(columnRegistry))
+	for name := range columnRegistry {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// validateColumns checks that all names are registered columns. When descending is set,
+// names may be prefixed with '-'.
+func validateColumns(flag string, names []string, descending bool) error {
+	for _, name := range names {
+		key := name
+		if descending {
+			key = strings.TrimPrefix(name, "-")
+		}
+		if _, ok := columnRegistry[key]; !ok {
+			return fmt.Errorf("invalid %s value %q. Available columns: %s.", flag, name, strings.Join(columnNames(), ", "))
+		}
+	}
+	return nil
+}
+
+// sortKey is a column to sort by, parsed from a --sort-by value such as "-updated".
+type sortKey struct {
+	column     column
+	descending bool
+}
+
+func parseSortKeys(values []string) []sortKey {
+	keys := make([]sortKey, 0, len(values))
+	for _, v := range values {
+		keys = append(keys, sortKey{
+			column:     columnRegistry[strings.TrimPrefix(v, "-")],
+			descending: strings.HasPrefix(v, "-"),
+		})
+	}
+	return keys
+}
+
+// sortRows sorts the rows by the given keys. The sort is stable, so merge requests
+// that compare equal keep the order returned by the API.
+func sortRows(rows []*mrRow, keys []sortKey) {
+	if len(keys) == 0 {
+		return
+	}
+	slices.SortStableFunc(rows, func(a, b *mrRow) int {
+		for _, key := range keys {
+			c := key.column.compare(a, b)
+			if key.descending {
+				c = -c
+			}
+			if c != 0 {
+				return c
+			}
+		}
+		return 0
+	})
+}
+
+// fetchRows wraps the merge requests in rows and fetches only the extra data
+// required by the given columns.
+func fetchRows(client *gitlab.Client, mrs []*gitlab.BasicMergeRequest, columns []string) ([]*mrRow, error) {
+	var needPipeline, needApprovals bool
+	for _, name := range columns {
+		switch columnRegistry[strings.TrimPrefix(name, "-")].fetch {
+		case fetchPipeline:
+			needPipeline = true
+		case fetchApprovals:
+			needApprovals = true
+		}
+	}
+
+	rows := make([]*mrRow, len(mrs))
+	for i, m := range mrs {
+		rows[i] = &mrRow{mr: m}
+	}
+	if !needPipeline && !needApprovals {
+		return rows, nil
+	}
+
+	g := &errgroup.Group{}
+	g.SetLimit(maxConcurrentFetches)
+	for _, row := range rows {
+		if needPipeline {
+			g.Go(func() error {
+				mr, err := api.GetMR(client, row.mr.ProjectID, row.mr.IID, &gitlab.GetMergeRequestsOptions{})
+				if err != nil {
+					return fmt.Errorf("failed to get the pipeline of merge request !%d: %w", row.mr.IID, err)
+				}
+				row.pipeline = mr.HeadPipeline
+				return nil
+			})
+		}
+		if needApprovals {
+			g.Go(func() error {
+				approvals, _, err := client.MergeRequestApprovals.GetConfiguration(row.mr.ProjectID, row.mr.IID)
+				if err != nil {
+					return fmt.Errorf("failed to get the approvals of merge request !%d: %w", row.mr.IID, err)
+				}
+				row.approvals = approvals
+				return nil
+			})
+		}
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+func renderRows(streams *iostreams.IOStreams, rows []*mrRow, columns []string) string {
+	table := tableprinter.New(streams)
+	table.SetIsTTY(streams.IsOutputTTY())
+	if i := slices.Index(columns, "title"); i >= 0 {
+		table.SetWrapColumns(i)
+	}
+	for _, row := range rows {
+		for _, name := range columns {
+			table.AddCell(columnRegistry[name].render(streams, row))
+		}
+		table.EndRow()
+	}
+
+	return table.Render()
+}
+
+func reference(mr *gitlab.BasicMergeRequest) string {
+	if mr.References == nil {
+		return ""
+	}
+	return mr.References.Full
+}
+
+func username(u *gitlab.BasicUser) string {
+	if u == nil {
+		return ""
+	}
+	return u.Username
+}
+
+func usernames(users []*gitlab.BasicUser) string {
+	names := make([]string, 0, len(users))
+	for _, u := range users {
+		names = append(names, username(u))
+	}
+	return strings.Join(names, ", ")
+}
+
+func pipelineStatus(row *mrRow) string {
+	if row.pipeline == nil {
+		return ""
+	}
+	return row.pipeline.Status
+}
+
+func approvalCount(row *mrRow) int {
+	if row.approvals == nil {
+		return 0
+	}
+	return len(row.approvals.ApprovedBy)
+}
+
+func compareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return 1
+	default:
+		return -1
+	}
+}
+
+func compareTime(a, b *time.Time) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	default:
+		return a.Compare(*b)
+	}
+}
@@ -1,8 +1,10 @@
 package list
 
 import (
-	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/spf13/cobra"
@@ -11,10 +13,10 @@ import (
 
 	"gitlab.com/gitlab-org/cli/internal/api"
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
-	"gitlab.com/gitlab-org/cli/internal/commands/mr/mrutils"
 	"gitlab.com/gitlab-org/cli/internal/glrepo"
 	"gitlab.com/gitlab-org/cli/internal/iostreams"
 	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/text"
 	"gitlab.com/gitlab-org/cli/internal/utils"
 )
 
@@ -57,14 +59,17 @@ type options struct {
 	page         int
 	perPage      int
 	outputFormat string
+	includeMeta  bool
 
 	// display opts
 	listType       string
 	titleQualifier string
+	columns        []string
 
 	// sort options
 	sort    string
 	orderBy string
+	sortBy  []string
 
 	io        *iostreams.IOStreams
 	baseRepo  func() (glrepo.Interface, error)
@@ -99,6 +104,9 @@ func NewCmdList(f cmdutils.Factory, runE func(opts *options) error) *cobra.Comma
 			$ glab mr list -M --per-page 10
 			$ glab mr list --draft
 			$ glab mr list --not-draft
+			$ glab mr list --columns iid,title,author,updated,pipeline,approvals
+			$ glab mr list --columns iid,title,author,approvals --sort-by author,-approvals
+			$ glab mr list --output json --include-meta --page 2
 		`),
 		Args: cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -127,13 +135,16 @@ func NewCmdList(f cmdutils.Factory, runE func(opts *options) error) *cobra.Comma
 	mrListCmd.Flags().BoolVarP(&opts.merged, "merged", "M", false, "Get only merged merge requests.")
 	mrListCmd.Flags().BoolVarP(&opts.draft, "draft", "d", false, "Filter by draft merge requests.")
 	mrListCmd.Flags().BoolVarP(&opts.notDraft, "not-draft", "", false, "Filter by non-draft merge requests.")
-	mrListCmd.Flags().StringVarP(&opts.outputFormat, "output", "F", "text", "Format output as: text, json.")
+	mrListCmd.Flags().StringVarP(&opts.outputFormat, "output", "F", "text", "Format output as: text, json, slack, ids. ids prints one merge request ID per line, to pipe to other commands.")
+	mrListCmd.Flags().BoolVar(&opts.includeMeta, "include-meta", false, "With --output json, wrap the merge requests in an object with the pagination and rate limit state.")
 	mrListCmd.Flags().IntVarP(&opts.page, "page", "p", 1, "Page number.")
 	mrListCmd.Flags().IntVarP(&opts.perPage, "per-page", "P", 30, "Number of items to list per page.")
 	mrListCmd.Flags().StringSliceVarP(&opts.assignee, "assignee", "a", []string{}, "Get only merge requests assigned to users. Multiple users can be comma-separated or specified by repeating the flag.")
 	mrListCmd.Flags().StringSliceVarP(&opts.reviewer, "reviewer", "r", []string{}, "Get only merge requests with users as reviewer. Multiple users can be comma-separated or specified by repeating the flag.")
 	mrListCmd.Flags().StringVarP(&opts.sort, "sort", "S", "", "Sort merge requests by <field>. Sort options: asc, desc.")
 	mrListCmd.Flags().StringVarP(&opts.orderBy, "order", "o", "", "Order merge requests by <field>. Order options: created_at, updated_at, merged_at, title, priority, label_priority, milestone_due, and popularity.")
+	mrListCmd.Flags().StringSliceVar(&opts.columns, "columns", []string{}, fmt.Sprintf("Comma-separated list of columns to display. Columns: %s.", strings.Join(columnNames(), ", ")))
+	mrListCmd.Flags().StringSliceVar(&opts.sortBy, "sort-by", []string{}, "Sort the fetched merge requests client-side by comma-separated <columns>. Prefix a column with '-' to sort in descending order.")
 
 	mrListCmd.Flags().BoolP("opened", "O", false, "Get only open merge requests.")
 	_ = mrListCmd.Flags().MarkHidden("opened")
@@ -164,6 +175,25 @@ func (o *options) complete(cmd *cobra.Command) error {
 		o.titleQualifier = "open"
 	}
 
+	if err := validateColumns("--columns", o.columns, false); err != nil {
+		return &cmdutils.FlagError{Err: err}
+	}
+	if err := validateColumns("--sort-by", o.sortBy, true); err != nil {
+		return &cmdutils.FlagError{Err: err}
+	}
+	if o.outputFormat != "text" && (len(o.columns) > 0 || len(o.sortBy) > 0) {
+		return &cmdutils.FlagError{Err: errors.New("--columns and --sort-by can only be used with text output.")}
+	}
+	if o.includeMeta {
+		if o.outputFormat != "json" {
+			return &cmdutils.FlagError{Err: errors.New("--include-meta requires --output json.")}
+		}
+		// Filtering by users takes a request per user, so there's no single pagination state.
+		if hasUsers(o.assignee) || hasUsers(o.reviewer) {
+			return &cmdutils.FlagError{Err: errors.New("--include-meta can't be used with --assignee or --reviewer, except for @any.")}
+		}
+	}
+
 	group, err := cmdutils.GroupOverride(cmd)
 	if err != nil {
 		return err
@@ -181,8 +211,13 @@ func (o *options) complete(cmd *cobra.Command) error {
 	return nil
 }
 
+func hasUsers(names []string) bool {
+	return len(names) > 0 && names[0] != "@any"
+}
+
 func (o *options) run() error {
 	var mergeRequests []*gitlab.BasicMergeRequest
+	var resp *gitlab.Response
 
 	// NOTE: this command can not only be used for projects,
 	// so we have to manually check for the base repo, if it doesn't exist,
@@ -211,7 +246,7 @@ func (o *options) run() error {
 	}
 
 	if o.author != "" {
-		u, err := api.UserByName(client, o.author)
+		u, err := apiClient.UserByName(o.author)
 		if err != nil {
 			return err
 		}
@@ -276,7 +311,7 @@ func (o *options) run() error {
 		if o.assignee[0] == "@any" {
 			l.AssigneeID = gitlab.AssigneeID(gitlab.UserIDAny)
 		} else {
-			users, err := api.UsersByNames(client, o.assignee)
+			users, err := apiClient.UsersByNames(o.assignee)
 			if err != nil {
 				return err
 			}
@@ -291,7 +326,7 @@ func (o *options) run() error {
 		if o.reviewer[0] == "@any" {
 			l.ReviewerID = gitlab.ReviewerID(gitlab.UserIDAny)
 		} else {
-			users, err := api.UsersByNames(client, o.reviewer)
+			users, err := apiClient.UsersByNames(o.reviewer)
 			if err != nil {
 				return err
 			}
@@ -303,7 +338,7 @@ func (o *options) run() error {
 	title := utils.NewListTitle(o.titleQualifier + " merge request")
 
 	if o.group != "" {
-		mergeRequests, err = api.ListGroupMRs(client, o.group, projectListMROptionsToGroup(l), api.WithMRAssignees(assigneeIds), api.WithMRReviewers(reviewerIds))
+		mergeRequests, err = api.ListGroupMRs(client, o.group, projectListMROptionsToGroup(l), api.WithMRAssignees(assigneeIds), api.WithMRReviewers(reviewerIds), api.WithMRResponse(&resp))
 		title.RepoName = o.group
 	} else {
 		var repo glrepo.Interface
@@ -313,7 +348,7 @@ func (o *options) run() error {
 		}
 
 		title.RepoName = repo.FullName()
-		mergeRequests, err = api.ListMRs(client, repo.FullName(), l, api.WithMRAssignees(assigneeIds), api.WithMRReviewers(reviewerIds))
+		mergeRequests, err = api.ListMRs(client, repo.FullName(), l, api.WithMRAssignees(assigneeIds), api.WithMRReviewers(reviewerIds), api.WithMRResponse(&resp))
 	}
 	if err != nil {
 		return err
@@ -323,19 +358,56 @@ func (o *options) run() error {
 	title.ListActionType = o.listType
 	title.CurrentPageTotal = len(mergeRequests)
 
-	if jsonOutput {
-		mrListJSON, _ := json.Marshal(mergeRequests)
+	switch o.outputFormat {
+	case "json":
+		mrListJSON, _ := cmdutils.ListJSON(mergeRequests, resp, o.includeMeta)
 		fmt.Fprintln(o.io.StdOut, string(mrListJSON))
-	} else {
+	case "slack":
+		fmt.Fprint(o.io.StdOut, slackMRList(title.Describe(), mergeRequests))
+	case "ids":
+		for _, mr := range mergeRequests {
+			fmt.Fprintln(o.io.StdOut, mr.IID)
+		}
+	default:
+		columns := o.columns
+		if len(columns) == 0 {
+			columns = defaultColumns
+		}
+
+		// Only the columns that are displayed or sorted by trigger extra API calls.
+		rows, err := fetchRows(client, mergeRequests, append(slices.Clone(columns), o.sortBy...))
+		if err != nil {
+			return err
+		}
+		sortRows(rows, parseSortKeys(o.sortBy))
+
 		if err = o.io.StartPager(); err != nil {
 			return err
 		}
 		defer o.io.StopPager()
-		fmt.Fprintf(o.io.StdOut, "%s\n%s\n", title.Describe(), mrutils.DisplayAllMRs(o.io, mergeRequests))
+		fmt.Fprintf(o.io.StdOut, "%s\n%s\n", title.Describe(), renderRows(o.io, rows, columns))
 	}
 	return nil
 }
 
+// slackMRList returns the merge requests as a Slack mrkdwn list, under a bold title.
+func slackMRList(title string, mrs []*gitlab.BasicMergeRequest) string {
+	var b strings.Builder
+	b.WriteString(text.SlackBold(text.SlackEscape(title)) + "\n")
+	for _, mr := range mrs {
+		fmt.Fprintf(&b, "• %s %s `%s` → `%s`", text.SlackLink(mr.WebURL, fmt.Sprintf("!%d", mr.IID)), text.SlackEscape(mr.Title),
+			text.SlackEscape(mr.SourceBranch), text.SlackEscape(mr.TargetBranch))
+		if mr.Author != nil {
+			fmt.Fprintf(&b, " by @%s", mr.Author.Username)
+		}
+		if mr.State != "opened" {
+			fmt.Fprintf(&b, " _(%s)_", mr.State)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
 func projectListMROptionsToGroup(l *gitlab.ListProjectMergeRequestsOptions) *gitlab.ListGroupMergeRequestsOptions {
 	return &gitlab.ListGroupMergeRequestsOptions{
 		ListOptions:            l.ListOptions,
@@ -3,6 +3,7 @@
 package list
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
@@ -460,8 +461,6 @@ func TestMergeRequestList_hyperlinks(t *testing.T) {
 func TestMergeRequestList_labels(t *testing.T) {
 	// NOTE: we need to force disable colors, otherwise we'd need ANSI sequences in our test output assertions.
 	t.Setenv("NO_COLOR", "true")
-	// NOTE: These subtests cannot run in parallel because they use cmdutils.GroupOverride()
-	// which modifies global viper state (SetEnvPrefix, BindEnv).
 
 	type labelTest struct {
 		name            string
@@ -1048,6 +1047,25 @@ func TestMergeRequestList_CreatedAtDefaultsToDesc(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestMergeRequestList_IDs(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+	exec := cmdtest.SetupCmdForTest(
+		t,
+		func(f cmdutils.Factory) *cobra.Command { return NewCmdList(f, nil) },
+		false,
+		cmdtest.WithBaseRepo("OWNER", "REPO", ""),
+		cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(testClient.Client))),
+	)
+
+	testClient.MockMergeRequests.EXPECT().
+		ListProjectMergeRequests("OWNER/REPO", gomock.Any()).
+		Return([]*gitlab.BasicMergeRequest{{IID: 4}, {IID: 1}}, &gitlab.Response{}, nil)
+
+	out, err := exec("--output ids")
+	require.NoError(t, err)
+	assert.Equal(t, "4\n1\n", out.OutBuf.String())
+}
+
 func TestMergeRequestList_ExplicitSortOverridesDefault(t *testing.T) {
 	// GIVEN
 	testClient := gitlabtesting.NewTestClient(t)
@@ -1074,3 +1092,283 @@ func TestMergeRequestList_ExplicitSortOverridesDefault(t *testing.T) {
 	// THEN
 	require.NoError(t, err)
 }
+
+func TestMergeRequestList_Columns(t *testing.T) {
+	// NOTE: we need to force disable colors, otherwise we'd need ANSI sequences in our test output assertions.
+	t.Setenv("NO_COLOR", "true")
+
+	mrs := []*gitlab.BasicMergeRequest{
+		{
+			IID:       6,
+			ProjectID: 1,
+			State:     "opened",
+			Title:     "MergeRequest one",
+			Author:    &gitlab.BasicUser{Username: "bob"},
+			WebURL:    "http://gitlab.com/OWNER/REPO/merge_requests/6",
+		},
+		{
+			IID:       7,
+			ProjectID: 1,
+			State:     "opened",
+			Title:     "MergeRequest two",
+			Author:    &gitlab.BasicUser{Username: "alice"},
+			WebURL:    "http://gitlab.com/OWNER/REPO/merge_requests/7",
+		},
+		{
+			IID:       8,
+			ProjectID: 1,
+			State:     "opened",
+			Title:     "MergeRequest three",
+			Author:    &gitlab.BasicUser{Username: "bob"},
+			WebURL:    "http://gitlab.com/OWNER/REPO/merge_requests/8",
+		},
+	}
+
+	tests := []struct {
+		name          string
+		cli           string
+		withPipelines bool
+		withApprovals bool
+		wantOutput    string
+	}{
+		{
+			name: "Columns without extra data",
+			cli:  "--columns iid,author,title",
+			wantOutput: heredoc.Doc(`
+				Showing 3 open merge requests on OWNER/REPO. (Page 1)
+
+				!6	bob  	MergeRequest one  
+				!7	alice	MergeRequest two  
+				!8	bob  	MergeRequest three
+
+			`),
+		},
+		{
+			name:          "Pipeline and approvals columns",
+			cli:           "--columns iid,pipeline,approvals",
+			withPipelines: true,
+			withApprovals: true,
+			wantOutput: heredoc.Doc(`
+				Showing 3 open merge requests on OWNER/REPO. (Page 1)
+
+				!6	success	1/2
+				!7	-      	0/2
+				!8	failed 	2/2
+
+			`),
+		},
+		{
+			name: "Secondary sort keeps API order for ties",
+			cli:  "--columns iid,author --sort-by author",
+			wantOutput: heredoc.Doc(`
+				Showing 3 open merge requests on OWNER/REPO. (Page 1)
+
+				!7	alice
+				!6	bob  
+				!8	bob  
+
+			`),
+		},
+		{
+			name:          "Sorting by a column that is not displayed fetches its data",
+			cli:           "--columns iid,title --sort-by -approvals",
+			withApprovals: true,
+			wantOutput: heredoc.Doc(`
+				Showing 3 open merge requests on OWNER/REPO. (Page 1)
+
+				!8	MergeRequest three
+				!6	MergeRequest one  
+				!7	MergeRequest two  
+
+			`),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+
+			testClient.MockMergeRequests.EXPECT().
+				ListProjectMergeRequests("OWNER/REPO", gomock.Any()).
+				Return(mrs, nil, nil)
+
+			if tc.withPipelines {
+				pipelines := map[int64]*gitlab.Pipeline{
+					6: {Status: "success"},
+					8: {Status: "failed"},
+				}
+				testClient.MockMergeRequests.EXPECT().
+					GetMergeRequest(int64(1), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, iid int64, _ *gitlab.GetMergeRequestsOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error) {
+						return &gitlab.MergeRequest{HeadPipeline: pipelines[iid]}, nil, nil
+					}).
+					Times(3)
+			}
+
+			if tc.withApprovals {
+				approvers := map[int64]int{6: 1, 7: 0, 8: 2}
+				testClient.MockMergeRequestApprovals.EXPECT().
+					GetConfiguration(int64(1), gomock.Any()).
+					DoAndReturn(func(_ any, iid int64, _ ...gitlab.RequestOptionFunc) (*gitlab.MergeRequestApprovals, *gitlab.Response, error) {
+						approvedBy := make([]*gitlab.MergeRequestApproverUser, approvers[iid])
+						return &gitlab.MergeRequestApprovals{ApprovalsRequired: 2, ApprovedBy: approvedBy}, nil, nil
+					}).
+					Times(3)
+			}
+
+			apiClient, err := api.NewClient(
+				func(*http.Client) (gitlab.AuthSource, error) {
+					return gitlab.AccessTokenAuthSource{Token: "test-token"}, nil
+				},
+				api.WithGitLabClient(testClient.Client),
+			)
+			require.NoError(t, err)
+
+			exec := cmdtest.SetupCmdForTest(t, func(f cmdutils.Factory) *cobra.Command {
+				return NewCmdList(f, nil)
+			}, true,
+				cmdtest.WithApiClient(apiClient),
+				cmdtest.WithBaseRepo("OWNER", "REPO", ""),
+			)
+
+			output, err := exec(tc.cli)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.wantOutput, output.String())
+			assert.Empty(t, output.Stderr())
+		})
+	}
+}
+
+func TestMergeRequestList_ColumnsValidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		cli       string
+		wantError string
+	}{
+		{
+			name:      "Unknown column",
+			cli:       "--columns iid,foo",
+			wantError: `invalid --columns value "foo". Available columns: approvals, assignees, author, branches, created, draft, iid, labels, pipeline, reference, reviewers, state, title, updated.`,
+		},
+		{
+			name:      "Descending prefix is only valid for sorting",
+			cli:       "--columns -iid",
+			wantError: `invalid --columns value "-iid". Available columns: approvals, assignees, author, branches, created, draft, iid, labels, pipeline, reference, reviewers, state, title, updated.`,
+		},
+		{
+			name:      "Unknown sort column",
+			cli:       "--sort-by -bar",
+			wantError: `invalid --sort-by value "-bar". Available columns: approvals, assignees, author, branches, created, draft, iid, labels, pipeline, reference, reviewers, state, title, updated.`,
+		},
+		{
+			name:      "JSON output",
+			cli:       "--columns iid --output json",
+			wantError: "--columns and --sort-by can only be used with text output.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			exec := cmdtest.SetupCmdForTest(t, func(f cmdutils.Factory) *cobra.Command {
+				return NewCmdList(f, nil)
+			}, true,
+				cmdtest.WithBaseRepo("OWNER", "REPO", ""),
+			)
+
+			_, err := exec(tc.cli)
+			require.Error(t, err)
+			assert.EqualError(t, err, tc.wantError)
+		})
+	}
+}
+
+func TestSlackMRList(t *testing.T) {
+	mrs := []*gitlab.BasicMergeRequest{
+		{IID: 3, Title: "Add a flag", State: "opened", SourceBranch: "feature", TargetBranch: "main", Author: &gitlab.BasicUser{Username: "alice"}, WebURL: "https://gitlab.com/OWNER/REPO/-/merge_requests/3"},
+		{IID: 4, Title: "Fix & test", State: "merged", SourceBranch: "fix", TargetBranch: "main", WebURL: "https://gitlab.com/OWNER/REPO/-/merge_requests/4"},
+	}
+
+	want := "*Showing 2 open merge requests on OWNER/REPO.*\n" +
+		"• <https://gitlab.com/OWNER/REPO/-/merge_requests/3|!3> Add a flag `feature` → `main` by @alice\n" +
+		"• <https://gitlab.com/OWNER/REPO/-/merge_requests/4|!4> Fix &amp; test `fix` → `main` _(merged)_\n"
+	assert.Equal(t, want, slackMRList("Showing 2 open merge requests on OWNER/REPO.", mrs))
+}
+
+func TestMergeRequestList_IncludeMeta(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+	exec := cmdtest.SetupCmdForTest(
+		t,
+		func(f cmdutils.Factory) *cobra.Command { return NewCmdList(f, nil) },
+		false,
+		cmdtest.WithBaseRepo("OWNER", "REPO", ""),
+		cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(testClient.Client))),
+	)
+
+	header := http.Header{}
+	header.Set("X-Total", "31")
+	header.Set("X-Total-Pages", "2")
+	testClient.MockMergeRequests.EXPECT().
+		ListProjectMergeRequests("OWNER/REPO", gomock.Any()).
+		Return([]*gitlab.BasicMergeRequest{{IID: 4}}, &gitlab.Response{
+			Response:     &http.Response{Header: header},
+			TotalItems:   31,
+			TotalPages:   2,
+			CurrentPage:  2,
+			ItemsPerPage: 30,
+		}, nil)
+
+	out, err := exec("--output json --include-meta --page 2")
+	require.NoError(t, err)
+
+	var envelope struct {
+		Data []gitlab.BasicMergeRequest `json:"data"`
+		Meta cmdutils.ListMeta          `json:"meta"`
+	}
+	require.NoError(t, json.Unmarshal(out.OutBuf.Bytes(), &envelope))
+	require.Len(t, envelope.Data, 1)
+	assert.Equal(t, int64(4), envelope.Data[0].IID)
+	assert.Equal(t, cmdutils.ListMeta{
+		TotalItems:  gitlab.Ptr(int64(31)),
+		TotalPages:  gitlab.Ptr(int64(2)),
+		CurrentPage: 2,
+		PerPage:     30,
+	}, envelope.Meta)
+}
+
+func TestMergeRequestList_IncludeMetaValidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		cli       string
+		wantError string
+	}{
+		{
+			name:      "Text output",
+			cli:       "--include-meta",
+			wantError: "--include-meta requires --output json.",
+		},
+		{
+			name:      "Assignee",
+			cli:       "--include-meta --output json --assignee @me",
+			wantError: "--include-meta can't be used with --assignee or --reviewer, except for @any.",
+		},
+		{
+			name:      "Reviewer",
+			cli:       "--include-meta --output json --reviewer alice",
+			wantError: "--include-meta can't be used with --assignee or --reviewer, except for @any.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			exec := cmdtest.SetupCmdForTest(t, func(f cmdutils.Factory) *cobra.Command {
+				return NewCmdList(f, nil)
+			}, false,
+				cmdtest.WithBaseRepo("OWNER", "REPO", ""),
+			)
+
+			_, err := exec(tc.cli)
+			assert.EqualError(t, err, tc.wantError)
+		})
+	}
+}
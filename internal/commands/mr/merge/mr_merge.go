@@ -14,12 +14,15 @@ import (
 
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 
+	"gitlab.com/gitlab-org/cli/internal/api"
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
 	"gitlab.com/gitlab-org/cli/internal/commands/mr/mrutils"
 	"gitlab.com/gitlab-org/cli/internal/config"
 	"gitlab.com/gitlab-org/cli/internal/dbg"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
 	"gitlab.com/gitlab-org/cli/internal/iostreams"
 	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/scheduler"
 )
 
 type MRMergeMethod int
@@ -40,11 +43,17 @@ type options struct {
 	rebaseBeforeMerge  bool
 	removeSourceBranch bool
 	skipPrompts        bool
+	noTrailers         bool
 
 	squashMessage      string
 	mergeCommitMessage string
 	sha                string
 
+	at          string
+	scheduledAt time.Time
+
+	checksTimeout time.Duration
+
 	mergeMethod MRMergeMethod
 }
 
@@ -72,9 +81,22 @@ func NewCmdMerge(f cmdutils.Factory) *cobra.Command {
 
 			# Finds open merge request from current branch
 			$ glab mr merge
+
+			# Merge a merge request on June 1st at 9:00, local time.
+			# Requires 'glab schedule actions run' to be running at that time.
+			$ glab mr merge 235 --at "2024-06-01T09:00"
+
+			# Wait up to 30 minutes for the pipeline and the approvals, then merge
+			$ glab mr merge 235 --checks-timeout 30m --yes
 		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.checksTimeout > 0 {
+				if cmd.Flags().Changed("auto-merge") || cmd.Flags().Changed("when-pipeline-succeeds") {
+					return &cmdutils.FlagError{Err: errors.New("--auto-merge can't be used with --checks-timeout.")}
+				}
+				opts.setAutoMerge = false
+			}
 			if err := opts.validate(); err != nil {
 				return err
 			}
@@ -91,10 +113,14 @@ func NewCmdMerge(f cmdutils.Factory) *cobra.Command {
 	mrMergeCmd.Flags().BoolVarP(&opts.squashBeforeMerge, "squash", "s", false, "Squash commits on merge.")
 	mrMergeCmd.Flags().BoolVarP(&opts.rebaseBeforeMerge, "rebase", "r", false, "Rebase the commits onto the base branch.")
 	mrMergeCmd.Flags().BoolVarP(&opts.skipPrompts, "yes", "y", false, "Skip submission confirmation prompt.")
+	mrMergeCmd.Flags().BoolVar(&opts.noTrailers, "no-trailers", false, "Don't add the sign-offs recorded with 'glab mr signoff' to the commit message as trailers.")
+	mrMergeCmd.Flags().StringVar(&opts.at, "at", "", "Schedule the merge for a later time, such as 2024-06-01T09:00. The merge is run by 'glab schedule actions run'.")
+	mrMergeCmd.Flags().DurationVar(&opts.checksTimeout, "checks-timeout", 0, "Wait up to this time for the pipeline to succeed and the approvals, then merge. Exits with 1 if the pipeline fails, and 3 on timeout.")
 
 	mrMergeCmd.Flags().BoolVarP(&opts.setAutoMerge, "when-pipeline-succeeds", "", true, "Merge only when pipeline succeeds")
 	_ = mrMergeCmd.Flags().MarkDeprecated("when-pipeline-succeeds", "use --auto-merge instead.")
 	mrMergeCmd.MarkFlagsMutuallyExclusive("squash", "rebase")
+	mrMergeCmd.MarkFlagsMutuallyExclusive("at", "checks-timeout")
 
 	return mrMergeCmd
 }
@@ -104,6 +130,21 @@ func (o *options) validate() error {
 		return &cmdutils.FlagError{Err: errors.New("--squash-message can only be used with --squash.")}
 	}
 
+	if o.at != "" {
+		if o.rebaseBeforeMerge {
+			return &cmdutils.FlagError{Err: errors.New("--rebase can't be used with --at.")}
+		}
+
+		at, err := scheduler.ParseTime(o.at)
+		if err != nil {
+			return &cmdutils.FlagError{Err: err}
+		}
+		if !at.After(scheduler.Now()) {
+			return &cmdutils.FlagError{Err: fmt.Errorf("--at must be in the future: %s.", o.at)}
+		}
+		o.scheduledAt = at
+	}
+
 	return nil
 }
 
@@ -120,20 +161,54 @@ func (o *options) run(x cmdutils.Factory, cmd *cobra.Command, args []string) err
 		return err
 	}
 
-	if err = mrutils.MRCheckErrors(mr, mrutils.MRCheckErrOptions{
-		Draft:          true,
-		Closed:         true,
-		Merged:         true,
-		Conflict:       true,
-		PipelineStatus: true,
-		MergePrivilege: true,
-	}); err != nil {
-		dbg.Debug("MRCheckErrors failed")
-		return err
+	if !o.scheduledAt.IsZero() {
+		if err = mrutils.MRCheckErrors(mr, mrutils.MRCheckErrOptions{
+			Draft:          true,
+			Closed:         true,
+			Merged:         true,
+			Conflict:       true,
+			PipelineStatus: true,
+			MergePrivilege: true,
+		}); err != nil {
+			dbg.Debug("MRCheckErrors failed")
+			return err
+		}
+		return o.schedule(mr, repo)
+	}
+
+	if o.checksTimeout > 0 {
+		mr, err = o.waitForChecks(cmd.Context(), apiClient, repo.FullName(), mr)
+		if err != nil {
+			return err
+		}
+	}
+
+	// The merge settings of the project explain most of the refusals of the API.
+	// Without them, only the state of the merge request is checked.
+	project, err := api.GetProject(apiClient, repo.FullName())
+	if err != nil {
+		dbg.Debug("failed to get the merge settings of the project:", err.Error())
+		project = &gitlab.Project{}
+	}
+	if blockers := o.mergeBlockers(project, mr); len(blockers) > 0 {
+		fmt.Fprintf(o.io.StdErr, "%s Merge request !%d can't be merged because:\n", c.FailedIcon(), mr.IID)
+		for _, blocker := range blockers {
+			fmt.Fprintf(o.io.StdErr, "  - %s\n", blocker)
+		}
+		return cmdutils.SilentError
+	}
+
+	var trailers []string
+	if !o.noTrailers {
+		trailers, err = mrutils.ListSignoffTrailers(apiClient, repo.FullName(), mr.IID)
+		if err != nil {
+			return cmdutils.WrapError(err, "failed to get the sign-offs of the merge request.")
+		}
 	}
 
 	if !cmd.Flags().Changed("when-pipeline-succeeds") &&
 		!cmd.Flags().Changed("auto-merge") &&
+		o.checksTimeout == 0 &&
 		o.io.IsOutputTTY() &&
 		mr.Pipeline != nil &&
 		o.io.PromptEnabled() &&
@@ -173,7 +248,7 @@ func (o *options) run(x cmdutils.Factory, cmd *cobra.Command, args []string) err
 				if err != nil {
 					return err
 				}
-				err = o.io.Editor(cmd.Context(), &mergeMessage, "Merge commit message", "", mr.Title, editor)
+				err = o.io.Editor(cmd.Context(), &mergeMessage, "Merge commit message", "", mrutils.AppendTrailers(mr.Title, trailers), editor)
 				if err != nil {
 					return err
 				}
@@ -200,6 +275,22 @@ func (o *options) run(x cmdutils.Factory, cmd *cobra.Command, args []string) err
 		}
 	}
 
+	// Without a message, GitLab uses the commit templates of the project, which
+	// can't include the trailers. Use the default templates instead.
+	if len(trailers) > 0 {
+		if o.squashBeforeMerge {
+			if o.squashMessage == "" {
+				o.squashMessage = mr.Title
+			}
+			o.squashMessage = mrutils.AppendTrailers(o.squashMessage, trailers)
+		} else {
+			if o.mergeCommitMessage == "" {
+				o.mergeCommitMessage = defaultMergeCommitMessage(mr)
+			}
+			o.mergeCommitMessage = mrutils.AppendTrailers(o.mergeCommitMessage, trailers)
+		}
+	}
+
 	mergeOpts := &gitlab.AcceptMergeRequestOptions{}
 	if o.mergeCommitMessage != "" {
 		mergeOpts.MergeCommitMessage = gitlab.Ptr(o.mergeCommitMessage)
@@ -297,6 +388,42 @@ func (o *options) run(x cmdutils.Factory, cmd *cobra.Command, args []string) err
 	return nil
 }
 
+// defaultMergeCommitMessage returns the merge commit message of the default
+// template of GitLab.
+func defaultMergeCommitMessage(mr *gitlab.MergeRequest) string {
+	message := fmt.Sprintf("Merge branch '%s' into '%s'\n\n%s", mr.SourceBranch, mr.TargetBranch, mr.Title)
+	if mr.References != nil && mr.References.Full != "" {
+		message += "\n\nSee merge request " + mr.References.Full
+	}
+	return message
+}
+
+// schedule records the merge for 'glab schedule actions run' instead of merging now.
+func (o *options) schedule(mr *gitlab.MergeRequest, repo glrepo.Interface) error {
+	action, err := scheduler.Add(&scheduler.Action{
+		Type:  scheduler.ActionMergeMR,
+		Host:  repo.RepoHost(),
+		Repo:  repo.FullName(),
+		MRIID: mr.IID,
+		At:    o.scheduledAt,
+		Merge: &scheduler.MergeOptions{
+			Squash:             o.squashBeforeMerge,
+			RemoveSourceBranch: o.removeSourceBranch,
+			SquashMessage:      o.squashMessage,
+			MergeCommitMessage: o.mergeCommitMessage,
+			SHA:                o.sha,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	c := o.io.Color()
+	fmt.Fprintf(o.io.StdOut, "%s Scheduled merge of !%d for %s (ID %d).\n", c.GreenCheck(), mr.IID, o.scheduledAt.Format(time.RFC1123), action.ID)
+	fmt.Fprintln(o.io.StdOut, "The merge runs only while 'glab schedule actions run' is running. Run 'glab schedule actions generate' to run it with systemd or cron.")
+	return nil
+}
+
 func mergeMethodSurvey(io *iostreams.IOStreams) (MRMergeMethod, error) {
 	type mergeOption struct {
 		title  string
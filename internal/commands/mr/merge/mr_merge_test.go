@@ -4,6 +4,7 @@ package merge
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -12,6 +13,8 @@ import (
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
 
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/scheduler"
 	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
 )
 
@@ -25,6 +28,22 @@ func TestMrMerge(t *testing.T) {
 		setupMock   func(tc *gitlabtesting.TestClient)
 	}
 
+	openMR := &gitlab.MergeRequest{
+		BasicMergeRequest: gitlab.BasicMergeRequest{
+			ID:                  190608322,
+			IID:                 123,
+			ProjectID:           37777023,
+			Title:               "foo",
+			State:               "opened",
+			SourceBranch:        "1-issue-20",
+			WebURL:              "https://gitlab.com/OWNER/REPO/-/merge_requests/123",
+			DetailedMergeStatus: "mergeable",
+		},
+		User: gitlab.MergeRequestUser{
+			CanMerge: true,
+		},
+	}
+
 	mergedMR := &gitlab.MergeRequest{
 		BasicMergeRequest: gitlab.BasicMergeRequest{
 			ID:        190608322,
@@ -62,18 +81,56 @@ func TestMrMerge(t *testing.T) {
 					},
 				}
 				tc.MockMergeRequests.EXPECT().
-					GetMergeRequest("OWNER/REPO", int64(123), gomock.Any()).
+					GetMergeRequest("OWNER/REPO", int64(123), gomock.Any(), gomock.Any()).
 					Return(getMR, nil, nil)
+				tc.MockProjects.EXPECT().
+					GetProject("OWNER/REPO", gomock.Any()).
+					Return(&gitlab.Project{}, nil, nil)
+				tc.MockNotes.EXPECT().
+					ListMergeRequestNotes("OWNER/REPO", int64(123), gomock.Any(), gomock.Any()).
+					Return([]*gitlab.Note{}, &gitlab.Response{}, nil)
 				tc.MockMergeRequests.EXPECT().
 					AcceptMergeRequest("OWNER/REPO", int64(123), gomock.Any()).
 					Return(mergedMR, nil, nil)
 			},
 		},
+		{
+			name:        "Schedule a merge",
+			cli:         "123 --at 2999-06-01T09:00:00Z --squash -d",
+			expectedOut: "✓ Scheduled merge of !123 for Sat, 01 Jun 2999 09:00:00 UTC (ID 1).\nThe merge runs only while 'glab schedule actions run' is running. Run 'glab schedule actions generate' to run it with systemd or cron.\n",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockMergeRequests.EXPECT().
+					GetMergeRequest("OWNER/REPO", int64(123), gomock.Any(), gomock.Any()).
+					Return(openMR, nil, nil)
+			},
+		},
+		{
+			name:       "Schedule a merge in the past",
+			cli:        "123 --at 2000-06-01T09:00",
+			wantErr:    true,
+			wantStderr: "--at must be in the future: 2000-06-01T09:00.",
+			setupMock:  func(tc *gitlabtesting.TestClient) {},
+		},
+		{
+			name:       "Schedule a merge with an invalid time",
+			cli:        "123 --at tomorrow",
+			wantErr:    true,
+			wantStderr: `invalid time "tomorrow".`,
+			setupMock:  func(tc *gitlabtesting.TestClient) {},
+		},
+		{
+			name:       "Schedule a merge with rebase",
+			cli:        "123 --at 2999-06-01T09:00 --rebase",
+			wantErr:    true,
+			wantStderr: "--rebase can't be used with --at.",
+			setupMock:  func(tc *gitlabtesting.TestClient) {},
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			// GIVEN
+			t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
 			testClient := gitlabtesting.NewTestClient(t)
 			tc.setupMock(testClient)
 			exec := cmdtest.SetupCmdForTest(
@@ -98,3 +155,299 @@ func TestMrMerge(t *testing.T) {
 		})
 	}
 }
+
+func TestMrMerge_ScheduleStoresAction(t *testing.T) {
+	t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockMergeRequests.EXPECT().
+		GetMergeRequest("OWNER/REPO", int64(123), gomock.Any(), gomock.Any()).
+		Return(&gitlab.MergeRequest{
+			BasicMergeRequest: gitlab.BasicMergeRequest{IID: 123, State: "opened"},
+			User:              gitlab.MergeRequestUser{CanMerge: true},
+		}, nil, nil)
+	exec := cmdtest.SetupCmdForTest(t, NewCmdMerge, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	_, err := exec("123 --at 2999-06-01T09:00 --squash --squash-message done -d")
+	require.NoError(t, err)
+
+	actions, err := scheduler.Load()
+	require.NoError(t, err)
+	require.Len(t, actions, 1)
+	assert.Equal(t, scheduler.ActionMergeMR, actions[0].Type)
+	assert.Equal(t, "OWNER/REPO!123", actions[0].Target())
+	assert.Equal(t, &scheduler.MergeOptions{Squash: true, SquashMessage: "done", RemoveSourceBranch: true}, actions[0].Merge)
+}
+
+func TestMrMerge_SignoffTrailers(t *testing.T) {
+	openMR := &gitlab.MergeRequest{
+		BasicMergeRequest: gitlab.BasicMergeRequest{
+			IID:                 123,
+			Title:               "Fix login",
+			State:               "opened",
+			SourceBranch:        "fix-login",
+			TargetBranch:        "main",
+			DetailedMergeStatus: "mergeable",
+			References:          &gitlab.IssueReferences{Full: "OWNER/REPO!123"},
+		},
+		User: gitlab.MergeRequestUser{CanMerge: true},
+	}
+	notes := []*gitlab.Note{
+		{Body: "Reviewed-by: Jane Doe <jane@example.com>", Author: gitlab.NoteAuthor{Name: "Jane Doe"}},
+		{Body: "Looks good to me", Author: gitlab.NoteAuthor{Name: "Jane Doe"}},
+		{Body: "Acked-by: Linus <linus@example.com>", Author: gitlab.NoteAuthor{Name: "Mallory"}},
+		{Body: "Tested-by: Bob <bob@example.com>", Author: gitlab.NoteAuthor{Name: "Bob"}},
+	}
+
+	tests := []struct {
+		name     string
+		cli      string
+		wantOpts *gitlab.AcceptMergeRequestOptions
+	}{
+		{
+			name: "default merge commit message",
+			cli:  "123 --auto-merge=false",
+			wantOpts: &gitlab.AcceptMergeRequestOptions{
+				MergeCommitMessage: gitlab.Ptr("Merge branch 'fix-login' into 'main'\n\nFix login\n\nSee merge request OWNER/REPO!123\n\nReviewed-by: Jane Doe <jane@example.com>\nTested-by: Bob <bob@example.com>"),
+			},
+		},
+		{
+			name: "custom squash message",
+			cli:  "123 --auto-merge=false --squash --squash-message 'Fix the login form'",
+			wantOpts: &gitlab.AcceptMergeRequestOptions{
+				Squash:              gitlab.Ptr(true),
+				SquashCommitMessage: gitlab.Ptr("Fix the login form\n\nReviewed-by: Jane Doe <jane@example.com>\nTested-by: Bob <bob@example.com>"),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+			testClient := gitlabtesting.NewTestClient(t)
+			testClient.MockMergeRequests.EXPECT().
+				GetMergeRequest("OWNER/REPO", int64(123), gomock.Any(), gomock.Any()).
+				Return(openMR, nil, nil)
+			testClient.MockProjects.EXPECT().
+				GetProject("OWNER/REPO", gomock.Any()).
+				Return(&gitlab.Project{}, nil, nil)
+			testClient.MockNotes.EXPECT().
+				ListMergeRequestNotes("OWNER/REPO", int64(123), gomock.Any(), gomock.Any()).
+				Return(notes, &gitlab.Response{}, nil)
+			testClient.MockMergeRequests.EXPECT().
+				AcceptMergeRequest("OWNER/REPO", int64(123), tc.wantOpts).
+				Return(&gitlab.MergeRequest{BasicMergeRequest: gitlab.BasicMergeRequest{IID: 123, State: "merged"}}, nil, nil)
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdMerge, false, cmdtest.WithGitLabClient(testClient.Client))
+			_, err := exec(tc.cli)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMrMerge_NoTrailers(t *testing.T) {
+	t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockMergeRequests.EXPECT().
+		GetMergeRequest("OWNER/REPO", int64(123), gomock.Any(), gomock.Any()).
+		Return(&gitlab.MergeRequest{
+			BasicMergeRequest: gitlab.BasicMergeRequest{IID: 123, State: "opened", DetailedMergeStatus: "mergeable"},
+			User:              gitlab.MergeRequestUser{CanMerge: true},
+		}, nil, nil)
+	testClient.MockProjects.EXPECT().
+		GetProject("OWNER/REPO", gomock.Any()).
+		Return(&gitlab.Project{}, nil, nil)
+	testClient.MockMergeRequests.EXPECT().
+		AcceptMergeRequest("OWNER/REPO", int64(123), &gitlab.AcceptMergeRequestOptions{}).
+		Return(&gitlab.MergeRequest{BasicMergeRequest: gitlab.BasicMergeRequest{IID: 123, State: "merged"}}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdMerge, false, cmdtest.WithGitLabClient(testClient.Client))
+	_, err := exec("123 --auto-merge=false --no-trailers")
+	require.NoError(t, err)
+}
+
+func TestMrMerge_Preflight(t *testing.T) {
+	tests := []struct {
+		name       string
+		cli        string
+		project    *gitlab.Project
+		mr         *gitlab.MergeRequest
+		wantStderr string
+	}{
+		{
+			name: "unmet project requirements",
+			cli:  "123 --squash --auto-merge=false",
+			project: &gitlab.Project{
+				MergeMethod:                      gitlab.FastForwardMerge,
+				SquashOption:                     gitlab.SquashOptionNever,
+				OnlyAllowMergeIfPipelineSucceeds: true,
+				OnlyAllowMergeIfAllDiscussionsAreResolved: true,
+			},
+			mr: &gitlab.MergeRequest{
+				BasicMergeRequest: gitlab.BasicMergeRequest{
+					IID:                 123,
+					State:               "opened",
+					TargetBranch:        "main",
+					DetailedMergeStatus: "need_rebase",
+				},
+				Pipeline: &gitlab.PipelineInfo{ID: 77, Status: "running"},
+				User:     gitlab.MergeRequestUser{CanMerge: true},
+			},
+			wantStderr: "x Merge request !123 can't be merged because:\n" +
+				"  - the project uses fast-forward merges, so the source branch must be rebased onto main. Use --rebase.\n" +
+				"  - the project doesn't allow squashing commits. Merge without --squash.\n" +
+				"  - the project requires a successful pipeline, and pipeline #77 is running. Use --auto-merge to merge when it succeeds.\n" +
+				"  - the project requires all threads to be resolved, and some threads are unresolved.\n",
+		},
+		{
+			name:    "unmet merge request requirements",
+			cli:     "123",
+			project: &gitlab.Project{OnlyAllowMergeIfPipelineSucceeds: true},
+			mr: &gitlab.MergeRequest{
+				BasicMergeRequest: gitlab.BasicMergeRequest{
+					IID:                 123,
+					State:               "opened",
+					TargetBranch:        "main",
+					Draft:               true,
+					HasConflicts:        true,
+					DetailedMergeStatus: "not_approved",
+				},
+				Pipeline: &gitlab.PipelineInfo{ID: 78, Status: "failed"},
+			},
+			wantStderr: "x Merge request !123 can't be merged because:\n" +
+				"  - you don't have permission to merge it.\n" +
+				"  - it is a draft. Run `glab mr update 123 --ready` to mark it as ready.\n" +
+				"  - it has merge conflicts with main. Resolve the conflicts, or merge locally.\n" +
+				"  - the project requires a successful pipeline, and pipeline #78 is failed.\n" +
+				"  - it needs more approvals. Run `glab mr approvers 123` to see the approval rules.\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+			t.Setenv("NO_COLOR", "true")
+			testClient := gitlabtesting.NewTestClient(t)
+			testClient.MockMergeRequests.EXPECT().
+				GetMergeRequest("OWNER/REPO", int64(123), gomock.Any(), gomock.Any()).
+				Return(tc.mr, nil, nil)
+			testClient.MockProjects.EXPECT().
+				GetProject("OWNER/REPO", gomock.Any()).
+				Return(tc.project, nil, nil)
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdMerge, false, cmdtest.WithGitLabClient(testClient.Client))
+			out, err := exec(tc.cli)
+			require.ErrorIs(t, err, cmdutils.SilentError)
+			assert.Equal(t, tc.wantStderr, out.Stderr())
+		})
+	}
+}
+
+func TestMrMerge_PreflightAllowsPendingPipelineWithAutoMerge(t *testing.T) {
+	opts := &options{setAutoMerge: true}
+	project := &gitlab.Project{OnlyAllowMergeIfPipelineSucceeds: true}
+	mr := &gitlab.MergeRequest{
+		BasicMergeRequest: gitlab.BasicMergeRequest{IID: 123, State: "opened"},
+		Pipeline:          &gitlab.PipelineInfo{ID: 77, Status: "pending"},
+		User:              gitlab.MergeRequestUser{CanMerge: true},
+	}
+
+	assert.Empty(t, opts.mergeBlockers(project, mr))
+}
+
+func TestMrMerge_ChecksTimeout(t *testing.T) {
+	defaultChecksInterval := checksInterval
+	checksInterval = time.Millisecond
+	t.Cleanup(func() { checksInterval = defaultChecksInterval })
+
+	pendingMR := &gitlab.MergeRequest{
+		BasicMergeRequest: gitlab.BasicMergeRequest{IID: 123, State: "opened", DetailedMergeStatus: "not_approved"},
+		Pipeline:          &gitlab.PipelineInfo{ID: 77, Status: "running"},
+		User:              gitlab.MergeRequestUser{CanMerge: true},
+	}
+	greenMR := &gitlab.MergeRequest{
+		BasicMergeRequest: gitlab.BasicMergeRequest{
+			IID:                 123,
+			State:               "opened",
+			DetailedMergeStatus: "mergeable",
+			WebURL:              "https://gitlab.com/OWNER/REPO/-/merge_requests/123",
+		},
+		Pipeline: &gitlab.PipelineInfo{ID: 77, Status: "success"},
+		User:     gitlab.MergeRequestUser{CanMerge: true},
+	}
+
+	t.Run("merge when the checks pass", func(t *testing.T) {
+		t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+		t.Setenv("NO_COLOR", "true")
+		testClient := gitlabtesting.NewTestClient(t)
+		gomock.InOrder(
+			testClient.MockMergeRequests.EXPECT().
+				GetMergeRequest("OWNER/REPO", int64(123), gomock.Any(), gomock.Any()).
+				Return(pendingMR, nil, nil),
+			testClient.MockMergeRequests.EXPECT().
+				GetMergeRequest("OWNER/REPO", int64(123), gomock.Any(), gomock.Any()).
+				Return(greenMR, nil, nil),
+		)
+		testClient.MockProjects.EXPECT().
+			GetProject("OWNER/REPO", gomock.Any()).
+			Return(&gitlab.Project{OnlyAllowMergeIfPipelineSucceeds: true}, nil, nil)
+		testClient.MockMergeRequests.EXPECT().
+			AcceptMergeRequest("OWNER/REPO", int64(123), &gitlab.AcceptMergeRequestOptions{}).
+			Return(&gitlab.MergeRequest{BasicMergeRequest: gitlab.BasicMergeRequest{
+				IID:    123,
+				State:  "merged",
+				WebURL: "https://gitlab.com/OWNER/REPO/-/merge_requests/123",
+			}}, nil, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdMerge, false, cmdtest.WithGitLabClient(testClient.Client))
+		out, err := exec("123 --checks-timeout 1m --no-trailers")
+		require.NoError(t, err)
+		assert.Equal(t, "• Waiting for pipeline #77 (running), approvals of !123.\n✓ Merge checks of !123 passed.\n", out.Stderr())
+		assert.Equal(t, "✓ Merged!\nhttps://gitlab.com/OWNER/REPO/-/merge_requests/123\n", out.String())
+	})
+
+	t.Run("pipeline fails", func(t *testing.T) {
+		t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+		testClient := gitlabtesting.NewTestClient(t)
+		gomock.InOrder(
+			testClient.MockMergeRequests.EXPECT().
+				GetMergeRequest("OWNER/REPO", int64(123), gomock.Any(), gomock.Any()).
+				Return(pendingMR, nil, nil),
+			testClient.MockMergeRequests.EXPECT().
+				GetMergeRequest("OWNER/REPO", int64(123), gomock.Any(), gomock.Any()).
+				Return(&gitlab.MergeRequest{
+					BasicMergeRequest: gitlab.BasicMergeRequest{IID: 123, State: "opened"},
+					Pipeline:          &gitlab.PipelineInfo{ID: 77, Status: "failed"},
+				}, nil, nil),
+		)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdMerge, false, cmdtest.WithGitLabClient(testClient.Client))
+		_, err := exec("123 --checks-timeout 1m")
+		var exitErr *cmdutils.ExitError
+		require.ErrorAs(t, err, &exitErr)
+		assert.Equal(t, exitChecksFailed, exitErr.Code)
+		assert.EqualError(t, exitErr.Err, "pipeline #77 of merge request !123 failed.")
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+		testClient := gitlabtesting.NewTestClient(t)
+		testClient.MockMergeRequests.EXPECT().
+			GetMergeRequest("OWNER/REPO", int64(123), gomock.Any(), gomock.Any()).
+			Return(pendingMR, nil, nil).
+			MinTimes(1)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdMerge, false, cmdtest.WithGitLabClient(testClient.Client))
+		_, err := exec("123 --checks-timeout 20ms")
+		var exitErr *cmdutils.ExitError
+		require.ErrorAs(t, err, &exitErr)
+		assert.Equal(t, exitChecksTimedOut, exitErr.Code)
+		assert.EqualError(t, exitErr.Err, "timed out after 20ms waiting for pipeline #77 (running), approvals of merge request !123.")
+	})
+
+	t.Run("with --auto-merge", func(t *testing.T) {
+		exec := cmdtest.SetupCmdForTest(t, NewCmdMerge, false)
+		_, err := exec("123 --checks-timeout 1m --auto-merge")
+		assert.EqualError(t, err, "--auto-merge can't be used with --checks-timeout.")
+	})
+}
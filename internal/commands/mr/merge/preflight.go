@@ -0,0 +1,113 @@
+package merge
+
+import (
+	"fmt"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// pendingPipelineStatuses are the statuses of pipelines that haven't finished yet.
+var pendingPipelineStatuses = map[string]bool{
+	"created":              true,
+	"waiting_for_resource": true,
+	"preparing":            true,
+	"pending":              true,
+	"running":              true,
+	"scheduled":            true,
+}
+
+// mergeBlockers returns the requirements of the project and the merge request
+// that the merge doesn't meet, so they can be reported together instead of
+// relaying the first error of the API.
+func (o *options) mergeBlockers(project *gitlab.Project, mr *gitlab.MergeRequest) []string {
+	switch mr.State {
+	case "merged":
+		return []string{"it has already been merged."}
+	case "closed":
+		return []string{fmt.Sprintf("it is closed. Run `glab mr reopen %d` to reopen it.", mr.IID)}
+	}
+
+	var blockers []string
+
+	if !mr.User.CanMerge {
+		blockers = append(blockers, "you don't have permission to merge it.")
+	}
+	if mr.Draft {
+		blockers = append(blockers, fmt.Sprintf("it is a draft. Run `glab mr update %d --ready` to mark it as ready.", mr.IID))
+	}
+	if mr.HasConflicts {
+		blockers = append(blockers, fmt.Sprintf("it has merge conflicts with %s. Resolve the conflicts, or merge locally.", mr.TargetBranch))
+	}
+
+	if mr.DetailedMergeStatus == "need_rebase" && !o.rebaseBeforeMerge {
+		blockers = append(blockers, fmt.Sprintf("the project uses %s, so the source branch must be rebased onto %s. Use --rebase.", mergeMethodName(project.MergeMethod), mr.TargetBranch))
+	}
+	if o.squashBeforeMerge && project.SquashOption == gitlab.SquashOptionNever {
+		blockers = append(blockers, "the project doesn't allow squashing commits. Merge without --squash.")
+	}
+
+	if blocker := o.pipelineBlocker(project, mr); blocker != "" {
+		blockers = append(blockers, blocker)
+	}
+
+	if (project.OnlyAllowMergeIfAllDiscussionsAreResolved && !mr.BlockingDiscussionsResolved) || mr.DetailedMergeStatus == "discussions_not_resolved" {
+		blockers = append(blockers, "the project requires all threads to be resolved, and some threads are unresolved.")
+	}
+
+	switch mr.DetailedMergeStatus {
+	case "not_approved":
+		blockers = append(blockers, fmt.Sprintf("it needs more approvals. Run `glab mr approvers %d` to see the approval rules.", mr.IID))
+	case "requested_changes":
+		blockers = append(blockers, "a reviewer requested changes.")
+	case "blocked_status":
+		blockers = append(blockers, "it depends on merge requests that aren't merged yet.")
+	case "external_status_checks":
+		blockers = append(blockers, "the external status checks haven't passed.")
+	case "jira_association_missing":
+		blockers = append(blockers, "the title or description must mention a Jira issue.")
+	}
+
+	return blockers
+}
+
+// pipelineBlocker returns why the pipeline of the merge request prevents the
+// merge, if the project requires a successful pipeline. With auto-merge, a
+// pipeline that is still running doesn't prevent the merge.
+func (o *options) pipelineBlocker(project *gitlab.Project, mr *gitlab.MergeRequest) string {
+	if !project.OnlyAllowMergeIfPipelineSucceeds && mr.DetailedMergeStatus != "ci_must_pass" && mr.DetailedMergeStatus != "ci_still_running" {
+		return ""
+	}
+
+	if mr.Pipeline == nil {
+		if mr.DetailedMergeStatus == "ci_must_pass" {
+			return "the project requires a successful pipeline, but no pipeline ran for the merge request."
+		}
+		return ""
+	}
+
+	status := mr.Pipeline.Status
+	switch {
+	case status == "success":
+		return ""
+	case status == "skipped" && project.AllowMergeOnSkippedPipeline:
+		return ""
+	case pendingPipelineStatuses[status]:
+		if o.setAutoMerge {
+			return ""
+		}
+		return fmt.Sprintf("the project requires a successful pipeline, and pipeline #%d is %s. Use --auto-merge to merge when it succeeds.", mr.Pipeline.ID, status)
+	default:
+		return fmt.Sprintf("the project requires a successful pipeline, and pipeline #%d is %s.", mr.Pipeline.ID, status)
+	}
+}
+
+func mergeMethodName(method gitlab.MergeMethodValue) string {
+	switch method {
+	case gitlab.FastForwardMerge:
+		return "fast-forward merges"
+	case gitlab.RebaseMerge:
+		return "merge commits with semi-linear history"
+	default:
+		return "merge commits"
+	}
+}
@@ -0,0 +1,99 @@
+package merge
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+)
+
+// checksInterval is the time between the first two checks of --checks-timeout.
+// The time doubles after each check, up to maxChecksInterval.
+var (
+	checksInterval    = 10 * time.Second
+	maxChecksInterval = time.Minute
+)
+
+// Exit codes of --checks-timeout when the merge request isn't merged.
+const (
+	exitChecksFailed   = 1
+	exitChecksTimedOut = 3
+)
+
+// waitingMergeStatuses are the detailed merge statuses that can change
+// without any action, and what the merge request waits for.
+var waitingMergeStatuses = map[string]string{
+	"not_approved":      "approvals",
+	"approvals_syncing": "approvals",
+	"ci_must_pass":      "a pipeline",
+	"ci_still_running":  "the pipeline",
+	"checking":          "the merge checks",
+	"unchecked":         "the merge checks",
+	"preparing":         "the merge checks",
+}
+
+// waitForChecks polls the merge request until its pipeline has succeeded and
+// it is approved, or until --checks-timeout. It returns the last version of the
+// merge request. Other merge checks, like conflicts, are reported by
+// mergeBlockers afterwards, because waiting doesn't fix them.
+func (o *options) waitForChecks(ctx context.Context, client *gitlab.Client, repo string, mr *gitlab.MergeRequest) (*gitlab.MergeRequest, error) {
+	c := o.io.Color()
+	ctx, cancel := context.WithTimeout(ctx, o.checksTimeout)
+	defer cancel()
+
+	iid := mr.IID
+	interval := checksInterval
+	lastWaiting := ""
+	for {
+		if mr.Pipeline != nil && (mr.Pipeline.Status == "failed" || mr.Pipeline.Status == "canceled") {
+			return nil, cmdutils.WrapErrorWithCode(fmt.Errorf("pipeline #%d of merge request !%d %s.", mr.Pipeline.ID, mr.IID, mr.Pipeline.Status), exitChecksFailed, "")
+		}
+
+		waiting := waitingFor(mr)
+		if len(waiting) == 0 {
+			if lastWaiting != "" {
+				fmt.Fprintf(o.io.StdErr, "%s Merge checks of !%d passed.\n", c.GreenCheck(), mr.IID)
+			}
+			return mr, nil
+		}
+		if joined := strings.Join(waiting, ", "); joined != lastWaiting {
+			fmt.Fprintf(o.io.StdErr, "%s Waiting for %s of !%d.\n", c.ProgressIcon(), joined, mr.IID)
+			lastWaiting = joined
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, cmdutils.WrapErrorWithCode(fmt.Errorf("timed out after %s waiting for %s of merge request !%d.", o.checksTimeout, lastWaiting, mr.IID), exitChecksTimedOut, "")
+		case <-time.After(interval):
+		}
+		interval = min(interval*2, maxChecksInterval)
+
+		var err error
+		mr, _, err = client.MergeRequests.GetMergeRequest(repo, iid, &gitlab.GetMergeRequestsOptions{}, api.WithoutCache())
+		if err != nil {
+			return nil, cmdutils.WrapError(err, fmt.Sprintf("failed to get merge request !%d.", iid))
+		}
+	}
+}
+
+// waitingFor returns what the merge request waits for before it can be merged.
+func waitingFor(mr *gitlab.MergeRequest) []string {
+	if mr.State != "opened" {
+		return nil
+	}
+
+	var waiting []string
+	if mr.Pipeline != nil && pendingPipelineStatuses[mr.Pipeline.Status] {
+		waiting = append(waiting, fmt.Sprintf("pipeline #%d (%s)", mr.Pipeline.ID, mr.Pipeline.Status))
+	}
+	// A pending pipeline is already listed.
+	if what, ok := waitingMergeStatuses[mr.DetailedMergeStatus]; ok && (len(waiting) == 0 || !strings.Contains(what, "pipeline")) {
+		waiting = append(waiting, what)
+	}
+	return waiting
+}
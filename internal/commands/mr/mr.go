@@ -5,6 +5,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	mrApplyCmd "gitlab.com/gitlab-org/cli/internal/commands/mr/apply"
 	mrApproveCmd "gitlab.com/gitlab-org/cli/internal/commands/mr/approve"
 	mrApproversCmd "gitlab.com/gitlab-org/cli/internal/commands/mr/approvers"
 	mrCheckoutCmd "gitlab.com/gitlab-org/cli/internal/commands/mr/checkout"
@@ -14,12 +15,16 @@ import (
 	mrDiffCmd "gitlab.com/gitlab-org/cli/internal/commands/mr/diff"
 	mrForCmd "gitlab.com/gitlab-org/cli/internal/commands/mr/for"
 	mrIssuesCmd "gitlab.com/gitlab-org/cli/internal/commands/mr/issues"
+	mrLintCmd "gitlab.com/gitlab-org/cli/internal/commands/mr/lint"
 	mrListCmd "gitlab.com/gitlab-org/cli/internal/commands/mr/list"
 	mrMergeCmd "gitlab.com/gitlab-org/cli/internal/commands/mr/merge"
 	mrNoteCmd "gitlab.com/gitlab-org/cli/internal/commands/mr/note"
+	mrPatchCmd "gitlab.com/gitlab-org/cli/internal/commands/mr/patch"
 	mrRebaseCmd "gitlab.com/gitlab-org/cli/internal/commands/mr/rebase"
 	mrReopenCmd "gitlab.com/gitlab-org/cli/internal/commands/mr/reopen"
+	mrReviewCmd "gitlab.com/gitlab-org/cli/internal/commands/mr/review"
 	mrRevokeCmd "gitlab.com/gitlab-org/cli/internal/commands/mr/revoke"
+	mrSignoffCmd "gitlab.com/gitlab-org/cli/internal/commands/mr/signoff"
 	mrSubscribeCmd "gitlab.com/gitlab-org/cli/internal/commands/mr/subscribe"
 	mrTodoCmd "gitlab.com/gitlab-org/cli/internal/commands/mr/todo"
 	mrUnsubscribeCmd "gitlab.com/gitlab-org/cli/internal/commands/mr/unsubscribe"
@@ -43,12 +48,18 @@ func NewCmdMR(f cmdutils.Factory) *cobra.Command {
 			- by number, e.g. "123"; or
 			- by the name of its source branch, e.g. "patch-1" or "OWNER:patch-1"; or
 			- by URL, e.g. "https://gitlab.com/gitlab-org/cli/-/merge_requests/123".
+
+			Without an argument, the merge request of the current branch is used, or of the branch
+			given with --ref. On a detached HEAD, the branch of the CI/CD job is used, or else the
+			merge request of the checked-out commit.
 			`),
 		},
 	}
 
 	cmdutils.EnableRepoOverride(mrCmd, f)
+	cmdutils.EnableRefOverride(mrCmd, f)
 
+	mrCmd.AddCommand(mrApplyCmd.NewCmdApply(f))
 	mrCmd.AddCommand(mrApproveCmd.NewCmdApprove(f))
 	mrCmd.AddCommand(mrApproversCmd.NewCmdApprovers(f))
 	mrCmd.AddCommand(mrCheckoutCmd.NewCmdCheckout(f))
@@ -58,12 +69,16 @@ func NewCmdMR(f cmdutils.Factory) *cobra.Command {
 	mrCmd.AddCommand(mrDiffCmd.NewCmdDiff(f, nil))
 	mrCmd.AddCommand(mrForCmd.NewCmdFor(f))
 	mrCmd.AddCommand(mrIssuesCmd.NewCmdIssues(f))
+	mrCmd.AddCommand(mrLintCmd.NewCmdLint(f))
 	mrCmd.AddCommand(mrListCmd.NewCmdList(f, nil))
 	mrCmd.AddCommand(mrMergeCmd.NewCmdMerge(f))
 	mrCmd.AddCommand(mrNoteCmd.NewCmdNote(f))
+	mrCmd.AddCommand(mrPatchCmd.NewCmdPatch(f))
 	mrCmd.AddCommand(mrRebaseCmd.NewCmdRebase(f))
 	mrCmd.AddCommand(mrReopenCmd.NewCmdReopen(f))
+	mrCmd.AddCommand(mrReviewCmd.NewCmdReview(f))
 	mrCmd.AddCommand(mrRevokeCmd.NewCmdRevoke(f))
+	mrCmd.AddCommand(mrSignoffCmd.NewCmdSignoff(f))
 	mrCmd.AddCommand(mrSubscribeCmd.NewCmdSubscribe(f))
 	mrCmd.AddCommand(mrUnsubscribeCmd.NewCmdUnsubscribe(f))
 	mrCmd.AddCommand(mrTodoCmd.NewCmdTodo(f))
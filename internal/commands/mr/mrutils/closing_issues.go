@@ -0,0 +1,77 @@
+package mrutils
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// closingRE matches the default closing pattern of GitLab, like "Closes #12" or
+// "Fixes #12, #34 and #56".
+var closingRE = regexp.MustCompile(`(?i)\b(?:clos(?:e[sd]?|ing)|fix(?:e[sd]|ing)?|resolv(?:e[sd]?|ing)|implement(?:s|ed|ing)?):?\s+((?:#\d+(?:\s*,\s*|\s+and\s+|,\s+and\s+)?)+)`)
+
+// ParseIssueRefs parses issue IDs like "12" or "#12" into references like "#12",
+// without duplicates.
+func ParseIssueRefs(values []string) ([]string, error) {
+	var refs []string
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		iid, err := strconv.ParseInt(strings.TrimPrefix(value, "#"), 10, 64)
+		if err != nil || iid <= 0 {
+			return nil, fmt.Errorf("invalid issue ID %q.", value)
+		}
+		if ref := "#" + strconv.FormatInt(iid, 10); !slices.Contains(refs, ref) {
+			refs = append(refs, ref)
+		}
+	}
+	return refs, nil
+}
+
+// ClosedIssueRefs returns the issues that a description closes when the merge
+// request is merged, like "#12" for "Closes #12".
+func ClosedIssueRefs(description string) []string {
+	var refs []string
+	for _, m := range closingRE.FindAllStringSubmatch(description, -1) {
+		for _, ref := range messageIssueRE.FindAllStringSubmatch(m[1], -1) {
+			if r := "#" + ref[1]; !slices.Contains(refs, r) {
+				refs = append(refs, r)
+			}
+		}
+	}
+	return refs
+}
+
+// UnclosedIssueRefs returns the references that a description doesn't close yet.
+func UnclosedIssueRefs(description string, refs []string) []string {
+	closed := ClosedIssueRefs(description)
+	var unclosed []string
+	for _, ref := range refs {
+		if !slices.Contains(closed, ref) && !slices.Contains(unclosed, ref) {
+			unclosed = append(unclosed, ref)
+		}
+	}
+	return unclosed
+}
+
+// AppendClosingRefs adds a "Closes #12" line to a description for each of the
+// references it doesn't close yet.
+func AppendClosingRefs(description string, refs []string) string {
+	unclosed := UnclosedIssueRefs(description, refs)
+	if len(unclosed) == 0 {
+		return description
+	}
+
+	var b strings.Builder
+	if description = strings.TrimRight(description, "\n"); description != "" {
+		b.WriteString(description + "\n\n")
+	}
+	for i, ref := range unclosed {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString("Closes " + ref)
+	}
+	return b.String()
+}
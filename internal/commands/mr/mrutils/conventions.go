@@ -0,0 +1,251 @@
+package mrutils
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"gitlab.com/gitlab-org/cli/internal/git"
+)
+
+// ConventionsFile is the file in the root of the repository that configures the
+// conventions for merge requests.
+const ConventionsFile = ".glab.yml"
+
+var conventionalCommitRE = regexp.MustCompile(`^([a-z]+)(?:\(([^()]+)\))?(!)?: (\S.*)$`)
+
+// LintRules is the mr_lint section of the conventions file.
+type LintRules struct {
+	// ConventionalTitle requires titles like "feat(scope): description".
+	ConventionalTitle bool `yaml:"conventional_title"`
+	// TitleTypes restricts the types allowed in conventional titles.
+	TitleTypes     []string `yaml:"title_types"`
+	MaxTitleLength int      `yaml:"max_title_length"`
+	// RequiredSections are Markdown headings that the description must contain.
+	RequiredSections   []string `yaml:"required_sections"`
+	RequireLinkedIssue bool     `yaml:"require_linked_issue"`
+	// MaxDiffLines is the maximum number of added and removed lines.
+	MaxDiffLines    int `yaml:"max_diff_lines"`
+	MaxChangedFiles int `yaml:"max_changed_files"`
+}
+
+// DefaultLintRules apply when there is no configuration.
+var DefaultLintRules = LintRules{ConventionalTitle: true}
+
+// LoadLintRules reads the rules from path. With an empty path, the conventions file in
+// the root of the repository is used, if any.
+func LoadLintRules(path string) (*LintRules, error) {
+	if path == "" {
+		dir, err := git.ToplevelDir()
+		if err != nil {
+			r := DefaultLintRules
+			return &r, nil
+		}
+		path = filepath.Join(dir, ConventionsFile)
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) && filepath.Base(path) == ConventionsFile {
+		r := DefaultLintRules
+		return &r, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var cfg struct {
+		Rules *LintRules `yaml:"mr_lint"`
+	}
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("could not parse %s: %w", path, err)
+	}
+	if cfg.Rules == nil {
+		r := DefaultLintRules
+		return &r, nil
+	}
+	return cfg.Rules, nil
+}
+
+// AllowsType reports whether the rules allow the Conventional Commits type.
+func (r *LintRules) AllowsType(commitType string) bool {
+	return len(r.TitleTypes) == 0 || slices.Contains(r.TitleTypes, commitType)
+}
+
+// ConventionalCommit is a commit subject in the Conventional Commits format,
+// "type(scope)!: description".
+type ConventionalCommit struct {
+	Type        string
+	Scope       string
+	Breaking    bool
+	Description string
+}
+
+// ParseConventionalCommit parses a commit subject or merge request title. It returns
+// nil when the subject doesn't follow Conventional Commits.
+func ParseConventionalCommit(subject string) *ConventionalCommit {
+	m := conventionalCommitRE.FindStringSubmatch(subject)
+	if m == nil {
+		return nil
+	}
+	return &ConventionalCommit{Type: m[1], Scope: m[2], Breaking: m[3] != "", Description: m[4]}
+}
+
+// UnconventionalCommits returns the commits whose subjects don't follow the conventions
+// of the rules. It returns nil when the rules don't require Conventional Commits.
+func UnconventionalCommits(commits []*git.Commit, r *LintRules) []*git.Commit {
+	if !r.ConventionalTitle {
+		return nil
+	}
+
+	var bad []*git.Commit
+	for _, commit := range commits {
+		if cc := ParseConventionalCommit(commit.Title); cc == nil || !r.AllowsType(cc.Type) {
+			bad = append(bad, commit)
+		}
+	}
+	return bad
+}
+
+type commitGroup struct {
+	commitType string
+	heading    string
+}
+
+// commitGroups are the headings of the Conventional Commits types, in the order they
+// are listed in merge request descriptions.
+var commitGroups = []commitGroup{
+	{"feat", "Features"},
+	{"fix", "Bug fixes"},
+	{"perf", "Performance improvements"},
+	{"refactor", "Refactoring"},
+	{"docs", "Documentation"},
+	{"test", "Tests"},
+	{"build", "Build system"},
+	{"ci", "CI/CD"},
+	{"style", "Style"},
+	{"chore", "Chores"},
+	{"revert", "Reverts"},
+}
+
+// GenerateGroupedMRBody creates a Markdown description that lists the commits under a
+// heading for each Conventional Commits type. Commits that don't follow the convention
+// are listed under "Other changes".
+func GenerateGroupedMRBody(commits []*git.Commit, fillCommitBody bool) (string, error) {
+	groups := map[string][]string{}
+	var otherTypes []string
+	for _, commit := range slices.Backward(commits) {
+		commitType, item := "", commit.Title
+		if cc := ParseConventionalCommit(commit.Title); cc != nil {
+			commitType, item = cc.Type, cc.Description
+			if cc.Scope != "" {
+				item = fmt.Sprintf("**%s:** %s", cc.Scope, item)
+			}
+			if cc.Breaking {
+				item = "**BREAKING:** " + item
+			}
+		}
+
+		if fillCommitBody {
+			commitBody, err := git.CommitBody(commit.Sha)
+			if err != nil {
+				return "", fmt.Errorf("failed to get commit message for %s: %w", commit.Sha, err)
+			}
+			if body := strings.TrimSpace(commitBody); body != "" {
+				item += "\n\n  " + strings.ReplaceAll(body, "\n", "\n  ")
+			}
+		}
+
+		if _, seen := groups[commitType]; !seen && commitType != "" && !isKnownType(commitType) {
+			otherTypes = append(otherTypes, commitType)
+		}
+		groups[commitType] = append(groups[commitType], item)
+	}
+
+	sections := slices.Clone(commitGroups)
+	slices.Sort(otherTypes)
+	for _, t := range otherTypes {
+		sections = append(sections, commitGroup{commitType: t, heading: t})
+	}
+	sections = append(sections, commitGroup{heading: "Other changes"})
+
+	var body strings.Builder
+	for _, s := range sections {
+		items := groups[s.commitType]
+		if len(items) == 0 {
+			continue
+		}
+		if body.Len() > 0 {
+			body.WriteString("\n")
+		}
+		fmt.Fprintf(&body, "### %s\n\n", s.heading)
+		for _, item := range items {
+			fmt.Fprintf(&body, "- %s\n", item)
+		}
+	}
+	return body.String(), nil
+}
+
+func isKnownType(commitType string) bool {
+	return slices.ContainsFunc(commitGroups, func(g commitGroup) bool {
+		return g.commitType == commitType
+	})
+}
+
+// FirstConventionalSubject returns the subject of the oldest commit that follows
+// Conventional Commits, or an empty string when none does. Commits are listed newest
+// first, like git.Commits returns them.
+func FirstConventionalSubject(commits []*git.Commit) string {
+	for _, commit := range slices.Backward(commits) {
+		if ParseConventionalCommit(commit.Title) != nil {
+			return commit.Title
+		}
+	}
+	return ""
+}
+
+// LabelRules maps Conventional Commits types to merge request labels.
+type LabelRules map[string][]string
+
+// ParseLabelRules parses the mr_label_rules setting: a comma-separated list of
+// type=label rules, like "feat=feature,fix=bug". A type is mapped to several labels
+// by repeating it.
+func ParseLabelRules(s string) (LabelRules, error) {
+	rules := LabelRules{}
+	for rule := range strings.SplitSeq(s, ",") {
+		rule = strings.TrimSpace(rule)
+		if rule == "" {
+			continue
+		}
+		commitType, label, ok := strings.Cut(rule, "=")
+		commitType, label = strings.TrimSpace(commitType), strings.TrimSpace(label)
+		if !ok || commitType == "" || label == "" {
+			return nil, fmt.Errorf("invalid rule %q: expected type=label", rule)
+		}
+		rules[commitType] = append(rules[commitType], label)
+	}
+	return rules, nil
+}
+
+// Labels returns the labels mapped from the types of the commits, without duplicates,
+// in the order the types first appear from the oldest commit.
+func (r LabelRules) Labels(commits []*git.Commit) []string {
+	var labels []string
+	for _, commit := range slices.Backward(commits) {
+		cc := ParseConventionalCommit(commit.Title)
+		if cc == nil {
+			continue
+		}
+		for _, label := range r[cc.Type] {
+			if !slices.Contains(labels, label) {
+				labels = append(labels, label)
+			}
+		}
+	}
+	return labels
+}
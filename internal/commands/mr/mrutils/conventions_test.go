@@ -0,0 +1,123 @@
+//go:build !integration
+
+package mrutils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/git"
+)
+
+func TestLoadLintRules(t *testing.T) {
+	dir := t.TempDir()
+
+	r, err := LoadLintRules(filepath.Join(dir, ConventionsFile))
+	require.NoError(t, err)
+	assert.Equal(t, &DefaultLintRules, r)
+
+	path := filepath.Join(dir, "rules.yml")
+	require.NoError(t, os.WriteFile(path, []byte("mr_lint:\n  required_sections: [Summary]\n  max_diff_lines: 10\n"), 0o644))
+	r, err = LoadLintRules(path)
+	require.NoError(t, err)
+	assert.Equal(t, &LintRules{RequiredSections: []string{"Summary"}, MaxDiffLines: 10}, r)
+
+	require.NoError(t, os.WriteFile(path, []byte("other: true\n"), 0o644))
+	r, err = LoadLintRules(path)
+	require.NoError(t, err)
+	assert.Equal(t, &DefaultLintRules, r)
+
+	_, err = LoadLintRules(filepath.Join(dir, "missing.yml"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestParseConventionalCommit(t *testing.T) {
+	assert.Equal(t, &ConventionalCommit{Type: "feat", Scope: "mr", Breaking: true, Description: "add lint"}, ParseConventionalCommit("feat(mr)!: add lint"))
+	assert.Equal(t, &ConventionalCommit{Type: "fix", Description: "handle nil"}, ParseConventionalCommit("fix: handle nil"))
+	assert.Nil(t, ParseConventionalCommit("Fix: handle nil"))
+	assert.Nil(t, ParseConventionalCommit("Update README"))
+}
+
+func TestUnconventionalCommits(t *testing.T) {
+	commits := []*git.Commit{
+		{Sha: "1", Title: "feat: add lint"},
+		{Sha: "2", Title: "Update README"},
+		{Sha: "3", Title: "chore: bump deps"},
+	}
+
+	got := UnconventionalCommits(commits, &LintRules{ConventionalTitle: true, TitleTypes: []string{"feat", "fix"}})
+	assert.Equal(t, []*git.Commit{commits[1], commits[2]}, got)
+
+	assert.Empty(t, UnconventionalCommits(commits, &LintRules{}))
+}
+
+func TestGenerateGroupedMRBody(t *testing.T) {
+	// Commits are listed newest first, like git.Commits returns them.
+	commits := []*git.Commit{
+		{Sha: "5", Title: "Update README"},
+		{Sha: "4", Title: "deps: bump yaml"},
+		{Sha: "3", Title: "fix(api): handle nil projects"},
+		{Sha: "2", Title: "feat!: drop the legacy flag"},
+		{Sha: "1", Title: "feat(mr): add lint"},
+	}
+
+	body, err := GenerateGroupedMRBody(commits, false)
+	require.NoError(t, err)
+	assert.Equal(t, `### Features
+
+- **mr:** add lint
+- **BREAKING:** drop the legacy flag
+
+### Bug fixes
+
+- **api:** handle nil projects
+
+### deps
+
+- bump yaml
+
+### Other changes
+
+- Update README
+`, body)
+}
+
+func TestFirstConventionalSubject(t *testing.T) {
+	// Commits are listed newest first, like git.Commits returns them.
+	commits := []*git.Commit{
+		{Sha: "3", Title: "fix(api): handle nil"},
+		{Sha: "2", Title: "feat: add lint"},
+		{Sha: "1", Title: "Update README"},
+	}
+	assert.Equal(t, "feat: add lint", FirstConventionalSubject(commits))
+	assert.Empty(t, FirstConventionalSubject(commits[2:]))
+}
+
+func TestParseLabelRules(t *testing.T) {
+	rules, err := ParseLabelRules(" feat=feature, fix=bug,fix=type::fix,,")
+	require.NoError(t, err)
+	assert.Equal(t, LabelRules{"feat": {"feature"}, "fix": {"bug", "type::fix"}}, rules)
+
+	rules, err = ParseLabelRules("")
+	require.NoError(t, err)
+	assert.Empty(t, rules)
+
+	_, err = ParseLabelRules("feat=feature,fix")
+	assert.EqualError(t, err, `invalid rule "fix": expected type=label`)
+}
+
+func TestLabelRules_Labels(t *testing.T) {
+	rules := LabelRules{"feat": {"feature"}, "fix": {"bug", "type::fix"}, "docs": {"documentation"}}
+	commits := []*git.Commit{
+		{Sha: "4", Title: "fix: handle empty list"},
+		{Sha: "3", Title: "chore: tidy"},
+		{Sha: "2", Title: "Update README"},
+		{Sha: "1", Title: "fix(api)!: handle nil"},
+	}
+	assert.Equal(t, []string{"bug", "type::fix"}, rules.Labels(commits))
+	assert.Empty(t, LabelRules{}.Labels(commits))
+}
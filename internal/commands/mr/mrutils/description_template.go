@@ -0,0 +1,83 @@
+package mrutils
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"text/template"
+
+	"gitlab.com/gitlab-org/cli/internal/git"
+)
+
+var (
+	branchIssueRE  = regexp.MustCompile(`^(\d+)-`)
+	messageIssueRE = regexp.MustCompile(`(?:^|[\s(])#(\d+)\b`)
+)
+
+// DescriptionTemplateData is the data available to the placeholders of the
+// templates of merge request descriptions, like {{.SourceBranch}}.
+type DescriptionTemplateData struct {
+	SourceBranch string
+	TargetBranch string
+	Commits      TemplateCommits
+	IssueRefs    TemplateIssueRefs
+}
+
+// TemplateCommits are the commits of a merge request, oldest first. In a
+// template, they're shown as a Markdown list of their titles.
+type TemplateCommits []*git.Commit
+
+func (c TemplateCommits) String() string {
+	var b strings.Builder
+	for _, commit := range c {
+		b.WriteString("- " + commit.Title + "\n")
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// TemplateIssueRefs are references to issues, like #12. In a template, they're
+// shown separated by commas.
+type TemplateIssueRefs []string
+
+func (r TemplateIssueRefs) String() string {
+	return strings.Join(r, ", ")
+}
+
+// IssueRefs returns the issues referenced by the name of a branch, like
+// 12-fix-login, and by commit messages, in order of appearance.
+func IssueRefs(branch string, messages []string) []string {
+	var refs []string
+	add := func(iid string) {
+		if ref := "#" + iid; !slices.Contains(refs, ref) {
+			refs = append(refs, ref)
+		}
+	}
+
+	if m := branchIssueRE.FindStringSubmatch(branch); m != nil {
+		add(m[1])
+	}
+	for _, message := range messages {
+		for _, m := range messageIssueRE.FindAllStringSubmatch(message, -1) {
+			add(m[1])
+		}
+	}
+	return refs
+}
+
+// RenderDescriptionTemplate replaces the placeholders of a description template,
+// which uses the syntax of Go templates.
+func RenderDescriptionTemplate(content string, data DescriptionTemplateData) (string, error) {
+	if !strings.Contains(content, "{{") {
+		return content, nil
+	}
+
+	tmpl, err := template.New("description").Option("missingkey=error").Parse(content)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
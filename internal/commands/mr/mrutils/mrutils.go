@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"regexp"
 	"slices"
 	"sort"
@@ -128,8 +129,9 @@ func MRState(c *iostreams.ColorPalette, m *gitlab.BasicMergeRequest) string {
 
 func DisplayAllMRs(streams *iostreams.IOStreams, mrs []*gitlab.BasicMergeRequest) string {
 	c := streams.Color()
-	table := tableprinter.NewTablePrinter()
+	table := tableprinter.New(streams)
 	table.SetIsTTY(streams.IsOutputTTY())
+	table.SetWrapColumns(2)
 	for _, m := range mrs {
 		table.AddCell(streams.Hyperlink(MRState(c, m), m.WebURL))
 		table.AddCell(m.References.Full)
@@ -194,7 +196,14 @@ func MRFromArgsWithOpts(
 
 	if branch == "" && mrID == 0 {
 		branch, err = f.Branch()
-		if err != nil {
+		if errors.Is(err, git.ErrNotOnAnyBranch) {
+			// Without a branch, use the merge request of the checked-out commit.
+			basicMR, err := GetMRForCommit(client, baseRepo, state)
+			if err != nil {
+				return nil, nil, err
+			}
+			mrID = int(basicMR.IID)
+		} else if err != nil {
 			return nil, nil, err
 		}
 	}
@@ -214,6 +223,24 @@ func MRFromArgsWithOpts(
 	return mr, baseRepo, nil
 }
 
+// IDsFromStdin returns the merge request IDs read from in when args is "-", like the
+// output of `glab mr list --output ids`, and args otherwise.
+func IDsFromStdin(args []string, in io.Reader) ([]string, error) {
+	if len(args) != 1 || args[0] != "-" {
+		return args, nil
+	}
+
+	b, err := io.ReadAll(in)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read merge request IDs from standard input: %w", err)
+	}
+	ids := strings.Fields(string(b))
+	if len(ids) == 0 {
+		return nil, errors.New("no merge request IDs on standard input.")
+	}
+	return ids, nil
+}
+
 func MRsFromArgs(f cmdutils.Factory, args []string, state string) ([]*gitlab.MergeRequest, glrepo.Interface, error) {
 	if len(args) <= 1 {
 		var arrIDs []string
@@ -329,6 +356,24 @@ var GetMRForBranch = func(ios *iostreams.IOStreams, apiClient *gitlab.Client, mr
 	return mrMap[pickedMR], nil
 }
 
+// GetMRForCommit returns the merge request of the checked-out commit, for a
+// detached HEAD.
+var GetMRForCommit = func(apiClient *gitlab.Client, repo glrepo.Interface, state string) (*gitlab.BasicMergeRequest, error) {
+	sha, err := git.HeadSHA()
+	if err != nil {
+		return nil, err
+	}
+
+	mr, err := api.MergeRequestForCommit(apiClient, repo.FullName(), sha, state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the merge requests of commit %s: %w", sha, err)
+	}
+	if mr == nil {
+		return nil, fmt.Errorf("not on any branch, and no merge request found for commit %s.", sha)
+	}
+	return mr, nil
+}
+
 func RebaseMR(ios *iostreams.IOStreams, apiClient *gitlab.Client, repo glrepo.Interface, mr *gitlab.MergeRequest, rebaseOpts *gitlab.RebaseMergeRequestOptions) error {
 	ios.StartSpinner("Sending rebase request...")
 	_, err := apiClient.MergeRequests.RebaseMergeRequest(repo.FullName(), mr.IID, rebaseOpts)
@@ -378,7 +423,7 @@ func PrintMRApprovalState(ios *iostreams.IOStreams, mrApprovals *gitlab.MergeReq
 		fmt.Fprintln(ios.StdOut, c.Yellow("Approval rules overwritten."))
 	}
 	for _, rule := range mrApprovals.Rules {
-		table := tableprinter.NewTablePrinter()
+		table := tableprinter.New(ios)
 		if rule.Approved {
 			fmt.Fprintln(ios.StdOut, c.Green(fmt.Sprintf("Rule %q sufficient approvals (%d/%d required):", rule.Name, len(rule.ApprovedBy), rule.ApprovalsRequired)))
 		} else {
@@ -392,7 +437,7 @@ func PrintMRApprovalState(ios *iostreams.IOStreams, mrApprovals *gitlab.MergeReq
 			approvedBy[by.Username] = by
 		}
 
-		table.AddRow("Name", "Username", "Approved")
+		table.AddHeader("Name", "Username", "Approved")
 		for _, eligibleApprover := range eligibleApprovers {
 			approved := "-"
 			source := ""
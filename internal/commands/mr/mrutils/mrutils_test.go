@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/survivorbat/huhtest"
 
 	gitlab "gitlab.com/gitlab-org/api/client-go"
@@ -584,6 +585,27 @@ func Test_MRFromArgsWithOpts(t *testing.T) {
 			assert.Equal(t, "test mr", gotMR.Title)
 			assert.Equal(t, "main", gotMR.SourceBranch)
 		})
+		t.Run("via-commit", func(t *testing.T) {
+			f := cmdtest.NewTestFactory(ios,
+				cmdtest.WithBaseRepo("foo", "bar", ""),
+				cmdtest.WithBranchError(fmt.Errorf("could not determine current branch: %w", git.ErrNotOnAnyBranch)),
+			)
+			GetMRForCommit = func(_ *gitlab.Client, repo glrepo.Interface, state string) (*gitlab.BasicMergeRequest, error) {
+				assert.Equal(t, "foo/bar", repo.FullName())
+				assert.Equal(t, "opened", state)
+				return &gitlab.BasicMergeRequest{IID: 3}, nil
+			}
+			api.GetMR = func(client *gitlab.Client, projectID any, mrID int64, opts *gitlab.GetMergeRequestsOptions) (*gitlab.MergeRequest, error) {
+				assert.Equal(t, int64(3), mrID)
+				return &gitlab.MergeRequest{
+					BasicMergeRequest: gitlab.BasicMergeRequest{IID: 3, Title: "detached mr"},
+				}, nil
+			}
+
+			gotMR, _, err := MRFromArgs(f, []string{}, "opened")
+			require.NoError(t, err)
+			assert.Equal(t, "detached mr", gotMR.Title)
+		})
 		t.Run("via-URL", func(t *testing.T) {
 			api.GetMR = func(client *gitlab.Client, projectID any, mrID int64, opts *gitlab.GetMergeRequestsOptions) (*gitlab.MergeRequest, error) {
 				// Verify the correct MR ID from the URL is being used
@@ -1139,3 +1161,116 @@ User One	aaa	👍
 		})
 	}
 }
+
+func TestTitleSlug(t *testing.T) {
+	assert.Equal(t, "fix-the-login-page-on-safari", TitleSlug("Fix the login page on Safari!"))
+	assert.Equal(t, "draft-add-api-v2", TitleSlug("Draft: Add API v2"))
+	assert.Equal(t, "a-very-long-title-that-is-longer-than-fifty-two-char", TitleSlug("A very long title that is longer than fifty-two characters"))
+	assert.Equal(t, "", TitleSlug("!!!"))
+}
+
+func TestAppendTrailers(t *testing.T) {
+	reviewed := "Reviewed-by: Jane Doe <jane@example.com>"
+	acked := "Acked-by: Bob <bob@example.com>"
+
+	tests := []struct {
+		name     string
+		message  string
+		trailers []string
+		want     string
+	}{
+		{
+			name:     "subject only",
+			message:  "Fix login\n",
+			trailers: []string{reviewed, acked},
+			want:     "Fix login\n\n" + reviewed + "\n" + acked,
+		},
+		{
+			name:     "body",
+			message:  "Fix login\n\nThe form was submitted twice.",
+			trailers: []string{reviewed},
+			want:     "Fix login\n\nThe form was submitted twice.\n\n" + reviewed,
+		},
+		{
+			name:     "existing trailers",
+			message:  "Fix login\n\nSigned-off-by: Jane Doe <jane@example.com>",
+			trailers: []string{reviewed},
+			want:     "Fix login\n\nSigned-off-by: Jane Doe <jane@example.com>\n" + reviewed,
+		},
+		{
+			name:     "trailers already present",
+			message:  "Fix login\n\n" + reviewed,
+			trailers: []string{reviewed, reviewed},
+			want:     "Fix login\n\n" + reviewed,
+		},
+		{
+			name:     "no trailers",
+			message:  "Fix login",
+			trailers: nil,
+			want:     "Fix login",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, AppendTrailers(tc.message, tc.trailers))
+		})
+	}
+}
+
+func TestIssueRefs(t *testing.T) {
+	assert.Equal(t, []string{"#12", "#34", "#5"}, IssueRefs("12-fix-login", []string{
+		"Fix the login form (#34)",
+		"Closes #5 and #12",
+		"Bump version to 1.2#3",
+	}))
+	assert.Empty(t, IssueRefs("fix-login", nil))
+}
+
+func TestParseIssueRefs(t *testing.T) {
+	refs, err := ParseIssueRefs([]string{"12", "#34", " 12 "})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"#12", "#34"}, refs)
+
+	_, err = ParseIssueRefs([]string{"12", "gitlab#34"})
+	assert.EqualError(t, err, `invalid issue ID "gitlab#34".`)
+}
+
+func TestClosedIssueRefs(t *testing.T) {
+	assert.Equal(t, []string{"#12", "#34", "#56", "#7"}, ClosedIssueRefs(
+		"Fixes #12, #34 and #56.\n\nRelated to #8.\nresolves: #7"))
+	assert.Empty(t, ClosedIssueRefs("See #12"))
+}
+
+func TestAppendClosingRefs(t *testing.T) {
+	assert.Equal(t, "Fix the login.\n\nCloses #12\nCloses #34",
+		AppendClosingRefs("Fix the login.\n", []string{"#12", "#34"}))
+	assert.Equal(t, "Closes #12\n\nCloses #34",
+		AppendClosingRefs("Closes #12", []string{"#12", "#34", "#34"}))
+	assert.Equal(t, "Closes #12", AppendClosingRefs("", []string{"#12"}))
+	assert.Equal(t, "Fixes #12", AppendClosingRefs("Fixes #12", []string{"#12"}))
+}
+
+func TestRenderDescriptionTemplate(t *testing.T) {
+	data := DescriptionTemplateData{
+		SourceBranch: "12-fix-login",
+		TargetBranch: "main",
+		Commits:      TemplateCommits{{Sha: "deadbeef", Title: "Fix the login form"}, {Sha: "deadb00f", Title: "Add a test"}},
+		IssueRefs:    TemplateIssueRefs{"#12", "#34"},
+	}
+
+	got, err := RenderDescriptionTemplate("{{.SourceBranch}} -> {{.TargetBranch}}\n\n{{.Commits}}\n\nCloses {{.IssueRefs}}", data)
+	require.NoError(t, err)
+	assert.Equal(t, "12-fix-login -> main\n\n- Fix the login form\n- Add a test\n\nCloses #12, #34", got)
+
+	got, err = RenderDescriptionTemplate("{{range .Commits}}* {{.Sha}}\n{{end}}", data)
+	require.NoError(t, err)
+	assert.Equal(t, "* deadbeef\n* deadb00f\n", got)
+
+	got, err = RenderDescriptionTemplate("No placeholders, just text.", data)
+	require.NoError(t, err)
+	assert.Equal(t, "No placeholders, just text.", got)
+
+	_, err = RenderDescriptionTemplate("{{.Milestone}}", data)
+	require.Error(t, err)
+}
@@ -0,0 +1,21 @@
+package mrutils
+
+import (
+	"regexp"
+	"strings"
+)
+
+var nonSlugRE = regexp.MustCompile(`[^a-z0-9]+`)
+
+// maxSlugLength is the length git format-patch truncates the names of patches to.
+const maxSlugLength = 52
+
+// TitleSlug returns a title as lowercase words joined with dashes, for use in
+// file and branch names.
+func TitleSlug(title string) string {
+	slug := strings.Trim(nonSlugRE.ReplaceAllString(strings.ToLower(title), "-"), "-")
+	if len(slug) > maxSlugLength {
+		slug = strings.TrimRight(slug[:maxSlugLength], "-")
+	}
+	return slug
+}
@@ -0,0 +1,85 @@
+package mrutils
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+)
+
+var (
+	signoffNoteRE = regexp.MustCompile(`^(Reviewed-by|Acked-by|Tested-by): (.+?) <[^<>\s]+>$`)
+	trailerLineRE = regexp.MustCompile(`^[A-Za-z0-9-]+: \S`)
+)
+
+// SignoffTrailer returns a trailer like "Reviewed-by: Jane Doe <jane@example.com>".
+func SignoffTrailer(key, name, email string) string {
+	return key + ": " + name + " <" + email + ">"
+}
+
+// ListSignoffTrailers returns the trailers recorded as comments on a merge
+// request, oldest first. A comment is a sign-off when its whole body is a
+// trailer with the name of the author of the comment.
+func ListSignoffTrailers(client *gitlab.Client, repo string, mrIID int64) ([]string, error) {
+	notes, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Note, *gitlab.Response, error) {
+		return client.Notes.ListMergeRequestNotes(repo, mrIID, &gitlab.ListMergeRequestNotesOptions{
+			ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage},
+			OrderBy:     gitlab.Ptr("created_at"),
+			Sort:        gitlab.Ptr("asc"),
+		}, p)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var trailers []string
+	for _, note := range notes {
+		if note.System {
+			continue
+		}
+		body := strings.TrimSpace(note.Body)
+		m := signoffNoteRE.FindStringSubmatch(body)
+		if m == nil || m[2] != note.Author.Name || slices.Contains(trailers, body) {
+			continue
+		}
+		trailers = append(trailers, body)
+	}
+	return trailers, nil
+}
+
+// AppendTrailers appends the trailers missing from a commit message to its
+// last paragraph, when it's made of trailers, or to a new paragraph.
+func AppendTrailers(message string, trailers []string) string {
+	message = strings.TrimRight(message, "\n")
+	lines := strings.Split(message, "\n")
+
+	var missing []string
+	for _, trailer := range trailers {
+		if !slices.Contains(lines, trailer) && !slices.Contains(missing, trailer) {
+			missing = append(missing, trailer)
+		}
+	}
+	if len(missing) == 0 {
+		return message
+	}
+
+	// The subject of a message is never a trailer.
+	inTrailers := false
+	if i := strings.LastIndex(message, "\n\n"); i >= 0 {
+		inTrailers = true
+		for _, line := range strings.Split(message[i+2:], "\n") {
+			if !trailerLineRE.MatchString(line) {
+				inTrailers = false
+				break
+			}
+		}
+	}
+
+	if inTrailers {
+		return message + "\n" + strings.Join(missing, "\n")
+	}
+	return message + "\n\n" + strings.Join(missing, "\n")
+}
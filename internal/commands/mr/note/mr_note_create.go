@@ -28,8 +28,15 @@ func NewCmdNote(f cmdutils.Factory) *cobra.Command {
 			# Add a comment to the merge request for the current branch
 			$ glab mr note -m "LGTM"
 
+			# Add a comment read from a file, or from standard input
+			$ glab mr note 123 --body-file review.md
+			$ generate-review | glab mr note 123 --body-file -
+
 			# Open your editor to compose a multi-line comment
-			$ glab mr note 123`),
+			$ glab mr note 123
+
+			# Add a pending draft note, published later with the other drafts by "glab mr review publish"
+			$ glab mr note 123 --draft -m "Consider renaming this function."`),
 		Args: cobra.MaximumNArgs(1),
 		Annotations: map[string]string{
 			mcpannotations.Destructive: "true",
@@ -45,6 +52,9 @@ func NewCmdNote(f cmdutils.Factory) *cobra.Command {
 				return err
 			}
 
+			if err := cmdutils.ApplyBodyFile(cmd, f.IO(), "message"); err != nil {
+				return err
+			}
 			body, _ := cmd.Flags().GetString("message")
 
 			if strings.TrimSpace(body) == "" {
@@ -62,6 +72,16 @@ func NewCmdNote(f cmdutils.Factory) *cobra.Command {
 				return fmt.Errorf("aborted... Note has an empty message.")
 			}
 
+			if draft, _ := cmd.Flags().GetBool("draft"); draft {
+				draftNote, _, err := client.DraftNotes.CreateDraftNote(repo.FullName(), mr.IID, &gitlab.CreateDraftNoteOptions{Note: &body})
+				if err != nil {
+					return err
+				}
+
+				fmt.Fprintf(f.IO().StdOut, "%s Added draft note %d to !%d. Publish your drafts with `glab mr review publish %d`.\n", f.IO().Color().GreenCheck(), draftNote.ID, mr.IID, mr.IID)
+				return nil
+			}
+
 			uniqueNoteEnabled, _ := cmd.Flags().GetBool("unique")
 
 			if uniqueNoteEnabled {
@@ -89,6 +109,9 @@ func NewCmdNote(f cmdutils.Factory) *cobra.Command {
 	}
 
 	mrCreateNoteCmd.Flags().StringP("message", "m", "", "Comment or note message.")
+	cmdutils.AddBodyFileFlag(mrCreateNoteCmd, "message")
 	mrCreateNoteCmd.Flags().Bool("unique", false, "Don't create a comment or note if it already exists.")
+	mrCreateNoteCmd.Flags().Bool("draft", false, "Add the note as a pending draft, visible only to you until published with `glab mr review publish`.")
+	mrCreateNoteCmd.MarkFlagsMutuallyExclusive("draft", "unique")
 	return mrCreateNoteCmd
 }
@@ -5,6 +5,8 @@ package note
 import (
 	"errors"
 	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/spf13/cobra"
@@ -71,6 +73,72 @@ func Test_NewCmdNote(t *testing.T) {
 		assert.Equal(t, "https://gitlab.com/OWNER/REPO/merge_requests/1#note_301\n", output.String())
 	})
 
+	t.Run("--body-file flag specified", func(t *testing.T) {
+		t.Parallel()
+
+		bodyFile := filepath.Join(t.TempDir(), "note.md")
+		require.NoError(t, os.WriteFile(bodyFile, []byte("Note from a file\n"), 0o600))
+
+		testClient := gitlabtesting.NewTestClient(t)
+		testClient.MockMergeRequests.EXPECT().
+			GetMergeRequest("OWNER/REPO", int64(1), gomock.Any()).
+			Return(&gitlab.MergeRequest{
+				BasicMergeRequest: gitlab.BasicMergeRequest{
+					ID:     1,
+					IID:    1,
+					WebURL: "https://gitlab.com/OWNER/REPO/merge_requests/1",
+				},
+			}, nil, nil)
+		testClient.MockNotes.EXPECT().
+			CreateMergeRequestNote("OWNER/REPO", int64(1), gomock.Any()).
+			DoAndReturn(func(pid any, mrIID int64, opts *gitlab.CreateMergeRequestNoteOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Note, *gitlab.Response, error) {
+				assert.Equal(t, "Note from a file\n", *opts.Body)
+				return &gitlab.Note{ID: 302, NoteableID: 1, NoteableType: "MergeRequest", NoteableIID: 1}, nil, nil
+			})
+
+		exec := cmdtest.SetupCmdForTest(t, func(f cmdutils.Factory) *cobra.Command {
+			return NewCmdNote(f)
+		}, true,
+			cmdtest.WithGitLabClient(testClient.Client),
+			cmdtest.WithBaseRepo("OWNER", "REPO", ""),
+			cmdtest.WithConfig(config.NewFromString("editor: vi")),
+		)
+
+		output, err := exec("1 --body-file " + bodyFile)
+		require.NoError(t, err)
+		assert.Equal(t, "https://gitlab.com/OWNER/REPO/merge_requests/1#note_302\n", output.String())
+	})
+
+	t.Run("--draft flag specified", func(t *testing.T) {
+		t.Parallel()
+
+		testClient := gitlabtesting.NewTestClient(t)
+		testClient.MockMergeRequests.EXPECT().
+			GetMergeRequest("OWNER/REPO", int64(1), gomock.Any()).
+			Return(&gitlab.MergeRequest{
+				BasicMergeRequest: gitlab.BasicMergeRequest{
+					ID:     1,
+					IID:    1,
+					WebURL: "https://gitlab.com/OWNER/REPO/merge_requests/1",
+				},
+			}, nil, nil)
+		testClient.MockDraftNotes.EXPECT().
+			CreateDraftNote("OWNER/REPO", int64(1), &gitlab.CreateDraftNoteOptions{Note: gitlab.Ptr("Draft note")}).
+			Return(&gitlab.DraftNote{ID: 41}, nil, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, func(f cmdutils.Factory) *cobra.Command {
+			return NewCmdNote(f)
+		}, false,
+			cmdtest.WithGitLabClient(testClient.Client),
+			cmdtest.WithBaseRepo("OWNER", "REPO", ""),
+			cmdtest.WithConfig(config.NewFromString("editor: vi")),
+		)
+
+		output, err := exec(`1 --draft -m "Draft note"`)
+		require.NoError(t, err)
+		assert.Equal(t, "✓ Added draft note 41 to !1. Publish your drafts with `glab mr review publish 1`.\n", output.String())
+	})
+
 	t.Run("merge request not found", func(t *testing.T) {
 		t.Parallel()
 
@@ -0,0 +1,103 @@
+package patch
+
+import (
+	"fmt"
+	"mime"
+	"strings"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// mboxDate is the date of the "From" line separating the patches of a mailbox,
+// the same fixed date git format-patch uses.
+const mboxDate = "Mon Sep 17 00:00:00 2001"
+
+// formatPatch formats a commit and its diffs as an email-style patch, which
+// git am can apply. n and total number the patch in its series.
+func formatPatch(commit *gitlab.Commit, diffs []*gitlab.Diff, n, total int) (string, error) {
+	var b strings.Builder
+
+	subject, body := splitMessage(commit.Message)
+	if subject == "" {
+		subject = commit.Title
+	}
+	prefix := "[PATCH]"
+	if total > 1 {
+		prefix = fmt.Sprintf("[PATCH %d/%d]", n, total)
+	}
+
+	date := time.Time{}
+	if commit.AuthoredDate != nil {
+		date = *commit.AuthoredDate
+	}
+
+	fmt.Fprintf(&b, "From %s %s\n", commit.ID, mboxDate)
+	fmt.Fprintf(&b, "From: %s <%s>\n", mime.QEncoding.Encode("utf-8", commit.AuthorName), commit.AuthorEmail)
+	fmt.Fprintf(&b, "Date: %s\n", date.Format(time.RFC1123Z))
+	fmt.Fprintf(&b, "Subject: %s %s\n", prefix, mime.QEncoding.Encode("utf-8", subject))
+	b.WriteString("MIME-Version: 1.0\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\n")
+	b.WriteString("\n")
+	if body != "" {
+		b.WriteString(body + "\n\n")
+	}
+	b.WriteString("---\n\n")
+
+	for _, d := range diffs {
+		if strings.HasPrefix(d.Diff, "Binary files ") {
+			return "", fmt.Errorf("commit %s changes the binary file %s, which can't be exported as a patch.", commit.ShortID, d.NewPath)
+		}
+		b.WriteString(fileHeader(d))
+		if d.Diff != "" {
+			b.WriteString(d.Diff)
+			if !strings.HasSuffix(d.Diff, "\n") {
+				b.WriteString("\n")
+			}
+		}
+	}
+
+	b.WriteString("-- \nglab\n\n")
+	return b.String(), nil
+}
+
+// fileHeader returns the git diff header of a file, which the API doesn't include.
+func fileHeader(d *gitlab.Diff) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "diff --git a/%s b/%s\n", d.OldPath, d.NewPath)
+	switch {
+	case d.NewFile:
+		fmt.Fprintf(&b, "new file mode %s\n", d.BMode)
+	case d.DeletedFile:
+		fmt.Fprintf(&b, "deleted file mode %s\n", d.AMode)
+	case d.AMode != d.BMode:
+		fmt.Fprintf(&b, "old mode %s\nnew mode %s\n", d.AMode, d.BMode)
+	}
+	if d.RenamedFile {
+		fmt.Fprintf(&b, "rename from %s\nrename to %s\n", d.OldPath, d.NewPath)
+	}
+
+	if d.Diff == "" {
+		return b.String()
+	}
+
+	oldPath, newPath := "a/"+d.OldPath, "b/"+d.NewPath
+	if d.NewFile {
+		oldPath = "/dev/null"
+	}
+	if d.DeletedFile {
+		newPath = "/dev/null"
+	}
+	fmt.Fprintf(&b, "--- %s\n+++ %s\n", oldPath, newPath)
+	return b.String()
+}
+
+// splitMessage splits a commit message into its subject, the first paragraph
+// joined in a line, and its body.
+func splitMessage(message string) (subject, body string) {
+	message = strings.TrimSpace(strings.ReplaceAll(message, "\r\n", "\n"))
+	subject, body, _ = strings.Cut(message, "\n\n")
+	return strings.Join(strings.Fields(subject), " "), strings.TrimSpace(body)
+}
@@ -0,0 +1,144 @@
+package patch
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/mr/mrutils"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	args      []string
+	outputDir string
+
+	io      *iostreams.IOStreams
+	factory cmdutils.Factory
+}
+
+func NewCmdPatch(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:      f.IO(),
+		factory: f,
+	}
+
+	mrPatchCmd := &cobra.Command{
+		Use:   "patch [<id> | <branch>] [flags]",
+		Short: `Export a merge request as a series of email-style patches.`,
+		Long: heredoc.Doc(`
+			Export the commits of a merge request as a series of patches in mailbox format, one
+			patch per commit, like git format-patch. Merge commits are skipped.
+
+			The patches can be reviewed and applied without access to GitLab, with git am, or
+			turned into a new merge request with glab mr apply.
+
+			Changes to binary files can't be exported.
+		`),
+		Example: heredoc.Doc(`
+			# Write the patches to standard output
+			$ glab mr patch 123 > mr-123.mbox
+
+			# Write one file per patch
+			$ glab mr patch 123 --output-dir patches/
+
+			# Apply the patches to the current branch
+			$ glab mr patch 123 | git am
+		`),
+		Args: cobra.MaximumNArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.args = args
+
+			return opts.run()
+		},
+	}
+
+	mrPatchCmd.Flags().StringVarP(&opts.outputDir, "output-dir", "o", "", "Write each patch to a numbered file in this directory, instead of standard output.")
+
+	return mrPatchCmd
+}
+
+func (o *options) run() error {
+	client, err := o.factory.GitLabClient()
+	if err != nil {
+		return err
+	}
+
+	mr, repo, err := mrutils.MRFromArgs(o.factory, o.args, "any")
+	if err != nil {
+		return err
+	}
+
+	commits, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Commit, *gitlab.Response, error) {
+		return client.MergeRequests.GetMergeRequestCommits(repo.FullName(), mr.IID, &gitlab.GetMergeRequestCommitsOptions{
+			ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage},
+		}, p)
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to get the commits of the merge request.")
+	}
+
+	// The API lists the newest commits first.
+	slices.Reverse(commits)
+	commits = slices.DeleteFunc(commits, func(c *gitlab.Commit) bool {
+		return len(c.ParentIDs) > 1
+	})
+	if len(commits) == 0 {
+		return fmt.Errorf("merge request !%d has no commits to export.", mr.IID)
+	}
+
+	if o.outputDir != "" {
+		if err := os.MkdirAll(o.outputDir, 0o755); err != nil {
+			return err
+		}
+	}
+
+	for i, commit := range commits {
+		diffs, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Diff, *gitlab.Response, error) {
+			return client.Commits.GetCommitDiff(repo.FullName(), commit.ID, &gitlab.GetCommitDiffOptions{
+				ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage},
+			}, p)
+		})
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to get the diff of commit %s.", commit.ShortID))
+		}
+
+		patch, err := formatPatch(commit, diffs, i+1, len(commits))
+		if err != nil {
+			return err
+		}
+
+		if o.outputDir == "" {
+			fmt.Fprint(o.io.StdOut, patch)
+			continue
+		}
+
+		subject, _ := splitMessage(commit.Message)
+		name := filepath.Join(o.outputDir, fmt.Sprintf("%04d-%s.patch", i+1, mrutils.TitleSlug(subject)))
+		if err := os.WriteFile(name, []byte(patch), 0o644); err != nil {
+			return err
+		}
+		fmt.Fprintln(o.io.StdOut, name)
+	}
+
+	if o.outputDir != "" {
+		patches := "patches"
+		if len(commits) == 1 {
+			patches = "patch"
+		}
+		fmt.Fprintf(o.io.StdErr, "%s Exported %d %s of merge request !%d.\n", o.io.Color().GreenCheck(), len(commits), patches, mr.IID)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+//go:build !integration
+
+package patch
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+const wantPatches = `From 1111111111111111111111111111111111111111 Mon Sep 17 00:00:00 2001
+From: Jane Doe <jane@example.com>
+Date: Tue, 02 Jan 2024 10:00:00 +0000
+Subject: [PATCH 1/2] Add greeting
+MIME-Version: 1.0
+Content-Type: text/plain; charset=UTF-8
+Content-Transfer-Encoding: 8bit
+
+Say hello to new users.
+
+---
+
+diff --git a/hello.txt b/hello.txt
+new file mode 100644
+--- /dev/null
++++ b/hello.txt
+@@ -0,0 +1 @@
++hello
+-- 
+glab
+
+From 3333333333333333333333333333333333333333 Mon Sep 17 00:00:00 2001
+From: =?utf-8?q?Jos=C3=A9?= <jose@example.com>
+Date: Wed, 03 Jan 2024 10:00:00 +0000
+Subject: [PATCH 2/2] Rename greeting and make it executable
+MIME-Version: 1.0
+Content-Type: text/plain; charset=UTF-8
+Content-Transfer-Encoding: 8bit
+
+---
+
+diff --git a/hello.txt b/greeting.sh
+old mode 100644
+new mode 100755
+rename from hello.txt
+rename to greeting.sh
+--- a/hello.txt
++++ b/greeting.sh
+@@ -1 +1 @@
+-hello
++echo hello
+diff --git a/old.txt b/old.txt
+deleted file mode 100644
+--- a/old.txt
++++ /dev/null
+@@ -1 +0,0 @@
+-old
+-- 
+glab
+
+`
+
+func mockPatchMR(tc *gitlabtesting.TestClient) {
+	first := time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC)
+	second := time.Date(2024, 1, 3, 10, 0, 0, 0, time.UTC)
+
+	tc.MockMergeRequests.EXPECT().
+		GetMergeRequest("OWNER/REPO", int64(123), gomock.Any(), gomock.Any()).
+		Return(&gitlab.MergeRequest{BasicMergeRequest: gitlab.BasicMergeRequest{IID: 123}}, nil, nil)
+	tc.MockMergeRequests.EXPECT().
+		GetMergeRequestCommits("OWNER/REPO", int64(123), gomock.Any(), gomock.Any()).
+		Return([]*gitlab.Commit{
+			{ID: "3333333333333333333333333333333333333333", ShortID: "3333333", Message: "Rename greeting\nand make it executable\n", AuthorName: "José", AuthorEmail: "jose@example.com", AuthoredDate: &second, ParentIDs: []string{"2222222222222222222222222222222222222222"}},
+			{ID: "2222222222222222222222222222222222222222", ShortID: "2222222", Message: "Merge branch 'main'", ParentIDs: []string{"1111111111111111111111111111111111111111", "0000000000000000000000000000000000000000"}},
+			{ID: "1111111111111111111111111111111111111111", ShortID: "1111111", Message: "Add greeting\n\nSay hello to new users.\n", AuthorName: "Jane Doe", AuthorEmail: "jane@example.com", AuthoredDate: &first, ParentIDs: []string{"0000000000000000000000000000000000000000"}},
+		}, &gitlab.Response{}, nil)
+	tc.MockCommits.EXPECT().
+		GetCommitDiff("OWNER/REPO", "1111111111111111111111111111111111111111", gomock.Any(), gomock.Any()).
+		Return([]*gitlab.Diff{
+			{OldPath: "hello.txt", NewPath: "hello.txt", AMode: "0", BMode: "100644", NewFile: true, Diff: "@@ -0,0 +1 @@\n+hello\n"},
+		}, &gitlab.Response{}, nil)
+	tc.MockCommits.EXPECT().
+		GetCommitDiff("OWNER/REPO", "3333333333333333333333333333333333333333", gomock.Any(), gomock.Any()).
+		Return([]*gitlab.Diff{
+			{OldPath: "hello.txt", NewPath: "greeting.sh", AMode: "100644", BMode: "100755", RenamedFile: true, Diff: "@@ -1 +1 @@\n-hello\n+echo hello\n"},
+			{OldPath: "old.txt", NewPath: "old.txt", AMode: "100644", BMode: "0", DeletedFile: true, Diff: "@@ -1 +0,0 @@\n-old\n"},
+		}, &gitlab.Response{}, nil)
+}
+
+func TestMrPatch(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+	mockPatchMR(testClient)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdPatch, false, cmdtest.WithGitLabClient(testClient.Client))
+	out, err := exec("123")
+	require.NoError(t, err)
+	assert.Equal(t, wantPatches, out.String())
+}
+
+func TestMrPatchOutputDir(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+	dir := filepath.Join(t.TempDir(), "patches")
+
+	testClient := gitlabtesting.NewTestClient(t)
+	mockPatchMR(testClient)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdPatch, false, cmdtest.WithGitLabClient(testClient.Client))
+	out, err := exec("123 --output-dir " + dir)
+	require.NoError(t, err)
+
+	first := filepath.Join(dir, "0001-add-greeting.patch")
+	second := filepath.Join(dir, "0002-rename-greeting-and-make-it-executable.patch")
+	assert.Equal(t, first+"\n"+second+"\n", out.String())
+	assert.Equal(t, "✓ Exported 2 patches of merge request !123.\n", out.Stderr())
+
+	content, err := os.ReadFile(first)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "Subject: [PATCH 1/2] Add greeting\n")
+}
+
+func TestFormatPatchBinary(t *testing.T) {
+	_, err := formatPatch(&gitlab.Commit{ShortID: "1111111"}, []*gitlab.Diff{
+		{OldPath: "logo.png", NewPath: "logo.png", Diff: "Binary files a/logo.png and b/logo.png differ\n"},
+	}, 1, 1)
+	require.EqualError(t, err, "commit 1111111 changes the binary file logo.png, which can't be exported as a patch.")
+}
@@ -0,0 +1,126 @@
+package review
+
+import (
+	"bufio"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+type lineKind int
+
+const (
+	lineHunk lineKind = iota
+	lineContext
+	lineAdded
+	lineRemoved
+	lineMeta
+)
+
+// diffLine is a line of the diff of a file, with its line numbers in the old and
+// new versions of the file. A number is 0 when the line isn't in that version.
+type diffLine struct {
+	Kind    lineKind
+	OldLine int64
+	NewLine int64
+	Text    string
+}
+
+// commentable reports whether a comment can be attached to the line.
+func (l diffLine) commentable() bool {
+	return l.Kind == lineContext || l.Kind == lineAdded || l.Kind == lineRemoved
+}
+
+var hunkHeaderRE = regexp.MustCompile(`^@@ -(\d+)(?:,\d+)? \+(\d+)(?:,\d+)? @@`)
+
+// parseDiff parses the hunks of the diff of a file, as returned by the API.
+func parseDiff(diff string) ([]diffLine, error) {
+	var lines []diffLine
+	var oldLine, newLine int64
+
+	scanner := bufio.NewScanner(strings.NewReader(diff))
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		text := scanner.Text()
+		if strings.HasPrefix(text, "@@") {
+			m := hunkHeaderRE.FindStringSubmatch(text)
+			if m == nil {
+				return nil, fmt.Errorf("invalid hunk header %q.", text)
+			}
+			oldLine, _ = strconv.ParseInt(m[1], 10, 64)
+			newLine, _ = strconv.ParseInt(m[2], 10, 64)
+			lines = append(lines, diffLine{Kind: lineHunk, Text: text})
+			continue
+		}
+		if len(lines) == 0 {
+			// Headers before the first hunk, like in raw diffs.
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(text, "+"):
+			lines = append(lines, diffLine{Kind: lineAdded, NewLine: newLine, Text: text})
+			newLine++
+		case strings.HasPrefix(text, "-"):
+			lines = append(lines, diffLine{Kind: lineRemoved, OldLine: oldLine, Text: text})
+			oldLine++
+		case strings.HasPrefix(text, `\`):
+			// "\ No newline at end of file"
+			lines = append(lines, diffLine{Kind: lineMeta, Text: text})
+		default:
+			lines = append(lines, diffLine{Kind: lineContext, OldLine: oldLine, NewLine: newLine, Text: text})
+			oldLine++
+			newLine++
+		}
+	}
+	return lines, scanner.Err()
+}
+
+// findLine returns the commentable line referenced by ref: a line number of the new
+// version of the file, or a line number of the old version prefixed with "-" for
+// removed lines.
+func findLine(lines []diffLine, ref string) (diffLine, error) {
+	ref = strings.TrimSpace(ref)
+	removed := strings.HasPrefix(ref, "-")
+	n, err := strconv.ParseInt(strings.TrimPrefix(ref, "-"), 10, 64)
+	if err != nil || n <= 0 {
+		return diffLine{}, fmt.Errorf("invalid line %q: use a line number, or -NUMBER for a removed line.", ref)
+	}
+
+	for _, l := range lines {
+		switch {
+		case removed && l.Kind == lineRemoved && l.OldLine == n:
+			return l, nil
+		case !removed && (l.Kind == lineAdded || l.Kind == lineContext) && l.NewLine == n:
+			return l, nil
+		}
+	}
+	if removed {
+		return diffLine{}, fmt.Errorf("line %d isn't a removed line of the diff.", n)
+	}
+	return diffLine{}, fmt.Errorf("line %d isn't in the diff.", n)
+}
+
+// position returns the position of a comment on line of the file, in the diff version.
+// Added lines are referenced by their new line number, removed lines by their old
+// line number, and unchanged lines by both.
+func position(version *gitlab.MergeRequestDiffVersion, file *gitlab.Diff, line diffLine) *gitlab.PositionOptions {
+	pos := &gitlab.PositionOptions{
+		BaseSHA:      gitlab.Ptr(version.BaseCommitSHA),
+		HeadSHA:      gitlab.Ptr(version.HeadCommitSHA),
+		StartSHA:     gitlab.Ptr(version.StartCommitSHA),
+		OldPath:      gitlab.Ptr(file.OldPath),
+		NewPath:      gitlab.Ptr(file.NewPath),
+		PositionType: gitlab.Ptr("text"),
+	}
+	if line.OldLine > 0 {
+		pos.OldLine = gitlab.Ptr(line.OldLine)
+	}
+	if line.NewLine > 0 {
+		pos.NewLine = gitlab.Ptr(line.NewLine)
+	}
+	return pos
+}
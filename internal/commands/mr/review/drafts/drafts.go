@@ -0,0 +1,126 @@
+package drafts
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/mr/mrutils"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+)
+
+type options struct {
+	factory      cmdutils.Factory
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+
+	args         []string
+	outputFormat string
+}
+
+func NewCmdDrafts(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		factory:      f,
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "drafts [<id> | <branch>]",
+		Short: "List your pending draft notes on a merge request.",
+		Example: heredoc.Doc(`
+			# List your draft notes on merge request 123
+			$ glab mr review drafts 123
+			> ID   LOCATION     NOTE
+			> 41   main.go:12   Use a constant here.
+			> 42                Looks good overall.
+		`),
+		Args: cobra.MaximumNArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.args = args
+
+			return opts.run()
+		},
+	}
+
+	cmd.Flags().VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+
+	return cmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	mr, repo, err := mrutils.MRFromArgs(o.factory, o.args, "any")
+	if err != nil {
+		return err
+	}
+
+	drafts, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.DraftNote, *gitlab.Response, error) {
+		return client.DraftNotes.ListDraftNotes(repo.FullName(), mr.IID, &gitlab.ListDraftNotesOptions{
+			ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage},
+		}, p)
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to list draft notes.")
+	}
+
+	if o.outputFormat == "json" {
+		draftsJSON, _ := json.Marshal(drafts)
+		fmt.Fprintln(o.io.StdOut, string(draftsJSON))
+		return nil
+	}
+
+	if len(drafts) == 0 {
+		o.io.LogInfof("No draft notes on !%d.\n", mr.IID)
+		return nil
+	}
+
+	table := tableprinter.New(o.io)
+	table.AddHeader("ID", "LOCATION", "NOTE")
+	for _, d := range drafts {
+		table.AddRow(d.ID, Location(d.Position), FirstLine(d.Note))
+	}
+	fmt.Fprint(o.io.StdOut, table.String())
+
+	return nil
+}
+
+// Location returns the file and line a draft note is on, like "main.go:12", or
+// "main.go:-7" for a removed line. It's empty for notes on the whole merge request.
+func Location(pos *gitlab.NotePosition) string {
+	switch {
+	case pos == nil:
+		return ""
+	case pos.NewLine > 0:
+		return fmt.Sprintf("%s:%d", pos.NewPath, pos.NewLine)
+	case pos.OldLine > 0:
+		return fmt.Sprintf("%s:-%d", pos.OldPath, pos.OldLine)
+	default:
+		return pos.NewPath
+	}
+}
+
+// FirstLine returns the first line of a note, marked as truncated when there are more.
+func FirstLine(note string) string {
+	first, _, found := strings.Cut(strings.TrimSpace(note), "\n")
+	if found {
+		return first + " …"
+	}
+	return first
+}
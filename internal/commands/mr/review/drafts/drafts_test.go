@@ -0,0 +1,68 @@
+//go:build !integration
+
+package drafts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/glinstance"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func mockMR(tc *gitlabtesting.TestClient) {
+	tc.MockMergeRequests.EXPECT().
+		GetMergeRequest("OWNER/REPO", int64(123), gomock.Any(), gomock.Any()).
+		Return(&gitlab.MergeRequest{BasicMergeRequest: gitlab.BasicMergeRequest{IID: 123, State: "opened"}}, nil, nil)
+}
+
+func TestDrafts(t *testing.T) {
+	t.Parallel()
+
+	tc := gitlabtesting.NewTestClient(t)
+	mockMR(tc)
+	tc.MockDraftNotes.EXPECT().
+		ListDraftNotes("OWNER/REPO", int64(123), gomock.Any(), gomock.Any()).
+		Return([]*gitlab.DraftNote{
+			{ID: 41, Note: "Use a constant here.", Position: &gitlab.NotePosition{NewPath: "main.go", NewLine: 12}},
+			{ID: 42, Note: "Why?", Position: &gitlab.NotePosition{OldPath: "old.go", OldLine: 7}},
+			{ID: 43, Note: "Looks good overall.\n\nThanks!"},
+		}, &gitlab.Response{}, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdDrafts, false,
+		cmdtest.WithGitLabClient(tc.Client),
+		cmdtest.WithBaseRepo("OWNER", "REPO", glinstance.DefaultHostname),
+	)
+
+	output, err := exec("123")
+	require.NoError(t, err)
+	assert.Equal(t, "ID\tLOCATION\tNOTE\n"+
+		"41\tmain.go:12\tUse a constant here.\n"+
+		"42\told.go:-7\tWhy?\n"+
+		"43\t\tLooks good overall. …\n", output.String())
+}
+
+func TestDrafts_none(t *testing.T) {
+	t.Parallel()
+
+	tc := gitlabtesting.NewTestClient(t)
+	mockMR(tc)
+	tc.MockDraftNotes.EXPECT().
+		ListDraftNotes("OWNER/REPO", int64(123), gomock.Any(), gomock.Any()).
+		Return([]*gitlab.DraftNote{}, &gitlab.Response{}, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdDrafts, false,
+		cmdtest.WithGitLabClient(tc.Client),
+		cmdtest.WithBaseRepo("OWNER", "REPO", glinstance.DefaultHostname),
+	)
+
+	output, err := exec("123")
+	require.NoError(t, err)
+	assert.Equal(t, "No draft notes on !123.\n", output.String())
+}
@@ -0,0 +1,122 @@
+package edit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/mr/mrutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	factory      cmdutils.Factory
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	config       func() config.Config
+
+	draftID int64
+	args    []string
+	message string
+}
+
+func NewCmdEdit(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		factory:      f,
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		config:       f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "edit <draft-id> [<id> | <branch>]",
+		Short: "Edit one of your pending draft notes on a merge request.",
+		Example: heredoc.Doc(`
+			# Replace the text of draft note 41 on merge request 123
+			$ glab mr review edit 41 123 -m "Use a constant here."
+
+			# Edit draft note 41 of the merge request of the current branch in your editor
+			$ glab mr review edit 41
+		`),
+		Args: cobra.RangeArgs(1, 2),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmdutils.ApplyBodyFile(cmd, opts.io, "message"); err != nil {
+				return err
+			}
+			if err := opts.complete(args); err != nil {
+				return err
+			}
+
+			return opts.run(cmd.Context())
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.message, "message", "m", "", "New text of the draft note. Opens your editor when not set.")
+	cmdutils.AddBodyFileFlag(cmd, "message")
+
+	return cmd
+}
+
+func (o *options) complete(args []string) error {
+	id, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil || id <= 0 {
+		return &cmdutils.FlagError{Err: fmt.Errorf("invalid draft note ID %q.", args[0])}
+	}
+	o.draftID = id
+	o.args = args[1:]
+
+	return nil
+}
+
+func (o *options) run(ctx context.Context) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	mr, repo, err := mrutils.MRFromArgs(o.factory, o.args, "any")
+	if err != nil {
+		return err
+	}
+
+	body := o.message
+	if strings.TrimSpace(body) == "" {
+		draft, _, err := client.DraftNotes.GetDraftNote(repo.FullName(), mr.IID, o.draftID)
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to get draft note %d.", o.draftID))
+		}
+
+		editor, err := cmdutils.GetEditor(o.config)
+		if err != nil {
+			return err
+		}
+		if err := o.io.Editor(ctx, &body, "Draft note:", "Edit the text of the draft note.", draft.Note, editor); err != nil {
+			return err
+		}
+	}
+	if strings.TrimSpace(body) == "" {
+		return fmt.Errorf("aborted... Draft note has an empty message.")
+	}
+
+	draft, _, err := client.DraftNotes.UpdateDraftNote(repo.FullName(), mr.IID, o.draftID, &gitlab.UpdateDraftNoteOptions{
+		Note: gitlab.Ptr(body),
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to update draft note %d.", o.draftID))
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Updated draft note %d on !%d\n", o.io.Color().GreenCheck(), draft.ID, mr.IID)
+	return nil
+}
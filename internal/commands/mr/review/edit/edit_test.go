@@ -0,0 +1,47 @@
+//go:build !integration
+
+package edit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/glinstance"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestEdit(t *testing.T) {
+	t.Parallel()
+
+	tc := gitlabtesting.NewTestClient(t)
+	tc.MockMergeRequests.EXPECT().
+		GetMergeRequest("OWNER/REPO", int64(123), gomock.Any(), gomock.Any()).
+		Return(&gitlab.MergeRequest{BasicMergeRequest: gitlab.BasicMergeRequest{IID: 123, State: "opened"}}, nil, nil)
+	tc.MockDraftNotes.EXPECT().
+		UpdateDraftNote("OWNER/REPO", int64(123), int64(41), &gitlab.UpdateDraftNoteOptions{Note: gitlab.Ptr("Use a constant.")}).
+		Return(&gitlab.DraftNote{ID: 41}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdEdit, false,
+		cmdtest.WithGitLabClient(tc.Client),
+		cmdtest.WithBaseRepo("OWNER", "REPO", glinstance.DefaultHostname),
+	)
+
+	output, err := exec(`41 123 -m "Use a constant."`)
+	require.NoError(t, err)
+	assert.Equal(t, "✓ Updated draft note 41 on !123\n", output.String())
+}
+
+func TestEdit_invalidID(t *testing.T) {
+	t.Parallel()
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdEdit, false)
+
+	_, err := exec(`abc -m "text"`)
+	require.EqualError(t, err, `invalid draft note ID "abc".`)
+}
@@ -0,0 +1,94 @@
+package publish
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/mr/mrutils"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	factory      cmdutils.Factory
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+
+	args []string
+}
+
+func NewCmdPublish(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		factory:      f,
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "publish [<id> | <branch>]",
+		Short: "Publish all your pending draft notes on a merge request at once.",
+		Long: heredoc.Doc(`
+			Publish all your pending draft notes on a merge request at once.
+
+			The notes are published together, so the participants of the merge request
+			get a single notification instead of one for each note.
+		`),
+		Example: heredoc.Doc(`
+			# Add draft notes to merge request 123, then publish them
+			$ glab mr note 123 --draft -m "Use a constant here."
+			$ glab mr note 123 --draft -m "Looks good overall."
+			$ glab mr review publish 123
+		`),
+		Args: cobra.MaximumNArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.args = args
+
+			return opts.run()
+		},
+	}
+
+	return cmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	mr, repo, err := mrutils.MRFromArgs(o.factory, o.args, "any")
+	if err != nil {
+		return err
+	}
+
+	drafts, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.DraftNote, *gitlab.Response, error) {
+		return client.DraftNotes.ListDraftNotes(repo.FullName(), mr.IID, &gitlab.ListDraftNotesOptions{
+			ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage},
+		}, p)
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to list draft notes.")
+	}
+	if len(drafts) == 0 {
+		o.io.LogInfof("No draft notes to publish on !%d.\n", mr.IID)
+		return nil
+	}
+
+	if _, err := client.DraftNotes.PublishAllDraftNotes(repo.FullName(), mr.IID); err != nil {
+		return cmdutils.WrapError(err, "failed to publish draft notes.")
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Published %s on !%d\n", o.io.Color().GreenCheck(), utils.Pluralize(len(drafts), "draft note"), mr.IID)
+	fmt.Fprintln(o.io.StdOut, mr.WebURL)
+	return nil
+}
@@ -0,0 +1,69 @@
+//go:build !integration
+
+package publish
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/glinstance"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestPublish(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		drafts     []*gitlab.DraftNote
+		wantOutput string
+	}{
+		{
+			name:       "drafts published",
+			drafts:     []*gitlab.DraftNote{{ID: 41}, {ID: 42}},
+			wantOutput: "✓ Published 2 draft notes on !123\nhttps://gitlab.com/OWNER/REPO/-/merge_requests/123\n",
+		},
+		{
+			name:       "no drafts",
+			wantOutput: "No draft notes to publish on !123.\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tc := gitlabtesting.NewTestClient(t)
+			tc.MockMergeRequests.EXPECT().
+				GetMergeRequest("OWNER/REPO", int64(123), gomock.Any(), gomock.Any()).
+				Return(&gitlab.MergeRequest{BasicMergeRequest: gitlab.BasicMergeRequest{
+					IID:    123,
+					State:  "opened",
+					WebURL: "https://gitlab.com/OWNER/REPO/-/merge_requests/123",
+				}}, nil, nil)
+			tc.MockDraftNotes.EXPECT().
+				ListDraftNotes("OWNER/REPO", int64(123), gomock.Any(), gomock.Any()).
+				Return(tt.drafts, &gitlab.Response{}, nil)
+			if len(tt.drafts) > 0 {
+				tc.MockDraftNotes.EXPECT().
+					PublishAllDraftNotes("OWNER/REPO", int64(123)).
+					Return(nil, nil)
+			}
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdPublish, false,
+				cmdtest.WithGitLabClient(tc.Client),
+				cmdtest.WithBaseRepo("OWNER", "REPO", glinstance.DefaultHostname),
+			)
+
+			output, err := exec("123")
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOutput, output.String())
+		})
+	}
+}
@@ -0,0 +1,444 @@
+package review
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/mr/mrutils"
+	mrReviewDraftsCmd "gitlab.com/gitlab-org/cli/internal/commands/mr/review/drafts"
+	mrReviewEditCmd "gitlab.com/gitlab-org/cli/internal/commands/mr/review/edit"
+	mrReviewPublishCmd "gitlab.com/gitlab-org/cli/internal/commands/mr/review/publish"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+const (
+	actionComment  = "Comment on a line"
+	actionNext     = "Next file"
+	actionPrevious = "Previous file"
+	actionGoTo     = "Go to file"
+	actionFinish   = "Finish review"
+	actionDiscard  = "Discard review"
+)
+
+const (
+	decisionComment        = "Comment"
+	decisionApprove        = "Approve"
+	decisionRequestChanges = "Request changes"
+)
+
+type options struct {
+	factory      cmdutils.Factory
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	config       func() config.Config
+
+	args []string
+}
+
+// file is a changed file of the merge request, with its parsed diff.
+type file struct {
+	diff  *gitlab.Diff
+	lines []diffLine
+}
+
+// comment is a comment drafted on a line of a file.
+type comment struct {
+	file *file
+	line diffLine
+	body string
+}
+
+// review is what is submitted at the end of the session.
+type review struct {
+	comments []comment
+	summary  string
+	decision string
+}
+
+func NewCmdReview(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		factory:      f,
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		config:       f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "review [<id> | <branch>]",
+		Short: "Review a merge request interactively.",
+		Long: heredoc.Docf(`
+			Review the changes of a merge request in an interactive session.
+
+			Page through the changed files, and draft comments on lines of the diff.
+			Lines are referenced by their number in the new version of the file, or by
+			their number in the old version prefixed with %[1]s-%[1]s for removed lines.
+
+			Nothing is sent until you finish the review. The comments, and an optional summary,
+			are then submitted together as a single review, with your other pending draft
+			comments on the merge request. The review ends with a comment, an approval, or
+			a request for changes.
+
+			To review without the interactive session, add draft notes with %[1]sglab mr note --draft%[1]s,
+			then publish them together with %[1]sglab mr review publish%[1]s.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Review merge request 123
+			$ glab mr review 123
+
+			# Review the merge request of the current branch
+			$ glab mr review
+
+			# List and publish your draft notes on merge request 123
+			$ glab mr review drafts 123
+			$ glab mr review publish 123
+		`),
+		Args: cobra.MaximumNArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.args = args
+
+			return opts.run(cmd.Context())
+		},
+	}
+
+	cmd.AddCommand(mrReviewDraftsCmd.NewCmdDrafts(f))
+	cmd.AddCommand(mrReviewEditCmd.NewCmdEdit(f))
+	cmd.AddCommand(mrReviewPublishCmd.NewCmdPublish(f))
+
+	return cmd
+}
+
+func (o *options) run(ctx context.Context) error {
+	if !o.io.PromptEnabled() {
+		return errors.New("the review session is interactive and needs a terminal. Use `glab mr note` and `glab mr approve` instead.")
+	}
+
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	mr, repo, err := mrutils.MRFromArgs(o.factory, o.args, "opened")
+	if err != nil {
+		return err
+	}
+	if err := mrutils.MRCheckErrors(mr, mrutils.MRCheckErrOptions{
+		Closed: true,
+		Merged: true,
+	}); err != nil {
+		return err
+	}
+
+	version, err := latestDiffVersion(client, repo, mr.IID)
+	if err != nil {
+		return err
+	}
+	files := make([]*file, 0, len(version.Diffs))
+	for _, d := range version.Diffs {
+		lines, err := parseDiff(d.Diff)
+		if err != nil {
+			return fmt.Errorf("could not parse the diff of %s: %w", d.NewPath, err)
+		}
+		files = append(files, &file{diff: d, lines: lines})
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("merge request !%d has no changes to review.", mr.IID)
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Reviewing !%d %s (%s)\n", o.io.Color().ProgressIcon(), mr.IID, mr.Title, utils.Pluralize(len(files), "file"))
+
+	r, err := o.session(ctx, files)
+	if err != nil {
+		return err
+	}
+	if r == nil {
+		fmt.Fprintln(o.io.StdErr, "Review discarded.")
+		return nil
+	}
+
+	return o.submit(client, repo, mr, version, r)
+}
+
+// latestDiffVersion returns the most recent diff version of the merge request, with its diffs.
+func latestDiffVersion(client *gitlab.Client, repo glrepo.Interface, iid int64) (*gitlab.MergeRequestDiffVersion, error) {
+	versions, _, err := client.MergeRequests.GetMergeRequestDiffVersions(repo.FullName(), iid, &gitlab.GetMergeRequestDiffVersionsOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not find merge request diffs: %w", err)
+	}
+	if len(versions) == 0 {
+		return nil, errors.New("no merge request diffs found.")
+	}
+
+	// diff versions are returned by the API in order of most recent first
+	version, _, err := client.MergeRequests.GetSingleMergeRequestDiffVersion(repo.FullName(), iid, versions[0].ID, &gitlab.GetSingleMergeRequestDiffVersionOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not find merge request diff: %w", err)
+	}
+	return version, nil
+}
+
+// session pages through the files until the review is finished. It returns nil when
+// the review is discarded.
+func (o *options) session(ctx context.Context, files []*file) (*review, error) {
+	r := &review{}
+	current := 0
+
+	for {
+		o.printFile(files, current, r.comments)
+
+		actions := []string{actionComment}
+		if current < len(files)-1 {
+			actions = append(actions, actionNext)
+		}
+		if current > 0 {
+			actions = append(actions, actionPrevious)
+		}
+		if len(files) > 1 {
+			actions = append(actions, actionGoTo)
+		}
+		actions = append(actions, actionFinish, actionDiscard)
+
+		var action string
+		if err := o.io.Select(ctx, &action, "What next?", actions); err != nil {
+			return nil, err
+		}
+
+		switch action {
+		case actionComment:
+			c, err := o.draftComment(ctx, files[current])
+			if err != nil {
+				return nil, err
+			}
+			if c != nil {
+				r.comments = append(r.comments, *c)
+			}
+		case actionNext:
+			current++
+		case actionPrevious:
+			current--
+		case actionGoTo:
+			paths := make([]string, 0, len(files))
+			for _, f := range files {
+				paths = append(paths, f.diff.NewPath)
+			}
+			var path string
+			if err := o.io.Select(ctx, &path, "Go to file:", paths); err != nil {
+				return nil, err
+			}
+			for i, f := range files {
+				if f.diff.NewPath == path {
+					current = i
+				}
+			}
+		case actionFinish:
+			done, err := o.finish(ctx, r)
+			if err != nil {
+				return nil, err
+			}
+			if done {
+				return r, nil
+			}
+		case actionDiscard:
+			if len(r.comments) == 0 {
+				return nil, nil
+			}
+			var discard bool
+			if err := o.io.Confirm(ctx, &discard, fmt.Sprintf("Discard %s?", utils.Pluralize(len(r.comments), "draft comment"))); err != nil {
+				return nil, err
+			}
+			if discard {
+				return nil, nil
+			}
+		}
+	}
+}
+
+// draftComment asks for a line of f and the body of a comment on it. It returns nil
+// when the body is empty.
+func (o *options) draftComment(ctx context.Context, f *file) (*comment, error) {
+	var ref string
+	err := o.io.InputWithDescription(ctx, &ref, "Line:", "Line number in the new file, or -NUMBER for a removed line.", "", func(s string) error {
+		_, err := findLine(f.lines, s)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	line, err := findLine(f.lines, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	editor, err := cmdutils.GetEditor(o.config)
+	if err != nil {
+		return nil, err
+	}
+	var body string
+	title := fmt.Sprintf("Comment on %s:%s", f.diff.NewPath, strings.TrimSpace(ref))
+	if err := o.io.Editor(ctx, &body, title, line.Text, "", editor); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(body) == "" {
+		fmt.Fprintln(o.io.StdErr, "Empty comment discarded.")
+		return nil, nil
+	}
+
+	return &comment{file: f, line: line, body: body}, nil
+}
+
+// finish asks for the decision and the summary of the review. It returns false when
+// there is nothing to submit, to continue the session.
+func (o *options) finish(ctx context.Context, r *review) (bool, error) {
+	if len(r.comments) > 0 {
+		fmt.Fprintf(o.io.StdOut, "\n%s:\n", utils.Pluralize(len(r.comments), "draft comment"))
+		for _, c := range r.comments {
+			fmt.Fprintf(o.io.StdOut, "  %s: %s\n", location(c), mrReviewDraftsCmd.FirstLine(c.body))
+		}
+		fmt.Fprintln(o.io.StdOut)
+	}
+
+	if err := o.io.Select(ctx, &r.decision, "Submit review as:", []string{decisionComment, decisionApprove, decisionRequestChanges}); err != nil {
+		return false, err
+	}
+
+	editor, err := cmdutils.GetEditor(o.config)
+	if err != nil {
+		return false, err
+	}
+	if err := o.io.Editor(ctx, &r.summary, "Summary:", "Optional comment on the whole merge request.", "", editor); err != nil {
+		return false, err
+	}
+	r.summary = strings.TrimSpace(r.summary)
+
+	if r.decision == decisionComment && len(r.comments) == 0 && r.summary == "" {
+		fmt.Fprintf(o.io.StdErr, "%s Nothing to submit. Draft a comment or write a summary first.\n", o.io.Color().WarnIcon())
+		return false, nil
+	}
+	return true, nil
+}
+
+// submit sends the comments of the review as draft notes, publishes them at once,
+// and then applies the decision of the review.
+func (o *options) submit(client *gitlab.Client, repo glrepo.Interface, mr *gitlab.MergeRequest, version *gitlab.MergeRequestDiffVersion, r *review) error {
+	c := o.io.Color()
+
+	for _, cm := range r.comments {
+		_, _, err := client.DraftNotes.CreateDraftNote(repo.FullName(), mr.IID, &gitlab.CreateDraftNoteOptions{
+			Note:     gitlab.Ptr(cm.body),
+			Position: position(version, cm.file.diff, cm.line),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create the comment on %s: %w", location(cm), err)
+		}
+	}
+	if r.summary != "" {
+		_, _, err := client.DraftNotes.CreateDraftNote(repo.FullName(), mr.IID, &gitlab.CreateDraftNoteOptions{
+			Note: gitlab.Ptr(r.summary),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create the summary comment: %w", err)
+		}
+	}
+
+	if len(r.comments) > 0 || r.summary != "" {
+		if _, err := client.DraftNotes.PublishAllDraftNotes(repo.FullName(), mr.IID); err != nil {
+			return fmt.Errorf("failed to publish the review: %w", err)
+		}
+		fmt.Fprintf(o.io.StdOut, "%s Submitted %s on !%d\n", c.GreenCheck(), utils.Pluralize(len(r.comments), "comment"), mr.IID)
+	}
+
+	switch r.decision {
+	case decisionApprove:
+		_, _, err := client.MergeRequestApprovals.ApproveMergeRequest(repo.FullName(), mr.IID, &gitlab.ApproveMergeRequestOptions{
+			SHA: gitlab.Ptr(version.HeadCommitSHA),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to approve the merge request: %w", err)
+		}
+		fmt.Fprintf(o.io.StdOut, "%s Approved !%d\n", c.GreenCheck(), mr.IID)
+	case decisionRequestChanges:
+		if err := api.SetReviewerState(client, repo.FullName(), strconv.FormatInt(mr.IID, 10), api.ReviewerStateRequestedChanges); err != nil {
+			return fmt.Errorf("failed to request changes: %w", err)
+		}
+		fmt.Fprintf(o.io.StdOut, "%s Requested changes on !%d\n", c.GreenCheck(), mr.IID)
+	}
+
+	fmt.Fprintln(o.io.StdOut, mr.WebURL)
+	return nil
+}
+
+// printFile prints the diff of the current file, with the line numbers to comment on
+// and the comments already drafted.
+func (o *options) printFile(files []*file, current int, comments []comment) {
+	c := o.io.Color()
+	f := files[current]
+	out := o.io.StdOut
+
+	header := f.diff.NewPath
+	switch {
+	case f.diff.NewFile:
+		header += " (new file)"
+	case f.diff.DeletedFile:
+		header += " (deleted)"
+	case f.diff.RenamedFile:
+		header = f.diff.OldPath + " → " + f.diff.NewPath
+	}
+	fmt.Fprintf(out, "\n%s %s\n", c.Bold(header), c.Gray(fmt.Sprintf("[%d/%d]", current+1, len(files))))
+	if len(f.lines) == 0 {
+		fmt.Fprintln(out, c.Gray("No changes to display."))
+	}
+
+	for _, l := range f.lines {
+		gutter := c.Gray(fmt.Sprintf("%5s %5s ", lineNumber(l.OldLine), lineNumber(l.NewLine)))
+		switch l.Kind {
+		case lineHunk:
+			fmt.Fprintln(out, c.Cyan(l.Text))
+		case lineAdded:
+			fmt.Fprintln(out, gutter+c.Green(l.Text))
+		case lineRemoved:
+			fmt.Fprintln(out, gutter+c.Red(l.Text))
+		case lineMeta:
+			fmt.Fprintln(out, c.Gray(l.Text))
+		default:
+			fmt.Fprintln(out, gutter+l.Text)
+		}
+
+		for _, cm := range comments {
+			if cm.file == f && cm.line == l {
+				fmt.Fprintf(out, "%s %s\n", c.Yellow("            > draft:"), mrReviewDraftsCmd.FirstLine(cm.body))
+			}
+		}
+	}
+	fmt.Fprintln(out)
+}
+
+// location returns the file and line of a comment, like "main.go:12" or "main.go:-7".
+func location(c comment) string {
+	if c.line.Kind == lineRemoved {
+		return fmt.Sprintf("%s:-%d", c.file.diff.OldPath, c.line.OldLine)
+	}
+	return fmt.Sprintf("%s:%d", c.file.diff.NewPath, c.line.NewLine)
+}
+
+func lineNumber(n int64) string {
+	if n == 0 {
+		return ""
+	}
+	return strconv.FormatInt(n, 10)
+}
@@ -0,0 +1,253 @@
+//go:build !integration
+
+package review
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/glinstance"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+const testDiff = `@@ -1,4 +1,5 @@
+ package main
+-import "fmt"
++import (
++	"fmt"
++)
+ 
+@@ -10,2 +11,2 @@ func main() {
+ 	fmt.Println("hello")
+\ No newline at end of file
+`
+
+func TestParseDiff(t *testing.T) {
+	lines, err := parseDiff(testDiff)
+	require.NoError(t, err)
+
+	assert.Equal(t, []diffLine{
+		{Kind: lineHunk, Text: "@@ -1,4 +1,5 @@"},
+		{Kind: lineContext, OldLine: 1, NewLine: 1, Text: " package main"},
+		{Kind: lineRemoved, OldLine: 2, Text: `-import "fmt"`},
+		{Kind: lineAdded, NewLine: 2, Text: "+import ("},
+		{Kind: lineAdded, NewLine: 3, Text: `+	"fmt"`},
+		{Kind: lineAdded, NewLine: 4, Text: "+)"},
+		{Kind: lineContext, OldLine: 3, NewLine: 5, Text: " "},
+		{Kind: lineHunk, Text: "@@ -10,2 +11,2 @@ func main() {"},
+		{Kind: lineContext, OldLine: 10, NewLine: 11, Text: ` 	fmt.Println("hello")`},
+		{Kind: lineMeta, Text: `\ No newline at end of file`},
+	}, lines)
+
+	_, err = parseDiff("@@ invalid @@\n")
+	assert.EqualError(t, err, `invalid hunk header "@@ invalid @@".`)
+}
+
+func TestFindLine(t *testing.T) {
+	lines, err := parseDiff(testDiff)
+	require.NoError(t, err)
+
+	tests := []struct {
+		ref     string
+		want    diffLine
+		wantErr string
+	}{
+		{ref: "3", want: diffLine{Kind: lineAdded, NewLine: 3, Text: `+	"fmt"`}},
+		{ref: " 11 ", want: diffLine{Kind: lineContext, OldLine: 10, NewLine: 11, Text: ` 	fmt.Println("hello")`}},
+		{ref: "-2", want: diffLine{Kind: lineRemoved, OldLine: 2, Text: `-import "fmt"`}},
+		{ref: "7", wantErr: "line 7 isn't in the diff."},
+		{ref: "-1", wantErr: "line 1 isn't a removed line of the diff."},
+		{ref: "abc", wantErr: `invalid line "abc": use a line number, or -NUMBER for a removed line.`},
+		{ref: "0", wantErr: `invalid line "0": use a line number, or -NUMBER for a removed line.`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.ref, func(t *testing.T) {
+			got, err := findLine(lines, tc.ref)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestPosition(t *testing.T) {
+	version := &gitlab.MergeRequestDiffVersion{BaseCommitSHA: "base", HeadCommitSHA: "head", StartCommitSHA: "start"}
+	diff := &gitlab.Diff{OldPath: "old.go", NewPath: "new.go"}
+
+	pos := position(version, diff, diffLine{Kind: lineAdded, NewLine: 4})
+	assert.Equal(t, &gitlab.PositionOptions{
+		BaseSHA:      gitlab.Ptr("base"),
+		HeadSHA:      gitlab.Ptr("head"),
+		StartSHA:     gitlab.Ptr("start"),
+		OldPath:      gitlab.Ptr("old.go"),
+		NewPath:      gitlab.Ptr("new.go"),
+		PositionType: gitlab.Ptr("text"),
+		NewLine:      gitlab.Ptr(int64(4)),
+	}, pos)
+
+	pos = position(version, diff, diffLine{Kind: lineRemoved, OldLine: 2})
+	assert.Equal(t, gitlab.Ptr(int64(2)), pos.OldLine)
+	assert.Nil(t, pos.NewLine)
+
+	pos = position(version, diff, diffLine{Kind: lineContext, OldLine: 10, NewLine: 11})
+	assert.Equal(t, gitlab.Ptr(int64(10)), pos.OldLine)
+	assert.Equal(t, gitlab.Ptr(int64(11)), pos.NewLine)
+}
+
+func TestReviewNotInteractive(t *testing.T) {
+	t.Parallel()
+
+	tc := gitlabtesting.NewTestClient(t)
+	exec := cmdtest.SetupCmdForTest(t, NewCmdReview, false,
+		cmdtest.WithGitLabClient(tc.Client),
+		cmdtest.WithBaseRepo("OWNER", "REPO", glinstance.DefaultHostname),
+	)
+
+	_, err := exec("123")
+	require.EqualError(t, err, "the review session is interactive and needs a terminal. Use `glab mr note` and `glab mr approve` instead.")
+}
+
+func TestSubmit(t *testing.T) {
+	version := &gitlab.MergeRequestDiffVersion{BaseCommitSHA: "base", HeadCommitSHA: "head", StartCommitSHA: "start"}
+	f := &file{diff: &gitlab.Diff{OldPath: "main.go", NewPath: "main.go"}}
+	mr := &gitlab.MergeRequest{BasicMergeRequest: gitlab.BasicMergeRequest{IID: 12, WebURL: "https://gitlab.com/OWNER/REPO/-/merge_requests/12"}}
+
+	tests := []struct {
+		name       string
+		review     *review
+		setupMock  func(t *testing.T, tc *gitlabtesting.TestClient)
+		wantOutput string
+		wantErr    string
+	}{
+		{
+			name: "comments and approval",
+			review: &review{
+				comments: []comment{
+					{file: f, line: diffLine{Kind: lineAdded, NewLine: 3}, body: "Use a constant."},
+					{file: f, line: diffLine{Kind: lineRemoved, OldLine: 2}, body: "Why?"},
+				},
+				summary:  "Looks good.",
+				decision: decisionApprove,
+			},
+			setupMock: func(t *testing.T, tc *gitlabtesting.TestClient) {
+				gomock.InOrder(
+					tc.MockDraftNotes.EXPECT().
+						CreateDraftNote("OWNER/REPO", int64(12), gomock.Any()).
+						DoAndReturn(func(_ any, _ int64, opt *gitlab.CreateDraftNoteOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.DraftNote, *gitlab.Response, error) {
+							assert.Equal(t, "Use a constant.", *opt.Note)
+							assert.Equal(t, int64(3), *opt.Position.NewLine)
+							assert.Nil(t, opt.Position.OldLine)
+							return &gitlab.DraftNote{}, nil, nil
+						}),
+					tc.MockDraftNotes.EXPECT().
+						CreateDraftNote("OWNER/REPO", int64(12), gomock.Any()).
+						DoAndReturn(func(_ any, _ int64, opt *gitlab.CreateDraftNoteOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.DraftNote, *gitlab.Response, error) {
+							assert.Equal(t, "Why?", *opt.Note)
+							assert.Equal(t, int64(2), *opt.Position.OldLine)
+							return &gitlab.DraftNote{}, nil, nil
+						}),
+					tc.MockDraftNotes.EXPECT().
+						CreateDraftNote("OWNER/REPO", int64(12), &gitlab.CreateDraftNoteOptions{Note: gitlab.Ptr("Looks good.")}).
+						Return(&gitlab.DraftNote{}, nil, nil),
+					tc.MockDraftNotes.EXPECT().
+						PublishAllDraftNotes("OWNER/REPO", int64(12)).
+						Return(nil, nil),
+					tc.MockMergeRequestApprovals.EXPECT().
+						ApproveMergeRequest("OWNER/REPO", int64(12), &gitlab.ApproveMergeRequestOptions{SHA: gitlab.Ptr("head")}).
+						Return(&gitlab.MergeRequestApprovals{}, nil, nil),
+				)
+			},
+			wantOutput: heredoc.Doc(`
+				✓ Submitted 2 comments on !12
+				✓ Approved !12
+				https://gitlab.com/OWNER/REPO/-/merge_requests/12
+			`),
+		},
+		{
+			name: "approval without comments",
+			review: &review{
+				decision: decisionApprove,
+			},
+			setupMock: func(t *testing.T, tc *gitlabtesting.TestClient) {
+				tc.MockMergeRequestApprovals.EXPECT().
+					ApproveMergeRequest("OWNER/REPO", int64(12), gomock.Any()).
+					Return(&gitlab.MergeRequestApprovals{}, nil, nil)
+			},
+			wantOutput: heredoc.Doc(`
+				✓ Approved !12
+				https://gitlab.com/OWNER/REPO/-/merge_requests/12
+			`),
+		},
+		{
+			name: "request changes",
+			review: &review{
+				comments: []comment{{file: f, line: diffLine{Kind: lineContext, OldLine: 1, NewLine: 1}, body: "Rename this."}},
+				decision: decisionRequestChanges,
+			},
+			setupMock: func(t *testing.T, tc *gitlabtesting.TestClient) {
+				tc.MockDraftNotes.EXPECT().
+					CreateDraftNote("OWNER/REPO", int64(12), gomock.Any()).
+					Return(&gitlab.DraftNote{}, nil, nil)
+				tc.MockDraftNotes.EXPECT().
+					PublishAllDraftNotes("OWNER/REPO", int64(12)).
+					Return(nil, nil)
+				tc.MockGraphQL.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(query gitlab.GraphQLQuery, response any, _ ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						assert.Equal(t, "12", query.Variables["iid"])
+						assert.Equal(t, "REQUESTED_CHANGES", query.Variables["reviewerState"])
+						return nil, json.Unmarshal([]byte(`{"data": {"mergeRequestUpdateReviewerState": {"errors": []}}}`), response)
+					})
+			},
+			wantOutput: heredoc.Doc(`
+				✓ Submitted 1 comment on !12
+				✓ Requested changes on !12
+				https://gitlab.com/OWNER/REPO/-/merge_requests/12
+			`),
+		},
+		{
+			name: "failed comment",
+			review: &review{
+				comments: []comment{{file: f, line: diffLine{Kind: lineRemoved, OldLine: 7}, body: "Why?"}},
+				decision: decisionComment,
+			},
+			setupMock: func(t *testing.T, tc *gitlabtesting.TestClient) {
+				tc.MockDraftNotes.EXPECT().
+					CreateDraftNote("OWNER/REPO", int64(12), gomock.Any()).
+					Return(nil, nil, assert.AnError)
+			},
+			wantErr: "failed to create the comment on main.go:-7: " + assert.AnError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tc := gitlabtesting.NewTestClient(t)
+			tt.setupMock(t, tc)
+
+			ios, _, stdout, _ := cmdtest.TestIOStreams()
+			o := &options{io: ios}
+
+			err := o.submit(tc.Client, glrepo.New("OWNER", "REPO", glinstance.DefaultHostname), mr, version, tt.review)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOutput, stdout.String())
+		})
+	}
+}
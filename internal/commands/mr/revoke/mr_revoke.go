@@ -6,6 +6,8 @@ import (
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/spf13/cobra"
 
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
 	"gitlab.com/gitlab-org/cli/internal/commands/mr/mrutils"
 	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
@@ -13,9 +15,14 @@ import (
 
 func NewCmdRevoke(f cmdutils.Factory) *cobra.Command {
 	mrRevokeCmd := &cobra.Command{
-		Use:     "revoke [<id> | <branch>]",
-		Short:   `Revoke approval on a merge request.`,
-		Long:    ``,
+		Use:   "revoke [<id> | <branch> | -]",
+		Short: `Revoke approval on a merge request.`,
+		Long: heredoc.Doc(`
+			Revoke approval on merge requests.
+
+			Pass - to read the IDs of the merge requests from standard input, like the
+			output of glab mr list --output ids.
+		`),
 		Aliases: []string{"unapprove"},
 		Example: heredoc.Doc(`
 		# Revoke approval on a merge request
@@ -27,6 +34,12 @@ func NewCmdRevoke(f cmdutils.Factory) *cobra.Command {
 		$ glab mr revoke
 		# Revoke approval on merge request 123 on branch 456
 		$ glab mr revoke 123 branch 456
+
+		# Revoke approval with a comment explaining why
+		$ glab mr revoke 123 --message "The new changes break the API."
+
+		# Revoke approval on the merge requests of a list
+		$ glab mr list --reviewer @me --output ids | glab mr revoke -
 		`),
 		Annotations: map[string]string{
 			mcpannotations.Destructive: "true",
@@ -40,10 +53,16 @@ func NewCmdRevoke(f cmdutils.Factory) *cobra.Command {
 				return err
 			}
 
+			args, err = mrutils.IDsFromStdin(args, f.IO().In)
+			if err != nil {
+				return err
+			}
+
 			mrs, repo, err := mrutils.MRsFromArgs(f, args, "opened")
 			if err != nil {
 				return err
 			}
+			message, _ := cmd.Flags().GetString("message")
 
 			for _, mr := range mrs {
 				if err = mrutils.MRCheckErrors(mr, mrutils.MRCheckErrOptions{
@@ -61,11 +80,20 @@ func NewCmdRevoke(f cmdutils.Factory) *cobra.Command {
 				}
 
 				fmt.Fprintln(f.IO().StdOut, c.GreenCheck(), "Merge request approval revoked.")
+
+				if message != "" {
+					_, _, err := client.Notes.CreateMergeRequestNote(repo.FullName(), mr.IID, &gitlab.CreateMergeRequestNoteOptions{Body: gitlab.Ptr(message)})
+					if err != nil {
+						return cmdutils.WrapError(err, fmt.Sprintf("approval of merge request !%d was revoked, but the comment couldn't be added.", mr.IID))
+					}
+				}
 			}
 
 			return nil
 		},
 	}
 
+	mrRevokeCmd.Flags().StringP("message", "m", "", "Add a comment to the merge requests with the revocation.")
+
 	return mrRevokeCmd
 }
@@ -149,3 +149,26 @@ func TestMrRevokeDraft(t *testing.T) {
 		`), output.String())
 	assert.Empty(t, output.Stderr())
 }
+
+func TestMrRevokeWithMessage(t *testing.T) {
+	t.Parallel()
+
+	tc := gitlabtesting.NewTestClient(t)
+	tc.MockMergeRequests.EXPECT().
+		GetMergeRequest("OWNER/REPO", int64(123), gomock.Any(), gomock.Any()).
+		Return(&gitlab.MergeRequest{BasicMergeRequest: gitlab.BasicMergeRequest{IID: 123, State: "opened"}}, nil, nil)
+	tc.MockMergeRequestApprovals.EXPECT().
+		UnapproveMergeRequest("OWNER/REPO", int64(123), gomock.Any()).
+		Return(nil, nil)
+	tc.MockNotes.EXPECT().
+		CreateMergeRequestNote("OWNER/REPO", int64(123), &gitlab.CreateMergeRequestNoteOptions{Body: gitlab.Ptr("Breaks the API.")}).
+		Return(&gitlab.Note{}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdRevoke, false,
+		cmdtest.WithGitLabClient(tc.Client),
+		cmdtest.WithBaseRepo("OWNER", "REPO", glinstance.DefaultHostname),
+	)
+
+	_, err := exec(`123 --message "Breaks the API."`)
+	require.NoError(t, err)
+}
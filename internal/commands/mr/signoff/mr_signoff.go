@@ -0,0 +1,122 @@
+package signoff
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/mr/mrutils"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+// trailerKeys maps the values of --type to the keys of the trailers.
+var trailerKeys = map[string]string{
+	"reviewed": "Reviewed-by",
+	"acked":    "Acked-by",
+	"tested":   "Tested-by",
+}
+
+type options struct {
+	args        []string
+	trailerType string
+
+	io      *iostreams.IOStreams
+	factory cmdutils.Factory
+}
+
+func NewCmdSignoff(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:      f.IO(),
+		factory: f,
+	}
+
+	mrSignoffCmd := &cobra.Command{
+		Use:   "signoff [<id> | <branch>] [flags]",
+		Short: `Record your review of a merge request as a Git trailer.`,
+		Long: heredoc.Doc(`
+			Record your review of a merge request as a comment with a Git trailer, like
+			"Reviewed-by: Jane Doe <jane@example.com>", with your name and public email.
+
+			When the merge request is merged with glab mr merge, the trailers are added to
+			the merge or squash commit message, to keep the provenance of the review in the
+			history of the repository. A comment counts as a sign-off only when the name in
+			the trailer is the name of the author of the comment.
+		`),
+		Example: heredoc.Doc(`
+			$ glab mr signoff 123
+			$ glab mr signoff 123 --type acked
+			$ glab mr signoff feature-branch --type tested
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.args = args
+
+			return opts.run()
+		},
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+	}
+
+	mrSignoffCmd.Flags().VarP(cmdutils.NewEnumValue([]string{"reviewed", "acked", "tested"}, "reviewed", &opts.trailerType), "type", "t", "Type of the sign-off: reviewed (Reviewed-by), acked (Acked-by), tested (Tested-by).")
+
+	return mrSignoffCmd
+}
+
+func (o *options) run() error {
+	c := o.io.Color()
+	client, err := o.factory.GitLabClient()
+	if err != nil {
+		return err
+	}
+
+	mr, repo, err := mrutils.MRFromArgs(o.factory, o.args, "opened")
+	if err != nil {
+		return err
+	}
+
+	user, _, err := client.Users.CurrentUser()
+	if err != nil {
+		return err
+	}
+	email := signoffEmail(user)
+	if email == "" {
+		return errors.New("your GitLab account has no email address to sign off with.")
+	}
+	trailer := mrutils.SignoffTrailer(trailerKeys[o.trailerType], user.Name, email)
+
+	trailers, err := mrutils.ListSignoffTrailers(client, repo.FullName(), mr.IID)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to get the comments of the merge request.")
+	}
+	if slices.Contains(trailers, trailer) {
+		fmt.Fprintf(o.io.StdErr, "%s You have already signed off merge request !%d: %s\n", c.WarnIcon(), mr.IID, trailer)
+		return nil
+	}
+
+	_, _, err = client.Notes.CreateMergeRequestNote(repo.FullName(), mr.IID, &gitlab.CreateMergeRequestNoteOptions{
+		Body: gitlab.Ptr(trailer),
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to add the sign-off comment.")
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Signed off merge request !%d: %s\n", c.GreenCheck(), mr.IID, trailer)
+	return nil
+}
+
+// signoffEmail returns the public email of the user, which ends up in the
+// history of the repository, or their primary email.
+func signoffEmail(user *gitlab.User) string {
+	if user.PublicEmail != "" {
+		return user.PublicEmail
+	}
+	return user.Email
+}
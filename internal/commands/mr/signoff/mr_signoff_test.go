@@ -0,0 +1,62 @@
+//go:build !integration
+
+package signoff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func mockSignoffMR(tc *gitlabtesting.TestClient, notes []*gitlab.Note) {
+	tc.MockMergeRequests.EXPECT().
+		GetMergeRequest("OWNER/REPO", int64(123), gomock.Any()).
+		Return(&gitlab.MergeRequest{BasicMergeRequest: gitlab.BasicMergeRequest{IID: 123, State: "opened"}}, nil, nil)
+	tc.MockUsers.EXPECT().
+		CurrentUser().
+		Return(&gitlab.User{Name: "Jane Doe", Email: "jane@corp.example.com", PublicEmail: "jane@example.com"}, nil, nil)
+	tc.MockNotes.EXPECT().
+		ListMergeRequestNotes("OWNER/REPO", int64(123), gomock.Any(), gomock.Any()).
+		Return(notes, &gitlab.Response{}, nil)
+}
+
+func TestMrSignoff(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	testClient := gitlabtesting.NewTestClient(t)
+	mockSignoffMR(testClient, []*gitlab.Note{
+		{Body: "Reviewed-by: Jane Doe <jane@example.com>", Author: gitlab.NoteAuthor{Name: "Jane Doe"}},
+	})
+	testClient.MockNotes.EXPECT().
+		CreateMergeRequestNote("OWNER/REPO", int64(123), &gitlab.CreateMergeRequestNoteOptions{
+			Body: gitlab.Ptr("Acked-by: Jane Doe <jane@example.com>"),
+		}).
+		Return(&gitlab.Note{}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdSignoff, false, cmdtest.WithGitLabClient(testClient.Client))
+	out, err := exec("123 --type acked")
+	require.NoError(t, err)
+	assert.Equal(t, "✓ Signed off merge request !123: Acked-by: Jane Doe <jane@example.com>\n", out.String())
+}
+
+func TestMrSignoffAlreadySignedOff(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	testClient := gitlabtesting.NewTestClient(t)
+	mockSignoffMR(testClient, []*gitlab.Note{
+		{Body: "Reviewed-by: Jane Doe <jane@example.com>\n", Author: gitlab.NoteAuthor{Name: "Jane Doe"}},
+	})
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdSignoff, false, cmdtest.WithGitLabClient(testClient.Client))
+	out, err := exec("123")
+	require.NoError(t, err)
+	assert.Empty(t, out.String())
+	assert.Equal(t, "! You have already signed off merge request !123: Reviewed-by: Jane Doe <jane@example.com>\n", out.Stderr())
+}
@@ -49,6 +49,10 @@ func NewCmdUpdate(f cmdutils.Factory) *cobra.Command {
 			var ur *cmdutils.UserAssignments // reviewers
 			c := f.IO().Color()
 
+			if err := cmdutils.ApplyBodyFile(cmd, f.IO(), "description"); err != nil {
+				return err
+			}
+
 			// Check for autofill flags
 			autofill, _ := cmd.Flags().GetBool("fill")
 			fillCommitBody, _ := cmd.Flags().GetBool("fill-commit-body")
@@ -323,6 +327,7 @@ func NewCmdUpdate(f cmdutils.Factory) *cobra.Command {
 	mrUpdateCmd.Flags().BoolP("lock-discussion", "", false, "Lock discussion on merge request.")
 	mrUpdateCmd.Flags().BoolP("unlock-discussion", "", false, "Unlock discussion on merge request.")
 	mrUpdateCmd.Flags().StringP("description", "d", "", "Merge request description. Set to \"-\" to open an editor.")
+	cmdutils.AddBodyFileFlag(mrUpdateCmd, "description")
 	mrUpdateCmd.Flags().StringSliceP("label", "l", []string{}, "Add labels.")
 	mrUpdateCmd.Flags().StringSliceP("unlabel", "u", []string{}, "Remove labels.")
 	mrUpdateCmd.Flags().
@@ -18,6 +18,7 @@ import (
 	"gitlab.com/gitlab-org/cli/internal/config"
 	"gitlab.com/gitlab-org/cli/internal/iostreams"
 	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/text"
 	"gitlab.com/gitlab-org/cli/internal/utils"
 )
 
@@ -75,7 +76,7 @@ func NewCmdView(f cmdutils.Factory) *cobra.Command {
 
 	mrViewCmd.Flags().BoolVarP(&opts.showComments, "comments", "c", false, "Show merge request comments and activities.")
 	mrViewCmd.Flags().BoolVarP(&opts.showSystemLogs, "system-logs", "s", false, "Show system activities and logs.")
-	mrViewCmd.Flags().StringVarP(&opts.outputFormat, "output", "F", "text", "Format output as: text, json.")
+	mrViewCmd.Flags().StringVarP(&opts.outputFormat, "output", "F", "text", "Format output as: text, json, slack.")
 	mrViewCmd.Flags().BoolVarP(&opts.openInBrowser, "web", "w", false, "Open merge request in a browser. Uses default browser or browser specified in BROWSER variable.")
 	mrViewCmd.Flags().IntVarP(&opts.commentPageNujmber, "page", "p", 0, "Page number.")
 	mrViewCmd.Flags().IntVarP(&opts.commentLimit, "per-page", "P", 20, "Number of items to list per page.")
@@ -146,6 +147,8 @@ func (o *options) run(f cmdutils.Factory, args []string) error {
 	switch {
 	case o.outputFormat == "json":
 		printJSONMR(o, mr, notes)
+	case o.outputFormat == "slack":
+		fmt.Fprint(o.io.StdOut, slackMRPreview(mr))
 	case o.io.IsOutputTTY():
 		printTTYMRPreview(o, mr, mrApprovals, notes)
 	default:
@@ -329,6 +332,41 @@ func rawMRPreview(opts *options, mr *gitlab.MergeRequest, notes []*gitlab.Note)
 	return out
 }
 
+// slackMRPreview returns the merge request in Slack mrkdwn, for chat bots to post.
+func slackMRPreview(mr *gitlab.MergeRequest) string {
+	var b strings.Builder
+	b.WriteString(text.SlackBold(text.SlackLink(mr.WebURL, fmt.Sprintf("!%d %s", mr.IID, mr.Title))) + "\n")
+
+	state := mr.State
+	if state == "opened" {
+		state = "open"
+	}
+	fmt.Fprintf(&b, "*State:* %s · *Author:* @%s · *Comments:* %d\n", state, mr.Author.Username, mr.UserNotesCount)
+	fmt.Fprintf(&b, "*Branch:* `%s` → `%s`\n", text.SlackEscape(mr.SourceBranch), text.SlackEscape(mr.TargetBranch))
+	if mr.HeadPipeline != nil {
+		fmt.Fprintf(&b, "*Pipeline:* %s\n", text.SlackLink(mr.HeadPipeline.WebURL, mr.HeadPipeline.Status))
+	}
+	if labels := labelsList(mr); labels != "" {
+		fmt.Fprintf(&b, "*Labels:* %s\n", text.SlackEscape(labels))
+	}
+	for _, field := range []struct {
+		name  string
+		users []*gitlab.BasicUser
+	}{{"Assignees", mr.Assignees}, {"Reviewers", mr.Reviewers}} {
+		if len(field.users) > 0 {
+			names := utils.Map(field.users, func(u *gitlab.BasicUser) string { return "@" + u.Username })
+			fmt.Fprintf(&b, "*%s:* %s\n", field.name, strings.Join(names, ", "))
+		}
+	}
+	if mr.Milestone != nil {
+		fmt.Fprintf(&b, "*Milestone:* %s\n", text.SlackEscape(mr.Milestone.Title))
+	}
+	if mr.Description != "" {
+		b.WriteString(text.SlackQuote(mr.Description, text.SlackPreviewLines))
+	}
+	return b.String()
+}
+
 func printJSONMR(opts *options, mr *gitlab.MergeRequest, notes []*gitlab.Note) {
 	if opts.showComments {
 		extendedMR := MRWithNotes{mr, notes}
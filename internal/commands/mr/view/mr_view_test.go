@@ -611,3 +611,31 @@ func Test_printTTYMRPreview_closedMRWithNilClosedBy(t *testing.T) {
 	assert.Contains(t, output, "Closed")
 	assert.NotContains(t, output, "Closed by:")
 }
+
+func Test_slackMRPreview(t *testing.T) {
+	mr := &gitlab.MergeRequest{
+		BasicMergeRequest: gitlab.BasicMergeRequest{
+			IID:            3,
+			Title:          "Add a flag",
+			State:          "opened",
+			SourceBranch:   "feature",
+			TargetBranch:   "main",
+			Author:         &gitlab.BasicUser{Username: "alice"},
+			Reviewers:      []*gitlab.BasicUser{{Username: "bob"}, {Username: "carol"}},
+			Labels:         gitlab.Labels{"feature"},
+			UserNotesCount: 2,
+			Description:    "Adds --flag.\n\nCloses #1",
+			WebURL:         "https://gitlab.com/OWNER/REPO/-/merge_requests/3",
+		},
+		HeadPipeline: &gitlab.Pipeline{Status: "success", WebURL: "https://gitlab.com/OWNER/REPO/-/pipelines/9"},
+	}
+
+	want := "*<https://gitlab.com/OWNER/REPO/-/merge_requests/3|!3 Add a flag>*\n" +
+		"*State:* open · *Author:* @alice · *Comments:* 2\n" +
+		"*Branch:* `feature` → `main`\n" +
+		"*Pipeline:* <https://gitlab.com/OWNER/REPO/-/pipelines/9|success>\n" +
+		"*Labels:* feature\n" +
+		"*Reviewers:* @bob, @carol\n" +
+		"> Adds --flag.\n> \n> Closes #1\n"
+	assert.Equal(t, want, slackMRPreview(mr))
+}
@@ -0,0 +1,27 @@
+package oncall
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	oncallPoliciesCmd "gitlab.com/gitlab-org/cli/internal/commands/oncall/policies"
+	oncallWhoCmd "gitlab.com/gitlab-org/cli/internal/commands/oncall/who"
+)
+
+func NewCmdOncall(f cmdutils.Factory) *cobra.Command {
+	oncallCmd := &cobra.Command{
+		Use:   "oncall <command> [flags]",
+		Short: `View on-call schedules and escalation policies of a project.`,
+		Long: heredoc.Doc(`
+		On-call schedules and escalation policies define who is paged when
+		an incident is created. Use them together with 'glab incident'.
+		`),
+	}
+
+	cmdutils.EnableRepoOverride(oncallCmd, f)
+
+	oncallCmd.AddCommand(oncallWhoCmd.NewCmdWho(f))
+	oncallCmd.AddCommand(oncallPoliciesCmd.NewCmdPolicies(f))
+	return oncallCmd
+}
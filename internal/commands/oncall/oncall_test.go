@@ -0,0 +1,27 @@
+//go:build !integration
+
+package oncall
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestNewCmdOncall(t *testing.T) {
+	ios, _, _, _ := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios)
+
+	cmd := NewCmdOncall(factory)
+
+	assert.Equal(t, "oncall <command> [flags]", cmd.Use)
+	assert.True(t, cmd.HasSubCommands())
+
+	subcommandNames := make([]string, 0, len(cmd.Commands()))
+	for _, subcmd := range cmd.Commands() {
+		subcommandNames = append(subcommandNames, subcmd.Name())
+	}
+	assert.ElementsMatch(t, []string{"who", "policies"}, subcommandNames)
+}
@@ -0,0 +1,115 @@
+package policies
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+)
+
+type options struct {
+	outputFormat string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdPolicies(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	oncallPoliciesCmd := &cobra.Command{
+		Use:     "policies [flags]",
+		Short:   `List the escalation policies of a project.`,
+		Aliases: []string{"policy"},
+		Args:    cobra.NoArgs,
+		Example: heredoc.Doc(`
+			$ glab oncall policies
+			$ glab oncall policies -R group/project -F json
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+
+	oncallPoliciesCmd.Flags().VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+
+	return oncallPoliciesCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	policies, err := api.ListEscalationPolicies(client, repo.FullName())
+	if err != nil {
+		return err
+	}
+
+	if o.outputFormat == "json" {
+		policiesJSON, _ := json.Marshal(policies)
+		fmt.Fprintln(o.io.StdOut, string(policiesJSON))
+		return nil
+	}
+
+	if len(policies) == 0 {
+		o.io.LogInfof("No escalation policies found for %s.\n", repo.FullName())
+		return nil
+	}
+
+	c := o.io.Color()
+	for i, p := range policies {
+		if i > 0 {
+			fmt.Fprintln(o.io.StdOut)
+		}
+		fmt.Fprintf(o.io.StdOut, "%s %s\n", c.Bold(p.Name), c.Gray(fmt.Sprintf("(ID %d)", p.NumericID())))
+		if p.Description != "" {
+			fmt.Fprintln(o.io.StdOut, p.Description)
+		}
+
+		table := tableprinter.New(o.io)
+		table.AddHeader("AFTER", "IF NOT", "NOTIFY")
+		for _, r := range p.Rules {
+			table.AddRow(time.Duration(r.ElapsedTimeSeconds)*time.Second, strings.ToLower(r.Status), formatTarget(r))
+		}
+		fmt.Fprint(o.io.StdOut, table.String())
+	}
+
+	return nil
+}
+
+func formatTarget(r api.EscalationRule) string {
+	switch {
+	case r.OncallSchedule != nil:
+		return fmt.Sprintf("schedule %q", r.OncallSchedule.Name)
+	case r.User != nil:
+		return "@" + r.User.Username
+	default:
+		return "-"
+	}
+}
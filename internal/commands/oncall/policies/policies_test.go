@@ -0,0 +1,100 @@
+//go:build !integration
+
+package policies
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+const policiesResponse = `{"data": {"project": {"incidentManagementEscalationPolicies": {"nodes": [
+	{"id": "gid://gitlab/IncidentManagement::EscalationPolicy/7", "name": "Default", "description": "Page the primary schedule first", "rules": [
+		{"status": "ACKNOWLEDGED", "elapsedTimeSeconds": 0, "oncallSchedule": {"iid": "1", "name": "Primary"}},
+		{"status": "RESOLVED", "elapsedTimeSeconds": 1800, "user": {"username": "bob", "name": "Bob"}}
+	]}
+]}}}}`
+
+func Test_OncallPolicies(t *testing.T) {
+	type testCase struct {
+		name        string
+		cli         string
+		response    string
+		expectedMsg []string
+		wantErr     bool
+		wantStderr  string
+	}
+
+	testCases := []testCase{
+		{
+			name:     "List escalation policies with their rules",
+			cli:      "",
+			response: policiesResponse,
+			expectedMsg: []string{
+				"Default (ID 7)",
+				"Page the primary schedule first",
+				"AFTER",
+				"acknowledged",
+				`schedule "Primary"`,
+				"30m0s",
+				"resolved",
+				"@bob",
+			},
+		},
+		{
+			name:        "Output as JSON",
+			cli:         "--output json",
+			response:    policiesResponse,
+			expectedMsg: []string{`"id":"gid://gitlab/IncidentManagement::EscalationPolicy/7"`, `"elapsedTimeSeconds":1800`},
+		},
+		{
+			name:        "Project without escalation policies",
+			cli:         "",
+			response:    `{"data": {"project": {"incidentManagementEscalationPolicies": {"nodes": []}}}}`,
+			expectedMsg: []string{"No escalation policies found for OWNER/REPO."},
+		},
+		{
+			name:       "Project not found",
+			cli:        "",
+			response:   `{"data": {"project": null}}`,
+			wantErr:    true,
+			wantStderr: `project "OWNER/REPO" not found.`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// GIVEN
+			testClient := gitlabtesting.NewTestClient(t)
+			testClient.MockGraphQL.EXPECT().
+				Do(gomock.Any(), gomock.Any()).
+				DoAndReturn(cmdtest.GraphQLResponse(tc.response))
+			exec := cmdtest.SetupCmdForTest(
+				t,
+				NewCmdPolicies,
+				false,
+				cmdtest.WithGitLabClient(testClient.Client),
+			)
+
+			// WHEN
+			out, err := exec(tc.cli)
+
+			// THEN
+			if tc.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantStderr)
+				return
+			}
+			require.NoError(t, err)
+			for _, msg := range tc.expectedMsg {
+				assert.Contains(t, out.String(), msg)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package who
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+)
+
+type options struct {
+	schedule     string
+	outputFormat string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdWho(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	oncallWhoCmd := &cobra.Command{
+		Use:   "who [flags]",
+		Short: `Show who is currently on call.`,
+		Args:  cobra.NoArgs,
+		Example: heredoc.Doc(`
+			# Show who is on call for each schedule of the current project
+			$ glab oncall who
+
+			# Show who is on call for a schedule of another project
+			$ glab oncall who --schedule "Primary" -R group/project
+
+			# Print only the usernames, for use in scripts
+			$ glab oncall who -F json | jq -r '.[].oncallUsers[].username'
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+
+	fl := oncallWhoCmd.Flags()
+	fl.StringVarP(&opts.schedule, "schedule", "s", "", "Only show the schedule with this name or IID.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+
+	return oncallWhoCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	schedules, err := api.ListOncallSchedules(client, repo.FullName())
+	if err != nil {
+		return err
+	}
+
+	if o.schedule != "" {
+		var filtered []*api.OncallSchedule
+		for _, s := range schedules {
+			if strings.EqualFold(s.Name, o.schedule) || s.IID == o.schedule {
+				filtered = append(filtered, s)
+			}
+		}
+		if len(filtered) == 0 {
+			return fmt.Errorf("on-call schedule %q not found in %s.", o.schedule, repo.FullName())
+		}
+		schedules = filtered
+	}
+
+	if o.outputFormat == "json" {
+		schedulesJSON, _ := json.Marshal(schedules)
+		fmt.Fprintln(o.io.StdOut, string(schedulesJSON))
+		return nil
+	}
+
+	if len(schedules) == 0 {
+		o.io.LogInfof("No on-call schedules found for %s.\n", repo.FullName())
+		return nil
+	}
+
+	c := o.io.Color()
+	table := tableprinter.New(o.io)
+	table.AddHeader("SCHEDULE", "TIMEZONE", "ON CALL")
+	for _, s := range schedules {
+		table.AddRow(s.Name, s.Timezone, formatUsers(c, s.OncallUsers))
+	}
+	fmt.Fprint(o.io.StdOut, table.String())
+
+	return nil
+}
+
+func formatUsers(c *iostreams.ColorPalette, users []api.OncallUser) string {
+	if len(users) == 0 {
+		return c.Gray("nobody")
+	}
+
+	names := make([]string, 0, len(users))
+	for _, u := range users {
+		names = append(names, fmt.Sprintf("@%s (%s)", u.Username, u.Name))
+	}
+	return strings.Join(names, ", ")
+}
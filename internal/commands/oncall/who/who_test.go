@@ -0,0 +1,113 @@
+//go:build !integration
+
+package who
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+const schedulesResponse = `{"data": {"project": {"incidentManagementOncallSchedules": {"nodes": [
+	{"iid": "1", "name": "Primary", "timezone": "Europe/Berlin", "oncallUsers": [{"username": "alice", "name": "Alice"}]},
+	{"iid": "2", "name": "Secondary", "timezone": "UTC", "oncallUsers": []}
+]}}}}`
+
+func Test_OncallWho(t *testing.T) {
+	type testCase struct {
+		name          string
+		cli           string
+		response      string
+		expectedMsg   []string
+		unexpectedMsg []string
+		wantErr       bool
+		wantStderr    string
+	}
+
+	testCases := []testCase{
+		{
+			name:     "List who is on call for all schedules",
+			cli:      "",
+			response: schedulesResponse,
+			expectedMsg: []string{
+				"SCHEDULE",
+				"Primary",
+				"@alice (Alice)",
+				"Secondary",
+				"nobody",
+			},
+		},
+		{
+			name:          "Filter schedules by name",
+			cli:           "--schedule primary",
+			response:      schedulesResponse,
+			expectedMsg:   []string{"Primary", "@alice (Alice)"},
+			unexpectedMsg: []string{"Secondary"},
+		},
+		{
+			name:          "Filter schedules by IID",
+			cli:           "-s 2",
+			response:      schedulesResponse,
+			expectedMsg:   []string{"Secondary"},
+			unexpectedMsg: []string{"Primary"},
+		},
+		{
+			name:       "Schedule filter without a match",
+			cli:        "--schedule Tertiary",
+			response:   schedulesResponse,
+			wantErr:    true,
+			wantStderr: `on-call schedule "Tertiary" not found in OWNER/REPO.`,
+		},
+		{
+			name:        "Output as JSON",
+			cli:         "-F json",
+			response:    schedulesResponse,
+			expectedMsg: []string{`"username":"alice"`, `"name":"Secondary"`},
+		},
+		{
+			name:        "Project without schedules",
+			cli:         "",
+			response:    `{"data": {"project": {"incidentManagementOncallSchedules": {"nodes": []}}}}`,
+			expectedMsg: []string{"No on-call schedules found for OWNER/REPO."},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// GIVEN
+			testClient := gitlabtesting.NewTestClient(t)
+			testClient.MockGraphQL.EXPECT().
+				Do(gomock.Any(), gomock.Any()).
+				DoAndReturn(cmdtest.GraphQLResponse(tc.response))
+			exec := cmdtest.SetupCmdForTest(
+				t,
+				NewCmdWho,
+				false,
+				cmdtest.WithGitLabClient(testClient.Client),
+			)
+
+			// WHEN
+			out, err := exec(tc.cli)
+
+			// THEN
+			if tc.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantStderr)
+				return
+			}
+			require.NoError(t, err)
+			for _, msg := range tc.expectedMsg {
+				assert.Contains(t, out.String(), msg)
+			}
+			for _, msg := range tc.unexpectedMsg {
+				assert.NotContains(t, out.String(), msg)
+			}
+		})
+	}
+}
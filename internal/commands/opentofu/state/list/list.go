@@ -58,9 +58,8 @@ func (o *options) run() error {
 		return err
 	}
 
-	c := o.io.Color()
-	table := tableprinter.NewTablePrinter()
-	table.AddRow(c.Bold("Name"), c.Bold("Latest Version Serial"), c.Bold("Created At"), c.Bold("Updated At"), c.Bold("Locked At"))
+	table := tableprinter.New(o.io)
+	table.AddHeader("Name", "Latest Version Serial", "Created At", "Updated At", "Locked At")
 	for _, state := range states {
 		table.AddRow(state.Name, state.LatestVersion.Serial, state.CreatedAt, state.UpdatedAt, state.LockedAt)
 	}
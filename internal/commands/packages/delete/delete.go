@@ -0,0 +1,100 @@
+package delete
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/packages/packageutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	name        string
+	version     string
+	packageType string
+	yes         bool
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdDelete(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	packageDeleteCmd := &cobra.Command{
+		Use:   "delete <name> <version> [flags]",
+		Short: `Delete a version of a package.`,
+		Long:  "Delete a version of a package, with all its files.",
+		Args:  cobra.ExactArgs(2),
+		Example: heredoc.Doc(`
+			$ glab package delete my-tool 1.2.0
+			$ glab package delete @my-scope/my-lib 2.0.1 --type npm --yes
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.name = args[0]
+			opts.version = args[1]
+
+			if !opts.yes && !opts.io.PromptEnabled() {
+				return &cmdutils.FlagError{Err: errors.New("--yes or -y flag is required when not running interactively.")}
+			}
+
+			return opts.run(cmd.Context())
+		},
+	}
+
+	fl := packageDeleteCmd.Flags()
+	fl.Var(cmdutils.NewEnumValue(packageutils.PackageTypes, "", &opts.packageType), "type", "Type of the package, when packages of several types have the name.")
+	fl.BoolVarP(&opts.yes, "yes", "y", false, "Skip the confirmation prompt.")
+
+	return packageDeleteCmd
+}
+
+func (o *options) run(ctx context.Context) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	pkg, err := packageutils.FindPackage(client, repo.FullName(), o.name, o.version, o.packageType)
+	if err != nil {
+		return err
+	}
+
+	if !o.yes {
+		err = o.io.Confirm(ctx, &o.yes, fmt.Sprintf("Delete version %s of the %s package %s?", pkg.Version, pkg.PackageType, pkg.Name))
+		if err != nil {
+			return cmdutils.WrapError(err, "could not prompt")
+		}
+		if !o.yes {
+			return cmdutils.CancelError()
+		}
+	}
+
+	if _, err := client.Packages.DeleteProjectPackage(repo.FullName(), pkg.ID); err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to delete package %s %s.", pkg.Name, pkg.Version))
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Deleted package %s %s.\n", o.io.Color().RedCheck(), pkg.Name, pkg.Version)
+	return nil
+}
@@ -0,0 +1,57 @@
+//go:build !integration
+
+package delete
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_PackageDelete(t *testing.T) {
+	testCases := []struct {
+		name    string
+		cli     string
+		wantOut string
+		wantErr string
+	}{
+		{
+			name:    "Delete a package",
+			cli:     "my-tool 1.2.0 --yes",
+			wantOut: "✓ Deleted package my-tool 1.2.0.\n",
+		},
+		{
+			name:    "Requires --yes",
+			cli:     "my-tool 1.2.0",
+			wantErr: "--yes or -y flag is required when not running interactively.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			if tc.wantOut != "" {
+				testClient.MockPackages.EXPECT().ListProjectPackages("OWNER/REPO", gomock.Any(), gomock.Any()).
+					Return([]*gitlab.Package{{ID: 12, Name: "my-tool", Version: "1.2.0", PackageType: "generic"}}, &gitlab.Response{}, nil)
+				testClient.MockPackages.EXPECT().DeleteProjectPackage("OWNER/REPO", int64(12)).Return(nil, nil)
+			}
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdDelete, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			out, err := exec(tc.cli)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantOut, out.String())
+		})
+	}
+}
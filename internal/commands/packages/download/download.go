@@ -0,0 +1,193 @@
+package download
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/packages/packageutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+// downloadableTypes are the package types with an API to download their files.
+var downloadableTypes = []string{"generic", "maven", "npm", "pypi"}
+
+type options struct {
+	name        string
+	version     string
+	packageType string
+	files       []string
+	dir         string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdDownload(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	packageDownloadCmd := &cobra.Command{
+		Use:   "download <name> <version> [flags]",
+		Short: `Download the files of a package.`,
+		Long: heredoc.Docf(`
+			Download the files of a version of a package into a directory. Files of %s
+			packages can be downloaded.
+		`, strings.Join(downloadableTypes, ", ")),
+		Args: cobra.ExactArgs(2),
+		Example: heredoc.Doc(`
+			# Download all files of a package
+			$ glab package download my-tool 1.2.0
+
+			# Download one file of a package into the dist directory
+			$ glab package download my-tool 1.2.0 --file my-tool-linux-amd64.tar.gz --dir dist
+
+			# Download the npm package when a generic package has the same name
+			$ glab package download @my-scope/my-lib 2.0.1 --type npm
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.name = args[0]
+			opts.version = args[1]
+			return opts.run()
+		},
+	}
+
+	fl := packageDownloadCmd.Flags()
+	fl.Var(cmdutils.NewEnumValue(packageutils.PackageTypes, "", &opts.packageType), "type", "Type of the package, when packages of several types have the name.")
+	fl.StringSliceVarP(&opts.files, "file", "f", nil, "Only download the files with these names.")
+	fl.StringVarP(&opts.dir, "dir", "D", ".", "Directory to download the files to.")
+
+	return packageDownloadCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	pkg, err := packageutils.FindPackage(client, repo.FullName(), o.name, o.version, o.packageType)
+	if err != nil {
+		return err
+	}
+
+	files, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.PackageFile, *gitlab.Response, error) {
+		return client.Packages.ListPackageFiles(repo.FullName(), pkg.ID, &gitlab.ListPackageFilesOptions{ListOptions: gitlab.ListOptions{PerPage: 100}}, p)
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to list the files of the package.")
+	}
+	files, err = o.selectFiles(files)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(o.dir, 0o755); err != nil {
+		return err
+	}
+
+	c := o.io.Color()
+	for _, file := range files {
+		path, err := filePath(repo.FullName(), pkg, file)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(o.dir, filepath.Base(file.FileName))
+		if err := downloadFile(client, path, target); err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to download %s.", file.FileName))
+		}
+		fmt.Fprintf(o.io.StdOut, "%s Downloaded %s\n", c.GreenCheck(), target)
+	}
+
+	return nil
+}
+
+// selectFiles returns the files that were asked for. Packages can have several files
+// with the same name, when a file is published again, so only the latest is kept.
+func (o *options) selectFiles(files []*gitlab.PackageFile) ([]*gitlab.PackageFile, error) {
+	latest := map[string]*gitlab.PackageFile{}
+	var names []string
+	for _, f := range files {
+		if prev, ok := latest[f.FileName]; !ok {
+			names = append(names, f.FileName)
+		} else if prev.ID > f.ID {
+			continue
+		}
+		latest[f.FileName] = f
+	}
+
+	if len(o.files) == 0 {
+		selected := make([]*gitlab.PackageFile, 0, len(names))
+		for _, name := range names {
+			selected = append(selected, latest[name])
+		}
+		return selected, nil
+	}
+
+	var selected []*gitlab.PackageFile
+	for _, name := range o.files {
+		f, ok := latest[name]
+		if !ok {
+			return nil, fmt.Errorf("package %s %s has no file %s. Files: %s.", o.name, o.version, name, strings.Join(names, ", "))
+		}
+		selected = append(selected, f)
+	}
+	return selected, nil
+}
+
+// filePath returns the API path to download a file of a package.
+func filePath(repo string, pkg *gitlab.Package, file *gitlab.PackageFile) (string, error) {
+	project := gitlab.PathEscape(repo)
+	switch pkg.PackageType {
+	case "generic":
+		return fmt.Sprintf("projects/%s/packages/generic/%s/%s/%s", project, gitlab.PathEscape(pkg.Name), gitlab.PathEscape(pkg.Version), gitlab.PathEscape(file.FileName)), nil
+	case "maven":
+		// Maven package names are paths, like com/example/my-app.
+		return fmt.Sprintf("projects/%s/packages/maven/%s/%s/%s", project, pkg.Name, gitlab.PathEscape(pkg.Version), gitlab.PathEscape(file.FileName)), nil
+	case "npm":
+		return fmt.Sprintf("projects/%s/packages/npm/%s/-/%s", project, pkg.Name, gitlab.PathEscape(file.FileName)), nil
+	case "pypi":
+		return fmt.Sprintf("projects/%s/packages/pypi/files/%s/%s", project, file.FileSHA256, gitlab.PathEscape(file.FileName)), nil
+	}
+	return "", fmt.Errorf("cannot download %s packages. Files of %s packages can be downloaded.", pkg.PackageType, strings.Join(downloadableTypes, ", "))
+}
+
+func downloadFile(client *gitlab.Client, path, target string) error {
+	req, err := client.NewRequest(http.MethodGet, path, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(target)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if _, err := client.Do(req, f); err != nil {
+		os.Remove(target)
+		return err
+	}
+	return f.Close()
+}
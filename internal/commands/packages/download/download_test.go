@@ -0,0 +1,117 @@
+//go:build !integration
+
+package download
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_PackageDownload(t *testing.T) {
+	testCases := []struct {
+		name      string
+		cli       string
+		pkg       *gitlab.Package
+		files     []*gitlab.PackageFile
+		wantPaths map[string]string
+		wantErr   string
+	}{
+		{
+			name: "Download all files of a generic package",
+			cli:  "my-tool 1.2.0",
+			pkg:  &gitlab.Package{ID: 12, Name: "my-tool", Version: "1.2.0", PackageType: "generic"},
+			files: []*gitlab.PackageFile{
+				{ID: 1, FileName: "my-tool.tar.gz"},
+				{ID: 2, FileName: "my-tool.zip"},
+			},
+			wantPaths: map[string]string{
+				"my-tool.tar.gz": "/api/v4/projects/OWNER%2FREPO/packages/generic/my-tool/1%2E2%2E0/my-tool%2Etar%2Egz",
+				"my-tool.zip":    "/api/v4/projects/OWNER%2FREPO/packages/generic/my-tool/1%2E2%2E0/my-tool%2Ezip",
+			},
+		},
+		{
+			name:  "Download one file of an npm package",
+			cli:   "@my-scope/lib 2.0.1 --file lib-2.0.1.tgz --type npm",
+			pkg:   &gitlab.Package{ID: 13, Name: "@my-scope/lib", Version: "2.0.1", PackageType: "npm"},
+			files: []*gitlab.PackageFile{{ID: 3, FileName: "lib-2.0.1.tgz"}, {ID: 4, FileName: "package.json"}},
+			wantPaths: map[string]string{
+				"lib-2.0.1.tgz": "/api/v4/projects/OWNER%2FREPO/packages/npm/@my-scope/lib/-/lib-2%2E0%2E1%2Etgz",
+			},
+		},
+		{
+			name:  "Download a PyPI package",
+			cli:   "my-lib 0.3.0",
+			pkg:   &gitlab.Package{ID: 14, Name: "my-lib", Version: "0.3.0", PackageType: "pypi"},
+			files: []*gitlab.PackageFile{{ID: 5, FileName: "my_lib-0.3.0.whl", FileSHA256: "abc123"}},
+			wantPaths: map[string]string{
+				"my_lib-0.3.0.whl": "/api/v4/projects/OWNER%2FREPO/packages/pypi/files/abc123/my_lib-0%2E3%2E0%2Ewhl",
+			},
+		},
+		{
+			name:    "Unknown file",
+			cli:     "my-tool 1.2.0 --file my-tool.exe",
+			pkg:     &gitlab.Package{ID: 12, Name: "my-tool", Version: "1.2.0", PackageType: "generic"},
+			files:   []*gitlab.PackageFile{{ID: 1, FileName: "my-tool.tar.gz"}},
+			wantErr: "package my-tool 1.2.0 has no file my-tool.exe. Files: my-tool.tar.gz.",
+		},
+		{
+			name:    "Unsupported type",
+			cli:     "my-chart 1.0.0",
+			pkg:     &gitlab.Package{ID: 15, Name: "my-chart", Version: "1.0.0", PackageType: "helm"},
+			files:   []*gitlab.PackageFile{{ID: 6, FileName: "my-chart-1.0.0.tgz"}},
+			wantErr: "cannot download helm packages. Files of generic, maven, npm, pypi packages can be downloaded.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			requested := map[string]string{}
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.EscapedPath() {
+				case "/api/v4/projects/OWNER%2FREPO/packages":
+					assert.Equal(t, tc.pkg.Name, r.URL.Query().Get("package_name"))
+					assert.Equal(t, tc.pkg.Version, r.URL.Query().Get("package_version"))
+					require.NoError(t, json.NewEncoder(w).Encode([]*gitlab.Package{tc.pkg}))
+				case fmt.Sprintf("/api/v4/projects/OWNER%%2FREPO/packages/%d/package_files", tc.pkg.ID):
+					require.NoError(t, json.NewEncoder(w).Encode(tc.files))
+				default:
+					requested[filepath.Base(r.URL.Path)] = r.URL.EscapedPath()
+					fmt.Fprint(w, "content of "+filepath.Base(r.URL.Path))
+				}
+			}))
+			t.Cleanup(srv.Close)
+
+			client, err := gitlab.NewClient("test-token", gitlab.WithBaseURL(srv.URL+"/api/v4"))
+			require.NoError(t, err)
+			dir := t.TempDir()
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdDownload, false, cmdtest.WithGitLabClient(client))
+
+			out, err := exec(tc.cli + " --dir " + dir)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantPaths, requested)
+			for name := range tc.wantPaths {
+				data, err := os.ReadFile(filepath.Join(dir, name))
+				require.NoError(t, err)
+				assert.Equal(t, "content of "+name, string(data))
+				assert.Contains(t, out.String(), "✓ Downloaded "+filepath.Join(dir, name))
+			}
+		})
+	}
+}
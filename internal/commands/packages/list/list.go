@@ -0,0 +1,177 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/packages/packageutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	group        string
+	packageType  string
+	name         string
+	page         int
+	perPage      int
+	outputFormat string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdList(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	packageListCmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   `List the packages of a project or group.`,
+		Long:    "List the packages in the package registry of a project or group, most recent first.",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Example: heredoc.Doc(`
+			# List the packages of the current project
+			$ glab package list
+
+			# List the npm packages of a group and its subgroups
+			$ glab package list --group my-group --type npm
+
+			# List the versions of a package as JSON
+			$ glab package list --name my-package -F json
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+
+	fl := packageListCmd.Flags()
+	fl.StringVarP(&opts.group, "group", "g", "", "List the packages of this group and its subgroups instead of the project.")
+	fl.Var(cmdutils.NewEnumValue(packageutils.PackageTypes, "", &opts.packageType), "type", fmt.Sprintf("Only list packages of this type: %s.", strings.Join(packageutils.PackageTypes, ", ")))
+	fl.StringVarP(&opts.name, "name", "n", "", "Only list packages with a name that contains this text.")
+	fl.IntVarP(&opts.page, "page", "p", 1, "Page number.")
+	fl.IntVarP(&opts.perPage, "per-page", "P", 30, "Number of packages to list per page.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+
+	return packageListCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	var packages any
+	var rows [][]any
+	var source string
+	c := o.io.Color()
+	if o.group != "" {
+		source = o.group
+		groupPackages, err := o.groupPackages(client)
+		if err != nil {
+			return cmdutils.WrapError(err, "failed to list packages.")
+		}
+		packages = groupPackages
+		for _, p := range groupPackages {
+			rows = append(rows, append(packageRow(c, &p.Package), p.ProjectPath))
+		}
+	} else {
+		repo, err := o.baseRepo()
+		if err != nil {
+			return err
+		}
+		source = repo.FullName()
+		projectPackages, err := o.projectPackages(client, source)
+		if err != nil {
+			return cmdutils.WrapError(err, "failed to list packages.")
+		}
+		packages = projectPackages
+		for _, p := range projectPackages {
+			rows = append(rows, packageRow(c, p))
+		}
+	}
+
+	if o.outputFormat == "json" {
+		packagesJSON, _ := json.Marshal(packages)
+		fmt.Fprintln(o.io.StdOut, string(packagesJSON))
+		return nil
+	}
+
+	if len(rows) == 0 {
+		o.io.LogInfof("No packages found for %s.\n", source)
+		return nil
+	}
+
+	table := tableprinter.New(o.io)
+	header := []any{"ID", "NAME", "VERSION", "TYPE", "CREATED"}
+	if o.group != "" {
+		header = append(header, "PROJECT")
+	}
+	table.AddHeader(header...)
+	for _, row := range rows {
+		table.AddRow(row...)
+	}
+	fmt.Fprint(o.io.StdOut, table.String())
+
+	return nil
+}
+
+func packageRow(c *iostreams.ColorPalette, p *gitlab.Package) []any {
+	created := ""
+	if p.CreatedAt != nil {
+		created = utils.TimeToPrettyTimeAgo(*p.CreatedAt)
+	}
+	return []any{p.ID, p.Name, p.Version, p.PackageType, c.Gray(created)}
+}
+
+func (o *options) projectPackages(client *gitlab.Client, repo string) ([]*gitlab.Package, error) {
+	listOpts := &gitlab.ListProjectPackagesOptions{
+		ListOptions: gitlab.ListOptions{Page: int64(o.page), PerPage: int64(o.perPage)},
+		OrderBy:     gitlab.Ptr("created_at"),
+		Sort:        gitlab.Ptr("desc"),
+	}
+	if o.packageType != "" {
+		listOpts.PackageType = gitlab.Ptr(o.packageType)
+	}
+	if o.name != "" {
+		listOpts.PackageName = gitlab.Ptr(o.name)
+	}
+
+	packages, _, err := client.Packages.ListProjectPackages(repo, listOpts)
+	return packages, err
+}
+
+func (o *options) groupPackages(client *gitlab.Client) ([]*gitlab.GroupPackage, error) {
+	listOpts := &gitlab.ListGroupPackagesOptions{
+		ListOptions: gitlab.ListOptions{Page: int64(o.page), PerPage: int64(o.perPage)},
+		OrderBy:     gitlab.Ptr("created_at"),
+		Sort:        gitlab.Ptr("desc"),
+	}
+	if o.packageType != "" {
+		listOpts.PackageType = gitlab.Ptr(o.packageType)
+	}
+	if o.name != "" {
+		listOpts.PackageName = gitlab.Ptr(o.name)
+	}
+
+	packages, _, err := client.Packages.ListGroupPackages(o.group, listOpts)
+	return packages, err
+}
@@ -0,0 +1,108 @@
+//go:build !integration
+
+package list
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_PackageList(t *testing.T) {
+	created := time.Now().Add(-2 * time.Hour)
+	pkg := gitlab.Package{ID: 12, Name: "my-tool", Version: "1.2.0", PackageType: "generic", CreatedAt: &created}
+
+	testCases := []struct {
+		name        string
+		cli         string
+		setupMock   func(tc *gitlabtesting.TestClient)
+		expectedMsg []string
+		wantErr     string
+	}{
+		{
+			name: "List project packages",
+			cli:  "",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockPackages.EXPECT().ListProjectPackages("OWNER/REPO", &gitlab.ListProjectPackagesOptions{
+					ListOptions: gitlab.ListOptions{Page: 1, PerPage: 30},
+					OrderBy:     gitlab.Ptr("created_at"),
+					Sort:        gitlab.Ptr("desc"),
+				}).Return([]*gitlab.Package{&pkg}, nil, nil)
+			},
+			expectedMsg: []string{"ID", "NAME", "VERSION", "TYPE", "CREATED", "12", "my-tool", "1.2.0", "generic", "about 2 hours ago"},
+		},
+		{
+			name: "List group packages by type and name",
+			cli:  "--group my-group --type npm --name lib",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockPackages.EXPECT().ListGroupPackages("my-group", &gitlab.ListGroupPackagesOptions{
+					ListOptions: gitlab.ListOptions{Page: 1, PerPage: 30},
+					OrderBy:     gitlab.Ptr("created_at"),
+					Sort:        gitlab.Ptr("desc"),
+					PackageType: gitlab.Ptr("npm"),
+					PackageName: gitlab.Ptr("lib"),
+				}).Return([]*gitlab.GroupPackage{{
+					Package:     gitlab.Package{ID: 13, Name: "@my-scope/lib", Version: "2.0.1", PackageType: "npm", CreatedAt: &created},
+					ProjectPath: "my-group/lib",
+				}}, nil, nil)
+			},
+			expectedMsg: []string{"PROJECT", "13", "@my-scope/lib", "2.0.1", "npm", "my-group/lib"},
+		},
+		{
+			name: "No packages",
+			cli:  "--type pypi",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockPackages.EXPECT().ListProjectPackages("OWNER/REPO", &gitlab.ListProjectPackagesOptions{
+					ListOptions: gitlab.ListOptions{Page: 1, PerPage: 30},
+					OrderBy:     gitlab.Ptr("created_at"),
+					Sort:        gitlab.Ptr("desc"),
+					PackageType: gitlab.Ptr("pypi"),
+				}).Return(nil, nil, nil)
+			},
+			expectedMsg: []string{"No packages found for OWNER/REPO."},
+		},
+		{
+			name: "JSON output",
+			cli:  "-F json",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockPackages.EXPECT().ListProjectPackages("OWNER/REPO", gomock.Any()).Return([]*gitlab.Package{&pkg}, nil, nil)
+			},
+			expectedMsg: []string{`"id":12`, `"package_type":"generic"`},
+		},
+		{
+			name:    "Invalid type",
+			cli:     "--type rubygem",
+			wantErr: "must be one of",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			if tc.setupMock != nil {
+				tc.setupMock(testClient)
+			}
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdList, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			out, err := exec(tc.cli)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			output := out.String() + out.Stderr()
+			for _, msg := range tc.expectedMsg {
+				assert.Contains(t, output, msg)
+			}
+		})
+	}
+}
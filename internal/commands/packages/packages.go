@@ -0,0 +1,31 @@
+package packages
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	packageDeleteCmd "gitlab.com/gitlab-org/cli/internal/commands/packages/delete"
+	packageDownloadCmd "gitlab.com/gitlab-org/cli/internal/commands/packages/download"
+	packageListCmd "gitlab.com/gitlab-org/cli/internal/commands/packages/list"
+)
+
+func NewCmdPackage(f cmdutils.Factory) *cobra.Command {
+	packageCmd := &cobra.Command{
+		Use:     "package <command> [flags]",
+		Short:   `Manage the packages in the package registry of a project.`,
+		Aliases: []string{"packages", "pkg"},
+		Long: heredoc.Doc(`
+		The package registry stores the packages that a project publishes, such as npm,
+		Maven, PyPI, and generic packages. Every version of a package is a separate
+		package, with one or more files.
+		`),
+	}
+
+	cmdutils.EnableRepoOverride(packageCmd, f)
+
+	packageCmd.AddCommand(packageListCmd.NewCmdList(f))
+	packageCmd.AddCommand(packageDownloadCmd.NewCmdDownload(f))
+	packageCmd.AddCommand(packageDeleteCmd.NewCmdDelete(f))
+	return packageCmd
+}
@@ -0,0 +1,23 @@
+//go:build !integration
+
+package packages
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestNewCmdPackage(t *testing.T) {
+	ios, _, _, _ := cmdtest.TestIOStreams()
+	cmd := NewCmdPackage(cmdtest.NewTestFactory(ios))
+
+	assert.Equal(t, "package <command> [flags]", cmd.Use)
+	var names []string
+	for _, c := range cmd.Commands() {
+		names = append(names, c.Name())
+	}
+	assert.ElementsMatch(t, []string{"list", "download", "delete"}, names)
+}
@@ -0,0 +1,53 @@
+package packageutils
+
+import (
+	"fmt"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+)
+
+// PackageTypes are the types of packages in the package registry.
+var PackageTypes = []string{"composer", "conan", "generic", "golang", "helm", "maven", "npm", "nuget", "pypi", "terraform_module"}
+
+// FindPackage returns the package of a project with exactly this name and version.
+// Set packageType when packages of several types share the name.
+func FindPackage(client *gitlab.Client, repo, name, version, packageType string) (*gitlab.Package, error) {
+	listOpts := &gitlab.ListProjectPackagesOptions{
+		ListOptions:    gitlab.ListOptions{PerPage: 100},
+		PackageName:    gitlab.Ptr(name),
+		PackageVersion: gitlab.Ptr(version),
+	}
+	if packageType != "" {
+		listOpts.PackageType = gitlab.Ptr(packageType)
+	}
+
+	candidates, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Package, *gitlab.Response, error) {
+		return client.Packages.ListProjectPackages(repo, listOpts, p)
+	})
+	if err != nil {
+		return nil, cmdutils.WrapError(err, "failed to list packages.")
+	}
+
+	// The API matches names partially, so keep the exact matches only.
+	var found []*gitlab.Package
+	for _, p := range candidates {
+		if p.Name == name && p.Version == version {
+			found = append(found, p)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("no package %s with version %s found in %s.", name, version, repo)
+	case 1:
+		return found[0], nil
+	}
+	var types []string
+	for _, p := range found {
+		types = append(types, p.PackageType)
+	}
+	return nil, fmt.Errorf("several packages %s with version %s found, of types %s. Use --type to choose one.", name, version, strings.Join(types, ", "))
+}
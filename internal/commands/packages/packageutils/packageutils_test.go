@@ -0,0 +1,73 @@
+//go:build !integration
+
+package packageutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+)
+
+func TestFindPackage(t *testing.T) {
+	generic := &gitlab.Package{ID: 1, Name: "my-tool", Version: "1.2.0", PackageType: "generic"}
+	npm := &gitlab.Package{ID: 2, Name: "my-tool", Version: "1.2.0", PackageType: "npm"}
+	partial := &gitlab.Package{ID: 3, Name: "my-tool-extras", Version: "1.2.0", PackageType: "generic"}
+
+	tests := []struct {
+		name        string
+		packageType string
+		packages    []*gitlab.Package
+		want        *gitlab.Package
+		wantErr     string
+	}{
+		{
+			name:     "exact match",
+			packages: []*gitlab.Package{partial, generic},
+			want:     generic,
+		},
+		{
+			name:     "not found",
+			packages: []*gitlab.Package{partial},
+			wantErr:  "no package my-tool with version 1.2.0 found in OWNER/REPO.",
+		},
+		{
+			name:     "several types",
+			packages: []*gitlab.Package{generic, npm},
+			wantErr:  "several packages my-tool with version 1.2.0 found, of types generic, npm. Use --type to choose one.",
+		},
+		{
+			name:        "filtered by type",
+			packageType: "npm",
+			packages:    []*gitlab.Package{npm},
+			want:        npm,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tc := gitlabtesting.NewTestClient(t)
+			wantOpts := &gitlab.ListProjectPackagesOptions{
+				ListOptions:    gitlab.ListOptions{PerPage: 100},
+				PackageName:    gitlab.Ptr("my-tool"),
+				PackageVersion: gitlab.Ptr("1.2.0"),
+			}
+			if tt.packageType != "" {
+				wantOpts.PackageType = gitlab.Ptr(tt.packageType)
+			}
+			tc.MockPackages.EXPECT().ListProjectPackages("OWNER/REPO", wantOpts, gomock.Any()).Return(tt.packages, &gitlab.Response{}, nil)
+
+			got, err := FindPackage(tc.Client, "OWNER/REPO", "my-tool", "1.2.0", tt.packageType)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package clone
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/config"
+)
+
+// manifestFile records the progress of a group clone in the target directory,
+// so an interrupted clone can be resumed by running the same command again.
+const manifestFile = ".glab-clone.json"
+
+// Clone states stored in the manifest.
+const (
+	stateCloning = "cloning"
+	stateCloned  = "cloned"
+	stateFailed  = "failed"
+)
+
+// Directory layouts of group clones.
+const (
+	layoutFlat   = "flat"
+	layoutNested = "nested"
+)
+
+type manifestEntry struct {
+	Dir   string `json:"dir"`
+	State string `json:"state"`
+}
+
+type manifest struct {
+	path string
+
+	mu       sync.Mutex
+	Projects map[string]*manifestEntry `json:"projects"`
+}
+
+func loadManifest(path string) (*manifest, error) {
+	m := &manifest{path: path, Projects: map[string]*manifestEntry{}}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return m, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf("could not decode %s: %w", path, err)
+	}
+	if m.Projects == nil {
+		m.Projects = map[string]*manifestEntry{}
+	}
+	return m, nil
+}
+
+// cloned reports whether a previous run finished cloning the project into dir.
+func (m *manifest) cloned(project, dir string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry := m.Projects[project]
+	if entry == nil || entry.State != stateCloned || entry.Dir != dir {
+		return false
+	}
+	_, err := os.Stat(dir)
+	return err == nil
+}
+
+// start records that the project is being cloned into dir. If a previous run was
+// interrupted while cloning the project, the partial clone is removed first.
+func (m *manifest) start(project, dir string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if entry := m.Projects[project]; entry != nil && entry.State == stateCloning {
+		if err := os.RemoveAll(entry.Dir); err != nil {
+			return fmt.Errorf("removing incomplete clone: %w", err)
+		}
+	}
+	m.Projects[project] = &manifestEntry{Dir: dir, State: stateCloning}
+	return m.save()
+}
+
+// finish records the result of cloning the project.
+func (m *manifest) finish(project string, cloneErr error) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry := m.Projects[project]
+	entry.State = stateCloned
+	if cloneErr != nil {
+		entry.State = stateFailed
+	}
+	return m.save()
+}
+
+func (m *manifest) save() error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	if dir := filepath.Dir(m.path); dir != "." {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return err
+		}
+	}
+	return config.WriteFile(m.path, data, 0o644)
+}
+
+// projectDirs returns the directory, relative to the target directory, that each
+// project is cloned into. The nested layout mirrors the namespaces of the projects.
+// The flat layout uses the project path, and falls back to the full path with
+// dashes for projects whose paths clash.
+func projectDirs(projects []*gitlab.Project, layout string) []string {
+	dirs := make([]string, len(projects))
+	if layout == layoutNested {
+		for i, p := range projects {
+			dirs[i] = filepath.FromSlash(p.PathWithNamespace)
+		}
+		return dirs
+	}
+
+	count := make(map[string]int, len(projects))
+	for _, p := range projects {
+		count[p.Path]++
+	}
+	for i, p := range projects {
+		dirs[i] = p.Path
+		if count[p.Path] > 1 {
+			dirs[i] = strings.ReplaceAll(p.PathWithNamespace, "/", "-")
+		}
+	}
+	return dirs
+}
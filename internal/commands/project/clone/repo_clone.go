@@ -3,12 +3,16 @@ package clone
 import (
 	"errors"
 	"fmt"
+	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	"golang.org/x/sync/errgroup"
 
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 
@@ -30,6 +34,7 @@ type options struct {
 	withShared        bool
 	archived          bool
 	archivedSet       bool
+	includeArchived   bool
 	active            bool
 	activeSet         bool
 	visibility        string
@@ -38,6 +43,9 @@ type options struct {
 	dir               string
 	host              string
 	protocol          string
+	layout            string
+	jobs              int
+	depth             int
 
 	page     int
 	perPage  int
@@ -49,6 +57,9 @@ type options struct {
 	currentUser *gitlab.User
 }
 
+// runClone clones a repository. Tests replace it to avoid running Git.
+var runClone = git.RunClone
+
 type ContextOpts struct {
 	Project *gitlab.Project
 	Repo    string
@@ -89,17 +100,29 @@ glab repo clone -g <group> [flags] [<dir>] [-- <gitflags>...]`,
 			# Clones only active projects in a group
 			$ glab repo clone -g everyonecancontribute --active=true --paginate
 
+			# Clones all repos in a group and its subgroups into 'src', mirroring the group structure
+			$ glab repo clone -g everyonecancontribute src --layout nested --paginate
+
+			# Clones the latest commit of all active repos in a group, eight at a time
+			$ glab repo clone -g everyonecancontribute --include-archived=false --depth 1 --jobs 8 --paginate
+
 			# Clones from a GitLab Self-Managed or GitLab Dedicated instance
 			$ GITLAB_HOST=salsa.debian.org glab repo clone myrepo
 		`),
-		Long: heredoc.Doc(`
+		Long: heredoc.Docf(`
 		Clone supports these shorthand references:
 
 		- repo
 		- namespace/repo
 		- org/group/repo
 		- project ID
-		`),
+
+		With --group, every project of the group is cloned into the directory given as
+		argument, or the current directory. The --layout flag controls where each project
+		is cloned: 'flat' uses the project path, and 'nested' mirrors the namespaces of the
+		projects. Progress is recorded in a %[1]s file in that directory. When a group clone
+		is interrupted, run the same command again to clone the remaining projects.
+		`, manifestFile),
 		Annotations: map[string]string{
 			mcpannotations.Destructive: "true",
 		},
@@ -112,9 +135,13 @@ glab repo clone -g <group> [flags] [<dir>] [-- <gitflags>...]`,
 			dbg.Debug("Args:", strings.Join(args, " "))
 			dbg.Debug("GitFlags:", strings.Join(opts.gitFlags, " "))
 			if nArgs := len(args); nArgs > 0 {
-				ctxOpts.Repo = args[0]
-				if nArgs > 1 && !opts.preserveNamespace {
-					opts.dir = args[1]
+				if opts.groupName != "" {
+					opts.dir = args[0]
+				} else {
+					ctxOpts.Repo = args[0]
+					if nArgs > 1 && !opts.preserveNamespace {
+						opts.dir = args[1]
+					}
 				}
 			}
 			dbg.Debug("Dir:", opts.dir)
@@ -123,6 +150,29 @@ glab repo clone -g <group> [flags] [<dir>] [-- <gitflags>...]`,
 				return &cmdutils.FlagError{Err: fmt.Errorf("Specify repository argument, or use the --group flag to specify a group to clone all repos from the group.")}
 			}
 
+			if opts.groupName == "" {
+				for _, flag := range []string{"layout", "jobs", "include-archived"} {
+					if cmd.Flags().Changed(flag) {
+						return &cmdutils.FlagError{Err: fmt.Errorf("--%s can only be used with --group.", flag)}
+					}
+				}
+			}
+			if opts.jobs < 1 {
+				return &cmdutils.FlagError{Err: errors.New("--jobs must be at least 1.")}
+			}
+			if opts.depth < 0 {
+				return &cmdutils.FlagError{Err: errors.New("--depth must be a positive number.")}
+			}
+			if opts.depth > 0 {
+				opts.gitFlags = append([]string{"--depth", strconv.Itoa(opts.depth)}, opts.gitFlags...)
+			}
+			if opts.layout == "" {
+				opts.layout = layoutFlat
+				if opts.preserveNamespace {
+					opts.layout = layoutNested
+				}
+			}
+
 			opts.archivedSet = cmd.Flags().Changed("archived")
 			opts.activeSet = cmd.Flags().Changed("active")
 
@@ -158,17 +208,22 @@ glab repo clone -g <group> [flags] [<dir>] [-- <gitflags>...]`,
 	repoCloneCmd.Flags().BoolVarP(&opts.preserveNamespace, "preserve-namespace", "p", false, "Clone the repository in a subdirectory based on namespace.")
 	repoCloneCmd.Flags().BoolVarP(&opts.active, "active", "", false, "Limit by project status. When true, returns active projects. When false, returns projects that are archived or marked for deletion. Used with the --group flag.")
 	repoCloneCmd.Flags().BoolVarP(&opts.archived, "archived", "a", false, "Limit by archived status. Use with '-a=false' to exclude archived repositories. Used with the --group flag.")
+	repoCloneCmd.Flags().BoolVar(&opts.includeArchived, "include-archived", true, "Include archived projects. Use '--include-archived=false' to skip them. Used with the --group flag.")
 	repoCloneCmd.Flags().BoolVarP(&opts.includeSubgroups, "include-subgroups", "G", true, "Include projects in subgroups of this group. Default is true. Used with the --group flag.")
 	repoCloneCmd.Flags().BoolVarP(&opts.owned, "mine", "m", false, "Limit by projects in the group owned by the current authenticated user. Used with the --group flag.")
 	repoCloneCmd.Flags().StringVarP(&opts.visibility, "visibility", "v", "", "Limit by visibility: public, internal, private. Used with the --group flag.")
 	repoCloneCmd.Flags().BoolVarP(&opts.withIssuesEnabled, "with-issues-enabled", "I", false, "Limit by projects with the issues feature enabled. Default is false. Used with the --group flag.")
 	repoCloneCmd.Flags().BoolVarP(&opts.withMREnabled, "with-mr-enabled", "M", false, "Limit by projects with the merge request feature enabled. Default is false. Used with the --group flag.")
 	repoCloneCmd.Flags().BoolVarP(&opts.withShared, "with-shared", "S", true, "Include projects shared to this group. Default is true. Used with the --group flag.")
+	repoCloneCmd.Flags().Var(cmdutils.NewEnumValue([]string{layoutFlat, layoutNested}, "", &opts.layout), "layout", "Directory layout of the clones: flat, nested. Defaults to nested with --preserve-namespace, flat otherwise. Used with the --group flag.")
+	repoCloneCmd.Flags().IntVarP(&opts.jobs, "jobs", "j", 1, "Number of repositories to clone in parallel. Used with the --group flag.")
+	repoCloneCmd.Flags().IntVar(&opts.depth, "depth", 0, "Create shallow clones with a history truncated to the given number of commits.")
 	repoCloneCmd.Flags().BoolVarP(&opts.paginate, "paginate", "", false, "Make additional HTTP requests to fetch all pages of projects before cloning. Respects --per-page.")
 	repoCloneCmd.Flags().IntVarP(&opts.page, "page", "", 1, "Page number.")
 	repoCloneCmd.Flags().IntVarP(&opts.perPage, "per-page", "", 30, "Number of items to list per page.")
 
 	repoCloneCmd.Flags().SortFlags = false
+	repoCloneCmd.MarkFlagsMutuallyExclusive("archived", "include-archived")
 	repoCloneCmd.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
 		if errors.Is(err, pflag.ErrHelp) {
 			return err
@@ -200,6 +255,9 @@ func groupClone(opts *options, ctxOpts *ContextOpts) error {
 	if opts.archivedSet {
 		listOpts.Archived = gitlab.Ptr(opts.archived)
 	}
+	if !opts.includeArchived {
+		listOpts.Archived = gitlab.Ptr(false)
+	}
 	if opts.includeSubgroups {
 		includeSubGroups := true
 		listOpts.IncludeSubGroups = &includeSubGroups
@@ -236,24 +294,66 @@ func groupClone(opts *options, ctxOpts *ContextOpts) error {
 		return cmdutils.SilentError
 	}
 
-	var finalOutput []string
-	for _, project := range projects {
-		ctxOpt := *ctxOpts
-		ctxOpt.Project = project
-		ctxOpt.Repo = project.PathWithNamespace
-		err = cloneRun(opts, &ctxOpt)
-		if err != nil {
-			finalOutput = append(finalOutput, fmt.Sprintf("%s %s - Error: %q", c.RedCheck(), project.PathWithNamespace, err.Error()))
-		} else {
-			finalOutput = append(finalOutput, fmt.Sprintf("%s %s", c.GreenCheck(), project.PathWithNamespace))
+	m, err := loadManifest(filepath.Join(opts.dir, manifestFile))
+	if err != nil {
+		return err
+	}
+
+	// Parallel clones would interleave the progress output of Git.
+	gitFlags := opts.gitFlags
+	if opts.jobs > 1 {
+		gitFlags = append([]string{"--quiet"}, gitFlags...)
+	}
+
+	var mu sync.Mutex
+	failed := false
+	finalOutput := make([]string, len(projects))
+	dirs := projectDirs(projects, opts.layout)
+
+	g := new(errgroup.Group)
+	g.SetLimit(opts.jobs)
+	for i, project := range projects {
+		dir := filepath.Join(opts.dir, dirs[i])
+		if m.cloned(project.PathWithNamespace, dir) {
+			finalOutput[i] = fmt.Sprintf("%s %s (already cloned)", c.GreenCheck(), project.PathWithNamespace)
+			continue
 		}
+
+		g.Go(func() error {
+			err := m.start(project.PathWithNamespace, dir)
+			if err == nil {
+				projectOpts := *opts
+				projectOpts.dir = dir
+				projectOpts.preserveNamespace = false
+				projectOpts.gitFlags = slices.Clip(gitFlags)
+
+				ctxOpt := *ctxOpts
+				ctxOpt.Project = project
+				ctxOpt.Repo = project.PathWithNamespace
+				err = cloneRun(&projectOpts, &ctxOpt)
+				if saveErr := m.finish(project.PathWithNamespace, err); err == nil {
+					err = saveErr
+				}
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				failed = true
+				finalOutput[i] = fmt.Sprintf("%s %s - Error: %q", c.RedCheck(), project.PathWithNamespace, err.Error())
+			} else {
+				finalOutput[i] = fmt.Sprintf("%s %s", c.GreenCheck(), project.PathWithNamespace)
+			}
+			return nil
+		})
 	}
+	_ = g.Wait()
 
 	// Print error/success msgs in human-readable formats
 	for _, out := range finalOutput {
 		fmt.Fprintln(opts.io.StdOut, out)
 	}
-	if err != nil { // if any error came up
+	if failed {
 		return cmdutils.SilentError
 	}
 	return nil
@@ -284,7 +384,7 @@ func cloneRun(opts *options, ctxOpts *ContextOpts) error {
 		namespacedDir := ctxOpts.Project.PathWithNamespace
 		opts.dir = namespacedDir
 	}
-	_, err := git.RunClone(ctxOpts.Repo, opts.dir, opts.gitFlags)
+	_, err := runClone(ctxOpts.Repo, opts.dir, opts.gitFlags)
 	if err != nil {
 		return err
 	}
@@ -3,12 +3,25 @@
 package clone
 
 import (
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/google/shlex"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/git"
 	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
 )
 
@@ -75,8 +88,49 @@ func TestNewCmdClone(t *testing.T) {
 		},
 		{
 			name:    "unknown argument",
-			args:    "NAMESPACE/REPO --depth 1",
-			wantErr: "unknown flag: --depth\nSeparate Git clone flags with '--'.",
+			args:    "NAMESPACE/REPO --recurse-submodules",
+			wantErr: "unknown flag: --recurse-submodules\nSeparate Git clone flags with '--'.",
+		},
+		{
+			name: "group clone directory argument",
+			args: "-g mygroup src --layout nested --jobs 8 --depth 1",
+			wantOpts: options{
+				gitFlags:  []string{"--depth", "1"},
+				groupName: "mygroup",
+				dir:       "src",
+				layout:    "nested",
+				jobs:      8,
+			},
+		},
+		{
+			name: "group clone preserving namespaces",
+			args: "-g mygroup -p",
+			wantOpts: options{
+				gitFlags:  []string{},
+				groupName: "mygroup",
+				layout:    "nested",
+				jobs:      1,
+			},
+		},
+		{
+			name:    "layout without group",
+			args:    "NAMESPACE/REPO --layout nested",
+			wantErr: "--layout can only be used with --group.",
+		},
+		{
+			name:    "invalid layout",
+			args:    "-g mygroup --layout tree",
+			wantErr: `invalid argument "tree" for "--layout" flag: must be one of [flat nested]` + "\nSeparate Git clone flags with '--'.",
+		},
+		{
+			name:    "invalid jobs",
+			args:    "-g mygroup --jobs 0",
+			wantErr: "--jobs must be at least 1.",
+		},
+		{
+			name:    "archived and include-archived",
+			args:    "-g mygroup --archived --include-archived=false",
+			wantErr: "if any flags in the group [archived include-archived] are set none of the others can be; [archived include-archived] were all set",
 		},
 		{
 			name: "group clone with active=true",
@@ -157,6 +211,166 @@ func TestNewCmdClone(t *testing.T) {
 			assert.Equal(t, tt.wantOpts.groupName, opts.groupName)
 			assert.Equal(t, tt.wantOpts.active, opts.active)
 			assert.Equal(t, tt.wantOpts.activeSet, opts.activeSet)
+			if tt.wantOpts.layout != "" {
+				assert.Equal(t, tt.wantOpts.dir, opts.dir)
+				assert.Equal(t, tt.wantOpts.layout, opts.layout)
+				assert.Equal(t, tt.wantOpts.jobs, opts.jobs)
+			}
+		})
+	}
+}
+
+func TestGroupClone(t *testing.T) {
+	projects := []*gitlab.Project{
+		{Path: "api", PathWithNamespace: "mygroup/api"},
+		{Path: "web", PathWithNamespace: "mygroup/frontend/web"},
+		{Path: "web", PathWithNamespace: "mygroup/legacy/web"},
+		{Path: "broken", PathWithNamespace: "mygroup/broken"},
+	}
+
+	tests := []struct {
+		name      string
+		layout    string
+		jobs      int
+		manifest  map[string]*manifestEntry
+		wantDirs  map[string]string
+		wantFlags []string
+		wantOut   []string
+	}{
+		{
+			name:   "Flat layout",
+			layout: layoutFlat,
+			jobs:   1,
+			wantDirs: map[string]string{
+				"mygroup/api":          "api",
+				"mygroup/frontend/web": "mygroup-frontend-web",
+				"mygroup/legacy/web":   "mygroup-legacy-web",
+				"mygroup/broken":       "broken",
+			},
+			wantFlags: []string{"--depth", "1"},
+			wantOut: []string{
+				"✓ mygroup/api",
+				"✓ mygroup/frontend/web",
+				"✓ mygroup/legacy/web",
+				`✓ mygroup/broken - Error: "exit status 128"`,
+			},
+		},
+		{
+			name:   "Nested layout in parallel",
+			layout: layoutNested,
+			jobs:   4,
+			wantDirs: map[string]string{
+				"mygroup/api":          "mygroup/api",
+				"mygroup/frontend/web": "mygroup/frontend/web",
+				"mygroup/legacy/web":   "mygroup/legacy/web",
+				"mygroup/broken":       "mygroup/broken",
+			},
+			wantFlags: []string{"--quiet", "--depth", "1"},
+			wantOut: []string{
+				"✓ mygroup/api",
+				"✓ mygroup/frontend/web",
+				"✓ mygroup/legacy/web",
+				`✓ mygroup/broken - Error: "exit status 128"`,
+			},
+		},
+		{
+			name:   "Resume an interrupted clone",
+			layout: layoutFlat,
+			jobs:   1,
+			manifest: map[string]*manifestEntry{
+				"mygroup/api":          {Dir: "api", State: stateCloned},
+				"mygroup/frontend/web": {Dir: "mygroup-frontend-web", State: stateCloning},
+			},
+			wantDirs: map[string]string{
+				"mygroup/frontend/web": "mygroup-frontend-web",
+				"mygroup/legacy/web":   "mygroup-legacy-web",
+				"mygroup/broken":       "broken",
+			},
+			wantFlags: []string{"--depth", "1"},
+			wantOut: []string{
+				"✓ mygroup/api (already cloned)",
+				"✓ mygroup/frontend/web",
+				"✓ mygroup/legacy/web",
+				`✓ mygroup/broken - Error: "exit status 128"`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			baseDir := t.TempDir()
+			if tt.manifest != nil {
+				m := &manifest{path: filepath.Join(baseDir, manifestFile), Projects: map[string]*manifestEntry{}}
+				for project, entry := range tt.manifest {
+					dir := filepath.Join(baseDir, entry.Dir)
+					require.NoError(t, os.MkdirAll(dir, 0o755))
+					m.Projects[project] = &manifestEntry{Dir: dir, State: entry.State}
+				}
+				require.NoError(t, m.save())
+				require.NoError(t, os.WriteFile(filepath.Join(baseDir, "mygroup-frontend-web", "partial"), nil, 0o644))
+			}
+
+			var mu sync.Mutex
+			gotDirs := map[string]string{}
+			runClone = func(cloneURL, target string, args []string) (string, error) {
+				assert.Equal(t, tt.wantFlags, args)
+				_, err := os.Stat(filepath.Join(target, "partial"))
+				assert.True(t, os.IsNotExist(err), "incomplete clone was not removed")
+
+				mu.Lock()
+				defer mu.Unlock()
+				rel, err := filepath.Rel(baseDir, target)
+				require.NoError(t, err)
+				gotDirs[strings.TrimSuffix(strings.TrimPrefix(cloneURL, "git@gitlab.com:"), ".git")] = filepath.ToSlash(rel)
+				if strings.HasSuffix(cloneURL, "broken.git") {
+					return target, errors.New("exit status 128")
+				}
+				return target, nil
+			}
+			t.Cleanup(func() { runClone = git.RunClone })
+
+			tc := gitlabtesting.NewTestClient(t)
+			tc.MockGroups.EXPECT().ListGroupProjects("mygroup", gomock.Any()).
+				DoAndReturn(func(_ any, opts *gitlab.ListGroupProjectsOptions, _ ...gitlab.RequestOptionFunc) ([]*gitlab.Project, *gitlab.Response, error) {
+					assert.Equal(t, gitlab.Ptr(false), opts.Archived)
+					var list []*gitlab.Project
+					for _, p := range projects {
+						p := *p
+						p.SSHURLToRepo = "git@gitlab.com:" + p.PathWithNamespace + ".git"
+						list = append(list, &p)
+					}
+					return list, nil, nil
+				})
+			apiClient, err := api.NewClient(
+				func(*http.Client) (gitlab.AuthSource, error) {
+					return gitlab.AccessTokenAuthSource{Token: "test-token"}, nil
+				},
+				api.WithGitLabClient(tc.Client),
+			)
+			require.NoError(t, err)
+
+			io, _, stdout, _ := cmdtest.TestIOStreams()
+			opts := &options{
+				groupName:   "mygroup",
+				dir:         baseDir,
+				layout:      tt.layout,
+				jobs:        tt.jobs,
+				gitFlags:    []string{"--depth", "1"},
+				protocol:    "ssh",
+				io:          io,
+				apiClient:   apiClient,
+				currentUser: &gitlab.User{Username: "me"},
+			}
+
+			err = groupClone(opts, &ContextOpts{})
+			require.ErrorIs(t, err, cmdutils.SilentError)
+			assert.Equal(t, strings.Join(tt.wantOut, "\n")+"\n", stdout.String())
+			assert.Equal(t, tt.wantDirs, gotDirs)
+
+			m, err := loadManifest(filepath.Join(baseDir, manifestFile))
+			require.NoError(t, err)
+			assert.Equal(t, stateCloned, m.Projects["mygroup/legacy/web"].State)
+			assert.Equal(t, stateFailed, m.Projects["mygroup/broken"].State)
 		})
 	}
 }
@@ -0,0 +1,233 @@
+package codeowners
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/token/accesslevel"
+	"gitlab.com/gitlab-org/cli/internal/git"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	path       string
+	syntaxOnly bool
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdCodeownersLint(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "codeowners-lint [<file>] [flags]",
+		Short: `Check the CODEOWNERS file of the project.`,
+		Long: heredoc.Docf(`
+			Check the syntax of a CODEOWNERS file, and that the users and groups it references
+			can approve merge requests of the project.
+
+			GitLab ignores the owners it can't resolve, so a typo in a username or a group
+			without access to the project silently removes an approval rule.
+
+			The command checks:
+
+			- The syntax of sections, like %[1]s[Section]%[1]s, %[1]s^[Optional section]%[1]s,
+			  and %[1]s[Section][2]%[1]s with the number of required approvals.
+			- The syntax of owners: %[1]s@user%[1]s, %[1]s@group/subgroup%[1]s, roles like
+			  %[1]s@@maintainer%[1]s, and email addresses.
+			- That every entry has owners, or is in a section with default owners.
+			- That referenced users are members of the project with at least the Developer role.
+			- That referenced groups exist, and contain the project or are invited to it.
+
+			Owners referenced by email address aren't checked on the instance.
+
+			Without a file argument, the CODEOWNERS file is searched where GitLab looks for it:
+			%[2]s, in the root of the repository.
+		`, "`", strings.Join(Locations, ", ")),
+		Example: heredoc.Doc(`
+			# Check the CODEOWNERS file of the current repository
+			$ glab repo codeowners-lint
+
+			# Check a file before committing it, without querying the instance
+			$ glab repo codeowners-lint .gitlab/CODEOWNERS --syntax-only
+		`),
+		Args: cobra.MaximumNArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.path = args[0]
+			}
+
+			return opts.run()
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.syntaxOnly, "syntax-only", false, "Check the syntax only, without checking the users and groups on the instance.")
+
+	return cmd
+}
+
+func (o *options) run() error {
+	path, err := o.findFile()
+	if err != nil {
+		return err
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	problems, refs := parse(content)
+
+	if !o.syntaxOnly && len(refs) > 0 {
+		ownerProblems, err := o.checkOwners(refs)
+		if err != nil {
+			return err
+		}
+		problems = append(problems, ownerProblems...)
+	}
+
+	if len(problems) == 0 {
+		fmt.Fprintf(o.io.StdOut, "%s %s is valid.\n", o.io.Color().GreenCheck(), path)
+		return nil
+	}
+
+	sort.SliceStable(problems, func(i, j int) bool {
+		return problems[i].Line < problems[j].Line
+	})
+	for _, p := range problems {
+		fmt.Fprintf(o.io.StdOut, "%s:%d: %s\n", path, p.Line, p.Message)
+	}
+	fmt.Fprintf(o.io.StdErr, "%s %s found.\n", o.io.Color().FailedIcon(), utils.Pluralize(len(problems), "problem"))
+	return cmdutils.SilentError
+}
+
+// findFile returns the path of the file to check: the argument, or the first
+// CODEOWNERS file of the repository in the order GitLab looks for it.
+func (o *options) findFile() (string, error) {
+	if o.path != "" {
+		return o.path, nil
+	}
+
+	root, err := git.ToplevelDir()
+	if err != nil || root == "" {
+		if root, err = os.Getwd(); err != nil {
+			return "", err
+		}
+	}
+	for _, location := range Locations {
+		path := filepath.Join(root, filepath.FromSlash(location))
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("no CODEOWNERS file found. GitLab looks for one in: %s.", strings.Join(Locations, ", "))
+}
+
+// checkOwners checks that the referenced users and groups exist, and can approve
+// merge requests of the project. Each owner is checked once.
+func (o *options) checkOwners(refs []ownerRef) ([]problem, error) {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return nil, err
+	}
+	repo, err := o.baseRepo()
+	if err != nil {
+		return nil, err
+	}
+	project, _, err := client.Projects.GetProject(repo.FullName(), nil)
+	if err != nil {
+		return nil, cmdutils.WrapError(err, "failed to get the project.")
+	}
+
+	var problems []problem
+	results := map[string]string{}
+	for _, ref := range refs {
+		key := strings.ToLower(ref.Name)
+		message, checked := results[key]
+		if !checked {
+			message, err = checkOwner(client, project, ref.Name)
+			if err != nil {
+				return nil, err
+			}
+			results[key] = message
+		}
+		if message != "" {
+			problems = append(problems, problem{ref.Line, message})
+		}
+	}
+	return problems, nil
+}
+
+// checkOwner returns why the user or group called name can't approve merge requests
+// of the project, or an empty string when it can.
+func checkOwner(client *gitlab.Client, project *gitlab.Project, name string) (string, error) {
+	// Usernames have no slash, so names with one can only be subgroups.
+	if !strings.Contains(name, "/") {
+		users, _, err := client.Users.ListUsers(&gitlab.ListUsersOptions{Username: gitlab.Ptr(name)})
+		if err != nil {
+			return "", cmdutils.WrapError(err, fmt.Sprintf("failed to look up @%s.", name))
+		}
+		if len(users) > 0 {
+			return checkUserAccess(client, project, users[0])
+		}
+	}
+
+	group, resp, err := client.Groups.GetGroup(name, nil)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return fmt.Sprintf("@%s isn't a user or a group.", name), nil
+		}
+		return "", cmdutils.WrapError(err, fmt.Sprintf("failed to look up @%s.", name))
+	}
+
+	if strings.HasPrefix(strings.ToLower(project.PathWithNamespace), strings.ToLower(group.FullPath)+"/") {
+		return "", nil
+	}
+	for _, shared := range project.SharedWithGroups {
+		if shared.GroupID == group.ID {
+			return "", nil
+		}
+	}
+	return fmt.Sprintf("group @%s has no access to the project. Invite it to the project.", name), nil
+}
+
+func checkUserAccess(client *gitlab.Client, project *gitlab.Project, user *gitlab.User) (string, error) {
+	member, resp, err := client.ProjectMembers.GetInheritedProjectMember(project.ID, user.ID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return fmt.Sprintf("user @%s isn't a member of the project.", user.Username), nil
+		}
+		return "", cmdutils.WrapError(err, fmt.Sprintf("failed to get the membership of @%s.", user.Username))
+	}
+	if member.AccessLevel < gitlab.DeveloperPermissions {
+		level := accesslevel.AccessLevel{Value: member.AccessLevel}
+		return fmt.Sprintf("user @%s has the %s role, but code owners need at least the developer role to approve.", user.Username, level.String()), nil
+	}
+	if member.State != "" && member.State != "active" {
+		return fmt.Sprintf("user @%s is %s.", user.Username, member.State), nil
+	}
+	return "", nil
+}
@@ -0,0 +1,113 @@
+//go:build !integration
+
+package codeowners
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glinstance"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func writeFile(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "CODEOWNERS")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func notFound() *gitlab.Response {
+	return &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}
+}
+
+func TestCodeownersLint(t *testing.T) {
+	t.Parallel()
+
+	path := writeFile(t, heredoc.Doc(`
+		* @alice @platform
+		*.md @reporter @typo @alice
+		[Ops] @ops
+		deploy/
+	`))
+
+	tc := gitlabtesting.NewTestClient(t)
+	tc.MockProjects.EXPECT().
+		GetProject("OWNER/REPO", gomock.Any()).
+		Return(&gitlab.Project{
+			ID:                7,
+			PathWithNamespace: "OWNER/REPO",
+			SharedWithGroups:  []gitlab.ProjectSharedWithGroup{{GroupID: 20, GroupFullPath: "platform"}},
+		}, nil, nil)
+
+	users := map[string]*gitlab.User{
+		"alice":    {ID: 1, Username: "alice"},
+		"reporter": {ID: 2, Username: "reporter"},
+	}
+	tc.MockUsers.EXPECT().
+		ListUsers(gomock.Any()).
+		DoAndReturn(func(opt *gitlab.ListUsersOptions, _ ...gitlab.RequestOptionFunc) ([]*gitlab.User, *gitlab.Response, error) {
+			if u, ok := users[*opt.Username]; ok {
+				return []*gitlab.User{u}, nil, nil
+			}
+			return nil, nil, nil
+		}).
+		Times(5)
+	tc.MockProjectMembers.EXPECT().
+		GetInheritedProjectMember(int64(7), int64(1)).
+		Return(&gitlab.ProjectMember{AccessLevel: gitlab.MaintainerPermissions, State: "active"}, nil, nil)
+	tc.MockProjectMembers.EXPECT().
+		GetInheritedProjectMember(int64(7), int64(2)).
+		Return(&gitlab.ProjectMember{AccessLevel: gitlab.ReporterPermissions, State: "active"}, nil, nil)
+	tc.MockGroups.EXPECT().
+		GetGroup("platform", gomock.Any()).
+		Return(&gitlab.Group{ID: 20, FullPath: "platform"}, nil, nil)
+	tc.MockGroups.EXPECT().
+		GetGroup("typo", gomock.Any()).
+		Return(nil, notFound(), gitlab.ErrNotFound)
+	tc.MockGroups.EXPECT().
+		GetGroup("ops", gomock.Any()).
+		Return(&gitlab.Group{ID: 30, FullPath: "ops"}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdCodeownersLint, false,
+		cmdtest.WithGitLabClient(tc.Client),
+		cmdtest.WithBaseRepo("OWNER", "REPO", glinstance.DefaultHostname),
+	)
+
+	output, err := exec(path)
+	require.ErrorIs(t, err, cmdutils.SilentError)
+	assert.Equal(t, path+":2: user @reporter has the reporter role, but code owners need at least the developer role to approve.\n"+
+		path+":2: @typo isn't a user or a group.\n"+
+		path+":3: group @ops has no access to the project. Invite it to the project.\n", output.String())
+	assert.Equal(t, "x 3 problems found.\n", output.Stderr())
+}
+
+func TestCodeownersLint_syntaxOnly(t *testing.T) {
+	t.Parallel()
+
+	path := writeFile(t, "* @alice\n[Docs][two]\n")
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdCodeownersLint, false)
+
+	output, err := exec(path + " --syntax-only")
+	require.ErrorIs(t, err, cmdutils.SilentError)
+	assert.Equal(t, path+":2: invalid number of approvals \"two\": must be at least 1.\n", output.String())
+
+	path = writeFile(t, "* @alice\n")
+	exec = cmdtest.SetupCmdForTest(t, NewCmdCodeownersLint, false)
+	output, err = exec(path + " --syntax-only")
+	require.NoError(t, err)
+	assert.Equal(t, "✓ "+path+" is valid.\n", output.String())
+}
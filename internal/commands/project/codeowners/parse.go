@@ -0,0 +1,116 @@
+package codeowners
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Locations are the paths where GitLab looks for the CODEOWNERS file, in order.
+var Locations = []string{"CODEOWNERS", "docs/CODEOWNERS", ".gitlab/CODEOWNERS"}
+
+// roles are the roles that can be referenced as @@role.
+var roles = []string{"developer", "developers", "maintainer", "maintainers", "owner", "owners"}
+
+var (
+	sectionRE = regexp.MustCompile(`^(\^)?\[([^\]]*)\](?:\[([^\]]*)\])?(.*)$`)
+	nameRE    = regexp.MustCompile(`^@[\w.-]+(/[\w.-]+)*$`)
+	emailRE   = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+)
+
+// problem is a problem found on a line of the CODEOWNERS file.
+type problem struct {
+	Line    int
+	Message string
+}
+
+// ownerRef is a user or group referenced as @name on a line.
+type ownerRef struct {
+	Line int
+	Name string
+}
+
+// parse checks the syntax of a CODEOWNERS file. It returns the problems found, and
+// the users and groups it references, which can only be checked on the instance.
+func parse(content []byte) ([]problem, []ownerRef) {
+	var problems []problem
+	var refs []ownerRef
+	sectionHasOwners := false
+
+	checkOwners := func(line int, owners []string) {
+		for _, o := range owners {
+			switch {
+			case strings.HasPrefix(o, "@@"):
+				if !slices.Contains(roles, strings.ToLower(o[2:])) {
+					problems = append(problems, problem{line, fmt.Sprintf("unknown role %q: use @@developer, @@maintainer, or @@owner.", o)})
+				}
+			case strings.HasPrefix(o, "@"):
+				if !nameRE.MatchString(o) {
+					problems = append(problems, problem{line, fmt.Sprintf("malformed owner %q.", o)})
+					continue
+				}
+				refs = append(refs, ownerRef{Line: line, Name: o[1:]})
+			case emailRE.MatchString(o):
+			default:
+				problems = append(problems, problem{line, fmt.Sprintf("malformed owner %q: use @user, @group, @@role, or an email address.", o)})
+			}
+		}
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	n := 0
+	for scanner.Scan() {
+		n++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") || strings.HasPrefix(line, "^[") {
+			m := sectionRE.FindStringSubmatch(line)
+			if m == nil {
+				problems = append(problems, problem{n, "malformed section header: missing ]."})
+				continue
+			}
+			if strings.TrimSpace(m[2]) == "" {
+				problems = append(problems, problem{n, "section name is missing."})
+			}
+			if m[3] != "" {
+				approvals, err := strconv.Atoi(m[3])
+				if err != nil || approvals < 1 {
+					problems = append(problems, problem{n, fmt.Sprintf("invalid number of approvals %q: must be at least 1.", m[3])})
+				}
+			}
+			owners := strings.Fields(m[4])
+			checkOwners(n, owners)
+			sectionHasOwners = len(owners) > 0
+			continue
+		}
+
+		pattern, owners := splitEntry(line)
+		if len(owners) == 0 && !sectionHasOwners {
+			problems = append(problems, problem{n, fmt.Sprintf("%s has no owners, and the section has no default owners.", pattern)})
+		}
+		checkOwners(n, owners)
+	}
+
+	return problems, refs
+}
+
+// splitEntry splits an entry into its path pattern, where spaces are escaped with
+// a backslash, and its owners.
+func splitEntry(line string) (string, []string) {
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			i++
+		case ' ', '\t':
+			return line[:i], strings.Fields(line[i:])
+		}
+	}
+	return line, nil
+}
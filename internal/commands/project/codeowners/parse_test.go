@@ -0,0 +1,83 @@
+//go:build !integration
+
+package codeowners
+
+import (
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name         string
+		content      string
+		wantProblems []problem
+		wantRefs     []ownerRef
+	}{
+		{
+			name: "valid file",
+			content: heredoc.Doc(`
+				# Default owners
+				* @alice @platform/backend
+
+				[Docs][2] @docs-team
+				docs/
+				README.md jane@example.com
+
+				^[Optional]
+				/scripts/my\ script.sh @@maintainer
+			`),
+			wantRefs: []ownerRef{
+				{Line: 2, Name: "alice"},
+				{Line: 2, Name: "platform/backend"},
+				{Line: 4, Name: "docs-team"},
+			},
+		},
+		{
+			name: "syntax errors",
+			content: heredoc.Doc(`
+				[Broken
+				[]
+				[Approvals][0] @alice
+				*.go @bob alice
+				*.md @@reviewer
+				*.rb @bad!name
+				[Empty]
+				*.py
+			`),
+			wantProblems: []problem{
+				{1, "malformed section header: missing ]."},
+				{2, "section name is missing."},
+				{3, `invalid number of approvals "0": must be at least 1.`},
+				{4, `malformed owner "alice": use @user, @group, @@role, or an email address.`},
+				{5, `unknown role "@@reviewer": use @@developer, @@maintainer, or @@owner.`},
+				{6, `malformed owner "@bad!name".`},
+				{8, "*.py has no owners, and the section has no default owners."},
+			},
+			wantRefs: []ownerRef{
+				{Line: 3, Name: "alice"},
+				{Line: 4, Name: "bob"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			problems, refs := parse([]byte(tt.content))
+			assert.Equal(t, tt.wantProblems, problems)
+			assert.Equal(t, tt.wantRefs, refs)
+		})
+	}
+}
+
+func TestSplitEntry(t *testing.T) {
+	pattern, owners := splitEntry(`docs/my\ file.md   @alice	@bob`)
+	assert.Equal(t, `docs/my\ file.md`, pattern)
+	assert.Equal(t, []string{"@alice", "@bob"}, owners)
+
+	pattern, owners = splitEntry("*.go")
+	assert.Equal(t, "*.go", pattern)
+	assert.Empty(t, owners)
+}
@@ -104,7 +104,7 @@ func (o *options) run() error {
 	title.CurrentPageTotal = len(users)
 
 	// List
-	table := tableprinter.NewTablePrinter()
+	table := tableprinter.New(o.io)
 	for _, user := range users {
 		table.AddCell(user.Name)
 		table.AddCellf("%s", c.Gray(user.Email))
@@ -0,0 +1,108 @@
+package delete
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/project/file/fileutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	path    string
+	message string
+	branch  string
+	yes     bool
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdDelete(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	fileDeleteCmd := &cobra.Command{
+		Use:   "delete <path> [flags]",
+		Short: `Delete a file of the repository.`,
+		Long:  "Delete a file of the repository, in a new commit on the branch.\n",
+		Args:  cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ glab repo file delete old-notes.md
+			$ glab repo file delete config/legacy.yml --branch cleanup --message "Remove the legacy config" --yes
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.path = args[0]
+
+			if !opts.yes && !opts.io.PromptEnabled() {
+				return &cmdutils.FlagError{Err: errors.New("--yes or -y flag is required when not running interactively.")}
+			}
+
+			return opts.run(cmd.Context())
+		},
+	}
+
+	fl := fileDeleteCmd.Flags()
+	fl.StringVarP(&opts.message, "message", "m", "", "Commit message. Defaults to 'Delete <path>'.")
+	fl.StringVarP(&opts.branch, "branch", "b", "", "Branch to commit to. Defaults to the default branch.")
+	fl.BoolVarP(&opts.yes, "yes", "y", false, "Skip the confirmation prompt.")
+
+	return fileDeleteCmd
+}
+
+func (o *options) run(ctx context.Context) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	branch, err := fileutils.Branch(client, repo.FullName(), o.branch)
+	if err != nil {
+		return err
+	}
+
+	if !o.yes {
+		err = o.io.Confirm(ctx, &o.yes, fmt.Sprintf("Delete %s on branch %s?", o.path, branch))
+		if err != nil {
+			return cmdutils.WrapError(err, "could not prompt")
+		}
+		if !o.yes {
+			return cmdutils.CancelError()
+		}
+	}
+
+	message := o.message
+	if message == "" {
+		message = "Delete " + o.path
+	}
+	_, err = client.RepositoryFiles.DeleteFile(repo.FullName(), o.path, &gitlab.DeleteFileOptions{
+		Branch:        gitlab.Ptr(branch),
+		CommitMessage: gitlab.Ptr(message),
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to delete %s.", o.path))
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Deleted %s on branch %s.\n", o.io.Color().RedCheck(), o.path, branch)
+	return nil
+}
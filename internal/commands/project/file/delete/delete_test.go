@@ -0,0 +1,61 @@
+//go:build !integration
+
+package delete
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_FileDelete(t *testing.T) {
+	testCases := []struct {
+		name      string
+		cli       string
+		setupMock func(tc *gitlabtesting.TestClient)
+		wantOut   string
+		wantErr   string
+	}{
+		{
+			name: "Delete a file",
+			cli:  "old.md --branch cleanup --yes",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockRepositoryFiles.EXPECT().DeleteFile("OWNER/REPO", "old.md", &gitlab.DeleteFileOptions{
+					Branch:        gitlab.Ptr("cleanup"),
+					CommitMessage: gitlab.Ptr("Delete old.md"),
+				}).Return(nil, nil)
+			},
+			wantOut: "✓ Deleted old.md on branch cleanup.\n",
+		},
+		{
+			name:    "Requires --yes",
+			cli:     "old.md",
+			wantErr: "--yes or -y flag is required when not running interactively.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			if tc.setupMock != nil {
+				tc.setupMock(testClient)
+			}
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdDelete, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			out, err := exec(tc.cli)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantOut, out.String())
+		})
+	}
+}
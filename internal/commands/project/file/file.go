@@ -0,0 +1,26 @@
+package file
+
+import (
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	cmdDelete "gitlab.com/gitlab-org/cli/internal/commands/project/file/delete"
+	cmdGet "gitlab.com/gitlab-org/cli/internal/commands/project/file/get"
+	cmdPut "gitlab.com/gitlab-org/cli/internal/commands/project/file/put"
+)
+
+func NewCmdFile(f cmdutils.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "file <command> [flags]",
+		Short: `Get, create, update, and delete files of the repository.`,
+		Long:  "Work with single files of the repository through the API, without a local clone.\n",
+	}
+
+	cmdutils.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdGet.NewCmdGet(f))
+	cmd.AddCommand(cmdPut.NewCmdPut(f))
+	cmd.AddCommand(cmdDelete.NewCmdDelete(f))
+
+	return cmd
+}
@@ -0,0 +1,20 @@
+package fileutils
+
+import (
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+)
+
+// Branch returns branch, or the default branch of the project when it's empty.
+func Branch(client *gitlab.Client, repo, branch string) (string, error) {
+	if branch != "" {
+		return branch, nil
+	}
+	project, err := api.GetProject(client, repo)
+	if err != nil {
+		return "", cmdutils.WrapError(err, "failed to get the default branch of the project.")
+	}
+	return project.DefaultBranch, nil
+}
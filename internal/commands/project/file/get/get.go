@@ -0,0 +1,90 @@
+package get
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	path         string
+	ref          string
+	outputFormat string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdGet(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	fileGetCmd := &cobra.Command{
+		Use:     "get <path> [flags]",
+		Short:   `Print the content of a file of the repository.`,
+		Long:    "Print the content of a file of the repository. With --output json, print the file with its metadata.\n",
+		Aliases: []string{"cat"},
+		Args:    cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ glab repo file get README.md
+			$ glab repo file cat src/main.go --ref v1.2.0 > main.go
+			$ glab repo file get .gitlab-ci.yml -R group/project --output json
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.path = args[0]
+			return opts.run()
+		},
+	}
+
+	fl := fileGetCmd.Flags()
+	fl.StringVarP(&opts.ref, "ref", "r", "", "Branch, tag, or commit to get the file from. Defaults to the default branch.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+
+	return fileGetCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	ref := o.ref
+	if ref == "" {
+		ref = "HEAD"
+	}
+	file, err := api.GetFile(client, repo.FullName(), o.path, ref)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to get %s at %s.", o.path, ref))
+	}
+
+	if o.outputFormat == "json" {
+		fileJSON, _ := json.Marshal(file)
+		fmt.Fprintln(o.io.StdOut, string(fileJSON))
+		return nil
+	}
+
+	_, err = o.io.StdOut.Write([]byte(file.Content))
+	return err
+}
@@ -0,0 +1,79 @@
+//go:build !integration
+
+package get
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_FileGet(t *testing.T) {
+	file := func() *gitlab.File {
+		return &gitlab.File{
+			FileName: "main.go",
+			FilePath: "src/main.go",
+			Encoding: "base64",
+			Content:  base64.StdEncoding.EncodeToString([]byte("package main\n")),
+			Ref:      "v1.2.0",
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		cli       string
+		setupMock func(tc *gitlabtesting.TestClient)
+		wantOut   string
+		wantErr   string
+	}{
+		{
+			name: "Print the content",
+			cli:  "src/main.go",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockRepositoryFiles.EXPECT().GetFile("OWNER/REPO", "src/main.go", &gitlab.GetFileOptions{Ref: gitlab.Ptr("HEAD")}).Return(file(), nil, nil)
+			},
+			wantOut: "package main\n",
+		},
+		{
+			name: "JSON output at a ref",
+			cli:  "src/main.go --ref v1.2.0 -F json",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockRepositoryFiles.EXPECT().GetFile("OWNER/REPO", "src/main.go", &gitlab.GetFileOptions{Ref: gitlab.Ptr("v1.2.0")}).Return(file(), nil, nil)
+			},
+			wantOut: `{"file_name":"main.go","file_path":"src/main.go","size":0,"encoding":"text","content":"package main\n","execute_filemode":false,"ref":"v1.2.0","blob_id":"","commit_id":"","content_sha256":"","last_commit_id":""}` + "\n",
+		},
+		{
+			name: "Missing file",
+			cli:  "missing.go",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockRepositoryFiles.EXPECT().GetFile("OWNER/REPO", "missing.go", gomock.Any()).Return(nil, nil, gitlab.ErrNotFound)
+			},
+			wantErr: "404 Not Found",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			tc.setupMock(testClient)
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdGet, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			out, err := exec(tc.cli)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantOut, out.String())
+		})
+	}
+}
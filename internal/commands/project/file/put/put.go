@@ -0,0 +1,169 @@
+package put
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/project/file/fileutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	path        string
+	contentFrom string
+	message     string
+	branch      string
+	startBranch string
+	executable  bool
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdPut(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	filePutCmd := &cobra.Command{
+		Use:   "put <path> --content-from <file> [flags]",
+		Short: `Create or update a file of the repository.`,
+		Long: heredoc.Doc(`
+			Create or update a file of the repository, in a new commit on the branch.
+
+			The file is created when the branch doesn't have it, and updated otherwise.
+		`),
+		Args: cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ glab repo file put docs/setup.md --content-from setup.md --message "Update the setup guide"
+			$ echo "v1.2.0" | glab repo file put VERSION --content-from - --branch release
+			$ glab repo file put config.yml --content-from config.yml --branch new-config --start-branch main
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.path = args[0]
+			if opts.startBranch != "" && opts.branch == "" {
+				return &cmdutils.FlagError{Err: errors.New("--start-branch requires --branch.")}
+			}
+			return opts.run()
+		},
+	}
+
+	fl := filePutCmd.Flags()
+	fl.StringVarP(&opts.contentFrom, "content-from", "c", "", "File to read the content from. Use - to read from standard input.")
+	fl.StringVarP(&opts.message, "message", "m", "", "Commit message. Defaults to 'Add <path>' or 'Update <path>'.")
+	fl.StringVarP(&opts.branch, "branch", "b", "", "Branch to commit to. Defaults to the default branch.")
+	fl.StringVar(&opts.startBranch, "start-branch", "", "Create --branch from this branch.")
+	fl.BoolVar(&opts.executable, "executable", false, "Make the file executable.")
+	_ = filePutCmd.MarkFlagRequired("content-from")
+
+	return filePutCmd
+}
+
+func (o *options) run() error {
+	content, err := o.readContent()
+	if err != nil {
+		return err
+	}
+
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	branch, err := fileutils.Branch(client, repo.FullName(), o.branch)
+	if err != nil {
+		return err
+	}
+
+	// Check the start branch when the branch is created from it.
+	ref := branch
+	if o.startBranch != "" {
+		ref = o.startBranch
+	}
+	_, _, err = client.RepositoryFiles.GetFileMetaData(repo.FullName(), o.path, &gitlab.GetFileMetaDataOptions{Ref: gitlab.Ptr(ref)})
+	exists := err == nil
+	if err != nil && !errors.Is(err, gitlab.ErrNotFound) {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to get %s.", o.path))
+	}
+
+	encoded := base64.StdEncoding.EncodeToString(content)
+	var startBranch *string
+	if o.startBranch != "" {
+		startBranch = gitlab.Ptr(o.startBranch)
+	}
+	// Leave the mode of existing files unchanged, unless asked.
+	var executable *bool
+	if o.executable {
+		executable = gitlab.Ptr(true)
+	}
+
+	action := "Updated"
+	if exists {
+		_, _, err = client.RepositoryFiles.UpdateFile(repo.FullName(), o.path, &gitlab.UpdateFileOptions{
+			Branch:          gitlab.Ptr(branch),
+			StartBranch:     startBranch,
+			Encoding:        gitlab.Ptr("base64"),
+			Content:         gitlab.Ptr(encoded),
+			CommitMessage:   gitlab.Ptr(o.commitMessage("Update")),
+			ExecuteFilemode: executable,
+		})
+	} else {
+		action = "Created"
+		_, _, err = client.RepositoryFiles.CreateFile(repo.FullName(), o.path, &gitlab.CreateFileOptions{
+			Branch:          gitlab.Ptr(branch),
+			StartBranch:     startBranch,
+			Encoding:        gitlab.Ptr("base64"),
+			Content:         gitlab.Ptr(encoded),
+			CommitMessage:   gitlab.Ptr(o.commitMessage("Add")),
+			ExecuteFilemode: executable,
+		})
+	}
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to commit %s.", o.path))
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s %s %s on branch %s.\n", o.io.Color().GreenCheck(), action, o.path, branch)
+	return nil
+}
+
+func (o *options) readContent() ([]byte, error) {
+	var content []byte
+	var err error
+	if o.contentFrom == "-" {
+		content, err = io.ReadAll(o.io.In)
+	} else {
+		content, err = os.ReadFile(o.contentFrom)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the content of %s: %w", o.path, err)
+	}
+	return content, nil
+}
+
+func (o *options) commitMessage(verb string) string {
+	if o.message != "" {
+		return o.message
+	}
+	return verb + " " + o.path
+}
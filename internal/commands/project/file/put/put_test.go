@@ -0,0 +1,95 @@
+//go:build !integration
+
+package put
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_FilePut(t *testing.T) {
+	encoded := base64.StdEncoding.EncodeToString([]byte("v1.2.0\n"))
+
+	testCases := []struct {
+		name      string
+		cli       string
+		setupMock func(tc *gitlabtesting.TestClient)
+		wantOut   string
+		wantErr   string
+	}{
+		{
+			name: "Create a file on the default branch",
+			cli:  "VERSION --content-from -",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockProjects.EXPECT().GetProject("OWNER/REPO", gomock.Any()).Return(&gitlab.Project{DefaultBranch: "main"}, nil, nil)
+				tc.MockRepositoryFiles.EXPECT().GetFileMetaData("OWNER/REPO", "VERSION", &gitlab.GetFileMetaDataOptions{Ref: gitlab.Ptr("main")}).
+					Return(nil, nil, gitlab.ErrNotFound)
+				tc.MockRepositoryFiles.EXPECT().CreateFile("OWNER/REPO", "VERSION", &gitlab.CreateFileOptions{
+					Branch:        gitlab.Ptr("main"),
+					Encoding:      gitlab.Ptr("base64"),
+					Content:       gitlab.Ptr(encoded),
+					CommitMessage: gitlab.Ptr("Add VERSION"),
+				}).Return(&gitlab.FileInfo{}, nil, nil)
+			},
+			wantOut: "✓ Created VERSION on branch main.\n",
+		},
+		{
+			name: "Update a file on a new branch",
+			cli:  "VERSION --content-from - --branch release --start-branch main --message 'Bump the version' --executable",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockRepositoryFiles.EXPECT().GetFileMetaData("OWNER/REPO", "VERSION", &gitlab.GetFileMetaDataOptions{Ref: gitlab.Ptr("main")}).
+					Return(&gitlab.File{}, nil, nil)
+				tc.MockRepositoryFiles.EXPECT().UpdateFile("OWNER/REPO", "VERSION", &gitlab.UpdateFileOptions{
+					Branch:          gitlab.Ptr("release"),
+					StartBranch:     gitlab.Ptr("main"),
+					Encoding:        gitlab.Ptr("base64"),
+					Content:         gitlab.Ptr(encoded),
+					CommitMessage:   gitlab.Ptr("Bump the version"),
+					ExecuteFilemode: gitlab.Ptr(true),
+				}).Return(&gitlab.FileInfo{}, nil, nil)
+			},
+			wantOut: "✓ Updated VERSION on branch release.\n",
+		},
+		{
+			name:    "Start branch without branch",
+			cli:     "VERSION --content-from - --start-branch main",
+			wantErr: "--start-branch requires --branch.",
+		},
+		{
+			name:    "Content is required",
+			cli:     "VERSION",
+			wantErr: `required flag(s) "content-from" not set`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			if tc.setupMock != nil {
+				tc.setupMock(testClient)
+			}
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdPut, false,
+				cmdtest.WithGitLabClient(testClient.Client),
+				cmdtest.WithStdin("v1.2.0\n"),
+			)
+
+			out, err := exec(tc.cli)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantOut, out.String())
+		})
+	}
+}
@@ -2,11 +2,12 @@ package fork
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/spf13/cobra"
@@ -24,12 +25,16 @@ import (
 	"gitlab.com/gitlab-org/cli/internal/run"
 )
 
+var statusInterval = 2 * time.Second
+
 type options struct {
 	clone     bool
 	addRemote bool
 	repo      string
 	name      string
 	path      string
+	namespace string
+	timeout   time.Duration
 
 	cloneSet     bool
 	addRemoteSet bool
@@ -45,7 +50,7 @@ type options struct {
 	remotes         func() (glrepo.Remotes, error)
 	config          func() config.Config
 	apiClient       func(repoHost string) (*api.Client, error)
-	defaultHostname string
+	defaultHostname func() string
 }
 
 func NewCmdFork(f cmdutils.Factory) *cobra.Command {
@@ -55,16 +60,29 @@ func NewCmdFork(f cmdutils.Factory) *cobra.Command {
 		remotes:            f.Remotes,
 		config:             f.Config,
 		apiClient:          f.ApiClient,
-		defaultHostname:    f.DefaultHostname(),
+		defaultHostname:    f.DefaultHostname,
 		currentDirIsParent: true,
 	}
 	forkCmd := &cobra.Command{
 		Use:   "fork <repo>",
 		Short: "Fork a GitLab repository.",
+		Long: heredoc.Doc(`
+			Fork a GitLab repository, and wait until the fork is ready to use.
+
+			By default, the fork is created in your personal namespace. Use --namespace
+			to create it in a group. When running interactively without --namespace, and
+			you can create projects in more than one namespace, you are prompted for it.
+
+			Then, you can clone the fork and add the forked repository as the upstream remote,
+			or add the fork as a remote of the current repository.
+		`),
 		Example: heredoc.Doc(`
 			$ glab repo fork
 			$ glab repo fork namespace/repo
 			$ glab repo fork namespace/repo --clone
+
+			# Fork into a group, with another name and path
+			$ glab repo fork namespace/repo --namespace my-group --name "My repo" --path my-repo
 		`),
 		Args: cobra.MaximumNArgs(1),
 		Annotations: map[string]string{
@@ -81,6 +99,10 @@ func NewCmdFork(f cmdutils.Factory) *cobra.Command {
 		StringVarP(&opts.name, "name", "n", "", "The name assigned to the new project after forking.")
 	forkCmd.Flags().
 		StringVarP(&opts.path, "path", "p", "", "The path assigned to the new project after forking.")
+	forkCmd.Flags().
+		StringVar(&opts.namespace, "namespace", "", "The namespace to create the fork in. Defaults to your personal namespace.")
+	forkCmd.Flags().
+		DurationVar(&opts.timeout, "timeout", 10*time.Minute, "Maximum time to wait for the fork to be ready.")
 	forkCmd.Flags().
 		BoolVarP(&opts.clone, "clone", "c", false, "Clone the fork. Options: true, false.")
 	forkCmd.Flags().
@@ -110,12 +132,12 @@ func (o *options) run(ctx context.Context) error {
 			if err != nil {
 				return fmt.Errorf("invalid argument: %w", err)
 			}
-			o.repoToFork, err = glrepo.FromURL(u, o.defaultHostname)
+			o.repoToFork, err = glrepo.FromURL(u, o.defaultHostname())
 			if err != nil {
 				return fmt.Errorf("invalid argument: %w", err)
 			}
 		} else {
-			o.repoToFork, err = glrepo.FromFullName(o.repo, o.defaultHostname)
+			o.repoToFork, err = glrepo.FromFullName(o.repo, o.defaultHostname())
 			if err != nil {
 				return fmt.Errorf("argument error: %w", err)
 			}
@@ -144,6 +166,15 @@ func (o *options) run(ctx context.Context) error {
 	if o.path != "" {
 		forkOpts.Path = gitlab.Ptr(o.path)
 	}
+	if o.namespace == "" && o.isTerminal {
+		o.namespace, err = o.selectNamespace(ctx, labClient)
+		if err != nil {
+			return err
+		}
+	}
+	if o.namespace != "" {
+		forkOpts.NamespacePath = gitlab.Ptr(o.namespace)
+	}
 
 	forkedProject, resp, err := labClient.Projects.ForkProject(o.repoToFork.FullName(), forkOpts)
 	usingExisting := false
@@ -154,7 +185,7 @@ func (o *options) run(ctx context.Context) error {
 
 			fmt.Fprintln(o.io.StdErr, c.Yellow("! Repository already exists in your namespace"))
 
-			namespace := o.path
+			namespace := o.namespace
 			currentUser, err := api.UserByName(labClient, "@me")
 			if err != nil {
 				return err
@@ -235,75 +266,14 @@ func (o *options) run(ctx context.Context) error {
 		}
 	}
 	// The forking operation for a project is asynchronous and is completed in a background job.
-	// The request returns immediately. To determine whether the fork of the project has completed,
-	// we query the import_status for the new project.
-	importStatus := ""
-	var importError error
-	maximumRetries := 3
-	retries := 0
-	skipFirstCheck := true
-
-	if !usingExisting && (forkedProject == nil || forkedProject.ImportStatus != "") {
-	loop:
-		for {
-			// Add a defensive check to prevent nil pointer dereference when accessing forkedProject.ID
-			if !skipFirstCheck {
-				// Safety check - make sure forkedProject is not nil before accessing its ID field
-				if forkedProject == nil {
-					fmt.Fprintf(o.io.StdErr, "Error: Lost track of forked project during status check")
-					break loop
-				}
-
-				// Now it's safe to access forkedProject.ID
-				forkedProject, err = api.GetProject(labClient, forkedProject.ID)
-				if err != nil {
-					fmt.Fprintf(o.io.StdErr, "error checking fork status: %q", err.Error())
-					if retries == maximumRetries {
-						break loop
-					}
-					fmt.Fprintln(o.io.StdErr, "- Retrying...")
-					retries++
-					continue
-				}
-			}
-			skipFirstCheck = false
-
-			// check import status of Fork
-			// Import status should be one of {none, failed, scheduled, started, finished}
-			// https://docs.gitlab.com/ee/api/project_import_export.html#import-status
-			if forkedProject == nil {
-				fmt.Fprintf(o.io.StdErr, "Error: Lost track of forked project during status check")
-				break loop
-			}
-
-			// Now it's safe to access forkedProject.ImportStatus
-			switch forkedProject.ImportStatus {
-			case "none": // no import initiated
-				break loop
-			case importStatus:
-				continue
-			case "scheduled", "started": // import scheduled or started
-				if importStatus != forkedProject.ImportStatus { // avoid printing the same message again
-					fmt.Fprintln(o.io.StdErr, "- "+forkedProject.ImportStatus)
-					importStatus = forkedProject.ImportStatus
-				}
-			case "finished": // import completed
-				fmt.Fprintln(o.io.StdErr, "- "+forkedProject.ImportStatus)
-				break loop
-			case "failed": // import failed
-				importError = errors.New(forkedProject.ImportError) // return the import error
-				break loop
-			default:
-				break loop
-			}
+	// The request returns immediately, so wait until the repository of the fork is usable.
+	if !usingExisting && forkedProject != nil {
+		forkedProject, err = o.wait(ctx, labClient, forkedProject)
+		if err != nil {
+			return err
 		}
 	}
 
-	if importError != nil {
-		fmt.Fprintf(o.io.StdErr, "%s: %q", c.Red("Fork failed"), importError.Error())
-		return nil
-	}
-
 	// Only print one message about the fork creation
 	if forkedProject != nil {
 		fmt.Fprintf(
@@ -425,6 +395,84 @@ func (o *options) run(ctx context.Context) error {
 	return nil
 }
 
+// selectNamespace prompts for the namespace to create the fork in. It returns an empty
+// string, which forks into the personal namespace, when there is only one namespace.
+func (o *options) selectNamespace(ctx context.Context, client *gitlab.Client) (string, error) {
+	namespaces, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Namespace, *gitlab.Response, error) {
+		return client.Namespaces.ListNamespaces(&gitlab.ListNamespacesOptions{ListOptions: gitlab.ListOptions{PerPage: 100}}, p)
+	})
+	if err != nil {
+		return "", cmdutils.WrapError(err, "failed to list namespaces.")
+	}
+	if len(namespaces) <= 1 {
+		return "", nil
+	}
+
+	// The personal namespace comes first, and is the default.
+	slices.SortStableFunc(namespaces, func(a, b *gitlab.Namespace) int {
+		switch {
+		case a.Kind == b.Kind:
+			return 0
+		case a.Kind == "user":
+			return -1
+		case b.Kind == "user":
+			return 1
+		}
+		return 0
+	})
+	paths := make([]string, len(namespaces))
+	for i, ns := range namespaces {
+		paths[i] = ns.FullPath
+	}
+
+	namespace := paths[0]
+	if err := o.io.Select(ctx, &namespace, "Which namespace should the fork be created in?", paths); err != nil {
+		return "", fmt.Errorf("failed to prompt: %w", err)
+	}
+	return namespace, nil
+}
+
+// wait polls the import status of the fork until its repository is usable.
+// The import status is one of none, scheduled, started, finished, or failed:
+// https://docs.gitlab.com/api/project_import_export/#import-status
+func (o *options) wait(ctx context.Context, client *gitlab.Client, project *gitlab.Project) (*gitlab.Project, error) {
+	ctx, cancel := context.WithTimeout(ctx, o.timeout)
+	defer cancel()
+
+	const maximumRetries = 3
+	retries := 0
+	status := ""
+	for {
+		switch project.ImportStatus {
+		case "scheduled", "started":
+			if project.ImportStatus != status { // avoid printing the same message again
+				fmt.Fprintf(o.io.StdErr, "- Waiting for the fork to be ready (%s)...\n", project.ImportStatus)
+				status = project.ImportStatus
+			}
+		case "failed":
+			return nil, fmt.Errorf("fork of %s failed: %s", o.repoToFork.FullName(), project.ImportError)
+		default:
+			return project, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out waiting for the fork %s to be ready: %w", project.PathWithNamespace, ctx.Err())
+		case <-time.After(statusInterval):
+		}
+
+		latest, _, err := client.Projects.GetProject(project.ID, nil, api.WithoutCache())
+		if err != nil {
+			if retries == maximumRetries {
+				return nil, cmdutils.WrapError(err, "failed to check the status of the fork.")
+			}
+			retries++
+			continue
+		}
+		project = latest
+	}
+}
+
 func searchProject(o *options, client *gitlab.Client) (*gitlab.Project, error) {
 	projects, _, err := client.Projects.ListProjects(&gitlab.ListProjectsOptions{
 		Search: gitlab.Ptr(o.repoToFork.RepoName()),
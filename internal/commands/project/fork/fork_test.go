@@ -8,6 +8,7 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -260,6 +261,9 @@ func TestProjectForkExistingRepo(t *testing.T) {
 				Name:        "Test User",
 				NamespaceID: 123,
 			}, nil, nil).AnyTimes()
+			tc.MockNamespaces.EXPECT().
+				ListNamespaces(gomock.Any(), gomock.Any()).
+				Return([]*gitlab.Namespace{{Kind: "user", FullPath: "OWNER"}}, &gitlab.Response{}, nil)
 			tc.MockProjects.EXPECT().
 				ForkProject("OWNER/REPO", gomock.Any(), gomock.Any()).
 				Return(nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusConflict}}, errors.New(`{"message":"Project namespace name has already been taken"}`))
@@ -401,3 +405,108 @@ func TestProjectForkExistingRepo(t *testing.T) {
 		})
 	}
 }
+
+func TestProjectForkWait(t *testing.T) {
+	tests := []struct {
+		name         string
+		args         string
+		statuses     []string
+		importError  string
+		interval     time.Duration
+		wantErr      string
+		wantMessages []string
+	}{
+		{
+			name:         "waits until the fork is ready",
+			args:         "OWNER/REPO --namespace my-group --clone=false",
+			statuses:     []string{"scheduled", "scheduled", "started", "finished"},
+			wantMessages: []string{"- Waiting for the fork to be ready (scheduled)...\n- Waiting for the fork to be ready (started)...\n✓ Created fork my-group/baz.\n"},
+		},
+		{
+			name:         "does not wait when there is no import",
+			args:         "OWNER/REPO --namespace my-group --clone=false",
+			statuses:     []string{"none"},
+			wantMessages: []string{"✓ Created fork my-group/baz.\n"},
+		},
+		{
+			name:        "fails when the import fails",
+			args:        "OWNER/REPO --namespace my-group --clone=false",
+			statuses:    []string{"scheduled", "failed"},
+			importError: "repository is too large",
+			wantErr:     "fork of OWNER/REPO failed: repository is too large",
+		},
+		{
+			name:     "times out",
+			args:     "OWNER/REPO --namespace my-group --clone=false --timeout 10ms",
+			statuses: []string{"started"},
+			interval: time.Hour,
+			wantErr:  "timed out waiting for the fork my-group/baz to be ready",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interval := statusInterval
+			statusInterval = tt.interval
+			t.Cleanup(func() { statusInterval = interval })
+
+			project := func(status string) *gitlab.Project {
+				return &gitlab.Project{
+					ID:                99,
+					Path:              "baz",
+					PathWithNamespace: "my-group/baz",
+					ImportStatus:      status,
+					ImportError:       tt.importError,
+				}
+			}
+
+			tc := gitlabtesting.NewTestClient(t)
+			tc.MockProjects.EXPECT().
+				ForkProject("OWNER/REPO", gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ any, opts *gitlab.ForkProjectOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+					assert.Equal(t, "my-group", *opts.NamespacePath)
+					return project(tt.statuses[0]), nil, nil
+				})
+			for _, status := range tt.statuses[1:] {
+				tc.MockProjects.EXPECT().
+					GetProject(int64(99), gomock.Any(), gomock.Any()).
+					Return(project(status), nil, nil)
+			}
+
+			exec := cmdtest.SetupCmdForTest(
+				t,
+				NewCmdFork,
+				false,
+				cmdtest.WithGitLabClient(tc.Client),
+				cmdtest.WithApiClient(
+					cmdtest.NewTestApiClient(t, nil, "", glinstance.DefaultHostname, api.WithGitLabClient(tc.Client)),
+				),
+			)
+
+			out, err := exec(tt.args)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			for _, msg := range tt.wantMessages {
+				assert.Equal(t, msg, out.ErrBuf.String())
+			}
+		})
+	}
+}
+
+func TestSelectNamespace_SingleNamespace(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+	tc.MockNamespaces.EXPECT().
+		ListNamespaces(gomock.Any(), gomock.Any()).
+		Return([]*gitlab.Namespace{{Kind: "user", FullPath: "OWNER"}}, &gitlab.Response{}, nil)
+
+	ios, _, _, _ := cmdtest.TestIOStreams()
+	o := &options{io: ios}
+
+	namespace, err := o.selectNamespace(t.Context(), tc.Client)
+	require.NoError(t, err)
+	assert.Empty(t, namespace)
+}
@@ -0,0 +1,225 @@
+package projectimport
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+// statusInterval is the time between two checks of the import status.
+var statusInterval = 5 * time.Second
+
+type options struct {
+	archive     string
+	path        string
+	name        string
+	namespace   string
+	mappingFile string
+	overwrite   bool
+	noWait      bool
+	timeout     time.Duration
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+}
+
+func NewCmdImport(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+	}
+	repoImportCmd := &cobra.Command{
+		Use:   "import <archive> [flags]",
+		Short: `Import a project from an export archive.`,
+		Long: heredoc.Docf(`
+		Import a project from an archive created by a project export.
+
+		When migrating between instances, usernames and group paths often differ.
+		Use --mapping with a YAML file to rename them during the import:
+
+		%[1]syaml
+		users:
+		  old-username: new-username
+		groups:
+		  old-group/subgroup: new-group
+		%[1]s
+
+		Users are renamed in the exported records and in @mentions of titles,
+		descriptions, and comments. Groups are renamed in the --namespace path.
+		After the import, users of the archive that do not exist on the destination
+		instance are reported. GitLab attributes their contributions to you.
+		`, "```"),
+		Args: cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			# Import a project into your personal namespace
+			$ glab repo import my-project.tar.gz
+
+			# Import a project into a group, renaming users and groups of the old instance
+			$ glab repo import export.tar.gz --path my-project --namespace old-group/team --mapping mapping.yml
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.complete(args)
+			return opts.run(cmd.Context())
+		},
+	}
+
+	fl := repoImportCmd.Flags()
+	fl.StringVarP(&opts.path, "path", "p", "", "Path of the new project. Defaults to the name of the archive file.")
+	fl.StringVarP(&opts.name, "name", "n", "", "Name of the new project. Defaults to the path.")
+	fl.StringVarP(&opts.namespace, "namespace", "g", "", "Group or user namespace to import the project into. Defaults to your personal namespace.")
+	fl.StringVarP(&opts.mappingFile, "mapping", "m", "", "YAML file that maps usernames and group paths of the source instance to the destination instance.")
+	fl.BoolVar(&opts.overwrite, "overwrite", false, "Overwrite an existing project with the same path.")
+	fl.BoolVar(&opts.noWait, "no-wait", false, "Don't wait for the import to finish.")
+	fl.DurationVar(&opts.timeout, "timeout", 30*time.Minute, "Maximum time to wait for the import to finish.")
+
+	return repoImportCmd
+}
+
+func (o *options) complete(args []string) {
+	o.archive = args[0]
+
+	if o.path == "" {
+		base := filepath.Base(o.archive)
+		for _, ext := range []string{".tar.gz", ".tgz", ".gz"} {
+			base = strings.TrimSuffix(base, ext)
+		}
+		o.path = base
+	}
+}
+
+func (o *options) run(ctx context.Context) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	m := &mapping{}
+	if o.mappingFile != "" {
+		m, err = readMapping(o.mappingFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	archive, err := os.Open(o.archive)
+	if err != nil {
+		return err
+	}
+	defer archive.Close()
+
+	// The archive is always rewritten, even without user mappings,
+	// to collect the users it references.
+	rw := newRewriter(m)
+	rewritten, err := os.CreateTemp("", "glab-import-*.tar.gz")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(rewritten.Name())
+	defer rewritten.Close()
+
+	if err := rw.rewriteArchive(archive, rewritten); err != nil {
+		return err
+	}
+	if _, err := rewritten.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	importOpts := &gitlab.ImportFileOptions{
+		Path: gitlab.Ptr(o.path),
+	}
+	if o.name != "" {
+		importOpts.Name = gitlab.Ptr(o.name)
+	}
+	if o.namespace != "" {
+		importOpts.Namespace = gitlab.Ptr(m.namespace(o.namespace))
+	}
+	if o.overwrite {
+		importOpts.Overwrite = gitlab.Ptr(true)
+	}
+
+	c := o.io.Color()
+	status, _, err := client.ProjectImportExport.ImportFromFile(rewritten, importOpts)
+	if err != nil {
+		return cmdutils.WrapError(err, "Could not start the import")
+	}
+	o.io.LogInfof("%s Importing %s into %s.\n", c.ProgressIcon(), o.archive, c.Bold(status.PathWithNamespace))
+
+	if !o.noWait {
+		status, err = o.wait(ctx, client, status)
+		if err != nil {
+			return err
+		}
+		o.io.LogInfof("%s Imported project %s.\n", c.GreenCheck(), c.Bold(status.PathWithNamespace))
+	}
+
+	return o.reportUnmappedUsers(client, rw.usernames())
+}
+
+// wait polls the import status until the import has finished.
+func (o *options) wait(ctx context.Context, client *gitlab.Client, status *gitlab.ImportStatus) (*gitlab.ImportStatus, error) {
+	ctx, cancel := context.WithTimeout(ctx, o.timeout)
+	defer cancel()
+
+	for {
+		switch status.ImportStatus {
+		case "finished":
+			return status, nil
+		case "failed":
+			return nil, fmt.Errorf("import of %s failed: %s", status.PathWithNamespace, status.ImportError)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out waiting for the import of %s: %w", status.PathWithNamespace, ctx.Err())
+		case <-time.After(statusInterval):
+		}
+
+		var err error
+		status, _, err = client.ProjectImportExport.ImportStatus(status.ID, api.WithoutCache())
+		if err != nil {
+			return nil, cmdutils.WrapError(err, "Could not get the import status")
+		}
+	}
+}
+
+// reportUnmappedUsers reports the users of the archive that do not exist on the destination instance.
+func (o *options) reportUnmappedUsers(client *gitlab.Client, usernames []string) error {
+	var unmapped []string
+	for _, username := range usernames {
+		users, _, err := client.Users.ListUsers(&gitlab.ListUsersOptions{Username: gitlab.Ptr(username)})
+		if err != nil {
+			return cmdutils.WrapError(err, "Could not look up users")
+		}
+		if len(users) == 0 {
+			unmapped = append(unmapped, "@"+username)
+		}
+	}
+
+	if len(unmapped) == 0 {
+		return nil
+	}
+
+	c := o.io.Color()
+	o.io.LogInfof("%s %d users of the archive have no matching user on the destination instance: %s\n",
+		c.WarnIcon(), len(unmapped), strings.Join(unmapped, ", "))
+	o.io.LogInfo("Add them to the users section of the mapping file, or create them, and import the project again.")
+
+	return nil
+}
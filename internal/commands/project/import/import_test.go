@@ -0,0 +1,161 @@
+//go:build !integration
+
+package projectimport
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_RepoImport(t *testing.T) {
+	defaultStatusInterval := statusInterval
+	statusInterval = time.Millisecond
+	t.Cleanup(func() { statusInterval = defaultStatusInterval })
+
+	dir := t.TempDir()
+	archivePath := filepath.Join(dir, "my-project.tar.gz")
+	require.NoError(t, os.WriteFile(archivePath, createArchive(t, map[string]string{
+		"tree/project/project_members.ndjson": `{"user":{"username":"alice"}}` + "\n" + `{"user":{"username":"carol"}}` + "\n",
+	}), 0o600))
+
+	mappingPath := filepath.Join(dir, "mapping.yml")
+	require.NoError(t, os.WriteFile(mappingPath, []byte("users:\n  alice: alice.smith\ngroups:\n  old-group: new-group\n"), 0o600))
+
+	type testCase struct {
+		name        string
+		cli         string
+		expectedMsg []string
+		wantErr     bool
+		wantStderr  string
+		setupMock   func(tc *gitlabtesting.TestClient)
+	}
+
+	expectUsers := func(tc *gitlabtesting.TestClient) {
+		tc.MockUsers.EXPECT().
+			ListUsers(gomock.Any()).
+			DoAndReturn(func(opts *gitlab.ListUsersOptions, _ ...gitlab.RequestOptionFunc) ([]*gitlab.User, *gitlab.Response, error) {
+				if *opts.Username == "alice.smith" {
+					return []*gitlab.User{{Username: "alice.smith"}}, nil, nil
+				}
+				return nil, nil, nil
+			}).
+			Times(2)
+	}
+
+	testCases := []testCase{
+		{
+			name: "Import with a mapping file and wait for the import",
+			cli:  archivePath + " --namespace old-group/team --mapping " + mappingPath,
+			expectedMsg: []string{
+				"Importing " + archivePath + " into new-group/team/my-project.",
+				"Imported project new-group/team/my-project.",
+				"1 users of the archive have no matching user on the destination instance: @carol",
+			},
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockProjectImportExport.EXPECT().
+					ImportFromFile(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(archive io.Reader, opts *gitlab.ImportFileOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.ImportStatus, *gitlab.Response, error) {
+						assert.Equal(t, "my-project", *opts.Path)
+						assert.Equal(t, "new-group/team", *opts.Namespace)
+
+						content, err := io.ReadAll(archive)
+						require.NoError(t, err)
+						assert.Contains(t, readArchive(t, content)["tree/project/project_members.ndjson"], `"username":"alice.smith"`)
+
+						return &gitlab.ImportStatus{ID: 5, PathWithNamespace: "new-group/team/my-project", ImportStatus: "scheduled"}, nil, nil
+					})
+				gomock.InOrder(
+					tc.MockProjectImportExport.EXPECT().
+						ImportStatus(int64(5), gomock.Any()).
+						Return(&gitlab.ImportStatus{ID: 5, PathWithNamespace: "new-group/team/my-project", ImportStatus: "started"}, nil, nil),
+					tc.MockProjectImportExport.EXPECT().
+						ImportStatus(int64(5), gomock.Any()).
+						Return(&gitlab.ImportStatus{ID: 5, PathWithNamespace: "new-group/team/my-project", ImportStatus: "finished"}, nil, nil),
+				)
+				expectUsers(tc)
+			},
+		},
+		{
+			name: "Import without waiting",
+			cli:  archivePath + " --path renamed --no-wait",
+			expectedMsg: []string{
+				"Importing " + archivePath + " into me/renamed.",
+				"2 users of the archive have no matching user on the destination instance: @alice, @carol",
+			},
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockProjectImportExport.EXPECT().
+					ImportFromFile(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(archive io.Reader, opts *gitlab.ImportFileOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.ImportStatus, *gitlab.Response, error) {
+						assert.Equal(t, "renamed", *opts.Path)
+						assert.Nil(t, opts.Namespace)
+						return &gitlab.ImportStatus{ID: 5, PathWithNamespace: "me/renamed", ImportStatus: "scheduled"}, nil, nil
+					})
+				tc.MockUsers.EXPECT().
+					ListUsers(gomock.Any()).
+					Return(nil, nil, nil).
+					Times(2)
+			},
+		},
+		{
+			name: "Failed import",
+			cli:  archivePath,
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockProjectImportExport.EXPECT().
+					ImportFromFile(gomock.Any(), gomock.Any()).
+					Return(&gitlab.ImportStatus{ID: 5, PathWithNamespace: "me/my-project", ImportStatus: "scheduled"}, nil, nil)
+				tc.MockProjectImportExport.EXPECT().
+					ImportStatus(int64(5), gomock.Any()).
+					Return(&gitlab.ImportStatus{ID: 5, PathWithNamespace: "me/my-project", ImportStatus: "failed", ImportError: "Project with same path exists"}, nil, nil)
+			},
+			wantErr:    true,
+			wantStderr: "import of me/my-project failed: Project with same path exists",
+		},
+		{
+			name:       "Missing archive",
+			cli:        filepath.Join(dir, "missing.tar.gz"),
+			setupMock:  func(tc *gitlabtesting.TestClient) {},
+			wantErr:    true,
+			wantStderr: "no such file or directory",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// GIVEN
+			testClient := gitlabtesting.NewTestClient(t)
+			tc.setupMock(testClient)
+			exec := cmdtest.SetupCmdForTest(
+				t,
+				NewCmdImport,
+				false,
+				cmdtest.WithGitLabClient(testClient.Client),
+			)
+
+			// WHEN
+			out, err := exec(tc.cli)
+
+			// THEN
+			if tc.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantStderr)
+				return
+			}
+			require.NoError(t, err)
+			for _, msg := range tc.expectedMsg {
+				assert.Contains(t, out.String(), msg)
+			}
+		})
+	}
+}
@@ -0,0 +1,239 @@
+package projectimport
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// mapping describes how users and groups of the source instance are renamed on the destination instance.
+type mapping struct {
+	Users  map[string]string `yaml:"users"`
+	Groups map[string]string `yaml:"groups"`
+}
+
+func readMapping(path string) (*mapping, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &mapping{}
+	if err := yaml.Unmarshal(content, m); err != nil {
+		return nil, fmt.Errorf("invalid mapping file %s: %w", path, err)
+	}
+
+	return m, nil
+}
+
+// namespace returns the destination path of a group path.
+// Paths of subgroups are mapped through their closest mapped parent group.
+func (m *mapping) namespace(path string) string {
+	path = strings.Trim(path, "/")
+	for parent := path; parent != ""; {
+		if mapped, ok := m.Groups[parent]; ok {
+			return mapped + strings.TrimPrefix(path, parent)
+		}
+
+		i := strings.LastIndex(parent, "/")
+		if i < 0 {
+			break
+		}
+		parent = parent[:i]
+	}
+
+	return path
+}
+
+// textFields are the attributes of exported records that can mention users.
+var textFields = map[string]bool{
+	"description": true,
+	"note":        true,
+	"title":       true,
+}
+
+var mentionRE = regexp.MustCompile(`\B@([A-Za-z0-9_][A-Za-z0-9_.-]*)`)
+
+// rewriter renames users in the records of a project export.
+type rewriter struct {
+	users map[string]string
+	// seen holds every username referenced by the exported records.
+	seen map[string]bool
+}
+
+func newRewriter(m *mapping) *rewriter {
+	return &rewriter{users: m.Users, seen: map[string]bool{}}
+}
+
+// usernames returns the usernames referenced by the rewritten archive, sorted.
+func (r *rewriter) usernames() []string {
+	unique := map[string]bool{}
+	for name := range r.seen {
+		if mapped, ok := r.users[name]; ok {
+			name = mapped
+		}
+		unique[name] = true
+	}
+
+	names := make([]string, 0, len(unique))
+	for name := range unique {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (r *rewriter) rewriteValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			s, isString := value.(string)
+			switch {
+			case key == "username" && isString:
+				r.seen[s] = true
+				if mapped, ok := r.users[s]; ok {
+					v[key] = mapped
+				}
+			case textFields[key] && isString:
+				v[key] = r.rewriteMentions(s)
+			default:
+				v[key] = r.rewriteValue(value)
+			}
+		}
+	case []any:
+		for i, value := range v {
+			v[i] = r.rewriteValue(value)
+		}
+	}
+
+	return v
+}
+
+func (r *rewriter) rewriteMentions(text string) string {
+	return mentionRE.ReplaceAllStringFunc(text, func(mention string) string {
+		// Sentence punctuation directly after a mention is not part of the username.
+		username := strings.TrimRight(mention[1:], ".-")
+		if mapped, ok := r.users[username]; ok {
+			return "@" + mapped + mention[1+len(username):]
+		}
+		return mention
+	})
+}
+
+// rewriteJSON rewrites a JSON document, or a newline-delimited JSON document if ndjson is true.
+func (r *rewriter) rewriteJSON(in io.Reader, out io.Writer, ndjson bool) error {
+	encode := func(v any) ([]byte, error) {
+		var buf bytes.Buffer
+		enc := json.NewEncoder(&buf)
+		enc.SetEscapeHTML(false)
+		if err := enc.Encode(v); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+
+	if !ndjson {
+		dec := json.NewDecoder(in)
+		dec.UseNumber()
+		var v any
+		if err := dec.Decode(&v); err != nil {
+			return err
+		}
+		data, err := encode(r.rewriteValue(v))
+		if err != nil {
+			return err
+		}
+		_, err = out.Write(data)
+		return err
+	}
+
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 0, 64*1024), 256*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+
+		dec := json.NewDecoder(bytes.NewReader(line))
+		dec.UseNumber()
+		var v any
+		if err := dec.Decode(&v); err != nil {
+			return err
+		}
+		data, err := encode(r.rewriteValue(v))
+		if err != nil {
+			return err
+		}
+		if _, err := out.Write(data); err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
+
+// rewriteArchive copies a gzipped project export from in to out, renaming users
+// in the project.json and .ndjson files of the export.
+func (r *rewriter) rewriteArchive(in io.Reader, out io.Writer) error {
+	gzr, err := gzip.NewReader(in)
+	if err != nil {
+		return fmt.Errorf("not a project export archive: %w", err)
+	}
+	defer gzr.Close()
+
+	gzw := gzip.NewWriter(out)
+	tr := tar.NewReader(gzr)
+	tw := tar.NewWriter(gzw)
+
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("could not read project export archive: %w", err)
+		}
+
+		isJSON := strings.HasSuffix(hdr.Name, "project.json")
+		isNDJSON := strings.HasSuffix(hdr.Name, ".ndjson")
+		if hdr.Typeflag != tar.TypeReg || (!isJSON && !isNDJSON) {
+			if err := tw.WriteHeader(hdr); err != nil {
+				return err
+			}
+			if _, err := io.Copy(tw, tr); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var buf bytes.Buffer
+		if err := r.rewriteJSON(tr, &buf, isNDJSON); err != nil {
+			return fmt.Errorf("could not rewrite %s: %w", hdr.Name, err)
+		}
+
+		hdr.Size = int64(buf.Len())
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if _, err := io.Copy(tw, &buf); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gzw.Close()
+}
@@ -0,0 +1,130 @@
+//go:build !integration
+
+package projectimport
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createArchive returns a gzipped tar archive with the given files.
+func createArchive(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	gzw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gzw)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o600, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gzw.Close())
+
+	return buf.Bytes()
+}
+
+// readArchive returns the files of a gzipped tar archive.
+func readArchive(t *testing.T, archive []byte) map[string]string {
+	t.Helper()
+
+	gzr, err := gzip.NewReader(bytes.NewReader(archive))
+	require.NoError(t, err)
+	tr := tar.NewReader(gzr)
+
+	files := map[string]string{}
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		content, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		files[hdr.Name] = string(content)
+	}
+
+	return files
+}
+
+func TestMappingNamespace(t *testing.T) {
+	m := &mapping{Groups: map[string]string{
+		"old-group":      "new-group",
+		"old-group/team": "platform",
+	}}
+
+	tests := map[string]string{
+		"old-group":             "new-group",
+		"old-group/team":        "platform",
+		"old-group/team/sub":    "platform/sub",
+		"old-group/other":       "new-group/other",
+		"/old-group/other/":     "new-group/other",
+		"unrelated/old-group":   "unrelated/old-group",
+		"old-group-with-suffix": "old-group-with-suffix",
+	}
+
+	for path, want := range tests {
+		t.Run(path, func(t *testing.T) {
+			assert.Equal(t, want, m.namespace(path))
+		})
+	}
+}
+
+func TestRewriteMentions(t *testing.T) {
+	r := newRewriter(&mapping{Users: map[string]string{"alice": "alice.smith", "bob": "robert"}})
+
+	tests := map[string]string{
+		"cc @alice":                   "cc @alice.smith",
+		"@alice, @bob and @carol":     "@alice.smith, @robert and @carol",
+		"Thanks @bob.":                "Thanks @robert.",
+		"mail alice@example.com":      "mail alice@example.com",
+		"@alicex is someone else":     "@alicex is someone else",
+		"no mentions in this comment": "no mentions in this comment",
+	}
+
+	for text, want := range tests {
+		t.Run(text, func(t *testing.T) {
+			assert.Equal(t, want, r.rewriteMentions(text))
+		})
+	}
+}
+
+func TestRewriteArchive(t *testing.T) {
+	archive := createArchive(t, map[string]string{
+		"VERSION":           "0.2.4",
+		"tree/project.json": `{"description":"Maintained by @alice","visibility_level":20}`,
+		"tree/project/project_members.ndjson": `{"access_level":40,"user":{"username":"alice","public_email":"alice@example.com"}}` + "\n" +
+			`{"access_level":30,"user":{"username":"carol"}}` + "\n",
+		"tree/project/issues.ndjson": `{"iid":1,"title":"Fix <b> & more","notes":[{"note":"ping @bob","author":{"name":"Bob"}}]}` + "\n",
+	})
+
+	r := newRewriter(&mapping{Users: map[string]string{"alice": "alice.smith", "bob": "robert"}})
+	var out bytes.Buffer
+	require.NoError(t, r.rewriteArchive(bytes.NewReader(archive), &out))
+
+	files := readArchive(t, out.Bytes())
+	assert.Equal(t, "0.2.4", files["VERSION"])
+	assert.JSONEq(t, `{"description":"Maintained by @alice.smith","visibility_level":20}`, files["tree/project.json"])
+	assert.Equal(t,
+		`{"access_level":40,"user":{"public_email":"alice@example.com","username":"alice.smith"}}`+"\n"+
+			`{"access_level":30,"user":{"username":"carol"}}`+"\n",
+		files["tree/project/project_members.ndjson"])
+	assert.Equal(t,
+		`{"iid":1,"notes":[{"author":{"name":"Bob"},"note":"ping @robert"}],"title":"Fix <b> & more"}`+"\n",
+		files["tree/project/issues.ndjson"])
+
+	assert.Equal(t, []string{"alice.smith", "carol"}, r.usernames())
+}
+
+func TestRewriteArchive_NotAnArchive(t *testing.T) {
+	r := newRewriter(&mapping{})
+	err := r.rewriteArchive(bytes.NewReader([]byte("not gzip")), io.Discard)
+	require.ErrorContains(t, err, "not a project export archive")
+}
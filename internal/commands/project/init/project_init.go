@@ -0,0 +1,255 @@
+package projectinit
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/git"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	dir           string
+	name          string
+	group         string
+	description   string
+	visibility    string
+	mergeMethod   string
+	defaultBranch string
+	template      string
+	profile       string
+	license       string
+
+	io              *iostreams.IOStreams
+	gitlabClient    func() (*gitlab.Client, error)
+	config          func() config.Config
+	defaultHostname func() string
+}
+
+func NewCmdInit(f cmdutils.Factory, gr git.GitRunner) *cobra.Command {
+	opts := &options{
+		io:              f.IO(),
+		gitlabClient:    f.GitLabClient,
+		config:          f.Config,
+		defaultHostname: f.DefaultHostname,
+	}
+
+	repoInitCmd := &cobra.Command{
+		Use:   "init [<directory>] [flags]",
+		Short: `Create a project with a new repository from a template or profile.`,
+		Long: heredoc.Docf(`
+			Scaffold a new repository, create its GitLab project, and push the initial commit,
+			in one step.
+
+			The files of the repository come either from a template repository, or from one of
+			the built-in profiles: %[2]s. Every profile adds a %[1]s.gitlab-ci.yml%[1]s file, issue
+			and merge request templates, a %[1]sCODEOWNERS%[1]s file that makes you the owner,
+			and a %[1]sREADME.md%[1]s file. Use --license to add a license from the license
+			templates of GitLab.
+
+			The project is named after the directory, which is created if needed. Files that
+			already exist in the directory are kept.
+		`, "`", strings.Join(profiles, ", ")),
+		Example: heredoc.Doc(`
+			# Create a project in the current directory with the default profile
+			$ glab repo init
+
+			# Create a public Go project with the MIT license in a group
+			$ glab repo init my-service --profile go --license mit --group my-group --visibility public
+
+			# Start from the files of a template repository, and use fast-forward merges
+			$ glab repo init my-service --template my-group/service-template --merge-method ff
+		`),
+		Args: cobra.MaximumNArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.dir = "."
+			if len(args) > 0 {
+				opts.dir = args[0]
+			}
+			return opts.run(gr)
+		},
+	}
+
+	fl := repoInitCmd.Flags()
+	fl.StringVarP(&opts.name, "name", "n", "", "Name of the project. Defaults to the name of the directory.")
+	fl.StringVarP(&opts.group, "group", "g", "", "Group for the project. Defaults to your personal namespace.")
+	fl.StringVarP(&opts.description, "description", "d", "", "Description of the project.")
+	fl.Var(cmdutils.NewEnumValue([]string{"private", "internal", "public"}, "private", &opts.visibility), "visibility", "Visibility of the project: private, internal, public.")
+	fl.Var(cmdutils.NewEnumValue([]string{"merge", "rebase_merge", "ff"}, "", &opts.mergeMethod), "merge-method", "Merge method of the project: merge, rebase_merge, ff. Defaults to the setting of the instance.")
+	fl.StringVar(&opts.defaultBranch, "default-branch", git.DefaultBranchName, "Name of the default branch.")
+	fl.StringVarP(&opts.template, "template", "t", "", "Template repository to copy the files from, as a URL or a path like OWNER/REPO.")
+	fl.Var(cmdutils.NewEnumValue(profiles, profiles[0], &opts.profile), "profile", fmt.Sprintf("Built-in profile to scaffold the repository from: %s.", strings.Join(profiles, ", ")))
+	fl.StringVar(&opts.license, "license", "", "Key of the license template to add, such as mit or apache-2.0.")
+	repoInitCmd.MarkFlagsMutuallyExclusive("template", "profile")
+
+	return repoInitCmd
+}
+
+func (o *options) run(gr git.GitRunner) error {
+	if _, err := os.Stat(filepath.Join(o.dir, ".git")); err == nil {
+		return fmt.Errorf("%s is already a Git repository. To create a project for it, use 'glab repo create'.", o.dir)
+	}
+	absDir, err := filepath.Abs(o.dir)
+	if err != nil {
+		return err
+	}
+	projectPath := filepath.Base(absDir)
+	if o.name == "" {
+		o.name = projectPath
+	}
+
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+	user, _, err := client.Users.CurrentUser()
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to get the current user.")
+	}
+
+	files, err := o.scaffold(client, gr, user)
+	if err != nil {
+		return err
+	}
+
+	createOpts := &gitlab.CreateProjectOptions{
+		Name:          gitlab.Ptr(o.name),
+		Path:          gitlab.Ptr(projectPath),
+		DefaultBranch: gitlab.Ptr(o.defaultBranch),
+		Visibility:    gitlab.Ptr(gitlab.VisibilityValue(o.visibility)),
+	}
+	if o.description != "" {
+		createOpts.Description = gitlab.Ptr(o.description)
+	}
+	if o.mergeMethod != "" {
+		createOpts.MergeMethod = gitlab.Ptr(gitlab.MergeMethodValue(o.mergeMethod))
+	}
+	if o.group != "" {
+		group, _, err := client.Groups.GetGroup(o.group, &gitlab.GetGroupOptions{})
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("could not find group %s.", o.group))
+		}
+		createOpts.NamespaceID = gitlab.Ptr(group.ID)
+	}
+
+	project, _, err := client.Projects.CreateProject(createOpts)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to create the project.")
+	}
+
+	c := o.io.Color()
+	fmt.Fprintf(o.io.StdOut, "%s Created project %s: %s\n", c.GreenCheck(), project.PathWithNamespace, project.WebURL)
+
+	if err := os.MkdirAll(o.dir, 0o755); err != nil {
+		return err
+	}
+	kept, err := writeFiles(o.dir, files)
+	if err != nil {
+		return fmt.Errorf("could not write the files of the repository: %w", err)
+	}
+	for _, name := range kept {
+		fmt.Fprintf(o.io.StdErr, "%s Kept the existing file %s.\n", c.WarnIcon(), name)
+	}
+
+	protocol, _ := o.config().Get(o.defaultHostname(), "git_protocol")
+	remoteURL := glrepo.RemoteURL(project, protocol)
+	steps := [][]string{
+		{"init", "--quiet", "--initial-branch", o.defaultBranch},
+		{"add", "--all"},
+		{"commit", "--quiet", "--message", "Initial commit"},
+		{"remote", "add", "origin", remoteURL},
+		{"push", "--quiet", "--set-upstream", "origin", o.defaultBranch},
+	}
+	for _, args := range steps {
+		if _, err := gr.Git(append([]string{"-C", o.dir}, args...)...); err != nil {
+			return fmt.Errorf("project %s was created, but 'git %s' failed: %w", project.PathWithNamespace, args[0], err)
+		}
+	}
+	fmt.Fprintf(o.io.StdOut, "%s Pushed the initial commit to %s.\n", c.GreenCheck(), o.defaultBranch)
+
+	return nil
+}
+
+// scaffold returns the files of the new repository, from the template repository or
+// the built-in profile.
+func (o *options) scaffold(client *gitlab.Client, gr git.GitRunner, user *gitlab.User) (map[string]scaffoldFile, error) {
+	var files map[string]scaffoldFile
+	var err error
+	if o.template != "" {
+		files, err = o.templateRepoFiles(client, gr)
+	} else {
+		files, err = profileFiles(o.profile)
+		if err == nil {
+			files["README.md"] = scaffoldFile{data: []byte(readme(o.name, o.description)), mode: 0o644}
+			files[".gitlab/CODEOWNERS"] = scaffoldFile{data: fmt.Appendf(nil, "* @%s\n", user.Username), mode: 0o644}
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if o.license != "" {
+		license, _, err := client.LicenseTemplates.GetLicenseTemplate(o.license, &gitlab.GetLicenseTemplateOptions{
+			Project:  gitlab.Ptr(o.name),
+			Fullname: gitlab.Ptr(user.Name),
+		})
+		if err != nil {
+			return nil, cmdutils.WrapError(err, fmt.Sprintf("failed to get the %s license template.", o.license))
+		}
+		files["LICENSE"] = scaffoldFile{data: []byte(license.Content), mode: 0o644}
+	}
+	return files, nil
+}
+
+func (o *options) templateRepoFiles(client *gitlab.Client, gr git.GitRunner) (map[string]scaffoldFile, error) {
+	templateURL := o.template
+	if !git.IsValidURL(templateURL) {
+		template, err := api.GetProject(client, o.template)
+		if err != nil {
+			return nil, cmdutils.WrapError(err, fmt.Sprintf("failed to get the template repository %s.", o.template))
+		}
+		protocol, _ := o.config().Get(o.defaultHostname(), "git_protocol")
+		templateURL = glrepo.RemoteURL(template, protocol)
+	}
+
+	tmp, err := os.MkdirTemp("", "glab-repo-init-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmp)
+
+	if _, err := gr.Git("clone", "--quiet", "--depth", "1", templateURL, tmp); err != nil {
+		return nil, fmt.Errorf("failed to clone the template repository: %w", err)
+	}
+	files, err := templateFiles(tmp)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, errors.New("the template repository has no files.")
+	}
+	return files, nil
+}
+
+func readme(name, description string) string {
+	if description == "" {
+		return fmt.Sprintf("# %s\n", name)
+	}
+	return fmt.Sprintf("# %s\n\n%s\n", name, description)
+}
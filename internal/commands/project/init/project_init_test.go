@@ -0,0 +1,165 @@
+//go:build !integration
+
+package projectinit
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	git_testing "gitlab.com/gitlab-org/cli/internal/git/testing"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+var testProject = &gitlab.Project{
+	PathWithNamespace: "OWNER/my-app",
+	WebURL:            "https://gitlab.com/OWNER/my-app",
+	SSHURLToRepo:      "git@gitlab.com:OWNER/my-app.git",
+	HTTPURLToRepo:     "https://gitlab.com/OWNER/my-app.git",
+}
+
+func expectPush(mockGit *git_testing.MockGitRunner) {
+	gomock.InOrder(
+		mockGit.EXPECT().Git([]string{"-C", "my-app", "init", "--quiet", "--initial-branch", "main"}),
+		mockGit.EXPECT().Git([]string{"-C", "my-app", "add", "--all"}),
+		mockGit.EXPECT().Git([]string{"-C", "my-app", "commit", "--quiet", "--message", "Initial commit"}),
+		mockGit.EXPECT().Git([]string{"-C", "my-app", "remote", "add", "origin", "git@gitlab.com:OWNER/my-app.git"}),
+		mockGit.EXPECT().Git([]string{"-C", "my-app", "push", "--quiet", "--set-upstream", "origin", "main"}),
+	)
+}
+
+func TestRepoInit(t *testing.T) {
+	tests := []struct {
+		name      string
+		cli       string
+		existing  map[string]string
+		setupMock func(tc *gitlabtesting.TestClient, mockGit *git_testing.MockGitRunner)
+		wantFiles map[string]string
+		wantOut   string
+		wantErr   string
+		wantErrIO string
+	}{
+		{
+			name: "Profile with settings and license",
+			cli:  "my-app --profile go --license mit --group my-group --visibility public --merge-method ff",
+			setupMock: func(tc *gitlabtesting.TestClient, mockGit *git_testing.MockGitRunner) {
+				tc.MockLicenseTemplates.EXPECT().
+					GetLicenseTemplate("mit", &gitlab.GetLicenseTemplateOptions{Project: gitlab.Ptr("my-app"), Fullname: gitlab.Ptr("Owner Name")}).
+					Return(&gitlab.LicenseTemplate{Content: "MIT License\n"}, nil, nil)
+				tc.MockGroups.EXPECT().GetGroup("my-group", gomock.Any()).Return(&gitlab.Group{ID: 7}, nil, nil)
+				tc.MockProjects.EXPECT().CreateProject(&gitlab.CreateProjectOptions{
+					Name:          gitlab.Ptr("my-app"),
+					Path:          gitlab.Ptr("my-app"),
+					DefaultBranch: gitlab.Ptr("main"),
+					Visibility:    gitlab.Ptr(gitlab.PublicVisibility),
+					MergeMethod:   gitlab.Ptr(gitlab.FastForwardMerge),
+					NamespaceID:   gitlab.Ptr(int64(7)),
+				}).Return(testProject, nil, nil)
+				expectPush(mockGit)
+			},
+			wantFiles: map[string]string{
+				"LICENSE":                            "MIT License\n",
+				"README.md":                          "# my-app\n",
+				".gitlab/CODEOWNERS":                 "* @OWNER\n",
+				".gitignore":                         "",
+				".gitlab-ci.yml":                     "",
+				".gitlab/issue_templates/Default.md": "",
+				".gitlab/merge_request_templates/Default.md": "",
+			},
+			wantOut: "✓ Created project OWNER/my-app: https://gitlab.com/OWNER/my-app\n✓ Pushed the initial commit to main.\n",
+		},
+		{
+			name: "Template repository",
+			cli:  "my-app --template OWNER/template",
+			setupMock: func(tc *gitlabtesting.TestClient, mockGit *git_testing.MockGitRunner) {
+				tc.MockProjects.EXPECT().GetProject("OWNER/template", gomock.Any()).Return(&gitlab.Project{
+					SSHURLToRepo: "git@gitlab.com:OWNER/template.git",
+				}, nil, nil)
+				mockGit.EXPECT().Git(gomock.Any()).DoAndReturn(func(args ...string) (string, error) {
+					require.Equal(t, []string{"clone", "--quiet", "--depth", "1", "git@gitlab.com:OWNER/template.git"}, args[:5])
+					dir := args[5]
+					require.NoError(t, os.MkdirAll(filepath.Join(dir, ".git"), 0o755))
+					require.NoError(t, os.WriteFile(filepath.Join(dir, ".git", "HEAD"), []byte("ref"), 0o644))
+					require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\n"), 0o644))
+					return "", nil
+				})
+				tc.MockProjects.EXPECT().CreateProject(gomock.Any()).Return(testProject, nil, nil)
+				expectPush(mockGit)
+			},
+			wantFiles: map[string]string{"main.go": "package main\n"},
+			wantOut:   "✓ Created project OWNER/my-app: https://gitlab.com/OWNER/my-app\n✓ Pushed the initial commit to main.\n",
+		},
+		{
+			name:     "Keeps existing files",
+			cli:      "my-app",
+			existing: map[string]string{"README.md": "# Existing\n"},
+			setupMock: func(tc *gitlabtesting.TestClient, mockGit *git_testing.MockGitRunner) {
+				tc.MockProjects.EXPECT().CreateProject(gomock.Any()).Return(testProject, nil, nil)
+				expectPush(mockGit)
+			},
+			wantFiles: map[string]string{"README.md": "# Existing\n"},
+			wantOut:   "✓ Created project OWNER/my-app: https://gitlab.com/OWNER/my-app\n✓ Pushed the initial commit to main.\n",
+			wantErrIO: "! Kept the existing file README.md.\n",
+		},
+		{
+			name:     "Existing repository",
+			cli:      "my-app",
+			existing: map[string]string{".git/HEAD": "ref"},
+			wantErr:  "my-app is already a Git repository. To create a project for it, use 'glab repo create'.",
+		},
+		{
+			name:    "Template and profile",
+			cli:     "my-app --template OWNER/template --profile go",
+			wantErr: "if any flags in the group [template profile] are set none of the others can be; [profile template] were all set",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Chdir(t.TempDir())
+			for name, content := range tt.existing {
+				path := filepath.Join("my-app", name)
+				require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+				require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+			}
+
+			tc := gitlabtesting.NewTestClient(t)
+			mockGit := git_testing.NewMockGitRunner(gomock.NewController(t))
+			tc.MockUsers.EXPECT().CurrentUser().Return(&gitlab.User{Username: "OWNER", Name: "Owner Name"}, nil, nil).AnyTimes()
+			if tt.setupMock != nil {
+				tt.setupMock(tc, mockGit)
+			}
+
+			exec := cmdtest.SetupCmdForTest(t, func(f cmdutils.Factory) *cobra.Command {
+				return NewCmdInit(f, mockGit)
+			}, false, cmdtest.WithGitLabClient(tc.Client))
+
+			out, err := exec(tt.cli)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOut, out.String())
+			assert.Equal(t, tt.wantErrIO, out.Stderr())
+
+			for name, content := range tt.wantFiles {
+				data, err := os.ReadFile(filepath.Join("my-app", filepath.FromSlash(name)))
+				require.NoError(t, err, name)
+				if content != "" {
+					assert.Equal(t, content, string(data), name)
+				}
+			}
+			assert.NoDirExists(t, filepath.Join("my-app", ".git"))
+		})
+	}
+}
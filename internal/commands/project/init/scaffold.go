@@ -0,0 +1,105 @@
+package projectinit
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+//go:embed all:profiles
+var profilesFS embed.FS
+
+// commonProfile holds the files that every built-in profile adds.
+const commonProfile = "common"
+
+// profiles are the built-in profiles, with the default first.
+var profiles = []string{"default", "go", "node", "python"}
+
+type scaffoldFile struct {
+	data []byte
+	mode fs.FileMode
+}
+
+// profileFiles returns the files of a built-in profile, keyed by their slash-separated path.
+func profileFiles(profile string) (map[string]scaffoldFile, error) {
+	files := map[string]scaffoldFile{}
+	for _, dir := range []string{commonProfile, profile} {
+		root := path.Join("profiles", dir)
+		err := fs.WalkDir(profilesFS, root, func(p string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+			data, err := profilesFS.ReadFile(p)
+			if err != nil {
+				return err
+			}
+			files[strings.TrimPrefix(p, root+"/")] = scaffoldFile{data: data, mode: 0o644}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not read the %s profile: %w", dir, err)
+		}
+	}
+	return files, nil
+}
+
+// templateFiles returns the files of a clone of a template repository, without its Git directory.
+func templateFiles(dir string) (map[string]scaffoldFile, error) {
+	files := map[string]scaffoldFile{}
+	root := os.DirFS(dir)
+	err := fs.WalkDir(root, ".", func(p string, d fs.DirEntry, err error) error {
+		switch {
+		case err != nil:
+			return err
+		case d.IsDir() && d.Name() == ".git":
+			return fs.SkipDir
+		case !d.Type().IsRegular():
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		data, err := fs.ReadFile(root, p)
+		if err != nil {
+			return err
+		}
+		files[p] = scaffoldFile{data: data, mode: info.Mode().Perm()}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not read the template: %w", err)
+	}
+	return files, nil
+}
+
+// writeFiles writes the files into dir. Files that already exist are kept, and
+// their paths are returned.
+func writeFiles(dir string, files map[string]scaffoldFile) ([]string, error) {
+	var kept []string
+	for _, name := range slices.Sorted(maps.Keys(files)) {
+		target := filepath.Join(dir, filepath.FromSlash(name))
+		if _, err := os.Stat(target); err == nil {
+			kept = append(kept, name)
+			continue
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(target, files[name].data, files[name].mode); err != nil {
+			return nil, err
+		}
+	}
+	return kept, nil
+}
@@ -1,7 +1,6 @@
 package list
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -25,6 +24,7 @@ type options struct {
 	perPage          int
 	page             int
 	outputFormat     string
+	includeMeta      bool
 	filterAll        bool
 	filterOwner      bool
 	filterMember     bool
@@ -47,6 +47,7 @@ func NewCmdList(f cmdutils.Factory) *cobra.Command {
 		Short: `Get list of repositories.`,
 		Example: heredoc.Doc(`
 			$ glab repo list
+			$ glab repo list --output json --include-meta
 		`),
 		Args:    cobra.ExactArgs(0),
 		Aliases: []string{"ls"},
@@ -56,6 +57,10 @@ func NewCmdList(f cmdutils.Factory) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.complete(cmd)
 
+			if err := opts.validate(); err != nil {
+				return err
+			}
+
 			return opts.run()
 		},
 	}
@@ -67,6 +72,7 @@ func NewCmdList(f cmdutils.Factory) *cobra.Command {
 	repoListCmd.Flags().IntVarP(&opts.page, "page", "p", 1, "Page number.")
 	repoListCmd.Flags().IntVarP(&opts.perPage, "per-page", "P", 30, "Number of items to list per page.")
 	repoListCmd.Flags().StringVarP(&opts.outputFormat, "output", "F", "text", "Format output as: text, json.")
+	repoListCmd.Flags().BoolVar(&opts.includeMeta, "include-meta", false, "With --output json, wrap the projects in an object with the pagination and rate limit state.")
 	repoListCmd.Flags().BoolVarP(&opts.filterAll, "all", "a", false, "List all projects on the instance.")
 	repoListCmd.Flags().BoolVarP(&opts.filterOwner, "mine", "m", false, "List only projects you own. Default if no filters are provided.")
 	repoListCmd.Flags().StringVarP(&opts.user, "user", "u", "", "List user projects.")
@@ -82,6 +88,13 @@ func (o *options) complete(cmd *cobra.Command) {
 	o.archivedSet = cmd.Flags().Changed("archived")
 }
 
+func (o *options) validate() error {
+	if o.includeMeta && o.outputFormat != "json" {
+		return &cmdutils.FlagError{Err: errors.New("--include-meta requires --output json.")}
+	}
+	return nil
+}
+
 func (o *options) run() error {
 	var err error
 	c := o.io.Color()
@@ -107,16 +120,16 @@ func (o *options) run() error {
 	}
 
 	if o.outputFormat == "json" {
-		projectListJSON, _ := json.Marshal(projects)
+		projectListJSON, _ := cmdutils.ListJSON(projects, resp, o.includeMeta)
 		fmt.Fprintln(o.io.StdOut, string(projectListJSON))
 	} else {
 		// Title
 		title := fmt.Sprintf("Showing %d of %d projects (Page %d of %d).\n", len(projects), resp.TotalItems, resp.CurrentPage, resp.TotalPages)
 
 		// List
-		table := tableprinter.NewTablePrinter()
+		table := tableprinter.New(o.io)
 		if len(projects) > 0 {
-			table.AddRow("Project path", "Git URL", "Description")
+			table.AddHeader("Project path", "Git URL", "Description")
 		}
 
 		for _, prj := range projects {
@@ -31,7 +31,7 @@ type options struct {
 	gitlabClient    func() (*gitlab.Client, error)
 	client          *gitlab.Client
 	baseRepoFactory func() (glrepo.Interface, error)
-	defaultHostname string
+	defaultHostname func() string
 }
 
 func NewCmdMirror(f cmdutils.Factory) *cobra.Command {
@@ -40,7 +40,7 @@ func NewCmdMirror(f cmdutils.Factory) *cobra.Command {
 		apiClient:       f.ApiClient,
 		gitlabClient:    f.GitLabClient,
 		baseRepoFactory: f.BaseRepo,
-		defaultHostname: f.DefaultHostname(),
+		defaultHostname: f.DefaultHostname,
 	}
 
 	projectMirrorCmd := &cobra.Command{
@@ -108,7 +108,7 @@ func NewCmdMirror(f cmdutils.Factory) *cobra.Command {
 
 func (o *options) complete(args []string) error {
 	if len(args) > 0 {
-		baseRepo, err := glrepo.FromFullName(args[0], o.defaultHostname)
+		baseRepo, err := glrepo.FromFullName(args[0], o.defaultHostname())
 		if err != nil {
 			return err
 		}
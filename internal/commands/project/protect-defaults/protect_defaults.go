@@ -0,0 +1,356 @@
+package protectdefaults
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/branch/branchutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+// check is one setting of the baseline. fix is nil when the project already
+// matches the baseline.
+type check struct {
+	setting  string
+	current  string
+	baseline string
+	fix      func() error
+}
+
+type options struct {
+	approvals        int64
+	pushAccessLevel  string
+	mergeAccessLevel string
+	tagPattern       string
+	tagAccessLevel   string
+	dryRun           bool
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdProtectDefaults(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "protect-defaults [flags]",
+		Short: `Apply a baseline of protection settings to a project.`,
+		Long: heredoc.Doc(`
+			Apply a baseline of protection settings to a project:
+
+			- Protect the default branch, and restrict who can push and merge to it.
+			- Disable force push to the default branch.
+			- Restrict who can create tags that match a pattern.
+			- Require approvals for merge requests.
+			- Allow merge requests to merge only if their pipeline succeeds.
+
+			Only the settings that differ from the baseline are changed, so running the
+			command again changes nothing. Use --dry-run to report the settings that
+			differ without changing them. With --dry-run, the command exits with
+			status 1 if any setting differs from the baseline.
+		`),
+		Args: cobra.NoArgs,
+		Example: heredoc.Doc(`
+			$ glab repo protect-defaults
+			$ glab repo protect-defaults -R group/project --approvals 2
+
+			# Check a project against the baseline without changing it
+			$ glab repo protect-defaults --dry-run
+
+			# Only let maintainers create release tags
+			$ glab repo protect-defaults --tag-pattern 'v*'
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.approvals < 0 {
+				return &cmdutils.FlagError{Err: errors.New("--approvals can't be negative.")}
+			}
+			return opts.run()
+		},
+	}
+
+	levels := branchutils.AccessLevelNames
+	cmd.Flags().Int64Var(&opts.approvals, "approvals", 1, "Number of approvals required for merge requests. Use 0 to skip this setting.")
+	cmd.Flags().Var(cmdutils.NewEnumValue(levels, "maintainer", &opts.pushAccessLevel), "push-access-level", "Role allowed to push to the default branch: no-one, developer, maintainer.")
+	cmd.Flags().Var(cmdutils.NewEnumValue(levels, "maintainer", &opts.mergeAccessLevel), "merge-access-level", "Role allowed to merge to the default branch: no-one, developer, maintainer.")
+	cmd.Flags().StringVar(&opts.tagPattern, "tag-pattern", "*", "Protect the tags that match this pattern. Use an empty pattern to skip this setting.")
+	cmd.Flags().Var(cmdutils.NewEnumValue(levels, "maintainer", &opts.tagAccessLevel), "tag-access-level", "Role allowed to create protected tags: no-one, developer, maintainer.")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Report the settings that differ from the baseline without changing them.")
+
+	cmdutils.EnableRepoOverride(cmd, f)
+
+	return cmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+	projectID := repo.FullName()
+
+	project, _, err := client.Projects.GetProject(projectID, nil)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to get project %s.", projectID))
+	}
+
+	var checks []check
+
+	if project.DefaultBranch == "" {
+		fmt.Fprintf(o.io.StdErr, "%s has no default branch yet. Skipping the protection of the default branch.\n", projectID)
+	} else {
+		branchChecks, err := o.branchChecks(client, projectID, project.DefaultBranch)
+		if err != nil {
+			return err
+		}
+		checks = append(checks, branchChecks...)
+	}
+
+	if o.tagPattern != "" {
+		tagCheck, err := o.tagCheck(client, projectID)
+		if err != nil {
+			return err
+		}
+		checks = append(checks, tagCheck)
+	}
+
+	if o.approvals > 0 {
+		approvalCheck, err := o.approvalCheck(client, projectID)
+		if err != nil {
+			return err
+		}
+		checks = append(checks, approvalCheck)
+	}
+
+	checks = append(checks, pipelineCheck(client, project))
+
+	return o.apply(checks)
+}
+
+func (o *options) apply(checks []check) error {
+	c := o.io.Color()
+	table := tableprinter.New(o.io)
+	table.AddHeader("SETTING", "CURRENT", "BASELINE", "STATUS")
+
+	drift := 0
+	for _, ch := range checks {
+		status := c.Green("ok")
+		if ch.fix != nil {
+			drift++
+			status = c.Yellow("drift")
+			if !o.dryRun {
+				if err := ch.fix(); err != nil {
+					fmt.Fprint(o.io.StdOut, table.String())
+					return cmdutils.WrapError(err, fmt.Sprintf("failed to update %q.", ch.setting))
+				}
+				status = c.Green("updated")
+			}
+		}
+		table.AddRow(ch.setting, ch.current, ch.baseline, status)
+	}
+	fmt.Fprint(o.io.StdOut, table.String())
+
+	switch {
+	case drift == 0:
+		fmt.Fprintf(o.io.StdOut, "%s The project matches the baseline.\n", c.GreenCheck())
+	case o.dryRun:
+		fmt.Fprintf(o.io.StdErr, "%s %s differ from the baseline.\n", c.WarnIcon(), utils.Pluralize(drift, "setting"))
+		return cmdutils.SilentError
+	default:
+		fmt.Fprintf(o.io.StdOut, "%s Updated %s to match the baseline.\n", c.GreenCheck(), utils.Pluralize(drift, "setting"))
+	}
+	return nil
+}
+
+func (o *options) branchChecks(client *gitlab.Client, projectID, branch string) ([]check, error) {
+	pushLevel := branchutils.AccessLevels[o.pushAccessLevel]
+	mergeLevel := branchutils.AccessLevels[o.mergeAccessLevel]
+
+	protected, _, err := client.ProtectedBranches.GetProtectedBranch(projectID, branch)
+	if errors.Is(err, gitlab.ErrNotFound) {
+		return []check{{
+			setting:  fmt.Sprintf("Protect branch %s", branch),
+			current:  "not protected",
+			baseline: fmt.Sprintf("push: %s, merge: %s, no force push", o.pushAccessLevel, o.mergeAccessLevel),
+			fix: func() error {
+				_, _, err := client.ProtectedBranches.ProtectRepositoryBranches(projectID, &gitlab.ProtectRepositoryBranchesOptions{
+					Name:             gitlab.Ptr(branch),
+					PushAccessLevel:  gitlab.Ptr(pushLevel),
+					MergeAccessLevel: gitlab.Ptr(mergeLevel),
+					AllowForcePush:   gitlab.Ptr(false),
+				})
+				return err
+			},
+		}}, nil
+	}
+	if err != nil {
+		return nil, cmdutils.WrapError(err, fmt.Sprintf("failed to get the protection of branch %s.", branch))
+	}
+
+	pushCheck := check{
+		setting:  fmt.Sprintf("Push to %s", branch),
+		current:  branchutils.LevelNames(protected.PushAccessLevels),
+		baseline: o.pushAccessLevel,
+	}
+	if !branchutils.HasOnlyLevel(protected.PushAccessLevels, pushLevel) {
+		pushCheck.fix = func() error {
+			_, _, err := client.ProtectedBranches.UpdateProtectedBranch(projectID, branch, &gitlab.UpdateProtectedBranchOptions{
+				AllowedToPush: gitlab.Ptr(branchutils.ReplaceLevels(protected.PushAccessLevels, pushLevel)),
+			})
+			return err
+		}
+	}
+
+	mergeCheck := check{
+		setting:  fmt.Sprintf("Merge to %s", branch),
+		current:  branchutils.LevelNames(protected.MergeAccessLevels),
+		baseline: o.mergeAccessLevel,
+	}
+	if !branchutils.HasOnlyLevel(protected.MergeAccessLevels, mergeLevel) {
+		mergeCheck.fix = func() error {
+			_, _, err := client.ProtectedBranches.UpdateProtectedBranch(projectID, branch, &gitlab.UpdateProtectedBranchOptions{
+				AllowedToMerge: gitlab.Ptr(branchutils.ReplaceLevels(protected.MergeAccessLevels, mergeLevel)),
+			})
+			return err
+		}
+	}
+
+	forcePushCheck := check{
+		setting:  fmt.Sprintf("Force push to %s", branch),
+		current:  "not allowed",
+		baseline: "not allowed",
+	}
+	if protected.AllowForcePush {
+		forcePushCheck.current = "allowed"
+		forcePushCheck.fix = func() error {
+			_, _, err := client.ProtectedBranches.UpdateProtectedBranch(projectID, branch, &gitlab.UpdateProtectedBranchOptions{
+				AllowForcePush: gitlab.Ptr(false),
+			})
+			return err
+		}
+	}
+
+	return []check{pushCheck, mergeCheck, forcePushCheck}, nil
+}
+
+func (o *options) tagCheck(client *gitlab.Client, projectID string) (check, error) {
+	level := branchutils.AccessLevels[o.tagAccessLevel]
+	protect := func() error {
+		_, _, err := client.ProtectedTags.ProtectRepositoryTags(projectID, &gitlab.ProtectRepositoryTagsOptions{
+			Name:              gitlab.Ptr(o.tagPattern),
+			CreateAccessLevel: gitlab.Ptr(level),
+		})
+		return err
+	}
+
+	tagCheck := check{
+		setting:  fmt.Sprintf("Create tags %s", o.tagPattern),
+		baseline: o.tagAccessLevel,
+	}
+
+	protected, _, err := client.ProtectedTags.GetProtectedTag(projectID, o.tagPattern)
+	if errors.Is(err, gitlab.ErrNotFound) {
+		tagCheck.current = "not protected"
+		tagCheck.fix = protect
+		return tagCheck, nil
+	}
+	if err != nil {
+		return check{}, cmdutils.WrapError(err, fmt.Sprintf("failed to get the protection of tags %s.", o.tagPattern))
+	}
+
+	levels := branchutils.TagRoleLevels(protected.CreateAccessLevels)
+	tagCheck.current = branchutils.JoinLevels(levels)
+	if len(levels) != 1 || levels[0] != level {
+		// Protected tags can't be updated, so protect them again.
+		tagCheck.fix = func() error {
+			if _, err := client.ProtectedTags.UnprotectRepositoryTags(projectID, o.tagPattern); err != nil {
+				return err
+			}
+			return protect()
+		}
+	}
+	return tagCheck, nil
+}
+
+func (o *options) approvalCheck(client *gitlab.Client, projectID string) (check, error) {
+	rules, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+		return client.Projects.GetProjectApprovalRules(projectID, &gitlab.GetProjectApprovalRulesListsOptions{ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage}}, p)
+	})
+	if err != nil {
+		return check{}, cmdutils.WrapError(err, "failed to get the approval rules.")
+	}
+
+	approvalCheck := check{
+		setting:  "Required approvals",
+		current:  "0",
+		baseline: strconv.FormatInt(o.approvals, 10),
+	}
+
+	for _, rule := range rules {
+		if rule.RuleType != "any_approver" {
+			continue
+		}
+		approvalCheck.current = strconv.FormatInt(rule.ApprovalsRequired, 10)
+		if rule.ApprovalsRequired < o.approvals {
+			approvalCheck.fix = func() error {
+				_, _, err := client.Projects.UpdateProjectApprovalRule(projectID, rule.ID, &gitlab.UpdateProjectLevelRuleOptions{
+					ApprovalsRequired: gitlab.Ptr(o.approvals),
+				})
+				return err
+			}
+		}
+		return approvalCheck, nil
+	}
+
+	approvalCheck.fix = func() error {
+		_, _, err := client.Projects.CreateProjectApprovalRule(projectID, &gitlab.CreateProjectLevelRuleOptions{
+			Name:              gitlab.Ptr("All Members"),
+			RuleType:          gitlab.Ptr("any_approver"),
+			ApprovalsRequired: gitlab.Ptr(o.approvals),
+		})
+		return err
+	}
+	return approvalCheck, nil
+}
+
+func pipelineCheck(client *gitlab.Client, project *gitlab.Project) check {
+	pipelineCheck := check{
+		setting:  "Pipelines must succeed",
+		current:  "yes",
+		baseline: "yes",
+	}
+	if !project.OnlyAllowMergeIfPipelineSucceeds {
+		pipelineCheck.current = "no"
+		pipelineCheck.fix = func() error {
+			_, _, err := client.Projects.EditProject(project.PathWithNamespace, &gitlab.EditProjectOptions{
+				OnlyAllowMergeIfPipelineSucceeds: gitlab.Ptr(true),
+			})
+			return err
+		}
+	}
+	return pipelineCheck
+}
@@ -0,0 +1,130 @@
+//go:build !integration
+
+package protectdefaults
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestProtectDefaultsNewProject(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	tc := gitlabtesting.NewTestClient(t)
+	tc.MockProjects.EXPECT().GetProject("OWNER/REPO", gomock.Any()).
+		Return(&gitlab.Project{PathWithNamespace: "OWNER/REPO", DefaultBranch: "main"}, nil, nil)
+	tc.MockProtectedBranches.EXPECT().GetProtectedBranch("OWNER/REPO", "main").Return(nil, nil, gitlab.ErrNotFound)
+	tc.MockProtectedTags.EXPECT().GetProtectedTag("OWNER/REPO", "*").Return(nil, nil, gitlab.ErrNotFound)
+	tc.MockProjects.EXPECT().GetProjectApprovalRules("OWNER/REPO", gomock.Any(), gomock.Any()).Return(nil, &gitlab.Response{}, nil)
+
+	tc.MockProtectedBranches.EXPECT().ProtectRepositoryBranches("OWNER/REPO", &gitlab.ProtectRepositoryBranchesOptions{
+		Name:             gitlab.Ptr("main"),
+		PushAccessLevel:  gitlab.Ptr(gitlab.MaintainerPermissions),
+		MergeAccessLevel: gitlab.Ptr(gitlab.DeveloperPermissions),
+		AllowForcePush:   gitlab.Ptr(false),
+	}).Return(&gitlab.ProtectedBranch{}, nil, nil)
+	tc.MockProtectedTags.EXPECT().ProtectRepositoryTags("OWNER/REPO", &gitlab.ProtectRepositoryTagsOptions{
+		Name:              gitlab.Ptr("*"),
+		CreateAccessLevel: gitlab.Ptr(gitlab.MaintainerPermissions),
+	}).Return(&gitlab.ProtectedTag{}, nil, nil)
+	tc.MockProjects.EXPECT().CreateProjectApprovalRule("OWNER/REPO", &gitlab.CreateProjectLevelRuleOptions{
+		Name:              gitlab.Ptr("All Members"),
+		RuleType:          gitlab.Ptr("any_approver"),
+		ApprovalsRequired: gitlab.Ptr(int64(2)),
+	}).Return(&gitlab.ProjectApprovalRule{}, nil, nil)
+	tc.MockProjects.EXPECT().EditProject("OWNER/REPO", &gitlab.EditProjectOptions{
+		OnlyAllowMergeIfPipelineSucceeds: gitlab.Ptr(true),
+	}).Return(&gitlab.Project{}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdProtectDefaults, false, cmdtest.WithGitLabClient(tc.Client))
+
+	out, err := exec("--approvals 2 --merge-access-level developer")
+	require.NoError(t, err)
+	assert.Equal(t, ""+
+		"SETTING\tCURRENT\tBASELINE\tSTATUS\n"+
+		"Protect branch main\tnot protected\tpush: maintainer, merge: developer, no force push\tupdated\n"+
+		"Create tags *\tnot protected\tmaintainer\tupdated\n"+
+		"Required approvals\t0\t2\tupdated\n"+
+		"Pipelines must succeed\tno\tyes\tupdated\n"+
+		"✓ Updated 4 settings to match the baseline.\n", out.String())
+}
+
+func TestProtectDefaultsCompliantProject(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	tc := gitlabtesting.NewTestClient(t)
+	tc.MockProjects.EXPECT().GetProject("OWNER/REPO", gomock.Any()).
+		Return(&gitlab.Project{PathWithNamespace: "OWNER/REPO", DefaultBranch: "main", OnlyAllowMergeIfPipelineSucceeds: true}, nil, nil)
+	tc.MockProtectedBranches.EXPECT().GetProtectedBranch("OWNER/REPO", "main").Return(&gitlab.ProtectedBranch{
+		Name:              "main",
+		PushAccessLevels:  []*gitlab.BranchAccessDescription{{ID: 1, AccessLevel: gitlab.MaintainerPermissions}, {ID: 2, UserID: 7, AccessLevel: gitlab.DeveloperPermissions}},
+		MergeAccessLevels: []*gitlab.BranchAccessDescription{{ID: 3, AccessLevel: gitlab.MaintainerPermissions}},
+	}, nil, nil)
+	tc.MockProtectedTags.EXPECT().GetProtectedTag("OWNER/REPO", "v*").Return(&gitlab.ProtectedTag{
+		Name:               "v*",
+		CreateAccessLevels: []*gitlab.TagAccessDescription{{AccessLevel: gitlab.MaintainerPermissions}},
+	}, nil, nil)
+	tc.MockProjects.EXPECT().GetProjectApprovalRules("OWNER/REPO", gomock.Any(), gomock.Any()).
+		Return([]*gitlab.ProjectApprovalRule{{ID: 9, RuleType: "any_approver", ApprovalsRequired: 2}}, &gitlab.Response{}, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdProtectDefaults, false, cmdtest.WithGitLabClient(tc.Client))
+
+	out, err := exec("--tag-pattern v*")
+	require.NoError(t, err)
+	assert.Contains(t, out.String(), "Push to main\tmaintainer\tmaintainer\tok\n")
+	assert.Contains(t, out.String(), "Required approvals\t2\t1\tok\n")
+	assert.Contains(t, out.String(), "✓ The project matches the baseline.\n")
+}
+
+func TestProtectDefaultsDryRun(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	tc := gitlabtesting.NewTestClient(t)
+	tc.MockProjects.EXPECT().GetProject("OWNER/REPO", gomock.Any()).
+		Return(&gitlab.Project{PathWithNamespace: "OWNER/REPO", DefaultBranch: "main", OnlyAllowMergeIfPipelineSucceeds: true}, nil, nil)
+	tc.MockProtectedBranches.EXPECT().GetProtectedBranch("OWNER/REPO", "main").Return(&gitlab.ProtectedBranch{
+		Name:              "main",
+		PushAccessLevels:  []*gitlab.BranchAccessDescription{{ID: 1, AccessLevel: gitlab.DeveloperPermissions}},
+		MergeAccessLevels: []*gitlab.BranchAccessDescription{{ID: 3, AccessLevel: gitlab.MaintainerPermissions}},
+		AllowForcePush:    true,
+	}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdProtectDefaults, false, cmdtest.WithGitLabClient(tc.Client))
+
+	out, err := exec("--dry-run --approvals 0 --tag-pattern ''")
+	require.ErrorIs(t, err, cmdutils.SilentError)
+	assert.Contains(t, out.String(), "Push to main\tdeveloper\tmaintainer\tdrift\n")
+	assert.Contains(t, out.String(), "Force push to main\tallowed\tnot allowed\tdrift\n")
+	assert.Equal(t, "! 2 settings differ from the baseline.\n", out.Stderr())
+}
+
+func TestProtectDefaultsUpdatesAccessLevels(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+	tc.MockProjects.EXPECT().GetProject("OWNER/REPO", gomock.Any()).
+		Return(&gitlab.Project{PathWithNamespace: "OWNER/REPO", DefaultBranch: "main", OnlyAllowMergeIfPipelineSucceeds: true}, nil, nil)
+	tc.MockProtectedBranches.EXPECT().GetProtectedBranch("OWNER/REPO", "main").Return(&gitlab.ProtectedBranch{
+		Name:              "main",
+		PushAccessLevels:  []*gitlab.BranchAccessDescription{{ID: 1, AccessLevel: gitlab.DeveloperPermissions}, {ID: 2, DeployKeyID: 5}},
+		MergeAccessLevels: []*gitlab.BranchAccessDescription{{ID: 3, AccessLevel: gitlab.MaintainerPermissions}},
+	}, nil, nil)
+	tc.MockProtectedBranches.EXPECT().UpdateProtectedBranch("OWNER/REPO", "main", &gitlab.UpdateProtectedBranchOptions{
+		AllowedToPush: &[]*gitlab.BranchPermissionOptions{
+			{ID: gitlab.Ptr(int64(1)), Destroy: gitlab.Ptr(true)},
+			{AccessLevel: gitlab.Ptr(gitlab.MaintainerPermissions)},
+		},
+	}).Return(&gitlab.ProtectedBranch{}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdProtectDefaults, false, cmdtest.WithGitLabClient(tc.Client))
+
+	_, err := exec("--approvals 0 --tag-pattern ''")
+	require.NoError(t, err)
+}
@@ -6,18 +6,28 @@ import (
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
 	repoCmdArchive "gitlab.com/gitlab-org/cli/internal/commands/project/archive"
 	repoCmdClone "gitlab.com/gitlab-org/cli/internal/commands/project/clone"
+	repoCmdCodeowners "gitlab.com/gitlab-org/cli/internal/commands/project/codeowners"
 	repoCmdContributors "gitlab.com/gitlab-org/cli/internal/commands/project/contributors"
 	repoCmdCreate "gitlab.com/gitlab-org/cli/internal/commands/project/create"
 	repoCmdDelete "gitlab.com/gitlab-org/cli/internal/commands/project/delete"
+	repoCmdFile "gitlab.com/gitlab-org/cli/internal/commands/project/file"
 	repoCmdFork "gitlab.com/gitlab-org/cli/internal/commands/project/fork"
+	repoCmdImport "gitlab.com/gitlab-org/cli/internal/commands/project/import"
+	repoCmdInit "gitlab.com/gitlab-org/cli/internal/commands/project/init"
 	repoCmdList "gitlab.com/gitlab-org/cli/internal/commands/project/list"
 	repoCmdMembers "gitlab.com/gitlab-org/cli/internal/commands/project/members"
 	repoCmdMirror "gitlab.com/gitlab-org/cli/internal/commands/project/mirror"
+	repoCmdProtectDefaults "gitlab.com/gitlab-org/cli/internal/commands/project/protect-defaults"
 	repoCmdPublish "gitlab.com/gitlab-org/cli/internal/commands/project/publish"
 	repoCmdSearch "gitlab.com/gitlab-org/cli/internal/commands/project/search"
+	repoCmdSettings "gitlab.com/gitlab-org/cli/internal/commands/project/settings"
+	repoCmdSync "gitlab.com/gitlab-org/cli/internal/commands/project/sync"
 	repoCmdTransfer "gitlab.com/gitlab-org/cli/internal/commands/project/transfer"
+	repoCmdTree "gitlab.com/gitlab-org/cli/internal/commands/project/tree"
 	repoCmdUpdate "gitlab.com/gitlab-org/cli/internal/commands/project/update"
+	repoCmdUsage "gitlab.com/gitlab-org/cli/internal/commands/project/usage"
 	repoCmdView "gitlab.com/gitlab-org/cli/internal/commands/project/view"
+	"gitlab.com/gitlab-org/cli/internal/git"
 )
 
 func NewCmdRepo(f cmdutils.Factory) *cobra.Command {
@@ -30,18 +40,29 @@ func NewCmdRepo(f cmdutils.Factory) *cobra.Command {
 
 	repoCmd.AddCommand(repoCmdArchive.NewCmdArchive(f))
 	repoCmd.AddCommand(repoCmdClone.NewCmdClone(f, nil))
+	repoCmd.AddCommand(repoCmdCodeowners.NewCmdCodeownersLint(f))
 	repoCmd.AddCommand(repoCmdContributors.NewCmdContributors(f))
 	repoCmd.AddCommand(repoCmdList.NewCmdList(f))
 	repoCmd.AddCommand(repoCmdMembers.NewCmdMembers(f))
 	repoCmd.AddCommand(repoCmdCreate.NewCmdCreate(f))
 	repoCmd.AddCommand(repoCmdDelete.NewCmdDelete(f))
+	repoCmd.AddCommand(repoCmdFile.NewCmdFile(f))
 	repoCmd.AddCommand(repoCmdFork.NewCmdFork(f))
+	repoCmd.AddCommand(repoCmdImport.NewCmdImport(f))
 	repoCmd.AddCommand(repoCmdSearch.NewCmdSearch(f))
 	repoCmd.AddCommand(repoCmdTransfer.NewCmdTransfer(f))
+	repoCmd.AddCommand(repoCmdTree.NewCmdTree(f))
 	repoCmd.AddCommand(repoCmdUpdate.NewCmdUpdate(f))
+	repoCmd.AddCommand(repoCmdUsage.NewCmdUsage(f))
 	repoCmd.AddCommand(repoCmdView.NewCmdView(f))
 	repoCmd.AddCommand(repoCmdMirror.NewCmdMirror(f))
 	repoCmd.AddCommand(repoCmdPublish.NewCmdPublish(f))
+	repoCmd.AddCommand(repoCmdProtectDefaults.NewCmdProtectDefaults(f))
+	repoCmd.AddCommand(repoCmdSettings.NewCmdSettings(f))
+
+	var gr git.StandardGitCommand
+	repoCmd.AddCommand(repoCmdSync.NewCmdSync(f, gr))
+	repoCmd.AddCommand(repoCmdInit.NewCmdInit(f, gr))
 
 	return repoCmd
 }
@@ -88,9 +88,9 @@ func (o *options) run() error {
 		title = fmt.Sprintf("No results found for \"%s\"", o.search)
 	}
 
-	table := tableprinter.NewTablePrinter()
+	table := tableprinter.New(o.io)
 	if len(projects) > 0 {
-		table.AddRow("Project ID", "Project path", "Description", "Stars, forks, open issues", "Updated at")
+		table.AddHeader("Project ID", "Project path", "Description", "Stars, forks, open issues", "Updated at")
 	}
 	table.Wrap = false
 	for _, p := range projects {
@@ -0,0 +1,450 @@
+package apply
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/branch/branchutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+var (
+	mergeMethods  = []string{"merge", "rebase_merge", "ff"}
+	squashOptions = []string{"never", "always", "default_on", "default_off"}
+)
+
+// settingsFile is the settings file. Settings that aren't set are kept as
+// they are in the project.
+type settingsFile struct {
+	MergeMethod             *string               `yaml:"merge_method"`
+	SquashOption            *string               `yaml:"squash_option"`
+	CIConfigPath            *string               `yaml:"ci_config_path"`
+	Topics                  *[]string             `yaml:"topics"`
+	Approvals               *approvalsSpec        `yaml:"approvals"`
+	DefaultBranchProtection *branchProtectionSpec `yaml:"default_branch_protection"`
+}
+
+type approvalsSpec struct {
+	Required         *int64 `yaml:"required"`
+	ResetOnPush      *bool  `yaml:"reset_on_push"`
+	AuthorCanApprove *bool  `yaml:"author_can_approve"`
+}
+
+type branchProtectionSpec struct {
+	PushAccessLevel   *string `yaml:"push_access_level"`
+	MergeAccessLevel  *string `yaml:"merge_access_level"`
+	AllowForcePush    *bool   `yaml:"allow_force_push"`
+	CodeOwnerApproval *bool   `yaml:"code_owner_approval"`
+}
+
+// change is a setting of the project that differs from the settings file.
+type change struct {
+	setting string
+	current string
+	desired string
+}
+
+// update is an API call that changes one or more settings.
+type update struct {
+	what  string
+	apply func() error
+}
+
+// plan is the changes that make the project match the settings file, and the
+// updates that make them.
+type plan struct {
+	changes []change
+	updates []update
+}
+
+// add records the setting as changed if current and desired differ, and
+// reports whether they do.
+func (p *plan) add(setting, current, desired string) bool {
+	if current == desired {
+		return false
+	}
+	p.changes = append(p.changes, change{setting: setting, current: current, desired: desired})
+	return true
+}
+
+type options struct {
+	file   string
+	dryRun bool
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdApply(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "apply --file <file> [flags]",
+		Short: `Change the settings of a project to match a YAML file.`,
+		Long: heredoc.Docf(`
+			Change the settings of a project to match a YAML file, to use the same
+			settings in many projects.
+
+			Settings that aren't in the file are kept as they are. The file can set:
+
+			%[1]syaml
+			merge_method: ff                # merge, rebase_merge, or ff
+			squash_option: default_on       # never, always, default_on, or default_off
+			ci_config_path: .gitlab/ci.yml
+			topics: [go, cli]
+			approvals:
+			  required: 2
+			  reset_on_push: true
+			  author_can_approve: false
+			default_branch_protection:
+			  push_access_level: no-one     # no-one, developer, or maintainer
+			  merge_access_level: maintainer
+			  allow_force_push: false
+			  code_owner_approval: true
+			%[1]s
+
+			The settings that differ from the file are printed with their current and
+			desired values. Use --dry-run to print them without changing them. With
+			--dry-run, the command exits with status 1 if any setting differs.
+		`, "```"),
+		Args: cobra.NoArgs,
+		Example: heredoc.Doc(`
+			$ glab repo settings apply --file settings.yml
+
+			# Check another project against the file without changing it
+			$ glab repo settings apply --file settings.yml -R my-group/my-project --dry-run
+
+			# Apply the file to all the projects of a group
+			$ glab repo list --group my-group --output json | jq -r '.[].path_with_namespace' | xargs -I{} glab repo settings apply --file settings.yml -R {}
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.file, "file", "f", "", "YAML file with the settings of the project.")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Print the settings that differ from the file without changing them.")
+	cobra.CheckErr(cmd.MarkFlagRequired("file"))
+
+	return cmd
+}
+
+func (o *options) run() error {
+	settings, err := readSettingsFile(o.file)
+	if err != nil {
+		return err
+	}
+
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+	projectID := repo.FullName()
+
+	project, _, err := client.Projects.GetProject(projectID, nil)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to get project %s.", projectID))
+	}
+
+	p := &plan{}
+	planProject(client, project, settings, p)
+
+	if settings.Approvals != nil {
+		if err := planApprovals(client, projectID, settings.Approvals, p); err != nil {
+			return err
+		}
+	}
+
+	if settings.DefaultBranchProtection != nil {
+		if project.DefaultBranch == "" {
+			fmt.Fprintf(o.io.StdErr, "%s has no default branch yet. Skipping default_branch_protection.\n", projectID)
+		} else if err := planBranchProtection(client, projectID, project.DefaultBranch, settings.DefaultBranchProtection, p); err != nil {
+			return err
+		}
+	}
+
+	c := o.io.Color()
+	if len(p.changes) == 0 {
+		fmt.Fprintf(o.io.StdOut, "%s %s matches %s.\n", c.GreenCheck(), projectID, o.file)
+		return nil
+	}
+
+	for _, ch := range p.changes {
+		fmt.Fprintf(o.io.StdOut, "  %s: %s → %s\n", ch.setting, c.Red(ch.current), c.Green(ch.desired))
+	}
+	if o.dryRun {
+		fmt.Fprintf(o.io.StdErr, "%s %s of %s differ from %s.\n", c.WarnIcon(), utils.Pluralize(len(p.changes), "setting"), projectID, o.file)
+		return cmdutils.SilentError
+	}
+
+	for _, u := range p.updates {
+		if err := u.apply(); err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to update the %s.", u.what))
+		}
+	}
+	fmt.Fprintf(o.io.StdOut, "%s Updated %s of %s to match %s.\n", c.GreenCheck(), utils.Pluralize(len(p.changes), "setting"), projectID, o.file)
+	return nil
+}
+
+func readSettingsFile(path string) (*settingsFile, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := &settingsFile{}
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(settings); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("settings file %s has no settings.", path)
+		}
+		return nil, fmt.Errorf("invalid settings file %s: %w", path, err)
+	}
+
+	if err := validEnum(path, "merge_method", settings.MergeMethod, mergeMethods); err != nil {
+		return nil, err
+	}
+	if err := validEnum(path, "squash_option", settings.SquashOption, squashOptions); err != nil {
+		return nil, err
+	}
+	if a := settings.Approvals; a != nil && a.Required != nil && *a.Required < 0 {
+		return nil, fmt.Errorf("approvals.required in %s can't be negative.", path)
+	}
+	if b := settings.DefaultBranchProtection; b != nil {
+		if err := validEnum(path, "default_branch_protection.push_access_level", b.PushAccessLevel, branchutils.AccessLevelNames); err != nil {
+			return nil, err
+		}
+		if err := validEnum(path, "default_branch_protection.merge_access_level", b.MergeAccessLevel, branchutils.AccessLevelNames); err != nil {
+			return nil, err
+		}
+	}
+	return settings, nil
+}
+
+func validEnum(path, setting string, value *string, values []string) error {
+	if value == nil || slices.Contains(values, *value) {
+		return nil
+	}
+	return fmt.Errorf("invalid %s %q in %s. Use one of: %s.", setting, *value, path, strings.Join(values, ", "))
+}
+
+// planProject plans the settings of the project itself, which are all updated
+// by one call.
+func planProject(client *gitlab.Client, project *gitlab.Project, settings *settingsFile, p *plan) {
+	editOpts := &gitlab.EditProjectOptions{}
+	changes := len(p.changes)
+
+	if settings.MergeMethod != nil && p.add("merge_method", string(project.MergeMethod), *settings.MergeMethod) {
+		editOpts.MergeMethod = gitlab.Ptr(gitlab.MergeMethodValue(*settings.MergeMethod))
+	}
+	if settings.SquashOption != nil && p.add("squash_option", string(project.SquashOption), *settings.SquashOption) {
+		editOpts.SquashOption = gitlab.Ptr(gitlab.SquashOptionValue(*settings.SquashOption))
+	}
+	if settings.CIConfigPath != nil && p.add("ci_config_path", orNone(project.CIConfigPath), orNone(*settings.CIConfigPath)) {
+		editOpts.CIConfigPath = settings.CIConfigPath
+	}
+	// The order of topics doesn't matter.
+	if settings.Topics != nil && p.add("topics", joinTopics(project.Topics), joinTopics(*settings.Topics)) {
+		editOpts.Topics = settings.Topics
+	}
+
+	if len(p.changes) == changes {
+		return
+	}
+	p.updates = append(p.updates, update{
+		what: "project settings",
+		apply: func() error {
+			_, _, err := client.Projects.EditProject(project.PathWithNamespace, editOpts)
+			return err
+		},
+	})
+}
+
+func planApprovals(client *gitlab.Client, projectID string, spec *approvalsSpec, p *plan) error {
+	if spec.Required != nil {
+		rules, err := gitlab.ScanAndCollect(func(pg gitlab.PaginationOptionFunc) ([]*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+			return client.Projects.GetProjectApprovalRules(projectID, &gitlab.GetProjectApprovalRulesListsOptions{ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage}}, pg)
+		})
+		if err != nil {
+			return cmdutils.WrapError(err, "failed to get the approval rules.")
+		}
+
+		var rule *gitlab.ProjectApprovalRule
+		for _, r := range rules {
+			if r.RuleType == "any_approver" {
+				rule = r
+				break
+			}
+		}
+		current := int64(0)
+		if rule != nil {
+			current = rule.ApprovalsRequired
+		}
+
+		if p.add("approvals.required", strconv.FormatInt(current, 10), strconv.FormatInt(*spec.Required, 10)) {
+			p.updates = append(p.updates, update{
+				what: "approval rules",
+				apply: func() error {
+					if rule != nil {
+						_, _, err := client.Projects.UpdateProjectApprovalRule(projectID, rule.ID, &gitlab.UpdateProjectLevelRuleOptions{
+							ApprovalsRequired: spec.Required,
+						})
+						return err
+					}
+					_, _, err := client.Projects.CreateProjectApprovalRule(projectID, &gitlab.CreateProjectLevelRuleOptions{
+						Name:              gitlab.Ptr("All Members"),
+						RuleType:          gitlab.Ptr("any_approver"),
+						ApprovalsRequired: spec.Required,
+					})
+					return err
+				},
+			})
+		}
+	}
+
+	if spec.ResetOnPush == nil && spec.AuthorCanApprove == nil {
+		return nil
+	}
+	config, _, err := client.Projects.GetApprovalConfiguration(projectID)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to get the approval settings.")
+	}
+
+	changeOpts := &gitlab.ChangeApprovalConfigurationOptions{}
+	changes := len(p.changes)
+	if spec.ResetOnPush != nil && p.add("approvals.reset_on_push", strconv.FormatBool(config.ResetApprovalsOnPush), strconv.FormatBool(*spec.ResetOnPush)) {
+		changeOpts.ResetApprovalsOnPush = spec.ResetOnPush
+	}
+	if spec.AuthorCanApprove != nil && p.add("approvals.author_can_approve", strconv.FormatBool(config.MergeRequestsAuthorApproval), strconv.FormatBool(*spec.AuthorCanApprove)) {
+		changeOpts.MergeRequestsAuthorApproval = spec.AuthorCanApprove
+	}
+	if len(p.changes) > changes {
+		p.updates = append(p.updates, update{
+			what: "approval settings",
+			apply: func() error {
+				_, _, err := client.Projects.ChangeApprovalConfiguration(projectID, changeOpts)
+				return err
+			},
+		})
+	}
+	return nil
+}
+
+func planBranchProtection(client *gitlab.Client, projectID, branch string, spec *branchProtectionSpec, p *plan) error {
+	what := fmt.Sprintf("protection of branch %s", branch)
+
+	protected, _, err := client.ProtectedBranches.GetProtectedBranch(projectID, branch)
+	if errors.Is(err, gitlab.ErrNotFound) {
+		protectOpts := &gitlab.ProtectRepositoryBranchesOptions{Name: gitlab.Ptr(branch)}
+		changes := len(p.changes)
+		if spec.PushAccessLevel != nil {
+			p.add("default_branch_protection.push_access_level", "not protected", *spec.PushAccessLevel)
+			protectOpts.PushAccessLevel = gitlab.Ptr(branchutils.AccessLevels[*spec.PushAccessLevel])
+		}
+		if spec.MergeAccessLevel != nil {
+			p.add("default_branch_protection.merge_access_level", "not protected", *spec.MergeAccessLevel)
+			protectOpts.MergeAccessLevel = gitlab.Ptr(branchutils.AccessLevels[*spec.MergeAccessLevel])
+		}
+		if spec.AllowForcePush != nil {
+			p.add("default_branch_protection.allow_force_push", "not protected", strconv.FormatBool(*spec.AllowForcePush))
+			protectOpts.AllowForcePush = spec.AllowForcePush
+		}
+		if spec.CodeOwnerApproval != nil {
+			p.add("default_branch_protection.code_owner_approval", "not protected", strconv.FormatBool(*spec.CodeOwnerApproval))
+			protectOpts.CodeOwnerApprovalRequired = spec.CodeOwnerApproval
+		}
+		// An empty section protects the branch with the defaults of GitLab.
+		if len(p.changes) == changes {
+			p.add("default_branch_protection", "not protected", "protected")
+		}
+
+		p.updates = append(p.updates, update{
+			what: what,
+			apply: func() error {
+				_, _, err := client.ProtectedBranches.ProtectRepositoryBranches(projectID, protectOpts)
+				return err
+			},
+		})
+		return nil
+	}
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to get the protection of branch %s.", branch))
+	}
+
+	updateOpts := &gitlab.UpdateProtectedBranchOptions{}
+	changes := len(p.changes)
+	if spec.PushAccessLevel != nil {
+		level := branchutils.AccessLevels[*spec.PushAccessLevel]
+		if !branchutils.HasOnlyLevel(protected.PushAccessLevels, level) {
+			p.add("default_branch_protection.push_access_level", branchutils.LevelNames(protected.PushAccessLevels), *spec.PushAccessLevel)
+			updateOpts.AllowedToPush = gitlab.Ptr(branchutils.ReplaceLevels(protected.PushAccessLevels, level))
+		}
+	}
+	if spec.MergeAccessLevel != nil {
+		level := branchutils.AccessLevels[*spec.MergeAccessLevel]
+		if !branchutils.HasOnlyLevel(protected.MergeAccessLevels, level) {
+			p.add("default_branch_protection.merge_access_level", branchutils.LevelNames(protected.MergeAccessLevels), *spec.MergeAccessLevel)
+			updateOpts.AllowedToMerge = gitlab.Ptr(branchutils.ReplaceLevels(protected.MergeAccessLevels, level))
+		}
+	}
+	if spec.AllowForcePush != nil && p.add("default_branch_protection.allow_force_push", strconv.FormatBool(protected.AllowForcePush), strconv.FormatBool(*spec.AllowForcePush)) {
+		updateOpts.AllowForcePush = spec.AllowForcePush
+	}
+	if spec.CodeOwnerApproval != nil && p.add("default_branch_protection.code_owner_approval", strconv.FormatBool(protected.CodeOwnerApprovalRequired), strconv.FormatBool(*spec.CodeOwnerApproval)) {
+		updateOpts.CodeOwnerApprovalRequired = spec.CodeOwnerApproval
+	}
+	if len(p.changes) > changes {
+		p.updates = append(p.updates, update{
+			what: what,
+			apply: func() error {
+				_, _, err := client.ProtectedBranches.UpdateProtectedBranch(projectID, branch, updateOpts)
+				return err
+			},
+		})
+	}
+	return nil
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "none"
+	}
+	return s
+}
+
+func joinTopics(topics []string) string {
+	sorted := slices.Clone(topics)
+	slices.Sort(sorted)
+	return orNone(strings.Join(sorted, ", "))
+}
@@ -0,0 +1,188 @@
+//go:build !integration
+
+package apply
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+const settingsYAML = `
+merge_method: ff
+squash_option: default_on
+ci_config_path: .gitlab-ci.yml
+topics: [go, cli]
+approvals:
+  required: 2
+  reset_on_push: true
+default_branch_protection:
+  push_access_level: no-one
+  allow_force_push: false
+`
+
+func writeSettingsFile(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "settings.yml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func setupProject(tc *gitlabtesting.TestClient) {
+	tc.MockProjects.EXPECT().GetProject("OWNER/REPO", gomock.Any()).Return(&gitlab.Project{
+		PathWithNamespace: "OWNER/REPO",
+		DefaultBranch:     "main",
+		MergeMethod:       gitlab.NoFastForwardMerge,
+		SquashOption:      gitlab.SquashOptionValue("default_on"),
+		Topics:            []string{"go"},
+	}, nil, nil)
+	tc.MockProjects.EXPECT().GetProjectApprovalRules("OWNER/REPO", gomock.Any(), gomock.Any()).
+		Return([]*gitlab.ProjectApprovalRule{{ID: 4, RuleType: "any_approver", ApprovalsRequired: 1}}, &gitlab.Response{}, nil)
+	tc.MockProjects.EXPECT().GetApprovalConfiguration("OWNER/REPO").
+		Return(&gitlab.ProjectApprovals{ResetApprovalsOnPush: true}, nil, nil)
+	tc.MockProtectedBranches.EXPECT().GetProtectedBranch("OWNER/REPO", "main").Return(&gitlab.ProtectedBranch{
+		Name:              "main",
+		PushAccessLevels:  []*gitlab.BranchAccessDescription{{ID: 1, AccessLevel: gitlab.MaintainerPermissions}},
+		MergeAccessLevels: []*gitlab.BranchAccessDescription{{ID: 2, AccessLevel: gitlab.MaintainerPermissions}},
+	}, nil, nil)
+}
+
+func TestSettingsApply(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	tc := gitlabtesting.NewTestClient(t)
+	setupProject(tc)
+	tc.MockProjects.EXPECT().EditProject("OWNER/REPO", &gitlab.EditProjectOptions{
+		MergeMethod:  gitlab.Ptr(gitlab.FastForwardMerge),
+		CIConfigPath: gitlab.Ptr(".gitlab-ci.yml"),
+		Topics:       gitlab.Ptr([]string{"go", "cli"}),
+	}).Return(&gitlab.Project{}, nil, nil)
+	tc.MockProjects.EXPECT().UpdateProjectApprovalRule("OWNER/REPO", int64(4), &gitlab.UpdateProjectLevelRuleOptions{
+		ApprovalsRequired: gitlab.Ptr(int64(2)),
+	}).Return(&gitlab.ProjectApprovalRule{}, nil, nil)
+	tc.MockProtectedBranches.EXPECT().UpdateProtectedBranch("OWNER/REPO", "main", &gitlab.UpdateProtectedBranchOptions{
+		AllowedToPush: gitlab.Ptr([]*gitlab.BranchPermissionOptions{
+			{ID: gitlab.Ptr(int64(1)), Destroy: gitlab.Ptr(true)},
+			{AccessLevel: gitlab.Ptr(gitlab.NoPermissions)},
+		}),
+	}).Return(&gitlab.ProtectedBranch{}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdApply, false, cmdtest.WithGitLabClient(tc.Client))
+
+	path := writeSettingsFile(t, settingsYAML)
+	out, err := exec("--file " + path)
+	require.NoError(t, err)
+	assert.Equal(t, ""+
+		"  merge_method: merge → ff\n"+
+		"  ci_config_path: none → .gitlab-ci.yml\n"+
+		"  topics: go → cli, go\n"+
+		"  approvals.required: 1 → 2\n"+
+		"  default_branch_protection.push_access_level: maintainer → no-one\n"+
+		"✓ Updated 5 settings of OWNER/REPO to match "+path+".\n", out.String())
+}
+
+func TestSettingsApplyDryRun(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	tc := gitlabtesting.NewTestClient(t)
+	setupProject(tc)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdApply, false, cmdtest.WithGitLabClient(tc.Client))
+
+	path := writeSettingsFile(t, settingsYAML)
+	out, err := exec("--file " + path + " --dry-run")
+	assert.ErrorIs(t, err, cmdutils.SilentError)
+	assert.Contains(t, out.String(), "  topics: go → cli, go\n")
+	assert.Equal(t, "! 5 settings of OWNER/REPO differ from "+path+".\n", out.Stderr())
+}
+
+func TestSettingsApplyUnprotectedBranch(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	tc := gitlabtesting.NewTestClient(t)
+	tc.MockProjects.EXPECT().GetProject("OWNER/REPO", gomock.Any()).
+		Return(&gitlab.Project{PathWithNamespace: "OWNER/REPO", DefaultBranch: "main", CIConfigPath: ".gitlab-ci.yml"}, nil, nil)
+	tc.MockProtectedBranches.EXPECT().GetProtectedBranch("OWNER/REPO", "main").Return(nil, nil, gitlab.ErrNotFound)
+	tc.MockProtectedBranches.EXPECT().ProtectRepositoryBranches("OWNER/REPO", &gitlab.ProtectRepositoryBranchesOptions{
+		Name: gitlab.Ptr("main"),
+	}).Return(&gitlab.ProtectedBranch{}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdApply, false, cmdtest.WithGitLabClient(tc.Client))
+
+	path := writeSettingsFile(t, "ci_config_path: .gitlab-ci.yml\ndefault_branch_protection: {}\n")
+	out, err := exec("--file " + path)
+	require.NoError(t, err)
+	assert.Equal(t, ""+
+		"  default_branch_protection: not protected → protected\n"+
+		"✓ Updated 1 setting of OWNER/REPO to match "+path+".\n", out.String())
+}
+
+func TestSettingsApplyMatches(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	tc := gitlabtesting.NewTestClient(t)
+	tc.MockProjects.EXPECT().GetProject("OWNER/REPO", gomock.Any()).
+		Return(&gitlab.Project{PathWithNamespace: "OWNER/REPO", Topics: []string{"cli", "go"}}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdApply, false, cmdtest.WithGitLabClient(tc.Client))
+
+	path := writeSettingsFile(t, "topics: [go, cli]\n")
+	out, err := exec("--file " + path)
+	require.NoError(t, err)
+	assert.Equal(t, "✓ OWNER/REPO matches "+path+".\n", out.String())
+}
+
+func TestSettingsApplyInvalidFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{
+			name:    "unknown setting",
+			content: "merge_methods: ff\n",
+			wantErr: "field merge_methods not found",
+		},
+		{
+			name:    "invalid value",
+			content: "merge_method: squash\n",
+			wantErr: `invalid merge_method "squash"`,
+		},
+		{
+			name:    "invalid access level",
+			content: "default_branch_protection:\n  push_access_level: owner\n",
+			wantErr: `invalid default_branch_protection.push_access_level "owner"`,
+		},
+		{
+			name:    "negative approvals",
+			content: "approvals:\n  required: -1\n",
+			wantErr: "approvals.required in",
+		},
+		{
+			name:    "empty file",
+			content: "",
+			wantErr: "has no settings.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exec := cmdtest.SetupCmdForTest(t, NewCmdApply, false, cmdtest.WithGitLabClient(gitlabtesting.NewTestClient(t).Client))
+
+			_, err := exec("--file " + writeSettingsFile(t, tt.content))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package settings
+
+import (
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	cmdApply "gitlab.com/gitlab-org/cli/internal/commands/project/settings/apply"
+)
+
+func NewCmdSettings(f cmdutils.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "settings <command> [flags]",
+		Short: `Manage the settings of a project.`,
+		Long:  "Manage the settings of a project, like its merge method and approvals.\n",
+	}
+
+	cmdutils.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdApply.NewCmdApply(f))
+
+	return cmd
+}
@@ -0,0 +1,201 @@
+package sync
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/git"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+// cap the number of Git remotes looked up, like the remote resolver does
+const maxRemotesForLookup = 5
+
+type options struct {
+	allRemotes  bool
+	pruneMerged bool
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	remotes      func() (glrepo.Remotes, error)
+	branch       func() (string, error)
+}
+
+// forkRemotes are the Git remotes of a fork and of the project it was forked from.
+type forkRemotes struct {
+	fork     *glrepo.Remote
+	upstream *glrepo.Remote
+	// defaultBranch is the default branch of the upstream project.
+	defaultBranch string
+}
+
+func NewCmdSync(f cmdutils.Factory, gr git.GitRunner) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		remotes:      f.Remotes,
+		branch:       f.Branch,
+	}
+
+	repoSyncCmd := &cobra.Command{
+		Use:   "sync [flags]",
+		Short: `Update the local repository and its fork from the upstream project.`,
+		Long: heredoc.Doc(`
+			Bring a local clone of a fork up to date with the project it was forked from:
+
+			1. Fetch the upstream remote, or every remote with --all-remotes.
+			1. Fast-forward the local default branch to the default branch of the upstream project.
+			1. Push the default branch to the remote of the fork.
+			1. With --prune-merged, delete the local branches that are merged into the default branch.
+
+			The fork and upstream remotes are detected from the Git remotes of the repository:
+			the fork is the remote whose project was forked from the project of another remote.
+
+			The default branch is never rebased or merged. If it has commits that are not in the
+			upstream branch, the command stops before pushing. To update a fork on the server
+			without a local clone, use 'glab sync fork'.
+		`),
+		Example: heredoc.Doc(`
+			# Update the default branch of the clone and of the fork
+			$ glab repo sync
+
+			# Also fetch every other remote, and delete local branches that were merged
+			$ glab repo sync --all-remotes --prune-merged
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run(gr)
+		},
+	}
+
+	repoSyncCmd.Flags().BoolVar(&opts.allRemotes, "all-remotes", false, "Fetch every remote, not only the upstream and fork remotes.")
+	repoSyncCmd.Flags().BoolVar(&opts.pruneMerged, "prune-merged", false, "Delete local branches that are merged into the default branch.")
+
+	return repoSyncCmd
+}
+
+func (o *options) run(gr git.GitRunner) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	remotes, err := o.remotes()
+	if err != nil {
+		return err
+	}
+
+	fr, err := findForkRemotes(client, remotes)
+	if err != nil {
+		return err
+	}
+
+	c := o.io.Color()
+	fetchArgs := [][]string{{"fetch", "--prune", fr.upstream.Name}, {"fetch", "--prune", fr.fork.Name}}
+	if o.allRemotes {
+		fetchArgs = [][]string{{"fetch", "--all", "--prune"}}
+	}
+	for _, args := range fetchArgs {
+		if _, err := gr.Git(args...); err != nil {
+			return fmt.Errorf("failed to fetch: %w", err)
+		}
+	}
+
+	current, err := o.branch()
+	if err != nil && !errors.Is(err, git.ErrNotOnAnyBranch) {
+		return err
+	}
+
+	defaultBranch := fr.defaultBranch
+	upstreamBranch := fr.upstream.Name + "/" + defaultBranch
+	if current == defaultBranch {
+		_, err = gr.Git("merge", "--ff-only", "refs/remotes/"+upstreamBranch)
+	} else {
+		// Fetching into the local branch only succeeds for fast-forwards.
+		_, err = gr.Git("fetch", ".", "refs/remotes/"+upstreamBranch+":refs/heads/"+defaultBranch)
+	}
+	if err != nil {
+		return fmt.Errorf("could not fast-forward %s to %s. Merge or rebase it manually: %w", defaultBranch, upstreamBranch, err)
+	}
+	fmt.Fprintf(o.io.StdOut, "%s Updated %s from %s.\n", c.GreenCheck(), defaultBranch, upstreamBranch)
+
+	if _, err := gr.Git("push", fr.fork.Name, "refs/heads/"+defaultBranch+":refs/heads/"+defaultBranch); err != nil {
+		return fmt.Errorf("failed to push %s to %s: %w", defaultBranch, fr.fork.Name, err)
+	}
+	fmt.Fprintf(o.io.StdOut, "%s Pushed %s to %s.\n", c.GreenCheck(), defaultBranch, fr.fork.Name)
+
+	if o.pruneMerged {
+		return o.deleteMergedBranches(gr, defaultBranch, current)
+	}
+	return nil
+}
+
+func (o *options) deleteMergedBranches(gr git.GitRunner, defaultBranch, current string) error {
+	out, err := gr.Git("branch", "--format=%(refname:short)", "--merged", defaultBranch)
+	if err != nil {
+		return fmt.Errorf("failed to list merged branches: %w", err)
+	}
+
+	c := o.io.Color()
+	deleted := 0
+	for branch := range strings.SplitSeq(out, "\n") {
+		branch = strings.TrimSpace(branch)
+		if branch == "" || branch == defaultBranch || branch == current {
+			continue
+		}
+		if _, err := gr.Git("branch", "-d", branch); err != nil {
+			return fmt.Errorf("could not delete local branch %s: %w", branch, err)
+		}
+		deleted++
+		fmt.Fprintf(o.io.StdOut, "%s Deleted merged branch %s.\n", c.GreenCheck(), branch)
+	}
+
+	if deleted == 0 {
+		fmt.Fprintln(o.io.StdOut, "No merged branches to delete.")
+	}
+	return nil
+}
+
+// findForkRemotes finds a remote whose project is a fork of the project of another remote.
+func findForkRemotes(client *gitlab.Client, remotes glrepo.Remotes) (*forkRemotes, error) {
+	for i, remote := range remotes {
+		if i >= maxRemotesForLookup {
+			break
+		}
+
+		project, err := api.GetProject(client, remote.FullName())
+		if err != nil || project.ForkedFromProject == nil {
+			continue
+		}
+
+		upstreamName := project.ForkedFromProject.PathWithNamespace
+		for _, candidate := range remotes {
+			if !strings.EqualFold(candidate.FullName(), upstreamName) {
+				continue
+			}
+
+			upstream, err := api.GetProject(client, upstreamName)
+			if err != nil {
+				return nil, cmdutils.WrapError(err, "failed to get the upstream project.")
+			}
+			return &forkRemotes{fork: remote, upstream: candidate, defaultBranch: upstream.DefaultBranch}, nil
+		}
+
+		return nil, fmt.Errorf("%s is a fork of %s, but no remote points to %s. Add one with 'git remote add upstream <url>'.", remote.FullName(), upstreamName, upstreamName)
+	}
+
+	return nil, errors.New("no remote points to a fork of the project of another remote.")
+}
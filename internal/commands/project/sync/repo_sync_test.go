@@ -0,0 +1,142 @@
+//go:build !integration
+
+package sync
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/git"
+	git_testing "gitlab.com/gitlab-org/cli/internal/git/testing"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+var testRemotes = glrepo.Remotes{
+	{Remote: &git.Remote{Name: "upstream"}, Repo: glrepo.TestProject("upstream", "REPO")},
+	{Remote: &git.Remote{Name: "origin"}, Repo: glrepo.TestProject("OWNER", "REPO")},
+}
+
+func expectProjects(tc *gitlabtesting.TestClient) {
+	upstream := &gitlab.Project{PathWithNamespace: "upstream/REPO", DefaultBranch: "main"}
+	tc.MockProjects.EXPECT().GetProject("upstream/REPO", gomock.Any()).Return(upstream, nil, nil).Times(2)
+	tc.MockProjects.EXPECT().GetProject("OWNER/REPO", gomock.Any()).Return(&gitlab.Project{
+		PathWithNamespace: "OWNER/REPO",
+		ForkedFromProject: &gitlab.ForkParent{PathWithNamespace: "upstream/REPO"},
+	}, nil, nil)
+}
+
+func TestRepoSync(t *testing.T) {
+	tests := []struct {
+		name      string
+		cli       string
+		branch    string
+		remotes   glrepo.Remotes
+		setupMock func(tc *gitlabtesting.TestClient, mockGit *git_testing.MockGitRunner)
+		wantOut   string
+		wantErr   string
+	}{
+		{
+			name:   "Default branch checked out",
+			branch: "main",
+			setupMock: func(tc *gitlabtesting.TestClient, mockGit *git_testing.MockGitRunner) {
+				expectProjects(tc)
+				gomock.InOrder(
+					mockGit.EXPECT().Git([]string{"fetch", "--prune", "upstream"}),
+					mockGit.EXPECT().Git([]string{"fetch", "--prune", "origin"}),
+					mockGit.EXPECT().Git([]string{"merge", "--ff-only", "refs/remotes/upstream/main"}),
+					mockGit.EXPECT().Git([]string{"push", "origin", "refs/heads/main:refs/heads/main"}),
+				)
+			},
+			wantOut: "✓ Updated main from upstream/main.\n✓ Pushed main to origin.\n",
+		},
+		{
+			name:   "Other branch checked out, all remotes, prune merged",
+			cli:    "--all-remotes --prune-merged",
+			branch: "feature",
+			setupMock: func(tc *gitlabtesting.TestClient, mockGit *git_testing.MockGitRunner) {
+				expectProjects(tc)
+				gomock.InOrder(
+					mockGit.EXPECT().Git([]string{"fetch", "--all", "--prune"}),
+					mockGit.EXPECT().Git([]string{"fetch", ".", "refs/remotes/upstream/main:refs/heads/main"}),
+					mockGit.EXPECT().Git([]string{"push", "origin", "refs/heads/main:refs/heads/main"}),
+					mockGit.EXPECT().Git([]string{"branch", "--format=%(refname:short)", "--merged", "main"}).
+						Return("done\nfeature\nmain\nold\n", nil),
+					mockGit.EXPECT().Git([]string{"branch", "-d", "done"}),
+					mockGit.EXPECT().Git([]string{"branch", "-d", "old"}),
+				)
+			},
+			wantOut: "✓ Updated main from upstream/main.\n✓ Pushed main to origin.\n✓ Deleted merged branch done.\n✓ Deleted merged branch old.\n",
+		},
+		{
+			name:   "Diverged default branch",
+			branch: "main",
+			setupMock: func(tc *gitlabtesting.TestClient, mockGit *git_testing.MockGitRunner) {
+				expectProjects(tc)
+				mockGit.EXPECT().Git([]string{"fetch", "--prune", "upstream"})
+				mockGit.EXPECT().Git([]string{"fetch", "--prune", "origin"})
+				mockGit.EXPECT().Git([]string{"merge", "--ff-only", "refs/remotes/upstream/main"}).
+					Return("", errors.New("exit status 128"))
+			},
+			wantErr: "could not fast-forward main to upstream/main. Merge or rebase it manually: exit status 128",
+		},
+		{
+			name:    "No upstream remote",
+			remotes: testRemotes[1:],
+			setupMock: func(tc *gitlabtesting.TestClient, _ *git_testing.MockGitRunner) {
+				tc.MockProjects.EXPECT().GetProject("OWNER/REPO", gomock.Any()).Return(&gitlab.Project{
+					ForkedFromProject: &gitlab.ForkParent{PathWithNamespace: "upstream/REPO"},
+				}, nil, nil)
+			},
+			wantErr: "OWNER/REPO is a fork of upstream/REPO, but no remote points to upstream/REPO. Add one with 'git remote add upstream <url>'.",
+		},
+		{
+			name:    "No fork",
+			remotes: testRemotes[:1],
+			setupMock: func(tc *gitlabtesting.TestClient, _ *git_testing.MockGitRunner) {
+				tc.MockProjects.EXPECT().GetProject("upstream/REPO", gomock.Any()).Return(&gitlab.Project{}, nil, nil)
+			},
+			wantErr: "no remote points to a fork of the project of another remote.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tc := gitlabtesting.NewTestClient(t)
+			mockGit := git_testing.NewMockGitRunner(gomock.NewController(t))
+			tt.setupMock(tc, mockGit)
+
+			remotes := tt.remotes
+			if remotes == nil {
+				remotes = testRemotes
+			}
+
+			exec := cmdtest.SetupCmdForTest(t, func(f cmdutils.Factory) *cobra.Command {
+				return NewCmdSync(f, mockGit)
+			}, false,
+				cmdtest.WithGitLabClient(tc.Client),
+				cmdtest.WithBranch(tt.branch),
+				func(f *cmdtest.Factory) {
+					f.RemotesStub = func() (glrepo.Remotes, error) { return remotes, nil }
+				},
+			)
+
+			out, err := exec(tt.cli)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOut, out.String())
+		})
+	}
+}
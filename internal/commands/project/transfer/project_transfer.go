@@ -2,6 +2,7 @@ package transfer
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/MakeNowJust/heredoc/v2"
@@ -17,18 +18,50 @@ import (
 
 func NewCmdTransfer(f cmdutils.Factory) *cobra.Command {
 	repoTransferCmd := &cobra.Command{
-		Use:   "transfer [repo] [flags]",
+		Use:   "transfer [<repo>] [<namespace>] [flags]",
 		Short: `Transfer a repository to a new namespace.`,
+		Long: heredoc.Doc(`
+			Transfer a repository to another user or group namespace.
+
+			Before the transfer, the current and new paths of the repository are shown and
+			you are asked to confirm. Use --yes to skip the confirmation, for example in scripts.
+		`),
 		Example: heredoc.Doc(`
+			$ glab repo transfer profclems/glab notprofclems
 			$ glab repo transfer profclems/glab --target-namespace notprofclems
+
+			# Transfer the repository of the current directory without confirmation
+			$ glab repo transfer --target-namespace my-group/subgroup --yes
 		`),
-		Args: cobra.MaximumNArgs(1),
+		Args: cobra.MaximumNArgs(2),
 		Annotations: map[string]string{
 			mcpannotations.Destructive: "true",
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			var err error
 
+			targetNamespace, err := cmd.Flags().GetString("target-namespace")
+			if err != nil {
+				return err
+			}
+			if len(args) == 2 {
+				if targetNamespace != "" && targetNamespace != args[1] {
+					return &cmdutils.FlagError{Err: errors.New("specify the namespace either as an argument or with --target-namespace, not both.")}
+				}
+				targetNamespace = args[1]
+			}
+			if targetNamespace == "" {
+				return &cmdutils.FlagError{Err: errors.New("specify the namespace to transfer the repository to.")}
+			}
+
+			dontPromptForConfirmation, err := cmd.Flags().GetBool("yes")
+			if err != nil {
+				return err
+			}
+			if !dontPromptForConfirmation && !f.IO().PromptEnabled() {
+				return &cmdutils.FlagError{Err: errors.New("--yes is required when not running interactively.")}
+			}
+
 			var client *gitlab.Client
 			var repo glrepo.Interface
 			if len(args) != 0 {
@@ -55,18 +88,9 @@ func NewCmdTransfer(f cmdutils.Factory) *cobra.Command {
 				}
 			}
 
-			dontPromptForConfirmation, err := cmd.Flags().GetBool("yes")
-			if err != nil {
-				return err
-			}
-
-			targetNamespace, err := cmd.Flags().GetString("target-namespace")
-			if err != nil {
-				return err
-			}
-
 			c := f.IO().Color()
-			fmt.Printf(heredoc.Doc(`
+			targetPath := targetNamespace + "/" + repo.RepoName()
+			fmt.Fprintf(f.IO().StdErr, heredoc.Doc(`
 				🔴 WARNING: This operation can be irreversible! 🔴
 
 				If you don't have access to the target namespace:
@@ -75,10 +99,10 @@ func NewCmdTransfer(f cmdutils.Factory) *cobra.Command {
 				- You won't be able to transfer the repository back to the original namespace, UNLESS you have administrative access
 				to the target namespace.
 
-				Source repository: %s
-				Target namespace: %s
+				Current path: %s
+				New path:     %s
 
-			`), c.Yellow(repo.FullName()), c.Yellow(targetNamespace))
+			`), c.Yellow(repo.FullName()), c.Yellow(targetPath))
 
 			if !dontPromptForConfirmation {
 				err = confirmTransfer(f.IO())
@@ -103,9 +127,7 @@ func NewCmdTransfer(f cmdutils.Factory) *cobra.Command {
 	}
 
 	repoTransferCmd.Flags().BoolP("yes", "y", false, "Warning: Skip confirmation prompt and force transfer operation. Transfer cannot be undone.")
-	repoTransferCmd.Flags().StringP("target-namespace", "t", "", "The namespace where your project should be transferred to.")
-
-	_ = repoTransferCmd.MarkFlagRequired("target-namespace")
+	repoTransferCmd.Flags().StringP("target-namespace", "t", "", "The namespace where your project should be transferred to. Can also be given as the second argument.")
 
 	return repoTransferCmd
 }
@@ -0,0 +1,91 @@
+//go:build !integration
+
+package transfer
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestProjectTransfer(t *testing.T) {
+	tests := []struct {
+		name          string
+		cli           string
+		wantNamespace string
+		wantOut       string
+		wantStderr    string
+		wantErr       string
+	}{
+		{
+			name:          "Namespace as argument",
+			cli:           "OWNER/REPO new-group --yes",
+			wantNamespace: "new-group",
+			wantOut:       "✓ Successfully transferred repository OWNER/REPO to new-group/REPO.\n",
+			wantStderr:    "Current path: OWNER/REPO\nNew path:     new-group/REPO\n",
+		},
+		{
+			name:          "Namespace as flag for the current repository",
+			cli:           "--target-namespace new-group/sub -y",
+			wantNamespace: "new-group/sub",
+			wantOut:       "✓ Successfully transferred repository OWNER/REPO to new-group/sub/REPO.\n",
+			wantStderr:    "Current path: OWNER/REPO\nNew path:     new-group/sub/REPO\n",
+		},
+		{
+			name:    "Conflicting namespaces",
+			cli:     "OWNER/REPO new-group --target-namespace other --yes",
+			wantErr: "specify the namespace either as an argument or with --target-namespace, not both.",
+		},
+		{
+			name:    "Missing namespace",
+			cli:     "OWNER/REPO --yes",
+			wantErr: "specify the namespace to transfer the repository to.",
+		},
+		{
+			name:    "Confirmation required when not interactive",
+			cli:     "OWNER/REPO new-group",
+			wantErr: "--yes is required when not running interactively.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tc := gitlabtesting.NewTestClient(t)
+			if tt.wantNamespace != "" {
+				tc.MockProjects.EXPECT().TransferProject("OWNER/REPO", &gitlab.TransferProjectOptions{Namespace: tt.wantNamespace}, gomock.Any()).
+					Return(&gitlab.Project{PathWithNamespace: tt.wantNamespace + "/REPO"}, nil, nil)
+			}
+
+			apiClient, err := api.NewClient(
+				func(*http.Client) (gitlab.AuthSource, error) {
+					return gitlab.AccessTokenAuthSource{Token: "test-token"}, nil
+				},
+				api.WithGitLabClient(tc.Client),
+			)
+			require.NoError(t, err)
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdTransfer, false,
+				cmdtest.WithGitLabClient(tc.Client),
+				cmdtest.WithApiClient(apiClient),
+			)
+
+			out, err := exec(tt.cli)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOut, out.String())
+			assert.Contains(t, out.Stderr(), tt.wantStderr)
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package tree
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+)
+
+// sizeRequestLimit is the number of file sizes requested at once.
+const sizeRequestLimit = 8
+
+// Entry is a file or directory of the tree, in the JSON output.
+type Entry struct {
+	*gitlab.TreeNode
+	// Size is the size of files in bytes, with --long.
+	Size *int64 `json:"size,omitempty"`
+}
+
+type options struct {
+	path         string
+	ref          string
+	recursive    bool
+	long         bool
+	outputFormat string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdTree(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	treeCmd := &cobra.Command{
+		Use:   "tree [<path>] [flags]",
+		Short: `List the files and directories of the repository.`,
+		Long: heredoc.Doc(`
+			List the files and directories of the repository at a path, without a local clone.
+
+			Directories are listed first. With --long, the size of files is shown too,
+			which takes one request per file.
+		`),
+		Args: cobra.MaximumNArgs(1),
+		Example: heredoc.Doc(`
+			$ glab repo tree
+			$ glab repo tree docs --ref v1.2.0 --long
+			$ glab repo tree src --recursive --output json
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 1 {
+				opts.path = args[0]
+			}
+			return opts.run()
+		},
+	}
+
+	cmdutils.EnableRepoOverride(treeCmd, f)
+
+	fl := treeCmd.Flags()
+	fl.StringVarP(&opts.ref, "ref", "r", "", "Branch, tag, or commit to list. Defaults to the default branch.")
+	fl.BoolVar(&opts.recursive, "recursive", false, "List the contents of the subdirectories too.")
+	fl.BoolVarP(&opts.long, "long", "l", false, "Show the size of files.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+
+	return treeCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	listOpts := &gitlab.ListTreeOptions{
+		ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage},
+		Recursive:   gitlab.Ptr(o.recursive),
+	}
+	if o.path != "" {
+		listOpts.Path = gitlab.Ptr(o.path)
+	}
+	if o.ref != "" {
+		listOpts.Ref = gitlab.Ptr(o.ref)
+	}
+	nodes, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.TreeNode, *gitlab.Response, error) {
+		return client.Repositories.ListTree(repo.FullName(), listOpts, p)
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to list the repository tree.")
+	}
+
+	entries := make([]Entry, len(nodes))
+	for i, node := range nodes {
+		entries[i] = Entry{TreeNode: node}
+	}
+	if o.long {
+		if err := o.addSizes(client, repo.FullName(), entries); err != nil {
+			return err
+		}
+	}
+
+	if o.outputFormat == "json" {
+		entriesJSON, _ := json.Marshal(entries)
+		fmt.Fprintln(o.io.StdOut, string(entriesJSON))
+		return nil
+	}
+
+	if len(entries) == 0 {
+		o.io.LogInfof("No files found.\n")
+		return nil
+	}
+
+	c := o.io.Color()
+	table := tableprinter.New(o.io)
+	for _, e := range entries {
+		row := []any{e.Type}
+		if o.long {
+			row = append(row, o.formatSize(e.Size))
+		}
+		path := e.Path
+		if e.Type == "tree" {
+			path = c.Blue(path + "/")
+		}
+		table.AddRow(append(row, path)...)
+	}
+	fmt.Fprint(o.io.StdOut, table.String())
+	return nil
+}
+
+// addSizes sets the size of the files, with a few requests at a time.
+func (o *options) addSizes(client *gitlab.Client, repo string, entries []Entry) error {
+	ref := o.ref
+	if ref == "" {
+		ref = "HEAD"
+	}
+
+	var g errgroup.Group
+	g.SetLimit(sizeRequestLimit)
+	for i := range entries {
+		if entries[i].Type != "blob" {
+			continue
+		}
+		g.Go(func() error {
+			file, _, err := client.RepositoryFiles.GetFileMetaData(repo, entries[i].Path, &gitlab.GetFileMetaDataOptions{Ref: gitlab.Ptr(ref)})
+			if err != nil {
+				return cmdutils.WrapError(err, fmt.Sprintf("failed to get the size of %s.", entries[i].Path))
+			}
+			entries[i].Size = gitlab.Ptr(file.Size)
+			return nil
+		})
+	}
+	return g.Wait()
+}
+
+func (o *options) formatSize(size *int64) string {
+	if size == nil {
+		return "-"
+	}
+	if o.io.IsOutputTTY() {
+		return humanize.IBytes(uint64(*size))
+	}
+	return strconv.FormatInt(*size, 10)
+}
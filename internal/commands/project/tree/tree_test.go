@@ -0,0 +1,82 @@
+//go:build !integration
+
+package tree
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_Tree(t *testing.T) {
+	nodes := []*gitlab.TreeNode{
+		{ID: "a1", Name: "api", Type: "tree", Path: "docs/api", Mode: "040000"},
+		{ID: "b2", Name: "index.md", Type: "blob", Path: "docs/index.md", Mode: "100644"},
+	}
+
+	testCases := []struct {
+		name      string
+		cli       string
+		setupMock func(tc *gitlabtesting.TestClient)
+		wantOut   string
+	}{
+		{
+			name: "List a path",
+			cli:  "docs",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockRepositories.EXPECT().ListTree("OWNER/REPO", &gitlab.ListTreeOptions{
+					ListOptions: gitlab.ListOptions{PerPage: 100},
+					Path:        gitlab.Ptr("docs"),
+					Recursive:   gitlab.Ptr(false),
+				}, gomock.Any()).Return(nodes, &gitlab.Response{}, nil)
+			},
+			wantOut: "tree\tdocs/api/\nblob\tdocs/index.md\n",
+		},
+		{
+			name: "Recursive with sizes at a ref",
+			cli:  "docs --recursive --long --ref v1.2.0",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockRepositories.EXPECT().ListTree("OWNER/REPO", &gitlab.ListTreeOptions{
+					ListOptions: gitlab.ListOptions{PerPage: 100},
+					Path:        gitlab.Ptr("docs"),
+					Ref:         gitlab.Ptr("v1.2.0"),
+					Recursive:   gitlab.Ptr(true),
+				}, gomock.Any()).Return(nodes, &gitlab.Response{}, nil)
+				tc.MockRepositoryFiles.EXPECT().GetFileMetaData("OWNER/REPO", "docs/index.md", &gitlab.GetFileMetaDataOptions{Ref: gitlab.Ptr("v1.2.0")}).
+					Return(&gitlab.File{Size: 2048}, nil, nil)
+			},
+			wantOut: "tree\t-\tdocs/api/\nblob\t2048\tdocs/index.md\n",
+		},
+		{
+			name: "JSON output",
+			cli:  "--long -F json",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockRepositories.EXPECT().ListTree("OWNER/REPO", gomock.Any(), gomock.Any()).Return(nodes, &gitlab.Response{}, nil)
+				tc.MockRepositoryFiles.EXPECT().GetFileMetaData("OWNER/REPO", "docs/index.md", &gitlab.GetFileMetaDataOptions{Ref: gitlab.Ptr("HEAD")}).
+					Return(&gitlab.File{Size: 2048}, nil, nil)
+			},
+			wantOut: `[{"id":"a1","name":"api","type":"tree","path":"docs/api","mode":"040000"},` +
+				`{"id":"b2","name":"index.md","type":"blob","path":"docs/index.md","mode":"100644","size":2048}]` + "\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			tc.setupMock(testClient)
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdTree, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			out, err := exec(tc.cli)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantOut, out.String())
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package usage
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+)
+
+type options struct {
+	group        string
+	outputFormat string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdUsage(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	repoUsageCmd := &cobra.Command{
+		Use:   "usage [flags]",
+		Short: `Show the storage used by a project or group.`,
+		Long: heredoc.Doc(`
+			Show how much storage a project uses for its repository, job artifacts, packages,
+			container registry, and LFS objects, largest first.
+
+			With --group, show the storage of every project in the group and its subgroups,
+			largest first, and the storage used by the dependency proxy of the group.
+		`),
+		Args: cobra.NoArgs,
+		Example: heredoc.Doc(`
+			$ glab repo usage
+			$ glab repo usage -R group/project -F json
+
+			# Find the projects that use the most storage in a group
+			$ glab repo usage --group my-group
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+
+	repoUsageCmd.Flags().StringVarP(&opts.group, "group", "g", "", "Show the storage of the projects in this group and its subgroups.")
+	repoUsageCmd.Flags().VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+
+	return repoUsageCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	if o.group != "" {
+		return o.groupUsage(client)
+	}
+	return o.projectUsage(client)
+}
+
+func (o *options) projectUsage(client *gitlab.Client) error {
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	project, _, err := client.Projects.GetProject(repo.FullName(), &gitlab.GetProjectOptions{Statistics: gitlab.Ptr(true)})
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to get the project statistics.")
+	}
+	if project.Statistics == nil {
+		return fmt.Errorf("the storage of %s is not visible to you. Storage statistics require at least the Reporter role.", repo.FullName())
+	}
+	storage := api.ProjectStorageFromStatistics(project)
+
+	if o.outputFormat == "json" {
+		storageJSON, _ := json.Marshal(storage)
+		fmt.Fprintln(o.io.StdOut, string(storageJSON))
+		return nil
+	}
+
+	type category struct {
+		name string
+		size int64
+	}
+	categories := []category{
+		{"Repository", storage.RepositorySize},
+		{"Job artifacts", storage.JobArtifactsSize},
+		{"Packages", storage.PackagesSize},
+		{"Container registry", storage.ContainerRegistrySize},
+		{"LFS objects", storage.LFSObjectsSize},
+	}
+	slices.SortStableFunc(categories, func(a, b category) int {
+		return cmp.Compare(b.size, a.size)
+	})
+
+	c := o.io.Color()
+	table := tableprinter.New(o.io)
+	table.AddHeader("STORAGE", "SIZE")
+	for _, cat := range categories {
+		table.AddRow(cat.name, humanize.IBytes(uint64(cat.size)))
+	}
+	table.AddRow(c.Bold("Total"), c.Bold(humanize.IBytes(uint64(storage.StorageSize))))
+	fmt.Fprint(o.io.StdOut, table.String())
+
+	return nil
+}
+
+func (o *options) groupUsage(client *gitlab.Client) error {
+	storage, err := api.GetGroupStorage(client, o.group)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to get the group storage.")
+	}
+	slices.SortStableFunc(storage.Projects, func(a, b *api.ProjectStorage) int {
+		return cmp.Compare(b.StorageSize, a.StorageSize)
+	})
+
+	if o.outputFormat == "json" {
+		storageJSON, _ := json.Marshal(storage)
+		fmt.Fprintln(o.io.StdOut, string(storageJSON))
+		return nil
+	}
+
+	if len(storage.Projects) == 0 {
+		o.io.LogInfof("No projects found in %s.\n", o.group)
+		return nil
+	}
+
+	c := o.io.Color()
+	total := &api.ProjectStorage{}
+	table := tableprinter.New(o.io)
+	table.AddHeader("PROJECT", "REPOSITORY", "ARTIFACTS", "PACKAGES", "REGISTRY", "LFS", "TOTAL")
+	for _, p := range storage.Projects {
+		row := []any{p.FullPath}
+		for _, size := range sizes(p) {
+			row = append(row, humanize.IBytes(uint64(size)))
+		}
+		table.AddRow(row...)
+		total.RepositorySize += p.RepositorySize
+		total.JobArtifactsSize += p.JobArtifactsSize
+		total.PackagesSize += p.PackagesSize
+		total.ContainerRegistrySize += p.ContainerRegistrySize
+		total.LFSObjectsSize += p.LFSObjectsSize
+		total.StorageSize += p.StorageSize
+	}
+	totalRow := []any{c.Bold(fmt.Sprintf("Total (%d projects)", len(storage.Projects)))}
+	for _, size := range sizes(total) {
+		totalRow = append(totalRow, c.Bold(humanize.IBytes(uint64(size))))
+	}
+	table.AddRow(totalRow...)
+	fmt.Fprint(o.io.StdOut, table.String())
+
+	fmt.Fprintf(o.io.StdOut, "\nDependency proxy: %s\n", humanize.IBytes(uint64(storage.DependencyProxySize)))
+
+	return nil
+}
+
+// sizes returns the sizes of a project in the order of the group table columns.
+func sizes(p *api.ProjectStorage) []int64 {
+	return []int64{p.RepositorySize, p.JobArtifactsSize, p.PackagesSize, p.ContainerRegistrySize, p.LFSObjectsSize, p.StorageSize}
+}
@@ -0,0 +1,125 @@
+//go:build !integration
+
+package usage
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+const (
+	kib = int64(1024)
+	mib = 1024 * kib
+	gib = 1024 * mib
+)
+
+func Test_ProjectUsage(t *testing.T) {
+	project := &gitlab.Project{
+		PathWithNamespace: "OWNER/REPO",
+		Statistics: &gitlab.Statistics{
+			StorageSize:           3*gib + 300*mib,
+			RepositorySize:        200 * mib,
+			JobArtifactsSize:      1 * gib,
+			PackagesSize:          100 * mib,
+			ContainerRegistrySize: 2 * gib,
+			LFSObjectsSize:        0,
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		cli         string
+		project     *gitlab.Project
+		expectedMsg []string
+		wantErr     string
+	}{
+		{
+			name:        "Storage breakdown largest first",
+			project:     project,
+			expectedMsg: []string{"STORAGE\tSIZE\nContainer registry\t2.0 GiB\nJob artifacts\t1.0 GiB\nRepository\t200 MiB\nPackages\t100 MiB\nLFS objects\t0 B\nTotal\t3.3 GiB\n"},
+		},
+		{
+			name:        "JSON output",
+			cli:         "-F json",
+			project:     project,
+			expectedMsg: []string{`"full_path":"OWNER/REPO"`, `"container_registry_size":2147483648`},
+		},
+		{
+			name:    "Statistics not visible",
+			project: &gitlab.Project{PathWithNamespace: "OWNER/REPO"},
+			wantErr: "the storage of OWNER/REPO is not visible to you.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			testClient.MockProjects.EXPECT().
+				GetProject("OWNER/REPO", &gitlab.GetProjectOptions{Statistics: gitlab.Ptr(true)}).
+				Return(tc.project, nil, nil)
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdUsage, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			out, err := exec(tc.cli)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			for _, msg := range tc.expectedMsg {
+				assert.Contains(t, out.String(), msg)
+			}
+		})
+	}
+}
+
+func Test_GroupUsage(t *testing.T) {
+	pages := []string{
+		`{"data": {"group": {
+			"dependencyProxyTotalSizeBytes": "52428800",
+			"projects": {
+				"pageInfo": {"hasNextPage": true, "endCursor": "abc"},
+				"nodes": [{"fullPath": "my-group/small", "statistics": {"storageSize": 1048576.0, "repositorySize": 1048576.0}}]
+			}
+		}}}`,
+		`{"data": {"group": {
+			"dependencyProxyTotalSizeBytes": "52428800",
+			"projects": {
+				"pageInfo": {"hasNextPage": false, "endCursor": "def"},
+				"nodes": [{"fullPath": "my-group/sub/big", "statistics": {"storageSize": 3221225472.0, "repositorySize": 1073741824.0, "containerRegistrySize": 2147483648.0}}]
+			}
+		}}}`,
+	}
+
+	testClient := gitlabtesting.NewTestClient(t)
+	var cursors []any
+	testClient.MockGraphQL.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		Times(2).
+		DoAndReturn(func(query gitlab.GraphQLQuery, response any, _ ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+			assert.Equal(t, "my-group", query.Variables["fullPath"])
+			cursors = append(cursors, query.Variables["after"])
+			return nil, json.Unmarshal([]byte(pages[len(cursors)-1]), response)
+		})
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdUsage, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	out, err := exec("--group my-group")
+	require.NoError(t, err)
+
+	assert.Equal(t, []any{(*string)(nil), gitlab.Ptr("abc")}, cursors)
+	assert.Equal(t, "PROJECT\tREPOSITORY\tARTIFACTS\tPACKAGES\tREGISTRY\tLFS\tTOTAL\n"+
+		"my-group/sub/big\t1.0 GiB\t0 B\t0 B\t2.0 GiB\t0 B\t3.0 GiB\n"+
+		"my-group/small\t1.0 MiB\t0 B\t0 B\t0 B\t0 B\t1.0 MiB\n"+
+		"Total (2 projects)\t1.0 GiB\t0 B\t0 B\t2.0 GiB\t0 B\t3.0 GiB\n"+
+		"\nDependency proxy: 50 MiB\n", out.String())
+}
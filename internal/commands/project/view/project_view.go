@@ -1,11 +1,12 @@
 package view
 
 import (
-	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/spf13/cobra"
@@ -27,6 +28,7 @@ type options struct {
 	web          bool
 	outputFormat string
 	branch       string
+	quiet        bool
 	browser      string
 	glamourStyle string
 
@@ -37,7 +39,7 @@ type options struct {
 	gitlabClient    func() (*gitlab.Client, error)
 	baseRepo        func() (glrepo.Interface, error)
 	branchFactory   func() (string, error)
-	defaultHostname string
+	defaultHostname func() string
 }
 
 func NewCmdView(f cmdutils.Factory) *cobra.Command {
@@ -48,13 +50,17 @@ func NewCmdView(f cmdutils.Factory) *cobra.Command {
 		branchFactory:   f.Branch,
 		apiClient:       f.ApiClient,
 		gitlabClient:    f.GitLabClient,
-		defaultHostname: f.DefaultHostname(),
+		defaultHostname: f.DefaultHostname,
 	}
 
 	projectViewCmd := &cobra.Command{
 		Use:   "view [repository] [flags]",
 		Short: "View a project or repository.",
 		Long: heredoc.Doc(`Display the description and README of a project, or open it in the browser.
+
+		The description is followed by the license, the status of the latest pipeline on the
+		default branch, and the number of open merge requests and issues. Use --quiet to
+		only show the name, description, and README.
 		`),
 		Args: cobra.MaximumNArgs(1),
 		Example: heredoc.Doc(`
@@ -87,6 +93,7 @@ func NewCmdView(f cmdutils.Factory) *cobra.Command {
 	projectViewCmd.Flags().BoolVarP(&opts.web, "web", "w", false, "Open a project in the browser.")
 	projectViewCmd.Flags().StringVarP(&opts.outputFormat, "output", "F", "text", "Format output as: text, json.")
 	projectViewCmd.Flags().StringVarP(&opts.branch, "branch", "b", "", "View a specific branch of the repository.")
+	projectViewCmd.Flags().BoolVarP(&opts.quiet, "quiet", "q", false, "Don't show the license, pipeline status, and open counts.")
 
 	return projectViewCmd
 }
@@ -132,7 +139,7 @@ func (o *options) complete(args []string) error {
 		}
 
 		// Get the repo full name from the ProjectID which can be a full URL or a group/repo format
-		repo, err := glrepo.FromFullName(o.projectID, o.defaultHostname)
+		repo, err := glrepo.FromFullName(o.projectID, o.defaultHostname())
 		if err != nil {
 			return err
 		}
@@ -152,6 +159,25 @@ func (o *options) complete(args []string) error {
 }
 
 func (o *options) run() error {
+	// The README is only shown in text output. Fetch the usual README.md while the
+	// project is fetched, rather than after, and check it's the right file later.
+	var guessedReadme chan readmeResult
+	if !o.web && o.outputFormat != "json" {
+		guessedReadme = make(chan readmeResult, 1)
+		go func() {
+			ref := o.branch
+			if ref == "" {
+				ref = "HEAD"
+			}
+			file, err := fetchReadmeFile(o.client, o.repo.FullName(), defaultReadmeFileName, ref)
+			guessedReadme <- readmeResult{fileName: defaultReadmeFileName, ref: ref, file: file, err: err}
+		}()
+	}
+	var status func() *projectStatus
+	if !o.web && o.outputFormat != "json" && !o.quiet {
+		status = fetchProjectStatus(o.client, o.repo.FullName())
+	}
+
 	project, err := o.repo.Project(o.client)
 	if err != nil {
 		return cmdutils.WrapError(err, "Failed to retrieve project information.")
@@ -175,9 +201,11 @@ func (o *options) run() error {
 	} else if o.outputFormat == "json" {
 		printProjectContentJSON(o, project)
 	} else {
-		readmeFile, err := getReadmeFile(o, project)
-		if err != nil {
-			return err
+		// The project is still worth showing when the README can't be fetched.
+		readmeFile, readmeErr := getReadmeFile(o, project, <-guessedReadme)
+		var projectStatus *projectStatus
+		if status != nil {
+			projectStatus = status()
 		}
 
 		if o.io.IsaTTY {
@@ -186,16 +214,66 @@ func (o *options) run() error {
 			}
 			defer o.io.StopPager()
 
-			printProjectContentTTY(o, project, readmeFile)
+			printProjectContentTTY(o, project, projectStatus, readmeFile, readmeErr)
 		} else {
-			printProjectContentRaw(o, project, readmeFile)
+			printProjectContentRaw(o, project, projectStatus, readmeFile, readmeErr)
 		}
 	}
 
 	return nil
 }
 
-func getReadmeFile(opts *options, project *gitlab.Project) (*gitlab.File, error) {
+const defaultReadmeFileName = "README.md"
+
+// projectStatus is what's shown about a project besides its description and README.
+// Counts that couldn't be fetched are nil.
+type projectStatus struct {
+	// pipeline is the latest pipeline of the default branch.
+	pipeline          *gitlab.Pipeline
+	openMergeRequests *int64
+}
+
+// fetchProjectStatus starts fetching the status of a project, and returns a function
+// that waits for it.
+func fetchProjectStatus(client *gitlab.Client, projectPath string) func() *projectStatus {
+	status := &projectStatus{}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		// Without a ref, this is the latest pipeline of the default branch.
+		status.pipeline, _, _ = client.Pipelines.GetLatestPipeline(projectPath, &gitlab.GetLatestPipelineOptions{})
+	}()
+	go func() {
+		defer wg.Done()
+		mergeRequests, resp, err := client.MergeRequests.ListProjectMergeRequests(projectPath, &gitlab.ListProjectMergeRequestsOptions{
+			ListOptions: gitlab.ListOptions{PerPage: 1},
+			State:       gitlab.Ptr("opened"),
+		})
+		// GitLab doesn't count more than 10,000 items.
+		if err != nil || resp == nil || (resp.TotalItems == 0 && len(mergeRequests) > 0) {
+			return
+		}
+		status.openMergeRequests = &resp.TotalItems
+	}()
+
+	return func() *projectStatus {
+		wg.Wait()
+		return status
+	}
+}
+
+// readmeResult is a README file fetched before the project was known.
+type readmeResult struct {
+	fileName string
+	// ref is the ref the file was fetched from, or HEAD for the default branch.
+	ref  string
+	file *gitlab.File
+	err  error
+}
+
+func getReadmeFile(opts *options, project *gitlab.Project, guessed readmeResult) (*gitlab.File, error) {
 	if project.ReadmeURL == "" {
 		return nil, nil
 	}
@@ -209,18 +287,23 @@ func getReadmeFile(opts *options, project *gitlab.Project) (*gitlab.File, error)
 		opts.branch = readmeRef
 	}
 
-	readmeFile, _, err := opts.client.RepositoryFiles.GetFile(project.PathWithNamespace, readmeFileName, &gitlab.GetFileOptions{Ref: gitlab.Ptr(opts.branch)})
-	if err != nil {
-		return nil, cmdutils.WrapError(err, fmt.Sprintf("Failed to retrieve README file on the %s branch.", opts.branch))
+	guessedRef := guessed.ref
+	if guessedRef == "HEAD" {
+		guessedRef = project.DefaultBranch
 	}
+	if guessed.err == nil && guessed.fileName == readmeFileName && guessedRef == opts.branch {
+		return guessed.file, nil
+	}
+
+	return fetchReadmeFile(opts.client, project.PathWithNamespace, readmeFileName, opts.branch)
+}
 
-	decoded, err := base64.StdEncoding.DecodeString(readmeFile.Content)
+func fetchReadmeFile(client *gitlab.Client, projectPath, fileName, ref string) (*gitlab.File, error) {
+	readmeFile, err := api.GetFile(client, projectPath, fileName, ref)
 	if err != nil {
-		return nil, cmdutils.WrapError(err, "Failed to decode README file.")
+		return nil, fmt.Errorf("failed to retrieve the README file on the %s branch: %w", ref, err)
 	}
 
-	readmeFile.Content = string(decoded)
-
 	return readmeFile, nil
 }
 
@@ -232,7 +315,7 @@ func generateProjectOpenURL(projectWebURL string, defaultBranch string, branch s
 	return projectWebURL
 }
 
-func printProjectContentTTY(opts *options, project *gitlab.Project, readme *gitlab.File) {
+func printProjectContentTTY(opts *options, project *gitlab.Project, status *projectStatus, readme *gitlab.File, readmeErr error) {
 	var description string
 	var readmeContent string
 	var err error
@@ -259,8 +342,21 @@ func printProjectContentTTY(opts *options, project *gitlab.Project, readme *gitl
 	fmt.Fprint(opts.io.StdOut, c.Bold(fullName))
 	fmt.Fprint(opts.io.StdOut, c.Gray(description))
 
+	// Status
+	if status != nil {
+		pipeline := c.Gray("none")
+		if status.pipeline != nil {
+			pipeline = fmt.Sprintf("%s on %s", pipelineStatusColor(c, status.pipeline.Status), status.pipeline.Ref)
+		}
+		fmt.Fprintf(opts.io.StdOut, "%s %s\n", c.Bold("License:"), license(project))
+		fmt.Fprintf(opts.io.StdOut, "%s %s\n", c.Bold("Pipeline:"), pipeline)
+		fmt.Fprintf(opts.io.StdOut, "%s %s merge requests, %d issues\n\n", c.Bold("Open:"), count(status.openMergeRequests), project.OpenIssuesCount)
+	}
+
 	// Readme
-	if readme != nil {
+	if readmeErr != nil {
+		fmt.Fprintln(opts.io.StdOut, c.Gray(fmt.Sprintf("(%s)", readmeErr)))
+	} else if readme != nil {
 		fmt.Fprint(opts.io.StdOut, readmeContent)
 	} else {
 		fmt.Fprintln(opts.io.StdOut, c.Gray("(This repository does not have a README file.)"))
@@ -270,13 +366,28 @@ func printProjectContentTTY(opts *options, project *gitlab.Project, readme *gitl
 	fmt.Fprintf(opts.io.StdOut, c.Gray("View this project on GitLab: %s\n"), project.WebURL)
 }
 
-func printProjectContentRaw(opts *options, project *gitlab.Project, readme *gitlab.File) {
+func printProjectContentRaw(opts *options, project *gitlab.Project, status *projectStatus, readme *gitlab.File, readmeErr error) {
 	fullName := project.NameWithNamespace
 	description := project.Description
 
 	fmt.Fprintf(opts.io.StdOut, "name:\t%s\n", fullName)
 	fmt.Fprintf(opts.io.StdOut, "description:\t%s\n", description)
 
+	if status != nil {
+		pipeline := "none"
+		if status.pipeline != nil {
+			pipeline = status.pipeline.Status
+		}
+		fmt.Fprintf(opts.io.StdOut, "license:\t%s\n", license(project))
+		fmt.Fprintf(opts.io.StdOut, "pipeline:\t%s\n", pipeline)
+		fmt.Fprintf(opts.io.StdOut, "open merge requests:\t%s\n", count(status.openMergeRequests))
+		fmt.Fprintf(opts.io.StdOut, "open issues:\t%d\n", project.OpenIssuesCount)
+	}
+
+	if readmeErr != nil {
+		opts.io.LogErrorf("%s %s\n", opts.io.Color().WarnIcon(), readmeErr)
+	}
+
 	if readme != nil {
 		fmt.Fprintln(opts.io.StdOut, "---")
 		fmt.Fprint(opts.io.StdOut, readme.Content)
@@ -284,6 +395,33 @@ func printProjectContentRaw(opts *options, project *gitlab.Project, readme *gitl
 	}
 }
 
+func license(project *gitlab.Project) string {
+	if project.License == nil || project.License.Name == "" {
+		return "none"
+	}
+	return project.License.Name
+}
+
+func count(n *int64) string {
+	if n == nil {
+		return "unknown"
+	}
+	return strconv.FormatInt(*n, 10)
+}
+
+func pipelineStatusColor(c *iostreams.ColorPalette, status string) string {
+	switch status {
+	case "success":
+		return c.Green(status)
+	case "failed":
+		return c.Red(status)
+	case "running", "pending":
+		return c.Blue(status)
+	default:
+		return c.Gray(status)
+	}
+}
+
 func printProjectContentJSON(opts *options, project *gitlab.Project) {
 	projectJSON, _ := json.Marshal(project)
 	fmt.Fprintln(opts.io.StdOut, string(projectJSON))
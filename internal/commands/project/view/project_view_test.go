@@ -3,12 +3,14 @@
 package view
 
 import (
+	"errors"
 	"net/http"
 	"os/exec"
 	"testing"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	gitlab "gitlab.com/gitlab-org/api/client-go"
@@ -64,6 +66,10 @@ func TestProjectView(t *testing.T) {
 			},
 			expectedOutput: heredoc.Doc(`name:	Test User / REPO
 description:	this is a test description
+license:	none
+pipeline:	success
+open merge requests:	3
+open issues:	0
 ---
 test readme
 
@@ -102,6 +108,10 @@ test readme
 			},
 			expectedOutput: heredoc.Doc(`name:	test_user / foo
 description:	this is a test description
+license:	none
+pipeline:	success
+open merge requests:	3
+open issues:	0
 ---
 test readme
 
@@ -137,6 +147,10 @@ test readme
 			},
 			expectedOutput: heredoc.Doc(`name:	foo / bar
 description:	this is a test description
+license:	none
+pipeline:	success
+open merge requests:	3
+open issues:	0
 ---
 test readme
 
@@ -172,6 +186,10 @@ test readme
 			},
 			expectedOutput: heredoc.Doc(`name:	group / foo / bar
 description:	this is a test description
+license:	none
+pipeline:	success
+open merge requests:	3
+open issues:	0
 ---
 test readme
 
@@ -208,6 +226,10 @@ test readme
 			},
 			expectedOutput: heredoc.Doc(`name:	OWNER / REPO
 description:	this is a test description
+license:	none
+pipeline:	success
+open merge requests:	3
+open issues:	0
 ---
 test readme
 
@@ -243,6 +265,10 @@ test readme
 			},
 			expectedOutput: heredoc.Doc(`name:	OWNER / REPO
 description:	this is a test description
+license:	none
+pipeline:	success
+open merge requests:	3
+open issues:	0
 ---
 test readme
 
@@ -441,6 +467,14 @@ test readme
 
 			// Setup mocks
 			tc.setupMocks(t, testClient)
+			testClient.MockPipelines.EXPECT().
+				GetLatestPipeline(gomock.Any(), gomock.Any()).
+				Return(&gitlab.Pipeline{ID: 42, Status: "success", Ref: "main"}, nil, nil).
+				AnyTimes()
+			testClient.MockMergeRequests.EXPECT().
+				ListProjectMergeRequests(gomock.Any(), gomock.Any()).
+				Return([]*gitlab.BasicMergeRequest{{IID: 1}}, &gitlab.Response{TotalItems: 3}, nil).
+				AnyTimes()
 
 			// Create api.Client that wraps the mock gitlab.Client
 			apiClient, err := api.NewClient(
@@ -482,3 +516,141 @@ test readme
 		})
 	}
 }
+
+func TestProjectViewReadme(t *testing.T) {
+	project := &gitlab.Project{
+		ID:                37777023,
+		Description:       "this is a test description",
+		NameWithNamespace: "OWNER / REPO",
+		PathWithNamespace: "OWNER/REPO",
+		DefaultBranch:     "main",
+		WebURL:            "https://gitlab.com/OWNER/REPO",
+	}
+	notFound := errors.New("404 File Not Found")
+
+	tests := []struct {
+		name       string
+		cli        string
+		readmeURL  string
+		setupMocks func(testClient *gitlabtesting.TestClient)
+
+		expectedOutput string
+		expectedStderr string
+	}{
+		{
+			name:      "README with another name is fetched after the project",
+			cli:       "OWNER/REPO --quiet",
+			readmeURL: "https://gitlab.com/OWNER/REPO/-/blob/main/README.rst",
+			setupMocks: func(testClient *gitlabtesting.TestClient) {
+				testClient.MockRepositoryFiles.EXPECT().
+					GetFile("OWNER/REPO", "README.md", &gitlab.GetFileOptions{Ref: gitlab.Ptr("HEAD")}).
+					Return(nil, nil, notFound)
+				testClient.MockRepositoryFiles.EXPECT().
+					GetFile("OWNER/REPO", "README.rst", &gitlab.GetFileOptions{Ref: gitlab.Ptr("main")}).
+					Return(&gitlab.File{Content: "dGVzdCByZWFkbWUK"}, nil, nil)
+			},
+			expectedOutput: "name:\tOWNER / REPO\ndescription:\tthis is a test description\n---\ntest readme\n\n",
+		},
+		{
+			name:      "project is shown when the README can't be fetched",
+			cli:       "OWNER/REPO --branch feature --quiet",
+			readmeURL: "https://gitlab.com/OWNER/REPO/-/blob/main/README.md",
+			setupMocks: func(testClient *gitlabtesting.TestClient) {
+				testClient.MockRepositoryFiles.EXPECT().
+					GetFile("OWNER/REPO", "README.md", &gitlab.GetFileOptions{Ref: gitlab.Ptr("feature")}).
+					Return(nil, nil, notFound)
+				testClient.MockRepositoryFiles.EXPECT().
+					GetFile("OWNER/REPO", "README.md", &gitlab.GetFileOptions{Ref: gitlab.Ptr("feature")}).
+					Return(nil, nil, notFound)
+			},
+			expectedOutput: "name:\tOWNER / REPO\ndescription:\tthis is a test description\n",
+			expectedStderr: "failed to retrieve the README file on the feature branch: 404 File Not Found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			p := *project
+			p.ReadmeURL = tc.readmeURL
+			testClient.MockProjects.EXPECT().GetProject("OWNER/REPO", gomock.Any()).Return(&p, nil, nil)
+			tc.setupMocks(testClient)
+
+			apiClient, err := api.NewClient(
+				func(*http.Client) (gitlab.AuthSource, error) {
+					return gitlab.AccessTokenAuthSource{Token: ""}, nil
+				},
+				api.WithGitLabClient(testClient.Client),
+			)
+			assert.NoError(t, err)
+
+			cmdExec := cmdtest.SetupCmdForTest(t, NewCmdView, false,
+				cmdtest.WithGitLabClient(testClient.Client),
+				cmdtest.WithApiClient(apiClient),
+			)
+
+			output, err := cmdExec(tc.cli)
+
+			if assert.NoError(t, err) {
+				assert.Equal(t, tc.expectedOutput, output.String())
+				if tc.expectedStderr == "" {
+					assert.Empty(t, output.Stderr())
+				} else {
+					assert.Contains(t, output.Stderr(), tc.expectedStderr)
+				}
+			}
+		})
+	}
+}
+
+func TestProjectViewStatus(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockProjects.EXPECT().
+		GetProject("OWNER/REPO", gomock.Any()).
+		Return(&gitlab.Project{
+			NameWithNamespace: "OWNER / REPO",
+			PathWithNamespace: "OWNER/REPO",
+			Description:       "this is a test description",
+			DefaultBranch:     "main",
+			WebURL:            "https://gitlab.com/OWNER/REPO",
+			OpenIssuesCount:   7,
+			License:           &gitlab.ProjectLicense{Name: "MIT License"},
+		}, nil, nil)
+	testClient.MockRepositoryFiles.EXPECT().
+		GetFile("OWNER/REPO", "README.md", gomock.Any()).
+		Return(nil, nil, errors.New("404 File Not Found"))
+	testClient.MockPipelines.EXPECT().
+		GetLatestPipeline("OWNER/REPO", &gitlab.GetLatestPipelineOptions{}).
+		Return(nil, nil, errors.New("404 Not Found"))
+	testClient.MockMergeRequests.EXPECT().
+		ListProjectMergeRequests("OWNER/REPO", &gitlab.ListProjectMergeRequestsOptions{
+			ListOptions: gitlab.ListOptions{PerPage: 1},
+			State:       gitlab.Ptr("opened"),
+		}).
+		Return([]*gitlab.BasicMergeRequest{{IID: 1}}, &gitlab.Response{TotalItems: 0}, nil)
+
+	apiClient, err := api.NewClient(
+		func(*http.Client) (gitlab.AuthSource, error) {
+			return gitlab.AccessTokenAuthSource{Token: ""}, nil
+		},
+		api.WithGitLabClient(testClient.Client),
+	)
+	require.NoError(t, err)
+
+	cmdExec := cmdtest.SetupCmdForTest(t, NewCmdView, false,
+		cmdtest.WithGitLabClient(testClient.Client),
+		cmdtest.WithApiClient(apiClient),
+	)
+
+	output, err := cmdExec("OWNER/REPO")
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		name:	OWNER / REPO
+		description:	this is a test description
+		license:	MIT License
+		pipeline:	none
+		open merge requests:	unknown
+		open issues:	7
+	`), output.String())
+}
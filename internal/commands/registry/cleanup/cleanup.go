@@ -0,0 +1,179 @@
+package cleanup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/registry/registryutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	repository  string
+	removeRegex string
+	keepRegex   string
+	keepN       int
+	olderThan   string
+	yes         bool
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdCleanup(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	registryCleanupCmd := &cobra.Command{
+		Use:   "cleanup [<repository>] [flags]",
+		Short: `Delete the tags of container repositories that match cleanup rules.`,
+		Long: heredoc.Doc(`
+			Delete the tags of a container repository that match cleanup rules, like a
+			cleanup policy that runs now. Without a repository, cleans up all container
+			repositories of the project.
+
+			Tags are deleted when their name matches --remove-regex, unless:
+
+			- Their name matches --keep-regex.
+			- They are among the --keep-n most recent tags.
+			- They are more recent than --older-than.
+
+			The latest tag is never deleted. GitLab deletes the tags in the background,
+			and allows one cleanup of a repository per hour.
+		`),
+		Args: cobra.MaximumNArgs(1),
+		Example: heredoc.Doc(`
+			# Delete all tags of the app repository, except the 5 most recent
+			$ glab registry cleanup app --remove-regex '.*' --keep-n 5
+
+			# Delete the merge request tags older than two weeks of all repositories
+			$ glab registry cleanup --remove-regex '^mr-.*' --older-than 14d --yes
+
+			# Delete all tags except release tags
+			$ glab registry cleanup app --remove-regex '.*' --keep-regex '^v\d+\.\d+\.\d+$'
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.repository = args[0]
+			}
+
+			if err := opts.validate(cmd); err != nil {
+				return err
+			}
+
+			return opts.run(cmd.Context())
+		},
+	}
+
+	fl := registryCleanupCmd.Flags()
+	fl.StringVar(&opts.removeRegex, "remove-regex", "", "Delete the tags with a name that matches this regular expression.")
+	fl.StringVar(&opts.keepRegex, "keep-regex", "", "Keep the tags with a name that matches this regular expression.")
+	fl.IntVar(&opts.keepN, "keep-n", 0, "Keep this number of the most recent tags that match --remove-regex.")
+	fl.StringVar(&opts.olderThan, "older-than", "", "Only delete the tags older than this, such as 1h, 7d, or 1month.")
+	fl.BoolVarP(&opts.yes, "yes", "y", false, "Skip the confirmation prompt.")
+	_ = registryCleanupCmd.MarkFlagRequired("remove-regex")
+
+	return registryCleanupCmd
+}
+
+func (o *options) validate(cmd *cobra.Command) error {
+	// GitLab evaluates the expressions with RE2, like Go.
+	if _, err := regexp.Compile(o.removeRegex); err != nil {
+		return &cmdutils.FlagError{Err: fmt.Errorf("invalid --remove-regex: %w", err)}
+	}
+	if _, err := regexp.Compile(o.keepRegex); err != nil {
+		return &cmdutils.FlagError{Err: fmt.Errorf("invalid --keep-regex: %w", err)}
+	}
+	if cmd.Flags().Changed("keep-n") && o.keepN < 1 {
+		return &cmdutils.FlagError{Err: errors.New("--keep-n must be at least 1.")}
+	}
+	if !o.yes && !o.io.PromptEnabled() {
+		return &cmdutils.FlagError{Err: errors.New("--yes or -y flag is required when not running interactively.")}
+	}
+	return nil
+}
+
+func (o *options) run(ctx context.Context) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	var repositories []*gitlab.RegistryRepository
+	if o.repository != "" {
+		repository, err := registryutils.FindRepository(client, repo.FullName(), o.repository)
+		if err != nil {
+			return err
+		}
+		repositories = []*gitlab.RegistryRepository{repository}
+	} else {
+		repositories, err = registryutils.ListRepositories(client, repo.FullName())
+		if err != nil {
+			return err
+		}
+		if len(repositories) == 0 {
+			o.io.LogInfof("No container repositories found for %s.\n", repo.FullName())
+			return nil
+		}
+	}
+
+	if !o.yes {
+		var paths []string
+		for _, r := range repositories {
+			paths = append(paths, r.Path)
+		}
+		err = o.io.Confirm(ctx, &o.yes, fmt.Sprintf("Delete the tags that match %q from %s?", o.removeRegex, strings.Join(paths, ", ")))
+		if err != nil {
+			return cmdutils.WrapError(err, "could not prompt")
+		}
+		if !o.yes {
+			return cmdutils.CancelError()
+		}
+	}
+
+	deleteOpts := &gitlab.DeleteRegistryRepositoryTagsOptions{
+		NameRegexpDelete: gitlab.Ptr(o.removeRegex),
+	}
+	if o.keepRegex != "" {
+		deleteOpts.NameRegexpKeep = gitlab.Ptr(o.keepRegex)
+	}
+	if o.keepN > 0 {
+		deleteOpts.KeepN = gitlab.Ptr(int64(o.keepN))
+	}
+	if o.olderThan != "" {
+		deleteOpts.OlderThan = gitlab.Ptr(o.olderThan)
+	}
+
+	c := o.io.Color()
+	for _, r := range repositories {
+		if _, err := client.ContainerRegistry.DeleteRegistryRepositoryTags(repo.FullName(), r.ID, deleteOpts); err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to clean up %s.", r.Path))
+		}
+		fmt.Fprintf(o.io.StdOut, "%s Started the cleanup of %s.\n", c.GreenCheck(), r.Path)
+	}
+	o.io.LogInfof("GitLab deletes the tags in the background.\n")
+
+	return nil
+}
@@ -0,0 +1,96 @@
+//go:build !integration
+
+package cleanup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_RegistryCleanup(t *testing.T) {
+	root := &gitlab.RegistryRepository{ID: 1, Name: "", Path: "owner/repo"}
+	app := &gitlab.RegistryRepository{ID: 2, Name: "app", Path: "owner/repo/app"}
+
+	testCases := []struct {
+		name     string
+		cli      string
+		wantOpts *gitlab.DeleteRegistryRepositoryTagsOptions
+		wantIDs  []int64
+		wantOut  string
+		wantErr  string
+	}{
+		{
+			name: "Clean up a repository",
+			cli:  `app --remove-regex '.*' --keep-regex '^v\d+$' --keep-n 5 --older-than 7d --yes`,
+			wantOpts: &gitlab.DeleteRegistryRepositoryTagsOptions{
+				NameRegexpDelete: gitlab.Ptr(".*"),
+				NameRegexpKeep:   gitlab.Ptr(`^v\d+$`),
+				KeepN:            gitlab.Ptr(int64(5)),
+				OlderThan:        gitlab.Ptr("7d"),
+			},
+			wantIDs: []int64{2},
+			wantOut: "✓ Started the cleanup of owner/repo/app.\nGitLab deletes the tags in the background.\n",
+		},
+		{
+			name: "Clean up all repositories",
+			cli:  `--remove-regex '^mr-' -y`,
+			wantOpts: &gitlab.DeleteRegistryRepositoryTagsOptions{
+				NameRegexpDelete: gitlab.Ptr("^mr-"),
+			},
+			wantIDs: []int64{1, 2},
+			wantOut: "✓ Started the cleanup of owner/repo.\n✓ Started the cleanup of owner/repo/app.\nGitLab deletes the tags in the background.\n",
+		},
+		{
+			name:    "Requires --remove-regex",
+			cli:     "app --yes",
+			wantErr: `required flag(s) "remove-regex" not set`,
+		},
+		{
+			name:    "Invalid regex",
+			cli:     "app --remove-regex '(' --yes",
+			wantErr: "invalid --remove-regex: error parsing regexp: missing closing ): `(`",
+		},
+		{
+			name:    "Invalid --keep-n",
+			cli:     "app --remove-regex '.*' --keep-n 0 --yes",
+			wantErr: "--keep-n must be at least 1.",
+		},
+		{
+			name:    "Requires --yes",
+			cli:     "app --remove-regex '.*'",
+			wantErr: "--yes or -y flag is required when not running interactively.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			if tc.wantOpts != nil {
+				testClient.MockContainerRegistry.EXPECT().
+					ListProjectRegistryRepositories("OWNER/REPO", gomock.Any(), gomock.Any()).
+					Return([]*gitlab.RegistryRepository{root, app}, &gitlab.Response{}, nil)
+				for _, id := range tc.wantIDs {
+					testClient.MockContainerRegistry.EXPECT().DeleteRegistryRepositoryTags("OWNER/REPO", id, tc.wantOpts).Return(nil, nil)
+				}
+			}
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdCleanup, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			out, err := exec(tc.cli)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantOut, out.String())
+		})
+	}
+}
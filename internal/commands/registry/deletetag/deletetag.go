@@ -0,0 +1,104 @@
+package deletetag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/registry/registryutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	repository string
+	tags       []string
+	yes        bool
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdDeleteTag(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	registryDeleteTagCmd := &cobra.Command{
+		Use:   "delete-tag <repository> <tag>... [flags]",
+		Short: `Delete tags from a container repository.`,
+		Long: heredoc.Doc(`
+			Delete one or more tags from a container repository. Specify the repository
+			by its ID, name, or path, as shown by 'glab registry list'.
+		`),
+		Args: cobra.MinimumNArgs(2),
+		Example: heredoc.Doc(`
+			$ glab registry delete-tag app v1.0.0
+			$ glab registry delete-tag group/project/app v1.0.0 v1.0.1 --yes
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.repository = args[0]
+			opts.tags = args[1:]
+
+			if !opts.yes && !opts.io.PromptEnabled() {
+				return &cmdutils.FlagError{Err: errors.New("--yes or -y flag is required when not running interactively.")}
+			}
+
+			return opts.run(cmd.Context())
+		},
+	}
+
+	registryDeleteTagCmd.Flags().BoolVarP(&opts.yes, "yes", "y", false, "Skip the confirmation prompt.")
+
+	return registryDeleteTagCmd
+}
+
+func (o *options) run(ctx context.Context) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	repository, err := registryutils.FindRepository(client, repo.FullName(), o.repository)
+	if err != nil {
+		return err
+	}
+
+	if !o.yes {
+		err = o.io.Confirm(ctx, &o.yes, fmt.Sprintf("Delete the tags %s of %s?", strings.Join(o.tags, ", "), repository.Path))
+		if err != nil {
+			return cmdutils.WrapError(err, "could not prompt")
+		}
+		if !o.yes {
+			return cmdutils.CancelError()
+		}
+	}
+
+	c := o.io.Color()
+	for _, tag := range o.tags {
+		if _, err := client.ContainerRegistry.DeleteRegistryRepositoryTag(repo.FullName(), repository.ID, tag); err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to delete tag %s of %s.", tag, repository.Path))
+		}
+		fmt.Fprintf(o.io.StdOut, "%s Deleted tag %s of %s.\n", c.RedCheck(), tag, repository.Path)
+	}
+
+	return nil
+}
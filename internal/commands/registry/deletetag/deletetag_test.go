@@ -0,0 +1,73 @@
+//go:build !integration
+
+package deletetag
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_RegistryDeleteTag(t *testing.T) {
+	testCases := []struct {
+		name    string
+		cli     string
+		tags    []string
+		wantOut string
+		wantErr string
+	}{
+		{
+			name:    "Delete a tag",
+			cli:     "app v1.0.0 --yes",
+			tags:    []string{"v1.0.0"},
+			wantOut: "✓ Deleted tag v1.0.0 of owner/repo/app.\n",
+		},
+		{
+			name:    "Delete several tags",
+			cli:     "app v1.0.0 v1.0.1 -y",
+			tags:    []string{"v1.0.0", "v1.0.1"},
+			wantOut: "✓ Deleted tag v1.0.0 of owner/repo/app.\n✓ Deleted tag v1.0.1 of owner/repo/app.\n",
+		},
+		{
+			name:    "Requires --yes",
+			cli:     "app v1.0.0",
+			wantErr: "--yes or -y flag is required when not running interactively.",
+		},
+		{
+			name:    "Requires a tag",
+			cli:     "app --yes",
+			wantErr: "requires at least 2 arg(s), only received 1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			if tc.wantOut != "" {
+				testClient.MockContainerRegistry.EXPECT().
+					ListProjectRegistryRepositories("OWNER/REPO", gomock.Any(), gomock.Any()).
+					Return([]*gitlab.RegistryRepository{{ID: 2, Name: "app", Path: "owner/repo/app"}}, &gitlab.Response{}, nil)
+				for _, tag := range tc.tags {
+					testClient.MockContainerRegistry.EXPECT().DeleteRegistryRepositoryTag("OWNER/REPO", int64(2), tag).Return(nil, nil)
+				}
+			}
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdDeleteTag, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			out, err := exec(tc.cli)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantOut, out.String())
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/registry/registryutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	outputFormat string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdList(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	registryListCmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   `List the container repositories of a project.`,
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Example: heredoc.Doc(`
+			$ glab registry list
+			$ glab registry list -R group/project -F json
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+
+	registryListCmd.Flags().VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+
+	return registryListCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	repositories, err := registryutils.ListRepositories(client, repo.FullName())
+	if err != nil {
+		return err
+	}
+
+	if o.outputFormat == "json" {
+		repositoriesJSON, _ := json.Marshal(repositories)
+		fmt.Fprintln(o.io.StdOut, string(repositoriesJSON))
+		return nil
+	}
+
+	if len(repositories) == 0 {
+		o.io.LogInfof("No container repositories found for %s.\n", repo.FullName())
+		return nil
+	}
+
+	c := o.io.Color()
+	table := tableprinter.New(o.io)
+	table.AddHeader("ID", "PATH", "TAGS", "CREATED")
+	for _, r := range repositories {
+		created := ""
+		if r.CreatedAt != nil {
+			created = utils.TimeToPrettyTimeAgo(*r.CreatedAt)
+		}
+		table.AddRow(r.ID, r.Path, r.TagsCount, c.Gray(created))
+	}
+	fmt.Fprint(o.io.StdOut, table.String())
+
+	return nil
+}
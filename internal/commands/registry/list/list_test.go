@@ -0,0 +1,74 @@
+//go:build !integration
+
+package list
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_RegistryList(t *testing.T) {
+	created := time.Now().Add(-2 * time.Hour)
+
+	testCases := []struct {
+		name         string
+		cli          string
+		repositories []*gitlab.RegistryRepository
+		expectedMsg  []string
+		wantErr      string
+	}{
+		{
+			name:         "List repositories",
+			repositories: []*gitlab.RegistryRepository{{ID: 2, Name: "app", Path: "owner/repo/app", TagsCount: 14, CreatedAt: &created}},
+			expectedMsg:  []string{"ID", "PATH", "TAGS", "CREATED", "2", "owner/repo/app", "14", "about 2 hours ago"},
+		},
+		{
+			name:        "No repositories",
+			expectedMsg: []string{"No container repositories found for OWNER/REPO."},
+		},
+		{
+			name:         "JSON output",
+			cli:          "-F json",
+			repositories: []*gitlab.RegistryRepository{{ID: 2, Name: "app", Path: "owner/repo/app", TagsCount: 14}},
+			expectedMsg:  []string{`"id":2`, `"tags_count":14`},
+		},
+		{
+			name:    "Invalid output format",
+			cli:     "-F yaml",
+			wantErr: "must be one of",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			if tc.wantErr == "" {
+				testClient.MockContainerRegistry.EXPECT().
+					ListProjectRegistryRepositories("OWNER/REPO", gomock.Any(), gomock.Any()).
+					Return(tc.repositories, &gitlab.Response{}, nil)
+			}
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdList, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			out, err := exec(tc.cli)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			output := out.String() + out.Stderr()
+			for _, msg := range tc.expectedMsg {
+				assert.Contains(t, output, msg)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package listtags
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/registry/registryutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+)
+
+type options struct {
+	repository   string
+	outputFormat string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdListTags(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	registryListTagsCmd := &cobra.Command{
+		Use:   "list-tags <repository> [flags]",
+		Short: `List the tags of a container repository.`,
+		Long: heredoc.Doc(`
+			List the tags of a container repository. Specify the repository by its ID,
+			name, or path, as shown by 'glab registry list'.
+		`),
+		Args: cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ glab registry list-tags app
+			$ glab registry list-tags group/project/app -F json
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.repository = args[0]
+			return opts.run()
+		},
+	}
+
+	registryListTagsCmd.Flags().VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+
+	return registryListTagsCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	repository, err := registryutils.FindRepository(client, repo.FullName(), o.repository)
+	if err != nil {
+		return err
+	}
+
+	tags, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.RegistryRepositoryTag, *gitlab.Response, error) {
+		return client.ContainerRegistry.ListRegistryRepositoryTags(repo.FullName(), repository.ID, &gitlab.ListRegistryRepositoryTagsOptions{
+			ListOptions: gitlab.ListOptions{PerPage: 100},
+		}, p)
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to list the tags of %s.", repository.Path))
+	}
+
+	if o.outputFormat == "json" {
+		tagsJSON, _ := json.Marshal(tags)
+		fmt.Fprintln(o.io.StdOut, string(tagsJSON))
+		return nil
+	}
+
+	if len(tags) == 0 {
+		o.io.LogInfof("No tags found for %s.\n", repository.Path)
+		return nil
+	}
+
+	table := tableprinter.New(o.io)
+	table.AddHeader("NAME", "LOCATION")
+	for _, tag := range tags {
+		table.AddRow(tag.Name, tag.Location)
+	}
+	fmt.Fprint(o.io.StdOut, table.String())
+
+	return nil
+}
@@ -0,0 +1,78 @@
+//go:build !integration
+
+package listtags
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_RegistryListTags(t *testing.T) {
+	app := &gitlab.RegistryRepository{ID: 2, Name: "app", Path: "owner/repo/app"}
+
+	testCases := []struct {
+		name        string
+		cli         string
+		tags        []*gitlab.RegistryRepositoryTag
+		expectedMsg []string
+		wantErr     string
+	}{
+		{
+			name:        "List tags",
+			cli:         "app",
+			tags:        []*gitlab.RegistryRepositoryTag{{Name: "v1.0.0", Location: "registry.example.com/owner/repo/app:v1.0.0"}},
+			expectedMsg: []string{"NAME", "LOCATION", "v1.0.0", "registry.example.com/owner/repo/app:v1.0.0"},
+		},
+		{
+			name:        "No tags",
+			cli:         "2",
+			expectedMsg: []string{"No tags found for owner/repo/app."},
+		},
+		{
+			name:        "JSON output",
+			cli:         "owner/repo/app -F json",
+			tags:        []*gitlab.RegistryRepositoryTag{{Name: "v1.0.0"}},
+			expectedMsg: []string{`"name":"v1.0.0"`},
+		},
+		{
+			name:    "Requires a repository",
+			cli:     "",
+			wantErr: "accepts 1 arg(s), received 0",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			if tc.wantErr == "" {
+				testClient.MockContainerRegistry.EXPECT().
+					ListProjectRegistryRepositories("OWNER/REPO", gomock.Any(), gomock.Any()).
+					Return([]*gitlab.RegistryRepository{app}, &gitlab.Response{}, nil)
+				testClient.MockContainerRegistry.EXPECT().
+					ListRegistryRepositoryTags("OWNER/REPO", int64(2), gomock.Any(), gomock.Any()).
+					Return(tc.tags, &gitlab.Response{}, nil)
+			}
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdListTags, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			out, err := exec(tc.cli)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			output := out.String() + out.Stderr()
+			for _, msg := range tc.expectedMsg {
+				assert.Contains(t, output, msg)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package registry
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	registryCleanupCmd "gitlab.com/gitlab-org/cli/internal/commands/registry/cleanup"
+	registryDeleteTagCmd "gitlab.com/gitlab-org/cli/internal/commands/registry/deletetag"
+	registryListCmd "gitlab.com/gitlab-org/cli/internal/commands/registry/list"
+	registryListTagsCmd "gitlab.com/gitlab-org/cli/internal/commands/registry/listtags"
+)
+
+func NewCmdRegistry(f cmdutils.Factory) *cobra.Command {
+	registryCmd := &cobra.Command{
+		Use:   "registry <command> [flags]",
+		Short: `Manage the container registry of a project.`,
+		Long: heredoc.Doc(`
+		The container registry stores the container images of a project. Images are
+		grouped in container repositories, and every image has one or more tags.
+		`),
+	}
+
+	cmdutils.EnableRepoOverride(registryCmd, f)
+
+	registryCmd.AddCommand(registryListCmd.NewCmdList(f))
+	registryCmd.AddCommand(registryListTagsCmd.NewCmdListTags(f))
+	registryCmd.AddCommand(registryDeleteTagCmd.NewCmdDeleteTag(f))
+	registryCmd.AddCommand(registryCleanupCmd.NewCmdCleanup(f))
+	return registryCmd
+}
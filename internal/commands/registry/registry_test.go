@@ -0,0 +1,23 @@
+//go:build !integration
+
+package registry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestNewCmdRegistry(t *testing.T) {
+	ios, _, _, _ := cmdtest.TestIOStreams()
+	cmd := NewCmdRegistry(cmdtest.NewTestFactory(ios))
+
+	assert.Equal(t, "registry <command> [flags]", cmd.Use)
+	var names []string
+	for _, c := range cmd.Commands() {
+		names = append(names, c.Name())
+	}
+	assert.ElementsMatch(t, []string{"list", "list-tags", "delete-tag", "cleanup"}, names)
+}
@@ -0,0 +1,51 @@
+package registryutils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+)
+
+// ListRepositories returns all container repositories of a project.
+func ListRepositories(client *gitlab.Client, repo string) ([]*gitlab.RegistryRepository, error) {
+	repositories, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.RegistryRepository, *gitlab.Response, error) {
+		return client.ContainerRegistry.ListProjectRegistryRepositories(repo, &gitlab.ListProjectRegistryRepositoriesOptions{
+			ListOptions: gitlab.ListOptions{PerPage: 100},
+			TagsCount:   gitlab.Ptr(true),
+		}, p)
+	})
+	if err != nil {
+		return nil, cmdutils.WrapError(err, "failed to list container repositories.")
+	}
+	return repositories, nil
+}
+
+// FindRepository returns the container repository of a project with this ID, path,
+// or name. The repository with the same path as the project has an empty name, so
+// it can be found by its path only.
+func FindRepository(client *gitlab.Client, repo, nameOrID string) (*gitlab.RegistryRepository, error) {
+	repositories, err := ListRepositories(client, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	id, idErr := strconv.ParseInt(nameOrID, 10, 64)
+	for _, r := range repositories {
+		if (idErr == nil && r.ID == id) || r.Path == nameOrID || (r.Name != "" && r.Name == nameOrID) {
+			return r, nil
+		}
+	}
+
+	var paths []string
+	for _, r := range repositories {
+		paths = append(paths, r.Path)
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no container repository %s found: %s has no container repositories.", nameOrID, repo)
+	}
+	return nil, fmt.Errorf("no container repository %s found in %s. Repositories: %s.", nameOrID, repo, strings.Join(paths, ", "))
+}
@@ -0,0 +1,77 @@
+//go:build !integration
+
+package registryutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+)
+
+func TestFindRepository(t *testing.T) {
+	root := &gitlab.RegistryRepository{ID: 1, Name: "", Path: "owner/repo"}
+	app := &gitlab.RegistryRepository{ID: 2, Name: "app", Path: "owner/repo/app"}
+
+	tests := []struct {
+		name         string
+		nameOrID     string
+		repositories []*gitlab.RegistryRepository
+		want         *gitlab.RegistryRepository
+		wantErr      string
+	}{
+		{
+			name:         "by ID",
+			nameOrID:     "2",
+			repositories: []*gitlab.RegistryRepository{root, app},
+			want:         app,
+		},
+		{
+			name:         "by name",
+			nameOrID:     "app",
+			repositories: []*gitlab.RegistryRepository{root, app},
+			want:         app,
+		},
+		{
+			name:         "by path",
+			nameOrID:     "owner/repo",
+			repositories: []*gitlab.RegistryRepository{root, app},
+			want:         root,
+		},
+		{
+			name:         "not found",
+			nameOrID:     "worker",
+			repositories: []*gitlab.RegistryRepository{root, app},
+			wantErr:      "no container repository worker found in OWNER/REPO. Repositories: owner/repo, owner/repo/app.",
+		},
+		{
+			name:     "no repositories",
+			nameOrID: "app",
+			wantErr:  "no container repository app found: OWNER/REPO has no container repositories.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tc := gitlabtesting.NewTestClient(t)
+			tc.MockContainerRegistry.EXPECT().
+				ListProjectRegistryRepositories("OWNER/REPO", &gitlab.ListProjectRegistryRepositoriesOptions{
+					ListOptions: gitlab.ListOptions{PerPage: 100},
+					TagsCount:   gitlab.Ptr(true),
+				}, gomock.Any()).
+				Return(tt.repositories, &gitlab.Response{}, nil)
+
+			got, err := FindRepository(tc.Client, "OWNER/REPO", tt.nameOrID)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -67,6 +68,9 @@ type options struct {
 	noUpdate                    bool
 	noCloseMilestone            bool
 
+	generateNotes bool
+	notesStartRef string
+
 	noteProvided bool
 
 	assetLink  []*upload.ReleaseAsset
@@ -74,12 +78,14 @@ type options struct {
 
 	usePackageRegistry bool
 	packageName        string
+	checksums          releaseutils.ChecksumsOptions
 
 	ctx          context.Context
 	io           *iostreams.IOStreams
 	gitlabClient func() (*gitlab.Client, error)
 	baseRepo     func() (glrepo.Interface, error)
 	config       func() config.Config
+	exec         cmdutils.Executor
 }
 
 func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
@@ -88,6 +94,7 @@ func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
 		gitlabClient: f.GitLabClient,
 		baseRepo:     f.BaseRepo,
 		config:       f.Config,
+		exec:         f.Executor(),
 	}
 
 	cmd := &cobra.Command{
@@ -122,6 +129,12 @@ func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
 			# Use release notes from a file
 			$ glab release create v1.0.1 -F changelog.md
 
+			# Generate the release notes from the commits since the previous tag
+			$ glab release create v1.0.1 --generate-notes
+
+			# Generate the release notes from the commits since a ref
+			$ glab release create v1.0.1 --generate-notes --notes-start-ref v0.9.0
+
 			# Upload a release asset with a display name (type will default to 'other')
 			$ glab release create v1.0.1 '/path/to/asset.zip#My display label'
 
@@ -183,6 +196,8 @@ func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
 	fl.StringVarP(&opts.tagMessage, "tag-message", "T", "", "Message to use if creating a new annotated tag.")
 	fl.StringVarP(&opts.notes, "notes", "N", "", "The release notes or description. Accepts Markdown.")
 	fl.StringVarP(&opts.notesFile, "notes-file", "F", "", "Read release notes 'file'. To read from stdin, use '-'.")
+	fl.BoolVar(&opts.generateNotes, "generate-notes", false, "Generate the release notes with the changelog API of GitLab, from the commits since the previous tag. Opens an editor to review them when running interactively.")
+	fl.StringVar(&opts.notesStartRef, "notes-start-ref", "", "With --generate-notes, generate the release notes from the commits after this ref, instead of the previous tag.")
 	fl.StringVarP(&opts.releasedAt, "released-at", "D", "", "ISO 8601 datetime when the release was ready. Defaults to the current datetime.")
 	fl.StringSliceVarP(&opts.milestone, "milestone", "m", []string{}, "The title of each milestone the release is associated with. Multiple milestones can be comma-separated or specified by repeating the flag.")
 	fl.StringVarP(&opts.assetLinksAsJSON, "assets-links", "a", "", "JSON string representation of assets links. See documentation for example.")
@@ -192,12 +207,14 @@ func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
 	fl.StringVar(&opts.experimentalNotesTextOrFile, "experimental-notes-text-or-file", "", "(EXPERIMENTAL) Value to use as release notes. If a file exists with this value as path, its content will be used. Otherwise, the value itself will be used as text.")
 	fl.BoolVar(&opts.usePackageRegistry, "use-package-registry", false, "Upload release assets to the generic package registry of the project. Overrides the GITLAB_RELEASE_ASSETS_USE_PACKAGE_REGISTRY environment variable.")
 	fl.StringVar(&opts.packageName, "package-name", upload.DefaultReleasePackageName, "The package name, when uploading assets to the generic package release with --use-package-registry.")
+	releaseutils.AddChecksumsFlags(fl, &opts.checksums)
 	cobra.CheckErr(fl.MarkHidden("experimental-notes-text-or-file"))
 
 	// These two need to be separately exclusive to avoid a breaking change
 	// because there may be existing scripts that already use both notes and notes-file.
 	cmd.MarkFlagsMutuallyExclusive("experimental-notes-text-or-file", "notes")
 	cmd.MarkFlagsMutuallyExclusive("experimental-notes-text-or-file", "notes-file")
+	cmd.MarkFlagsMutuallyExclusive("generate-notes", "notes", "notes-file", "experimental-notes-text-or-file")
 
 	return cmd
 }
@@ -220,6 +237,14 @@ func (o *options) complete(flags *pflag.FlagSet, args []string) error {
 		}
 	}
 
+	if err := o.checksums.Validate(); err != nil {
+		return err
+	}
+
+	if o.notesStartRef != "" && !o.generateNotes {
+		return &cmdutils.FlagError{Err: errors.New("--notes-start-ref can only be used with --generate-notes.")}
+	}
+
 	o.notes, err = resolveNotes(flags, o)
 	if err != nil {
 		return err
@@ -241,7 +266,7 @@ func resolveNotes(flags *pflag.FlagSet, opts *options) (string, error) {
 	}
 
 	if opts.notesFile != "" {
-		return resolveNotesFile(opts)
+		return cmdutils.ReadBodyFile(opts.io, opts.notesFile)
 	}
 
 	if opts.experimentalNotesTextOrFile != "" {
@@ -251,24 +276,6 @@ func resolveNotes(flags *pflag.FlagSet, opts *options) (string, error) {
 	return "", nil
 }
 
-func resolveNotesFile(opts *options) (string, error) {
-	var b []byte
-	var err error
-
-	if opts.notesFile == "-" {
-		b, err = io.ReadAll(opts.io.In)
-		_ = opts.io.In.Close()
-	} else {
-		b, err = os.ReadFile(opts.notesFile)
-	}
-
-	if err != nil {
-		return "", err
-	}
-
-	return string(b), nil
-}
-
 func resolveNotesFileOrText(opts *options) (string, error) {
 	// Rules from: https://docs.gitlab.com/ci/yaml/#releasedescription
 
@@ -347,6 +354,31 @@ func createRun(opts *options) error {
 		}
 	}
 
+	if opts.generateNotes {
+		to := opts.tagName
+		if tag == nil && opts.ref != "" {
+			to = opts.ref
+		}
+		opts.io.LogInfo(color.ProgressIcon(), "Generating release notes up to", to)
+		generated, err := generateNotes(client, repo.FullName(), opts.tagName, opts.notesStartRef, to)
+		if err != nil {
+			return err
+		}
+
+		opts.notes = generated
+		if opts.io.PromptEnabled() {
+			editorCommand, err := cmdutils.GetEditor(opts.config)
+			if err != nil {
+				return err
+			}
+			err = opts.io.Editor(opts.ctx, &opts.notes, "Release notes", "", generated, editorCommand)
+			if err != nil {
+				return err
+			}
+		}
+		opts.noteProvided = true
+	}
+
 	if opts.io.PromptEnabled() && !opts.noteProvided {
 		editorCommand, err := cmdutils.GetEditor(opts.config)
 		if err != nil {
@@ -524,7 +556,11 @@ func createRun(opts *options) error {
 	}
 
 	// upload files and create asset links
-	err = releaseutils.CreateReleaseAssets(opts.io, client, opts.assetFiles, opts.assetLink, repo.FullName(), release.TagName, opts.packageName, opts.usePackageRegistry)
+	signChecksums, err := opts.checksums.Signer(opts.ctx, opts.exec)
+	if err != nil {
+		return releaseFailedErr(err, start)
+	}
+	err = releaseutils.CreateReleaseAssets(opts.io, client, opts.assetFiles, opts.assetLink, repo.FullName(), release.TagName, opts.packageName, opts.usePackageRegistry, upload.DefaultConcurrency, opts.checksums.Checksums, signChecksums)
 	if err != nil {
 		return releaseFailedErr(err, start)
 	}
@@ -639,6 +675,55 @@ func closeMilestone(c *options, title string) error {
 	return err
 }
 
+// generateNotes returns release notes for the commits between from and to. The notes
+// come from the changelog API, which includes the commits with a Changelog trailer.
+// When no commit has the trailer, the notes list the subjects of all the commits.
+// If from is empty, the commits since the previous tag are used.
+func generateNotes(client *gitlab.Client, repo, tagName, from, to string) (string, error) {
+	changelogOpts := gitlab.GenerateChangelogDataOptions{
+		Version: gitlab.Ptr(strings.TrimPrefix(tagName, "v")),
+		To:      gitlab.Ptr(to),
+	}
+	if from != "" {
+		changelogOpts.From = gitlab.Ptr(from)
+	}
+	changelog, _, err := client.Repositories.GenerateChangelogData(repo, changelogOpts)
+	if err == nil && !strings.Contains(changelog.Notes, "No changes.") {
+		return changelog.Notes, nil
+	}
+
+	if from == "" {
+		tags, _, err := client.Tags.ListTags(repo, &gitlab.ListTagsOptions{
+			OrderBy:     gitlab.Ptr("updated"),
+			Sort:        gitlab.Ptr("desc"),
+			ListOptions: gitlab.ListOptions{PerPage: 2},
+		})
+		if err != nil {
+			return "", cmdutils.WrapError(err, "failed to find the previous tag.")
+		}
+		for _, t := range tags {
+			if t.Name != tagName {
+				from = t.Name
+				break
+			}
+		}
+		if from == "" {
+			return "", errors.New("could not generate release notes: there is no previous tag. Use --notes-start-ref to set the first commit.")
+		}
+	}
+
+	compare, _, err := client.Repositories.Compare(repo, &gitlab.CompareOptions{From: gitlab.Ptr(from), To: gitlab.Ptr(to)})
+	if err != nil {
+		return "", cmdutils.WrapError(err, fmt.Sprintf("failed to compare %s with %s.", from, to))
+	}
+	var notes strings.Builder
+	fmt.Fprintf(&notes, "## Changes since %s\n\n", from)
+	for _, c := range compare.Commits {
+		fmt.Fprintf(&notes, "- %s (%s)\n", c.Title, c.ShortID)
+	}
+	return notes.String(), nil
+}
+
 func detectPreviousTag(headRef string) (string, error) {
 	cmd := git.GitCommand("describe", "--tags", "--abbrev=0", fmt.Sprintf("%s^", headRef))
 	b, err := run.PrepareCmd(cmd).Output()
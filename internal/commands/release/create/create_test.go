@@ -772,3 +772,103 @@ func TestReleaseCreate_ExperimentalNotes(t *testing.T) {
 		})
 	}
 }
+
+func TestReleaseCreate_GenerateNotes(t *testing.T) {
+	t.Setenv("CI_DEFAULT_BRANCH", "main")
+
+	tests := []struct {
+		name            string
+		cli             string
+		setupMocks      func(tc *gitlabtesting.TestClient)
+		wantDescription string
+		wantErr         string
+	}{
+		{
+			name: "from the changelog API",
+			cli:  "v1.2.0 --generate-notes",
+			setupMocks: func(tc *gitlabtesting.TestClient) {
+				tc.MockRepositories.EXPECT().GenerateChangelogData("OWNER/REPO", gitlab.GenerateChangelogDataOptions{
+					Version: gitlab.Ptr("1.2.0"),
+					To:      gitlab.Ptr("v1.2.0"),
+				}).Return(&gitlab.ChangelogData{Notes: "## 1.2.0\n\n### Added\n\n- Add a flag\n"}, nil, nil)
+			},
+			wantDescription: "## 1.2.0\n\n### Added\n\n- Add a flag\n",
+		},
+		{
+			name: "from the commits since the previous tag",
+			cli:  "v1.2.0 --generate-notes",
+			setupMocks: func(tc *gitlabtesting.TestClient) {
+				tc.MockRepositories.EXPECT().GenerateChangelogData("OWNER/REPO", gomock.Any()).
+					Return(&gitlab.ChangelogData{Notes: "## 1.2.0 (2026-10-16)\n\nNo changes.\n"}, nil, nil)
+				tc.MockTags.EXPECT().ListTags("OWNER/REPO", gomock.Any()).
+					Return([]*gitlab.Tag{{Name: "v1.2.0"}, {Name: "v1.1.0"}}, nil, nil)
+				tc.MockRepositories.EXPECT().Compare("OWNER/REPO", &gitlab.CompareOptions{From: gitlab.Ptr("v1.1.0"), To: gitlab.Ptr("v1.2.0")}).
+					Return(&gitlab.Compare{Commits: []*gitlab.Commit{
+						{Title: "Add a flag", ShortID: "abc1234"},
+						{Title: "Fix a typo", ShortID: "def5678"},
+					}}, nil, nil)
+			},
+			wantDescription: "## Changes since v1.1.0\n\n- Add a flag (abc1234)\n- Fix a typo (def5678)\n",
+		},
+		{
+			name: "from the start ref",
+			cli:  "v1.2.0 --generate-notes --notes-start-ref v1.0.0",
+			setupMocks: func(tc *gitlabtesting.TestClient) {
+				tc.MockRepositories.EXPECT().GenerateChangelogData("OWNER/REPO", gitlab.GenerateChangelogDataOptions{
+					Version: gitlab.Ptr("1.2.0"),
+					From:    gitlab.Ptr("v1.0.0"),
+					To:      gitlab.Ptr("v1.2.0"),
+				}).Return(nil, nil, errors.New("no trailers"))
+				tc.MockRepositories.EXPECT().Compare("OWNER/REPO", &gitlab.CompareOptions{From: gitlab.Ptr("v1.0.0"), To: gitlab.Ptr("v1.2.0")}).
+					Return(&gitlab.Compare{Commits: []*gitlab.Commit{{Title: "Add a flag", ShortID: "abc1234"}}}, nil, nil)
+			},
+			wantDescription: "## Changes since v1.0.0\n\n- Add a flag (abc1234)\n",
+		},
+		{
+			name:    "start ref without generate notes",
+			cli:     "v1.2.0 --notes-start-ref v1.0.0",
+			wantErr: "--notes-start-ref can only be used with --generate-notes.",
+		},
+		{
+			name:    "generate notes with notes",
+			cli:     `v1.2.0 --generate-notes --notes "text"`,
+			wantErr: "if any flags in the group [generate-notes notes notes-file experimental-notes-text-or-file] are set none of the others can be; [generate-notes notes] were all set",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tc := gitlabtesting.NewTestClient(t)
+
+			if tt.setupMocks != nil {
+				notFoundResponse := &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}
+				tc.MockTags.EXPECT().GetTag("OWNER/REPO", "v1.2.0", gomock.Any()).Return(&gitlab.Tag{Name: "v1.2.0"}, nil, nil)
+				tc.MockReleases.EXPECT().GetRelease("OWNER/REPO", "v1.2.0", gomock.Any()).Return(nil, notFoundResponse, errors.New("not found"))
+				tt.setupMocks(tc)
+				tc.MockReleases.EXPECT().CreateRelease("OWNER/REPO", gomock.Any()).
+					DoAndReturn(func(pid any, opts *gitlab.CreateReleaseOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error) {
+						require.NotNil(t, opts.Description)
+						assert.Equal(t, tt.wantDescription, *opts.Description)
+						return &gitlab.Release{
+							Name:    "v1.2.0",
+							TagName: "v1.2.0",
+							Links:   gitlab.ReleaseLinks{Self: "https://gitlab.com/OWNER/REPO/-/releases/v1.2.0"},
+						}, nil, nil
+					})
+			}
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdCreate, false,
+				cmdtest.WithGitLabClient(tc.Client),
+				cmdtest.WithBaseRepo("OWNER", "REPO", ""),
+			)
+
+			output, err := exec(tt.cli)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Contains(t, output.String(), "✓ Release created:")
+		})
+	}
+}
@@ -4,9 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
-	"net/url"
 	"os"
 	"path"
 	"path/filepath"
@@ -19,6 +17,7 @@ import (
 
 	"gitlab.com/gitlab-org/cli/internal/api"
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/release/releaseutils"
 	"gitlab.com/gitlab-org/cli/internal/commands/release/releaseutils/upload"
 	"gitlab.com/gitlab-org/cli/internal/glrepo"
 	"gitlab.com/gitlab-org/cli/internal/iostreams"
@@ -227,36 +226,5 @@ func downloadAsset(ctx context.Context, client *gitlab.Client, assetURL, destina
 	}
 	defer f.Close()
 
-	// check if authenticated GitLab client should be used or not.
-	baseURL, _ := url.Parse(assetURL)
-	gitlabBaseURL := client.BaseURL()
-	if gitlabBaseURL.Scheme == baseURL.Scheme && gitlabBaseURL.Host == baseURL.Host {
-		r, err := client.NewRequestToURL(http.MethodGet, baseURL, http.NoBody, []gitlab.RequestOptionFunc{gitlab.WithHeader("Accept", "application/octet-stream")})
-		if err != nil {
-			return err
-		}
-		_, err = client.Do(r, f)
-		if err != nil {
-			return err
-		}
-		return nil
-	} else {
-		r, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL.String(), http.NoBody)
-		if err != nil {
-			return err
-		}
-		r.Header.Add("Accept", "application/octet-stream")
-
-		resp, err := http.DefaultClient.Do(r)
-		if err != nil {
-			return err
-		}
-		defer resp.Body.Close()
-
-		if resp.StatusCode > 299 {
-			return errors.New(resp.Status)
-		}
-		_, err = io.Copy(f, resp.Body)
-		return err
-	}
+	return releaseutils.DownloadAsset(ctx, client, assetURL, f)
 }
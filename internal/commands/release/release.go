@@ -9,6 +9,7 @@ import (
 	releaseDownloadCmd "gitlab.com/gitlab-org/cli/internal/commands/release/download"
 	releaseListCmd "gitlab.com/gitlab-org/cli/internal/commands/release/list"
 	releaseUploadCmd "gitlab.com/gitlab-org/cli/internal/commands/release/upload"
+	releaseVerifyCmd "gitlab.com/gitlab-org/cli/internal/commands/release/verify"
 	releaseViewCmd "gitlab.com/gitlab-org/cli/internal/commands/release/view"
 )
 
@@ -27,6 +28,7 @@ func NewCmdRelease(f cmdutils.Factory) *cobra.Command {
 	releaseCmd.AddCommand(releaseDeleteCmd.NewCmdDelete(f))
 	releaseCmd.AddCommand(releaseViewCmd.NewCmdView(f))
 	releaseCmd.AddCommand(releaseDownloadCmd.NewCmdDownload(f))
+	releaseCmd.AddCommand(releaseVerifyCmd.NewCmdVerify(f))
 
 	return releaseCmd
 }
@@ -0,0 +1,116 @@
+package releaseutils
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/release/releaseutils/upload"
+)
+
+const gpg = "gpg"
+
+// ChecksumsOptions are the options of the checksums asset of the uploaded files.
+type ChecksumsOptions struct {
+	Checksums bool
+	Sign      bool
+	SignKey   string
+}
+
+// AddChecksumsFlags adds the flags that upload and sign the checksums of the uploaded files.
+func AddChecksumsFlags(flags *pflag.FlagSet, opts *ChecksumsOptions) {
+	flags.BoolVar(&opts.Checksums, "checksums", false, fmt.Sprintf("Upload a %s asset with the SHA256 checksums of the files.", upload.ChecksumsFileName))
+	flags.BoolVar(&opts.Sign, "sign", false, fmt.Sprintf("Sign the checksums with GPG, and upload the signature as a %s asset. Requires --checksums.", upload.ChecksumsSignatureFileName))
+	flags.StringVar(&opts.SignKey, "sign-key", "", "The GPG key to sign the checksums with. Defaults to the default key of GPG.")
+}
+
+// Validate checks the combination of the checksums flags.
+func (o *ChecksumsOptions) Validate() error {
+	if o.Sign && !o.Checksums {
+		return &cmdutils.FlagError{Err: errors.New("--sign requires --checksums.")}
+	}
+	if o.SignKey != "" && !o.Sign {
+		return &cmdutils.FlagError{Err: errors.New("--sign-key requires --sign.")}
+	}
+	return nil
+}
+
+// Signer returns the function that signs the checksums, or nil when they aren't signed.
+func (o *ChecksumsOptions) Signer(ctx context.Context, exec cmdutils.Executor) (upload.SignFunc, error) {
+	if !o.Sign {
+		return nil, nil
+	}
+
+	gpgPath, err := exec.LookPath(gpg)
+	if err != nil {
+		return nil, fmt.Errorf("signing the checksums requires the `%s` binary: %w", gpg, err)
+	}
+
+	args := []string{"--armor", "--detach-sign", "--output", "-"}
+	if o.SignKey != "" {
+		args = append(args, "--local-user", o.SignKey)
+	}
+	return func(data []byte) ([]byte, error) {
+		var stdout, stderr bytes.Buffer
+		if err := exec.ExecWithIO(ctx, gpgPath, args, nil, bytes.NewReader(data), &stdout, &stderr); err != nil {
+			return nil, fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+		}
+		return stdout.Bytes(), nil
+	}, nil
+}
+
+// ParseChecksums parses the contents of a checksums asset, in the format of sha256sum,
+// and returns the checksums by file name.
+func ParseChecksums(content []byte) (map[string]string, error) {
+	checksums := map[string]string{}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		checksum, name, ok := strings.Cut(line, " ")
+		if !ok || len(checksum) != 64 {
+			return nil, fmt.Errorf("invalid checksum line %q.", line)
+		}
+		// sha256sum marks files read in binary mode with '*'.
+		name = strings.TrimPrefix(strings.TrimLeft(name, " "), "*")
+		checksums[name] = strings.ToLower(checksum)
+	}
+	return checksums, scanner.Err()
+}
+
+// VerifySignature verifies the detached GPG signature of data.
+func VerifySignature(ctx context.Context, exec cmdutils.Executor, data, signature []byte) error {
+	gpgPath, err := exec.LookPath(gpg)
+	if err != nil {
+		return fmt.Errorf("verifying the signature of the checksums requires the `%s` binary: %w", gpg, err)
+	}
+
+	signatureFile, err := os.CreateTemp("", "glab-checksums-*.asc")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(signatureFile.Name())
+	if _, err := signatureFile.Write(signature); err != nil {
+		signatureFile.Close()
+		return err
+	}
+	if err := signatureFile.Close(); err != nil {
+		return err
+	}
+
+	var stderr bytes.Buffer
+	args := []string{"--verify", signatureFile.Name(), "-"}
+	if err := exec.ExecWithIO(ctx, gpgPath, args, nil, bytes.NewReader(data), &stderr, &stderr); err != nil {
+		return fmt.Errorf("invalid signature of the checksums: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
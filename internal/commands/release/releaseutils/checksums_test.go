@@ -0,0 +1,52 @@
+//go:build !integration
+
+package releaseutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseChecksums(t *testing.T) {
+	content := "" +
+		"ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad  app.tar.gz\n" +
+		"\n" +
+		"BA7816BF8F01CFEA414140DE5DAE2223B00361A396177A9CB410FF61F20015AD *app.zip\n"
+
+	checksums, err := ParseChecksums([]byte(content))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"app.tar.gz": "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad",
+		"app.zip":    "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad",
+	}, checksums)
+
+	_, err = ParseChecksums([]byte("abc app.tar.gz\n"))
+	assert.EqualError(t, err, `invalid checksum line "abc app.tar.gz".`)
+}
+
+func TestChecksumsOptions_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    ChecksumsOptions
+		wantErr string
+	}{
+		{name: "none"},
+		{name: "checksums", opts: ChecksumsOptions{Checksums: true}},
+		{name: "signed", opts: ChecksumsOptions{Checksums: true, Sign: true, SignKey: "ABCD"}},
+		{name: "sign without checksums", opts: ChecksumsOptions{Sign: true}, wantErr: "--sign requires --checksums."},
+		{name: "key without sign", opts: ChecksumsOptions{Checksums: true, SignKey: "ABCD"}, wantErr: "--sign-key requires --sign."},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.opts.Validate()
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
@@ -1,8 +1,12 @@
 package releaseutils
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
+	"net/http"
+	"net/url"
 	"os"
 	"strings"
 
@@ -18,8 +22,8 @@ import (
 
 func DisplayAllReleases(io *iostreams.IOStreams, releases []*gitlab.Release, repoName string) string {
 	c := io.Color()
-	table := tableprinter.NewTablePrinter()
-	table.AddRow("Name", "Tag", "Created")
+	table := tableprinter.New(io)
+	table.AddHeader("Name", "Tag", "Created")
 	for _, r := range releases {
 		table.AddRow(r.Name, r.TagName, c.Gray(utils.TimeToPrettyTimeAgo(*r.CreatedAt)))
 	}
@@ -27,11 +31,11 @@ func DisplayAllReleases(io *iostreams.IOStreams, releases []*gitlab.Release, rep
 	return table.Render()
 }
 
-func RenderReleaseAssertLinks(assets []*gitlab.ReleaseLink) string {
+func RenderReleaseAssertLinks(io *iostreams.IOStreams, assets []*gitlab.ReleaseLink) string {
 	if len(assets) == 0 {
 		return "There are no assets for this release"
 	}
-	t := tableprinter.NewTablePrinter()
+	t := tableprinter.New(io)
 	for _, asset := range assets {
 		t.AddRow(asset.Name, asset.DirectAssetURL)
 		// assetsPrint += asset.DirectAssetURL + "\n"
@@ -55,7 +59,7 @@ func DisplayRelease(io *iostreams.IOStreams, r *gitlab.Release, repo glrepo.Inte
 	footer := fmt.Sprintf(c.Gray("View this release on GitLab at %s"), r.Links.Self)
 	return fmt.Sprintf("%s\n%s released this %s\n%s - %s\n%s\n%s\n%s\n%s\n%s\n\n%s", // whoops
 		c.Bold(r.Name), r.Author.Name, duration, r.Commit.ShortID, r.TagName, description, c.Bold("ASSETS"),
-		RenderReleaseAssertLinks(r.Assets.Links), c.Bold("SOURCES"), assetsSources.String(), footer,
+		RenderReleaseAssertLinks(io, r.Assets.Links), c.Bold("SOURCES"), assetsSources.String(), footer,
 	)
 }
 
@@ -93,6 +97,7 @@ func AssetsFromArgs(args []string) ([]*upload.ReleaseFile, error) {
 			Name:  fi.Name(),
 			Label: label,
 			Path:  fn,
+			Size:  fi.Size(),
 		}
 
 		// Only add a link type if it was specified
@@ -107,7 +112,7 @@ func AssetsFromArgs(args []string) ([]*upload.ReleaseFile, error) {
 	return assets, nil
 }
 
-func CreateReleaseAssets(io *iostreams.IOStreams, client *gitlab.Client, assetFiles []*upload.ReleaseFile, assetLinks []*upload.ReleaseAsset, repoName, tagName, packageName string, usePackageRegistry bool) error {
+func CreateReleaseAssets(io *iostreams.IOStreams, client *gitlab.Client, assetFiles []*upload.ReleaseFile, assetLinks []*upload.ReleaseAsset, repoName, tagName, packageName string, usePackageRegistry bool, concurrency int, checksums bool, signChecksums upload.SignFunc) error {
 	if assetFiles == nil && assetLinks == nil {
 		return nil
 	}
@@ -117,6 +122,10 @@ func CreateReleaseAssets(io *iostreams.IOStreams, client *gitlab.Client, assetFi
 		Client:      client,
 		AssetsLinks: assetLinks,
 		AssetFiles:  assetFiles,
+		Concurrency: concurrency,
+		Checksums:   checksums,
+
+		SignChecksums: signChecksums,
 	}
 
 	color := io.Color()
@@ -135,3 +144,40 @@ func CreateReleaseAssets(io *iostreams.IOStreams, client *gitlab.Client, assetFi
 	}
 	return nil
 }
+
+// DownloadAsset writes the asset at assetURL to w. Assets hosted on the GitLab
+// instance are downloaded with the authenticated client.
+func DownloadAsset(ctx context.Context, client *gitlab.Client, assetURL string, w io.Writer) error {
+	// check if authenticated GitLab client should be used or not.
+	baseURL, _ := url.Parse(assetURL)
+	gitlabBaseURL := client.BaseURL()
+	if gitlabBaseURL.Scheme == baseURL.Scheme && gitlabBaseURL.Host == baseURL.Host {
+		r, err := client.NewRequestToURL(http.MethodGet, baseURL, http.NoBody, []gitlab.RequestOptionFunc{gitlab.WithHeader("Accept", "application/octet-stream")})
+		if err != nil {
+			return err
+		}
+		_, err = client.Do(r, w)
+		if err != nil {
+			return err
+		}
+		return nil
+	} else {
+		r, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL.String(), http.NoBody)
+		if err != nil {
+			return err
+		}
+		r.Header.Add("Accept", "application/octet-stream")
+
+		resp, err := http.DefaultClient.Do(r)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode > 299 {
+			return errors.New(resp.Status)
+		}
+		_, err = io.Copy(w, resp.Body)
+		return err
+	}
+}
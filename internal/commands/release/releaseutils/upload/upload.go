@@ -1,8 +1,20 @@
 package upload
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
+	"maps"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
 
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 
@@ -41,6 +53,7 @@ type ReleaseFile struct {
 	Name  string
 	Label string
 	Path  string
+	Size  int64
 	Type  *gitlab.LinkTypeValue
 }
 
@@ -63,11 +76,37 @@ func CreateLink(c *gitlab.Client, projectID, tagName string, asset *ReleaseAsset
 	return releaseLink, aliased, nil
 }
 
+// DefaultConcurrency is the default number of files uploaded at the same time.
+const DefaultConcurrency = 4
+
+// ChecksumsFileName is the name of the asset that lists the SHA256 checksums of the uploaded files.
+const ChecksumsFileName = "SHA256SUMS"
+
+// ChecksumsSignatureFileName is the name of the asset with the detached signature of the checksums.
+const ChecksumsSignatureFileName = ChecksumsFileName + ".asc"
+
+// SignFunc returns an ASCII-armored detached signature of data.
+type SignFunc func(data []byte) ([]byte, error)
+
+// maxUploadAttempts is the number of times an upload is attempted when it fails with a transient error.
+const maxUploadAttempts = 3
+
+// retryDelay is the delay before the first retry of an upload. It doubles with every attempt.
+var retryDelay = 2 * time.Second
+
 type Context struct {
 	Client      *gitlab.Client
 	IO          *iostreams.IOStreams
 	AssetFiles  []*ReleaseFile
 	AssetsLinks []*ReleaseAsset
+
+	// Concurrency is the number of files uploaded at the same time. Defaults to 1.
+	Concurrency int
+	// Checksums uploads an asset with the SHA256 checksums of the files.
+	Checksums bool
+	// SignChecksums, when set with Checksums, signs the checksums and uploads
+	// the signature as another asset.
+	SignChecksums SignFunc
 }
 
 // UploadFiles uploads a file into a release repository.
@@ -76,32 +115,163 @@ func (c *Context) UploadFiles(projectID, tagName string, packageName string, use
 		return nil
 	}
 	color := c.IO.Color()
+	progress := c.IO.NewProgress()
+
+	var mu sync.Mutex
+	checksums := map[string]string{}
+
+	g := new(errgroup.Group)
+	g.SetLimit(max(c.Concurrency, 1))
 	for _, file := range c.AssetFiles {
-		fmt.Fprintf(c.IO.StdOut, "%s Uploading to release\t%s=%s %s=%s\n",
-			color.ProgressIcon(), color.Blue("file"), file.Path,
-			color.Blue("name"), file.Name)
+		bar := progress.AddBar(file.Name, file.Size)
+		g.Go(func() error {
+			if !progress.Enabled() {
+				mu.Lock()
+				fmt.Fprintf(c.IO.StdOut, "%s Uploading to release\t%s=%s %s=%s\n",
+					color.ProgressIcon(), color.Blue("file"), file.Path,
+					color.Blue("name"), file.Name)
+				mu.Unlock()
+			}
+
+			checksum, err := c.uploadFile(projectID, tagName, packageName, usePackageRegistry, file, bar)
+			bar.Done(err)
+			if err != nil {
+				return fmt.Errorf("failed to upload %s: %w", file.Name, err)
+			}
+
+			mu.Lock()
+			checksums[file.Name] = checksum
+			mu.Unlock()
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return err
+	}
+
+	if c.Checksums {
+		if err := c.uploadChecksums(projectID, tagName, packageName, usePackageRegistry, checksums); err != nil {
+			return err
+		}
+	}
+	c.AssetFiles = nil
+
+	return nil
+}
+
+// uploadFile uploads a file, retrying transient failures, and links it to the release.
+// It returns the SHA256 checksum of the file.
+func (c *Context) uploadFile(projectID, tagName, packageName string, usePackageRegistry bool, file *ReleaseFile, bar *iostreams.ProgressBar) (string, error) {
+	var releaseAsset *ReleaseAsset
+	var checksum string
+	var err error
+	for attempt := 1; ; attempt++ {
+		hash := sha256.New()
+		counted := &ReleaseFile{
+			Open: func() (io.ReadCloser, error) {
+				r, err := file.Open()
+				if err != nil {
+					return nil, err
+				}
+				return readCloser{io.TeeReader(r, io.MultiWriter(hash, bar)), r}, nil
+			},
+			Name:  file.Name,
+			Label: file.Label,
+			Path:  file.Path,
+			Type:  file.Type,
+		}
 
-		var releaseAsset *ReleaseAsset
-		var err error
 		if usePackageRegistry {
-			releaseAsset, err = c.uploadAsGenericPackage(projectID, tagName, packageName, file)
+			releaseAsset, err = c.uploadAsGenericPackage(projectID, tagName, packageName, counted)
 		} else {
-			releaseAsset, err = c.uploadAsProjectMarkdownFile(projectID, file)
+			releaseAsset, err = c.uploadAsProjectMarkdownFile(projectID, counted)
 		}
-		if err != nil {
-			return err
+		if err == nil {
+			checksum = hex.EncodeToString(hash.Sum(nil))
+			break
 		}
-
-		_, _, err = CreateLink(c.Client, projectID, tagName, releaseAsset)
-		if err != nil {
-			return err
+		if attempt == maxUploadAttempts || !isTransient(err) {
+			return "", err
 		}
+
+		delay := retryDelay << (attempt - 1)
+		c.IO.LogErrorf("%s Upload of %s failed, retrying in %s: %v\n", c.IO.Color().WarnIcon(), file.Name, delay, err)
+		time.Sleep(delay)
+		bar.Reset()
 	}
-	c.AssetFiles = nil
 
+	if _, _, err := CreateLink(c.Client, projectID, tagName, releaseAsset); err != nil {
+		return "", err
+	}
+	return checksum, nil
+}
+
+// uploadChecksums uploads the checksums in the format of sha256sum, so that they
+// can be verified with 'sha256sum --check'.
+func (c *Context) uploadChecksums(projectID, tagName, packageName string, usePackageRegistry bool, checksums map[string]string) error {
+	var content strings.Builder
+	for _, name := range slices.Sorted(maps.Keys(checksums)) {
+		fmt.Fprintf(&content, "%s  %s\n", checksums[name], name)
+	}
+
+	if err := c.uploadContent(projectID, tagName, packageName, usePackageRegistry, ChecksumsFileName, content.String()); err != nil {
+		return fmt.Errorf("failed to upload the checksums: %w", err)
+	}
+
+	if c.SignChecksums == nil {
+		return nil
+	}
+	signature, err := c.SignChecksums([]byte(content.String()))
+	if err != nil {
+		return fmt.Errorf("failed to sign the checksums: %w", err)
+	}
+	if err := c.uploadContent(projectID, tagName, packageName, usePackageRegistry, ChecksumsSignatureFileName, string(signature)); err != nil {
+		return fmt.Errorf("failed to upload the checksums signature: %w", err)
+	}
 	return nil
 }
 
+// uploadContent uploads generated content as an asset called name.
+func (c *Context) uploadContent(projectID, tagName, packageName string, usePackageRegistry bool, name, content string) error {
+	file := &ReleaseFile{
+		Open: func() (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader(content)), nil
+		},
+		Name:  name,
+		Label: name,
+		Path:  name,
+		Size:  int64(len(content)),
+		Type:  gitlab.Ptr(gitlab.OtherLinkType),
+	}
+	progress := c.IO.NewProgress()
+	if !progress.Enabled() {
+		color := c.IO.Color()
+		fmt.Fprintf(c.IO.StdOut, "%s Uploading to release\t%s=%s\n", color.ProgressIcon(), color.Blue("name"), name)
+	}
+	_, err := c.uploadFile(projectID, tagName, packageName, usePackageRegistry, file, progress.AddBar(file.Name, file.Size))
+	return err
+}
+
+// isTransient reports whether an upload that failed with err might succeed when retried.
+func isTransient(err error) bool {
+	var errResp *gitlab.ErrorResponse
+	if errors.As(err, &errResp) {
+		if errResp.Response == nil {
+			return false
+		}
+		code := errResp.Response.StatusCode
+		return code == http.StatusTooManyRequests || code >= http.StatusInternalServerError
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// readCloser reads from a reader that wraps the file, and closes the file.
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
 func (c *Context) uploadAsGenericPackage(projectID, tagName string, packageName string, file *ReleaseFile) (*ReleaseAsset, error) {
 	r, err := file.Open()
 	if err != nil {
@@ -4,8 +4,13 @@ package upload
 
 import (
 	"bytes"
+	"errors"
 	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -145,3 +150,149 @@ func TestReleaseUtilsUpload_UploadFiles_GenericPackageRegistry(t *testing.T) {
 	// THEN
 	require.NoError(t, err)
 }
+
+func testReleaseFile(name, content string) *ReleaseFile {
+	return &ReleaseFile{
+		Open: func() (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader(content)), nil
+		},
+		Name:  name,
+		Label: name,
+		Path:  "./dist/" + name,
+		Size:  int64(len(content)),
+	}
+}
+
+func TestReleaseUtilsUpload_UploadFiles_Checksums(t *testing.T) {
+	ios, _, _, _ := cmdtest.TestIOStreams()
+	tc := gitlabtesting.NewTestClient(t, gitlab.WithBaseURL(glinstance.DefaultHostname))
+	uploadCtx := &Context{
+		Client:      tc.Client,
+		IO:          ios,
+		AssetFiles:  []*ReleaseFile{testReleaseFile("b.txt", "world"), testReleaseFile("a.txt", "hello")},
+		Concurrency: 2,
+		Checksums:   true,
+	}
+
+	var checksums string
+	tc.MockProjectMarkdownUploads.EXPECT().
+		UploadProjectMarkdown("any-project", gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(pid any, content io.Reader, filename string, options ...gitlab.RequestOptionFunc) (*gitlab.MarkdownUploadedFile, *gitlab.Response, error) {
+			data, err := io.ReadAll(content)
+			require.NoError(t, err)
+			if filename == ChecksumsFileName {
+				checksums = string(data)
+			}
+			return &gitlab.MarkdownUploadedFile{FullPath: "/uploads/" + filename}, nil, nil
+		}).Times(3)
+	tc.MockReleaseLinks.EXPECT().CreateReleaseLink("any-project", "42.0.0", gomock.Any()).Times(3)
+
+	err := uploadCtx.UploadFiles("any-project", "42.0.0", DefaultReleasePackageName, false)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		"2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824  a.txt\n"+
+			"486ea46224d1bb4fb680f34f7c9ad96a8f24ec88be73ea8e5a6c65260e9cb8a7  b.txt\n",
+		checksums)
+}
+
+func TestReleaseUtilsUpload_UploadFiles_SignedChecksums(t *testing.T) {
+	ios, _, _, _ := cmdtest.TestIOStreams()
+	tc := gitlabtesting.NewTestClient(t, gitlab.WithBaseURL(glinstance.DefaultHostname))
+	uploadCtx := &Context{
+		Client:     tc.Client,
+		IO:         ios,
+		AssetFiles: []*ReleaseFile{testReleaseFile("a.txt", "hello")},
+		Checksums:  true,
+		SignChecksums: func(data []byte) ([]byte, error) {
+			return append([]byte("signature of "), data...), nil
+		},
+	}
+
+	uploaded := map[string]string{}
+	tc.MockProjectMarkdownUploads.EXPECT().
+		UploadProjectMarkdown("any-project", gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(pid any, content io.Reader, filename string, options ...gitlab.RequestOptionFunc) (*gitlab.MarkdownUploadedFile, *gitlab.Response, error) {
+			data, err := io.ReadAll(content)
+			require.NoError(t, err)
+			uploaded[filename] = string(data)
+			return &gitlab.MarkdownUploadedFile{FullPath: "/uploads/" + filename}, nil, nil
+		}).Times(3)
+	tc.MockReleaseLinks.EXPECT().CreateReleaseLink("any-project", "42.0.0", gomock.Any()).Times(3)
+
+	err := uploadCtx.UploadFiles("any-project", "42.0.0", DefaultReleasePackageName, false)
+	require.NoError(t, err)
+
+	assert.Equal(t, "signature of "+uploaded[ChecksumsFileName], uploaded[ChecksumsSignatureFileName])
+}
+
+func TestReleaseUtilsUpload_UploadFiles_Retry(t *testing.T) {
+	retryDelay = 0
+	t.Cleanup(func() { retryDelay = 2 * time.Second })
+
+	tests := []struct {
+		name      string
+		uploadErr error
+		wantErr   string
+	}{
+		{
+			name:      "retries a server error",
+			uploadErr: &gitlab.ErrorResponse{Response: &http.Response{StatusCode: http.StatusBadGateway}},
+		},
+		{
+			name:      "retries a network error",
+			uploadErr: io.ErrUnexpectedEOF,
+		},
+		{
+			name: "does not retry a client error",
+			uploadErr: &gitlab.ErrorResponse{
+				Response: &http.Response{
+					StatusCode: http.StatusBadRequest,
+					Request:    httptest.NewRequest(http.MethodPost, "https://gitlab.com/api/v4/projects/any-project/uploads", nil),
+				},
+				Message: "400 Bad Request",
+			},
+			wantErr: "failed to upload a.txt: POST https://gitlab.com/api/v4/projects/any-project/uploads: 400",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, stderr := cmdtest.TestIOStreams()
+			tc := gitlabtesting.NewTestClient(t, gitlab.WithBaseURL(glinstance.DefaultHostname))
+			uploadCtx := &Context{
+				Client:     tc.Client,
+				IO:         ios,
+				AssetFiles: []*ReleaseFile{testReleaseFile("a.txt", "hello")},
+			}
+
+			failure := tc.MockProjectMarkdownUploads.EXPECT().
+				UploadProjectMarkdown("any-project", gomock.Any(), "a.txt", gomock.Any()).
+				Return(nil, nil, tt.uploadErr)
+			if tt.wantErr == "" {
+				gomock.InOrder(
+					failure,
+					tc.MockProjectMarkdownUploads.EXPECT().
+						UploadProjectMarkdown("any-project", gomock.Any(), "a.txt", gomock.Any()).
+						Return(&gitlab.MarkdownUploadedFile{FullPath: "/uploads/a.txt"}, nil, nil),
+					tc.MockReleaseLinks.EXPECT().CreateReleaseLink("any-project", "42.0.0", gomock.Any()),
+				)
+			}
+
+			err := uploadCtx.UploadFiles("any-project", "42.0.0", DefaultReleasePackageName, false)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Contains(t, stderr.String(), "Upload of a.txt failed, retrying")
+		})
+	}
+}
+
+func TestReleaseUtilsUpload_IsTransient(t *testing.T) {
+	assert.True(t, isTransient(&gitlab.ErrorResponse{Response: &http.Response{StatusCode: http.StatusTooManyRequests}}))
+	assert.True(t, isTransient(&gitlab.ErrorResponse{Response: &http.Response{StatusCode: http.StatusServiceUnavailable}}))
+	assert.False(t, isTransient(&gitlab.ErrorResponse{Response: &http.Response{StatusCode: http.StatusNotFound}}))
+	assert.False(t, isTransient(errors.New("file not found")))
+}
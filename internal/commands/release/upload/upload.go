@@ -1,6 +1,7 @@
 package upload
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -33,9 +34,13 @@ type options struct {
 	usePackageRegistry bool
 	packageName        string
 
+	jobs      int
+	checksums releaseutils.ChecksumsOptions
+
 	io           *iostreams.IOStreams
 	gitlabClient func() (*gitlab.Client, error)
 	baseRepo     func() (glrepo.Interface, error)
+	exec         cmdutils.Executor
 }
 
 func NewCmdUpload(f cmdutils.Factory) *cobra.Command {
@@ -43,6 +48,7 @@ func NewCmdUpload(f cmdutils.Factory) *cobra.Command {
 		io:           f.IO(),
 		gitlabClient: f.GitLabClient,
 		baseRepo:     f.BaseRepo,
+		exec:         f.Executor(),
 	}
 
 	cmd := &cobra.Command{
@@ -52,6 +58,11 @@ func NewCmdUpload(f cmdutils.Factory) *cobra.Command {
 
 		Define the display name by appending '#' after the filename.
 		The link type comes after the display name, like this: 'myfile.tar.gz#My display name#package'
+
+		Files are uploaded in parallel, and uploads that fail with a server or network
+		error are retried. With --checksums, a SHA256SUMS asset lists the SHA256 checksums
+		of the files, so downloads can be verified with 'sha256sum --check SHA256SUMS',
+		or with 'glab release verify'. With --sign, the checksums are signed with GPG too.
 		`),
 		Args: func() cobra.PositionalArgs {
 			return func(cmd *cobra.Command, args []string) error {
@@ -77,6 +88,12 @@ func NewCmdUpload(f cmdutils.Factory) *cobra.Command {
 			# Upload all tarballs in a specified folder. 'Type' defaults to 'other'.
 			$ glab release upload v1.0.1 ./dist/*.tar.gz
 
+			# Upload eight files at a time, with their checksums
+			$ glab release upload v1.0.1 ./dist/* --jobs 8 --checksums
+
+			# Upload files with their checksums, signed with a GPG key
+			$ glab release upload v1.0.1 ./dist/* --checksums --sign --sign-key releases@example.com
+
 			# Upload release assets links specified as JSON string
 			$ glab release upload v1.0.1 --assets-links='
 			  [
@@ -100,7 +117,7 @@ func NewCmdUpload(f cmdutils.Factory) *cobra.Command {
 				return err
 			}
 
-			return opts.run()
+			return opts.run(cmd.Context())
 		},
 	}
 
@@ -108,6 +125,8 @@ func NewCmdUpload(f cmdutils.Factory) *cobra.Command {
 	fl.StringVarP(&opts.assetLinksAsJSON, "assets-links", "a", "", "`JSON` string representation of assets links, like: `--assets-links='[{\"name\": \"Asset1\", \"url\":\"https://<domain>/some/location/1\", \"link_type\": \"other\", \"direct_asset_path\": \"path/to/file\"}]'.`")
 	fl.BoolVar(&opts.usePackageRegistry, "use-package-registry", false, "Upload release assets to the generic package registry of the project. Alternatively to this flag you may also set the GITLAB_RELEASE_ASSETS_USE_PACKAGE_REGISTRY environment variable to either the value true or 1. The flag takes precedence over this environment variable.")
 	fl.StringVar(&opts.packageName, "package-name", upload.DefaultReleasePackageName, "The package name to use when uploading the assets to the generic package release with --use-package-registry.")
+	fl.IntVarP(&opts.jobs, "jobs", "j", upload.DefaultConcurrency, "Number of files to upload in parallel.")
+	releaseutils.AddChecksumsFlags(fl, &opts.checksums)
 
 	return cmd
 }
@@ -131,6 +150,14 @@ func (o *options) complete(flags *pflag.FlagSet, args []string) error {
 }
 
 func (o *options) validate() error {
+	if o.jobs < 1 {
+		return &cmdutils.FlagError{Err: errors.New("--jobs must be at least 1.")}
+	}
+
+	if err := o.checksums.Validate(); err != nil {
+		return err
+	}
+
 	if o.assetFiles == nil && o.assetLinksAsJSON == "" {
 		return cmdutils.FlagError{Err: errors.New("no files specified.")}
 	}
@@ -145,9 +172,14 @@ func (o *options) validate() error {
 	return nil
 }
 
-func (o *options) run() error {
+func (o *options) run(ctx context.Context) error {
 	start := time.Now()
 
+	signChecksums, err := o.checksums.Signer(ctx, o.exec)
+	if err != nil {
+		return err
+	}
+
 	client, err := o.gitlabClient()
 	if err != nil {
 		return err
@@ -174,7 +206,7 @@ func (o *options) run() error {
 	}
 
 	// upload files and create asset links
-	err = releaseutils.CreateReleaseAssets(o.io, client, o.assetFiles, o.assetLinks, repo.FullName(), release.TagName, o.packageName, o.usePackageRegistry)
+	err = releaseutils.CreateReleaseAssets(o.io, client, o.assetFiles, o.assetLinks, repo.FullName(), release.TagName, o.packageName, o.usePackageRegistry, o.jobs, o.checksums.Checksums, signChecksums)
 	if err != nil {
 		return cmdutils.WrapError(err, "creating release assets failed.")
 	}
@@ -182,3 +182,15 @@ func TestReleaseUpload_WithAssetsLinksJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestReleaseUpload_InvalidJobs(t *testing.T) {
+	t.Parallel()
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdUpload, false,
+		cmdtest.WithGitLabClient(gitlabtesting.NewTestClient(t).Client),
+		cmdtest.WithBaseRepo("OWNER", "REPO", ""),
+	)
+
+	_, err := exec("0.0.1 testdata/test_file.txt --jobs 0")
+	require.EqualError(t, err, "--jobs must be at least 1.")
+}
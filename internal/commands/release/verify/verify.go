@@ -0,0 +1,198 @@
+package verify
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	attestationVerify "gitlab.com/gitlab-org/cli/internal/commands/attestation/verify"
+	"gitlab.com/gitlab-org/cli/internal/commands/release/releaseutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/release/releaseutils/upload"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	tagName       string
+	assets        []string
+	skipSignature bool
+	provenance    bool
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+	exec         cmdutils.Executor
+}
+
+func NewCmdVerify(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+		exec:         f.Executor(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "verify <tag> --asset <file> [flags]",
+		Short: "Verify downloaded release assets with the checksums of the release.",
+		Long: heredoc.Docf(`Verify downloaded release assets with the checksums of the release.
+
+			The checksums are read from the %[2]s asset of the release, which
+			%[1]sglab release upload --checksums%[1]s creates. When the release has a
+			%[3]s asset too, the signature of the checksums is verified with GPG first.
+
+			With %[1]s--provenance%[1]s, the provenance of the assets is verified too, like
+			%[1]sglab attestation verify%[1]s does. It requires the cosign binary.
+		`, "`", upload.ChecksumsFileName, upload.ChecksumsSignatureFileName),
+		Args: cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			# Verify a downloaded asset of the release v1.0.0
+			$ glab release verify v1.0.0 --asset ./glab_1.0.0_linux_amd64.tar.gz
+
+			# Verify several assets, and their provenance
+			$ glab release verify v1.0.0 --asset app.tar.gz --asset app.zip --provenance
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.tagName = args[0]
+
+			return opts.run(cmd.Context())
+		},
+	}
+
+	fl := cmd.Flags()
+	fl.StringArrayVar(&opts.assets, "asset", nil, "Path of a downloaded asset to verify. Its file name must match the name of the asset. Can be repeated.")
+	fl.BoolVar(&opts.skipSignature, "skip-signature", false, fmt.Sprintf("Don't verify the signature of the checksums, even when the release has a %s asset.", upload.ChecksumsSignatureFileName))
+	fl.BoolVar(&opts.provenance, "provenance", false, "Verify the provenance of the assets too. Requires the cosign binary.")
+	cobra.CheckErr(cmd.MarkFlagRequired("asset"))
+
+	return cmd
+}
+
+func (o *options) run(ctx context.Context) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+	color := o.io.Color()
+
+	release, resp, err := client.Releases.GetRelease(repo.FullName(), o.tagName)
+	if err != nil {
+		if resp != nil && (resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusForbidden) {
+			return cmdutils.WrapError(err, "release does not exist.")
+		}
+		return cmdutils.WrapError(err, "failed to fetch release.")
+	}
+
+	var checksumsLink, signatureLink *gitlab.ReleaseLink
+	for _, link := range release.Assets.Links {
+		switch link.Name {
+		case upload.ChecksumsFileName:
+			checksumsLink = link
+		case upload.ChecksumsSignatureFileName:
+			signatureLink = link
+		}
+	}
+	if checksumsLink == nil {
+		return fmt.Errorf("release %s has no %s asset. Upload the assets with `glab release upload --checksums`.", o.tagName, upload.ChecksumsFileName)
+	}
+
+	var checksumsContent bytes.Buffer
+	if err := releaseutils.DownloadAsset(ctx, client, checksumsLink.URL, &checksumsContent); err != nil {
+		return fmt.Errorf("failed to download %s: %w", upload.ChecksumsFileName, err)
+	}
+
+	switch {
+	case o.skipSignature:
+	case signatureLink == nil:
+		fmt.Fprintf(o.io.StdErr, "%s %s of release %s isn't signed.\n", color.WarnIcon(), upload.ChecksumsFileName, o.tagName)
+	default:
+		var signature bytes.Buffer
+		if err := releaseutils.DownloadAsset(ctx, client, signatureLink.URL, &signature); err != nil {
+			return fmt.Errorf("failed to download %s: %w", upload.ChecksumsSignatureFileName, err)
+		}
+		if err := releaseutils.VerifySignature(ctx, o.exec, checksumsContent.Bytes(), signature.Bytes()); err != nil {
+			return err
+		}
+		fmt.Fprintf(o.io.StdOut, "%s Signature of %s verified\n", color.GreenCheck(), upload.ChecksumsFileName)
+	}
+
+	checksums, err := releaseutils.ParseChecksums(checksumsContent.Bytes())
+	if err != nil {
+		return fmt.Errorf("failed to parse %s: %w", upload.ChecksumsFileName, err)
+	}
+
+	failed := 0
+	for _, asset := range o.assets {
+		name := filepath.Base(asset)
+		if err := o.verifyAsset(ctx, client, repo.FullName(), asset, checksums[name]); err != nil {
+			fmt.Fprintf(o.io.StdOut, "%s %s: %s\n", color.FailedIcon(), name, err)
+			failed++
+			continue
+		}
+		fmt.Fprintf(o.io.StdOut, "%s %s: OK\n", color.GreenCheck(), name)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d assets failed verification.", failed, len(o.assets))
+	}
+	return nil
+}
+
+// verifyAsset compares the checksum of the file at path with the expected checksum,
+// and verifies its provenance when requested.
+func (o *options) verifyAsset(ctx context.Context, client *gitlab.Client, project, path, expected string) error {
+	if expected == "" {
+		return fmt.Errorf("not listed in %s", upload.ChecksumsFileName)
+	}
+
+	checksum, err := fileChecksum(path)
+	if err != nil {
+		return err
+	}
+	if checksum != expected {
+		return errors.New("checksum mismatch")
+	}
+
+	if o.provenance {
+		if err := attestationVerify.VerifyProvenance(ctx, client, o.exec, project, path); err != nil {
+			return fmt.Errorf("provenance verification failed: %w", err)
+		}
+	}
+	return nil
+}
+
+func fileChecksum(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
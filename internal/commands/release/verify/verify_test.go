@@ -0,0 +1,124 @@
+//go:build !integration
+
+package verify
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/glinstance"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+const checksums = "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824  a.txt\n" +
+	"486ea46224d1bb4fb680f34f7c9ad96a8f24ec88be73ea8e5a6c65260e9cb8a7 *b.txt\n"
+
+func setupRelease(t *testing.T, tc *gitlabtesting.TestClient, signed bool) {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/SHA256SUMS":
+			_, _ = w.Write([]byte(checksums))
+		case "/SHA256SUMS.asc":
+			_, _ = w.Write([]byte("signature"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	links := []*gitlab.ReleaseLink{
+		{Name: "a.txt", URL: server.URL + "/a.txt"},
+		{Name: "SHA256SUMS", URL: server.URL + "/SHA256SUMS"},
+	}
+	if signed {
+		links = append(links, &gitlab.ReleaseLink{Name: "SHA256SUMS.asc", URL: server.URL + "/SHA256SUMS.asc"})
+	}
+	tc.MockReleases.EXPECT().
+		GetRelease("OWNER/REPO", "v1.0.0", gomock.Any()).
+		Return(&gitlab.Release{TagName: "v1.0.0", Assets: gitlab.ReleaseAssets{Links: links}}, nil, nil)
+}
+
+func writeAssets(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+	return dir
+}
+
+func TestReleaseVerify(t *testing.T) {
+	t.Parallel()
+
+	tc := gitlabtesting.NewTestClient(t, gitlab.WithBaseURL(glinstance.DefaultHostname))
+	setupRelease(t, tc, false)
+	dir := writeAssets(t, map[string]string{"a.txt": "hello", "b.txt": "world"})
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdVerify, false, cmdtest.WithGitLabClient(tc.Client))
+	out, err := exec("v1.0.0 --asset " + filepath.Join(dir, "a.txt") + " --asset " + filepath.Join(dir, "b.txt"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ a.txt: OK\n✓ b.txt: OK\n", out.String())
+	assert.Contains(t, out.Stderr(), "SHA256SUMS of release v1.0.0 isn't signed.")
+}
+
+func TestReleaseVerify_failures(t *testing.T) {
+	t.Parallel()
+
+	tc := gitlabtesting.NewTestClient(t, gitlab.WithBaseURL(glinstance.DefaultHostname))
+	setupRelease(t, tc, false)
+	dir := writeAssets(t, map[string]string{"a.txt": "tampered", "c.txt": "unknown"})
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdVerify, false, cmdtest.WithGitLabClient(tc.Client))
+	out, err := exec("v1.0.0 --asset " + filepath.Join(dir, "a.txt") + " --asset " + filepath.Join(dir, "c.txt"))
+	require.EqualError(t, err, "2 of 2 assets failed verification.")
+
+	assert.Equal(t, "x a.txt: checksum mismatch\nx c.txt: not listed in SHA256SUMS\n", out.String())
+}
+
+func TestReleaseVerify_signed(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	tc := gitlabtesting.NewTestClientWithCtrl(ctrl, gitlab.WithBaseURL(glinstance.DefaultHostname))
+	mockExec := cmdtest.NewMockExecutor(ctrl)
+	setupRelease(t, tc, true)
+	dir := writeAssets(t, map[string]string{"a.txt": "hello"})
+
+	mockExec.EXPECT().LookPath("gpg").Return("/usr/bin/gpg", nil)
+	mockExec.EXPECT().
+		ExecWithIO(gomock.Any(), "/usr/bin/gpg", cmdtest.SliceMatch[string]("--verify", gomock.Any(), "-"), nil, gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdVerify, false, cmdtest.WithGitLabClient(tc.Client), cmdtest.WithExecutor(mockExec))
+	out, err := exec("v1.0.0 --asset " + filepath.Join(dir, "a.txt"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Signature of SHA256SUMS verified\n✓ a.txt: OK\n", out.String())
+}
+
+func TestReleaseVerify_noChecksums(t *testing.T) {
+	t.Parallel()
+
+	tc := gitlabtesting.NewTestClient(t, gitlab.WithBaseURL(glinstance.DefaultHostname))
+	tc.MockReleases.EXPECT().
+		GetRelease("OWNER/REPO", "v1.0.0", gomock.Any()).
+		Return(&gitlab.Release{TagName: "v1.0.0"}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdVerify, false, cmdtest.WithGitLabClient(tc.Client))
+	_, err := exec("v1.0.0 --asset a.txt")
+	require.EqualError(t, err, "release v1.0.0 has no SHA256SUMS asset. Upload the assets with `glab release upload --checksums`.")
+}
@@ -2,25 +2,37 @@ package commands
 
 import (
 	"errors"
+	"slices"
+	"strconv"
+	"time"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	alertCmd "gitlab.com/gitlab-org/cli/internal/commands/alert"
 	aliasCmd "gitlab.com/gitlab-org/cli/internal/commands/alias"
 	apiCmd "gitlab.com/gitlab-org/cli/internal/commands/api"
 	attestationCmd "gitlab.com/gitlab-org/cli/internal/commands/attestation"
 	authCmd "gitlab.com/gitlab-org/cli/internal/commands/auth"
+	branchCmd "gitlab.com/gitlab-org/cli/internal/commands/branch"
 	changelogCmd "gitlab.com/gitlab-org/cli/internal/commands/changelog"
 	pipelineCmd "gitlab.com/gitlab-org/cli/internal/commands/ci"
 	clusterCmd "gitlab.com/gitlab-org/cli/internal/commands/cluster"
+	commitCmd "gitlab.com/gitlab-org/cli/internal/commands/commit"
 	completionCmd "gitlab.com/gitlab-org/cli/internal/commands/completion"
 	configCmd "gitlab.com/gitlab-org/cli/internal/commands/config"
 	deployKeyCmd "gitlab.com/gitlab-org/cli/internal/commands/deploy-key"
+	deploymentCmd "gitlab.com/gitlab-org/cli/internal/commands/deployment"
 	duoCmd "gitlab.com/gitlab-org/cli/internal/commands/duo"
+	environmentCmd "gitlab.com/gitlab-org/cli/internal/commands/environment"
+	epicCmd "gitlab.com/gitlab-org/cli/internal/commands/epic"
+	eventsCmd "gitlab.com/gitlab-org/cli/internal/commands/events"
+	fleetCmd "gitlab.com/gitlab-org/cli/internal/commands/fleet"
 	gpgCmd "gitlab.com/gitlab-org/cli/internal/commands/gpg-key"
 	"gitlab.com/gitlab-org/cli/internal/commands/help"
+	inboxCmd "gitlab.com/gitlab-org/cli/internal/commands/inbox"
 	incidentCmd "gitlab.com/gitlab-org/cli/internal/commands/incident"
 	issueCmd "gitlab.com/gitlab-org/cli/internal/commands/issue"
 	iterationCmd "gitlab.com/gitlab-org/cli/internal/commands/iteration"
@@ -29,23 +41,48 @@ import (
 	mcpCmd "gitlab.com/gitlab-org/cli/internal/commands/mcp"
 	milestoneCmd "gitlab.com/gitlab-org/cli/internal/commands/milestone"
 	mrCmd "gitlab.com/gitlab-org/cli/internal/commands/mr"
+	oncallCmd "gitlab.com/gitlab-org/cli/internal/commands/oncall"
 	opentofuCmd "gitlab.com/gitlab-org/cli/internal/commands/opentofu"
+	packageCmd "gitlab.com/gitlab-org/cli/internal/commands/packages"
 	projectCmd "gitlab.com/gitlab-org/cli/internal/commands/project"
+	registryCmd "gitlab.com/gitlab-org/cli/internal/commands/registry"
 	releaseCmd "gitlab.com/gitlab-org/cli/internal/commands/release"
 	scheduleCmd "gitlab.com/gitlab-org/cli/internal/commands/schedule"
 	securefileCmd "gitlab.com/gitlab-org/cli/internal/commands/securefile"
 	snippetCmd "gitlab.com/gitlab-org/cli/internal/commands/snippet"
+	snoozeCmd "gitlab.com/gitlab-org/cli/internal/commands/snooze"
 	sshCmd "gitlab.com/gitlab-org/cli/internal/commands/ssh-key"
 	stackCmd "gitlab.com/gitlab-org/cli/internal/commands/stack"
+	syncCmd "gitlab.com/gitlab-org/cli/internal/commands/sync"
+	tagCmd "gitlab.com/gitlab-org/cli/internal/commands/tag"
+	templateCmd "gitlab.com/gitlab-org/cli/internal/commands/template"
 	tokenCmd "gitlab.com/gitlab-org/cli/internal/commands/token"
 	updateCmd "gitlab.com/gitlab-org/cli/internal/commands/update"
 	userCmd "gitlab.com/gitlab-org/cli/internal/commands/user"
 	variableCmd "gitlab.com/gitlab-org/cli/internal/commands/variable"
 	versionCmd "gitlab.com/gitlab-org/cli/internal/commands/version"
+	webhookCmd "gitlab.com/gitlab-org/cli/internal/commands/webhook"
+	workItemCmd "gitlab.com/gitlab-org/cli/internal/commands/workitem"
 )
 
 // NewCmdRoot is the main root/parent command
 func NewCmdRoot(f cmdutils.Factory) *cobra.Command {
+	return newCmdRoot(f, commandGroups)
+}
+
+// NewCmdRootForArgs is like NewCmdRoot, but only builds the top-level command
+// named by the first argument, so that running one command doesn't pay for
+// building all the others. The whole command tree is built when the arguments
+// don't start with a command, like for --help or completion, or when the
+// command inspects the tree.
+func NewCmdRootForArgs(f cmdutils.Factory, args []string) *cobra.Command {
+	if g := findCommandGroup(args); g != nil && !g.needsTree {
+		return newCmdRoot(f, []commandGroup{*g})
+	}
+	return newCmdRoot(f, commandGroups)
+}
+
+func newCmdRoot(f cmdutils.Factory, groups []commandGroup) *cobra.Command {
 	c := f.IO().Color()
 	rootCmd := &cobra.Command{
 		Use:           "glab <command> <subcommand> [flags]",
@@ -73,6 +110,14 @@ func NewCmdRoot(f cmdutils.Factory) *cobra.Command {
 			avoid prompts to authenticate. Overrides any previously-stored credentials.
 			Can be set in the config with 'glab config set token xxxxxx'.
 
+			GLAB_CACHE_STALE_TTL: Set to a duration, such as 24h, to show expired cached responses
+			younger than it when GitLab can't be reached. Requires GLAB_CACHE_TTL.
+			Can be set in the config with 'glab config set cache_stale_ttl 24h'.
+
+			GLAB_CACHE_TTL: Set to a duration, such as 5m, to cache API responses of read commands.
+			Write commands clear the cache. Downloads and commands that wait for changes, like
+			'glab mr merge --wait', are not cached. Can be set in the config with 'glab config set cache_ttl 5m'.
+
 			GLAB_CHECK_UPDATE: Set to true to force an update check. By default the cli tool
 			checks for updates once a day.
 
@@ -84,6 +129,10 @@ func NewCmdRoot(f cmdutils.Factory) *cobra.Command {
 			Can be set in the config with 'glab config set telemetry false'.
 			See https://docs.gitlab.com/administration/settings/usage_statistics/ for more information
 
+			GLAB_USER_CACHE_TTL: Set to a duration, such as 24h, to cache the users looked up by
+			username, for filters like --assignee of 'glab mr list'.
+			Can be set in the config with 'glab config set user_cache_ttl 24h'.
+
 			GLAMOUR_STYLE: The environment variable to set your desired Markdown renderer style.
 			Available options: dark, light, notty. To set a custom style, read
 			https://github.com/charmbracelet/glamour#styles
@@ -120,6 +169,15 @@ func NewCmdRoot(f cmdutils.Factory) *cobra.Command {
 	rootCmd.SetErr(f.IO().StdErr)
 
 	rootCmd.PersistentFlags().BoolP("help", "h", false, "Show help for this command.")
+	// The flag sets the streams as soon as it's parsed, so the tables of any command use it.
+	rootCmd.PersistentFlags().BoolFunc("no-truncate", "Show the full contents of table columns, even when wider than the terminal.", func(value string) error {
+		noTruncate, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		f.IO().SetTruncateTables(!noTruncate)
+		return nil
+	})
 	rootCmd.SetHelpFunc(func(command *cobra.Command, args []string) {
 		help.RootHelpFunc(f.IO().Color(), command, args)
 	})
@@ -136,41 +194,7 @@ func NewCmdRoot(f cmdutils.Factory) *cobra.Command {
 	rootCmd.SetVersionTemplate(formattedVersion)
 	rootCmd.Version = formattedVersion
 
-	// Child commands
-	rootCmd.AddCommand(aliasCmd.NewCmdAlias(f))
-	rootCmd.AddCommand(configCmd.NewCmdConfig(f))
-	rootCmd.AddCommand(completionCmd.NewCmdCompletion(f.IO()))
-	rootCmd.AddCommand(versionCmd.NewCmdVersion(f))
-	rootCmd.AddCommand(updateCmd.NewCheckUpdateCmd(f))
-	rootCmd.AddCommand(authCmd.NewCmdAuth(f))
-
-	rootCmd.AddCommand(apiCmd.NewCmdApi(f, nil))
-	rootCmd.AddCommand(changelogCmd.NewCmdChangelog(f))
-	rootCmd.AddCommand(clusterCmd.NewCmdCluster(f))
-	rootCmd.AddCommand(deployKeyCmd.NewCmdDeployKey(f))
-	rootCmd.AddCommand(duoCmd.NewCmdDuo(f))
-	rootCmd.AddCommand(gpgCmd.NewCmdGPGKey(f))
-	rootCmd.AddCommand(incidentCmd.NewCmdIncident(f))
-	rootCmd.AddCommand(issueCmd.NewCmdIssue(f))
-	rootCmd.AddCommand(iterationCmd.NewCmdIteration(f))
-	rootCmd.AddCommand(jobCmd.NewCmdJob(f))
-	rootCmd.AddCommand(labelCmd.NewCmdLabel(f))
-	rootCmd.AddCommand(mcpCmd.NewCmdMCP(f))
-	rootCmd.AddCommand(milestoneCmd.NewCmdMilestone(f))
-	rootCmd.AddCommand(mrCmd.NewCmdMR(f))
-	rootCmd.AddCommand(opentofuCmd.NewCmd(f))
-	rootCmd.AddCommand(attestationCmd.NewCmdAttestation(f))
-	rootCmd.AddCommand(pipelineCmd.NewCmdCI(f))
-	rootCmd.AddCommand(projectCmd.NewCmdRepo(f))
-	rootCmd.AddCommand(releaseCmd.NewCmdRelease(f))
-	rootCmd.AddCommand(scheduleCmd.NewCmdSchedule(f))
-	rootCmd.AddCommand(securefileCmd.NewCmdSecurefile(f))
-	rootCmd.AddCommand(snippetCmd.NewCmdSnippet(f))
-	rootCmd.AddCommand(sshCmd.NewCmdSSHKey(f))
-	rootCmd.AddCommand(stackCmd.NewCmdStack(f))
-	rootCmd.AddCommand(tokenCmd.NewTokenCmd(f))
-	rootCmd.AddCommand(userCmd.NewCmdUser(f))
-	rootCmd.AddCommand(variableCmd.NewVariableCmd(f))
+	addCommandGroups(rootCmd, f, groups)
 
 	// TODO: This can probably be removed by GitLab 18.3
 	// See: https://gitlab.com/gitlab-org/cli/-/issues/7885
@@ -178,5 +202,111 @@ func NewCmdRoot(f cmdutils.Factory) *cobra.Command {
 	cmdutils.AddGlobalRepoOverride(rootCmd, f)
 
 	rootCmd.Flags().BoolP("version", "v", false, "show glab version information")
+	rootCmd.Flags().Bool("profile-startup", false, "Report the start-up cost of packages and commands.")
 	return rootCmd
 }
+
+// commandGroup is a top-level command, registered by the function that builds it.
+type commandGroup struct {
+	// names is the name of the command, followed by its aliases.
+	names []string
+	// needsTree is set for commands that look at the whole command tree.
+	needsTree bool
+	newCmd    func(f cmdutils.Factory) *cobra.Command
+}
+
+var commandGroups = []commandGroup{
+	{names: []string{"alias"}, needsTree: true, newCmd: aliasCmd.NewCmdAlias},
+	{names: []string{"config", "conf"}, newCmd: configCmd.NewCmdConfig},
+	{names: []string{"completion"}, needsTree: true, newCmd: func(f cmdutils.Factory) *cobra.Command {
+		return completionCmd.NewCmdCompletion(f.IO())
+	}},
+	{names: []string{"version", "v"}, newCmd: versionCmd.NewCmdVersion},
+	{names: []string{"check-update", "update"}, newCmd: updateCmd.NewCheckUpdateCmd},
+	{names: []string{"auth"}, newCmd: authCmd.NewCmdAuth},
+
+	{names: []string{"api"}, newCmd: func(f cmdutils.Factory) *cobra.Command {
+		return apiCmd.NewCmdApi(f, nil)
+	}},
+	{names: []string{"alert"}, newCmd: alertCmd.NewCmdAlert},
+	{names: []string{"branch"}, newCmd: branchCmd.NewCmdBranch},
+	{names: []string{"changelog"}, newCmd: changelogCmd.NewCmdChangelog},
+	{names: []string{"cluster"}, newCmd: clusterCmd.NewCmdCluster},
+	{names: []string{"commit"}, newCmd: commitCmd.NewCmdCommit},
+	{names: []string{"deploy-key"}, newCmd: deployKeyCmd.NewCmdDeployKey},
+	{names: []string{"deployment", "deploy"}, newCmd: deploymentCmd.NewCmdDeployment},
+	{names: []string{"duo"}, newCmd: duoCmd.NewCmdDuo},
+	{names: []string{"environment", "env"}, newCmd: environmentCmd.NewCmdEnvironment},
+	{names: []string{"epic"}, newCmd: epicCmd.NewCmdEpic},
+	{names: []string{"events"}, newCmd: eventsCmd.NewCmdEvents},
+	{names: []string{"fleet"}, newCmd: fleetCmd.NewCmdFleet},
+	{names: []string{"gpg-key"}, newCmd: gpgCmd.NewCmdGPGKey},
+	{names: []string{"inbox"}, newCmd: inboxCmd.NewCmdInbox},
+	{names: []string{"incident"}, newCmd: incidentCmd.NewCmdIncident},
+	{names: []string{"issue"}, newCmd: issueCmd.NewCmdIssue},
+	{names: []string{"iteration"}, newCmd: iterationCmd.NewCmdIteration},
+	{names: []string{"job"}, newCmd: jobCmd.NewCmdJob},
+	{names: []string{"label"}, newCmd: labelCmd.NewCmdLabel},
+	{names: []string{"mcp"}, needsTree: true, newCmd: mcpCmd.NewCmdMCP},
+	{names: []string{"milestone"}, newCmd: milestoneCmd.NewCmdMilestone},
+	{names: []string{"mr"}, newCmd: mrCmd.NewCmdMR},
+	{names: []string{"oncall"}, newCmd: oncallCmd.NewCmdOncall},
+	{names: []string{"opentofu", "terraform", "tf"}, newCmd: opentofuCmd.NewCmd},
+	{names: []string{"package", "packages", "pkg"}, newCmd: packageCmd.NewCmdPackage},
+	{names: []string{"attestation"}, newCmd: attestationCmd.NewCmdAttestation},
+	{names: []string{"ci", "pipe", "pipeline"}, newCmd: pipelineCmd.NewCmdCI},
+	{names: []string{"repo", "project"}, newCmd: projectCmd.NewCmdRepo},
+	{names: []string{"registry"}, newCmd: registryCmd.NewCmdRegistry},
+	{names: []string{"release"}, newCmd: releaseCmd.NewCmdRelease},
+	{names: []string{"schedule", "sched", "skd"}, newCmd: scheduleCmd.NewCmdSchedule},
+	{names: []string{"securefile"}, newCmd: securefileCmd.NewCmdSecurefile},
+	{names: []string{"snippet"}, newCmd: snippetCmd.NewCmdSnippet},
+	{names: []string{"snooze"}, newCmd: snoozeCmd.NewCmdSnooze},
+	{names: []string{"ssh-key", "keys"}, newCmd: sshCmd.NewCmdSSHKey},
+	{names: []string{"stack", "stacks"}, newCmd: stackCmd.NewCmdStack},
+	{names: []string{"sync"}, newCmd: syncCmd.NewCmdSync},
+	{names: []string{"tag"}, newCmd: tagCmd.NewCmdTag},
+	{names: []string{"template"}, newCmd: templateCmd.NewCmdTemplate},
+	{names: []string{"token"}, newCmd: tokenCmd.NewTokenCmd},
+	{names: []string{"user"}, newCmd: userCmd.NewCmdUser},
+	{names: []string{"variable", "var"}, newCmd: variableCmd.NewVariableCmd},
+	{names: []string{"webhook"}, newCmd: webhookCmd.NewCmdWebhook},
+	{names: []string{"workitem", "work-item"}, newCmd: workItemCmd.NewCmdWorkItem},
+}
+
+func addCommandGroups(rootCmd *cobra.Command, f cmdutils.Factory, groups []commandGroup) {
+	for _, g := range groups {
+		rootCmd.AddCommand(g.newCmd(f))
+	}
+}
+
+// findCommandGroup returns the group named by the first argument, or nil if the
+// arguments start with a flag or an unknown command.
+func findCommandGroup(args []string) *commandGroup {
+	if len(args) == 0 {
+		return nil
+	}
+	for i := range commandGroups {
+		if slices.Contains(commandGroups[i].names, args[0]) {
+			return &commandGroups[i]
+		}
+	}
+	return nil
+}
+
+// CommandGroupTiming is how long it took to build a top-level command.
+type CommandGroupTiming struct {
+	Name     string
+	Duration time.Duration
+}
+
+// ProfileCommandGroups builds every top-level command and reports how long each took.
+func ProfileCommandGroups(f cmdutils.Factory) []CommandGroupTiming {
+	timings := make([]CommandGroupTiming, 0, len(commandGroups))
+	for _, g := range commandGroups {
+		start := time.Now()
+		g.newCmd(f)
+		timings = append(timings, CommandGroupTiming{Name: g.names[0], Duration: time.Since(start)})
+	}
+	return timings
+}
@@ -57,3 +57,38 @@ func TestRootNoArg(t *testing.T) {
 
 CORE COMMANDS`)
 }
+
+func TestCommandGroupsMatchCommandNames(t *testing.T) {
+	f := cmdutils.NewFactory(setupIOStreams(), false, config.NewBlankConfig(), api.BuildInfo{})
+
+	for _, g := range commandGroups {
+		cmd := g.newCmd(f)
+		assert.Equal(t, cmd.Name(), g.names[0])
+		assert.Subset(t, g.names, cmd.Aliases)
+	}
+}
+
+func TestRootForArgs(t *testing.T) {
+	f := cmdutils.NewFactory(setupIOStreams(), false, config.NewBlankConfig(), api.BuildInfo{})
+
+	tests := []struct {
+		name         string
+		args         []string
+		wantCommands int
+	}{
+		{name: "command", args: []string{"mr", "list"}, wantCommands: 1},
+		{name: "command alias", args: []string{"pipe", "list"}, wantCommands: 1},
+		{name: "no args", args: nil, wantCommands: len(commandGroups)},
+		{name: "flag", args: []string{"--help"}, wantCommands: len(commandGroups)},
+		{name: "unknown command", args: []string{"co", "123"}, wantCommands: len(commandGroups)},
+		{name: "command that needs the tree", args: []string{"alias", "set"}, wantCommands: len(commandGroups)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rootCmd := NewCmdRootForArgs(f, tt.args)
+
+			assert.Len(t, rootCmd.Commands(), tt.wantCommands)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package actions
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	actionsCancelCmd "gitlab.com/gitlab-org/cli/internal/commands/schedule/actions/cancel"
+	actionsGenerateCmd "gitlab.com/gitlab-org/cli/internal/commands/schedule/actions/generate"
+	actionsListCmd "gitlab.com/gitlab-org/cli/internal/commands/schedule/actions/list"
+	actionsRunCmd "gitlab.com/gitlab-org/cli/internal/commands/schedule/actions/run"
+)
+
+func NewCmdActions(f cmdutils.Factory) *cobra.Command {
+	actionsCmd := &cobra.Command{
+		Use:   "actions <command> [flags]",
+		Short: `Run and manage actions scheduled for later, such as merges.`,
+		Long: heredoc.Doc(`
+			Actions scheduled for later, such as merges scheduled with 'glab mr merge --at', are stored
+			locally and run by 'glab schedule actions run'. They don't run while 'glab schedule actions run'
+			is not running.
+
+			Unlike pipeline schedules, scheduled actions don't belong to a repository.
+		`),
+	}
+
+	actionsCmd.AddCommand(actionsRunCmd.NewCmdRun(f))
+	actionsCmd.AddCommand(actionsListCmd.NewCmdList(f))
+	actionsCmd.AddCommand(actionsCancelCmd.NewCmdCancel(f))
+	actionsCmd.AddCommand(actionsGenerateCmd.NewCmdGenerate(f))
+	return actionsCmd
+}
@@ -0,0 +1,60 @@
+package cancel
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/scheduler"
+)
+
+type options struct {
+	id int
+
+	io *iostreams.IOStreams
+}
+
+func NewCmdCancel(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io: f.IO(),
+	}
+
+	actionsCancelCmd := &cobra.Command{
+		Use:     "cancel <id>",
+		Short:   `Cancel a scheduled action.`,
+		Aliases: []string{"delete"},
+		Example: heredoc.Doc(`
+			$ glab schedule actions cancel 3
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				return &cmdutils.FlagError{Err: errors.New("the ID of a scheduled action must be a number.")}
+			}
+			opts.id = id
+
+			return opts.run()
+		},
+	}
+
+	return actionsCancelCmd
+}
+
+func (o *options) run() error {
+	if err := scheduler.Remove(o.id); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Cancelled scheduled action %d.\n", o.io.Color().RedCheck(), o.id)
+	return nil
+}
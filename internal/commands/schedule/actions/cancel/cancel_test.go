@@ -0,0 +1,35 @@
+//go:build !integration
+
+package cancel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/scheduler"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestSchedulerCancel(t *testing.T) {
+	t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+	_, err := scheduler.Add(&scheduler.Action{Type: scheduler.ActionMergeMR, Repo: "OWNER/REPO", MRIID: 1})
+	require.NoError(t, err)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdCancel, false)
+
+	out, err := exec("1")
+	require.NoError(t, err)
+	assert.Equal(t, "✓ Cancelled scheduled action 1.\n", out.String())
+
+	actions, err := scheduler.Load()
+	require.NoError(t, err)
+	assert.Empty(t, actions)
+
+	_, err = exec("1")
+	assert.EqualError(t, err, "no scheduled action with ID 1.")
+
+	_, err = exec("first")
+	assert.EqualError(t, err, "the ID of a scheduled action must be a number.")
+}
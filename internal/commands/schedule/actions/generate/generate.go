@@ -0,0 +1,99 @@
+package generate
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+// executable returns the path of the running glab binary. Tests can replace it.
+var executable = os.Executable
+
+const systemdTemplate = `# Save as ~/.config/systemd/user/glab-scheduler.service, then run:
+#   systemctl --user daemon-reload
+#   systemctl --user enable --now glab-scheduler.service
+[Unit]
+Description=Run actions scheduled with glab
+
+[Service]
+ExecStart=%s schedule actions run
+Restart=on-failure
+%s
+[Install]
+WantedBy=default.target
+`
+
+const cronTemplate = `# Add this line with 'crontab -e' to run due actions every minute.
+%s* * * * * %s schedule actions run --once
+`
+
+type options struct {
+	format string
+
+	io *iostreams.IOStreams
+}
+
+func NewCmdGenerate(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io: f.IO(),
+	}
+
+	actionsGenerateCmd := &cobra.Command{
+		Use:   "generate {systemd | cron}",
+		Short: `Print a systemd unit or crontab entry that runs scheduled actions.`,
+		Long: heredoc.Doc(`
+			Print a systemd user unit or a crontab entry that runs 'glab schedule actions run', so scheduled
+			actions run without keeping a terminal open. The output is not installed automatically.
+		`),
+		Example: heredoc.Doc(`
+			$ glab schedule actions generate systemd > ~/.config/systemd/user/glab-scheduler.service
+			$ glab schedule actions generate cron
+		`),
+		Args:      cobra.ExactArgs(1),
+		ValidArgs: []string{"systemd", "cron"},
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.format = args[0]
+			return opts.run()
+		},
+	}
+
+	return actionsGenerateCmd
+}
+
+func (o *options) run() error {
+	glab, err := executable()
+	if err != nil {
+		return fmt.Errorf("failed to find the glab executable: %w", err)
+	}
+
+	// Scheduled actions are stored in the config directory, so a custom one must be passed on.
+	configDir := os.Getenv("GLAB_CONFIG_DIR")
+
+	switch o.format {
+	case "systemd":
+		env := ""
+		if configDir != "" {
+			env = fmt.Sprintf("Environment=GLAB_CONFIG_DIR=%s\n", configDir)
+		}
+		fmt.Fprintf(o.io.StdOut, systemdTemplate, glab, env)
+	case "cron":
+		env := ""
+		if configDir != "" {
+			env = fmt.Sprintf("GLAB_CONFIG_DIR=%s\n", configDir)
+		}
+		fmt.Fprintf(o.io.StdOut, cronTemplate, env, glab)
+	default:
+		return &cmdutils.FlagError{Err: fmt.Errorf("unsupported format %q. Use systemd or cron.", o.format)}
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+//go:build !integration
+
+package generate
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestSchedulerGenerate(t *testing.T) {
+	executable = func() (string, error) { return "/usr/bin/glab", nil }
+	t.Cleanup(func() { executable = os.Executable })
+
+	tests := []struct {
+		name      string
+		cli       string
+		configDir string
+		want      []string
+		wantErr   string
+	}{
+		{
+			name: "systemd",
+			cli:  "systemd",
+			want: []string{"ExecStart=/usr/bin/glab schedule actions run\n", "WantedBy=default.target"},
+		},
+		{
+			name:      "systemd with a custom config directory",
+			cli:       "systemd",
+			configDir: "/etc/glab",
+			want:      []string{"Environment=GLAB_CONFIG_DIR=/etc/glab\n"},
+		},
+		{
+			name:      "cron",
+			cli:       "cron",
+			configDir: "/etc/glab",
+			want:      []string{"GLAB_CONFIG_DIR=/etc/glab\n* * * * * /usr/bin/glab schedule actions run --once\n"},
+		},
+		{
+			name:    "Unsupported format",
+			cli:     "launchd",
+			wantErr: `unsupported format "launchd". Use systemd or cron.`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("GLAB_CONFIG_DIR", tt.configDir)
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdGenerate, false)
+			out, err := exec(tt.cli)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			for _, w := range tt.want {
+				assert.Contains(t, out.String(), w)
+			}
+		})
+	}
+}
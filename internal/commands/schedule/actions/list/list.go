@@ -0,0 +1,84 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/scheduler"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+)
+
+type options struct {
+	outputFormat string
+
+	io *iostreams.IOStreams
+}
+
+func NewCmdList(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io: f.IO(),
+	}
+
+	actionsListCmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   `List pending scheduled actions.`,
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			$ glab schedule actions list
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+
+	actionsListCmd.Flags().VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+
+	return actionsListCmd
+}
+
+func (o *options) run() error {
+	actions, err := scheduler.Load()
+	if err != nil {
+		return err
+	}
+
+	if o.outputFormat == "json" {
+		if actions == nil {
+			actions = []*scheduler.Action{}
+		}
+		actionsJSON, _ := json.Marshal(actions)
+		fmt.Fprintln(o.io.StdOut, string(actionsJSON))
+		return nil
+	}
+
+	if len(actions) == 0 {
+		o.io.LogInfof("No scheduled actions.\n")
+		return nil
+	}
+
+	c := o.io.Color()
+	table := tableprinter.New(o.io)
+	table.AddHeader("ID", "ACTION", "TARGET", "DUE", "STATUS")
+	for _, a := range actions {
+		status := c.Gray("pending")
+		if a.LastError != "" {
+			status = c.Yellow(a.LastError)
+		}
+		table.AddRow(strconv.Itoa(a.ID), a.Type, a.Target(), a.At.Local().Format(time.RFC1123), status)
+	}
+	fmt.Fprint(o.io.StdOut, table.String())
+
+	return nil
+}
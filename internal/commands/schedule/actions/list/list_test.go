@@ -0,0 +1,51 @@
+//go:build !integration
+
+package list
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/scheduler"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestSchedulerList(t *testing.T) {
+	t.Run("No actions", func(t *testing.T) {
+		t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdList, false)
+		out, err := exec("")
+		require.NoError(t, err)
+		assert.Equal(t, "No scheduled actions.\n", out.String())
+
+		exec = cmdtest.SetupCmdForTest(t, NewCmdList, false)
+		out, err = exec("-F json")
+		require.NoError(t, err)
+		assert.Equal(t, "[]\n", out.String())
+	})
+
+	t.Run("Pending actions", func(t *testing.T) {
+		t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+		at := time.Date(2999, 6, 1, 9, 0, 0, 0, time.UTC)
+		_, err := scheduler.Add(&scheduler.Action{Type: scheduler.ActionMergeMR, Repo: "OWNER/REPO", MRIID: 1, At: at})
+		require.NoError(t, err)
+		_, err = scheduler.Add(&scheduler.Action{Type: scheduler.ActionMergeMR, Repo: "OWNER/REPO", MRIID: 2, At: at, LastError: "not approved"})
+		require.NoError(t, err)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdList, false)
+		out, err := exec("")
+		require.NoError(t, err)
+		assert.Contains(t, out.String(), "ID\tACTION\tTARGET\tDUE\tSTATUS")
+		assert.Regexp(t, `1\tmr-merge\tOWNER/REPO!1\s*\t.*2999.*\tpending`, out.String())
+		assert.Regexp(t, `2\tmr-merge\tOWNER/REPO!2\s*\t.*2999.*\tnot approved`, out.String())
+
+		exec = cmdtest.SetupCmdForTest(t, NewCmdList, false)
+		out, err = exec("-F json")
+		require.NoError(t, err)
+		assert.Contains(t, out.String(), `"mr_iid":2`)
+	})
+}
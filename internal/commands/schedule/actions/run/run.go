@@ -0,0 +1,213 @@
+package run
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/scheduler"
+)
+
+type outcome int
+
+const (
+	outcomeDone outcome = iota
+	outcomeDropped
+	outcomeWaiting
+)
+
+type options struct {
+	once     bool
+	interval time.Duration
+
+	io        *iostreams.IOStreams
+	apiClient func(repoHost string) (*api.Client, error)
+}
+
+func NewCmdRun(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:        f.IO(),
+		apiClient: f.ApiClient,
+	}
+
+	actionsRunCmd := &cobra.Command{
+		Use:   "run [flags]",
+		Short: `Run scheduled actions when they are due.`,
+		Long: heredoc.Doc(`
+			Run scheduled actions, such as merges scheduled with 'glab mr merge --at', when they are due.
+
+			The command keeps running and checks for due actions at each interval. Use --once to check only
+			once, for example from cron.
+
+			Before merging, the state of the merge request is checked. A merge request that is not yet
+			mergeable, for example because it is not approved or its pipeline is still running, is checked
+			again at the next interval. A scheduled merge is dropped when the merge request is closed or
+			its pipeline failed, or when GitLab rejects the merge for good, for example because the
+			merge request no longer exists or you are not allowed to merge it.
+		`),
+		Example: heredoc.Doc(`
+			# Run due actions every minute until interrupted
+			$ glab schedule actions run
+
+			# Run due actions once
+			$ glab schedule actions run --once
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run(cmd.Context())
+		},
+	}
+
+	fl := actionsRunCmd.Flags()
+	fl.BoolVar(&opts.once, "once", false, "Run due actions once and exit.")
+	fl.DurationVar(&opts.interval, "interval", time.Minute, "Time between two checks for due actions.")
+
+	return actionsRunCmd
+}
+
+func (o *options) run(ctx context.Context) error {
+	for {
+		if err := o.runDue(); err != nil {
+			return err
+		}
+		if o.once {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(o.interval):
+		}
+	}
+}
+
+func (o *options) runDue() error {
+	actions, err := scheduler.Load()
+	if err != nil {
+		return err
+	}
+
+	c := o.io.Color()
+	for _, action := range actions {
+		if !action.Due() {
+			continue
+		}
+
+		result, reason := o.execute(action)
+		switch result {
+		case outcomeDone:
+			fmt.Fprintf(o.io.StdOut, "%s %s %s.\n", c.GreenCheck(), reason, action.Target())
+		case outcomeDropped:
+			fmt.Fprintf(o.io.StdOut, "%s Dropped scheduled action %d for %s: %s.\n", c.FailedIcon(), action.ID, action.Target(), reason)
+		case outcomeWaiting:
+			// Only report changes, so a long wait doesn't print the same line at every interval.
+			if reason != action.LastError {
+				fmt.Fprintf(o.io.StdOut, "%s Waiting to run scheduled action %d for %s: %s.\n", c.WarnIcon(), action.ID, action.Target(), reason)
+				action.LastError = reason
+				if err := scheduler.Update(action); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if err := scheduler.Remove(action.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// execute runs the action and returns its outcome with a reason, or a past-tense verb on success.
+func (o *options) execute(action *scheduler.Action) (outcome, string) {
+	if action.Type != scheduler.ActionMergeMR {
+		return outcomeDropped, fmt.Sprintf("unknown action type %q", action.Type)
+	}
+
+	apiClient, err := o.apiClient(action.Host)
+	if err != nil {
+		return outcomeWaiting, err.Error()
+	}
+	client := apiClient.Lab()
+
+	mr, _, err := client.MergeRequests.GetMergeRequest(action.Repo, action.MRIID, &gitlab.GetMergeRequestsOptions{}, api.WithoutCache())
+	if err != nil {
+		if isPermanent(err) {
+			return outcomeDropped, fmt.Sprintf("could not get the merge request: %s", err)
+		}
+		return outcomeWaiting, err.Error()
+	}
+
+	switch {
+	case mr.State == "merged":
+		return outcomeDropped, "the merge request is already merged"
+	case mr.State == "closed":
+		return outcomeDropped, "the merge request is closed"
+	case mr.HeadPipeline != nil && (mr.HeadPipeline.Status == "failed" || mr.HeadPipeline.Status == "canceled"):
+		return outcomeDropped, fmt.Sprintf("the pipeline %s", mr.HeadPipeline.Status)
+	case mr.DetailedMergeStatus != "mergeable":
+		return outcomeWaiting, strings.ReplaceAll(mr.DetailedMergeStatus, "_", " ")
+	}
+
+	mergeOpts := &gitlab.AcceptMergeRequestOptions{}
+	if m := action.Merge; m != nil {
+		if m.Squash {
+			mergeOpts.Squash = gitlab.Ptr(true)
+		}
+		if m.RemoveSourceBranch {
+			mergeOpts.ShouldRemoveSourceBranch = gitlab.Ptr(true)
+		}
+		if m.SquashMessage != "" {
+			mergeOpts.SquashCommitMessage = gitlab.Ptr(m.SquashMessage)
+		}
+		if m.MergeCommitMessage != "" {
+			mergeOpts.MergeCommitMessage = gitlab.Ptr(m.MergeCommitMessage)
+		}
+		if m.SHA != "" {
+			mergeOpts.SHA = gitlab.Ptr(m.SHA)
+		}
+	}
+
+	if _, _, err := client.MergeRequests.AcceptMergeRequest(action.Repo, action.MRIID, mergeOpts); err != nil {
+		if isPermanent(err) {
+			return outcomeDropped, fmt.Sprintf("could not merge: %s", err)
+		}
+		return outcomeWaiting, err.Error()
+	}
+	return outcomeDone, "Merged"
+}
+
+// isPermanent reports whether a request that failed with err fails the same way when retried,
+// like when the merge request was deleted, the user may not merge it, or the source branch
+// changed since the merge was scheduled with --sha. The 405 response of a merge request that
+// can't be merged yet is not permanent: the state of the merge request is checked again.
+func isPermanent(err error) bool {
+	var errResp *gitlab.ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response == nil {
+		return false
+	}
+
+	switch code := errResp.Response.StatusCode; code {
+	case http.StatusMethodNotAllowed, http.StatusRequestTimeout, http.StatusTooManyRequests:
+		return false
+	default:
+		return code >= http.StatusBadRequest && code < http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,220 @@
+//go:build !integration
+
+package run
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/scheduler"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func openMR(status string, pipeline *gitlab.Pipeline) *gitlab.MergeRequest {
+	return &gitlab.MergeRequest{
+		BasicMergeRequest: gitlab.BasicMergeRequest{
+			IID:                 1,
+			State:               "opened",
+			DetailedMergeStatus: status,
+		},
+		HeadPipeline: pipeline,
+	}
+}
+
+const (
+	mrURL    = "https://gitlab.com/api/v4/projects/OWNER%2FREPO/merge_requests/1"
+	mergeURL = mrURL + "/merge"
+)
+
+func errorResponse(method, url string, code int, message string) error {
+	req, _ := http.NewRequest(method, url, nil)
+	return &gitlab.ErrorResponse{Response: &http.Response{StatusCode: code, Request: req}, Message: message}
+}
+
+func TestSchedulerRun(t *testing.T) {
+	tests := []struct {
+		name        string
+		at          time.Time
+		setupMock   func(tc *gitlabtesting.TestClient)
+		wantOut     string
+		wantPending bool
+		wantStatus  string
+	}{
+		{
+			name: "Merge a due merge request",
+			at:   time.Now().Add(-time.Minute),
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockMergeRequests.EXPECT().GetMergeRequest("OWNER/REPO", int64(1), gomock.Any(), gomock.Any()).
+					Return(openMR("mergeable", &gitlab.Pipeline{Status: "success"}), nil, nil)
+				tc.MockMergeRequests.EXPECT().AcceptMergeRequest("OWNER/REPO", int64(1), gomock.Any()).
+					DoAndReturn(func(_ any, _ int64, opts *gitlab.AcceptMergeRequestOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error) {
+						assert.Equal(t, gitlab.Ptr(true), opts.Squash)
+						assert.Equal(t, gitlab.Ptr("abc123"), opts.SHA)
+						return &gitlab.MergeRequest{}, nil, nil
+					})
+			},
+			wantOut: "✓ Merged OWNER/REPO!1.\n",
+		},
+		{
+			name:        "Skip actions that are not due",
+			at:          time.Now().Add(time.Hour),
+			wantPending: true,
+		},
+		{
+			name: "Wait for approval",
+			at:   time.Now().Add(-time.Minute),
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockMergeRequests.EXPECT().GetMergeRequest("OWNER/REPO", int64(1), gomock.Any(), gomock.Any()).
+					Return(openMR("not_approved", nil), nil, nil)
+			},
+			wantOut:     "! Waiting to run scheduled action 1 for OWNER/REPO!1: not approved.\n",
+			wantPending: true,
+			wantStatus:  "not approved",
+		},
+		{
+			name: "Drop when the pipeline failed",
+			at:   time.Now().Add(-time.Minute),
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockMergeRequests.EXPECT().GetMergeRequest("OWNER/REPO", int64(1), gomock.Any(), gomock.Any()).
+					Return(openMR("ci_must_pass", &gitlab.Pipeline{Status: "failed"}), nil, nil)
+			},
+			wantOut: "x Dropped scheduled action 1 for OWNER/REPO!1: the pipeline failed.\n",
+		},
+		{
+			name: "Drop when the merge is forbidden",
+			at:   time.Now().Add(-time.Minute),
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockMergeRequests.EXPECT().GetMergeRequest("OWNER/REPO", int64(1), gomock.Any(), gomock.Any()).
+					Return(openMR("mergeable", nil), nil, nil)
+				tc.MockMergeRequests.EXPECT().AcceptMergeRequest("OWNER/REPO", int64(1), gomock.Any()).
+					Return(nil, nil, errorResponse(http.MethodPut, mergeURL, http.StatusForbidden, "403 Forbidden"))
+			},
+			wantOut: "x Dropped scheduled action 1 for OWNER/REPO!1: could not merge: PUT https://gitlab.com/api/v4/projects/OWNER%2FREPO/merge_requests/1/merge: 403 403 Forbidden.\n",
+		},
+		{
+			name: "Drop when the merge request no longer exists",
+			at:   time.Now().Add(-time.Minute),
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockMergeRequests.EXPECT().GetMergeRequest("OWNER/REPO", int64(1), gomock.Any(), gomock.Any()).
+					Return(nil, nil, errorResponse(http.MethodGet, mrURL, http.StatusNotFound, "404 Not found"))
+			},
+			wantOut: "x Dropped scheduled action 1 for OWNER/REPO!1: could not get the merge request: GET https://gitlab.com/api/v4/projects/OWNER%2FREPO/merge_requests/1: 404 404 Not found.\n",
+		},
+		{
+			name: "Retry when the merge request can't be merged yet",
+			at:   time.Now().Add(-time.Minute),
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockMergeRequests.EXPECT().GetMergeRequest("OWNER/REPO", int64(1), gomock.Any(), gomock.Any()).
+					Return(openMR("mergeable", nil), nil, nil)
+				tc.MockMergeRequests.EXPECT().AcceptMergeRequest("OWNER/REPO", int64(1), gomock.Any()).
+					Return(nil, nil, errorResponse(http.MethodPut, mergeURL, http.StatusMethodNotAllowed, "405 Method Not Allowed"))
+			},
+			wantOut:     "! Waiting to run scheduled action 1 for OWNER/REPO!1: PUT https://gitlab.com/api/v4/projects/OWNER%2FREPO/merge_requests/1/merge: 405 405 Method Not Allowed.\n",
+			wantPending: true,
+			wantStatus:  "PUT https://gitlab.com/api/v4/projects/OWNER%2FREPO/merge_requests/1/merge: 405 405 Method Not Allowed",
+		},
+		{
+			name: "Retry after a server error",
+			at:   time.Now().Add(-time.Minute),
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockMergeRequests.EXPECT().GetMergeRequest("OWNER/REPO", int64(1), gomock.Any(), gomock.Any()).
+					Return(nil, nil, errorResponse(http.MethodGet, mrURL, http.StatusBadGateway, "502 Bad Gateway"))
+			},
+			wantOut:     "! Waiting to run scheduled action 1 for OWNER/REPO!1: GET https://gitlab.com/api/v4/projects/OWNER%2FREPO/merge_requests/1: 502 502 Bad Gateway.\n",
+			wantPending: true,
+			wantStatus:  "GET https://gitlab.com/api/v4/projects/OWNER%2FREPO/merge_requests/1: 502 502 Bad Gateway",
+		},
+		{
+			name: "Drop when the merge request is closed",
+			at:   time.Now().Add(-time.Minute),
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				mr := openMR("not_open", nil)
+				mr.State = "closed"
+				tc.MockMergeRequests.EXPECT().GetMergeRequest("OWNER/REPO", int64(1), gomock.Any(), gomock.Any()).
+					Return(mr, nil, nil)
+			},
+			wantOut: "x Dropped scheduled action 1 for OWNER/REPO!1: the merge request is closed.\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+			_, err := scheduler.Add(&scheduler.Action{
+				Type:  scheduler.ActionMergeMR,
+				Host:  "gitlab.com",
+				Repo:  "OWNER/REPO",
+				MRIID: 1,
+				At:    tt.at,
+				Merge: &scheduler.MergeOptions{Squash: true, SHA: "abc123"},
+			})
+			require.NoError(t, err)
+
+			testClient := gitlabtesting.NewTestClient(t)
+			if tt.setupMock != nil {
+				tt.setupMock(testClient)
+			}
+			apiClient, err := api.NewClient(
+				func(*http.Client) (gitlab.AuthSource, error) {
+					return gitlab.AccessTokenAuthSource{Token: "test-token"}, nil
+				},
+				api.WithGitLabClient(testClient.Client),
+			)
+			require.NoError(t, err)
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdRun, false, cmdtest.WithApiClient(apiClient))
+
+			out, err := exec("--once")
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOut, out.String())
+
+			actions, err := scheduler.Load()
+			require.NoError(t, err)
+			if !tt.wantPending {
+				assert.Empty(t, actions)
+				return
+			}
+			require.Len(t, actions, 1)
+			assert.Equal(t, tt.wantStatus, actions[0].LastError)
+		})
+	}
+}
+
+func TestSchedulerRun_ReportsWaitingOnlyOnChange(t *testing.T) {
+	t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+	_, err := scheduler.Add(&scheduler.Action{
+		Type:      scheduler.ActionMergeMR,
+		Host:      "gitlab.com",
+		Repo:      "OWNER/REPO",
+		MRIID:     1,
+		At:        time.Now().Add(-time.Minute),
+		LastError: "ci still running",
+	})
+	require.NoError(t, err)
+
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockMergeRequests.EXPECT().GetMergeRequest("OWNER/REPO", int64(1), gomock.Any(), gomock.Any()).
+		Return(openMR("ci_still_running", &gitlab.Pipeline{Status: "running"}), nil, nil)
+	apiClient, err := api.NewClient(
+		func(*http.Client) (gitlab.AuthSource, error) {
+			return gitlab.AccessTokenAuthSource{Token: "test-token"}, nil
+		},
+		api.WithGitLabClient(testClient.Client),
+	)
+	require.NoError(t, err)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdRun, false, cmdtest.WithApiClient(apiClient))
+
+	out, err := exec("--once")
+	require.NoError(t, err)
+	assert.Empty(t, out.String())
+}
@@ -39,8 +39,9 @@ func NewCmdRun(f cmdutils.Factory) *cobra.Command {
 		baseRepo:     f.BaseRepo,
 	}
 	scheduleRunCmd := &cobra.Command{
-		Use:   "run <id>",
-		Short: `Run the specified scheduled pipeline.`,
+		Use:     "run <id>",
+		Short:   `Run the specified scheduled pipeline.`,
+		Aliases: []string{"run-now"},
 		Example: heredoc.Doc(`
 			# Run a scheduled pipeline with ID 1
 			$ glab schedule run 1
@@ -4,17 +4,20 @@ import (
 	"github.com/spf13/cobra"
 
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	scheduleActionsCmd "gitlab.com/gitlab-org/cli/internal/commands/schedule/actions"
 	scheduleCreateCmd "gitlab.com/gitlab-org/cli/internal/commands/schedule/create"
 	scheduleDeleteCmd "gitlab.com/gitlab-org/cli/internal/commands/schedule/delete"
 	scheduleListCmd "gitlab.com/gitlab-org/cli/internal/commands/schedule/list"
 	scheduleRunCmd "gitlab.com/gitlab-org/cli/internal/commands/schedule/run"
+	scheduleTakeOwnershipCmd "gitlab.com/gitlab-org/cli/internal/commands/schedule/takeownership"
 	scheduleUpdateCmd "gitlab.com/gitlab-org/cli/internal/commands/schedule/update"
+	scheduleVariableCmd "gitlab.com/gitlab-org/cli/internal/commands/schedule/variable"
 )
 
 func NewCmdSchedule(f cmdutils.Factory) *cobra.Command {
 	scheduleCmd := &cobra.Command{
 		Use:     "schedule <command> [flags]",
-		Short:   `Work with GitLab CI/CD schedules.`,
+		Short:   `Work with GitLab CI/CD schedules and actions scheduled for later.`,
 		Long:    ``,
 		Aliases: []string{"sched", "skd"},
 	}
@@ -26,6 +29,9 @@ func NewCmdSchedule(f cmdutils.Factory) *cobra.Command {
 	scheduleCmd.AddCommand(scheduleCreateCmd.NewCmdCreate(f))
 	scheduleCmd.AddCommand(scheduleDeleteCmd.NewCmdDelete(f))
 	scheduleCmd.AddCommand(scheduleUpdateCmd.NewCmdUpdate(f))
+	scheduleCmd.AddCommand(scheduleTakeOwnershipCmd.NewCmdTakeOwnership(f))
+	scheduleCmd.AddCommand(scheduleVariableCmd.NewCmdVariable(f))
+	scheduleCmd.AddCommand(scheduleActionsCmd.NewCmdActions(f))
 
 	return scheduleCmd
 }
@@ -0,0 +1,89 @@
+package takeownership
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	scheduleID int64
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdTakeOwnership(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	scheduleTakeOwnershipCmd := &cobra.Command{
+		Use:   "take-ownership <id>",
+		Short: `Take ownership of a pipeline schedule.`,
+		Long: heredoc.Doc(`
+			Take ownership of a pipeline schedule.
+
+			Scheduled pipelines run as the owner of the schedule, so take ownership of
+			the schedules of people who left the project.
+		`),
+		Example: heredoc.Doc(`
+			# Take ownership of the scheduled pipeline with ID 10
+			$ glab schedule take-ownership 10
+			> Took ownership of schedule with ID 10
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.complete(args); err != nil {
+				return err
+			}
+
+			return opts.run()
+		},
+	}
+	return scheduleTakeOwnershipCmd
+}
+
+func (o *options) complete(args []string) error {
+	id, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return err
+	}
+	o.scheduleID = int64(id)
+
+	return nil
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	_, _, err = client.PipelineSchedules.TakeOwnershipOfPipelineSchedule(repo.FullName(), o.scheduleID)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(o.io.StdOut, "Took ownership of schedule with ID", o.scheduleID)
+
+	return nil
+}
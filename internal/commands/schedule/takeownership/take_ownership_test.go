@@ -0,0 +1,27 @@
+//go:build !integration
+
+package takeownership
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_ScheduleTakeOwnership(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockPipelineSchedules.EXPECT().
+		TakeOwnershipOfPipelineSchedule("OWNER/REPO", int64(10)).
+		Return(&gitlab.PipelineSchedule{ID: 10}, nil, nil)
+	exec := cmdtest.SetupCmdForTest(t, NewCmdTakeOwnership, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	out, err := exec("10")
+	require.NoError(t, err)
+	assert.Equal(t, "Took ownership of schedule with ID 10\n", out.OutBuf.String())
+}
@@ -16,8 +16,9 @@ import (
 
 func NewCmdUpdate(f cmdutils.Factory) *cobra.Command {
 	scheduleUpdateCmd := &cobra.Command{
-		Use:   "update <id> [flags]",
-		Short: `Update a pipeline schedule.`,
+		Use:     "update <id> [flags]",
+		Short:   `Update a pipeline schedule.`,
+		Aliases: []string{"edit"},
 		Example: heredoc.Doc(`
 			# Update a scheduled pipeline with ID 10
 			$ glab schedule update 10 --cron "0 * * * *" --description "Describe your pipeline here" --ref "main" --create-variable "foo:bar" --update-variable "baz:baz" --delete-variable "qux"
@@ -0,0 +1,86 @@
+package delete
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	scheduleID int64
+	key        string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdDelete(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	scheduleVariableDeleteCmd := &cobra.Command{
+		Use:   "delete <id> <key>",
+		Short: `Delete a variable of a pipeline schedule.`,
+		Example: heredoc.Doc(`
+			# Delete the variable DEPLOY_ENV of the scheduled pipeline with ID 10
+			$ glab schedule variable delete 10 DEPLOY_ENV
+			> Deleted variable DEPLOY_ENV of schedule with ID 10
+		`),
+		Long: ``,
+		Args: cobra.ExactArgs(2),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.complete(args); err != nil {
+				return err
+			}
+
+			return opts.run()
+		},
+	}
+	return scheduleVariableDeleteCmd
+}
+
+func (o *options) complete(args []string) error {
+	id, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return err
+	}
+	o.scheduleID = int64(id)
+	o.key = args[1]
+
+	return nil
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	_, _, err = client.PipelineSchedules.DeletePipelineScheduleVariable(repo.FullName(), o.scheduleID, o.key)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(o.io.StdOut, "Deleted variable %s of schedule with ID %d\n", o.key, o.scheduleID)
+
+	return nil
+}
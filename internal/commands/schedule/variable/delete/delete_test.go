@@ -0,0 +1,27 @@
+//go:build !integration
+
+package delete
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_ScheduleVariableDelete(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockPipelineSchedules.EXPECT().
+		DeletePipelineScheduleVariable("OWNER/REPO", int64(10), "DEPLOY_ENV").
+		Return(&gitlab.PipelineVariable{}, nil, nil)
+	exec := cmdtest.SetupCmdForTest(t, NewCmdDelete, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	out, err := exec("10 DEPLOY_ENV")
+	require.NoError(t, err)
+	assert.Equal(t, "Deleted variable DEPLOY_ENV of schedule with ID 10\n", out.OutBuf.String())
+}
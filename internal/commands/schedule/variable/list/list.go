@@ -0,0 +1,97 @@
+package list
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+)
+
+type options struct {
+	scheduleID int64
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdList(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	scheduleVariableListCmd := &cobra.Command{
+		Use:   "list <id>",
+		Short: `List the variables of a pipeline schedule.`,
+		Example: heredoc.Doc(`
+			# List the variables of the scheduled pipeline with ID 10
+			$ glab schedule variable list 10
+			> Key         Value    Type
+			> DEPLOY_ENV  staging  env_var
+		`),
+		Long: ``,
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.complete(args); err != nil {
+				return err
+			}
+
+			return opts.run()
+		},
+	}
+	return scheduleVariableListCmd
+}
+
+func (o *options) complete(args []string) error {
+	id, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return err
+	}
+	o.scheduleID = int64(id)
+
+	return nil
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	schedule, _, err := client.PipelineSchedules.GetPipelineSchedule(repo.FullName(), o.scheduleID)
+	if err != nil {
+		return err
+	}
+
+	if len(schedule.Variables) == 0 {
+		fmt.Fprintln(o.io.StdErr, "No variables found for schedule with ID", o.scheduleID)
+		return nil
+	}
+
+	table := tableprinter.New(o.io)
+	table.AddHeader("Key", "Value", "Type")
+	for _, v := range schedule.Variables {
+		table.AddRow(v.Key, v.Value, v.VariableType)
+	}
+	fmt.Fprint(o.io.StdOut, table.Render())
+
+	return nil
+}
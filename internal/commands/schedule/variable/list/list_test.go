@@ -0,0 +1,43 @@
+//go:build !integration
+
+package list
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_ScheduleVariableList(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockPipelineSchedules.EXPECT().
+		GetPipelineSchedule("OWNER/REPO", int64(10)).
+		Return(&gitlab.PipelineSchedule{ID: 10, Variables: []*gitlab.PipelineVariable{
+			{Key: "DEPLOY_ENV", Value: "staging", VariableType: gitlab.EnvVariableType},
+			{Key: "CONFIG", Value: "data", VariableType: gitlab.FileVariableType},
+		}}, nil, nil)
+	exec := cmdtest.SetupCmdForTest(t, NewCmdList, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	out, err := exec("10")
+	require.NoError(t, err)
+	assert.Equal(t, "Key\tValue\tType\nDEPLOY_ENV\tstaging\tenv_var\nCONFIG\tdata\tfile\n", out.OutBuf.String())
+}
+
+func Test_ScheduleVariableList_empty(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockPipelineSchedules.EXPECT().
+		GetPipelineSchedule("OWNER/REPO", int64(10)).
+		Return(&gitlab.PipelineSchedule{ID: 10}, nil, nil)
+	exec := cmdtest.SetupCmdForTest(t, NewCmdList, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	out, err := exec("10")
+	require.NoError(t, err)
+	assert.Empty(t, out.OutBuf.String())
+	assert.Equal(t, "No variables found for schedule with ID 10\n", out.ErrBuf.String())
+}
@@ -0,0 +1,131 @@
+package set
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	scheduleID int64
+	key        string
+	value      string
+	typ        string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdSet(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	scheduleVariableSetCmd := &cobra.Command{
+		Use:   "set <id> <key> <value> [flags]",
+		Short: `Create or update a variable of a pipeline schedule.`,
+		Example: heredoc.Doc(`
+			# Set the variable DEPLOY_ENV of the scheduled pipeline with ID 10
+			$ glab schedule variable set 10 DEPLOY_ENV staging
+			> Set variable DEPLOY_ENV of schedule with ID 10
+
+			# Set a file variable
+			$ glab schedule variable set 10 CONFIG "$(cat config.json)" --type file
+		`),
+		Long: ``,
+		Args: cobra.ExactArgs(3),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.complete(args); err != nil {
+				return err
+			}
+
+			if err := opts.validate(); err != nil {
+				return err
+			}
+
+			return opts.run()
+		},
+	}
+	scheduleVariableSetCmd.Flags().StringVarP(&opts.typ, "type", "t", "env_var", "The type of the variable: env_var, file.")
+
+	return scheduleVariableSetCmd
+}
+
+func (o *options) complete(args []string) error {
+	id, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return err
+	}
+	o.scheduleID = int64(id)
+	o.key = args[1]
+	o.value = args[2]
+
+	return nil
+}
+
+func (o *options) validate() error {
+	if o.typ != "env_var" && o.typ != "file" {
+		return cmdutils.FlagError{Err: fmt.Errorf("invalid type: %s. --type must be one of `env_var` or `file`.", o.typ)}
+	}
+
+	return nil
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	schedule, _, err := client.PipelineSchedules.GetPipelineSchedule(repo.FullName(), o.scheduleID)
+	if err != nil {
+		return err
+	}
+
+	exists := false
+	for _, v := range schedule.Variables {
+		if v.Key == o.key {
+			exists = true
+			break
+		}
+	}
+
+	variableType := gitlab.Ptr(gitlab.VariableTypeValue(o.typ))
+	if exists {
+		_, _, err = client.PipelineSchedules.EditPipelineScheduleVariable(repo.FullName(), o.scheduleID, o.key, &gitlab.EditPipelineScheduleVariableOptions{
+			Value:        &o.value,
+			VariableType: variableType,
+		})
+	} else {
+		_, _, err = client.PipelineSchedules.CreatePipelineScheduleVariable(repo.FullName(), o.scheduleID, &gitlab.CreatePipelineScheduleVariableOptions{
+			Key:          &o.key,
+			Value:        &o.value,
+			VariableType: variableType,
+		})
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(o.io.StdOut, "Set variable %s of schedule with ID %d\n", o.key, o.scheduleID)
+
+	return nil
+}
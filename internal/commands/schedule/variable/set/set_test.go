@@ -0,0 +1,84 @@
+//go:build !integration
+
+package set
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_ScheduleVariableSet(t *testing.T) {
+	type testCase struct {
+		name       string
+		cli        string
+		wantOut    string
+		wantStderr string
+		setupMock  func(tc *gitlabtesting.TestClient)
+	}
+
+	testCases := []testCase{
+		{
+			name:    "creates a new variable",
+			cli:     "10 DEPLOY_ENV staging",
+			wantOut: "Set variable DEPLOY_ENV of schedule with ID 10\n",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockPipelineSchedules.EXPECT().
+					GetPipelineSchedule("OWNER/REPO", int64(10)).
+					Return(&gitlab.PipelineSchedule{ID: 10}, nil, nil)
+				tc.MockPipelineSchedules.EXPECT().
+					CreatePipelineScheduleVariable("OWNER/REPO", int64(10), &gitlab.CreatePipelineScheduleVariableOptions{
+						Key:          gitlab.Ptr("DEPLOY_ENV"),
+						Value:        gitlab.Ptr("staging"),
+						VariableType: gitlab.Ptr(gitlab.EnvVariableType),
+					}).
+					Return(&gitlab.PipelineVariable{}, nil, nil)
+			},
+		},
+		{
+			name:    "updates an existing variable",
+			cli:     "10 CONFIG data --type file",
+			wantOut: "Set variable CONFIG of schedule with ID 10\n",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockPipelineSchedules.EXPECT().
+					GetPipelineSchedule("OWNER/REPO", int64(10)).
+					Return(&gitlab.PipelineSchedule{ID: 10, Variables: []*gitlab.PipelineVariable{{Key: "CONFIG"}}}, nil, nil)
+				tc.MockPipelineSchedules.EXPECT().
+					EditPipelineScheduleVariable("OWNER/REPO", int64(10), "CONFIG", &gitlab.EditPipelineScheduleVariableOptions{
+						Value:        gitlab.Ptr("data"),
+						VariableType: gitlab.Ptr(gitlab.FileVariableType),
+					}).
+					Return(&gitlab.PipelineVariable{}, nil, nil)
+			},
+		},
+		{
+			name:       "invalid type",
+			cli:        "10 KEY value --type secret",
+			wantStderr: "invalid type: secret. --type must be one of `env_var` or `file`.",
+			setupMock:  func(tc *gitlabtesting.TestClient) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			tc.setupMock(testClient)
+			exec := cmdtest.SetupCmdForTest(t, NewCmdSet, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			out, err := exec(tc.cli)
+			if tc.wantStderr != "" {
+				require.Error(t, err)
+				assert.Equal(t, tc.wantStderr, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantOut, out.OutBuf.String())
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package variable
+
+import (
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	scheduleVariableDeleteCmd "gitlab.com/gitlab-org/cli/internal/commands/schedule/variable/delete"
+	scheduleVariableListCmd "gitlab.com/gitlab-org/cli/internal/commands/schedule/variable/list"
+	scheduleVariableSetCmd "gitlab.com/gitlab-org/cli/internal/commands/schedule/variable/set"
+)
+
+func NewCmdVariable(f cmdutils.Factory) *cobra.Command {
+	variableCmd := &cobra.Command{
+		Use:     "variable <command> [flags]",
+		Short:   `Manage the variables of a pipeline schedule.`,
+		Long:    ``,
+		Aliases: []string{"var"},
+	}
+
+	variableCmd.AddCommand(scheduleVariableListCmd.NewCmdList(f))
+	variableCmd.AddCommand(scheduleVariableSetCmd.NewCmdSet(f))
+	variableCmd.AddCommand(scheduleVariableDeleteCmd.NewCmdDelete(f))
+
+	return variableCmd
+}
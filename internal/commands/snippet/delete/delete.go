@@ -0,0 +1,103 @@
+package delete
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/snippet/snippetutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	personal bool
+	yes      bool
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdDelete(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	snippetDeleteCmd := &cobra.Command{
+		Use:   "delete <id> [flags]",
+		Short: `Delete a snippet.`,
+		Args:  cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ glab snippet delete 12
+			$ glab snippet delete 12 --personal --yes
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !opts.yes && !opts.io.PromptEnabled() {
+				return &cmdutils.FlagError{Err: errors.New("--yes or -y flag is required when not running interactively.")}
+			}
+
+			return opts.run(cmd.Context(), args[0])
+		},
+	}
+
+	fl := snippetDeleteCmd.Flags()
+	fl.BoolVarP(&opts.personal, "personal", "p", false, "Delete a personal snippet instead of a snippet of the project.")
+	fl.BoolVarP(&opts.yes, "yes", "y", false, "Skip the confirmation prompt.")
+
+	return snippetDeleteCmd
+}
+
+func (o *options) run(ctx context.Context, arg string) error {
+	id, err := snippetutils.ParseID(arg)
+	if err != nil {
+		return &cmdutils.FlagError{Err: err}
+	}
+
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	project, err := snippetutils.Project(o.personal, o.baseRepo)
+	if err != nil {
+		return err
+	}
+
+	if !o.yes {
+		snippet, err := snippetutils.Get(client, project, id)
+		if err != nil {
+			return err
+		}
+		err = o.io.Confirm(ctx, &o.yes, fmt.Sprintf("Delete snippet $%d %q?", snippet.ID, snippet.Title))
+		if err != nil {
+			return cmdutils.WrapError(err, "could not prompt")
+		}
+		if !o.yes {
+			return cmdutils.CancelError()
+		}
+	}
+
+	if project == "" {
+		_, err = client.Snippets.DeleteSnippet(id)
+	} else {
+		_, err = client.ProjectSnippets.DeleteSnippet(project, id)
+	}
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to delete snippet $%d.", id))
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Deleted snippet $%d.\n", o.io.Color().RedCheck(), id)
+	return nil
+}
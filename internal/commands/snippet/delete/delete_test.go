@@ -0,0 +1,65 @@
+//go:build !integration
+
+package delete
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_SnippetDelete(t *testing.T) {
+	testCases := []struct {
+		name      string
+		cli       string
+		setupMock func(tc *gitlabtesting.TestClient)
+		wantOut   string
+		wantErr   string
+	}{
+		{
+			name: "Delete a project snippet",
+			cli:  "12 --yes",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockProjectSnippets.EXPECT().DeleteSnippet("OWNER/REPO", int64(12)).Return(nil, nil)
+			},
+			wantOut: "✓ Deleted snippet $12.\n",
+		},
+		{
+			name: "Delete a personal snippet",
+			cli:  "12 --personal -y",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockSnippets.EXPECT().DeleteSnippet(int64(12)).Return(nil, nil)
+			},
+			wantOut: "✓ Deleted snippet $12.\n",
+		},
+		{
+			name:    "Requires --yes",
+			cli:     "12",
+			wantErr: "--yes or -y flag is required when not running interactively.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			if tc.setupMock != nil {
+				tc.setupMock(testClient)
+			}
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdDelete, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			out, err := exec(tc.cli)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantOut, out.String())
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/snippet/snippetutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	personal     bool
+	page         int
+	perPage      int
+	outputFormat string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdList(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	snippetListCmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   `List the snippets of a project, or your personal snippets.`,
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Example: heredoc.Doc(`
+			$ glab snippet list
+			$ glab snippet list --personal --output json
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+
+	fl := snippetListCmd.Flags()
+	fl.BoolVarP(&opts.personal, "personal", "p", false, "List your personal snippets instead of the snippets of the project.")
+	fl.IntVar(&opts.page, "page", 1, "Page number.")
+	fl.IntVarP(&opts.perPage, "per-page", "P", 30, "Number of snippets to list per page.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+
+	return snippetListCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	project, err := snippetutils.Project(o.personal, o.baseRepo)
+	if err != nil {
+		return err
+	}
+
+	listOpts := gitlab.ListOptions{Page: int64(o.page), PerPage: int64(o.perPage)}
+	var snippets []*gitlab.Snippet
+	if project == "" {
+		snippets, _, err = client.Snippets.ListSnippets(&gitlab.ListSnippetsOptions{ListOptions: listOpts})
+	} else {
+		snippets, _, err = client.ProjectSnippets.ListSnippets(project, &gitlab.ListProjectSnippetsOptions{ListOptions: listOpts})
+	}
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to list snippets.")
+	}
+
+	if o.outputFormat == "json" {
+		snippetsJSON, _ := json.Marshal(snippets)
+		fmt.Fprintln(o.io.StdOut, string(snippetsJSON))
+		return nil
+	}
+
+	if len(snippets) == 0 {
+		if project == "" {
+			o.io.LogInfof("No personal snippets found.\n")
+		} else {
+			o.io.LogInfof("No snippets found for %s.\n", project)
+		}
+		return nil
+	}
+
+	c := o.io.Color()
+	table := tableprinter.New(o.io)
+	table.AddHeader("ID", "TITLE", "FILES", "VISIBILITY", "UPDATED")
+	for _, s := range snippets {
+		var files []string
+		for _, file := range s.Files {
+			files = append(files, file.Path)
+		}
+		updated := ""
+		if s.UpdatedAt != nil {
+			updated = utils.TimeToPrettyTimeAgo(*s.UpdatedAt)
+		}
+		table.AddRow(fmt.Sprintf("$%d", s.ID), s.Title, strings.Join(files, ", "), s.Visibility, c.Gray(updated))
+	}
+	fmt.Fprint(o.io.StdOut, table.String())
+
+	return nil
+}
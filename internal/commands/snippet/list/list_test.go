@@ -0,0 +1,88 @@
+//go:build !integration
+
+package list
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_SnippetList(t *testing.T) {
+	updated := time.Now().Add(-2 * time.Hour)
+	snippet := &gitlab.Snippet{
+		ID:         12,
+		Title:      "demo",
+		Visibility: "private",
+		UpdatedAt:  &updated,
+		Files:      []gitlab.SnippetFile{{Path: "main.go"}, {Path: "utils.go"}},
+	}
+
+	testCases := []struct {
+		name        string
+		cli         string
+		setupMock   func(tc *gitlabtesting.TestClient)
+		expectedMsg []string
+	}{
+		{
+			name: "List project snippets",
+			cli:  "",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockProjectSnippets.EXPECT().ListSnippets("OWNER/REPO", &gitlab.ListProjectSnippetsOptions{
+					ListOptions: gitlab.ListOptions{Page: 1, PerPage: 30},
+				}).Return([]*gitlab.Snippet{snippet}, nil, nil)
+			},
+			expectedMsg: []string{"ID\tTITLE\tFILES\tVISIBILITY\tUPDATED", "$12\tdemo\tmain.go, utils.go\tprivate\tabout 2 hours ago"},
+		},
+		{
+			name: "List personal snippets",
+			cli:  "--personal --page 2 --per-page 10",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockSnippets.EXPECT().ListSnippets(&gitlab.ListSnippetsOptions{
+					ListOptions: gitlab.ListOptions{Page: 2, PerPage: 10},
+				}).Return([]*gitlab.Snippet{snippet}, nil, nil)
+			},
+			expectedMsg: []string{"$12\tdemo"},
+		},
+		{
+			name: "No snippets",
+			cli:  "",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockProjectSnippets.EXPECT().ListSnippets("OWNER/REPO", gomock.Any()).Return(nil, nil, nil)
+			},
+			expectedMsg: []string{"No snippets found for OWNER/REPO."},
+		},
+		{
+			name: "JSON output",
+			cli:  "-F json",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockProjectSnippets.EXPECT().ListSnippets("OWNER/REPO", gomock.Any()).Return([]*gitlab.Snippet{snippet}, nil, nil)
+			},
+			expectedMsg: []string{`"id":12`, `"title":"demo"`},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			tc.setupMock(testClient)
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdList, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			out, err := exec(tc.cli)
+			require.NoError(t, err)
+			output := out.String() + out.Stderr()
+			for _, msg := range tc.expectedMsg {
+				assert.Contains(t, output, msg)
+			}
+		})
+	}
+}
@@ -6,20 +6,31 @@ import (
 
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
 	"gitlab.com/gitlab-org/cli/internal/commands/snippet/create"
+	snippetDeleteCmd "gitlab.com/gitlab-org/cli/internal/commands/snippet/delete"
+	"gitlab.com/gitlab-org/cli/internal/commands/snippet/list"
+	"gitlab.com/gitlab-org/cli/internal/commands/snippet/update"
+	"gitlab.com/gitlab-org/cli/internal/commands/snippet/view"
 )
 
 func NewCmdSnippet(f cmdutils.Factory) *cobra.Command {
 	snippetCmd := &cobra.Command{
 		Use:   "snippet <command> [flags]",
 		Short: `Create, view and manage snippets.`,
-		Long:  ``,
+		Long: heredoc.Doc(`
+			Create, view and manage snippets.
+
+			Commands work on the snippets of the current project, or on your personal
+			snippets with --personal.
+		`),
 		Example: heredoc.Doc(`
-			$ glab snippet create --title "Title of the snippet" --filename "main.go"
+			$ glab snippet create main.go utils.go --title "demo"
+			$ glab snippet list --personal
+			$ glab snippet view 123 --raw
 		`),
 		Annotations: map[string]string{
 			"help:arguments": heredoc.Doc(`
 			A snippet can be supplied as argument in the following format:
-			- by number, e.g. "123"
+			- by number, e.g. "123" or "$123"
 			`),
 		},
 	}
@@ -27,5 +38,9 @@ func NewCmdSnippet(f cmdutils.Factory) *cobra.Command {
 	cmdutils.EnableRepoOverride(snippetCmd, f)
 
 	snippetCmd.AddCommand(create.NewCmdCreate(f))
+	snippetCmd.AddCommand(list.NewCmdList(f))
+	snippetCmd.AddCommand(view.NewCmdView(f))
+	snippetCmd.AddCommand(update.NewCmdUpdate(f))
+	snippetCmd.AddCommand(snippetDeleteCmd.NewCmdDelete(f))
 	return snippetCmd
 }
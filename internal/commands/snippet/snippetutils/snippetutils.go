@@ -0,0 +1,105 @@
+package snippetutils
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+)
+
+// Visibilities are the visibility levels of snippets.
+var Visibilities = []string{"private", "internal", "public"}
+
+// ParseID parses a snippet ID, like "123" or "$123".
+func ParseID(arg string) (int64, error) {
+	id, err := strconv.ParseInt(strings.TrimPrefix(arg, "$"), 10, 64)
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("invalid snippet ID: %q", arg)
+	}
+	return id, nil
+}
+
+// Project returns the project of project snippets, or an empty string for
+// personal snippets.
+func Project(personal bool, baseRepo func() (glrepo.Interface, error)) (string, error) {
+	if personal {
+		return "", nil
+	}
+	repo, err := baseRepo()
+	if err != nil {
+		return "", errors.New("project snippets need a repository. Use --personal for personal snippets, or run the command in a repository.")
+	}
+	return repo.FullName(), nil
+}
+
+// Get returns a snippet of the project, or a personal snippet when project
+// is empty.
+func Get(client *gitlab.Client, project string, id int64) (*gitlab.Snippet, error) {
+	var snippet *gitlab.Snippet
+	var err error
+	if project == "" {
+		snippet, _, err = client.Snippets.GetSnippet(id)
+	} else {
+		snippet, _, err = client.ProjectSnippets.GetSnippet(project, id)
+	}
+	if err != nil {
+		return nil, cmdutils.WrapError(err, fmt.Sprintf("failed to get snippet $%d.", id))
+	}
+	return snippet, nil
+}
+
+// FindFile returns the file of the snippet with this path.
+func FindFile(snippet *gitlab.Snippet, path string) (*gitlab.SnippetFile, error) {
+	var paths []string
+	for i := range snippet.Files {
+		if snippet.Files[i].Path == path {
+			return &snippet.Files[i], nil
+		}
+		paths = append(paths, snippet.Files[i].Path)
+	}
+	return nil, fmt.Errorf("snippet $%d has no file %s. Its files are: %s.", snippet.ID, path, strings.Join(paths, ", "))
+}
+
+// FileContent returns the raw content of a file of a snippet of the project,
+// or of a personal snippet when project is empty.
+func FileContent(client *gitlab.Client, project string, snippet *gitlab.Snippet, file *gitlab.SnippetFile) ([]byte, error) {
+	ref := FileRef(file)
+	if project == "" {
+		content, _, err := client.Snippets.SnippetFileContent(snippet.ID, ref, file.Path)
+		return content, err
+	}
+
+	// The client has no method for the files of project snippets.
+	path := fmt.Sprintf("projects/%s/snippets/%d/files/%s/%s/raw", gitlab.PathEscape(project), snippet.ID, gitlab.PathEscape(ref), gitlab.PathEscape(file.Path))
+	req, err := client.NewRequest(http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	var content bytes.Buffer
+	if _, err := client.Do(req, &content); err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
+// FileRef returns the branch of the snippet repository that a file was read
+// from, which is part of its raw URL, like
+// "https://gitlab.com/-/snippets/1/raw/main/main.go".
+func FileRef(file *gitlab.SnippetFile) string {
+	_, rest, found := strings.Cut(file.RawURL, "/raw/")
+	if !found {
+		return "main"
+	}
+	ref, _, found := strings.Cut(rest, "/")
+	if !found || ref == "" {
+		return "main"
+	}
+	return ref
+}
@@ -0,0 +1,54 @@
+//go:build !integration
+
+package snippetutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestParseID(t *testing.T) {
+	tests := []struct {
+		arg     string
+		want    int64
+		wantErr string
+	}{
+		{arg: "12", want: 12},
+		{arg: "$12", want: 12},
+		{arg: "abc", wantErr: `invalid snippet ID: "abc"`},
+		{arg: "0", wantErr: `invalid snippet ID: "0"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.arg, func(t *testing.T) {
+			id, err := ParseID(tt.arg)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, id)
+		})
+	}
+}
+
+func TestFileRef(t *testing.T) {
+	assert.Equal(t, "master", FileRef(&gitlab.SnippetFile{Path: "main.go", RawURL: "https://gitlab.com/-/snippets/1/raw/master/main.go"}))
+	assert.Equal(t, "main", FileRef(&gitlab.SnippetFile{Path: "main.go", RawURL: "https://gitlab.com/OWNER/REPO/-/snippets/1/raw/main/dir/main.go"}))
+	assert.Equal(t, "main", FileRef(&gitlab.SnippetFile{Path: "main.go"}))
+}
+
+func TestFindFile(t *testing.T) {
+	snippet := &gitlab.Snippet{ID: 1, Files: []gitlab.SnippetFile{{Path: "main.go"}, {Path: "utils.go"}}}
+
+	file, err := FindFile(snippet, "utils.go")
+	require.NoError(t, err)
+	assert.Equal(t, "utils.go", file.Path)
+
+	_, err = FindFile(snippet, "other.go")
+	assert.EqualError(t, err, "snippet $1 has no file other.go. Its files are: main.go, utils.go.")
+}
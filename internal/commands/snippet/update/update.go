@@ -0,0 +1,184 @@
+package update
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/snippet/snippetutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	personal    bool
+	title       string
+	description string
+	visibility  string
+	paths       []string
+	deleteFiles []string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdUpdate(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	snippetUpdateCmd := &cobra.Command{
+		Use:   "update <id> [<file>...] [flags]",
+		Short: `Update a snippet.`,
+		Long: heredoc.Doc(`
+			Update the title, description, visibility, or files of a snippet.
+
+			Each file argument replaces the file of the snippet with the same path,
+			or is added to the snippet when it has no such file.
+		`),
+		Args: cobra.MinimumNArgs(1),
+		Example: heredoc.Doc(`
+			$ glab snippet update 12 --title "New title" --visibility internal
+			$ glab snippet update 12 main.go utils.go
+			$ glab snippet update 12 --personal --delete-file old.go
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.paths = args[1:]
+			if !cmd.Flags().Changed("title") && !cmd.Flags().Changed("description") && !cmd.Flags().Changed("visibility") &&
+				len(opts.paths) == 0 && len(opts.deleteFiles) == 0 {
+				return &cmdutils.FlagError{Err: errors.New("nothing to update. Use --title, --description, --visibility, --delete-file, or give files.")}
+			}
+
+			return opts.run(cmd, args[0])
+		},
+	}
+
+	fl := snippetUpdateCmd.Flags()
+	fl.BoolVarP(&opts.personal, "personal", "p", false, "Update a personal snippet instead of a snippet of the project.")
+	fl.StringVarP(&opts.title, "title", "t", "", "New title of the snippet.")
+	fl.StringVarP(&opts.description, "description", "d", "", "New description of the snippet.")
+	fl.VarP(cmdutils.NewEnumValue(snippetutils.Visibilities, "", &opts.visibility), "visibility", "v", "New visibility of the snippet: private, internal, public.")
+	fl.StringSliceVar(&opts.deleteFiles, "delete-file", nil, "Delete these files from the snippet. Repeat the flag, or separate the paths with commas.")
+
+	return snippetUpdateCmd
+}
+
+func (o *options) run(cmd *cobra.Command, arg string) error {
+	id, err := snippetutils.ParseID(arg)
+	if err != nil {
+		return &cmdutils.FlagError{Err: err}
+	}
+
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	project, err := snippetutils.Project(o.personal, o.baseRepo)
+	if err != nil {
+		return err
+	}
+
+	var files []*gitlab.UpdateSnippetFileOptions
+	if len(o.paths) > 0 || len(o.deleteFiles) > 0 {
+		snippet, err := snippetutils.Get(client, project, id)
+		if err != nil {
+			return err
+		}
+		files, err = o.fileActions(snippet)
+		if err != nil {
+			return err
+		}
+	}
+
+	var title, description *string
+	var visibility *gitlab.VisibilityValue
+	if cmd.Flags().Changed("title") {
+		title = gitlab.Ptr(o.title)
+	}
+	if cmd.Flags().Changed("description") {
+		description = gitlab.Ptr(o.description)
+	}
+	if o.visibility != "" {
+		visibility = gitlab.Ptr(gitlab.VisibilityValue(o.visibility))
+	}
+	var filesOpt *[]*gitlab.UpdateSnippetFileOptions
+	if len(files) > 0 {
+		filesOpt = &files
+	}
+
+	var snippet *gitlab.Snippet
+	if project == "" {
+		snippet, _, err = client.Snippets.UpdateSnippet(id, &gitlab.UpdateSnippetOptions{
+			Title:       title,
+			Description: description,
+			Visibility:  visibility,
+			Files:       filesOpt,
+		})
+	} else {
+		snippet, _, err = client.ProjectSnippets.UpdateSnippet(project, id, &gitlab.UpdateProjectSnippetOptions{
+			Title:       title,
+			Description: description,
+			Visibility:  visibility,
+			Files:       filesOpt,
+		})
+	}
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to update snippet $%d.", id))
+	}
+
+	if o.io.IsOutputTTY() {
+		fmt.Fprintf(o.io.StdOut, "%s Updated snippet %s %s\n %s\n", o.io.Color().GreenCheck(), o.io.Color().Green(fmt.Sprintf("$%d", snippet.ID)), snippet.Title, snippet.WebURL)
+	} else {
+		fmt.Fprintln(o.io.StdOut, snippet.WebURL)
+	}
+	return nil
+}
+
+// fileActions returns the changes to the files of the snippet: files that it
+// has are updated, other files are created.
+func (o *options) fileActions(snippet *gitlab.Snippet) ([]*gitlab.UpdateSnippetFileOptions, error) {
+	existing := make(map[string]bool, len(snippet.Files))
+	for _, file := range snippet.Files {
+		existing[file.Path] = true
+	}
+
+	var actions []*gitlab.UpdateSnippetFileOptions
+	for _, path := range o.paths {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read snippet file %s: %w", path, err)
+		}
+		action := "create"
+		if existing[path] {
+			action = "update"
+		}
+		actions = append(actions, &gitlab.UpdateSnippetFileOptions{
+			Action:   gitlab.Ptr(action),
+			FilePath: gitlab.Ptr(path),
+			Content:  gitlab.Ptr(string(content)),
+		})
+	}
+	for _, path := range o.deleteFiles {
+		if _, err := snippetutils.FindFile(snippet, path); err != nil {
+			return nil, err
+		}
+		actions = append(actions, &gitlab.UpdateSnippetFileOptions{
+			Action:   gitlab.Ptr("delete"),
+			FilePath: gitlab.Ptr(path),
+		})
+	}
+	return actions, nil
+}
@@ -0,0 +1,106 @@
+//go:build !integration
+
+package update
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_SnippetUpdate(t *testing.T) {
+	t.Chdir(t.TempDir())
+	require.NoError(t, os.WriteFile("main.go", []byte("package main\n"), 0o600))
+	require.NoError(t, os.WriteFile("utils.go", []byte("package utils\n"), 0o600))
+
+	snippet := &gitlab.Snippet{
+		ID:     12,
+		Title:  "demo",
+		WebURL: "https://gitlab.example.com/OWNER/REPO/-/snippets/12",
+		Files:  []gitlab.SnippetFile{{Path: "main.go"}, {Path: "old.go"}},
+	}
+
+	testCases := []struct {
+		name      string
+		cli       string
+		setupMock func(tc *gitlabtesting.TestClient)
+		wantOut   string
+		wantErr   string
+	}{
+		{
+			name: "Update the title and visibility",
+			cli:  "12 --title 'New title' --visibility internal",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockProjectSnippets.EXPECT().UpdateSnippet("OWNER/REPO", int64(12), &gitlab.UpdateProjectSnippetOptions{
+					Title:      gitlab.Ptr("New title"),
+					Visibility: gitlab.Ptr(gitlab.InternalVisibility),
+				}).Return(snippet, nil, nil)
+			},
+			wantOut: "https://gitlab.example.com/OWNER/REPO/-/snippets/12\n",
+		},
+		{
+			name: "Update, create, and delete files",
+			cli:  "12 main.go utils.go --delete-file old.go",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockProjectSnippets.EXPECT().GetSnippet("OWNER/REPO", int64(12)).Return(snippet, nil, nil)
+				tc.MockProjectSnippets.EXPECT().UpdateSnippet("OWNER/REPO", int64(12), &gitlab.UpdateProjectSnippetOptions{
+					Files: &[]*gitlab.UpdateSnippetFileOptions{
+						{Action: gitlab.Ptr("update"), FilePath: gitlab.Ptr("main.go"), Content: gitlab.Ptr("package main\n")},
+						{Action: gitlab.Ptr("create"), FilePath: gitlab.Ptr("utils.go"), Content: gitlab.Ptr("package utils\n")},
+						{Action: gitlab.Ptr("delete"), FilePath: gitlab.Ptr("old.go")},
+					},
+				}).Return(snippet, nil, nil)
+			},
+			wantOut: "https://gitlab.example.com/OWNER/REPO/-/snippets/12\n",
+		},
+		{
+			name: "Update a personal snippet",
+			cli:  "12 --personal --description ''",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockSnippets.EXPECT().UpdateSnippet(int64(12), &gitlab.UpdateSnippetOptions{
+					Description: gitlab.Ptr(""),
+				}).Return(snippet, nil, nil)
+			},
+			wantOut: "https://gitlab.example.com/OWNER/REPO/-/snippets/12\n",
+		},
+		{
+			name: "Delete an unknown file",
+			cli:  "12 --delete-file other.go",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockProjectSnippets.EXPECT().GetSnippet("OWNER/REPO", int64(12)).Return(snippet, nil, nil)
+			},
+			wantErr: "snippet $12 has no file other.go. Its files are: main.go, old.go.",
+		},
+		{
+			name:    "Nothing to update",
+			cli:     "12",
+			wantErr: "nothing to update. Use --title, --description, --visibility, --delete-file, or give files.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			if tc.setupMock != nil {
+				tc.setupMock(testClient)
+			}
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdUpdate, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			out, err := exec(tc.cli)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantOut, out.String())
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package view
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/snippet/snippetutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	personal     bool
+	raw          bool
+	file         string
+	web          bool
+	outputFormat string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+	config       func() config.Config
+}
+
+func NewCmdView(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+		config:       f.Config,
+	}
+	snippetViewCmd := &cobra.Command{
+		Use:     "view <id> [flags]",
+		Short:   `Display a snippet, or print the content of its files.`,
+		Aliases: []string{"show"},
+		Args:    cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ glab snippet view 12
+			$ glab snippet view 12 --personal --raw
+			$ glab snippet view 12 --file main.go > main.go
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.web && (opts.raw || opts.file != "" || opts.outputFormat == "json") {
+				return &cmdutils.FlagError{Err: errors.New("--web cannot be used with --raw, --file, or --output json.")}
+			}
+			return opts.run(args[0])
+		},
+	}
+
+	fl := snippetViewCmd.Flags()
+	fl.BoolVarP(&opts.personal, "personal", "p", false, "View a personal snippet instead of a snippet of the project.")
+	fl.BoolVarP(&opts.raw, "raw", "r", false, "Print the raw content of the files of the snippet.")
+	fl.StringVarP(&opts.file, "file", "f", "", "Print the raw content of this file of the snippet only.")
+	fl.BoolVarP(&opts.web, "web", "w", false, "Open the snippet in a browser. Uses the default browser, or the browser specified in the $BROWSER variable.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+	snippetViewCmd.MarkFlagsMutuallyExclusive("raw", "output")
+	snippetViewCmd.MarkFlagsMutuallyExclusive("file", "output")
+
+	return snippetViewCmd
+}
+
+func (o *options) run(arg string) error {
+	id, err := snippetutils.ParseID(arg)
+	if err != nil {
+		return &cmdutils.FlagError{Err: err}
+	}
+
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	project, err := snippetutils.Project(o.personal, o.baseRepo)
+	if err != nil {
+		return err
+	}
+
+	snippet, err := snippetutils.Get(client, project, id)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case o.web:
+		if o.io.IsaTTY && o.io.IsErrTTY {
+			fmt.Fprintf(o.io.StdErr, "Opening %s in your browser.\n", utils.DisplayURL(snippet.WebURL))
+		}
+		browser, _ := o.config().Get(client.BaseURL().Hostname(), "browser")
+		return utils.OpenInBrowser(snippet.WebURL, browser)
+	case o.raw || o.file != "":
+		return o.printContent(client, project, snippet)
+	case o.outputFormat == "json":
+		snippetJSON, _ := json.Marshal(snippet)
+		fmt.Fprintln(o.io.StdOut, string(snippetJSON))
+	case o.io.IsOutputTTY():
+		o.printTTYSnippet(snippet)
+	default:
+		o.printRawSnippet(snippet)
+	}
+	return nil
+}
+
+// printContent prints the content of the selected file, or of all the files
+// of the snippet one after the other.
+func (o *options) printContent(client *gitlab.Client, project string, snippet *gitlab.Snippet) error {
+	files := make([]*gitlab.SnippetFile, 0, len(snippet.Files))
+	if o.file != "" {
+		file, err := snippetutils.FindFile(snippet, o.file)
+		if err != nil {
+			return err
+		}
+		files = append(files, file)
+	} else {
+		for i := range snippet.Files {
+			files = append(files, &snippet.Files[i])
+		}
+	}
+
+	for _, file := range files {
+		content, err := snippetutils.FileContent(client, project, snippet, file)
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to get the content of %s.", file.Path))
+		}
+		if _, err := o.io.StdOut.Write(content); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *options) printTTYSnippet(snippet *gitlab.Snippet) {
+	c := o.io.Color()
+	out := o.io.StdOut
+
+	fmt.Fprintln(out, c.Bold(snippet.Title)+c.Gray(fmt.Sprintf(" $%d", snippet.ID)))
+	details := snippet.Visibility
+	if snippet.Author.Username != "" {
+		details += " • by " + snippet.Author.Username
+	}
+	if snippet.UpdatedAt != nil {
+		details += " • updated " + utils.TimeToPrettyTimeAgo(*snippet.UpdatedAt)
+	}
+	fmt.Fprintln(out, c.Gray(details))
+
+	if snippet.Description != "" {
+		description, _ := utils.RenderMarkdown(snippet.Description, o.io.BackgroundColor())
+		fmt.Fprintln(out, description)
+	}
+
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, c.Bold(fmt.Sprintf("Files (%d):", len(snippet.Files))))
+	for _, file := range snippet.Files {
+		fmt.Fprintln(out, o.io.Hyperlink(file.Path, file.RawURL))
+	}
+
+	fmt.Fprintf(out, c.Gray("\nView this snippet on GitLab: %s\n"), snippet.WebURL)
+}
+
+func (o *options) printRawSnippet(snippet *gitlab.Snippet) {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "id:\t%d\n", snippet.ID)
+	fmt.Fprintf(&b, "title:\t%s\n", snippet.Title)
+	fmt.Fprintf(&b, "visibility:\t%s\n", snippet.Visibility)
+	fmt.Fprintf(&b, "author:\t%s\n", snippet.Author.Username)
+	fmt.Fprintf(&b, "url:\t%s\n", snippet.WebURL)
+	b.WriteString("--\n")
+	fmt.Fprintf(&b, "%s\n", snippet.Description)
+	b.WriteString("--\n")
+	b.WriteString("files:\n")
+	for _, file := range snippet.Files {
+		fmt.Fprintf(&b, "%s\t%s\n", file.Path, file.RawURL)
+	}
+	fmt.Fprint(o.io.StdOut, b.String())
+}
@@ -0,0 +1,99 @@
+//go:build !integration
+
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_SnippetView(t *testing.T) {
+	snippet := &gitlab.Snippet{
+		ID:          12,
+		Title:       "demo",
+		Description: "A demo.",
+		Visibility:  "internal",
+		Author:      gitlab.SnippetAuthor{Username: "alice"},
+		WebURL:      "https://gitlab.example.com/-/snippets/12",
+		Files: []gitlab.SnippetFile{
+			{Path: "main.go", RawURL: "https://gitlab.example.com/-/snippets/12/raw/main/main.go"},
+			{Path: "utils.go", RawURL: "https://gitlab.example.com/-/snippets/12/raw/main/utils.go"},
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		cli       string
+		setupMock func(tc *gitlabtesting.TestClient)
+		wantOut   string
+		wantErr   string
+	}{
+		{
+			name: "View project snippet",
+			cli:  "12",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockProjectSnippets.EXPECT().GetSnippet("OWNER/REPO", int64(12)).Return(snippet, nil, nil)
+			},
+			wantOut: "id:\t12\ntitle:\tdemo\nvisibility:\tinternal\nauthor:\talice\nurl:\thttps://gitlab.example.com/-/snippets/12\n--\nA demo.\n--\nfiles:\n" +
+				"main.go\thttps://gitlab.example.com/-/snippets/12/raw/main/main.go\nutils.go\thttps://gitlab.example.com/-/snippets/12/raw/main/utils.go\n",
+		},
+		{
+			name: "Print the raw content of all files",
+			cli:  "$12 --personal --raw",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockSnippets.EXPECT().GetSnippet(int64(12)).Return(snippet, nil, nil)
+				tc.MockSnippets.EXPECT().SnippetFileContent(int64(12), "main", "main.go").Return([]byte("package main\n"), nil, nil)
+				tc.MockSnippets.EXPECT().SnippetFileContent(int64(12), "main", "utils.go").Return([]byte("package utils\n"), nil, nil)
+			},
+			wantOut: "package main\npackage utils\n",
+		},
+		{
+			name: "Print the raw content of a file",
+			cli:  "12 --personal --file utils.go",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockSnippets.EXPECT().GetSnippet(int64(12)).Return(snippet, nil, nil)
+				tc.MockSnippets.EXPECT().SnippetFileContent(int64(12), "main", "utils.go").Return([]byte("package utils\n"), nil, nil)
+			},
+			wantOut: "package utils\n",
+		},
+		{
+			name: "Unknown file",
+			cli:  "12 --personal --file other.go",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockSnippets.EXPECT().GetSnippet(int64(12)).Return(snippet, nil, nil)
+			},
+			wantErr: "snippet $12 has no file other.go. Its files are: main.go, utils.go.",
+		},
+		{
+			name:    "Invalid ID",
+			cli:     "abc",
+			wantErr: `invalid snippet ID: "abc"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			if tc.setupMock != nil {
+				tc.setupMock(testClient)
+			}
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdView, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			out, err := exec(tc.cli)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantOut, out.String())
+		})
+	}
+}
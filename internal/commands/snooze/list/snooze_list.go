@@ -0,0 +1,115 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/snooze/snoozeutils"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+var now = time.Now
+
+type options struct {
+	due          bool
+	outputFormat string
+
+	io *iostreams.IOStreams
+}
+
+// snoozeJSON is a snoozed issue in the JSON output.
+type snoozeJSON struct {
+	*snoozeutils.Snooze
+	Due bool `json:"due"`
+}
+
+func NewCmdList(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io: f.IO(),
+	}
+	snoozeListCmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   `List snoozed issues, and the ones that are due.`,
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Example: heredoc.Doc(`
+			$ glab snooze list
+			$ glab snooze list --due
+			$ glab snooze list --output json
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+
+	fl := snoozeListCmd.Flags()
+	fl.BoolVar(&opts.due, "due", false, "List only the issues that are due.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+
+	return snoozeListCmd
+}
+
+func (o *options) run() error {
+	store, err := snoozeutils.Load()
+	if err != nil {
+		return err
+	}
+
+	t := now()
+	var snoozes []*snoozeutils.Snooze
+	for _, snooze := range store.List() {
+		if !o.due || snooze.Due(t) {
+			snoozes = append(snoozes, snooze)
+		}
+	}
+
+	if o.outputFormat == "json" {
+		list := make([]snoozeJSON, 0, len(snoozes))
+		for _, snooze := range snoozes {
+			list = append(list, snoozeJSON{Snooze: snooze, Due: snooze.Due(t)})
+		}
+		listJSON, _ := json.Marshal(list)
+		fmt.Fprintln(o.io.StdOut, string(listJSON))
+		return nil
+	}
+
+	if len(snoozes) == 0 {
+		if o.due {
+			o.io.LogInfof("No snoozed issues are due.\n")
+		} else {
+			o.io.LogInfof("No snoozed issues.\n")
+		}
+		return nil
+	}
+
+	c := o.io.Color()
+	due := 0
+	table := tableprinter.New(o.io)
+	table.SetWrapColumns(1)
+	table.AddHeader("ISSUE", "TITLE", "UNTIL", "STATE")
+	for _, snooze := range snoozes {
+		state := c.Gray("snoozed")
+		if snooze.Due(t) {
+			due++
+			state = c.Yellow("due")
+		}
+		table.AddRow(snooze.Reference, snooze.Title, snoozeutils.FormatUntil(snooze.Until), state)
+	}
+	fmt.Fprint(o.io.StdOut, table.String())
+
+	if due > 0 {
+		fmt.Fprintf(o.io.StdErr, "%s %s due. Use 'glab issue unsnooze' to remove their reminders.\n", c.WarnIcon(), utils.Pluralize(due, "issue"))
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+//go:build !integration
+
+package list
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/commands/snooze/snoozeutils"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+// setupStore stores a snoozed issue, and an issue due since yesterday, which
+// it returns the time of.
+func setupStore(t *testing.T) time.Time {
+	t.Helper()
+
+	t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+	current := time.Now().UTC()
+	now = func() time.Time { return current }
+	t.Cleanup(func() { now = time.Now })
+	yesterday := time.Date(current.Year(), current.Month(), current.Day()-1, 0, 0, 0, 0, time.UTC)
+
+	store := snoozeutils.Store{}
+	store.Snooze(&gitlab.Issue{
+		Title:      "Flaky test",
+		WebURL:     "https://gitlab.com/OWNER/REPO/-/issues/1",
+		References: &gitlab.IssueReferences{Full: "OWNER/REPO#1"},
+	}, time.Date(2099, 1, 2, 0, 0, 0, 0, time.UTC))
+	store.Snooze(&gitlab.Issue{
+		Title:      "Follow up on the release",
+		WebURL:     "https://gitlab.com/OWNER/REPO/-/issues/2",
+		References: &gitlab.IssueReferences{Full: "OWNER/REPO#2"},
+	}, yesterday)
+	require.NoError(t, store.Save())
+	return yesterday
+}
+
+func TestSnoozeList(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+	yesterday := setupStore(t)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdList, false)
+	out, err := exec("")
+	require.NoError(t, err)
+	assert.Equal(t, ""+
+		"ISSUE\tTITLE\tUNTIL\tSTATE\n"+
+		"OWNER/REPO#2\tFollow up on the release\t"+snoozeutils.FormatUntil(yesterday)+"\tdue\n"+
+		"OWNER/REPO#1\tFlaky test\tFri, 02 Jan 2099\tsnoozed\n", out.String())
+	assert.Equal(t, "! 1 issue due. Use 'glab issue unsnooze' to remove their reminders.\n", out.Stderr())
+}
+
+func TestSnoozeListDue(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+	yesterday := setupStore(t)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdList, false)
+	out, err := exec("--due --output json")
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{
+		"reference": "OWNER/REPO#2",
+		"title": "Follow up on the release",
+		"web_url": "https://gitlab.com/OWNER/REPO/-/issues/2",
+		"until": "`+yesterday.Format(time.RFC3339)+`",
+		"due": true
+	}]`, out.String())
+}
+
+func TestSnoozeListEmpty(t *testing.T) {
+	t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdList, false)
+	out, err := exec("")
+	require.NoError(t, err)
+	assert.Equal(t, "No snoozed issues.\n", out.String())
+}
@@ -0,0 +1,29 @@
+package snooze
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	snoozeListCmd "gitlab.com/gitlab-org/cli/internal/commands/snooze/list"
+)
+
+func NewCmdSnooze(f cmdutils.Factory) *cobra.Command {
+	snoozeCmd := &cobra.Command{
+		Use:   "snooze <command> [flags]",
+		Short: `Follow up on snoozed issues.`,
+		Long: heredoc.Doc(`
+			Issues snoozed with 'glab issue snooze' are hidden from 'glab issue list'
+			until they're due. Once due, they're listed again, and 'glab snooze list'
+			reminds you of them until you unsnooze them with 'glab issue unsnooze',
+			or for 30 days.
+		`),
+		Example: heredoc.Doc(`
+			$ glab issue snooze 123 --until friday
+			$ glab snooze list
+		`),
+	}
+
+	snoozeCmd.AddCommand(snoozeListCmd.NewCmdList(f))
+	return snoozeCmd
+}
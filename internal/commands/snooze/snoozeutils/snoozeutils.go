@@ -0,0 +1,179 @@
+package snoozeutils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/config"
+)
+
+const storeFileName = "snoozed.json"
+
+// dueTTL is how long a snooze stays after it's due, so 'glab snooze list' can
+// remind about it.
+const dueTTL = 30 * 24 * time.Hour
+
+// Snooze is an issue hidden until a time.
+type Snooze struct {
+	Reference string    `json:"reference"`
+	Title     string    `json:"title"`
+	WebURL    string    `json:"web_url"`
+	Until     time.Time `json:"until"`
+}
+
+// Due reports whether the issue isn't hidden anymore.
+func (s *Snooze) Due(now time.Time) bool {
+	return !now.Before(s.Until)
+}
+
+// Store stores the snoozed issues by their web URL, which identifies them
+// across projects and GitLab instances.
+type Store map[string]*Snooze
+
+// StorePath returns the file the snoozed issues are stored in.
+func StorePath() string {
+	return filepath.Join(config.ConfigDir(), storeFileName)
+}
+
+// Load reads the snoozed issues.
+func Load() (Store, error) {
+	store := Store{}
+	data, err := os.ReadFile(StorePath())
+	if errors.Is(err, os.ErrNotExist) {
+		return store, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading the snoozed issues: %w", err)
+	}
+	if err := json.Unmarshal(data, &store); err != nil {
+		return nil, fmt.Errorf("could not decode %s: %w", StorePath(), err)
+	}
+	return store, nil
+}
+
+// Save writes the snoozed issues, without the ones due long ago.
+func (s Store) Save() error {
+	for url, snooze := range s {
+		if time.Since(snooze.Until) > dueTTL {
+			delete(s, url)
+		}
+	}
+
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(config.ConfigDir(), 0o750); err != nil {
+		return fmt.Errorf("creating config directory: %w", err)
+	}
+	return config.WriteFile(StorePath(), data, 0o600)
+}
+
+// Snooze hides the issue until a time.
+func (s Store) Snooze(issue *gitlab.Issue, until time.Time) {
+	reference := fmt.Sprintf("#%d", issue.IID)
+	if issue.References != nil && issue.References.Full != "" {
+		reference = issue.References.Full
+	}
+	s[issue.WebURL] = &Snooze{
+		Reference: reference,
+		Title:     issue.Title,
+		WebURL:    issue.WebURL,
+		Until:     until,
+	}
+}
+
+// Unsnooze shows the issue again, and reports whether it was snoozed.
+func (s Store) Unsnooze(issue *gitlab.Issue) bool {
+	_, ok := s[issue.WebURL]
+	delete(s, issue.WebURL)
+	return ok
+}
+
+// IsHidden reports whether the issue is snoozed, and not due yet.
+func (s Store) IsHidden(issue *gitlab.Issue, now time.Time) bool {
+	snooze, ok := s[issue.WebURL]
+	return ok && !snooze.Due(now)
+}
+
+// List returns the snoozed issues, the first due first.
+func (s Store) List() []*Snooze {
+	list := make([]*Snooze, 0, len(s))
+	for _, snooze := range s {
+		list = append(list, snooze)
+	}
+	slices.SortFunc(list, func(a, b *Snooze) int {
+		if c := a.Until.Compare(b.Until); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Reference, b.Reference)
+	})
+	return list
+}
+
+var durationRE = regexp.MustCompile(`^(\d+)([hdw])$`)
+
+// ParseUntil parses the time an issue is snoozed until: a date like
+// 2026-10-30, a weekday like friday, tomorrow, or a duration like 3d, 2w, or
+// 12h. Dates and weekdays start at midnight, in the local time zone.
+func ParseUntil(value string, now time.Time) (time.Time, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	var until time.Time
+	if m := durationRE.FindStringSubmatch(value); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		switch m[2] {
+		case "h":
+			until = now.Add(time.Duration(n) * time.Hour)
+		case "d":
+			until = now.AddDate(0, 0, n)
+		case "w":
+			until = now.AddDate(0, 0, 7*n)
+		}
+	} else if value == "tomorrow" {
+		until = today.AddDate(0, 0, 1)
+	} else if day, ok := weekday(value); ok {
+		// The next one, so "friday" on a Friday is a week later.
+		days := (int(day)-int(today.Weekday())+6)%7 + 1
+		until = today.AddDate(0, 0, days)
+	} else if date, err := time.ParseInLocation(time.DateOnly, value, now.Location()); err == nil {
+		until = date
+	} else {
+		return time.Time{}, fmt.Errorf("invalid time %q. Use a date like 2026-10-30, a weekday like friday, tomorrow, or a duration like 3d.", value)
+	}
+
+	if !until.After(now) {
+		return time.Time{}, fmt.Errorf("%s is in the past.", value)
+	}
+	return until, nil
+}
+
+func weekday(value string) (time.Weekday, bool) {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		name := strings.ToLower(day.String())
+		if value == name || value == name[:3] {
+			return day, true
+		}
+	}
+	return 0, false
+}
+
+// FormatUntil formats the time an issue is snoozed until, without the time of
+// day when it's midnight.
+func FormatUntil(until time.Time) string {
+	if until.Hour() == 0 && until.Minute() == 0 {
+		return until.Format("Mon, 02 Jan 2006")
+	}
+	return until.Format("Mon, 02 Jan 2006 15:04")
+}
@@ -0,0 +1,86 @@
+//go:build !integration
+
+package snoozeutils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestParseUntil(t *testing.T) {
+	// A Wednesday.
+	now := time.Date(2026, 10, 14, 15, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		value string
+		want  time.Time
+	}{
+		{value: "12h", want: time.Date(2026, 10, 15, 3, 30, 0, 0, time.UTC)},
+		{value: "3d", want: time.Date(2026, 10, 17, 15, 30, 0, 0, time.UTC)},
+		{value: "2w", want: time.Date(2026, 10, 28, 15, 30, 0, 0, time.UTC)},
+		{value: "tomorrow", want: time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC)},
+		{value: "Friday", want: time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)},
+		{value: "mon", want: time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)},
+		{value: "wednesday", want: time.Date(2026, 10, 21, 0, 0, 0, 0, time.UTC)},
+		{value: "2026-11-02", want: time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseUntil(tt.value, now)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := ParseUntil("2026-10-14", now)
+	assert.EqualError(t, err, "2026-10-14 is in the past.")
+
+	_, err = ParseUntil("someday", now)
+	assert.ErrorContains(t, err, `invalid time "someday".`)
+}
+
+func TestStore(t *testing.T) {
+	t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+
+	now := time.Now()
+	later := &gitlab.Issue{IID: 1, Title: "Later", WebURL: "https://gitlab.com/OWNER/REPO/-/issues/1"}
+	due := &gitlab.Issue{
+		IID:        2,
+		Title:      "Due",
+		WebURL:     "https://gitlab.com/OWNER/REPO/-/issues/2",
+		References: &gitlab.IssueReferences{Full: "OWNER/REPO#2"},
+	}
+	old := &gitlab.Issue{IID: 3, WebURL: "https://gitlab.com/OWNER/REPO/-/issues/3"}
+
+	store, err := Load()
+	require.NoError(t, err)
+	assert.Empty(t, store)
+
+	store.Snooze(later, now.Add(time.Hour))
+	store.Snooze(due, now.Add(-time.Hour))
+	store.Snooze(old, now.Add(-dueTTL-time.Hour))
+	require.NoError(t, store.Save())
+
+	store, err = Load()
+	require.NoError(t, err)
+	list := store.List()
+	require.Len(t, list, 2)
+	assert.Equal(t, "OWNER/REPO#2", list[0].Reference)
+	assert.Equal(t, "#1", list[1].Reference)
+
+	assert.True(t, store.IsHidden(later, now))
+	assert.False(t, store.IsHidden(due, now))
+	assert.True(t, store.Unsnooze(later))
+	assert.False(t, store.Unsnooze(later))
+	assert.False(t, store.IsHidden(later, now))
+}
+
+func TestFormatUntil(t *testing.T) {
+	assert.Equal(t, "Fri, 16 Oct 2026", FormatUntil(time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, "Fri, 16 Oct 2026 09:15", FormatUntil(time.Date(2026, 10, 16, 9, 15, 0, 0, time.UTC)))
+}
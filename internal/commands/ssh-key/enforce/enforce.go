@@ -0,0 +1,228 @@
+package enforce
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/ssh-key/add"
+	"gitlab.com/gitlab-org/cli/internal/git"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	gitlabClient func() (*gitlab.Client, error)
+	io           *iostreams.IOStreams
+
+	keyFile  string
+	title    string
+	noConfig bool
+}
+
+// signingKey is an SSH public key to sign commits with.
+type signingKey struct {
+	// Key is the public key, like "ssh-ed25519 AAAA... comment".
+	Key string
+	// ConfigValue is the value of user.signingkey for the key: the path of
+	// the public key file, or the key itself.
+	ConfigValue string
+}
+
+func NewCmdEnforce(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+	}
+	cmd := &cobra.Command{
+		Use:   "enforce [flags]",
+		Short: "Register your Git signing key with GitLab, and sign the commits of the repository.",
+		Long: heredoc.Doc(`
+			Make sure the commits you push are signed with an SSH key that GitLab
+			can verify:
+
+			1. Reads the SSH signing key configured in Git with 'user.signingkey', or
+			   the key given with '--key'.
+			1. Registers the key with your GitLab account for signing, if it isn't
+			   registered yet.
+			1. Configures the current repository to sign commits and tags with the key.
+
+			Run it in every repository that requires signed commits.
+		`),
+		Example: heredoc.Doc(`
+			# Check the signing key configured in Git
+			$ glab ssh-key enforce
+
+			# Sign with another key
+			$ glab ssh-key enforce --key ~/.ssh/id_ed25519_signing.pub
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.keyFile, "key", "k", "", "Public key file to sign with. Defaults to the 'user.signingkey' Git setting.")
+	cmd.Flags().StringVarP(&opts.title, "title", "t", "", "Title of the key when it's registered. Defaults to 'Signing key on <hostname>'.")
+	cmd.Flags().BoolVar(&opts.noConfig, "no-config", false, "Only register the key, without configuring the repository.")
+
+	return cmd
+}
+
+func (o *options) run() error {
+	key, err := o.signingKey()
+	if err != nil {
+		return err
+	}
+
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	registered, err := findKey(client, key.Key)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to get SSH keys.")
+	}
+
+	cs := o.io.Color()
+	switch {
+	case registered == nil:
+		title := o.title
+		if title == "" {
+			hostname, _ := os.Hostname()
+			title = strings.TrimSpace("Signing key on " + hostname)
+		}
+		if err := add.UploadSSHKey(client, title, key.Key, "signing", ""); err != nil {
+			return cmdutils.WrapError(err, "failed to register the signing key.")
+		}
+		fmt.Fprintf(o.io.StdOut, "%s Registered the signing key with your GitLab account as %q.\n", cs.GreenCheck(), title)
+	case registered.UsageType == "auth":
+		return fmt.Errorf("the key %q is registered for authentication only. Delete it with 'glab ssh-key delete %d', and run this command again to register it for signing.", registered.Title, registered.ID)
+	default:
+		fmt.Fprintf(o.io.StdOut, "%s The signing key is registered with your GitLab account as %q.\n", cs.GreenCheck(), registered.Title)
+	}
+
+	if o.noConfig {
+		return nil
+	}
+	if _, err := git.ToplevelDir(); err != nil {
+		fmt.Fprintf(o.io.StdErr, "%s Not in a Git repository. Run this command in a repository to sign its commits.\n", cs.WarnIcon())
+		return nil
+	}
+
+	settings := [][2]string{
+		{"gpg.format", "ssh"},
+		{"user.signingkey", key.ConfigValue},
+		{"commit.gpgsign", "true"},
+		{"tag.gpgsign", "true"},
+	}
+	for _, setting := range settings {
+		if err := git.SetLocalConfig(setting[0], setting[1]); err != nil {
+			return err
+		}
+	}
+	fmt.Fprintf(o.io.StdOut, "%s Configured the repository to sign commits and tags with the key.\n", cs.GreenCheck())
+	return nil
+}
+
+// signingKey reads the public key of the --key file, or of the user.signingkey
+// Git setting.
+func (o *options) signingKey() (*signingKey, error) {
+	value := o.keyFile
+	if value == "" {
+		var err error
+		value, err = git.Config("user.signingkey")
+		if err != nil || value == "" {
+			return nil, errors.New("no signing key is configured in Git. Use --key to set one.")
+		}
+		if format, _ := git.Config("gpg.format"); format != "ssh" {
+			return nil, errors.New("Git is configured to sign with a GPG key. Use --key to sign with an SSH key instead.")
+		}
+	}
+
+	// user.signingkey can be the key itself, with or without a key:: prefix.
+	if literal, ok := strings.CutPrefix(value, "key::"); ok {
+		return parseKey(literal, value)
+	}
+	if isPublicKey(value) {
+		return parseKey(value, "key::"+value)
+	}
+
+	path := value
+	if rest, ok := strings.CutPrefix(path, "~/"); ok {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, err
+		}
+		path = filepath.Join(home, rest)
+	}
+	// The setting can point to the private key, next to its public key.
+	if !strings.HasSuffix(path, ".pub") {
+		if _, err := os.Stat(path + ".pub"); err == nil {
+			path += ".pub"
+		}
+	}
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, cmdutils.WrapError(err, "failed to read the signing key.")
+	}
+	line, _, _ := strings.Cut(strings.TrimSpace(string(content)), "\n")
+	return parseKey(line, path)
+}
+
+func parseKey(key, configValue string) (*signingKey, error) {
+	key = strings.TrimSpace(key)
+	if !isPublicKey(key) {
+		return nil, fmt.Errorf("%s isn't an SSH public key.", configValue)
+	}
+	return &signingKey{Key: key, ConfigValue: configValue}, nil
+}
+
+func isPublicKey(key string) bool {
+	if len(strings.Fields(key)) < 2 {
+		return false
+	}
+	for _, prefix := range []string{"ssh-", "ecdsa-", "sk-"} {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// findKey returns the SSH key of the user with the same type and data as key,
+// whatever its comment, or nil if it's not registered.
+func findKey(client *gitlab.Client, key string) (*gitlab.SSHKey, error) {
+	keys, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.SSHKey, *gitlab.Response, error) {
+		return client.Users.ListSSHKeys(&gitlab.ListSSHKeysOptions{ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage}}, p)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	want := strings.Fields(key)[:2]
+	for _, k := range keys {
+		fields := strings.Fields(k.Key)
+		if len(fields) >= 2 && fields[0] == want[0] && fields[1] == want[1] {
+			return k, nil
+		}
+	}
+	return nil, nil
+}
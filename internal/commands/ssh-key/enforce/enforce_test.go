@@ -0,0 +1,146 @@
+//go:build !integration
+
+package enforce
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/git"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+const publicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIBSigningKey jdoe@laptop"
+
+// setupRepo creates a repository configured to sign with a key file, isolated
+// from the Git settings of the user.
+func setupRepo(t *testing.T) string {
+	t.Helper()
+
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("GIT_CONFIG_NOSYSTEM", "1")
+	dir := git.InitGitRepo(t)
+
+	keyFile := filepath.Join(dir, "id_ed25519.pub")
+	require.NoError(t, os.WriteFile(keyFile, []byte(publicKey+"\n"), 0o600))
+	return keyFile
+}
+
+func gitConfig(t *testing.T, key string) string {
+	t.Helper()
+
+	value, err := git.Config(key)
+	require.NoError(t, err)
+	return value
+}
+
+func TestEnforce(t *testing.T) {
+	testCases := []struct {
+		name    string
+		keys    []*gitlab.SSHKey
+		wantAdd bool
+		wantOut string
+	}{
+		{
+			name:    "registers a missing key",
+			keys:    []*gitlab.SSHKey{{ID: 1, Title: "other", Key: "ssh-ed25519 AAAAOther jdoe@desktop", UsageType: "auth"}},
+			wantAdd: true,
+			wantOut: "✓ Registered the signing key with your GitLab account as \"laptop\".\n" +
+				"✓ Configured the repository to sign commits and tags with the key.\n",
+		},
+		{
+			name: "key already registered with another comment",
+			keys: []*gitlab.SSHKey{{ID: 2, Title: "mine", Key: "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIBSigningKey", UsageType: "auth_and_signing"}},
+			wantOut: "✓ The signing key is registered with your GitLab account as \"mine\".\n" +
+				"✓ Configured the repository to sign commits and tags with the key.\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			keyFile := setupRepo(t)
+
+			testClient := gitlabtesting.NewTestClient(t)
+			testClient.MockUsers.EXPECT().ListSSHKeys(gomock.Any(), gomock.Any()).Return(tc.keys, &gitlab.Response{}, nil)
+			if tc.wantAdd {
+				testClient.MockUsers.EXPECT().AddSSHKey(&gitlab.AddSSHKeyOptions{
+					Title:     gitlab.Ptr("laptop"),
+					Key:       gitlab.Ptr(publicKey),
+					UsageType: gitlab.Ptr("signing"),
+				}).Return(&gitlab.SSHKey{ID: 3}, nil, nil)
+			}
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdEnforce, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			out, err := exec("--title laptop --key " + keyFile)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantOut, out.String())
+
+			assert.Equal(t, "ssh", gitConfig(t, "gpg.format"))
+			assert.Equal(t, keyFile, gitConfig(t, "user.signingkey"))
+			assert.Equal(t, "true", gitConfig(t, "commit.gpgsign"))
+			assert.Equal(t, "true", gitConfig(t, "tag.gpgsign"))
+		})
+	}
+}
+
+func TestEnforce_ConfiguredPrivateKey(t *testing.T) {
+	keyFile := setupRepo(t)
+	require.NoError(t, git.SetLocalConfig("gpg.format", "ssh"))
+	require.NoError(t, git.SetLocalConfig("user.signingkey", "key::"+publicKey))
+
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockUsers.EXPECT().ListSSHKeys(gomock.Any(), gomock.Any()).
+		Return([]*gitlab.SSHKey{{ID: 2, Title: "mine", Key: publicKey, UsageType: "signing"}}, &gitlab.Response{}, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdEnforce, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	_, err := exec("")
+	require.NoError(t, err)
+	assert.Equal(t, "key::"+publicKey, gitConfig(t, "user.signingkey"))
+
+	// A private key is resolved to its public key.
+	privateKey := keyFile[:len(keyFile)-len(".pub")]
+	key, err := (&options{keyFile: privateKey}).signingKey()
+	require.NoError(t, err)
+	assert.Equal(t, &signingKey{Key: publicKey, ConfigValue: keyFile}, key)
+}
+
+func TestEnforce_Errors(t *testing.T) {
+	t.Run("no signing key", func(t *testing.T) {
+		setupRepo(t)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdEnforce, false)
+		_, err := exec("")
+		assert.EqualError(t, err, "no signing key is configured in Git. Use --key to set one.")
+	})
+
+	t.Run("GPG signing key", func(t *testing.T) {
+		setupRepo(t)
+		require.NoError(t, git.SetLocalConfig("user.signingkey", "3AA5C34371567BD2"))
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdEnforce, false)
+		_, err := exec("")
+		assert.EqualError(t, err, "Git is configured to sign with a GPG key. Use --key to sign with an SSH key instead.")
+	})
+
+	t.Run("authentication key", func(t *testing.T) {
+		keyFile := setupRepo(t)
+
+		testClient := gitlabtesting.NewTestClient(t)
+		testClient.MockUsers.EXPECT().ListSSHKeys(gomock.Any(), gomock.Any()).
+			Return([]*gitlab.SSHKey{{ID: 4, Title: "mine", Key: publicKey, UsageType: "auth"}}, &gitlab.Response{}, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdEnforce, false, cmdtest.WithGitLabClient(testClient.Client))
+		_, err := exec("--key " + keyFile)
+		assert.EqualError(t, err, "the key \"mine\" is registered for authentication only. Delete it with 'glab ssh-key delete 4', and run this command again to register it for signing.")
+	})
+}
@@ -72,14 +72,14 @@ func (o *options) run() error {
 	}
 
 	cs := o.io.Color()
-	table := tableprinter.NewTablePrinter()
+	table := tableprinter.New(o.io)
 	isTTy := o.io.IsOutputTTY()
 
 	if len(keys) > 0 {
 		if o.showKeyIDs {
-			table.AddRow("ID", "Title", "Key", "Usage type", "Created At")
+			table.AddHeader("ID", "Title", "Key", "Usage type", "Created At")
 		} else {
-			table.AddRow("Title", "Key", "Usage type", "Created At")
+			table.AddHeader("Title", "Key", "Usage type", "Created At")
 		}
 	}
 
@@ -6,15 +6,17 @@ import (
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
 	cmdAdd "gitlab.com/gitlab-org/cli/internal/commands/ssh-key/add"
 	cmdDelete "gitlab.com/gitlab-org/cli/internal/commands/ssh-key/delete"
+	cmdEnforce "gitlab.com/gitlab-org/cli/internal/commands/ssh-key/enforce"
 	cmdGet "gitlab.com/gitlab-org/cli/internal/commands/ssh-key/get"
 	cmdList "gitlab.com/gitlab-org/cli/internal/commands/ssh-key/list"
 )
 
 func NewCmdSSHKey(f cmdutils.Factory) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "ssh-key <command>",
-		Short: "Manage SSH keys registered with your GitLab account.",
-		Long:  "",
+		Use:     "ssh-key <command>",
+		Aliases: []string{"keys"},
+		Short:   "Manage SSH keys registered with your GitLab account.",
+		Long:    "",
 	}
 
 	cmdutils.EnableRepoOverride(cmd, f)
@@ -23,6 +25,7 @@ func NewCmdSSHKey(f cmdutils.Factory) *cobra.Command {
 	cmd.AddCommand(cmdGet.NewCmdGet(f))
 	cmd.AddCommand(cmdList.NewCmdList(f))
 	cmd.AddCommand(cmdDelete.NewCmdDelete(f))
+	cmd.AddCommand(cmdEnforce.NewCmdEnforce(f))
 
 	return cmd
 }
@@ -12,6 +12,7 @@ import (
 	stackMoveCmd "gitlab.com/gitlab-org/cli/internal/commands/stack/navigate"
 	stackReorderCmd "gitlab.com/gitlab-org/cli/internal/commands/stack/reorder"
 	stackSaveCmd "gitlab.com/gitlab-org/cli/internal/commands/stack/save"
+	stackSubmitCmd "gitlab.com/gitlab-org/cli/internal/commands/stack/submit"
 	stackSwitchCmd "gitlab.com/gitlab-org/cli/internal/commands/stack/switch"
 	stackSyncCmd "gitlab.com/gitlab-org/cli/internal/commands/stack/sync"
 	"gitlab.com/gitlab-org/cli/internal/git"
@@ -47,6 +48,7 @@ func NewCmdStack(f cmdutils.Factory) *cobra.Command {
 	stackCmd.AddCommand(stackSaveCmd.NewCmdSaveStack(f, gr, getTextFromEditor))
 	stackCmd.AddCommand(stackSaveCmd.NewCmdAmendStack(f, gr, getTextFromEditor))
 	stackCmd.AddCommand(stackSyncCmd.NewCmdSyncStack(f, gr))
+	stackCmd.AddCommand(stackSubmitCmd.NewCmdSubmitStack(f, gr))
 	stackCmd.AddCommand(stackMoveCmd.NewCmdStackPrev(f, gr))
 	stackCmd.AddCommand(stackMoveCmd.NewCmdStackNext(f, gr))
 	stackCmd.AddCommand(stackMoveCmd.NewCmdStackFirst(f, gr))
@@ -0,0 +1,331 @@
+package submit
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/auth"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/mr/create"
+	"gitlab.com/gitlab-org/cli/internal/commands/mr/mrutils"
+	"gitlab.com/gitlab-org/cli/internal/dbg"
+	"gitlab.com/gitlab-org/cli/internal/git"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/text"
+)
+
+// The stack navigation section is placed between these markers in the merge request
+// description, so it can be replaced on subsequent runs without touching the rest.
+const (
+	navigationStart = "<!-- glab stack navigation: start -->"
+	navigationEnd   = "<!-- glab stack navigation: end -->"
+)
+
+// max string size for MR title is ~255, but we'll add a "..."
+const maxMRTitleSize = 252
+
+type options struct {
+	io        *iostreams.IOStreams
+	stack     git.Stack
+	target    glrepo.Interface
+	source    glrepo.Interface
+	labClient *gitlab.Client
+	user      gitlab.User
+}
+
+// stackMR is a stack ref with the merge request of its branch.
+type stackMR struct {
+	ref git.StackRef
+	mr  *gitlab.MergeRequest
+}
+
+func NewCmdSubmitStack(f cmdutils.Factory, gr git.GitRunner) *cobra.Command {
+	opts := &options{
+		io: f.IO(),
+	}
+
+	stackSubmitCmd := &cobra.Command{
+		Use:   "submit",
+		Short: `Push all branches of a stacked diff and create or update their merge requests. (EXPERIMENTAL)`,
+		Long: heredoc.Doc(`Push all branches of a stacked diff and create or update their merge requests. This command runs these steps:
+
+1. Pushes every branch of the stack.
+1. Creates a merge request for each branch without one, targeting the previous branch of the stack.
+1. Updates the target branch of existing merge requests that no longer match the order of the stack.
+1. Adds a stack navigation section, with links to the previous and next merge requests, to the
+   description of each merge request. The section is updated each time you run this command.
+` + text.ExperimentalString),
+		Example: heredoc.Doc(`
+			$ glab stack submit
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run(f, gr)
+		},
+	}
+
+	return stackSubmitCmd
+}
+
+func (o *options) run(f cmdutils.Factory, gr git.GitRunner) error {
+	client, err := auth.GetAuthenticatedClient(f.Config(), f.GitLabClient, f.IO())
+	if err != nil {
+		return fmt.Errorf("error authorizing with GitLab: %v", err)
+	}
+	o.labClient = client
+
+	repo, err := f.BaseRepo()
+	if err != nil {
+		return fmt.Errorf("error determining base repo: %v", err)
+	}
+
+	// This prompts the user for the head repo if they're in a fork,
+	// allowing them to choose between their fork and the original repository
+	source, err := create.ResolvedHeadRepo(f)()
+	if err != nil {
+		return fmt.Errorf("error determining head repo: %v", err)
+	}
+
+	title, err := git.GetCurrentStackTitle()
+	if err != nil {
+		return fmt.Errorf("error getting current stack: %v", err)
+	}
+
+	stack, err := git.GatherStackRefs(title)
+	if err != nil {
+		return fmt.Errorf("error getting current stack references: %v", err)
+	}
+	if stack.Empty() {
+		return fmt.Errorf("stack %q has no branches to submit.", title)
+	}
+
+	user, _, err := client.Users.CurrentUser()
+	if err != nil {
+		return fmt.Errorf("error getting current user: %v", err)
+	}
+
+	o.stack = stack
+	o.target = repo
+	o.source = source
+	o.user = *user
+
+	baseBranch, err := stack.BaseBranch(gr)
+	if err != nil {
+		return fmt.Errorf("error getting base branch: %w", err)
+	}
+
+	if err := o.pushBranches(gr); err != nil {
+		return err
+	}
+
+	var mrs []stackMR
+	for ref := range stack.Iter() {
+		targetBranch := baseBranch
+		if !ref.IsFirst() {
+			targetBranch = stack.Refs[ref.Prev].Branch
+		}
+
+		mr, err := o.submitRef(ref, targetBranch)
+		if err != nil {
+			return err
+		}
+		mrs = append(mrs, stackMR{ref: ref, mr: mr})
+	}
+
+	for i, entry := range mrs {
+		if entry.mr.State != "opened" {
+			continue
+		}
+
+		description := injectNavigation(entry.mr.Description, renderNavigation(stack.Title, mrs, i))
+		if description == entry.mr.Description {
+			continue
+		}
+
+		_, _, err := client.MergeRequests.UpdateMergeRequest(o.target.FullName(), entry.mr.IID, &gitlab.UpdateMergeRequestOptions{
+			Description: gitlab.Ptr(description),
+		})
+		if err != nil {
+			return fmt.Errorf("error updating the description of merge request !%d: %v", entry.mr.IID, err)
+		}
+	}
+
+	fmt.Fprint(o.io.StdOut, progressString(o.io, "Stack submitted!"))
+	for _, entry := range mrs {
+		fmt.Fprintln(o.io.StdOut, mrutils.DisplayMR(o.io.Color(), &entry.mr.BasicMergeRequest, o.io.IsaTTY))
+	}
+	return nil
+}
+
+func (o *options) pushBranches(gr git.GitRunner) error {
+	fmt.Fprint(o.io.StdOut, progressString(
+		o.io,
+		"Pushing branches:",
+		strings.Join(o.stack.Branches(), ", "),
+	))
+
+	output, err := gr.Git(append(
+		[]string{"push", "--force-with-lease", "--set-upstream", git.DefaultRemote},
+		o.stack.Branches()...,
+	)...)
+	if err != nil {
+		return fmt.Errorf("error pushing branches to remote: %v", err)
+	}
+	dbg.Debug("Pushed:", output)
+
+	return nil
+}
+
+// submitRef creates the merge request of a stack ref, or updates the target branch
+// of its existing merge request.
+func (o *options) submitRef(ref git.StackRef, targetBranch string) (*gitlab.MergeRequest, error) {
+	if ref.MR == "" {
+		return o.createMR(ref, targetBranch)
+	}
+
+	iid, err := mrIIDFromURL(ref.MR)
+	if err != nil {
+		return nil, err
+	}
+
+	mr, _, err := o.labClient.MergeRequests.GetMergeRequest(o.target.FullName(), iid, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting merge request !%d: %v. Does it still exist?", iid, err)
+	}
+
+	if mr.State != "opened" {
+		fmt.Fprint(o.io.StdOut, progressString(o.io, fmt.Sprintf("Merge request !%d is %s. Skipping it.", mr.IID, mr.State)))
+		return mr, nil
+	}
+
+	if mr.TargetBranch == targetBranch {
+		return mr, nil
+	}
+
+	fmt.Fprint(o.io.StdOut, progressString(o.io, fmt.Sprintf("Changing the target branch of !%d to %s.", mr.IID, targetBranch)))
+	mr, _, err = o.labClient.MergeRequests.UpdateMergeRequest(o.target.FullName(), iid, &gitlab.UpdateMergeRequestOptions{
+		TargetBranch: gitlab.Ptr(targetBranch),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error updating the target branch of merge request !%d: %v", iid, err)
+	}
+
+	return mr, nil
+}
+
+func (o *options) createMR(ref git.StackRef, targetBranch string) (*gitlab.MergeRequest, error) {
+	fmt.Fprint(o.io.StdOut, progressString(o.io, ref.Branch+" needs a merge request. Creating it now."))
+
+	targetProject, err := o.target.Project(o.labClient)
+	if err != nil {
+		return nil, fmt.Errorf("error getting target project: %v", err)
+	}
+
+	parts := strings.SplitN(ref.Description, "\n", 2)
+	title := strings.TrimSpace(parts[0])
+	if len(title) > maxMRTitleSize {
+		title = title[0:maxMRTitleSize] + "..."
+	}
+	var description string
+	if len(parts) > 1 {
+		description = strings.TrimSpace(parts[1])
+	}
+
+	mr, _, err := o.labClient.MergeRequests.CreateMergeRequest(o.source.FullName(), &gitlab.CreateMergeRequestOptions{
+		Title:              gitlab.Ptr(title),
+		Description:        gitlab.Ptr(description),
+		SourceBranch:       gitlab.Ptr(ref.Branch),
+		TargetBranch:       gitlab.Ptr(targetBranch),
+		AssigneeID:         gitlab.Ptr(o.user.ID),
+		RemoveSourceBranch: gitlab.Ptr(true),
+		TargetProjectID:    gitlab.Ptr(targetProject.ID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating merge request with the API: %v", err)
+	}
+
+	ref.MR = mr.WebURL
+	if err := git.UpdateStackRefFile(o.stack.Title, ref); err != nil {
+		return nil, fmt.Errorf("error updating stack ref files: %v", err)
+	}
+
+	return mr, nil
+}
+
+// mrIIDFromURL returns the IID of the merge request with the given web URL.
+func mrIIDFromURL(url string) (int64, error) {
+	iid, err := strconv.ParseInt(url[strings.LastIndex(url, "/")+1:], 10, 64)
+	if err != nil || !strings.Contains(url, "/merge_requests/") {
+		return 0, fmt.Errorf("invalid merge request URL in stack: %q", url)
+	}
+
+	return iid, nil
+}
+
+// renderNavigation returns the stack navigation section for the merge request at index current.
+func renderNavigation(title string, mrs []stackMR, current int) string {
+	var b strings.Builder
+
+	b.WriteString(navigationStart + "\n")
+	fmt.Fprintf(&b, "**Stack: %s**\n\n", title)
+	for i, entry := range mrs {
+		line := fmt.Sprintf("!%d %s", entry.mr.IID, entry.mr.Title)
+		if i == current {
+			line = "**" + line + "** (this merge request)"
+		}
+		fmt.Fprintf(&b, "1. %s\n", line)
+	}
+
+	var links []string
+	if current > 0 {
+		links = append(links, fmt.Sprintf("Previous: !%d", mrs[current-1].mr.IID))
+	}
+	if current < len(mrs)-1 {
+		links = append(links, fmt.Sprintf("Next: !%d", mrs[current+1].mr.IID))
+	}
+	if len(links) > 0 {
+		fmt.Fprintf(&b, "\n%s\n", strings.Join(links, " | "))
+	}
+	b.WriteString(navigationEnd)
+
+	return b.String()
+}
+
+// injectNavigation replaces the stack navigation section of a merge request description,
+// or appends it if the description does not have one yet.
+func injectNavigation(description, navigation string) string {
+	start := strings.Index(description, navigationStart)
+	end := strings.Index(description, navigationEnd)
+	if start >= 0 && end > start {
+		return description[:start] + navigation + description[end+len(navigationEnd):]
+	}
+
+	description = strings.TrimRight(description, " \n")
+	if description == "" {
+		return navigation
+	}
+	return description + "\n\n" + navigation
+}
+
+func progressString(io *iostreams.IOStreams, lines ...string) string {
+	blueDot := io.Color().ProgressIcon()
+	title := lines[0]
+
+	var body string
+
+	if len(lines) > 1 {
+		body = strings.Join(lines[1:], "\n  ")
+		return fmt.Sprintf("\n%s %s \n  %s\n", blueDot, title, body)
+	}
+	return fmt.Sprintf("\n%s %s\n", blueDot, title)
+}
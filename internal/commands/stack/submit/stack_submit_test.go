@@ -0,0 +1,260 @@
+//go:build !integration
+
+package submit
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/git"
+	git_testing "gitlab.com/gitlab-org/cli/internal/git/testing"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func setupTestFactory(t *testing.T, testClient *gitlabtesting.TestClient) (cmdutils.Factory, *test.CmdOut) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+
+	apiClient, err := api.NewClient(
+		func(*http.Client) (gitlab.AuthSource, error) {
+			return gitlab.AccessTokenAuthSource{Token: ""}, nil
+		},
+		api.WithGitLabClient(testClient.Client),
+	)
+	require.NoError(t, err)
+
+	f := cmdtest.NewTestFactory(ios,
+		cmdtest.WithGitLabClient(testClient.Client),
+		func(f *cmdtest.Factory) {
+			f.BaseRepoStub = func() (glrepo.Interface, error) {
+				return glrepo.TestProject("stack_guy", "stackproject"), nil
+			}
+			f.ApiClientStub = func(repoHost string) (*api.Client, error) {
+				return apiClient, nil
+			}
+			f.RemotesStub = func() (glrepo.Remotes, error) {
+				return glrepo.Remotes{
+					&glrepo.Remote{
+						Remote: &git.Remote{
+							Name:     "origin",
+							Resolved: "head: gitlab.com/stack_guy/stackproject",
+						},
+						Repo: glrepo.TestProject("stack_guy", "stackproject"),
+					},
+				}, nil
+			}
+		},
+	)
+
+	return f, &test.CmdOut{OutBuf: stdout, ErrBuf: stderr}
+}
+
+func createStack(t *testing.T, title string, refs []git.StackRef) {
+	t.Helper()
+	_ = git.CheckoutNewBranch("main")
+
+	for _, ref := range refs {
+		require.NoError(t, git.AddStackRefFile(title, ref))
+		require.NoError(t, git.CheckoutNewBranch(ref.Branch))
+	}
+	require.NoError(t, git.AddStackBaseBranch(title, "main"))
+	require.NoError(t, git.SetConfig("glab.currentstack", title))
+}
+
+func mergeRequest(iid int64, source, target, title, description string) *gitlab.MergeRequest {
+	return &gitlab.MergeRequest{
+		BasicMergeRequest: gitlab.BasicMergeRequest{
+			IID:          iid,
+			Title:        title,
+			Description:  description,
+			SourceBranch: source,
+			TargetBranch: target,
+			State:        "opened",
+			WebURL:       fmt.Sprintf("https://gitlab.com/stack_guy/stackproject/-/merge_requests/%d", iid),
+		},
+	}
+}
+
+func Test_stackSubmit(t *testing.T) {
+	tests := []struct {
+		name        string
+		refs        []git.StackRef
+		setupMocks  func(t *testing.T, tc *gitlabtesting.TestClient)
+		expectedMsg []string
+	}{
+		{
+			name: "creates merge requests and adds the navigation",
+			refs: []git.StackRef{
+				{SHA: "1", Next: "2", Branch: "Branch1", Description: "first change"},
+				{SHA: "2", Prev: "1", Branch: "Branch2", Description: "second change\n\nwith a body"},
+			},
+			setupMocks: func(t *testing.T, tc *gitlabtesting.TestClient) {
+				tc.MockMergeRequests.EXPECT().
+					CreateMergeRequest("stack_guy/stackproject", gomock.Any()).
+					DoAndReturn(func(pid any, opts *gitlab.CreateMergeRequestOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error) {
+						assert.Equal(t, "Branch1", *opts.SourceBranch)
+						assert.Equal(t, "main", *opts.TargetBranch)
+						return mergeRequest(1, "Branch1", "main", "first change", ""), nil, nil
+					})
+				tc.MockMergeRequests.EXPECT().
+					CreateMergeRequest("stack_guy/stackproject", gomock.Any()).
+					DoAndReturn(func(pid any, opts *gitlab.CreateMergeRequestOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error) {
+						assert.Equal(t, "Branch2", *opts.SourceBranch)
+						assert.Equal(t, "Branch1", *opts.TargetBranch)
+						assert.Equal(t, "with a body", *opts.Description)
+						return mergeRequest(2, "Branch2", "Branch1", "second change", "with a body"), nil, nil
+					})
+				tc.MockMergeRequests.EXPECT().
+					UpdateMergeRequest("stack_guy/stackproject", int64(1), gomock.Any()).
+					DoAndReturn(func(pid any, iid int64, opts *gitlab.UpdateMergeRequestOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error) {
+						assert.Contains(t, *opts.Description, "1. **!1 first change** (this merge request)")
+						assert.Contains(t, *opts.Description, "Next: !2")
+						assert.NotContains(t, *opts.Description, "Previous:")
+						return nil, nil, nil
+					})
+				tc.MockMergeRequests.EXPECT().
+					UpdateMergeRequest("stack_guy/stackproject", int64(2), gomock.Any()).
+					DoAndReturn(func(pid any, iid int64, opts *gitlab.UpdateMergeRequestOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error) {
+						assert.True(t, len(*opts.Description) > len("with a body\n\n"))
+						assert.Equal(t, "with a body\n\n", (*opts.Description)[:len("with a body\n\n")])
+						assert.Contains(t, *opts.Description, "Previous: !1")
+						return nil, nil, nil
+					})
+			},
+			expectedMsg: []string{"Pushing branches:", "Branch1, Branch2", "Stack submitted!"},
+		},
+		{
+			name: "updates the target branch and skips up-to-date descriptions",
+			refs: []git.StackRef{
+				{SHA: "1", Next: "2", Branch: "Branch1", MR: "https://gitlab.com/stack_guy/stackproject/-/merge_requests/1"},
+				{SHA: "2", Prev: "1", Branch: "Branch2", MR: "https://gitlab.com/stack_guy/stackproject/-/merge_requests/2"},
+			},
+			setupMocks: func(t *testing.T, tc *gitlabtesting.TestClient) {
+				first := mergeRequest(1, "Branch1", "main", "first change", "")
+				second := mergeRequest(2, "Branch2", "main", "second change", "")
+				stack := []stackMR{{mr: first}, {mr: second}}
+				first.Description = injectNavigation("", renderNavigation("my stack", stack, 0))
+
+				tc.MockMergeRequests.EXPECT().
+					GetMergeRequest("stack_guy/stackproject", int64(1), gomock.Any()).
+					Return(first, nil, nil)
+				tc.MockMergeRequests.EXPECT().
+					GetMergeRequest("stack_guy/stackproject", int64(2), gomock.Any()).
+					Return(second, nil, nil)
+				tc.MockMergeRequests.EXPECT().
+					UpdateMergeRequest("stack_guy/stackproject", int64(2), gomock.Any()).
+					DoAndReturn(func(pid any, iid int64, opts *gitlab.UpdateMergeRequestOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error) {
+						assert.Equal(t, "Branch1", *opts.TargetBranch)
+						return mergeRequest(2, "Branch2", "Branch1", "second change", ""), nil, nil
+					})
+				tc.MockMergeRequests.EXPECT().
+					UpdateMergeRequest("stack_guy/stackproject", int64(2), gomock.Any()).
+					DoAndReturn(func(pid any, iid int64, opts *gitlab.UpdateMergeRequestOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error) {
+						assert.Nil(t, opts.TargetBranch)
+						assert.Contains(t, *opts.Description, "Previous: !1")
+						return nil, nil, nil
+					})
+			},
+			expectedMsg: []string{"Changing the target branch of !2 to Branch1."},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			git.InitGitRepoWithCommit(t)
+
+			testClient := gitlabtesting.NewTestClient(t)
+			testClient.MockUsers.EXPECT().
+				CurrentUser(gomock.Any()).
+				Return(&gitlab.User{ID: 1, Username: "stack_guy"}, nil, nil)
+			tc.setupMocks(t, testClient)
+
+			createStack(t, "my stack", tc.refs)
+
+			ctrl := gomock.NewController(t)
+			mockCmd := git_testing.NewMockGitRunner(ctrl)
+			mockCmd.EXPECT().Git([]string{"push", "--force-with-lease", "--set-upstream", "origin", "Branch1", "Branch2"})
+
+			f, out := setupTestFactory(t, testClient)
+			opts := &options{io: f.IO()}
+
+			err := opts.run(f, mockCmd)
+			require.NoError(t, err)
+			for _, msg := range tc.expectedMsg {
+				assert.Contains(t, out.String(), msg)
+			}
+		})
+	}
+}
+
+func Test_injectNavigation(t *testing.T) {
+	navigation := navigationStart + "\nnew\n" + navigationEnd
+
+	tests := []struct {
+		name        string
+		description string
+		want        string
+	}{
+		{
+			name:        "empty description",
+			description: "",
+			want:        navigation,
+		},
+		{
+			name:        "description without navigation",
+			description: "Some text\n",
+			want:        "Some text\n\n" + navigation,
+		},
+		{
+			name:        "description with navigation",
+			description: "Some text\n\n" + navigationStart + "\nold\n" + navigationEnd + "\n\nMore text",
+			want:        "Some text\n\n" + navigation + "\n\nMore text",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, injectNavigation(tt.description, navigation))
+		})
+	}
+}
+
+func Test_renderNavigation(t *testing.T) {
+	mrs := []stackMR{
+		{mr: mergeRequest(1, "b1", "main", "first", "")},
+		{mr: mergeRequest(2, "b2", "b1", "second", "")},
+		{mr: mergeRequest(3, "b3", "b2", "third", "")},
+	}
+
+	assert.Equal(t, navigationStart+`
+**Stack: my stack**
+
+1. !1 first
+1. **!2 second** (this merge request)
+1. !3 third
+
+Previous: !1 | Next: !3
+`+navigationEnd, renderNavigation("my stack", mrs, 1))
+}
+
+func Test_mrIIDFromURL(t *testing.T) {
+	iid, err := mrIIDFromURL("https://gitlab.com/OWNER/REPO/-/merge_requests/12")
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), iid)
+
+	_, err = mrIIDFromURL("https://gitlab.com/OWNER/REPO/-/issues/12")
+	require.Error(t, err)
+}
@@ -31,6 +31,10 @@ type options struct {
 	baseRepo  func() (glrepo.Interface, error)
 	remotes   func() (glrepo.Remotes, error)
 	user      gitlab.User
+
+	shallowSince string
+	filter       string
+	fetchAll     bool
 }
 
 // max string size for MR title is ~255, but we'll add a "..."
@@ -61,9 +65,15 @@ func NewCmdSyncStack(f cmdutils.Factory, gr git.GitRunner) *cobra.Command {
 1. Pushes any amended changes to their merge requests.
 1. Rebases any changes that happened previously in the stack.
 1. Removes any branches that were already merged, or with a closed merge request.
+
+Only the branches of the stack are fetched from the remote. On large repositories,
+use --shallow-since and --filter to fetch less history and fewer objects.
 ` + text.ExperimentalString),
 		Example: heredoc.Doc(`
 			$ glab stack sync
+
+			# Fetch only the history of the last two weeks, without file contents
+			$ glab stack sync --shallow-since "2 weeks ago" --filter blob:none
 		`),
 		Annotations: map[string]string{
 			mcpannotations.Destructive: "true",
@@ -78,6 +88,10 @@ func NewCmdSyncStack(f cmdutils.Factory, gr git.GitRunner) *cobra.Command {
 		},
 	}
 
+	stackSaveCmd.Flags().StringVar(&opts.shallowSince, "shallow-since", "", "Only fetch the history after this date, like '2 weeks ago' or 2024-01-31. Makes the repository shallow.")
+	stackSaveCmd.Flags().StringVar(&opts.filter, "filter", "", "Fetch with a partial clone filter, like blob:none.")
+	stackSaveCmd.Flags().BoolVar(&opts.fetchAll, "fetch-all", false, "Fetch every branch of the remote, instead of only the branches of the stack.")
+
 	return stackSaveCmd
 }
 
@@ -119,7 +133,7 @@ func (o *options) run(f cmdutils.Factory, gr git.GitRunner) error {
 	o.source = source
 	o.user = *user
 
-	err = fetchOrigin(gr)
+	err = o.fetchOrigin(gr)
 	if err != nil {
 		return err
 	}
@@ -209,8 +223,32 @@ func gitPull(gr git.GitRunner) (string, error) {
 	return pull, nil
 }
 
-func fetchOrigin(gr git.GitRunner) error {
-	output, err := gr.Git("fetch", git.DefaultRemote)
+// fetchOrigin fetches the branches of the stack that exist on the remote, so that
+// syncs don't wait for every other branch of big repositories.
+func (o *options) fetchOrigin(gr git.GitRunner) error {
+	args := []string{"fetch"}
+	if o.shallowSince != "" {
+		args = append(args, "--shallow-since="+o.shallowSince)
+	}
+	if o.filter != "" {
+		args = append(args, "--filter="+o.filter)
+	}
+	args = append(args, git.DefaultRemote)
+
+	if !o.fetchAll {
+		branches, err := git.RemoteBranches(gr, o.stack.Branches()...)
+		if err != nil {
+			return fmt.Errorf("error listing remote branches: %v", err)
+		}
+		if len(branches) == 0 {
+			return nil
+		}
+		for _, branch := range branches {
+			args = append(args, fmt.Sprintf("+refs/heads/%[1]s:refs/remotes/%[2]s/%[1]s", branch, git.DefaultRemote))
+		}
+	}
+
+	output, err := gr.Git(args...)
 	dbg.Debug("Fetching from remote:", output)
 
 	if err != nil {
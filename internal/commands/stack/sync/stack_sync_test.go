@@ -400,7 +400,11 @@ func Test_stackSync(t *testing.T) {
 			stack, err := git.GatherStackRefs(tc.args.stack.title)
 			require.NoError(t, err)
 
-			mockCmd.EXPECT().Git([]string{"fetch", "origin"})
+			firstBranch := stack.First().Branch
+			mockCmd.EXPECT().
+				Git(append([]string{"ls-remote", "--heads", "origin"}, stack.Branches()...)).
+				Return("abc123\trefs/heads/"+firstBranch+"\n", nil)
+			mockCmd.EXPECT().Git([]string{"fetch", "origin", "+refs/heads/" + firstBranch + ":refs/remotes/origin/" + firstBranch})
 
 			for ref := range stack.Iter() {
 				state := tc.args.stack.refs[ref.SHA].state
@@ -465,3 +469,40 @@ func createStack(t *testing.T, title string, scenario map[string]TestRef) {
 		require.NoError(t, err)
 	}
 }
+
+func Test_fetchOrigin(t *testing.T) {
+	stack := git.Stack{
+		Title: "test",
+		Refs: map[string]git.StackRef{
+			"1": {SHA: "1", Branch: "Branch1", Next: "2"},
+			"2": {SHA: "2", Branch: "Branch2", Prev: "1"},
+		},
+	}
+
+	t.Run("fetches the branches of the stack on the remote", func(t *testing.T) {
+		mockCmd := git_testing.NewMockGitRunner(gomock.NewController(t))
+		mockCmd.EXPECT().
+			Git("ls-remote", "--heads", "origin", "Branch1", "Branch2").
+			Return("abc123\trefs/heads/Branch1\n", nil)
+		mockCmd.EXPECT().Git("fetch", "--shallow-since=2 weeks ago", "--filter=blob:none", "origin", "+refs/heads/Branch1:refs/remotes/origin/Branch1")
+
+		o := &options{stack: stack, shallowSince: "2 weeks ago", filter: "blob:none"}
+		require.NoError(t, o.fetchOrigin(mockCmd))
+	})
+
+	t.Run("skips the fetch when no branch is on the remote", func(t *testing.T) {
+		mockCmd := git_testing.NewMockGitRunner(gomock.NewController(t))
+		mockCmd.EXPECT().Git("ls-remote", "--heads", "origin", "Branch1", "Branch2").Return("", nil)
+
+		o := &options{stack: stack}
+		require.NoError(t, o.fetchOrigin(mockCmd))
+	})
+
+	t.Run("fetches every branch with --fetch-all", func(t *testing.T) {
+		mockCmd := git_testing.NewMockGitRunner(gomock.NewController(t))
+		mockCmd.EXPECT().Git("fetch", "origin")
+
+		o := &options{stack: stack, fetchAll: true}
+		require.NoError(t, o.fetchOrigin(mockCmd))
+	})
+}
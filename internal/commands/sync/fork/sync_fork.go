@@ -0,0 +1,311 @@
+package fork
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/git"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+// Temporary refs used to compare the branches of the fork and its upstream with Git.
+const (
+	upstreamRef = "refs/glab-sync/upstream"
+	forkRef     = "refs/glab-sync/fork"
+)
+
+type options struct {
+	branch      string
+	allBranches bool
+	local       bool
+	repoHost    string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+	config       func() config.Config
+}
+
+// syncResult is the comparison of a branch of the fork with its upstream branch.
+type syncResult struct {
+	branch string
+	ahead  int
+	behind int
+}
+
+func (r syncResult) diverged() bool {
+	return r.ahead > 0
+}
+
+func (r syncResult) String() string {
+	return fmt.Sprintf("%s ahead, %s behind", utils.Pluralize(r.ahead, "commit"), utils.Pluralize(r.behind, "commit"))
+}
+
+func NewCmdSyncFork(f cmdutils.Factory, gr git.GitRunner) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+		config:       f.Config,
+	}
+
+	syncForkCmd := &cobra.Command{
+		Use:   "fork [flags]",
+		Short: `Update a fork with the changes of its upstream project.`,
+		Long: heredoc.Doc(`
+			Fast-forward a branch of a fork to the default branch of the project it was forked from.
+
+			The fork is updated on the server when the GitLab instance supports it. Otherwise, or when
+			--local is set, the branches are fetched and the fork is updated with 'git push'. This requires
+			running the command in a Git repository, but the repository doesn't need to be a clone of the fork.
+
+			Branches with commits that are not in the upstream branch are never overwritten. Instead, the
+			number of commits the branch is ahead and behind is reported, and the command fails.
+
+			With --all-branches, every branch that exists in both the fork and the upstream project is
+			fast-forwarded to the upstream branch of the same name, using Git.
+		`),
+		Example: heredoc.Doc(`
+			# Update the default branch of the fork in the current repository
+			$ glab sync fork
+
+			# Update a branch of another fork
+			$ glab sync fork --branch main -R me/project
+
+			# Update every branch that also exists upstream
+			$ glab sync fork --all-branches
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run(gr)
+		},
+	}
+
+	fl := syncForkCmd.Flags()
+	fl.StringVarP(&opts.branch, "branch", "b", "", "Branch of the fork to update. Defaults to the default branch of the fork.")
+	fl.BoolVar(&opts.allBranches, "all-branches", false, "Update every branch that exists in both the fork and the upstream project.")
+	fl.BoolVar(&opts.local, "local", false, "Update the fork with Git instead of the GitLab API.")
+	syncForkCmd.MarkFlagsMutuallyExclusive("branch", "all-branches")
+
+	return syncForkCmd
+}
+
+func (o *options) run(gr git.GitRunner) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+	o.repoHost = repo.RepoHost()
+
+	fork, err := api.GetProject(client, repo.FullName())
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to get the project.")
+	}
+	if fork.ForkedFromProject == nil {
+		return fmt.Errorf("%s is not a fork.", fork.PathWithNamespace)
+	}
+
+	if o.allBranches {
+		return o.syncAllBranches(client, gr, fork)
+	}
+
+	branch := o.branch
+	if branch == "" {
+		branch = fork.DefaultBranch
+	}
+
+	if !o.local {
+		err := o.syncWithAPI(client, fork, branch)
+		if !errors.Is(err, api.ErrForkSyncUnsupported) {
+			return err
+		}
+		fmt.Fprintln(o.io.StdErr, "Fork synchronization is not supported by the GitLab instance. Using Git instead.")
+	}
+
+	upstream, err := api.GetProject(client, fork.ForkedFromProject.ID)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to get the upstream project.")
+	}
+
+	result, err := o.syncWithGit(gr, fork, upstream, upstream.DefaultBranch, branch)
+	if err != nil {
+		return err
+	}
+	if result.diverged() {
+		return divergedError(result, upstream.PathWithNamespace)
+	}
+	return nil
+}
+
+func (o *options) syncWithAPI(client *gitlab.Client, fork *gitlab.Project, branch string) error {
+	c := o.io.Color()
+	upstream := fork.ForkedFromProject.PathWithNamespace
+
+	details, err := api.GetForkDetails(client, fork.PathWithNamespace, branch)
+	if err != nil {
+		return err
+	}
+
+	result := syncResult{branch: branch, ahead: details.Ahead, behind: details.Behind}
+	switch {
+	case details.IsSyncing:
+		fmt.Fprintf(o.io.StdOut, "%s %s is already being updated with %s.\n", c.ProgressIcon(), branch, upstream)
+		return nil
+	case result.diverged() || details.HasConflicts:
+		return divergedError(result, upstream)
+	case details.Behind == 0:
+		fmt.Fprintf(o.io.StdOut, "%s %s is up to date with %s.\n", c.GreenCheck(), branch, upstream)
+		return nil
+	}
+
+	if _, err := api.SyncFork(client, fork.PathWithNamespace, branch); err != nil {
+		return cmdutils.WrapError(err, "failed to update the fork.")
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Updating %s with %s from %s.\n", c.GreenCheck(), branch, utils.Pluralize(details.Behind, "commit"), upstream)
+	return nil
+}
+
+func (o *options) syncAllBranches(client *gitlab.Client, gr git.GitRunner, fork *gitlab.Project) error {
+	upstream, err := api.GetProject(client, fork.ForkedFromProject.ID)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to get the upstream project.")
+	}
+
+	forkBranches, err := listBranches(client, fork.ID)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to list the branches of the fork.")
+	}
+	upstreamBranches, err := listBranches(client, upstream.ID)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to list the branches of the upstream project.")
+	}
+
+	inUpstream := make(map[string]bool, len(upstreamBranches))
+	for _, b := range upstreamBranches {
+		inUpstream[b.Name] = true
+	}
+
+	c := o.io.Color()
+	diverged := 0
+	for _, b := range forkBranches {
+		if !inUpstream[b.Name] {
+			continue
+		}
+
+		result, err := o.syncWithGit(gr, fork, upstream, b.Name, b.Name)
+		if err != nil {
+			return err
+		}
+		if result.diverged() {
+			diverged++
+			fmt.Fprintf(o.io.StdErr, "%s %s has diverged from %s: %s.\n", c.FailedIcon(), result.branch, upstream.PathWithNamespace, result)
+		}
+	}
+
+	if diverged > 0 {
+		fmt.Fprintln(o.io.StdErr, "Diverged branches were not updated. Merge or rebase them manually.")
+		return cmdutils.SilentError
+	}
+	return nil
+}
+
+// syncWithGit fast-forwards forkBranch of the fork to upstreamBranch of the upstream project
+// with Git. It doesn't push when the fork branch has diverged.
+func (o *options) syncWithGit(gr git.GitRunner, fork, upstream *gitlab.Project, upstreamBranch, forkBranch string) (syncResult, error) {
+	result := syncResult{branch: forkBranch}
+
+	protocol, err := o.config().Get(o.repoHost, "git_protocol")
+	if err != nil {
+		return result, err
+	}
+	forkURL := glrepo.RemoteURL(fork, protocol)
+	upstreamURL := glrepo.RemoteURL(upstream, protocol)
+
+	defer func() {
+		_, _ = gr.Git("update-ref", "-d", upstreamRef)
+		_, _ = gr.Git("update-ref", "-d", forkRef)
+	}()
+
+	if _, err := gr.Git("fetch", "--no-tags", upstreamURL, "+refs/heads/"+upstreamBranch+":"+upstreamRef); err != nil {
+		return result, fmt.Errorf("failed to fetch %s from %s: %w", upstreamBranch, upstream.PathWithNamespace, err)
+	}
+	if _, err := gr.Git("fetch", "--no-tags", forkURL, "+refs/heads/"+forkBranch+":"+forkRef); err != nil {
+		return result, fmt.Errorf("failed to fetch %s from %s: %w", forkBranch, fork.PathWithNamespace, err)
+	}
+
+	counts, err := gr.Git("rev-list", "--left-right", "--count", forkRef+"..."+upstreamRef)
+	if err != nil {
+		return result, fmt.Errorf("failed to compare %s with %s: %w", forkBranch, upstream.PathWithNamespace, err)
+	}
+	result.ahead, result.behind, err = parseCounts(counts)
+	if err != nil {
+		return result, err
+	}
+
+	c := o.io.Color()
+	switch {
+	case result.diverged():
+		return result, nil
+	case result.behind == 0:
+		fmt.Fprintf(o.io.StdOut, "%s %s is up to date with %s.\n", c.GreenCheck(), forkBranch, upstream.PathWithNamespace)
+		return result, nil
+	}
+
+	if _, err := gr.Git("push", forkURL, upstreamRef+":refs/heads/"+forkBranch); err != nil {
+		return result, fmt.Errorf("failed to push %s to %s: %w", forkBranch, fork.PathWithNamespace, err)
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Updated %s with %s from %s.\n", c.GreenCheck(), forkBranch, utils.Pluralize(result.behind, "commit"), upstream.PathWithNamespace)
+	return result, nil
+}
+
+// parseCounts parses the output of 'git rev-list --left-right --count'.
+func parseCounts(output string) (int, int, error) {
+	fields := strings.Fields(output)
+	if len(fields) != 2 {
+		return 0, 0, fmt.Errorf("unexpected output of git rev-list: %q", output)
+	}
+
+	ahead, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	behind, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return 0, 0, err
+	}
+	return ahead, behind, nil
+}
+
+func listBranches(client *gitlab.Client, projectID int64) ([]*gitlab.Branch, error) {
+	opts := &gitlab.ListBranchesOptions{ListOptions: gitlab.ListOptions{PerPage: 100}}
+	return gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Branch, *gitlab.Response, error) {
+		return client.Branches.ListBranches(projectID, opts, p)
+	})
+}
+
+func divergedError(result syncResult, upstream string) error {
+	return fmt.Errorf("%s has diverged from %s: %s. Merge or rebase it manually.", result.branch, upstream, result)
+}
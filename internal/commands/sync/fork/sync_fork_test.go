@@ -0,0 +1,192 @@
+//go:build !integration
+
+package fork
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	git_testing "gitlab.com/gitlab-org/cli/internal/git/testing"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+const (
+	forkURL     = "git@gitlab.com:OWNER/REPO.git"
+	upstreamURL = "git@gitlab.com:upstream/REPO.git"
+)
+
+var (
+	forkProject = &gitlab.Project{
+		ID:                1,
+		PathWithNamespace: "OWNER/REPO",
+		DefaultBranch:     "main",
+		SSHURLToRepo:      forkURL,
+		ForkedFromProject: &gitlab.ForkParent{ID: 2, PathWithNamespace: "upstream/REPO"},
+	}
+	upstreamProject = &gitlab.Project{
+		ID:                2,
+		PathWithNamespace: "upstream/REPO",
+		DefaultBranch:     "main",
+		SSHURLToRepo:      upstreamURL,
+	}
+)
+
+// expectGitSync sets up the Git commands to compare and update forkBranch with upstreamBranch.
+// The branch is pushed when counts reports that it is behind and not ahead.
+func expectGitSync(mockGit *git_testing.MockGitRunner, upstreamBranch, forkBranch, counts string) {
+	mockGit.EXPECT().Git([]string{"fetch", "--no-tags", upstreamURL, "+refs/heads/" + upstreamBranch + ":" + upstreamRef})
+	mockGit.EXPECT().Git([]string{"fetch", "--no-tags", forkURL, "+refs/heads/" + forkBranch + ":" + forkRef})
+	mockGit.EXPECT().Git([]string{"rev-list", "--left-right", "--count", forkRef + "..." + upstreamRef}).Return(counts+"\n", nil)
+	if strings.HasPrefix(counts, "0\t") && counts != "0\t0" {
+		mockGit.EXPECT().Git([]string{"push", forkURL, upstreamRef + ":refs/heads/" + forkBranch})
+	}
+	mockGit.EXPECT().Git([]string{"update-ref", "-d", upstreamRef})
+	mockGit.EXPECT().Git([]string{"update-ref", "-d", forkRef})
+}
+
+func TestSyncFork(t *testing.T) {
+	tests := []struct {
+		name       string
+		cli        string
+		project    *gitlab.Project
+		setupMocks func(tc *gitlabtesting.TestClient, mockGit *git_testing.MockGitRunner)
+		wantOut    string
+		wantStderr string
+		wantErr    string
+	}{
+		{
+			name:    "Not a fork",
+			project: upstreamProject,
+			wantErr: "upstream/REPO is not a fork.",
+		},
+		{
+			name:    "Up to date on the server",
+			project: forkProject,
+			setupMocks: func(tc *gitlabtesting.TestClient, _ *git_testing.MockGitRunner) {
+				tc.MockGraphQL.EXPECT().Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(cmdtest.GraphQLResponses(`{"data": {"project": {"forkDetails": {"ahead": 0, "behind": 0}}}}`))
+			},
+			wantOut: "✓ main is up to date with upstream/REPO.\n",
+		},
+		{
+			name:    "Synchronize on the server",
+			project: forkProject,
+			setupMocks: func(tc *gitlabtesting.TestClient, _ *git_testing.MockGitRunner) {
+				tc.MockGraphQL.EXPECT().Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(cmdtest.GraphQLResponses(
+						`{"data": {"project": {"forkDetails": {"ahead": 0, "behind": 3}}}}`,
+						`{"data": {"projectSyncFork": {"details": {"ahead": 0, "behind": 3, "isSyncing": true}, "errors": []}}}`,
+					)).
+					Times(2)
+			},
+			wantOut: "✓ Updating main with 3 commits from upstream/REPO.\n",
+		},
+		{
+			name:    "Diverged on the server",
+			cli:     "--branch stable",
+			project: forkProject,
+			setupMocks: func(tc *gitlabtesting.TestClient, _ *git_testing.MockGitRunner) {
+				tc.MockGraphQL.EXPECT().Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(cmdtest.GraphQLResponses(`{"data": {"project": {"forkDetails": {"ahead": 1, "behind": 2}}}}`))
+			},
+			wantErr: "stable has diverged from upstream/REPO: 1 commit ahead, 2 commits behind. Merge or rebase it manually.",
+		},
+		{
+			name:    "Fall back to Git when the API is not supported",
+			project: forkProject,
+			setupMocks: func(tc *gitlabtesting.TestClient, mockGit *git_testing.MockGitRunner) {
+				tc.MockGraphQL.EXPECT().Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(cmdtest.GraphQLResponses(`{"errors": [{"message": "Field 'forkDetails' doesn't exist on type 'Project'"}]}`))
+				tc.MockProjects.EXPECT().GetProject(int64(2), gomock.Any()).Return(upstreamProject, nil, nil)
+				expectGitSync(mockGit, "main", "main", "0\t2")
+			},
+			wantOut:    "✓ Updated main with 2 commits from upstream/REPO.\n",
+			wantStderr: "Fork synchronization is not supported by the GitLab instance. Using Git instead.\n",
+		},
+		{
+			name:    "Diverged with Git",
+			cli:     "--local",
+			project: forkProject,
+			setupMocks: func(tc *gitlabtesting.TestClient, mockGit *git_testing.MockGitRunner) {
+				tc.MockProjects.EXPECT().GetProject(int64(2), gomock.Any()).Return(upstreamProject, nil, nil)
+				expectGitSync(mockGit, "main", "main", "1\t1")
+			},
+			wantErr: "main has diverged from upstream/REPO: 1 commit ahead, 1 commit behind. Merge or rebase it manually.",
+		},
+		{
+			name:    "Fetch fails",
+			cli:     "--local",
+			project: forkProject,
+			setupMocks: func(tc *gitlabtesting.TestClient, mockGit *git_testing.MockGitRunner) {
+				tc.MockProjects.EXPECT().GetProject(int64(2), gomock.Any()).Return(upstreamProject, nil, nil)
+				mockGit.EXPECT().Git([]string{"fetch", "--no-tags", upstreamURL, "+refs/heads/main:" + upstreamRef}).Return("", errors.New("exit status 128"))
+				mockGit.EXPECT().Git([]string{"update-ref", "-d", upstreamRef})
+				mockGit.EXPECT().Git([]string{"update-ref", "-d", forkRef})
+			},
+			wantErr: "failed to fetch main from upstream/REPO: exit status 128",
+		},
+		{
+			name:    "All branches",
+			cli:     "--all-branches",
+			project: forkProject,
+			setupMocks: func(tc *gitlabtesting.TestClient, mockGit *git_testing.MockGitRunner) {
+				tc.MockProjects.EXPECT().GetProject(int64(2), gomock.Any()).Return(upstreamProject, nil, nil)
+				tc.MockBranches.EXPECT().ListBranches(int64(1), gomock.Any(), gomock.Any()).
+					Return([]*gitlab.Branch{{Name: "main"}, {Name: "feature"}, {Name: "stable"}}, &gitlab.Response{}, nil)
+				tc.MockBranches.EXPECT().ListBranches(int64(2), gomock.Any(), gomock.Any()).
+					Return([]*gitlab.Branch{{Name: "main"}, {Name: "stable"}}, &gitlab.Response{}, nil)
+				expectGitSync(mockGit, "main", "main", "0\t4")
+				expectGitSync(mockGit, "stable", "stable", "2\t1")
+			},
+			wantOut:    "✓ Updated main with 4 commits from upstream/REPO.\n",
+			wantStderr: "x stable has diverged from upstream/REPO: 2 commits ahead, 1 commit behind.\nDiverged branches were not updated. Merge or rebase them manually.\n",
+			wantErr:    cmdutils.SilentError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tc := gitlabtesting.NewTestClient(t)
+			tc.MockProjects.EXPECT().GetProject("OWNER/REPO", gomock.Any()).Return(tt.project, nil, nil)
+
+			ctrl := gomock.NewController(t)
+			mockGit := git_testing.NewMockGitRunner(ctrl)
+			if tt.setupMocks != nil {
+				tt.setupMocks(tc, mockGit)
+			}
+
+			exec := cmdtest.SetupCmdForTest(t, func(f cmdutils.Factory) *cobra.Command {
+				return NewCmdSyncFork(f, mockGit)
+			}, false, cmdtest.WithGitLabClient(tc.Client))
+
+			out, err := exec(tt.cli)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantOut, out.String())
+			assert.Equal(t, tt.wantStderr, out.Stderr())
+		})
+	}
+}
+
+func Test_parseCounts(t *testing.T) {
+	ahead, behind, err := parseCounts("3\t5\n")
+	require.NoError(t, err)
+	assert.Equal(t, 3, ahead)
+	assert.Equal(t, 5, behind)
+
+	_, _, err = parseCounts("fatal: bad revision\n")
+	assert.EqualError(t, err, `unexpected output of git rev-list: "fatal: bad revision\n"`)
+}
@@ -0,0 +1,26 @@
+package sync
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	syncForkCmd "gitlab.com/gitlab-org/cli/internal/commands/sync/fork"
+	"gitlab.com/gitlab-org/cli/internal/git"
+)
+
+func NewCmdSync(f cmdutils.Factory) *cobra.Command {
+	syncCmd := &cobra.Command{
+		Use:   "sync <command> [flags]",
+		Short: `Synchronize projects with their upstream.`,
+		Long: heredoc.Doc(`
+		Keep forks up to date with the project they were forked from.
+		`),
+	}
+
+	cmdutils.EnableRepoOverride(syncCmd, f)
+
+	var gr git.StandardGitCommand
+	syncCmd.AddCommand(syncForkCmd.NewCmdSyncFork(f, gr))
+	return syncCmd
+}
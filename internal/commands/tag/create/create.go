@@ -0,0 +1,120 @@
+package create
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	name         string
+	ref          string
+	message      string
+	releaseNotes string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	tagCreateCmd := &cobra.Command{
+		Use:   "create <name> [flags]",
+		Short: `Create a tag on a branch, tag, or commit.`,
+		Long: heredoc.Doc(`
+			Create a tag on the GitLab server, on a branch, tag, or commit. The local
+			repository is not changed.
+
+			With --message, the tag is an annotated tag. With --release-notes, a release
+			is also created for the tag. Use 'glab release create' for the other
+			settings of releases, like assets and milestones.
+		`),
+		Aliases: []string{"new"},
+		Args:    cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ glab tag create v1.2.0
+			$ glab tag create v1.2.0 --ref release-1.2 --message "Version 1.2.0"
+			$ glab tag create v1.2.0 --release-notes "Fixes the login page."
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.name = args[0]
+			return opts.run()
+		},
+	}
+
+	fl := tagCreateCmd.Flags()
+	fl.StringVarP(&opts.ref, "ref", "r", "", "Branch, tag, or commit to create the tag on. Defaults to the default branch.")
+	fl.StringVarP(&opts.message, "message", "m", "", "Message of the tag, to create an annotated tag.")
+	fl.StringVarP(&opts.releaseNotes, "release-notes", "N", "", "Create a release for the tag, with these release notes.")
+
+	return tagCreateCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	ref := o.ref
+	if ref == "" {
+		project, _, err := client.Projects.GetProject(repo.FullName(), nil)
+		if err != nil {
+			return cmdutils.WrapError(err, "failed to get the default branch of the project.")
+		}
+		ref = project.DefaultBranch
+	}
+
+	createOpts := &gitlab.CreateTagOptions{
+		TagName: gitlab.Ptr(o.name),
+		Ref:     gitlab.Ptr(ref),
+	}
+	if o.message != "" {
+		createOpts.Message = gitlab.Ptr(o.message)
+	}
+	tag, _, err := client.Tags.CreateTag(repo.FullName(), createOpts)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to create tag %s.", o.name))
+	}
+
+	c := o.io.Color()
+	commit := ""
+	if tag.Commit != nil {
+		commit = " at " + tag.Commit.ShortID
+	}
+	fmt.Fprintf(o.io.StdOut, "%s Created tag %s on %s%s\n", c.GreenCheck(), c.Bold(tag.Name), ref, commit)
+
+	if o.releaseNotes == "" {
+		return nil
+	}
+	release, _, err := client.Releases.CreateRelease(repo.FullName(), &gitlab.CreateReleaseOptions{
+		Name:        gitlab.Ptr(tag.Name),
+		TagName:     gitlab.Ptr(tag.Name),
+		Description: gitlab.Ptr(o.releaseNotes),
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to create a release for tag %s.", tag.Name))
+	}
+	fmt.Fprintf(o.io.StdOut, "%s Created release %s\n %s\n", c.GreenCheck(), c.Bold(release.Name), release.Links.Self)
+	return nil
+}
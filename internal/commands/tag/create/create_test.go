@@ -0,0 +1,59 @@
+//go:build !integration
+
+package create
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_Create(t *testing.T) {
+	created := &gitlab.Tag{Name: "v1.2.0", Commit: &gitlab.Commit{ShortID: "aaaa1111"}}
+
+	t.Run("lightweight tag on the default branch", func(t *testing.T) {
+		testClient := gitlabtesting.NewTestClient(t)
+		testClient.MockProjects.EXPECT().GetProject("OWNER/REPO", gomock.Nil()).Return(&gitlab.Project{DefaultBranch: "main"}, nil, nil)
+		testClient.MockTags.EXPECT().CreateTag("OWNER/REPO", &gitlab.CreateTagOptions{
+			TagName: gitlab.Ptr("v1.2.0"),
+			Ref:     gitlab.Ptr("main"),
+		}).Return(created, nil, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdCreate, false, cmdtest.WithGitLabClient(testClient.Client))
+
+		out, err := exec("v1.2.0")
+		require.NoError(t, err)
+		assert.Equal(t, "✓ Created tag v1.2.0 on main at aaaa1111\n", out.String())
+	})
+
+	t.Run("annotated tag with a release", func(t *testing.T) {
+		testClient := gitlabtesting.NewTestClient(t)
+		testClient.MockTags.EXPECT().CreateTag("OWNER/REPO", &gitlab.CreateTagOptions{
+			TagName: gitlab.Ptr("v1.2.0"),
+			Ref:     gitlab.Ptr("release-1.2"),
+			Message: gitlab.Ptr("Version 1.2.0"),
+		}).Return(created, nil, nil)
+		testClient.MockReleases.EXPECT().CreateRelease("OWNER/REPO", &gitlab.CreateReleaseOptions{
+			Name:        gitlab.Ptr("v1.2.0"),
+			TagName:     gitlab.Ptr("v1.2.0"),
+			Description: gitlab.Ptr("Fixes the login page."),
+		}).Return(&gitlab.Release{
+			Name:  "v1.2.0",
+			Links: gitlab.ReleaseLinks{Self: "https://gitlab.com/OWNER/REPO/-/releases/v1.2.0"},
+		}, nil, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdCreate, false, cmdtest.WithGitLabClient(testClient.Client))
+
+		out, err := exec(`v1.2.0 --ref release-1.2 --message "Version 1.2.0" --release-notes "Fixes the login page."`)
+		require.NoError(t, err)
+		assert.Equal(t, "✓ Created tag v1.2.0 on release-1.2 at aaaa1111\n"+
+			"✓ Created release v1.2.0\n https://gitlab.com/OWNER/REPO/-/releases/v1.2.0\n", out.String())
+	})
+}
@@ -0,0 +1,96 @@
+package delete
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	names []string
+	yes   bool
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdDelete(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	tagDeleteCmd := &cobra.Command{
+		Use:   "delete <name>... [flags]",
+		Short: `Delete tags.`,
+		Long: heredoc.Doc(`
+			Delete tags on the GitLab server. The releases of the tags are kept.
+			Use 'glab release delete --with-tag' to delete a release and its tag.
+		`),
+		Args: cobra.MinimumNArgs(1),
+		Example: heredoc.Doc(`
+			$ glab tag delete v1.2.0
+			$ glab tag delete v1.2.0-rc1 v1.2.0-rc2 --yes
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.names = args
+
+			if !opts.yes && !opts.io.PromptEnabled() {
+				return &cmdutils.FlagError{Err: errors.New("--yes or -y flag is required when not running interactively.")}
+			}
+
+			return opts.run(cmd.Context())
+		},
+	}
+
+	tagDeleteCmd.Flags().BoolVarP(&opts.yes, "yes", "y", false, "Skip the confirmation prompt.")
+
+	return tagDeleteCmd
+}
+
+func (o *options) run(ctx context.Context) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	if !o.yes {
+		msg := fmt.Sprintf("Delete tag %s?", o.names[0])
+		if len(o.names) > 1 {
+			msg = fmt.Sprintf("Delete %d tags?", len(o.names))
+		}
+		err = o.io.Confirm(ctx, &o.yes, msg)
+		if err != nil {
+			return cmdutils.WrapError(err, "could not prompt")
+		}
+		if !o.yes {
+			return cmdutils.CancelError()
+		}
+	}
+
+	for _, name := range o.names {
+		if _, err := client.Tags.DeleteTag(repo.FullName(), name); err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to delete tag %s.", name))
+		}
+		fmt.Fprintf(o.io.StdOut, "%s Deleted tag %s\n", o.io.Color().RedCheck(), name)
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	search       string
+	orderBy      string
+	sort         string
+	page         int
+	perPage      int
+	outputFormat string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdList(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	tagListCmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   `List the tags of a project.`,
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Example: heredoc.Doc(`
+			$ glab tag list
+			$ glab tag list --search v1. --order-by version
+			$ glab tag list --output json
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+
+	fl := tagListCmd.Flags()
+	fl.StringVarP(&opts.search, "search", "s", "", "List the tags whose name contains this string. Use ^ and $ to match the start and end of the name.")
+	fl.Var(cmdutils.NewEnumValue([]string{"name", "updated", "version"}, "updated", &opts.orderBy), "order-by", "Order tags by: name, updated, version.")
+	fl.Var(cmdutils.NewEnumValue([]string{"asc", "desc"}, "desc", &opts.sort), "sort", "Sort tags in: asc, desc order.")
+	fl.IntVar(&opts.page, "page", 1, "Page number.")
+	fl.IntVarP(&opts.perPage, "per-page", "P", 30, "Number of tags to list per page.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+
+	return tagListCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	listOpts := &gitlab.ListTagsOptions{
+		ListOptions: gitlab.ListOptions{Page: int64(o.page), PerPage: int64(o.perPage)},
+		OrderBy:     gitlab.Ptr(o.orderBy),
+		Sort:        gitlab.Ptr(o.sort),
+	}
+	if o.search != "" {
+		listOpts.Search = gitlab.Ptr(o.search)
+	}
+	tags, _, err := client.Tags.ListTags(repo.FullName(), listOpts)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to list tags.")
+	}
+
+	if o.outputFormat == "json" {
+		tagsJSON, _ := json.Marshal(tags)
+		fmt.Fprintln(o.io.StdOut, string(tagsJSON))
+		return nil
+	}
+
+	if len(tags) == 0 {
+		o.io.LogInfof("No tags found for %s.\n", repo.FullName())
+		return nil
+	}
+
+	c := o.io.Color()
+	table := tableprinter.New(o.io)
+	table.SetWrapColumns(2)
+	table.AddHeader("NAME", "COMMIT", "MESSAGE", "UPDATED", "STATE")
+	for _, tag := range tags {
+		var state []string
+		if tag.Protected {
+			state = append(state, c.Yellow("protected"))
+		}
+		if tag.Release != nil {
+			state = append(state, c.Green("released"))
+		}
+
+		commit, updated := "", ""
+		if tag.Commit != nil {
+			commit = tag.Commit.ShortID
+			if tag.Commit.CommittedDate != nil {
+				updated = utils.TimeToPrettyTimeAgo(*tag.Commit.CommittedDate)
+			}
+		}
+		message, _, _ := strings.Cut(strings.TrimSpace(tag.Message), "\n")
+
+		table.AddRow(tag.Name, c.Cyan(commit), message, c.Gray(updated), strings.Join(state, ", "))
+	}
+	fmt.Fprint(o.io.StdOut, table.String())
+
+	return nil
+}
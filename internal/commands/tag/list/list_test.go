@@ -0,0 +1,62 @@
+//go:build !integration
+
+package list
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_List(t *testing.T) {
+	now := time.Now()
+
+	t.Run("tags", func(t *testing.T) {
+		testClient := gitlabtesting.NewTestClient(t)
+		testClient.MockTags.EXPECT().ListTags("OWNER/REPO", &gitlab.ListTagsOptions{
+			ListOptions: gitlab.ListOptions{Page: 1, PerPage: 30},
+			OrderBy:     gitlab.Ptr("version"),
+			Sort:        gitlab.Ptr("desc"),
+			Search:      gitlab.Ptr("v1."),
+		}).Return([]*gitlab.Tag{
+			{
+				Name:      "v1.2.0",
+				Message:   "Version 1.2.0\n\nWith the new login page.",
+				Protected: true,
+				Release:   &gitlab.ReleaseNote{TagName: "v1.2.0"},
+				Commit:    &gitlab.Commit{ShortID: "aaaa1111", CommittedDate: &now},
+			},
+			{Name: "v1.1.0", Commit: &gitlab.Commit{ShortID: "bbbb2222", CommittedDate: &now}},
+		}, nil, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdList, false, cmdtest.WithGitLabClient(testClient.Client))
+
+		out, err := exec("--search v1. --order-by version")
+		require.NoError(t, err)
+		assert.Equal(t, "NAME\tCOMMIT\tMESSAGE\tUPDATED\tSTATE\n"+
+			"v1.2.0\taaaa1111\tVersion 1.2.0\tless than a minute ago\tprotected, released\n"+
+			"v1.1.0\tbbbb2222\t\tless than a minute ago\t\n", out.String())
+	})
+
+	t.Run("no tags", func(t *testing.T) {
+		testClient := gitlabtesting.NewTestClient(t)
+		testClient.MockTags.EXPECT().ListTags("OWNER/REPO", &gitlab.ListTagsOptions{
+			ListOptions: gitlab.ListOptions{Page: 1, PerPage: 30},
+			OrderBy:     gitlab.Ptr("updated"),
+			Sort:        gitlab.Ptr("desc"),
+		}).Return([]*gitlab.Tag{}, nil, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdList, false, cmdtest.WithGitLabClient(testClient.Client))
+
+		out, err := exec("")
+		require.NoError(t, err)
+		assert.Equal(t, "No tags found for OWNER/REPO.\n", out.String())
+	})
+}
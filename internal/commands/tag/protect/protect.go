@@ -0,0 +1,126 @@
+package protect
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/branch/branchutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	name              string
+	createAccessLevel string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdProtect(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	tagProtectCmd := &cobra.Command{
+		Use:   "protect <name> [flags]",
+		Short: `Protect a tag, or change who can create a protected tag.`,
+		Long: heredoc.Doc(`
+			Protect a tag, or the tags that match a wildcard like 'v*', and set who is
+			allowed to create them.
+
+			GitLab can't update protected tags, so when the tag is already protected,
+			its protection is removed and added again. Access given to specific users,
+			groups, and deploy keys is kept.
+		`),
+		Args: cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ glab tag protect 'v*'
+			$ glab tag protect 'v*' --create-access-level no-one
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.name = args[0]
+			return opts.run()
+		},
+	}
+
+	tagProtectCmd.Flags().Var(cmdutils.NewEnumValue(branchutils.AccessLevelNames, "maintainer", &opts.createAccessLevel), "create-access-level", "Role allowed to create the tag: no-one, developer, maintainer.")
+
+	return tagProtectCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	level := branchutils.AccessLevels[o.createAccessLevel]
+	protectOpts := &gitlab.ProtectRepositoryTagsOptions{
+		Name:              gitlab.Ptr(o.name),
+		CreateAccessLevel: gitlab.Ptr(level),
+	}
+	summary := "Protected"
+
+	current, _, err := client.ProtectedTags.GetProtectedTag(repo.FullName(), o.name)
+	switch {
+	case errors.Is(err, gitlab.ErrNotFound):
+	case err != nil:
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to get the protection of tag %s.", o.name))
+	default:
+		levels := branchutils.TagRoleLevels(current.CreateAccessLevels)
+		if len(levels) == 1 && levels[0] == level {
+			o.printRule("Tag is already protected:", current)
+			return nil
+		}
+
+		var allowed []*gitlab.TagsPermissionOptions
+		for _, a := range current.CreateAccessLevels {
+			switch {
+			case a.UserID != 0:
+				allowed = append(allowed, &gitlab.TagsPermissionOptions{UserID: gitlab.Ptr(a.UserID)})
+			case a.GroupID != 0:
+				allowed = append(allowed, &gitlab.TagsPermissionOptions{GroupID: gitlab.Ptr(a.GroupID)})
+			case a.DeployKeyID != 0:
+				allowed = append(allowed, &gitlab.TagsPermissionOptions{DeployKeyID: gitlab.Ptr(a.DeployKeyID)})
+			}
+		}
+		if len(allowed) > 0 {
+			protectOpts.AllowedToCreate = &allowed
+		}
+
+		if _, err := client.ProtectedTags.UnprotectRepositoryTags(repo.FullName(), o.name); err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to update the protection of tag %s.", o.name))
+		}
+		summary = "Updated the protection of"
+	}
+
+	protected, _, err := client.ProtectedTags.ProtectRepositoryTags(repo.FullName(), protectOpts)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to protect tag %s.", o.name))
+	}
+	o.printRule(summary, protected)
+	return nil
+}
+
+func (o *options) printRule(summary string, rule *gitlab.ProtectedTag) {
+	c := o.io.Color()
+	fmt.Fprintf(o.io.StdOut, "%s %s %s\n", c.GreenCheck(), summary, c.Bold(rule.Name))
+	fmt.Fprintf(o.io.StdOut, "  Allowed to create: %s\n", branchutils.JoinLevels(branchutils.TagRoleLevels(rule.CreateAccessLevels)))
+}
@@ -0,0 +1,81 @@
+//go:build !integration
+
+package protect
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_Protect(t *testing.T) {
+	notFound := &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}
+
+	t.Run("new protected tag", func(t *testing.T) {
+		testClient := gitlabtesting.NewTestClient(t)
+		testClient.MockProtectedTags.EXPECT().GetProtectedTag("OWNER/REPO", "v*").Return(nil, notFound, gitlab.ErrNotFound)
+		testClient.MockProtectedTags.EXPECT().ProtectRepositoryTags("OWNER/REPO", &gitlab.ProtectRepositoryTagsOptions{
+			Name:              gitlab.Ptr("v*"),
+			CreateAccessLevel: gitlab.Ptr(gitlab.MaintainerPermissions),
+		}).Return(&gitlab.ProtectedTag{
+			Name:               "v*",
+			CreateAccessLevels: []*gitlab.TagAccessDescription{{AccessLevel: gitlab.MaintainerPermissions}},
+		}, nil, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdProtect, false, cmdtest.WithGitLabClient(testClient.Client))
+
+		out, err := exec("v*")
+		require.NoError(t, err)
+		assert.Equal(t, "✓ Protected v*\n  Allowed to create: maintainer\n", out.String())
+	})
+
+	t.Run("change the access level and keep the users", func(t *testing.T) {
+		testClient := gitlabtesting.NewTestClient(t)
+		testClient.MockProtectedTags.EXPECT().GetProtectedTag("OWNER/REPO", "v*").Return(&gitlab.ProtectedTag{
+			Name: "v*",
+			CreateAccessLevels: []*gitlab.TagAccessDescription{
+				{AccessLevel: gitlab.MaintainerPermissions},
+				{UserID: 7, AccessLevel: gitlab.DeveloperPermissions},
+			},
+		}, nil, nil)
+		testClient.MockProtectedTags.EXPECT().UnprotectRepositoryTags("OWNER/REPO", "v*").Return(nil, nil)
+		testClient.MockProtectedTags.EXPECT().ProtectRepositoryTags("OWNER/REPO", &gitlab.ProtectRepositoryTagsOptions{
+			Name:              gitlab.Ptr("v*"),
+			CreateAccessLevel: gitlab.Ptr(gitlab.NoPermissions),
+			AllowedToCreate:   gitlab.Ptr([]*gitlab.TagsPermissionOptions{{UserID: gitlab.Ptr(int64(7))}}),
+		}).Return(&gitlab.ProtectedTag{
+			Name: "v*",
+			CreateAccessLevels: []*gitlab.TagAccessDescription{
+				{AccessLevel: gitlab.NoPermissions},
+				{UserID: 7, AccessLevel: gitlab.DeveloperPermissions},
+			},
+		}, nil, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdProtect, false, cmdtest.WithGitLabClient(testClient.Client))
+
+		out, err := exec("v* --create-access-level no-one")
+		require.NoError(t, err)
+		assert.Equal(t, "✓ Updated the protection of v*\n  Allowed to create: no-one\n", out.String())
+	})
+
+	t.Run("already protected", func(t *testing.T) {
+		testClient := gitlabtesting.NewTestClient(t)
+		testClient.MockProtectedTags.EXPECT().GetProtectedTag("OWNER/REPO", "v*").Return(&gitlab.ProtectedTag{
+			Name:               "v*",
+			CreateAccessLevels: []*gitlab.TagAccessDescription{{AccessLevel: gitlab.MaintainerPermissions}},
+		}, nil, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdProtect, false, cmdtest.WithGitLabClient(testClient.Client))
+
+		out, err := exec("v*")
+		require.NoError(t, err)
+		assert.Equal(t, "✓ Tag is already protected: v*\n  Allowed to create: maintainer\n", out.String())
+	})
+}
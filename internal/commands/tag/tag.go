@@ -0,0 +1,30 @@
+package tag
+
+import (
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	cmdCreate "gitlab.com/gitlab-org/cli/internal/commands/tag/create"
+	cmdDelete "gitlab.com/gitlab-org/cli/internal/commands/tag/delete"
+	cmdList "gitlab.com/gitlab-org/cli/internal/commands/tag/list"
+	cmdProtect "gitlab.com/gitlab-org/cli/internal/commands/tag/protect"
+	cmdUnprotect "gitlab.com/gitlab-org/cli/internal/commands/tag/unprotect"
+)
+
+func NewCmdTag(f cmdutils.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tag <command> [flags]",
+		Short: `Work with the tags of a repository.`,
+		Long:  ``,
+	}
+
+	cmdutils.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdList.NewCmdList(f))
+	cmd.AddCommand(cmdCreate.NewCmdCreate(f))
+	cmd.AddCommand(cmdDelete.NewCmdDelete(f))
+	cmd.AddCommand(cmdProtect.NewCmdProtect(f))
+	cmd.AddCommand(cmdUnprotect.NewCmdUnprotect(f))
+
+	return cmd
+}
@@ -0,0 +1,67 @@
+package unprotect
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	name string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdUnprotect(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	tagUnprotectCmd := &cobra.Command{
+		Use:   "unprotect <name>",
+		Short: `Remove the protection of a tag.`,
+		Args:  cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ glab tag unprotect v1.2.0
+			$ glab tag unprotect 'v*'
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.name = args[0]
+			return opts.run()
+		},
+	}
+
+	return tagUnprotectCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.ProtectedTags.UnprotectRepositoryTags(repo.FullName(), o.name); err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to unprotect tag %s.", o.name))
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Unprotected tag %s\n", o.io.Color().RedCheck(), o.name)
+	return nil
+}
@@ -0,0 +1,299 @@
+package sync
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+// defaultPaths are the directories of the issue and merge request templates.
+var defaultPaths = []string{".gitlab/issue_templates", ".gitlab/merge_request_templates"}
+
+type options struct {
+	from          string
+	ref           string
+	paths         []string
+	branch        string
+	message       string
+	mergeRequest  bool
+	dryRun        bool
+	branchChanged bool
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+// templateFile is a file of the template repository, and how it differs from the project.
+type templateFile struct {
+	path    string
+	content []byte
+	action  gitlab.FileActionValue
+}
+
+func NewCmdSync(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+	templateSyncCmd := &cobra.Command{
+		Use:   "sync --from <repo> [flags]",
+		Short: `Copy the templates of a template repository into the project.`,
+		Long: heredoc.Doc(`
+		Copy the issue and merge request templates of a template repository into the
+		project, in a single commit. Templates that are missing or differ from the template
+		repository are created or updated. Other files of the project are kept.
+
+		By default, the commit is pushed to the default branch of the project. Use --mr
+		to push it to a new branch, and open a merge request instead. When the branch
+		already exists, like when the merge request of a previous run is still open, the
+		templates are compared with the branch, and the commit is added to it.
+		`),
+		Args: cobra.NoArgs,
+		Example: heredoc.Doc(`
+			# Update the templates of the current project from the templates repository of a group
+			$ glab template sync --from my-group/templates
+
+			# Propose the changes in a merge request
+			$ glab template sync --from my-group/templates --mr
+
+			# Show which templates would change, for another project
+			$ glab template sync --from my-group/templates -R my-group/my-project --dry-run
+
+			# Also sync the CODEOWNERS file
+			$ glab template sync --from my-group/templates --path .gitlab/issue_templates --path .gitlab/merge_request_templates --path .gitlab/CODEOWNERS
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.branchChanged = cmd.Flags().Changed("branch")
+			return opts.run()
+		},
+	}
+
+	fl := templateSyncCmd.Flags()
+	fl.StringVar(&opts.from, "from", "", "Template repository to copy the templates from, such as my-group/templates.")
+	fl.StringVar(&opts.ref, "ref", "", "Branch, tag, or commit of the template repository. Defaults to its default branch.")
+	fl.StringSliceVar(&opts.paths, "path", defaultPaths, "Directories or files to copy from the template repository.")
+	fl.StringVarP(&opts.branch, "branch", "b", "", "Branch to commit to. Defaults to the default branch, or to 'template-sync' with --mr.")
+	fl.StringVarP(&opts.message, "message", "m", "", "Commit message. Defaults to 'Sync templates from <repo>'.")
+	fl.BoolVar(&opts.mergeRequest, "mr", false, "Commit to a new branch and open a merge request.")
+	fl.BoolVar(&opts.dryRun, "dry-run", false, "Show the templates that would change, without committing.")
+	cobra.CheckErr(templateSyncCmd.MarkFlagRequired("from"))
+
+	return templateSyncCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	project, err := api.GetProject(client, repo.FullName())
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to get project %s.", repo.FullName()))
+	}
+
+	branch := o.branch
+	switch {
+	case branch != "":
+	case o.mergeRequest:
+		branch = "template-sync"
+	default:
+		branch = project.DefaultBranch
+	}
+	if o.mergeRequest && branch == project.DefaultBranch {
+		return &cmdutils.FlagError{Err: errors.New("--branch must not be the default branch when used with --mr.")}
+	}
+
+	// Compare with the branch when it exists, like when the merge request of a previous
+	// run is still open, and create it from the default branch otherwise.
+	branchExists := true
+	if branch != project.DefaultBranch {
+		_, _, err := client.Branches.GetBranch(repo.FullName(), branch)
+		switch {
+		case errors.Is(err, gitlab.ErrNotFound):
+			branchExists = false
+		case err != nil:
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to get branch %s.", branch))
+		}
+	}
+	ref := branch
+	if !branchExists {
+		ref = project.DefaultBranch
+	}
+
+	files, err := o.templateFiles(client)
+	if err != nil {
+		return err
+	}
+
+	changed, err := compareFiles(client, repo.FullName(), ref, files)
+	if err != nil {
+		return err
+	}
+
+	c := o.io.Color()
+	if len(changed) == 0 {
+		fmt.Fprintf(o.io.StdOut, "%s Templates are up to date with %s.\n", c.GreenCheck(), o.from)
+		return nil
+	}
+
+	for _, f := range changed {
+		fmt.Fprintf(o.io.StdOut, "  %s %s\n", f.action, f.path)
+	}
+	if o.dryRun {
+		fmt.Fprintf(o.io.StdOut, "%s would change. Run again without --dry-run to commit.\n", utils.Pluralize(len(changed), "template"))
+		return nil
+	}
+
+	message := o.message
+	if message == "" {
+		message = "Sync templates from " + o.from
+	}
+
+	commitOpts := &gitlab.CreateCommitOptions{
+		Branch:        gitlab.Ptr(branch),
+		CommitMessage: gitlab.Ptr(message),
+	}
+	if !branchExists {
+		commitOpts.StartBranch = gitlab.Ptr(project.DefaultBranch)
+	}
+	for _, f := range changed {
+		commitOpts.Actions = append(commitOpts.Actions, &gitlab.CommitActionOptions{
+			Action:   gitlab.Ptr(f.action),
+			FilePath: gitlab.Ptr(f.path),
+			Content:  gitlab.Ptr(base64.StdEncoding.EncodeToString(f.content)),
+			Encoding: gitlab.Ptr("base64"),
+		})
+	}
+
+	commit, _, err := client.Commits.CreateCommit(repo.FullName(), commitOpts)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to commit the templates to %s.", branch))
+	}
+	fmt.Fprintf(o.io.StdOut, "%s Committed %s to %s: %s\n", c.GreenCheck(), utils.Pluralize(len(changed), "template"), branch, commit.ShortID)
+
+	if !o.mergeRequest {
+		return nil
+	}
+
+	if branchExists {
+		mrs, _, err := client.MergeRequests.ListProjectMergeRequests(repo.FullName(), &gitlab.ListProjectMergeRequestsOptions{
+			SourceBranch: gitlab.Ptr(branch),
+			TargetBranch: gitlab.Ptr(project.DefaultBranch),
+			State:        gitlab.Ptr("opened"),
+		})
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to list the merge requests of %s.", branch))
+		}
+		if len(mrs) > 0 {
+			fmt.Fprintf(o.io.StdOut, "%s Updated merge request !%d: %s\n", c.GreenCheck(), mrs[0].IID, mrs[0].WebURL)
+			return nil
+		}
+	}
+
+	mr, _, err := client.MergeRequests.CreateMergeRequest(repo.FullName(), &gitlab.CreateMergeRequestOptions{
+		Title:              gitlab.Ptr(message),
+		Description:        gitlab.Ptr(fmt.Sprintf("Update the templates from %s.", o.from)),
+		SourceBranch:       gitlab.Ptr(branch),
+		TargetBranch:       gitlab.Ptr(project.DefaultBranch),
+		RemoveSourceBranch: gitlab.Ptr(true),
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to create the merge request.")
+	}
+	fmt.Fprintf(o.io.StdOut, "%s Opened merge request !%d: %s\n", c.GreenCheck(), mr.IID, mr.WebURL)
+	return nil
+}
+
+// templateFiles returns the files of the template repository in the synced paths.
+func (o *options) templateFiles(client *gitlab.Client) ([]*templateFile, error) {
+	var ref *string
+	if o.ref != "" {
+		ref = gitlab.Ptr(o.ref)
+	}
+
+	var files []*templateFile
+	for _, p := range o.paths {
+		nodes, err := gitlab.ScanAndCollect(func(pf gitlab.PaginationOptionFunc) ([]*gitlab.TreeNode, *gitlab.Response, error) {
+			return client.Repositories.ListTree(o.from, &gitlab.ListTreeOptions{
+				ListOptions: gitlab.ListOptions{PerPage: 100},
+				Path:        gitlab.Ptr(p),
+				Ref:         ref,
+				Recursive:   gitlab.Ptr(true),
+			}, pf)
+		})
+		if err != nil && !errors.Is(err, gitlab.ErrNotFound) {
+			return nil, cmdutils.WrapError(err, fmt.Sprintf("failed to list the files of %s in %s.", p, o.from))
+		}
+
+		// A path without entries is a single file, or doesn't exist.
+		paths := []string{p}
+		if len(nodes) > 0 {
+			paths = nil
+			for _, n := range nodes {
+				if n.Type == "blob" {
+					paths = append(paths, n.Path)
+				}
+			}
+		}
+
+		for _, path := range paths {
+			content, _, err := client.RepositoryFiles.GetRawFile(o.from, path, &gitlab.GetRawFileOptions{Ref: ref})
+			if errors.Is(err, gitlab.ErrNotFound) {
+				continue
+			}
+			if err != nil {
+				return nil, cmdutils.WrapError(err, fmt.Sprintf("failed to get %s from %s.", path, o.from))
+			}
+			files = append(files, &templateFile{path: path, content: content})
+		}
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no templates found in %s.", o.from)
+	}
+	return files, nil
+}
+
+// compareFiles returns the files that are missing or differ in the project, with the action to sync them.
+func compareFiles(client *gitlab.Client, projectPath, ref string, files []*templateFile) ([]*templateFile, error) {
+	var changed []*templateFile
+	for _, f := range files {
+		content, _, err := client.RepositoryFiles.GetRawFile(projectPath, f.path, &gitlab.GetRawFileOptions{Ref: gitlab.Ptr(ref)})
+		switch {
+		case errors.Is(err, gitlab.ErrNotFound):
+			f.action = gitlab.FileCreate
+		case err != nil:
+			return nil, cmdutils.WrapError(err, fmt.Sprintf("failed to get %s from %s.", f.path, projectPath))
+		case bytes.Equal(content, f.content):
+			continue
+		default:
+			f.action = gitlab.FileUpdate
+		}
+		changed = append(changed, f)
+	}
+	return changed, nil
+}
@@ -0,0 +1,198 @@
+//go:build !integration
+
+package sync
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+const (
+	bugTemplate     = ".gitlab/issue_templates/Bug.md"
+	featureTemplate = ".gitlab/issue_templates/Feature.md"
+)
+
+// expectTemplates sets up the template repository with two issue templates, and the
+// branch ref of the project with the bug template, and the feature template if
+// featureContent is not empty.
+func expectTemplates(tc *gitlabtesting.TestClient, ref, featureContent string) {
+	tc.MockProjects.EXPECT().GetProject("OWNER/REPO", gomock.Any()).Return(&gitlab.Project{DefaultBranch: "main"}, nil, nil)
+
+	tc.MockRepositories.EXPECT().
+		ListTree("my-group/templates", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, opts *gitlab.ListTreeOptions, _ ...gitlab.RequestOptionFunc) ([]*gitlab.TreeNode, *gitlab.Response, error) {
+			if *opts.Path == ".gitlab/merge_request_templates" {
+				return nil, nil, gitlab.ErrNotFound
+			}
+			return []*gitlab.TreeNode{
+				{Type: "blob", Path: bugTemplate},
+				{Type: "tree", Path: ".gitlab/issue_templates/old"},
+				{Type: "blob", Path: featureTemplate},
+			}, &gitlab.Response{}, nil
+		}).Times(2)
+	tc.MockRepositoryFiles.EXPECT().GetRawFile("my-group/templates", bugTemplate, gomock.Any()).Return([]byte("bug"), nil, nil)
+	tc.MockRepositoryFiles.EXPECT().GetRawFile("my-group/templates", featureTemplate, gomock.Any()).Return([]byte("feature"), nil, nil)
+	tc.MockRepositoryFiles.EXPECT().GetRawFile("my-group/templates", ".gitlab/merge_request_templates", gomock.Any()).Return(nil, nil, gitlab.ErrNotFound)
+
+	refOpts := &gitlab.GetRawFileOptions{Ref: gitlab.Ptr(ref)}
+	tc.MockRepositoryFiles.EXPECT().GetRawFile("OWNER/REPO", bugTemplate, refOpts).Return([]byte("bug"), nil, nil)
+	if featureContent == "" {
+		tc.MockRepositoryFiles.EXPECT().GetRawFile("OWNER/REPO", featureTemplate, refOpts).Return(nil, nil, gitlab.ErrNotFound)
+	} else {
+		tc.MockRepositoryFiles.EXPECT().GetRawFile("OWNER/REPO", featureTemplate, refOpts).Return([]byte(featureContent), nil, nil)
+	}
+}
+
+func featureAction(action gitlab.FileActionValue) []*gitlab.CommitActionOptions {
+	return []*gitlab.CommitActionOptions{{
+		Action:   gitlab.Ptr(action),
+		FilePath: gitlab.Ptr(featureTemplate),
+		Content:  gitlab.Ptr(base64.StdEncoding.EncodeToString([]byte("feature"))),
+		Encoding: gitlab.Ptr("base64"),
+	}}
+}
+
+func TestTemplateSync(t *testing.T) {
+	tests := []struct {
+		name      string
+		cli       string
+		setupMock func(tc *gitlabtesting.TestClient)
+		wantOut   string
+		wantErr   string
+	}{
+		{
+			name: "Commit to the default branch",
+			cli:  "--from my-group/templates",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				expectTemplates(tc, "main", "")
+				tc.MockCommits.EXPECT().CreateCommit("OWNER/REPO", &gitlab.CreateCommitOptions{
+					Branch:        gitlab.Ptr("main"),
+					CommitMessage: gitlab.Ptr("Sync templates from my-group/templates"),
+					Actions:       featureAction(gitlab.FileCreate),
+				}).Return(&gitlab.Commit{ShortID: "abc1234"}, nil, nil)
+			},
+			wantOut: "  create .gitlab/issue_templates/Feature.md\n✓ Committed 1 template to main: abc1234\n",
+		},
+		{
+			name: "Open a merge request",
+			cli:  "--from my-group/templates --mr -m 'chore: update templates'",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				expectTemplates(tc, "main", "old feature")
+				tc.MockBranches.EXPECT().GetBranch("OWNER/REPO", "template-sync").Return(nil, nil, gitlab.ErrNotFound)
+				tc.MockCommits.EXPECT().CreateCommit("OWNER/REPO", &gitlab.CreateCommitOptions{
+					Branch:        gitlab.Ptr("template-sync"),
+					StartBranch:   gitlab.Ptr("main"),
+					CommitMessage: gitlab.Ptr("chore: update templates"),
+					Actions:       featureAction(gitlab.FileUpdate),
+				}).Return(&gitlab.Commit{ShortID: "abc1234"}, nil, nil)
+				tc.MockMergeRequests.EXPECT().CreateMergeRequest("OWNER/REPO", &gitlab.CreateMergeRequestOptions{
+					Title:              gitlab.Ptr("chore: update templates"),
+					Description:        gitlab.Ptr("Update the templates from my-group/templates."),
+					SourceBranch:       gitlab.Ptr("template-sync"),
+					TargetBranch:       gitlab.Ptr("main"),
+					RemoveSourceBranch: gitlab.Ptr(true),
+				}).Return(&gitlab.MergeRequest{BasicMergeRequest: gitlab.BasicMergeRequest{IID: 3, WebURL: "https://gitlab.com/OWNER/REPO/-/merge_requests/3"}}, nil, nil)
+			},
+			wantOut: "  update .gitlab/issue_templates/Feature.md\n" +
+				"✓ Committed 1 template to template-sync: abc1234\n" +
+				"✓ Opened merge request !3: https://gitlab.com/OWNER/REPO/-/merge_requests/3\n",
+		},
+		{
+			name: "Rerun while the merge request is open",
+			cli:  "--from my-group/templates --mr",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				expectTemplates(tc, "template-sync", "old feature")
+				tc.MockBranches.EXPECT().GetBranch("OWNER/REPO", "template-sync").Return(&gitlab.Branch{Name: "template-sync"}, nil, nil)
+				tc.MockCommits.EXPECT().CreateCommit("OWNER/REPO", &gitlab.CreateCommitOptions{
+					Branch:        gitlab.Ptr("template-sync"),
+					CommitMessage: gitlab.Ptr("Sync templates from my-group/templates"),
+					Actions:       featureAction(gitlab.FileUpdate),
+				}).Return(&gitlab.Commit{ShortID: "def5678"}, nil, nil)
+				tc.MockMergeRequests.EXPECT().ListProjectMergeRequests("OWNER/REPO", &gitlab.ListProjectMergeRequestsOptions{
+					SourceBranch: gitlab.Ptr("template-sync"),
+					TargetBranch: gitlab.Ptr("main"),
+					State:        gitlab.Ptr("opened"),
+				}).Return([]*gitlab.BasicMergeRequest{{IID: 3, WebURL: "https://gitlab.com/OWNER/REPO/-/merge_requests/3"}}, nil, nil)
+			},
+			wantOut: "  update .gitlab/issue_templates/Feature.md\n" +
+				"✓ Committed 1 template to template-sync: def5678\n" +
+				"✓ Updated merge request !3: https://gitlab.com/OWNER/REPO/-/merge_requests/3\n",
+		},
+		{
+			name: "Rerun when the branch is up to date",
+			cli:  "--from my-group/templates --mr",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				expectTemplates(tc, "template-sync", "feature")
+				tc.MockBranches.EXPECT().GetBranch("OWNER/REPO", "template-sync").Return(&gitlab.Branch{Name: "template-sync"}, nil, nil)
+			},
+			wantOut: "✓ Templates are up to date with my-group/templates.\n",
+		},
+		{
+			name: "Dry run",
+			cli:  "--from my-group/templates --dry-run",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				expectTemplates(tc, "main", "")
+			},
+			wantOut: "  create .gitlab/issue_templates/Feature.md\n1 template would change. Run again without --dry-run to commit.\n",
+		},
+		{
+			name: "Up to date",
+			cli:  "--from my-group/templates",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				expectTemplates(tc, "main", "feature")
+			},
+			wantOut: "✓ Templates are up to date with my-group/templates.\n",
+		},
+		{
+			name: "No templates",
+			cli:  "--from my-group/templates --path docs",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockProjects.EXPECT().GetProject("OWNER/REPO", gomock.Any()).Return(&gitlab.Project{DefaultBranch: "main"}, nil, nil)
+				tc.MockRepositories.EXPECT().ListTree("my-group/templates", gomock.Any(), gomock.Any()).Return(nil, nil, gitlab.ErrNotFound)
+				tc.MockRepositoryFiles.EXPECT().GetRawFile("my-group/templates", "docs", gomock.Any()).Return(nil, nil, gitlab.ErrNotFound)
+			},
+			wantErr: "no templates found in my-group/templates.",
+		},
+		{
+			name: "Merge request from the default branch",
+			cli:  "--from my-group/templates --mr --branch main",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockProjects.EXPECT().GetProject("OWNER/REPO", gomock.Any()).Return(&gitlab.Project{DefaultBranch: "main"}, nil, nil)
+			},
+			wantErr: "--branch must not be the default branch when used with --mr.",
+		},
+		{
+			name:    "Requires --from",
+			cli:     "",
+			wantErr: `required flag(s) "from" not set`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tc := gitlabtesting.NewTestClient(t)
+			if tt.setupMock != nil {
+				tt.setupMock(tc)
+			}
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdSync, false, cmdtest.WithGitLabClient(tc.Client))
+
+			out, err := exec(tt.cli)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOut, out.String())
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package template
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	templateSyncCmd "gitlab.com/gitlab-org/cli/internal/commands/template/sync"
+)
+
+func NewCmdTemplate(f cmdutils.Factory) *cobra.Command {
+	templateCmd := &cobra.Command{
+		Use:   "template <command> [flags]",
+		Short: `Manage the issue and merge request templates of a project.`,
+		Long: heredoc.Doc(`
+		Keep the issue and merge request templates of projects consistent with
+		the templates of a central repository.
+		`),
+	}
+
+	cmdutils.EnableRepoOverride(templateCmd, f)
+
+	templateCmd.AddCommand(templateSyncCmd.NewCmdSync(f))
+	return templateCmd
+}
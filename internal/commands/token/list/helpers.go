@@ -6,12 +6,13 @@ import (
 	"regexp"
 	"strings"
 
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
 	"gitlab.com/gitlab-org/cli/internal/tableprinter"
 )
 
 // createTablePrinter creates a table printer for all given tokens with column headers and values aligned.
-func createTablePrinter(tokens Tokens) *tableprinter.TablePrinter {
-	table := tableprinter.NewTablePrinter()
+func createTablePrinter(ios *iostreams.IOStreams, tokens Tokens) *tableprinter.TablePrinter {
+	table := tableprinter.New(ios)
 	table.NonTTYSeparator = " "
 	table.TTYSeparator = " "
 	val := reflect.ValueOf(Token{})
@@ -40,7 +41,7 @@ func createTablePrinter(tokens Tokens) *tableprinter.TablePrinter {
 		field := val.Type().Field(i)
 		columnNames = append(columnNames, fmt.Sprintf("%-*s", maxColumnWidths[i], toColumnName(field.Name)))
 	}
-	table.AddRow(columnNames...)
+	table.AddHeader(columnNames...)
 
 	for _, row := range tokens {
 		val := reflect.ValueOf(row)
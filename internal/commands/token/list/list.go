@@ -261,7 +261,7 @@ func (o *options) run() error {
 			return err
 		}
 	} else {
-		table := createTablePrinter(outputTokens)
+		table := createTablePrinter(o.io, outputTokens)
 		o.io.LogInfof("%s", table.String())
 	}
 	return nil
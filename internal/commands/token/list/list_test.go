@@ -29,6 +29,8 @@ func parseTime(s string) *time.Time {
 }
 
 func TestListProjectAccessToken(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
 	type testCase struct {
 		name        string
 		cli         string
@@ -113,6 +115,8 @@ func TestListProjectAccessToken(t *testing.T) {
 }
 
 func TestListGroupAccessToken(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
 	type testCase struct {
 		name        string
 		cli         string
@@ -197,6 +201,8 @@ func TestListGroupAccessToken(t *testing.T) {
 }
 
 func TestListPersonalAccessToken(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
 	type testCase struct {
 		name        string
 		cli         string
@@ -330,6 +336,8 @@ func TestListPersonalAccessToken(t *testing.T) {
 }
 
 func TestListPersonalAccessTokenWithoutExpiration(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
 	testUser := &gitlab.User{
 		ID:       1,
 		Username: "johndoe",
@@ -101,7 +101,7 @@ func (o *options) run() error {
 	}
 	client := apiClient.Lab()
 
-	table := tableprinter.NewTablePrinter()
+	table := tableprinter.New(o.io)
 
 	if o.group != "" {
 		o.io.LogInfof("Listing variables for the %s group:\n\n", color.Bold(o.group))
@@ -120,7 +120,7 @@ func (o *options) run() error {
 			fmt.Fprintln(o.io.StdOut, string(varListJSON))
 
 		} else {
-			table.AddRow("KEY", "PROTECTED", "MASKED", "HIDDEN", "EXPANDED", "SCOPE", "DESCRIPTION")
+			table.AddHeader("KEY", "PROTECTED", "MASKED", "HIDDEN", "EXPANDED", "SCOPE", "DESCRIPTION")
 			for _, variable := range variables {
 				table.AddRow(variable.Key, variable.Protected, variable.Masked, variable.Hidden, !variable.Raw, variable.EnvironmentScope, variable.Description)
 			}
@@ -142,7 +142,7 @@ func (o *options) run() error {
 			fmt.Fprintln(o.io.StdOut, string(varListJSON))
 
 		} else {
-			table.AddRow("KEY", "PROTECTED", "MASKED", "EXPANDED", "SCOPE", "DESCRIPTION")
+			table.AddHeader("KEY", "PROTECTED", "MASKED", "EXPANDED", "SCOPE", "DESCRIPTION")
 			for _, variable := range variables {
 				table.AddRow(variable.Key, variable.Protected, variable.Masked, !variable.Raw, "", variable.Description)
 			}
@@ -167,7 +167,7 @@ func (o *options) run() error {
 			varListJSON, _ := json.Marshal(variables)
 			fmt.Fprintln(o.io.StdOut, string(varListJSON))
 		} else {
-			table.AddRow("KEY", "PROTECTED", "MASKED", "HIDDEN", "EXPANDED", "SCOPE", "DESCRIPTION")
+			table.AddHeader("KEY", "PROTECTED", "MASKED", "HIDDEN", "EXPANDED", "SCOPE", "DESCRIPTION")
 			for _, variable := range variables {
 				table.AddRow(variable.Key, variable.Protected, variable.Masked, variable.Hidden, !variable.Raw, variable.EnvironmentScope, variable.Description)
 			}
@@ -0,0 +1,225 @@
+package forward
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"slices"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+const defaultRelay = "https://smee.io"
+
+// hookEvents are the events that the webhook can be triggered by.
+var hookEvents = []string{"push", "tag_push", "issues", "merge_requests", "note", "pipeline", "job", "deployment", "releases", "wiki_page"}
+
+type options struct {
+	relay        string
+	events       []string
+	forwardTo    string
+	outputFormat string
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+	httpClient   *http.Client
+}
+
+func NewCmdForward(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+		httpClient:   &http.Client{},
+	}
+	webhookForwardCmd := &cobra.Command{
+		Use:   "forward [flags]",
+		Short: `Receive the webhook events of a project locally.`,
+		Long: heredoc.Docf(`
+			Add a temporary webhook to the project that sends events to a relay, then print
+			the events as they arrive and, optionally, forward them to a local server. The
+			webhook is deleted when the command stops.
+
+			The relay is a public service like %[1]s%[2]s%[1]s that GitLab can reach. Events pass
+			through the relay, so use a relay that you trust, or host your own, for private
+			projects. Only events signed with the secret token of the webhook are forwarded.
+		`, "`", defaultRelay),
+		Args: cobra.NoArgs,
+		Example: heredoc.Doc(`
+			# Print push and merge request events
+			$ glab webhook forward --events push,merge_requests
+
+			# Forward all events to a server on port 8080
+			$ glab webhook forward --forward-to 8080
+
+			# Forward pipeline events to a path, through a self-hosted relay channel
+			$ glab webhook forward --events pipeline --forward-to http://localhost:3000/hooks/gitlab --relay https://relay.example.com/my-channel
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			for _, e := range opts.events {
+				if !slices.Contains(hookEvents, e) {
+					return &cmdutils.FlagError{Err: fmt.Errorf("invalid event %q. Must be one of: %s.", e, strings.Join(hookEvents, ", "))}
+				}
+			}
+			if _, err := strconv.Atoi(opts.forwardTo); err == nil {
+				opts.forwardTo = "http://localhost:" + opts.forwardTo + "/"
+			}
+
+			// Stop on interrupt, and still delete the webhook.
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			return opts.run(ctx)
+		},
+	}
+
+	fl := webhookForwardCmd.Flags()
+	fl.StringVar(&opts.relay, "relay", defaultRelay, "Relay service to create a channel on, or the URL of an existing channel.")
+	fl.StringSliceVar(&opts.events, "events", hookEvents, fmt.Sprintf("Comma-separated list of events to receive: %s.", strings.Join(hookEvents, ", ")))
+	fl.StringVar(&opts.forwardTo, "forward-to", "", "URL or local port to forward the events to, such as http://localhost:3000/webhook or 3000.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, or json for the body of every event on its own line.")
+
+	return webhookForwardCmd
+}
+
+func (o *options) run(ctx context.Context) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	channel := o.relay
+	if u := strings.TrimSuffix(o.relay, "/"); u == defaultRelay {
+		channel, err = newChannel(ctx, o.httpClient, u)
+		if err != nil {
+			return fmt.Errorf("could not create a relay channel: %w", err)
+		}
+	}
+
+	secret := make([]byte, 16)
+	if _, err := rand.Read(secret); err != nil {
+		return err
+	}
+	token := hex.EncodeToString(secret)
+
+	hook, _, err := client.Projects.AddProjectHook(repo.FullName(), o.hookOptions(channel, token))
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to add the webhook.")
+	}
+	defer func() {
+		// The context is canceled by now, so don't use it.
+		if _, err := client.Projects.DeleteProjectHook(repo.FullName(), hook.ID); err != nil {
+			fmt.Fprintf(o.io.StdErr, "%s Could not delete webhook %d. Delete it in the settings of %s.\n", o.io.Color().FailedIcon(), hook.ID, repo.FullName())
+			return
+		}
+		fmt.Fprintf(o.io.StdErr, "%s Deleted webhook %d.\n", o.io.Color().RedCheck(), hook.ID)
+	}()
+
+	c := o.io.Color()
+	fmt.Fprintf(o.io.StdErr, "%s Added webhook %d to %s, sending %s events to %s.\n", c.GreenCheck(), hook.ID, repo.FullName(), strings.Join(o.events, ", "), channel)
+	if o.forwardTo != "" {
+		fmt.Fprintf(o.io.StdErr, "Forwarding events to %s. Press Ctrl+C to stop.\n", o.forwardTo)
+	} else {
+		fmt.Fprintln(o.io.StdErr, "Waiting for events. Press Ctrl+C to stop.")
+	}
+
+	return stream(ctx, o.httpClient, channel, func(e relayEvent) {
+		if e.headers["x-gitlab-token"] != token {
+			return
+		}
+		o.handle(ctx, e)
+	})
+}
+
+func (o *options) hookOptions(url, token string) *gitlab.AddProjectHookOptions {
+	// Events that are not set default to true for push events, so set all of them.
+	on := func(event string) *bool {
+		return gitlab.Ptr(slices.Contains(o.events, event))
+	}
+	return &gitlab.AddProjectHookOptions{
+		Name:                     gitlab.Ptr("glab webhook forward"),
+		Description:              gitlab.Ptr("Temporary webhook of 'glab webhook forward'. Delete it if the command is not running."),
+		URL:                      gitlab.Ptr(url),
+		Token:                    gitlab.Ptr(token),
+		EnableSSLVerification:    gitlab.Ptr(true),
+		PushEvents:               on("push"),
+		TagPushEvents:            on("tag_push"),
+		IssuesEvents:             on("issues"),
+		ConfidentialIssuesEvents: on("issues"),
+		MergeRequestsEvents:      on("merge_requests"),
+		NoteEvents:               on("note"),
+		ConfidentialNoteEvents:   on("note"),
+		PipelineEvents:           on("pipeline"),
+		JobEvents:                on("job"),
+		DeploymentEvents:         on("deployment"),
+		ReleasesEvents:           on("releases"),
+		WikiPageEvents:           on("wiki_page"),
+	}
+}
+
+// handle prints an event, and forwards it.
+func (o *options) handle(ctx context.Context, e relayEvent) {
+	if o.outputFormat == "json" {
+		fmt.Fprintln(o.io.StdOut, string(e.body))
+	} else {
+		fmt.Fprintf(o.io.StdOut, "%s %s\n", time.Now().Format(time.TimeOnly), e.headers["x-gitlab-event"])
+	}
+	if o.forwardTo == "" {
+		return
+	}
+
+	c := o.io.Color()
+	status, err := o.forward(ctx, e)
+	switch {
+	case err != nil:
+		fmt.Fprintf(o.io.StdErr, "%s Could not forward the event: %v\n", c.FailedIcon(), err)
+	case status >= http.StatusBadRequest:
+		fmt.Fprintf(o.io.StdErr, "%s %s responded %d %s\n", c.WarnIcon(), o.forwardTo, status, http.StatusText(status))
+	}
+}
+
+// forward sends the event to the local server, with the headers that GitLab sent.
+func (o *options) forward(ctx context.Context, e relayEvent) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, o.forwardTo, bytes.NewReader(e.body))
+	if err != nil {
+		return 0, err
+	}
+	for name, value := range e.headers {
+		req.Header.Set(name, value)
+	}
+	if req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := o.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+	return resp.StatusCode, nil
+}
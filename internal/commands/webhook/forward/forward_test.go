@@ -0,0 +1,122 @@
+//go:build !integration
+
+package forward
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+// relayServer serves a channel that sends the events, then closes the stream.
+func relayServer(t *testing.T, events func() []string) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "text/event-stream", r.Header.Get("Accept"))
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprint(w, "event: ready\ndata: {}\n\n")
+		for _, e := range events() {
+			fmt.Fprintf(w, "data: %s\n\n", e)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestWebhookForward(t *testing.T) {
+	var received []string
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received = append(received, r.Header.Get("X-Gitlab-Event")+" "+string(body))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(target.Close)
+
+	var token string
+	relay := relayServer(t, func() []string {
+		return []string{
+			fmt.Sprintf(`{"x-gitlab-event":"Push Hook","x-gitlab-token":%q,"body":{"object_kind":"push"}}`, token),
+			`{"x-gitlab-event":"Push Hook","x-gitlab-token":"forged","body":{"object_kind":"forged"}}`,
+			fmt.Sprintf(`{"x-gitlab-event":"Merge Request Hook","x-gitlab-token":%q,"body":{"object_kind":"merge_request"}}`, token),
+		}
+	})
+
+	tc := gitlabtesting.NewTestClient(t)
+	tc.MockProjects.EXPECT().AddProjectHook("OWNER/REPO", gomock.Any()).DoAndReturn(
+		func(_ any, opt *gitlab.AddProjectHookOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error) {
+			assert.Equal(t, relay.URL+"/channel", *opt.URL)
+			assert.True(t, *opt.PushEvents)
+			assert.True(t, *opt.MergeRequestsEvents)
+			assert.False(t, *opt.PipelineEvents)
+			token = *opt.Token
+			return &gitlab.ProjectHook{ID: 12}, nil, nil
+		})
+	tc.MockProjects.EXPECT().DeleteProjectHook("OWNER/REPO", int64(12)).Return(nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdForward, false, cmdtest.WithGitLabClient(tc.Client))
+
+	out, err := exec(fmt.Sprintf("--relay %s/channel --events push,merge_requests --forward-to %s", relay.URL, target.URL))
+	require.EqualError(t, err, "the relay closed the connection")
+
+	assert.Equal(t, []string{
+		`Push Hook {"object_kind":"push"}`,
+		`Merge Request Hook {"object_kind":"merge_request"}`,
+	}, received)
+	assert.Contains(t, out.String(), "Push Hook\n")
+	assert.Contains(t, out.String(), "Merge Request Hook\n")
+	assert.NotContains(t, out.String(), "forged")
+	assert.Contains(t, out.Stderr(), "✓ Added webhook 12 to OWNER/REPO, sending push, merge_requests events to "+relay.URL+"/channel.\n")
+	assert.Contains(t, out.Stderr(), "✓ Deleted webhook 12.\n")
+}
+
+func TestWebhookForward_InvalidEvent(t *testing.T) {
+	exec := cmdtest.SetupCmdForTest(t, NewCmdForward, false)
+
+	_, err := exec("--events push,builds")
+	require.EqualError(t, err, `invalid event "builds". Must be one of: push, tag_push, issues, merge_requests, note, pipeline, job, deployment, releases, wiki_page.`)
+}
+
+func TestNewChannel(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/new", r.URL.Path)
+		http.Redirect(w, r, "https://smee.io/abc123", http.StatusTemporaryRedirect)
+	}))
+	t.Cleanup(srv.Close)
+
+	channel, err := newChannel(context.Background(), &http.Client{}, srv.URL+"/")
+	require.NoError(t, err)
+	assert.Equal(t, "https://smee.io/abc123", channel)
+}
+
+func TestReadEvents(t *testing.T) {
+	input := strings.Join([]string{
+		": a comment",
+		"event: ping",
+		"data: {}",
+		"",
+		"data: line one",
+		"data: line two",
+		"",
+		"",
+	}, "\n")
+
+	var events []string
+	err := readEvents(strings.NewReader(input), func(name, data string) {
+		events = append(events, name+"|"+data)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ping|{}", "|line one\nline two"}, events)
+}
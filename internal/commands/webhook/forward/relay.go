@@ -0,0 +1,133 @@
+package forward
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// maxEventSize is the largest webhook payload that is read from the relay.
+const maxEventSize = 25 * 1024 * 1024
+
+// relayEvent is a webhook request that a smee.io-compatible relay received. The
+// relay sends the lowercased request headers and the body in one JSON object.
+type relayEvent struct {
+	headers map[string]string
+	body    json.RawMessage
+}
+
+func (e *relayEvent) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	e.headers = map[string]string{}
+	for name, value := range fields {
+		switch {
+		case name == "body":
+			e.body = value
+		case strings.HasPrefix(name, "x-") || name == "content-type" || name == "user-agent":
+			var s string
+			if err := json.Unmarshal(value, &s); err == nil {
+				e.headers[name] = s
+			}
+		}
+	}
+	return nil
+}
+
+// newChannel asks the relay for a new channel, and returns its URL.
+func newChannel(ctx context.Context, client *http.Client, relay string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(relay, "/")+"/new", nil)
+	if err != nil {
+		return "", err
+	}
+
+	noRedirect := *client
+	noRedirect.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+	resp, err := noRedirect.Do(req)
+	if err != nil {
+		return "", err
+	}
+	resp.Body.Close()
+
+	location := resp.Header.Get("Location")
+	if location == "" {
+		return "", fmt.Errorf("%s did not create a channel: %s", relay, resp.Status)
+	}
+	return location, nil
+}
+
+// stream reads the events of a relay channel, and calls handle for every webhook
+// request. It returns when the context is canceled or the relay closes the stream.
+func stream(ctx context.Context, client *http.Client, channel string, handle func(relayEvent)) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, channel, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("could not connect to %s: %s", channel, resp.Status)
+	}
+
+	err = readEvents(resp.Body, func(name, data string) {
+		// The relay also sends "ready" and "ping" events, which have no request.
+		if name != "" && name != "message" {
+			return
+		}
+		var e relayEvent
+		if json.Unmarshal([]byte(data), &e) == nil && e.body != nil {
+			handle(e)
+		}
+	})
+	if ctx.Err() != nil {
+		return nil
+	}
+	if err == nil {
+		err = errors.New("the relay closed the connection")
+	}
+	return err
+}
+
+// readEvents parses a server-sent event stream, and calls dispatch for every event.
+func readEvents(r io.Reader, dispatch func(name, data string)) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxEventSize)
+
+	var name string
+	var data []string
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			if len(data) > 0 {
+				dispatch(name, strings.Join(data, "\n"))
+			}
+			name, data = "", nil
+			continue
+		}
+
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+		switch field {
+		case "event":
+			name = value
+		case "data":
+			data = append(data, value)
+		}
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,21 @@
+package webhook
+
+import (
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	webhookForwardCmd "gitlab.com/gitlab-org/cli/internal/commands/webhook/forward"
+)
+
+func NewCmdWebhook(f cmdutils.Factory) *cobra.Command {
+	webhookCmd := &cobra.Command{
+		Use:   "webhook <command> [flags]",
+		Short: `Work with project webhooks.`,
+		Long:  ``,
+	}
+
+	cmdutils.EnableRepoOverride(webhookCmd, f)
+
+	webhookCmd.AddCommand(webhookForwardCmd.NewCmdForward(f))
+	return webhookCmd
+}
@@ -0,0 +1,21 @@
+//go:build !integration
+
+package webhook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestNewCmdWebhook(t *testing.T) {
+	ios, _, _, _ := cmdtest.TestIOStreams()
+	cmd := NewCmdWebhook(cmdtest.NewTestFactory(ios))
+
+	assert.Equal(t, "webhook <command> [flags]", cmd.Use)
+	require.Len(t, cmd.Commands(), 1)
+	assert.Equal(t, "forward", cmd.Commands()[0].Name())
+}
@@ -0,0 +1,83 @@
+package close
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/workitem/workitemutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	io              *iostreams.IOStreams
+	gitlabClient    func() (*gitlab.Client, error)
+	baseRepo        func() (glrepo.Interface, error)
+	defaultHostname func() string
+}
+
+func NewCmdClose(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:              f.IO(),
+		gitlabClient:    f.GitLabClient,
+		baseRepo:        f.BaseRepo,
+		defaultHostname: f.DefaultHostname,
+	}
+
+	workItemCloseCmd := &cobra.Command{
+		Use:     "close <id> [flags]",
+		Short:   `Close a work item, like a task to mark it as completed.`,
+		Aliases: []string{"complete", "done"},
+		Example: heredoc.Doc(`
+			$ glab workitem close 45
+			$ glab workitem complete 45
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run(args[0])
+		},
+	}
+
+	return workItemCloseCmd
+}
+
+func (o *options) run(arg string) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	project, iid, err := workitemutils.WorkItemFromArg(arg, o.baseRepo, o.defaultHostname())
+	if err != nil {
+		return err
+	}
+
+	c := o.io.Color()
+	workItem, err := api.GetWorkItem(client, project, iid)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to get work item #%d of %s.", iid, project))
+	}
+	if workItem.Closed() {
+		fmt.Fprintf(o.io.StdOut, "%s %s #%d is already closed.\n", c.WarnIcon(), workItem.Type, iid)
+		return nil
+	}
+
+	workItem, err = api.SetWorkItemState(client, workItem.ID, "CLOSE")
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to close work item #%d.", iid))
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Closed %s #%d\n", c.RedCheck(), workItem.Type, workItem.IID)
+	fmt.Fprintln(o.io.StdOut, workitemutils.DisplayWorkItem(c, workItem, o.io.IsOutputTTY()))
+	return nil
+}
@@ -0,0 +1,125 @@
+package create
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/workitem/workitemutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	title        string
+	description  string
+	workItemType string
+	parent       string
+
+	io              *iostreams.IOStreams
+	gitlabClient    func() (*gitlab.Client, error)
+	baseRepo        func() (glrepo.Interface, error)
+	defaultHostname func() string
+}
+
+func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:              f.IO(),
+		gitlabClient:    f.GitLabClient,
+		baseRepo:        f.BaseRepo,
+		defaultHostname: f.DefaultHostname,
+	}
+
+	workItemCreateCmd := &cobra.Command{
+		Use:     "create [flags]",
+		Short:   `Create a work item, like a child task of an issue.`,
+		Aliases: []string{"new"},
+		Long: heredoc.Doc(`
+			Create a work item. Tasks are created by default.
+
+			With --parent, the work item is created as a child of another work
+			item, like a task of an issue, in the project of the parent.
+		`),
+		Example: heredoc.Doc(`
+			# Add a task to issue 12
+			$ glab workitem create --parent 12 --title "Update the documentation"
+
+			# Add a key result to objective 30
+			$ glab workitem create --type key_result --parent 30 --title "Reduce build time by 20%"
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.title == "" {
+				return &cmdutils.FlagError{Err: errors.New("--title is required.")}
+			}
+			return opts.run()
+		},
+	}
+
+	fl := workItemCreateCmd.Flags()
+	fl.StringVarP(&opts.title, "title", "t", "", "Title of the work item.")
+	fl.StringVarP(&opts.description, "description", "d", "", "Description of the work item.")
+	fl.VarP(cmdutils.NewEnumValue(workitemutils.Types, "task", &opts.workItemType), "type", "T", "Type of the work item: task, issue, incident, objective, key_result.")
+	fl.StringVar(&opts.parent, "parent", "", "Parent of the work item, like the issue of a task.")
+
+	return workItemCreateCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	createOpts := &api.CreateWorkItemOptions{
+		Type:        workitemutils.TypeName(o.workItemType),
+		Title:       o.title,
+		Description: o.description,
+	}
+
+	var project string
+	var parent *api.WorkItem
+	if o.parent != "" {
+		var iid int64
+		project, iid, err = workitemutils.WorkItemFromArg(o.parent, o.baseRepo, o.defaultHostname())
+		if err != nil {
+			return &cmdutils.FlagError{Err: err}
+		}
+		parent, err = api.GetWorkItem(client, project, iid)
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to get the parent work item #%d.", iid))
+		}
+		createOpts.ParentID = parent.ID
+	} else {
+		repo, err := o.baseRepo()
+		if err != nil {
+			return err
+		}
+		project = repo.FullName()
+	}
+
+	workItem, err := api.CreateWorkItem(client, project, createOpts)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to create the %s.", strings.ToLower(createOpts.Type)))
+	}
+
+	c := o.io.Color()
+	if parent != nil {
+		fmt.Fprintf(o.io.StdOut, "%s Created %s #%d in %s #%d\n", c.GreenCheck(), workItem.Type, workItem.IID, parent.Type, parent.IID)
+	} else {
+		fmt.Fprintf(o.io.StdOut, "%s Created %s #%d\n", c.GreenCheck(), workItem.Type, workItem.IID)
+	}
+	fmt.Fprintln(o.io.StdOut, workitemutils.DisplayWorkItem(c, workItem, o.io.IsOutputTTY()))
+	return nil
+}
@@ -0,0 +1,73 @@
+//go:build !integration
+
+package create
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+const typesResponse = `{"data": {"project": {"workItemTypes": {"nodes": [
+	{"id": "gid://gitlab/WorkItems::Type/1", "name": "Issue"},
+	{"id": "gid://gitlab/WorkItems::Type/5", "name": "Task"},
+	{"id": "gid://gitlab/WorkItems::Type/8", "name": "Key Result"}
+]}}}}`
+
+func TestWorkItemCreate(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	testClient := gitlabtesting.NewTestClient(t)
+	gomock.InOrder(
+		testClient.MockGraphQL.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(cmdtest.GraphQLResponse(`{"data": {"project": {"workItems": {"nodes": [{"id": "gid://gitlab/WorkItem/100", "iid": "12", "title": "Search", "state": "OPEN", "workItemType": {"name": "Issue"}}]}}}}`)),
+		testClient.MockGraphQL.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(cmdtest.GraphQLResponse(typesResponse)),
+		testClient.MockGraphQL.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(query gitlab.GraphQLQuery, response any, opts ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+				assert.Equal(t, map[string]any{"input": map[string]any{
+					"namespacePath":     "OWNER/REPO",
+					"workItemTypeId":    "gid://gitlab/WorkItems::Type/5",
+					"title":             "Update the docs",
+					"descriptionWidget": map[string]any{"description": "For the new search"},
+					"hierarchyWidget":   map[string]any{"parentId": "gid://gitlab/WorkItem/100"},
+				}}, query.Variables)
+				return cmdtest.GraphQLResponse(`{"data": {"workItemCreate": {"workItem": {"id": "gid://gitlab/WorkItem/103", "iid": "15", "title": "Update the docs", "state": "OPEN", "workItemType": {"name": "Task"}, "webUrl": "https://gitlab.com/OWNER/REPO/-/work_items/15"}, "errors": []}}}`)(query, response, opts...)
+			}),
+	)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdCreate, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	out, err := exec(`--parent 12 --title "Update the docs" -d "For the new search"`)
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Created Task #15 in Issue #12\nhttps://gitlab.com/OWNER/REPO/-/work_items/15\n", out.String())
+}
+
+func TestWorkItemCreateErrors(t *testing.T) {
+	t.Run("type not available", func(t *testing.T) {
+		testClient := gitlabtesting.NewTestClient(t)
+		testClient.MockGraphQL.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(cmdtest.GraphQLResponse(`{"data": {"project": {"workItemTypes": {"nodes": [{"id": "gid://gitlab/WorkItems::Type/1", "name": "Issue"}]}}}}`))
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdCreate, false, cmdtest.WithGitLabClient(testClient.Client))
+
+		_, err := exec("--type key_result --title Grow")
+		require.EqualError(t, err, `work item type "Key Result" isn't available in OWNER/REPO. Available types: Issue.`)
+	})
+
+	t.Run("title is required", func(t *testing.T) {
+		testClient := gitlabtesting.NewTestClient(t)
+		exec := cmdtest.SetupCmdForTest(t, NewCmdCreate, false, cmdtest.WithGitLabClient(testClient.Client))
+
+		_, err := exec("--parent 12")
+		require.EqualError(t, err, "--title is required.")
+	})
+}
@@ -0,0 +1,179 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/workitem/workitemutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	parent       string
+	types        []string
+	state        string
+	perPage      int
+	outputFormat string
+
+	// With --parent, the children are only filtered by the flags that are set.
+	filterType  bool
+	filterState bool
+
+	io              *iostreams.IOStreams
+	gitlabClient    func() (*gitlab.Client, error)
+	baseRepo        func() (glrepo.Interface, error)
+	defaultHostname func() string
+}
+
+func NewCmdList(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:              f.IO(),
+		gitlabClient:    f.GitLabClient,
+		baseRepo:        f.BaseRepo,
+		defaultHostname: f.DefaultHostname,
+	}
+
+	workItemListCmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   `List the work items of a project, or the child tasks of an issue.`,
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			# List the open tasks of the project
+			$ glab workitem list
+
+			# List the child tasks of issue 12, with their completion status
+			$ glab workitem list --parent 12
+
+			# List the objectives and key results of the project
+			$ glab workitem list --type objective,key_result --state all
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			for _, t := range opts.types {
+				if !slices.Contains(workitemutils.Types, t) {
+					return &cmdutils.FlagError{Err: fmt.Errorf("invalid work item type %q. Use one of: %s.", t, strings.Join(workitemutils.Types, ", "))}
+				}
+			}
+			opts.filterType = cmd.Flags().Changed("type")
+			opts.filterState = cmd.Flags().Changed("state")
+			return opts.run()
+		},
+	}
+
+	fl := workItemListCmd.Flags()
+	fl.StringVar(&opts.parent, "parent", "", "List the children of this work item, like an issue, instead of the work items of the project.")
+	fl.StringSliceVarP(&opts.types, "type", "t", []string{"task"}, "List the work items of these types: task, issue, incident, objective, key_result. Ignored with --parent unless set.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"opened", "closed", "all"}, "opened", &opts.state), "state", "s", "List the work items in this state: opened, closed, all. Defaults to all with --parent.")
+	fl.IntVarP(&opts.perPage, "per-page", "P", 30, "Number of work items to list.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+
+	return workItemListCmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	var items []*api.WorkItem
+	var scope string
+	if o.parent != "" {
+		project, iid, err := workitemutils.WorkItemFromArg(o.parent, o.baseRepo, o.defaultHostname())
+		if err != nil {
+			return &cmdutils.FlagError{Err: err}
+		}
+		parent, err := api.GetWorkItem(client, project, iid)
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to get work item #%d.", iid))
+		}
+		items = o.filterChildren(parent.Children)
+		scope = fmt.Sprintf("#%d", iid)
+	} else {
+		repo, err := o.baseRepo()
+		if err != nil {
+			return err
+		}
+		filter := &api.WorkItemsFilter{
+			Types: utils.Map(o.types, workitemutils.GraphQLType),
+			State: o.state,
+			First: int64(o.perPage),
+		}
+		items, err = api.ListWorkItems(client, repo.FullName(), filter)
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to list the work items of %s.", repo.FullName()))
+		}
+		scope = repo.FullName()
+	}
+
+	if o.outputFormat == "json" {
+		itemsJSON, _ := json.Marshal(items)
+		fmt.Fprintln(o.io.StdOut, string(itemsJSON))
+		return nil
+	}
+
+	if len(items) == 0 {
+		o.io.LogInfof("No work items match your search in %s.\n", scope)
+		return nil
+	}
+
+	c := o.io.Color()
+	table := tableprinter.New(o.io)
+	table.SetIsTTY(o.io.IsOutputTTY())
+	table.AddRow("", "ID", "Type", "Title")
+	for _, item := range items {
+		table.AddCell(workitemutils.Checkbox(c, item))
+		table.AddCell(o.io.Hyperlink(workitemutils.State(c, item), item.WebURL))
+		table.AddCell(c.Gray(item.Type))
+		table.AddCell(item.Title)
+		table.EndRow()
+	}
+
+	if o.parent != "" {
+		completed := 0
+		for _, item := range items {
+			if item.Closed() {
+				completed++
+			}
+		}
+		fmt.Fprintf(o.io.StdOut, "Showing %s of %s, %d completed.\n\n", utils.Pluralize(len(items), "child item"), scope, completed)
+	} else {
+		fmt.Fprintf(o.io.StdOut, "Showing %s in %s.\n\n", utils.Pluralize(len(items), "work item"), scope)
+	}
+	fmt.Fprint(o.io.StdOut, table.Render())
+	return nil
+}
+
+// filterChildren returns the children of the types and state of the --type
+// and --state flags, when they're set.
+func (o *options) filterChildren(children []*api.WorkItem) []*api.WorkItem {
+	items := make([]*api.WorkItem, 0, len(children))
+	for _, child := range children {
+		if o.filterType && !slices.ContainsFunc(o.types, func(t string) bool {
+			return strings.EqualFold(workitemutils.TypeName(t), child.Type)
+		}) {
+			continue
+		}
+		if o.filterState && (o.state == "opened" && child.Closed() || o.state == "closed" && !child.Closed()) {
+			continue
+		}
+		items = append(items, child)
+	}
+	return items
+}
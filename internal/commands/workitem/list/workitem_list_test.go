@@ -0,0 +1,99 @@
+//go:build !integration
+
+package list
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+const issueResponse = `{"data": {"project": {"workItems": {"nodes": [{
+	"id": "gid://gitlab/WorkItem/100", "iid": "12", "title": "Search", "state": "OPEN", "workItemType": {"name": "Issue"},
+	"widgets": [{"type": "HIERARCHY", "children": {"nodes": [
+		{"id": "gid://gitlab/WorkItem/101", "iid": "13", "title": "Index", "state": "CLOSED", "workItemType": {"name": "Task"}},
+		{"id": "gid://gitlab/WorkItem/102", "iid": "14", "title": "Docs", "state": "OPEN", "workItemType": {"name": "Task"}}
+	]}}]
+}]}}}}`
+
+func TestWorkItemList(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	tests := []struct {
+		name          string
+		args          string
+		response      string
+		wantVariables map[string]any
+		wantOut       []string
+		wantNotOut    []string
+	}{
+		{
+			name:          "tasks of the project",
+			args:          "",
+			response:      `{"data": {"project": {"workItems": {"nodes": [{"iid": "14", "title": "Docs", "state": "OPEN", "workItemType": {"name": "Task"}}]}}}}`,
+			wantVariables: map[string]any{"fullPath": "OWNER/REPO", "first": int64(30), "types": []string{"TASK"}, "state": "opened"},
+			wantOut:       []string{"Showing 1 work item in OWNER/REPO.", "[ ]\t#14\tTask\tDocs"},
+		},
+		{
+			name:          "objectives and key results in all states",
+			args:          "--type objective,key_result --state all -P 5",
+			response:      `{"data": {"project": {"workItems": {"nodes": []}}}}`,
+			wantVariables: map[string]any{"fullPath": "OWNER/REPO", "first": int64(5), "types": []string{"OBJECTIVE", "KEY_RESULT"}},
+			wantOut:       []string{"No work items match your search in OWNER/REPO."},
+		},
+		{
+			name:          "children of an issue",
+			args:          "--parent 12",
+			response:      issueResponse,
+			wantVariables: map[string]any{"fullPath": "OWNER/REPO", "iid": "12"},
+			wantOut:       []string{"Showing 2 child items of #12, 1 completed.", "[x]\t#13\tTask\tIndex", "[ ]\t#14\tTask\tDocs"},
+		},
+		{
+			name:          "open children of an issue",
+			args:          "--parent 12 --state opened",
+			response:      issueResponse,
+			wantVariables: map[string]any{"fullPath": "OWNER/REPO", "iid": "12"},
+			wantOut:       []string{"Showing 1 child item of #12, 0 completed.", "#14"},
+			wantNotOut:    []string{"#13"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			testClient.MockGraphQL.EXPECT().
+				Do(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(query gitlab.GraphQLQuery, response any, opts ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+					assert.Equal(t, tt.wantVariables, query.Variables)
+					return cmdtest.GraphQLResponse(tt.response)(query, response, opts...)
+				})
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdList, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			out, err := exec(tt.args)
+			require.NoError(t, err)
+
+			for _, want := range tt.wantOut {
+				assert.Contains(t, out.String(), want)
+			}
+			for _, notWant := range tt.wantNotOut {
+				assert.NotContains(t, out.String(), notWant)
+			}
+		})
+	}
+}
+
+func TestWorkItemListInvalidType(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+	exec := cmdtest.SetupCmdForTest(t, NewCmdList, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	_, err := exec("--type epic")
+	require.EqualError(t, err, `invalid work item type "epic". Use one of: task, issue, incident, objective, key_result.`)
+}
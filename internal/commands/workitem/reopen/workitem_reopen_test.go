@@ -0,0 +1,38 @@
+//go:build !integration
+
+package reopen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestWorkItemReopen(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	testClient := gitlabtesting.NewTestClient(t)
+	gomock.InOrder(
+		testClient.MockGraphQL.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(cmdtest.GraphQLResponse(`{"data": {"project": {"workItems": {"nodes": [{"id": "gid://gitlab/WorkItem/101", "iid": "13", "state": "CLOSED", "workItemType": {"name": "Task"}}]}}}}`)),
+		testClient.MockGraphQL.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(query gitlab.GraphQLQuery, response any, opts ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+				assert.Equal(t, map[string]any{"id": "gid://gitlab/WorkItem/101", "stateEvent": "REOPEN"}, query.Variables)
+				return cmdtest.GraphQLResponse(`{"data": {"workItemUpdate": {"workItem": {"id": "gid://gitlab/WorkItem/101", "iid": "13", "state": "OPEN", "workItemType": {"name": "Task"}, "webUrl": "https://gitlab.com/OWNER/REPO/-/work_items/13"}, "errors": []}}}`)(query, response, opts...)
+			}),
+	)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdReopen, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	out, err := exec("group/project#13")
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Reopened Task #13\nhttps://gitlab.com/OWNER/REPO/-/work_items/13\n", out.String())
+}
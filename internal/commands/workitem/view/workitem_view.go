@@ -0,0 +1,175 @@
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/workitem/workitemutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	web          bool
+	outputFormat string
+
+	workItem *api.WorkItem
+
+	io              *iostreams.IOStreams
+	gitlabClient    func() (*gitlab.Client, error)
+	baseRepo        func() (glrepo.Interface, error)
+	config          func() config.Config
+	defaultHostname func() string
+}
+
+func NewCmdView(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:              f.IO(),
+		gitlabClient:    f.GitLabClient,
+		baseRepo:        f.BaseRepo,
+		config:          f.Config,
+		defaultHostname: f.DefaultHostname,
+	}
+
+	workItemViewCmd := &cobra.Command{
+		Use:     "view <id> [flags]",
+		Short:   `Display a work item, with its parent and its children.`,
+		Aliases: []string{"show"},
+		Example: heredoc.Doc(`
+			$ glab workitem view 12
+			$ glab workitem view group/project#12 --output json
+			$ glab workitem view https://gitlab.com/NAMESPACE/REPO/-/work_items/12
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run(args[0])
+		},
+	}
+
+	fl := workItemViewCmd.Flags()
+	fl.BoolVarP(&opts.web, "web", "w", false, "Open the work item in a browser. Uses the default browser, or the browser specified in the $BROWSER variable.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+
+	return workItemViewCmd
+}
+
+func (o *options) run(arg string) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	project, iid, err := workitemutils.WorkItemFromArg(arg, o.baseRepo, o.defaultHostname())
+	if err != nil {
+		return err
+	}
+
+	o.workItem, err = api.GetWorkItem(client, project, iid)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to get work item #%d of %s.", iid, project))
+	}
+
+	if o.web {
+		if o.io.IsaTTY && o.io.IsErrTTY {
+			fmt.Fprintf(o.io.StdErr, "Opening %s in your browser.\n", utils.DisplayURL(o.workItem.WebURL))
+		}
+		browser, _ := o.config().Get(client.BaseURL().Hostname(), "browser")
+		return utils.OpenInBrowser(o.workItem.WebURL, browser)
+	}
+
+	switch {
+	case o.outputFormat == "json":
+		workItemJSON, _ := json.Marshal(o.workItem)
+		fmt.Fprintln(o.io.StdOut, string(workItemJSON))
+	case o.io.IsOutputTTY():
+		o.printTTYWorkItem()
+	default:
+		fmt.Fprint(o.io.StdOut, o.rawWorkItem())
+	}
+	return nil
+}
+
+func (o *options) printTTYWorkItem() {
+	c := o.io.Color()
+	out := o.io.StdOut
+	w := o.workItem
+
+	state := c.Green("open")
+	if w.Closed() {
+		state = c.Red("closed")
+	}
+	fmt.Fprint(out, state+c.Gray(" • "+w.Type))
+	if w.Author != "" && w.CreatedAt != nil {
+		fmt.Fprint(out, c.Gray(fmt.Sprintf(" • opened by %s %s", w.Author, utils.TimeToPrettyTimeAgo(*w.CreatedAt))))
+	}
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, c.Bold(w.Title)+c.Gray(fmt.Sprintf(" #%d", w.IID)))
+
+	if w.Description != "" {
+		description, _ := utils.RenderMarkdown(w.Description, o.io.BackgroundColor())
+		fmt.Fprintln(out, description)
+	}
+
+	if w.Parent != nil {
+		fmt.Fprintln(out)
+		fmt.Fprintf(out, "%s%s %s %s\n", c.Bold("Parent: "), c.Gray(w.Parent.Type), o.io.Hyperlink(workitemutils.State(c, w.Parent), w.Parent.WebURL), w.Parent.Title)
+	}
+
+	if len(w.Children) > 0 {
+		fmt.Fprintln(out)
+		fmt.Fprintln(out, c.Bold(fmt.Sprintf("Children (%d of %d completed):", completed(w.Children), len(w.Children))))
+		for _, child := range w.Children {
+			fmt.Fprintf(out, "  %s %s %s %s\n", workitemutils.Checkbox(c, child), o.io.Hyperlink(c.Cyan(fmt.Sprintf("#%d", child.IID)), child.WebURL), child.Title, c.Gray(child.Type))
+		}
+	}
+
+	fmt.Fprintf(out, c.Gray("\nView this work item on GitLab: %s\n"), w.WebURL)
+}
+
+func (o *options) rawWorkItem() string {
+	var b strings.Builder
+	w := o.workItem
+
+	fmt.Fprintf(&b, "title:\t%s\n", w.Title)
+	fmt.Fprintf(&b, "type:\t%s\n", w.Type)
+	fmt.Fprintf(&b, "state:\t%s\n", strings.ToLower(w.State))
+	fmt.Fprintf(&b, "author:\t%s\n", w.Author)
+	if w.Parent != nil {
+		fmt.Fprintf(&b, "parent:\t#%d\n", w.Parent.IID)
+	}
+	fmt.Fprintf(&b, "url:\t%s\n", w.WebURL)
+	b.WriteString("--\n")
+	fmt.Fprintf(&b, "%s\n", w.Description)
+	if len(w.Children) > 0 {
+		b.WriteString("--\n")
+		b.WriteString("children:\n")
+		for _, child := range w.Children {
+			fmt.Fprintf(&b, "#%d\t%s\t%s\t%s\n", child.IID, child.Type, strings.ToLower(child.State), child.Title)
+		}
+	}
+	return b.String()
+}
+
+func completed(items []*api.WorkItem) int {
+	n := 0
+	for _, item := range items {
+		if item.Closed() {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,104 @@
+//go:build !integration
+
+package view
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+const taskResponse = `{"data": {"project": {"workItems": {"nodes": [{
+	"id": "gid://gitlab/WorkItem/101", "iid": "13", "title": "Index", "state": "OPEN", "workItemType": {"name": "Task"},
+	"webUrl": "https://gitlab.com/OWNER/REPO/-/work_items/13", "description": "Index the titles", "author": {"username": "alice"},
+	"widgets": [{"type": "HIERARCHY",
+		"parent": {"id": "gid://gitlab/WorkItem/100", "iid": "12", "title": "Search", "state": "OPEN", "workItemType": {"name": "Issue"}},
+		"children": {"nodes": []}
+	}]
+}]}}}}`
+
+const objectiveResponse = `{"data": {"project": {"workItems": {"nodes": [{
+	"id": "gid://gitlab/WorkItem/200", "iid": "30", "title": "Faster builds", "state": "OPEN", "workItemType": {"name": "Objective"},
+	"webUrl": "https://gitlab.com/OWNER/REPO/-/work_items/30",
+	"widgets": [{"type": "HIERARCHY", "children": {"nodes": [
+		{"iid": "31", "title": "Cache dependencies", "state": "CLOSED", "workItemType": {"name": "Key Result"}},
+		{"iid": "32", "title": "Parallel tests", "state": "OPEN", "workItemType": {"name": "Key Result"}}
+	]}}]
+}]}}}}`
+
+func TestWorkItemView(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	tests := []struct {
+		name     string
+		args     string
+		isTTY    bool
+		response string
+		wantOut  []string
+	}{
+		{
+			name:     "task",
+			args:     "13",
+			response: taskResponse,
+			wantOut: []string{
+				"title:\tIndex\ntype:\tTask\nstate:\topen\nauthor:\talice\nparent:\t#12\n",
+				"url:\thttps://gitlab.com/OWNER/REPO/-/work_items/13\n--\nIndex the titles\n",
+			},
+		},
+		{
+			name:     "children with completion status",
+			args:     "30",
+			response: objectiveResponse,
+			wantOut:  []string{"children:\n#31\tKey Result\tclosed\tCache dependencies\n#32\tKey Result\topen\tParallel tests\n"},
+		},
+		{
+			name:     "children on a TTY",
+			args:     "30",
+			isTTY:    true,
+			response: objectiveResponse,
+			wantOut: []string{
+				"Faster builds #30",
+				"Children (1 of 2 completed):\n  [x] #31 Cache dependencies Key Result\n  [ ] #32 Parallel tests Key Result\n",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			testClient.MockGraphQL.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(cmdtest.GraphQLResponse(tt.response))
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdView, tt.isTTY, cmdtest.WithGitLabClient(testClient.Client))
+
+			out, err := exec(tt.args)
+			require.NoError(t, err)
+
+			for _, want := range tt.wantOut {
+				assert.Contains(t, out.String(), want)
+			}
+		})
+	}
+}
+
+func TestWorkItemViewJSON(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockGraphQL.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(cmdtest.GraphQLResponse(taskResponse))
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdView, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	out, err := exec("13 --output json")
+	require.NoError(t, err)
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal([]byte(out.String()), &got))
+	assert.Equal(t, float64(13), got["iid"])
+	assert.Equal(t, "Task", got["type"])
+	assert.Equal(t, float64(12), got["parent"].(map[string]any)["iid"])
+}
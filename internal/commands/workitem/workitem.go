@@ -0,0 +1,51 @@
+package workitem
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	workItemCloseCmd "gitlab.com/gitlab-org/cli/internal/commands/workitem/close"
+	workItemCreateCmd "gitlab.com/gitlab-org/cli/internal/commands/workitem/create"
+	workItemListCmd "gitlab.com/gitlab-org/cli/internal/commands/workitem/list"
+	workItemReopenCmd "gitlab.com/gitlab-org/cli/internal/commands/workitem/reopen"
+	workItemViewCmd "gitlab.com/gitlab-org/cli/internal/commands/workitem/view"
+)
+
+func NewCmdWorkItem(f cmdutils.Factory) *cobra.Command {
+	workItemCmd := &cobra.Command{
+		Use:   "workitem <command> [flags]",
+		Short: `Work with GitLab work items, like tasks, objectives, and key results.`,
+		Long: heredoc.Doc(`
+			Work items are the issues of a project, and the items that can be
+			organized in a hierarchy under them: tasks under issues, and key
+			results under objectives. They use the GraphQL API of GitLab.
+
+			Issues are work items too, with the same number. Use
+			'glab workitem list --parent <issue>' to list the tasks of an issue.
+		`),
+		Example: heredoc.Doc(`
+			$ glab workitem create --parent 12 --title "Update the documentation"
+			$ glab workitem list --parent 12
+			$ glab workitem close 45
+		`),
+		Aliases: []string{"work-item"},
+		Annotations: map[string]string{
+			"help:arguments": heredoc.Doc(`
+				A work item can be supplied as argument in any of the following formats:
+				- by number, like "12" or "#12"
+				- by reference, like "group/project#12"
+				- by URL, like "https://gitlab.com/NAMESPACE/REPO/-/work_items/12"
+			`),
+		},
+	}
+
+	cmdutils.EnableRepoOverride(workItemCmd, f)
+
+	workItemCmd.AddCommand(workItemListCmd.NewCmdList(f))
+	workItemCmd.AddCommand(workItemViewCmd.NewCmdView(f))
+	workItemCmd.AddCommand(workItemCreateCmd.NewCmdCreate(f))
+	workItemCmd.AddCommand(workItemCloseCmd.NewCmdClose(f))
+	workItemCmd.AddCommand(workItemReopenCmd.NewCmdReopen(f))
+	return workItemCmd
+}
@@ -0,0 +1,26 @@
+package workitem
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestNewCmdWorkItem(t *testing.T) {
+	ios, _, _, _ := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios)
+
+	cmd := NewCmdWorkItem(factory)
+
+	assert.Equal(t, "workitem <command> [flags]", cmd.Use)
+	assert.Contains(t, cmd.Aliases, "work-item")
+
+	subcommandNames := make([]string, 0, len(cmd.Commands()))
+	for _, subcmd := range cmd.Commands() {
+		subcommandNames = append(subcommandNames, subcmd.Name())
+	}
+
+	assert.ElementsMatch(t, []string{"list", "view", "create", "close", "reopen"}, subcommandNames)
+}
@@ -0,0 +1,73 @@
+package workitemutils
+
+import (
+	"fmt"
+	"strings"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+)
+
+// Types are the types of work items that the commands accept.
+var Types = []string{"task", "issue", "incident", "objective", "key_result"}
+
+// WorkItemFromArg parses a work item given as "12", "#12", "group/project#12",
+// or its URL, and returns its project and IID. The project is the current
+// repository, unless the argument names another one.
+func WorkItemFromArg(arg string, baseRepo func() (glrepo.Interface, error), defaultHostname string) (string, int64, error) {
+	project, iid, err := issueutils.ParseIssueRef(arg, defaultHostname)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid work item format: %q", arg)
+	}
+	if project != "" {
+		return project, iid, nil
+	}
+	repo, err := baseRepo()
+	if err != nil {
+		return "", 0, err
+	}
+	return repo.FullName(), iid, nil
+}
+
+// GraphQLType returns the GraphQL IssueType of a type, like KEY_RESULT.
+func GraphQLType(t string) string {
+	return strings.ToUpper(t)
+}
+
+// TypeName returns the name of a type, like "Key Result".
+func TypeName(t string) string {
+	words := strings.Split(t, "_")
+	for i, w := range words {
+		if w != "" {
+			words[i] = strings.ToUpper(w[:1]) + w[1:]
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// State returns the reference of a work item, like "#12", colored by state.
+func State(c *iostreams.ColorPalette, w *api.WorkItem) string {
+	if w.Closed() {
+		return c.Red(fmt.Sprintf("#%d", w.IID))
+	}
+	return c.Green(fmt.Sprintf("#%d", w.IID))
+}
+
+// Checkbox returns "[x]" for completed work items, and "[ ]" for open ones.
+func Checkbox(c *iostreams.ColorPalette, w *api.WorkItem) string {
+	if w.Closed() {
+		return c.Green("[x]")
+	}
+	return c.Gray("[ ]")
+}
+
+// DisplayWorkItem returns a one-line summary of a work item and its URL on
+// TTYs, or only its URL otherwise.
+func DisplayWorkItem(c *iostreams.ColorPalette, w *api.WorkItem, isTTY bool) string {
+	if !isTTY {
+		return w.WebURL
+	}
+	return fmt.Sprintf("%s %s %s\n %s\n", State(c, w), c.Gray(w.Type), w.Title, w.WebURL)
+}
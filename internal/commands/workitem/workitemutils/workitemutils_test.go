@@ -0,0 +1,51 @@
+//go:build !integration
+
+package workitemutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+)
+
+func TestWorkItemFromArg(t *testing.T) {
+	baseRepo := func() (glrepo.Interface, error) {
+		return glrepo.FromFullName("group/project", "gitlab.com")
+	}
+
+	tests := []struct {
+		name        string
+		arg         string
+		wantProject string
+		wantIID     int64
+		wantErr     string
+	}{
+		{name: "number", arg: "12", wantProject: "group/project", wantIID: 12},
+		{name: "reference", arg: "#12", wantProject: "group/project", wantIID: 12},
+		{name: "other project", arg: "other/repo#3", wantProject: "other/repo", wantIID: 3},
+		{name: "work item URL", arg: "https://gitlab.com/other/repo/-/work_items/7", wantProject: "other/repo", wantIID: 7},
+		{name: "invalid", arg: "abc", wantErr: `invalid work item format: "abc"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			project, iid, err := WorkItemFromArg(tt.arg, baseRepo, "gitlab.com")
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantProject, project)
+			assert.Equal(t, tt.wantIID, iid)
+		})
+	}
+}
+
+func TestTypeName(t *testing.T) {
+	assert.Equal(t, "Task", TypeName("task"))
+	assert.Equal(t, "Key Result", TypeName("key_result"))
+	assert.Equal(t, "KEY_RESULT", GraphQLType("key_result"))
+}
@@ -92,6 +92,12 @@ func EnvKeyEquivalence(key string) []string {
 		return []string{"NO_PROMPT", "PROMPT_DISABLED"}
 	case "telemetry":
 		return []string{"GLAB_SEND_TELEMETRY"}
+	case "cache_ttl":
+		return []string{"GLAB_CACHE_TTL"}
+	case "cache_stale_ttl":
+		return []string{"GLAB_CACHE_STALE_TTL"}
+	case "user_cache_ttl":
+		return []string{"GLAB_USER_CACHE_TTL"}
 	case "editor", "visual", "glab_editor":
 		return []string{"GLAB_EDITOR", "VISUAL", "EDITOR"}
 	case "remote_alias":
@@ -77,6 +77,24 @@ func rootConfig() *yaml.Node {
 						Kind:  yaml.ScalarNode,
 						Value: "false",
 					},
+					{
+						HeadComment: "# Color theme of the output. Supported values: default, dracula, light, solarized.",
+						Kind:        yaml.ScalarNode,
+						Value:       "theme",
+					},
+					{
+						Kind:  yaml.ScalarNode,
+						Value: "",
+					},
+					{
+						HeadComment: "# Colors of elements of the output that replace the colors of the theme. A comma-separated list of element=color rules, for example success=green,warning=208.",
+						Kind:        yaml.ScalarNode,
+						Value:       "theme_colors",
+					},
+					{
+						Kind:  yaml.ScalarNode,
+						Value: "",
+					},
 					{
 						HeadComment: "# Default GitLab hostname to use.",
 						Kind:        yaml.ScalarNode,
@@ -104,6 +122,78 @@ func rootConfig() *yaml.Node {
 						Kind:  yaml.ScalarNode,
 						Value: "true",
 					},
+					{
+						HeadComment: "# Cache the API responses of read commands for this duration, for example 5m. Leave empty to disable the cache.",
+						Kind:        yaml.ScalarNode,
+						Value:       "cache_ttl",
+					},
+					{
+						Kind:  yaml.ScalarNode,
+						Value: "",
+					},
+					{
+						HeadComment: "# Show expired cached responses, marked as (cached), when GitLab can't be reached, if they are younger than this duration, for example 24h. Requires cache_ttl. Leave empty to never show expired responses.",
+						Kind:        yaml.ScalarNode,
+						Value:       "cache_stale_ttl",
+					},
+					{
+						Kind:  yaml.ScalarNode,
+						Value: "",
+					},
+					{
+						HeadComment: "# Cache the IDs of users looked up by username, for filters like --assignee of 'glab mr list', for this duration, for example 24h. Leave empty to disable the cache.",
+						Kind:        yaml.ScalarNode,
+						Value:       "user_cache_ttl",
+					},
+					{
+						Kind:  yaml.ScalarNode,
+						Value: "",
+					},
+					{
+						HeadComment: "# Labels to add to merge requests created with 'glab mr create --fill-commits', by Conventional Commits type. A comma-separated list of type=label rules, for example feat=feature,fix=bug.",
+						Kind:        yaml.ScalarNode,
+						Value:       "mr_label_rules",
+					},
+					{
+						Kind:  yaml.ScalarNode,
+						Value: "",
+					},
+					{
+						HeadComment: "# Headings of issue templates that 'glab issue create' warns about when they're deleted from the description. A comma-separated list of headings, or all for every heading of the template.",
+						Kind:        yaml.ScalarNode,
+						Value:       "issue_required_sections",
+					},
+					{
+						Kind:  yaml.ScalarNode,
+						Value: "",
+					},
+					{
+						HeadComment: "# Short names for GitLab hosts, for repository arguments like gl/OWNER/REPO and git remotes. A comma-separated list of alias=host rules, for example gl=gitlab.example.com.",
+						Kind:        yaml.ScalarNode,
+						Value:       "host_aliases",
+					},
+					{
+						Kind:  yaml.ScalarNode,
+						Value: "",
+					},
+					{
+						HeadComment: "# GitLab hosts of git remotes cloned through jump hosts or mirrors. A comma-separated list of host=host rules, where the remote host can have a port and * wildcards, for example bastion.example.com:2222=gitlab.example.com.",
+						Kind:        yaml.ScalarNode,
+						Value:       "url_rewrites",
+					},
+					{
+						Kind:  yaml.ScalarNode,
+						Value: "",
+					},
+					{
+						HeadComment: "# Projects of the subdirectories of a monorepo, used instead of the project of the git remote. A comma-separated list of path=project rules, with paths relative to the top-level directory of the repository, for example services/auth=group/auth-service.",
+						Kind:        yaml.ScalarNode,
+						Value:       "monorepo_projects",
+					},
+					{
+						Kind:  yaml.ScalarNode,
+						Value: "",
+					},
 					{
 						HeadComment: "# Configuration specific for GitLab instances.",
 						Kind:        yaml.ScalarNode,
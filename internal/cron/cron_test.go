@@ -0,0 +1,67 @@
+//go:build !integration
+
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		expr    string
+		wantErr string
+	}{
+		{"0 0 * *", `invalid cron expression "0 0 * *": expected 5 fields, got 4`},
+		{"60 * * * *", `invalid cron expression "60 * * * *": invalid value "60" in minute field`},
+		{"* * * foo *", `invalid cron expression "* * * foo *": invalid value "foo" in month field`},
+		{"* 5-2 * * *", `invalid cron expression "* 5-2 * * *": invalid range "5-2" in hour field`},
+		{"*/0 * * * *", `invalid cron expression "*/0 * * * *": invalid step "0" in minute field`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			_, err := Parse(tt.expr)
+			require.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestNext(t *testing.T) {
+	// A Wednesday.
+	from := time.Date(2025, time.January, 15, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2025, time.January, 15, 10, 31, 0, 0, time.UTC)},
+		{"30 10 * * *", time.Date(2025, time.January, 16, 10, 30, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2025, time.January, 15, 10, 45, 0, 0, time.UTC)},
+		{"0 23 * * fri", time.Date(2025, time.January, 17, 23, 0, 0, 0, time.UTC)},
+		{"0 7 * * 1-5", time.Date(2025, time.January, 16, 7, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2025, time.January, 19, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1 jan,jul *", time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 20 * mon", time.Date(2025, time.January, 20, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{"0 0 30 2 *", time.Time{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			s, err := Parse(tt.expr)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, s.Next(from))
+		})
+	}
+}
+
+func TestNextKeepsLocation(t *testing.T) {
+	loc := time.FixedZone("CET", 3600)
+	s, err := Parse("0 9 * * *")
+	require.NoError(t, err)
+
+	next := s.Next(time.Date(2025, time.January, 15, 10, 0, 0, 0, loc))
+	assert.Equal(t, time.Date(2025, time.January, 16, 9, 0, 0, 0, loc), next)
+}
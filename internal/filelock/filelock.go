@@ -0,0 +1,98 @@
+// Package filelock coordinates glab processes that read and write the same file.
+package filelock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	checkInterval = 50 * time.Millisecond
+	// staleTimeout is the age after which a lock is considered left behind by a process that
+	// crashed, and is removed. Locks are only held while a file is read and written.
+	staleTimeout = 1 * time.Minute
+)
+
+// With runs fn while it holds the lock of path, the file path with the extension .lock.
+// It waits for other processes to release the lock, until ctx is done.
+func With(ctx context.Context, path string, fn func() error) (retErr error) { //nolint:nonamedreturns
+	lockPath := path + ".lock"
+	if err := os.MkdirAll(filepath.Dir(lockPath), 0o750); err != nil {
+		return fmt.Errorf("creating lock directory: %w", err)
+	}
+
+	if err := lock(ctx, lockPath); err != nil {
+		return fmt.Errorf("failed to acquire file lock (%s): %w", lockPath, err)
+	}
+	defer func() {
+		if err := os.Remove(lockPath); err != nil && !errors.Is(err, os.ErrNotExist) && retErr == nil {
+			retErr = fmt.Errorf("failed to release file lock (%s): %w", lockPath, err)
+		}
+	}()
+
+	return fn()
+}
+
+func lock(ctx context.Context, lockPath string) error {
+	t := time.NewTicker(checkInterval)
+	defer t.Stop()
+
+	for {
+		f, err := os.OpenFile(lockPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+		if err == nil {
+			return f.Close()
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return err
+		}
+
+		info, err := os.Stat(lockPath)
+		if err == nil && time.Since(info.ModTime()) > staleTimeout {
+			if err := os.Remove(lockPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("failed to remove stale lock file: %w", err)
+			}
+			continue
+		}
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to check if lock file is stale: %w", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// WriteFile replaces the contents of filename atomically: it writes data to a temporary file
+// in the same directory and renames it over filename, so readers never see a partial write.
+func WriteFile(filename string, data []byte, perm os.FileMode) error {
+	f, err := os.CreateTemp(filepath.Dir(filename), "."+filepath.Base(filename)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	tmpName := f.Name()
+	defer os.Remove(tmpName)
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Chmod(perm); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpName, filename)
+}
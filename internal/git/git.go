@@ -11,6 +11,7 @@ import (
 	"path"
 	"regexp"
 	"slices"
+	"strconv"
 	"strings"
 
 	"gitlab.com/gitlab-org/cli/internal/run"
@@ -43,6 +44,33 @@ func RemoteBranchExists(branch string, gr GitRunner) bool {
 	return err == nil
 }
 
+// RemoteBranches returns which of the branches exist on the default remote, with a
+// single request to the remote.
+func RemoteBranches(gr GitRunner, branches ...string) ([]string, error) {
+	if len(branches) == 0 {
+		return nil, nil
+	}
+
+	output, err := gr.Git(append([]string{"ls-remote", "--heads", DefaultRemote}, branches...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	var existing []string
+	for line := range strings.SplitSeq(output, "\n") {
+		_, ref, ok := strings.Cut(strings.TrimSpace(line), "\t")
+		if !ok {
+			continue
+		}
+		// Patterns match the end of refs, so refs/heads/x/main matches main too.
+		branch := strings.TrimPrefix(ref, "refs/heads/")
+		if slices.Contains(branches, branch) && !slices.Contains(existing, branch) {
+			existing = append(existing, branch)
+		}
+	}
+	return existing, nil
+}
+
 func ParseDefaultBranch(output []byte) (string, error) {
 	var headBranch string
 
@@ -91,6 +119,57 @@ func CurrentBranch() (string, error) {
 	return "", err
 }
 
+// DetachedHeadRef returns the branch or tag that a detached HEAD was checked
+// out from: the CI_COMMIT_REF_NAME variable of GitLab CI/CD jobs, or the tag
+// that points to HEAD. It returns ErrNotOnAnyBranch when there is neither.
+func DetachedHeadRef() (string, error) {
+	if ref := os.Getenv("CI_COMMIT_REF_NAME"); ref != "" {
+		return ref, nil
+	}
+
+	tagCmd := GitCommand("describe", "--tags", "--exact-match", "HEAD")
+	output, err := run.PrepareCmd(tagCmd).Output()
+	if err != nil || firstLine(output) == "" {
+		return "", ErrNotOnAnyBranch
+	}
+	return firstLine(output), nil
+}
+
+// DetachedHeadBranch returns the branch that a detached HEAD was checked out
+// from, if it is a real branch: the branch of a GitLab CI/CD job, or the only
+// local branch that points to HEAD. Unlike DetachedHeadRef, it never returns a
+// tag. It returns ErrNotOnAnyBranch when there is no such branch.
+func DetachedHeadBranch() (string, error) {
+	if branch := os.Getenv("CI_COMMIT_BRANCH"); branch != "" {
+		return branch, nil
+	}
+	// Merge request pipelines don't set CI_COMMIT_BRANCH, but their ref is the source branch.
+	if ref := os.Getenv("CI_COMMIT_REF_NAME"); ref != "" && os.Getenv("CI_COMMIT_TAG") == "" {
+		return ref, nil
+	}
+
+	branchCmd := GitCommand("for-each-ref", "--points-at=HEAD", "--format=%(refname:short)", "refs/heads/")
+	output, err := run.PrepareCmd(branchCmd).Output()
+	if err != nil {
+		return "", ErrNotOnAnyBranch
+	}
+	branches := strings.Fields(string(output))
+	if len(branches) != 1 {
+		return "", ErrNotOnAnyBranch
+	}
+	return branches[0], nil
+}
+
+// HeadSHA returns the SHA of the checked-out commit.
+func HeadSHA() (string, error) {
+	shaCmd := GitCommand("rev-parse", "HEAD")
+	output, err := run.PrepareCmd(shaCmd).Output()
+	if err != nil {
+		return "", fmt.Errorf("could not determine the current commit: %w", err)
+	}
+	return firstLine(output), nil
+}
+
 func listRemotes() ([]string, error) {
 	remoteCmd := exec.Command("git", "remote", "-v")
 	output, err := run.PrepareCmd(remoteCmd).Output()
@@ -193,6 +272,21 @@ func Push(remote string, ref string, cmdOut, cmdErr io.Writer) error {
 	return run.PrepareCmd(pushCmd).Run()
 }
 
+// ApplyMailbox applies the patches of a mailbox to the current branch, with git am.
+// When a patch doesn't apply, the operation is aborted and the branch is left unchanged.
+func ApplyMailbox(mbox io.Reader, cmdOut, cmdErr io.Writer) error {
+	amCmd := GitCommand("am", "--3way")
+	amCmd.Stdin = mbox
+	amCmd.Stdout = cmdOut
+	amCmd.Stderr = cmdErr
+	if err := run.PrepareCmd(amCmd).Run(); err != nil {
+		abortCmd := GitCommand("am", "--abort")
+		_ = run.PrepareCmd(abortCmd).Run()
+		return fmt.Errorf("could not apply the patches: %w", err)
+	}
+	return nil
+}
+
 // SetUpstream sets the upstream (tracking) of a branch
 func SetUpstream(remote string, branch string, cmdOut, cmdErr io.Writer) error {
 	setCmd := GitCommand("branch", "--set-upstream-to", fmt.Sprintf("%s/%s", remote, branch))
@@ -550,3 +644,102 @@ func ListTags() ([]string, error) {
 
 	return strings.Fields(tagsStr), nil
 }
+
+// Worktree is a working tree of the repository.
+type Worktree struct {
+	Path string
+	Head string
+	// Branch is the short name of the checked-out branch, empty when the HEAD is detached.
+	Branch string
+}
+
+// ListWorktrees lists the working trees of the repository, the main one first.
+// Reference: https://git-scm.com/docs/git-worktree#_porcelain_format
+func ListWorktrees() ([]Worktree, error) {
+	gitCmd := GitCommand("worktree", "list", "--porcelain")
+
+	output, err := run.PrepareCmd(gitCmd).Output()
+	if err != nil {
+		return nil, fmt.Errorf("could not list worktrees: %w", err)
+	}
+
+	return parseWorktrees(output), nil
+}
+
+func parseWorktrees(output []byte) []Worktree {
+	var worktrees []Worktree
+	for _, line := range outputLines(output) {
+		key, value, _ := strings.Cut(line, " ")
+		switch {
+		case key == "worktree":
+			worktrees = append(worktrees, Worktree{Path: value})
+		case len(worktrees) == 0:
+			continue
+		case key == "HEAD":
+			worktrees[len(worktrees)-1].Head = value
+		case key == "branch":
+			worktrees[len(worktrees)-1].Branch = strings.TrimPrefix(value, "refs/heads/")
+		}
+	}
+	return worktrees
+}
+
+// AddWorktree checks out ref in a new working tree at path. Unless detach is
+// set, ref must be a local branch that isn't checked out in another working tree.
+func AddWorktree(path, ref string, detach bool) error {
+	args := []string{"worktree", "add"}
+	if detach {
+		args = append(args, "--detach")
+	}
+	args = append(args, path, ref)
+
+	if err := RunCmd(args); err != nil {
+		return fmt.Errorf("could not add worktree: %w", err)
+	}
+	return nil
+}
+
+// DiffStat is the size of the changes between two refs.
+type DiffStat struct {
+	Files     int
+	Additions int
+	Deletions int
+}
+
+// Lines returns the number of added and removed lines.
+func (s DiffStat) Lines() int {
+	return s.Additions + s.Deletions
+}
+
+// DiffStats returns the size of the changes of headRef since it forked from baseRef,
+// like the diff of a merge request.
+func DiffStats(baseRef, headRef string) (DiffStat, error) {
+	diffCmd := GitCommand("diff", "--numstat", fmt.Sprintf("%s...%s", baseRef, headRef))
+
+	output, err := run.PrepareCmd(diffCmd).Output()
+	if err != nil {
+		return DiffStat{}, fmt.Errorf("could not get the changes between %s and %s: %w", baseRef, headRef, err)
+	}
+
+	return parseNumstat(output), nil
+}
+
+// parseNumstat parses the output of git diff --numstat. Binary files, listed with
+// "-" instead of line counts, only count as changed files.
+func parseNumstat(output []byte) DiffStat {
+	var stat DiffStat
+	for _, line := range outputLines(output) {
+		fields := strings.SplitN(line, "\t", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		stat.Files++
+		if n, err := strconv.Atoi(fields[0]); err == nil {
+			stat.Additions += n
+		}
+		if n, err := strconv.Atoi(fields[1]); err == nil {
+			stat.Deletions += n
+		}
+	}
+	return stat
+}
@@ -10,6 +10,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	gomock "go.uber.org/mock/gomock"
@@ -74,6 +75,19 @@ func TestRemoteBranchExists(t *testing.T) {
 	}
 }
 
+func TestRemoteBranches(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockCmd := git_testing.NewMockGitRunner(ctrl)
+
+	mockCmd.EXPECT().
+		Git("ls-remote", "--heads", DefaultRemote, "main", "feature", "gone").
+		Return("abc123\trefs/heads/main\ndef456\trefs/heads/team/feature\n789abc\trefs/heads/feature\n", nil)
+
+	branches, err := RemoteBranches(mockCmd, "main", "feature", "gone")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"main", "feature"}, branches)
+}
+
 func Test_isFilesystemPath(t *testing.T) {
 	type args struct {
 		p string
@@ -190,6 +204,105 @@ func Test_CurrentBranch_unexpected_error(t *testing.T) {
 	}
 }
 
+func Test_DetachedHeadRef(t *testing.T) {
+	t.Run("CI/CD job", func(t *testing.T) {
+		t.Setenv("CI_COMMIT_REF_NAME", "feature")
+		cs, teardown := test.InitCmdStubber()
+		defer teardown()
+
+		ref, err := DetachedHeadRef()
+		require.NoError(t, err)
+		assert.Equal(t, "feature", ref)
+		assert.Empty(t, cs.Calls)
+	})
+
+	t.Run("tag", func(t *testing.T) {
+		t.Setenv("CI_COMMIT_REF_NAME", "")
+		cs, teardown := test.InitCmdStubber()
+		defer teardown()
+		cs.Stub("v1.2.0\n")
+
+		ref, err := DetachedHeadRef()
+		require.NoError(t, err)
+		assert.Equal(t, "v1.2.0", ref)
+		require.Len(t, cs.Calls, 1)
+		assert.Equal(t, []string{"git", "describe", "--tags", "--exact-match", "HEAD"}, cs.Calls[0].Args)
+	})
+
+	t.Run("no ref", func(t *testing.T) {
+		t.Setenv("CI_COMMIT_REF_NAME", "")
+		cs, teardown := test.InitCmdStubber()
+		defer teardown()
+		cs.StubError("fatal: no tag exactly matches")
+
+		_, err := DetachedHeadRef()
+		assert.ErrorIs(t, err, ErrNotOnAnyBranch)
+	})
+}
+
+func Test_DetachedHeadBranch(t *testing.T) {
+	t.Run("CI/CD branch pipeline", func(t *testing.T) {
+		t.Setenv("CI_COMMIT_BRANCH", "feature")
+		cs, teardown := test.InitCmdStubber()
+		defer teardown()
+
+		branch, err := DetachedHeadBranch()
+		require.NoError(t, err)
+		assert.Equal(t, "feature", branch)
+		assert.Empty(t, cs.Calls)
+	})
+
+	t.Run("CI/CD merge request pipeline", func(t *testing.T) {
+		t.Setenv("CI_COMMIT_BRANCH", "")
+		t.Setenv("CI_COMMIT_TAG", "")
+		t.Setenv("CI_COMMIT_REF_NAME", "feature")
+		cs, teardown := test.InitCmdStubber()
+		defer teardown()
+
+		branch, err := DetachedHeadBranch()
+		require.NoError(t, err)
+		assert.Equal(t, "feature", branch)
+		assert.Empty(t, cs.Calls)
+	})
+
+	t.Run("CI/CD tag pipeline", func(t *testing.T) {
+		t.Setenv("CI_COMMIT_BRANCH", "")
+		t.Setenv("CI_COMMIT_TAG", "v1.2.0")
+		t.Setenv("CI_COMMIT_REF_NAME", "v1.2.0")
+		cs, teardown := test.InitCmdStubber()
+		defer teardown()
+		cs.Stub("")
+
+		_, err := DetachedHeadBranch()
+		assert.ErrorIs(t, err, ErrNotOnAnyBranch)
+		require.Len(t, cs.Calls, 1)
+		assert.Equal(t, []string{"git", "for-each-ref", "--points-at=HEAD", "--format=%(refname:short)", "refs/heads/"}, cs.Calls[0].Args)
+	})
+
+	t.Run("local branch", func(t *testing.T) {
+		t.Setenv("CI_COMMIT_BRANCH", "")
+		t.Setenv("CI_COMMIT_REF_NAME", "")
+		cs, teardown := test.InitCmdStubber()
+		defer teardown()
+		cs.Stub("feature\n")
+
+		branch, err := DetachedHeadBranch()
+		require.NoError(t, err)
+		assert.Equal(t, "feature", branch)
+	})
+
+	t.Run("several local branches", func(t *testing.T) {
+		t.Setenv("CI_COMMIT_BRANCH", "")
+		t.Setenv("CI_COMMIT_REF_NAME", "")
+		cs, teardown := test.InitCmdStubber()
+		defer teardown()
+		cs.Stub("feature\nmain\n")
+
+		_, err := DetachedHeadBranch()
+		assert.ErrorIs(t, err, ErrNotOnAnyBranch)
+	})
+}
+
 func TestReadBranchConfig(t *testing.T) {
 	cs, teardown := test.InitCmdStubber()
 	defer teardown()
@@ -241,6 +354,30 @@ func Test_parseRemotes(t *testing.T) {
 	eq(t, r[3].Name, "zardoz")
 }
 
+func Test_parseWorktrees(t *testing.T) {
+	output := heredoc.Doc(`
+		worktree /src/repo
+		HEAD 1234567890abcdef1234567890abcdef12345678
+		branch refs/heads/main
+
+		worktree /src/repo-review
+		HEAD abcdef1234567890abcdef1234567890abcdef12
+		detached
+
+		worktree /src/repo-feature
+		HEAD 0123456789abcdef0123456789abcdef01234567
+		branch refs/heads/feature/login
+		locked
+
+	`)
+
+	assert.Equal(t, []Worktree{
+		{Path: "/src/repo", Head: "1234567890abcdef1234567890abcdef12345678", Branch: "main"},
+		{Path: "/src/repo-review", Head: "abcdef1234567890abcdef1234567890abcdef12"},
+		{Path: "/src/repo-feature", Head: "0123456789abcdef0123456789abcdef01234567", Branch: "feature/login"},
+	}, parseWorktrees([]byte(output)))
+}
+
 func TestGetDefaultBranch(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -550,3 +687,13 @@ func TestGitUserName(t *testing.T) {
 		})
 	}
 }
+
+func Test_parseNumstat(t *testing.T) {
+	output := "10\t2\tREADME.md\n" +
+		"0\t40\tinternal/old.go\n" +
+		"-\t-\tdocs/logo.png\n" +
+		"3\t3\tinternal/{a => b}/file.go\n"
+
+	assert.Equal(t, DiffStat{Files: 4, Additions: 13, Deletions: 45}, parseNumstat([]byte(output)))
+	assert.Equal(t, DiffStat{}, parseNumstat(nil))
+}
@@ -0,0 +1,66 @@
+package glrepo
+
+import (
+	"path"
+	"strings"
+
+	"gitlab.com/gitlab-org/cli/internal/config"
+)
+
+// hostRule maps a host name, or a pattern of host names, to a GitLab host.
+type hostRule struct {
+	from string
+	to   string
+}
+
+// parseHostRules parses the host_aliases and url_rewrites settings: a
+// comma-separated list of from=to rules, like "gl=gitlab.example.com".
+// Invalid rules are skipped, so that a typo doesn't break every command.
+func parseHostRules(s string) []hostRule {
+	var rules []hostRule
+	for rule := range strings.SplitSeq(s, ",") {
+		from, to, ok := strings.Cut(rule, "=")
+		from, to = strings.ToLower(strings.TrimSpace(from)), strings.TrimSpace(to)
+		if !ok || from == "" || to == "" {
+			continue
+		}
+		rules = append(rules, hostRule{from: from, to: to})
+	}
+	return rules
+}
+
+// resolveHostAlias returns the host of the host_aliases setting for alias.
+func resolveHostAlias(cfg config.Config, alias string) (string, bool) {
+	if cfg == nil {
+		return "", false
+	}
+	setting, _ := cfg.Get("", "host_aliases")
+	for _, rule := range parseHostRules(setting) {
+		if rule.from == strings.ToLower(alias) {
+			return rule.to, true
+		}
+	}
+	return "", false
+}
+
+// rewriteHost returns the GitLab host of the host of a remote URL. The first
+// url_rewrites rule that matches the host, with or without its port, is applied,
+// like bastion.example.com:2222=gitlab.example.com. Rules can use * wildcards,
+// like *.mirror.example.com=gitlab.example.com. Then host aliases are resolved.
+func rewriteHost(cfg config.Config, hostname, hostport string) string {
+	if cfg == nil {
+		return hostname
+	}
+	setting, _ := cfg.Get("", "url_rewrites")
+	for _, rule := range parseHostRules(setting) {
+		for _, host := range []string{strings.ToLower(hostport), strings.ToLower(hostname)} {
+			if ok, _ := path.Match(rule.from, host); ok {
+				return rule.to
+			}
+		}
+	}
+	if host, ok := resolveHostAlias(cfg, hostname); ok {
+		return host
+	}
+	return hostname
+}
@@ -0,0 +1,71 @@
+//go:build !integration
+
+package glrepo
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/glinstance"
+)
+
+const hostRulesConfig = `---
+host_aliases: gl=gitlab.example.com, bad-rule, work=gitlab.corp.example
+url_rewrites: bastion.example.com:2222=gitlab.example.com,*.mirror.example.com=gitlab.corp.example
+hosts:
+  gitlab.example.com:
+    token: OTOKEN
+`
+
+func TestParseHostRules(t *testing.T) {
+	assert.Equal(t, []hostRule{
+		{from: "gl", to: "gitlab.example.com"},
+		{from: "work", to: "gitlab.corp.example"},
+	}, parseHostRules(" GL = gitlab.example.com,bad-rule,=x,work=gitlab.corp.example,"))
+}
+
+func TestFromURL_hostRules(t *testing.T) {
+	defer config.StubConfig(hostRulesConfig, "")()
+
+	tests := []struct {
+		name     string
+		input    string
+		wantHost string
+	}{
+		{name: "rewrite with port", input: "ssh://git@bastion.example.com:2222/group/repo.git", wantHost: "gitlab.example.com"},
+		{name: "rewrite with wildcard", input: "https://eu.mirror.example.com/group/repo.git", wantHost: "gitlab.corp.example"},
+		{name: "other port", input: "ssh://git@bastion.example.com:22/group/repo.git", wantHost: "bastion.example.com"},
+		{name: "SSH host alias", input: "ssh://git@gl/group/repo.git", wantHost: "gitlab.example.com"},
+		{name: "no rule", input: "https://gitlab.com/group/repo.git", wantHost: "gitlab.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := url.Parse(tt.input)
+			require.NoError(t, err)
+
+			repo, err := FromURL(u, glinstance.DefaultHostname)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantHost, repo.RepoHost())
+			assert.Equal(t, "group/repo", repo.FullName())
+		})
+	}
+}
+
+func TestFromFullName_hostAlias(t *testing.T) {
+	defer config.StubConfig(hostRulesConfig, "")()
+
+	repo, err := FromFullName("gl/group/sub/repo", glinstance.DefaultHostname)
+	require.NoError(t, err)
+	assert.Equal(t, "gitlab.example.com", repo.RepoHost())
+	assert.Equal(t, "group/sub/repo", repo.FullName())
+
+	repo, err = FromFullName("group/sub/repo", glinstance.DefaultHostname)
+	require.NoError(t, err)
+	assert.Equal(t, "gitlab.com", repo.RepoHost())
+	assert.Equal(t, "group/sub/repo", repo.FullName())
+}
@@ -0,0 +1,36 @@
+package glrepo
+
+import (
+	"strings"
+
+	"gitlab.com/gitlab-org/cli/internal/config"
+)
+
+// MappedProject returns the project that the monorepo_projects setting maps dir
+// to. dir is relative to the top-level directory of the repository, like
+// "services/auth/api". The setting is a comma-separated list of path=project
+// rules, like "services/auth=group/auth-service", and the rule of the deepest
+// directory that contains dir wins.
+func MappedProject(cfg config.Config, dir string) (string, bool) {
+	if cfg == nil {
+		return "", false
+	}
+	setting, _ := cfg.Get("", "monorepo_projects")
+	dir = strings.Trim(dir, "/")
+
+	var project, matched string
+	for rule := range strings.SplitSeq(setting, ",") {
+		from, to, ok := strings.Cut(rule, "=")
+		from, to = strings.Trim(strings.TrimSpace(from), "/"), strings.TrimSpace(to)
+		if !ok || from == "" || to == "" {
+			continue
+		}
+		if dir != from && !strings.HasPrefix(dir, from+"/") {
+			continue
+		}
+		if len(from) > len(matched) {
+			project, matched = to, from
+		}
+	}
+	return project, project != ""
+}
@@ -0,0 +1,41 @@
+//go:build !integration
+
+package glrepo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/config"
+)
+
+func TestMappedProject(t *testing.T) {
+	defer config.StubConfig(`---
+monorepo_projects: services/auth=group/auth-service, services/auth/admin/=group/auth-admin,bad-rule,web=group/web
+`, "")()
+	cfg, err := config.ParseDefaultConfig()
+	require.NoError(t, err)
+
+	tests := []struct {
+		dir         string
+		wantProject string
+	}{
+		{dir: "services/auth", wantProject: "group/auth-service"},
+		{dir: "services/auth/api", wantProject: "group/auth-service"},
+		{dir: "services/auth/admin/ui", wantProject: "group/auth-admin"},
+		{dir: "web/", wantProject: "group/web"},
+		{dir: "services/authz"},
+		{dir: "services"},
+		{dir: "."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dir, func(t *testing.T) {
+			project, ok := MappedProject(cfg, tt.dir)
+			assert.Equal(t, tt.wantProject != "", ok)
+			assert.Equal(t, tt.wantProject, project)
+		})
+	}
+}
@@ -98,6 +98,7 @@ func NewWithHost(owner, repo, hostname string) Interface {
 
 // FromFullName extracts the GitLab repository information from the following
 // formats: "OWNER/REPO", "HOST/OWNER/REPO", "HOST/GROUP/NAMESPACE/REPO", and a full URL.
+// HOST can be an alias of the host_aliases setting.
 func FromFullName(nwo string, defaultHostname string) (Interface, error) {
 	nwo = strings.TrimSpace(nwo)
 	// check if it's a valid git URL and parse it
@@ -126,6 +127,12 @@ func FromFullName(nwo string, defaultHostname string) (Interface, error) {
 	}
 	switch len(parts) {
 	case 2: // GROUP/NAMESPACE/REPO or HOST/OWNER/REPO or //HOST/GROUP/NAMESPACE/REPO
+		// Host aliases take precedence over groups with the same name.
+		if cfg, err := config.ParseDefaultConfig(); err == nil {
+			if host, ok := resolveHostAlias(cfg, parts[0]); ok {
+				return NewWithHost(parts[1], repo, host), nil
+			}
+		}
 		// First, checks if the first part matches the default instance host (i.e. gitlab.com) or the
 		// overridden default host (mostly from the GITLAB_HOST env variable)
 		if parts[0] == glinstance.DefaultHostname || parts[0] == defaultHostname {
@@ -160,7 +167,9 @@ func FromFullName(nwo string, defaultHostname string) (Interface, error) {
 	}
 }
 
-// FromURL extracts the GitLab repository information from a git remote URL
+// FromURL extracts the GitLab repository information from a git remote URL.
+// The host of the URL is mapped to a GitLab host by the url_rewrites and
+// host_aliases settings, for remotes cloned through jump hosts or mirrors.
 func FromURL(u *url.URL, defaultHostname string) (Interface, error) {
 	if u.Hostname() == "" {
 		return nil, fmt.Errorf("no hostname detected")
@@ -171,10 +180,12 @@ func FromURL(u *url.URL, defaultHostname string) (Interface, error) {
 	var pathWithoutRepo string
 	var apiHost string
 
+	hostname := u.Hostname()
 	cfg, err := config.ParseDefaultConfig()
 	// an error is fine here, there might not be a config available
 	if err == nil {
-		apiHost, _ = cfg.Get(u.Hostname(), "api_host")
+		hostname = rewriteHost(cfg, u.Hostname(), u.Host)
+		apiHost, _ = cfg.Get(hostname, "api_host")
 	}
 
 	if apiHost != "" {
@@ -199,11 +210,11 @@ func FromURL(u *url.URL, defaultHostname string) (Interface, error) {
 	if repo != "" && pathWithoutRepo != "" {
 		parts := strings.SplitN(pathWithoutRepo, "/", 2)
 		if len(parts) == 1 {
-			return NewWithHost(parts[0], repo, u.Hostname()), nil
+			return NewWithHost(parts[0], repo, hostname), nil
 		}
 
 		if len(parts) == 2 {
-			return NewWithGroup(parts[0], parts[1], repo, u.Hostname(), defaultHostname), nil
+			return NewWithGroup(parts[0], parts[1], repo, hostname, defaultHostname), nil
 		}
 	}
 	return nil, fmt.Errorf("invalid path: %s", u.Path)
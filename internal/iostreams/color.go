@@ -27,22 +27,53 @@ type ColorPalette struct {
 	Gray func(string) string
 	// Bold outputs ANSI color if stdout is a tty
 	Bold func(string) string
+	// Header outputs the color of headers if stdout is a tty
+	Header func(string) string
+	// Success outputs the color of successes if stdout is a tty
+	Success func(string) string
+	// Warning outputs the color of warnings if stdout is a tty
+	Warning func(string) string
+	// Error outputs the color of errors if stdout is a tty
+	Error func(string) string
+	// Link outputs the color of hyperlinks if stdout is a tty
+	Link func(string) string
 }
 
+// Color returns the palette of the theme of the streams.
 func (s *IOStreams) Color() *ColorPalette {
 	isColorfulOutput := s.ColorEnabled() && s.IsaTTY
+	t := s.Theme()
 	return &ColorPalette{
-		Magenta: makeColorFunc(isColorfulOutput, "magenta"),
-		Cyan:    makeColorFunc(isColorfulOutput, "cyan"),
-		Red:     makeColorFunc(isColorfulOutput, "red"),
-		Yellow:  makeColorFunc(isColorfulOutput, "yellow"),
-		Blue:    makeColorFunc(isColorfulOutput, "blue"),
-		Green:   makeColorFunc(isColorfulOutput, "green"),
-		Gray:    makeColorFunc(isColorfulOutput, "black+h"),
-		Bold:    makeColorFunc(isColorfulOutput, "default+b"),
+		Magenta: t.colorFunc(isColorfulOutput, "magenta"),
+		Cyan:    t.colorFunc(isColorfulOutput, "cyan"),
+		Red:     t.colorFunc(isColorfulOutput, "red"),
+		Yellow:  t.colorFunc(isColorfulOutput, "yellow"),
+		Blue:    t.colorFunc(isColorfulOutput, "blue"),
+		Green:   t.colorFunc(isColorfulOutput, "green"),
+		Gray:    t.colorFunc(isColorfulOutput, "gray"),
+		Bold:    t.colorFunc(isColorfulOutput, "bold"),
+		Header:  t.colorFunc(isColorfulOutput, "header"),
+		Success: t.colorFunc(isColorfulOutput, "success"),
+		Warning: t.colorFunc(isColorfulOutput, "warning"),
+		Error:   t.colorFunc(isColorfulOutput, "error"),
+		Link:    t.colorFunc(isColorfulOutput, "hyperlink"),
 	}
 }
 
+// Theme returns the theme of the streams, the default theme unless one is set
+// with SetTheme.
+func (s *IOStreams) Theme() *Theme {
+	if s.theme == nil {
+		return DefaultTheme()
+	}
+	return s.theme
+}
+
+// SetTheme sets the theme of the streams.
+func (s *IOStreams) SetTheme(theme *Theme) {
+	s.theme = theme
+}
+
 // NewColorable returns an output stream that handles ANSI color sequences on Windows
 func NewColorable(out io.Writer) io.Writer {
 	if outFile, isFile := out.(*os.File); isFile {
@@ -60,7 +91,7 @@ func makeColorFunc(isColorfulOutput bool, color string) func(string) string {
 
 	cf := ansi.ColorFunc(color)
 	return func(arg string) string {
-		if isColorfulOutput {
+		if isColorfulOutput && color != "" {
 			return cf(arg)
 		}
 		return arg
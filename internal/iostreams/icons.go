@@ -1,15 +1,15 @@
 package iostreams
 
 func (c *ColorPalette) GreenCheck() string {
-	return c.Green("✓")
+	return c.Success("✓")
 }
 
 func (c *ColorPalette) FailedIcon() string {
-	return c.Red("x")
+	return c.Error("x")
 }
 
 func (c *ColorPalette) WarnIcon() string {
-	return c.Yellow("!")
+	return c.Warning("!")
 }
 
 func (c *ColorPalette) RedCheck() string {
@@ -21,5 +21,5 @@ func (c *ColorPalette) ProgressIcon() string {
 }
 
 func (c *ColorPalette) DotWarnIcon() string {
-	return c.Yellow("•")
+	return c.Warning("•")
 }
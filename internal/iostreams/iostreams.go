@@ -47,6 +47,10 @@ type IOStreams struct {
 	displayHyperlinks string
 
 	isColorEnabled bool
+
+	theme *Theme
+
+	noTruncate bool // show the full contents of table columns
 }
 
 var controlCharRegEx = regexp.MustCompile(`(\x1b\[)((?:(\d*)(;*))*)([A-Z,a-l,n-z])`)
@@ -248,6 +252,16 @@ func (s *IOStreams) StopSpinner(format string, a ...any) {
 	}
 }
 
+// TruncateTables reports whether tables truncate their columns to fit the terminal width.
+func (s *IOStreams) TruncateTables() bool {
+	return !s.noTruncate
+}
+
+// SetTruncateTables sets whether tables truncate their columns to fit the terminal width.
+func (s *IOStreams) SetTruncateTables(truncate bool) {
+	s.noTruncate = !truncate
+}
+
 func (s *IOStreams) TerminalWidth() int {
 	return TerminalWidth(s.StdOut)
 }
@@ -325,7 +339,7 @@ func (s *IOStreams) Hyperlink(displayText, targetURL string) string {
 	openSequence := fmt.Sprintf("\x1b]8;;%s\x1b\\", targetURL)
 	closeSequence := "\x1b]8;;\x1b\\"
 
-	return openSequence + displayText + closeSequence
+	return openSequence + s.Color().Link(displayText) + closeSequence
 }
 
 func (s *IOStreams) Confirm(ctx context.Context, result *bool, title string) error {
@@ -0,0 +1,140 @@
+package iostreams
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	progressBarWidth    = 30
+	progressRedrawDelay = 100 * time.Millisecond
+)
+
+// Progress draws a progress bar for each of several concurrent tasks on stderr.
+// Nothing is drawn when the output is not a terminal.
+type Progress struct {
+	mu       sync.Mutex
+	s        *IOStreams
+	enabled  bool
+	bars     []*ProgressBar
+	drawn    int
+	lastDraw time.Time
+}
+
+// ProgressBar tracks the progress of one task. It implements io.Writer, so it can
+// count the bytes that pass through an io.TeeReader.
+type ProgressBar struct {
+	p       *Progress
+	name    string
+	total   int64
+	current int64
+	state   string
+}
+
+// NewProgress returns a Progress that draws on the stderr of s.
+func (s *IOStreams) NewProgress() *Progress {
+	return &Progress{s: s, enabled: s.IsOutputTTY()}
+}
+
+// Enabled reports whether the progress bars are drawn.
+func (p *Progress) Enabled() bool {
+	return p.enabled
+}
+
+// AddBar adds a bar for a task of total bytes.
+func (p *Progress) AddBar(name string, total int64) *ProgressBar {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	b := &ProgressBar{p: p, name: name, total: total}
+	p.bars = append(p.bars, b)
+	p.draw(true)
+	return b
+}
+
+func (b *ProgressBar) Write(data []byte) (int, error) {
+	b.p.mu.Lock()
+	defer b.p.mu.Unlock()
+
+	b.current += int64(len(data))
+	b.p.draw(false)
+	return len(data), nil
+}
+
+// Reset sets the bar back to zero, for example before the task is retried.
+func (b *ProgressBar) Reset() {
+	b.p.mu.Lock()
+	defer b.p.mu.Unlock()
+
+	b.current = 0
+	b.p.draw(true)
+}
+
+// Done marks the task as finished, successfully or not.
+func (b *ProgressBar) Done(err error) {
+	b.p.mu.Lock()
+	defer b.p.mu.Unlock()
+
+	c := b.p.s.Color()
+	if err != nil {
+		b.state = c.FailedIcon()
+	} else {
+		b.current = b.total
+		b.state = c.GreenCheck()
+	}
+	b.p.draw(true)
+}
+
+// draw redraws all the bars in place. Unless force is set, redraws are
+// throttled. The caller must hold p.mu.
+func (p *Progress) draw(force bool) {
+	if !p.enabled || (!force && time.Since(p.lastDraw) < progressRedrawDelay) {
+		return
+	}
+	p.lastDraw = time.Now()
+
+	var out strings.Builder
+	if p.drawn > 0 {
+		fmt.Fprintf(&out, "\x1b[%dA", p.drawn)
+	}
+	for _, b := range p.bars {
+		out.WriteString("\x1b[2K")
+		out.WriteString(b.render())
+		out.WriteString("\n")
+	}
+	p.drawn = len(p.bars)
+	fmt.Fprint(p.s.StdErr, out.String())
+}
+
+func (b *ProgressBar) render() string {
+	ratio := 1.0
+	if b.total > 0 {
+		ratio = min(float64(b.current)/float64(b.total), 1)
+	}
+	filled := int(ratio * progressBarWidth)
+
+	state := b.state
+	if state == "" {
+		state = b.p.s.Color().ProgressIcon()
+	}
+	return fmt.Sprintf("%s [%s%s] %3d%% %s %s",
+		state,
+		strings.Repeat("=", filled), strings.Repeat(" ", progressBarWidth-filled),
+		int(ratio*100), formatBytes(b.total), b.name)
+}
+
+// formatBytes returns a size in bytes with a binary unit, like "1.5 MiB".
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
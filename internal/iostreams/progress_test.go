@@ -0,0 +1,49 @@
+//go:build !integration
+
+package iostreams
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProgress(t *testing.T) {
+	t.Run("draws bars on a terminal", func(t *testing.T) {
+		var stderr bytes.Buffer
+		s := New(WithStdout(&bytes.Buffer{}, true), WithStderr(&stderr, true))
+		p := s.NewProgress()
+		assert.True(t, p.Enabled())
+
+		a := p.AddBar("a.tar.gz", 2048)
+		b := p.AddBar("b.tar.gz", 100)
+		_, _ = a.Write(make([]byte, 1024))
+		assert.Contains(t, a.render(), "[===============               ]  50% 2.0 KiB a.tar.gz")
+		a.Done(nil)
+		b.Done(errors.New("failed"))
+
+		out := stderr.String()
+		assert.Contains(t, out, "[==============================] 100% 2.0 KiB a.tar.gz")
+		assert.Contains(t, out, "\x1b[2A")
+	})
+
+	t.Run("draws nothing without a terminal", func(t *testing.T) {
+		var stderr bytes.Buffer
+		s := New(WithStdout(&bytes.Buffer{}, false), WithStderr(&stderr, false))
+		p := s.NewProgress()
+		assert.False(t, p.Enabled())
+
+		bar := p.AddBar("a.tar.gz", 10)
+		_, _ = bar.Write(make([]byte, 5))
+		bar.Done(nil)
+		assert.Empty(t, stderr.String())
+	})
+}
+
+func TestFormatBytes(t *testing.T) {
+	assert.Equal(t, "512 B", formatBytes(512))
+	assert.Equal(t, "1.5 KiB", formatBytes(1536))
+	assert.Equal(t, "3.0 MiB", formatBytes(3*1024*1024))
+}
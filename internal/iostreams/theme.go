@@ -0,0 +1,156 @@
+package iostreams
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Theme maps the elements of the output to colors. Colors are given like
+// "green", "red+b" for bold, "black+h" for high intensity, or "208" for a
+// color of the 256-color palette, which falls back to the color of the
+// default theme on terminals that only have 16 colors. An empty color leaves
+// the element uncolored.
+type Theme struct {
+	name   string
+	colors map[string]string
+}
+
+// ThemeElements are the elements that a theme colors: the colors of the
+// palette, followed by the semantic elements.
+var ThemeElements = []string{
+	"magenta", "cyan", "red", "yellow", "blue", "green", "gray", "bold",
+	"header", "success", "warning", "error", "hyperlink", "diff_add", "diff_remove",
+}
+
+var themes = map[string]map[string]string{
+	"default": {
+		"magenta": "magenta", "cyan": "cyan", "red": "red", "yellow": "yellow",
+		"blue": "blue", "green": "green", "gray": "black+h", "bold": "default+b",
+		"header": "default+b", "success": "green", "warning": "yellow", "error": "red",
+		"hyperlink": "", "diff_add": "green", "diff_remove": "red",
+	},
+	// light has darker colors that stay readable on light backgrounds.
+	"light": {
+		"magenta": "127", "cyan": "30", "red": "160", "yellow": "136",
+		"blue": "25", "green": "28", "gray": "244", "bold": "default+b",
+		"header": "default+b", "success": "28", "warning": "136", "error": "160",
+		"hyperlink": "25+u", "diff_add": "28", "diff_remove": "160",
+	},
+	"dracula": {
+		"magenta": "212", "cyan": "117", "red": "203", "yellow": "228",
+		"blue": "141", "green": "84", "gray": "61", "bold": "default+b",
+		"header": "141+b", "success": "84", "warning": "215", "error": "203",
+		"hyperlink": "117+u", "diff_add": "84", "diff_remove": "203",
+	},
+	"solarized": {
+		"magenta": "125", "cyan": "37", "red": "160", "yellow": "136",
+		"blue": "33", "green": "64", "gray": "240", "bold": "default+b",
+		"header": "33+b", "success": "64", "warning": "166", "error": "160",
+		"hyperlink": "37+u", "diff_add": "64", "diff_remove": "160",
+	},
+}
+
+// ThemeNames returns the names of the themes, sorted.
+func ThemeNames() []string {
+	return slices.Sorted(maps.Keys(themes))
+}
+
+// DefaultTheme returns the default theme.
+func DefaultTheme() *Theme {
+	return &Theme{name: "default", colors: themes["default"]}
+}
+
+// NewTheme returns the theme with the name, or the default theme when name is
+// empty, with the colors of some elements replaced by overrides: a
+// comma-separated list of element=color rules, like "success=84,warning=208".
+func NewTheme(name, overrides string) (*Theme, error) {
+	if name == "" {
+		name = "default"
+	}
+	base, ok := themes[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown theme %q. Use one of: %s.", name, strings.Join(ThemeNames(), ", "))
+	}
+
+	colors := maps.Clone(base)
+	for rule := range strings.SplitSeq(overrides, ",") {
+		if strings.TrimSpace(rule) == "" {
+			continue
+		}
+		element, color, ok := strings.Cut(rule, "=")
+		element, color = strings.TrimSpace(element), strings.TrimSpace(color)
+		if !ok {
+			return nil, fmt.Errorf("invalid theme color %q. Use element=color, such as success=green.", rule)
+		}
+		if !slices.Contains(ThemeElements, element) {
+			return nil, fmt.Errorf("unknown theme element %q. Use one of: %s.", element, strings.Join(ThemeElements, ", "))
+		}
+		if color != "" && !isValidColor(color) {
+			return nil, fmt.Errorf("invalid color %q for %s.", color, element)
+		}
+		colors[element] = color
+	}
+	return &Theme{name: name, colors: colors}, nil
+}
+
+// Name returns the name of the theme.
+func (t *Theme) Name() string {
+	return t.name
+}
+
+// Paint colors text like element, whether or not the output is a TTY.
+func (t *Theme) Paint(element, text string) string {
+	return makeColorFunc(true, t.color(element))(text)
+}
+
+// color returns the color of the element, or the color of the default theme
+// when the color needs 256 colors that the terminal doesn't have.
+func (t *Theme) color(element string) string {
+	color := t.colors[element]
+	if !is256ColorSupported() && uses256Colors(color) {
+		return themes["default"][element]
+	}
+	return color
+}
+
+var colorPattern = regexp.MustCompile(`^([a-z]+|\d{1,3})?(\+[bBuih]+)?(:([a-z]+|\d{1,3})(\+h)?)?$`)
+
+var colorNames = []string{"black", "red", "green", "yellow", "blue", "magenta", "cyan", "white", "default"}
+
+func isValidColor(color string) bool {
+	match := colorPattern.FindStringSubmatch(color)
+	if match == nil {
+		return false
+	}
+	for _, c := range []string{match[1], match[4]} {
+		if c == "" {
+			continue
+		}
+		if n, err := strconv.Atoi(c); err == nil {
+			if n > 255 {
+				return false
+			}
+		} else if !slices.Contains(colorNames, c) {
+			return false
+		}
+	}
+	return true
+}
+
+func uses256Colors(color string) bool {
+	for part := range strings.SplitSeq(color, ":") {
+		fg, _, _ := strings.Cut(part, "+")
+		if n, err := strconv.Atoi(fg); err == nil && n > 15 {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *Theme) colorFunc(isColorfulOutput bool, element string) func(string) string {
+	return makeColorFunc(isColorfulOutput, t.color(element))
+}
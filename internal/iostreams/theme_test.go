@@ -0,0 +1,106 @@
+//go:build !integration
+
+package iostreams
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTheme(t *testing.T) {
+	tests := []struct {
+		name      string
+		theme     string
+		overrides string
+		term      string
+		element   string
+		want      string
+		wantErr   string
+	}{
+		{
+			name:    "default theme",
+			element: "success",
+			want:    "\x1b[0;32mtext\x1b[0m",
+		},
+		{
+			name:    "named theme",
+			theme:   "dracula",
+			term:    "xterm-256color",
+			element: "success",
+			want:    "\x1b[0;38;5;84mtext\x1b[0m",
+		},
+		{
+			name:    "256 colors fall back to the default theme",
+			theme:   "dracula",
+			term:    "xterm-16color",
+			element: "success",
+			want:    "\x1b[0;32mtext\x1b[0m",
+		},
+		{
+			name:      "override",
+			theme:     "light",
+			overrides: "success=blue+b, hyperlink=",
+			element:   "success",
+			want:      "\x1b[0;1;34mtext\x1b[0m",
+		},
+		{
+			name:      "override without color",
+			theme:     "light",
+			overrides: "hyperlink=",
+			element:   "hyperlink",
+			want:      "text",
+		},
+		{
+			name:    "unknown theme",
+			theme:   "neon",
+			wantErr: `unknown theme "neon". Use one of: default, dracula, light, solarized.`,
+		},
+		{
+			name:      "unknown element",
+			overrides: "footer=red",
+			wantErr:   `unknown theme element "footer". Use one of: magenta, cyan, red, yellow, blue, green, gray, bold, header, success, warning, error, hyperlink, diff_add, diff_remove.`,
+		},
+		{
+			name:      "invalid color",
+			overrides: "success=grean",
+			wantErr:   `invalid color "grean" for success.`,
+		},
+		{
+			name:      "invalid rule",
+			overrides: "success",
+			wantErr:   `invalid theme color "success". Use element=color, such as success=green.`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("COLORTERM", "")
+			t.Setenv("TERM", tt.term)
+
+			theme, err := NewTheme(tt.theme, tt.overrides)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, theme.Paint(tt.element, "text"))
+		})
+	}
+}
+
+func TestColor_Theme(t *testing.T) {
+	t.Setenv("COLORTERM", "")
+	t.Setenv("TERM", "xterm-256color")
+	t.Setenv("COLOR_ENABLED", "1")
+
+	ios := New(WithStdout(nil, true), WithStderr(nil, true))
+	theme, err := NewTheme("solarized", "")
+	require.NoError(t, err)
+	ios.SetTheme(theme)
+
+	assert.Equal(t, "\x1b[0;38;5;64m✓\x1b[0m", ios.Color().GreenCheck())
+	assert.Equal(t, "\x1b[0;38;5;166m!\x1b[0m", ios.Color().WarnIcon())
+	assert.Equal(t, "solarized", ios.Theme().Name())
+}
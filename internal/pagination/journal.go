@@ -0,0 +1,190 @@
+// Package pagination helps commands that fetch every page of a list to retry
+// failed pages and to continue an interrupted run.
+package pagination
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"gitlab.com/gitlab-org/cli/internal/filelock"
+)
+
+// Journal records the progress of a paginated request, so an interrupted run
+// can continue from the last completed page with --resume.
+type Journal struct {
+	path string
+
+	Request string `json:"request"`
+	// Next identifies where the next page starts: the URL or number of the next
+	// page, or the end cursor of the last completed page for GraphQL requests.
+	Next  string `json:"next"`
+	Pages int    `json:"pages"`
+	// Items is the number of items recorded with Save. Commands that write their
+	// output only once all pages are completed record the items of each page.
+	Items int `json:"items"`
+}
+
+// journalDir returns the directory where journals are stored.
+func journalDir() (string, error) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(cacheDir, "glab", "journal"), nil
+}
+
+// JournalKey identifies a request by the command and everything that changes
+// its results, like the host, path, and filters, so only the same request
+// continues from a journal.
+func JournalKey(command string, request any) (string, error) {
+	b, err := json.Marshal(struct {
+		Command string `json:"command"`
+		Request any    `json:"request"`
+	}{command, request})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// OpenJournal reads the journal of the request identified by key. The journal is
+// empty when no previous run of the request was interrupted.
+func OpenJournal(key, request string) (*Journal, error) {
+	dir, err := journalDir()
+	if err != nil {
+		return nil, err
+	}
+	j := &Journal{path: filepath.Join(dir, key+".json")}
+
+	b, err := os.ReadFile(j.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		j.Request = request
+		return j, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, j); err != nil {
+		return nil, fmt.Errorf("invalid journal %s: %w", j.path, err)
+	}
+	return j, nil
+}
+
+// itemsPath returns the path of the file with the items recorded by Save.
+func (j *Journal) itemsPath() string {
+	return j.path[:len(j.path)-len(".json")] + ".ndjson"
+}
+
+// Save records that a page was completed with its items, and where the next one starts.
+func (j *Journal) Save(next string, items ...any) error {
+	if err := os.MkdirAll(filepath.Dir(j.path), 0o700); err != nil {
+		return err
+	}
+
+	if len(items) > 0 {
+		if err := j.appendItems(items); err != nil {
+			return err
+		}
+	}
+
+	j.Next = next
+	j.Pages++
+	j.Items += len(items)
+
+	b, err := json.Marshal(j)
+	if err != nil {
+		return err
+	}
+	// Replace the journal atomically, so an interruption never leaves a partial journal.
+	return filelock.WriteFile(j.path, b, 0o600)
+}
+
+// appendItems appends items to the items file, after the items recorded by the
+// journal. Items of a page that was interrupted before the journal was saved are
+// overwritten.
+func (j *Journal) appendItems(items []any) error {
+	f, err := os.OpenFile(j.itemsPath(), os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	offset, err := skipLines(f, j.Items)
+	if err != nil {
+		return err
+	}
+	if err := f.Truncate(offset); err != nil {
+		return err
+	}
+	if _, err := f.Seek(offset, 0); err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	for _, item := range items {
+		if err := enc.Encode(item); err != nil {
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	return f.Sync()
+}
+
+// skipLines returns the offset after the first n lines of f.
+func skipLines(f *os.File, n int) (int64, error) {
+	r := bufio.NewReader(f)
+	var offset int64
+	for range n {
+		line, err := r.ReadBytes('\n')
+		if err != nil {
+			return 0, fmt.Errorf("the items file of the journal is incomplete: %w", err)
+		}
+		offset += int64(len(line))
+	}
+	return offset, nil
+}
+
+// LoadItems returns the items recorded in the journal by previous runs.
+func LoadItems[T any](j *Journal) ([]T, error) {
+	items := make([]T, 0, j.Items)
+	if j.Items == 0 {
+		return items, nil
+	}
+
+	f, err := os.Open(j.itemsPath())
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	dec := json.NewDecoder(f)
+	for range j.Items {
+		var item T
+		if err := dec.Decode(&item); err != nil {
+			return nil, fmt.Errorf("invalid journal items %s: %w", j.itemsPath(), err)
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// Remove deletes the journal once all pages are completed.
+func (j *Journal) Remove() error {
+	for _, path := range []string{j.path, j.itemsPath()} {
+		if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
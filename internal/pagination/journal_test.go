@@ -0,0 +1,87 @@
+//go:build !integration
+
+package pagination
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type item struct {
+	ID int `json:"id"`
+}
+
+func TestJournal(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	key, err := JournalKey("test", map[string]string{"path": "issues"})
+	require.NoError(t, err)
+
+	j, err := OpenJournal(key, "GET issues")
+	require.NoError(t, err)
+	assert.Empty(t, j.Next)
+
+	require.NoError(t, j.Save("2", item{1}, item{2}))
+	require.NoError(t, j.Save("3", item{3}))
+
+	j, err = OpenJournal(key, "GET issues")
+	require.NoError(t, err)
+	assert.Equal(t, "GET issues", j.Request)
+	assert.Equal(t, "3", j.Next)
+	assert.Equal(t, 2, j.Pages)
+
+	items, err := LoadItems[item](j)
+	require.NoError(t, err)
+	assert.Equal(t, []item{{1}, {2}, {3}}, items)
+
+	require.NoError(t, j.Remove())
+	assert.NoFileExists(t, j.path)
+	assert.NoFileExists(t, j.itemsPath())
+
+	j, err = OpenJournal(key, "GET issues")
+	require.NoError(t, err)
+	assert.Empty(t, j.Next)
+	assert.Zero(t, j.Items)
+}
+
+func TestJournal_overwritesItemsOfInterruptedPage(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	j, err := OpenJournal("key", "GET issues")
+	require.NoError(t, err)
+	require.NoError(t, j.Save("2", item{1}))
+
+	// A run that was interrupted after writing the items of a page, but before saving the journal.
+	f, err := os.OpenFile(j.itemsPath(), os.O_APPEND|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+	_, err = f.WriteString("{\"id\":2}\n{\"id\":")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	j, err = OpenJournal("key", "GET issues")
+	require.NoError(t, err)
+	require.NoError(t, j.Save("3", item{2}))
+
+	items, err := LoadItems[item](j)
+	require.NoError(t, err)
+	assert.Equal(t, []item{{1}, {2}}, items)
+
+	data, err := os.ReadFile(j.itemsPath())
+	require.NoError(t, err)
+	assert.Equal(t, "{\"id\":1}\n{\"id\":2}\n", string(data))
+}
+
+func TestJournalKey(t *testing.T) {
+	k1, err := JournalKey("api", map[string]string{"path": "issues"})
+	require.NoError(t, err)
+	k2, err := JournalKey("api", map[string]string{"path": "issues"})
+	require.NoError(t, err)
+	k3, err := JournalKey("issue export", map[string]string{"path": "issues"})
+	require.NoError(t, err)
+
+	assert.Equal(t, k1, k2)
+	assert.NotEqual(t, k1, k3)
+}
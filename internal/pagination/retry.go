@@ -0,0 +1,48 @@
+package pagination
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryableStatus reports whether a page request that failed with the status code
+// can succeed when retried later.
+func RetryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code >= http.StatusInternalServerError
+}
+
+// RetryDelay returns how long to wait before retrying a page request: the
+// Retry-After header of the response when set, otherwise an exponential backoff.
+func RetryDelay(resp *http.Response, attempt int) time.Duration {
+	if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	return time.Duration(1<<attempt) * time.Second
+}
+
+// Retry requests a page with fn, and retries it up to maxRetries times while it
+// fails with HTTP 429 or a server error. Each retry is reported to stderr.
+// It returns the response and error of the last request.
+func Retry(ctx context.Context, stderr io.Writer, maxRetries int, fn func() (*http.Response, error)) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := fn()
+		if resp == nil || attempt >= maxRetries || !RetryableStatus(resp.StatusCode) {
+			return resp, err
+		}
+		if resp.Body != nil {
+			resp.Body.Close()
+		}
+
+		delay := RetryDelay(resp, attempt)
+		fmt.Fprintf(stderr, "glab: HTTP %d, retrying in %s (%d/%d)\n", resp.StatusCode, delay, attempt+1, maxRetries)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
@@ -0,0 +1,104 @@
+//go:build !integration
+
+package pagination
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryDelay(t *testing.T) {
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"7"}}}
+	assert.Equal(t, 7*time.Second, RetryDelay(resp, 3))
+
+	resp = &http.Response{Header: http.Header{}}
+	assert.Equal(t, 1*time.Second, RetryDelay(resp, 0))
+	assert.Equal(t, 4*time.Second, RetryDelay(resp, 2))
+}
+
+func TestRetry(t *testing.T) {
+	retryNow := http.Header{"Retry-After": []string{"0"}}
+
+	tests := []struct {
+		name       string
+		statuses   []int
+		maxRetries int
+		wantStatus int
+		wantCalls  int
+		wantStderr string
+	}{
+		{
+			name:       "success",
+			statuses:   []int{http.StatusOK},
+			maxRetries: 3,
+			wantStatus: http.StatusOK,
+			wantCalls:  1,
+		},
+		{
+			name:       "retried server error",
+			statuses:   []int{http.StatusBadGateway, http.StatusTooManyRequests, http.StatusOK},
+			maxRetries: 3,
+			wantStatus: http.StatusOK,
+			wantCalls:  3,
+			wantStderr: "glab: HTTP 502, retrying in 0s (1/3)\nglab: HTTP 429, retrying in 0s (2/3)\n",
+		},
+		{
+			name:       "too many retries",
+			statuses:   []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable},
+			maxRetries: 1,
+			wantStatus: http.StatusServiceUnavailable,
+			wantCalls:  2,
+			wantStderr: "glab: HTTP 503, retrying in 0s (1/1)\n",
+		},
+		{
+			name:       "client error",
+			statuses:   []int{http.StatusNotFound},
+			maxRetries: 3,
+			wantStatus: http.StatusNotFound,
+			wantCalls:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stderr bytes.Buffer
+			calls := 0
+			resp, err := Retry(t.Context(), &stderr, tt.maxRetries, func() (*http.Response, error) {
+				status := tt.statuses[calls]
+				calls++
+				return &http.Response{StatusCode: status, Header: retryNow}, nil
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, resp.StatusCode)
+			assert.Equal(t, tt.wantCalls, calls)
+			assert.Equal(t, tt.wantStderr, stderr.String())
+		})
+	}
+}
+
+func TestRetry_requestError(t *testing.T) {
+	calls := 0
+	_, err := Retry(t.Context(), &bytes.Buffer{}, 3, func() (*http.Response, error) {
+		calls++
+		return nil, errors.New("connection refused")
+	})
+	require.EqualError(t, err, "connection refused")
+	assert.Equal(t, 1, calls)
+}
+
+func TestRetry_contextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	_, err := Retry(ctx, &bytes.Buffer{}, 3, func() (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{"Retry-After": []string{"60"}}}, nil
+	})
+	require.ErrorIs(t, err, context.Canceled)
+}
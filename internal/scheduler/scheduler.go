@@ -0,0 +1,194 @@
+// Package scheduler stores actions, such as merging a merge request, that glab
+// should run at a later time. The actions are executed by 'glab schedule actions run'.
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/filelock"
+)
+
+const fileName = "scheduled-actions.json"
+
+// lockTimeout is how long changes wait for other glab processes to finish changing the actions.
+const lockTimeout = 30 * time.Second
+
+// Action types.
+const (
+	ActionMergeMR = "mr-merge"
+)
+
+// timeLayouts are the layouts accepted by ParseTime, in addition to RFC 3339.
+var timeLayouts = []string{
+	"2006-01-02T15:04",
+	"2006-01-02 15:04",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+}
+
+// Now returns the current time. Tests can replace it.
+var Now = time.Now
+
+type MergeOptions struct {
+	Squash             bool   `json:"squash,omitempty"`
+	RemoveSourceBranch bool   `json:"remove_source_branch,omitempty"`
+	SquashMessage      string `json:"squash_message,omitempty"`
+	MergeCommitMessage string `json:"merge_commit_message,omitempty"`
+	SHA                string `json:"sha,omitempty"`
+}
+
+// Action is a pending action.
+type Action struct {
+	ID        int           `json:"id"`
+	Type      string        `json:"type"`
+	Host      string        `json:"host"`
+	Repo      string        `json:"repo"`
+	MRIID     int64         `json:"mr_iid"`
+	At        time.Time     `json:"at"`
+	CreatedAt time.Time     `json:"created_at"`
+	Merge     *MergeOptions `json:"merge,omitempty"`
+	// LastError is the reason the action could not run when it was last due.
+	LastError string `json:"last_error,omitempty"`
+}
+
+// Due reports whether the action should run.
+func (a *Action) Due() bool {
+	return !Now().Before(a.At)
+}
+
+// Target describes what the action applies to.
+func (a *Action) Target() string {
+	return fmt.Sprintf("%s!%d", a.Repo, a.MRIID)
+}
+
+// ParseTime parses a time given on the command line. Times without a time zone are
+// interpreted in the local time zone.
+func ParseTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	for _, layout := range timeLayouts {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q. Use the format YYYY-MM-DDTHH:MM, optionally with seconds and a time zone offset.", value)
+}
+
+// Path returns the file the actions are stored in.
+func Path() string {
+	return filepath.Join(config.ConfigDir(), fileName)
+}
+
+// Load returns all pending actions, sorted by ID.
+func Load() ([]*Action, error) {
+	data, err := os.ReadFile(Path())
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading scheduled actions: %w", err)
+	}
+
+	var actions []*Action
+	if err := json.Unmarshal(data, &actions); err != nil {
+		return nil, fmt.Errorf("could not decode %s: %w", Path(), err)
+	}
+	return actions, nil
+}
+
+// Save replaces the pending actions.
+func Save(actions []*Action) error {
+	return withLock(func() error {
+		return save(actions)
+	})
+}
+
+// withLock runs fn while it holds the lock of the actions file, so concurrent glab processes,
+// like 'glab mr merge --at' and 'glab schedule actions run', don't overwrite each other's changes.
+func withLock(fn func() error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), lockTimeout)
+	defer cancel()
+	return filelock.With(ctx, Path(), fn)
+}
+
+// save writes the actions atomically, so Load never reads a partially written file.
+// The caller must hold the lock.
+func save(actions []*Action) error {
+	if actions == nil {
+		actions = []*Action{}
+	}
+
+	data, err := json.MarshalIndent(actions, "", "  ")
+	if err != nil {
+		return err
+	}
+	return filelock.WriteFile(Path(), data, 0o600)
+}
+
+// Add stores a new action and returns it with its assigned ID.
+func Add(action *Action) (*Action, error) {
+	err := withLock(func() error {
+		actions, err := Load()
+		if err != nil {
+			return err
+		}
+
+		action.ID = 1
+		for _, a := range actions {
+			if a.ID >= action.ID {
+				action.ID = a.ID + 1
+			}
+		}
+		action.CreatedAt = Now()
+
+		return save(append(actions, action))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return action, nil
+}
+
+// Remove deletes the action with the given ID.
+func Remove(id int) error {
+	return withLock(func() error {
+		actions, err := Load()
+		if err != nil {
+			return err
+		}
+
+		for i, a := range actions {
+			if a.ID == id {
+				return save(append(actions[:i], actions[i+1:]...))
+			}
+		}
+		return fmt.Errorf("no scheduled action with ID %d.", id)
+	})
+}
+
+// Update replaces the stored action with the same ID. It is a no-op when the
+// action was removed in the meantime.
+func Update(action *Action) error {
+	return withLock(func() error {
+		actions, err := Load()
+		if err != nil {
+			return err
+		}
+
+		for i, a := range actions {
+			if a.ID == action.ID {
+				actions[i] = action
+				return save(actions)
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,100 @@
+//go:build !integration
+
+package scheduler
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTime(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{value: "2024-06-01T09:00", want: time.Date(2024, 6, 1, 9, 0, 0, 0, time.Local)},
+		{value: "2024-06-01 09:00:30", want: time.Date(2024, 6, 1, 9, 0, 30, 0, time.Local)},
+		{value: "2024-06-01T09:00:00Z", want: time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)},
+		{value: "tomorrow", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseTime(tt.value)
+			if tt.wantErr {
+				assert.EqualError(t, err, `invalid time "tomorrow". Use the format YYYY-MM-DDTHH:MM, optionally with seconds and a time zone offset.`)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tt.want.Equal(got), "got %s", got)
+		})
+	}
+}
+
+func TestStore(t *testing.T) {
+	t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+
+	actions, err := Load()
+	require.NoError(t, err)
+	assert.Empty(t, actions)
+
+	first, err := Add(&Action{Type: ActionMergeMR, Repo: "OWNER/REPO", MRIID: 1})
+	require.NoError(t, err)
+	second, err := Add(&Action{Type: ActionMergeMR, Repo: "OWNER/REPO", MRIID: 2})
+	require.NoError(t, err)
+	assert.Equal(t, 1, first.ID)
+	assert.Equal(t, 2, second.ID)
+
+	second.LastError = "not approved"
+	require.NoError(t, Update(second))
+	require.NoError(t, Remove(first.ID))
+	assert.EqualError(t, Remove(first.ID), "no scheduled action with ID 1.")
+
+	actions, err = Load()
+	require.NoError(t, err)
+	require.Len(t, actions, 1)
+	assert.Equal(t, "OWNER/REPO!2", actions[0].Target())
+	assert.Equal(t, "not approved", actions[0].LastError)
+
+	third, err := Add(&Action{Type: ActionMergeMR, Repo: "OWNER/REPO", MRIID: 3})
+	require.NoError(t, err)
+	assert.Equal(t, 3, third.ID)
+}
+
+func TestStore_concurrentAdds(t *testing.T) {
+	t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+
+	var wg sync.WaitGroup
+	for i := range 10 {
+		wg.Go(func() {
+			_, err := Add(&Action{Type: ActionMergeMR, Repo: "OWNER/REPO", MRIID: int64(i)})
+			assert.NoError(t, err)
+		})
+	}
+	wg.Wait()
+
+	actions, err := Load()
+	require.NoError(t, err)
+	require.Len(t, actions, 10)
+	ids := map[int]bool{}
+	for _, a := range actions {
+		ids[a.ID] = true
+	}
+	assert.Len(t, ids, 10, "every action should get its own ID")
+	assert.NoFileExists(t, Path()+".lock")
+}
+
+func TestActionDue(t *testing.T) {
+	now := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+	Now = func() time.Time { return now }
+	t.Cleanup(func() { Now = time.Now })
+
+	assert.True(t, (&Action{At: now}).Due())
+	assert.True(t, (&Action{At: now.Add(-time.Minute)}).Due())
+	assert.False(t, (&Action{At: now.Add(time.Minute)}).Due())
+}
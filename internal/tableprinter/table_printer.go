@@ -2,8 +2,11 @@ package tableprinter
 
 import (
 	"fmt"
+	"regexp"
+	"slices"
 	"strings"
 
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
 	"gitlab.com/gitlab-org/cli/internal/text"
 )
 
@@ -17,6 +20,7 @@ func init() {
 		TTYSeparator:    "\t",
 		NonTTYSeparator: "\t",
 		TerminalWidth:   80,
+		Truncate:        true,
 	}
 }
 
@@ -26,6 +30,11 @@ type TablePrinter struct {
 	TotalRows int
 	// Wrap when set to true wraps the contents of the columns when the length exceeds the MaxColWidth
 	Wrap bool
+	// WrapColumns are the indexes of the columns that wrap, like Wrap, when the length exceeds their width
+	WrapColumns []int
+	// Truncate when set to false keeps the full contents of the columns on TTYs, even when the table is wider
+	// than the terminal. Default is true
+	Truncate bool
 	// MaxColWidth is the maximum allowed width for cells in the table
 	MaxColWidth int
 	// TTYSeparator is the separator for columns in the table on TTYs. Default is "\t"
@@ -38,6 +47,9 @@ type TablePrinter struct {
 	TerminalWidth int
 	// IsTTY indicates whether output is a TTY or non-TTY
 	IsTTY bool
+
+	// headerColor colors the cells of the rows added with AddHeader
+	headerColor func(string) string
 }
 
 type TableCell struct {
@@ -47,6 +59,8 @@ type TableCell struct {
 	Width int
 	// Wrap when true wraps the contents of the cell when the length exceeds the width
 	Wrap bool
+	// RightAlign when true pads the contents of the cell on the left
+	RightAlign bool
 
 	isaTTY bool
 }
@@ -65,11 +79,22 @@ func NewTablePrinter() *TablePrinter {
 		Wrap:            false,
 		TerminalWidth:   tp.TerminalWidth,
 		IsTTY:           tp.IsTTY,
+		Truncate:        tp.Truncate,
 	}
 
 	return t
 }
 
+// New returns a table printer for the output of streams, which truncates the columns unless
+// the streams have truncation turned off with --no-truncate, and colors its header with the
+// header element of the theme of the streams.
+func New(streams *iostreams.IOStreams) *TablePrinter {
+	t := NewTablePrinter()
+	t.Truncate = streams.TruncateTables()
+	t.headerColor = streams.Color().Header
+	return t
+}
+
 func (t *TablePrinter) Separator() string {
 	if t.IsTTY {
 		return t.TTYSeparator
@@ -100,6 +125,17 @@ func (t *TablePrinter) SetTTYSeparator(s string) {
 	t.TTYSeparator = s
 }
 
+// SetTruncate sets whether the columns are truncated to fit the terminal width on TTYs
+func (t *TablePrinter) SetTruncate(truncate bool) {
+	t.Truncate = truncate
+}
+
+// SetWrapColumns sets the indexes of the columns that wrap when the length exceeds their width,
+// like the title of a list of issues
+func (t *TablePrinter) SetWrapColumns(cols ...int) {
+	t.WrapColumns = cols
+}
+
 func (t *TablePrinter) makeRow() {
 	if t.Rows == nil {
 		t.Rows = make([]*TableRow, 1)
@@ -134,6 +170,17 @@ func (t *TablePrinter) AddRow(str ...any) {
 	t.EndRow()
 }
 
+// AddHeader adds a row of column names, colored with the header element of the theme
+func (t *TablePrinter) AddHeader(cols ...any) {
+	for _, col := range cols {
+		if t.headerColor != nil {
+			col = t.headerColor(fmt.Sprint(col))
+		}
+		t.AddCell(col)
+	}
+	t.EndRow()
+}
+
 func (t *TablePrinter) AddRowFunc(f func(int, int) string) {
 	for ri := range t.TotalRows {
 		row := make([]any, t.TotalRows)
@@ -229,13 +276,15 @@ func (t *TablePrinter) Render() string {
 	t.purgeRow()
 
 	colWidths := t.colWidths()
+	numericCols := t.numericCols()
 
 	var lines []string
 	for _, row := range t.Rows {
 		row.Separator = t.Separator()
 		for i, cell := range row.Cells {
 			cell.Width = colWidths[i]
-			cell.Wrap = t.Wrap
+			cell.Wrap = t.Wrap || slices.Contains(t.WrapColumns, i)
+			cell.RightAlign = numericCols[i]
 		}
 		lines = append(lines, row.String())
 	}
@@ -267,15 +316,52 @@ func (c *TableCell) String() string {
 	s := fmt.Sprintf("%v", c.Value)
 	// wrap or truncate the string if needed
 	if c.Width > 0 && c.isaTTY {
-		if c.Wrap && len(s) > c.Width {
-			return text.WrapString(s, c.Width)
-		} else {
-			return text.Truncate(s, c.Width)
+		if c.Wrap && text.StringWidth(s) > c.Width {
+			lines := strings.Split(text.WrapString(s, c.Width), "\n")
+			for i, line := range lines {
+				// words longer than the width are still truncated
+				lines[i] = c.fit(line)
+			}
+			return strings.Join(lines, "\n")
 		}
+		return c.fit(s)
 	}
 	return s
 }
 
+// fit pads the string to the width of the cell, or truncates it with an ellipsis when it's wider
+func (c *TableCell) fit(s string) string {
+	if c.RightAlign && text.StringWidth(s) < c.Width {
+		return text.PadLeft(s, c.Width, ' ')
+	}
+	return text.Truncate(s, c.Width)
+}
+
+// numberPattern matches plain decimal numbers, like 42, -3, or 1.5, unlike strconv.ParseFloat
+// which also accepts values like "inf", "NaN", or "0x1p-2".
+var numberPattern = regexp.MustCompile(`^[+-]?(\d+\.?\d*|\.\d+)$`)
+
+// numericCols determines the columns to right-align on TTYs: the columns where all the cells
+// but the header are numbers
+func (t *TablePrinter) numericCols() map[int]bool {
+	numericCols := map[int]bool{}
+	if !t.IsTTY || len(t.Rows) < 2 {
+		return numericCols
+	}
+	for _, row := range t.Rows[1:] {
+		for i, cell := range row.Cells {
+			value := text.Strip(strings.TrimSpace(fmt.Sprintf("%v", cell.Value)))
+			if cell.Value == nil || value == "" {
+				continue
+			}
+			if numeric, seen := numericCols[i]; !seen || numeric {
+				numericCols[i] = numberPattern.MatchString(value)
+			}
+		}
+	}
+	return numericCols
+}
+
 // colWidths determine the width for each column (cell in a row)
 func (t *TablePrinter) colWidths() []int {
 	var colWidths []int
@@ -286,7 +372,7 @@ func (t *TablePrinter) colWidths() []int {
 				colWidths = append(colWidths, 0)
 			}
 			cellwidth := cell.LineWidth()
-			if t.MaxColWidth != 0 && cellwidth > t.MaxColWidth {
+			if t.Truncate && t.MaxColWidth != 0 && cellwidth > t.MaxColWidth {
 				cellwidth = t.MaxColWidth
 			}
 
@@ -302,7 +388,7 @@ func (t *TablePrinter) colWidths() []int {
 		totalWidth += width
 	}
 
-	if t.MaxColWidth == 0 && totalWidth > t.TerminalWidth {
+	if t.Truncate && t.MaxColWidth == 0 && totalWidth > t.TerminalWidth {
 		availWidth := t.TerminalWidth - colWidths[0] - separatorWidth
 		// add extra space from columns that are already narrower than threshold
 		for col := 1; col < numCols; col++ {
@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
 )
 
 func Test_ttyTablePrinter_truncate(t *testing.T) {
@@ -70,3 +72,122 @@ func TestTablePrinter(t *testing.T) {
 		require.Equal(t, expected, tp.String())
 	})
 }
+
+func Test_ttyTablePrinter_wrapColumns(t *testing.T) {
+	tp := NewTablePrinter()
+	tp.SetTTYSeparator(" ")
+	tp.SetTerminalWidth(16)
+	tp.SetIsTTY(true)
+	tp.SetWrapColumns(1)
+
+	tp.AddRow("#1", "a long title to wrap", "x")
+	tp.AddRow("#2", "short", "y")
+
+	expected := "#1 a long   x\n" +
+		"   title to  \n" +
+		"   wrap      \n" +
+		"#2 short    y\n"
+	require.Equal(t, expected, tp.String())
+}
+
+func Test_ttyTablePrinter_wrapLongWord(t *testing.T) {
+	tp := NewTablePrinter()
+	tp.SetTTYSeparator(" ")
+	tp.SetTerminalWidth(10)
+	tp.SetIsTTY(true)
+	tp.SetWrapColumns(1)
+
+	tp.AddRow("1", "unbreakable word")
+
+	require.Equal(t, "1 unbre...\n  word    \n", tp.String())
+}
+
+func Test_ttyTablePrinter_rightAlignNumbers(t *testing.T) {
+	tp := NewTablePrinter()
+	tp.SetTTYSeparator(" ")
+	tp.SetIsTTY(true)
+
+	tp.AddRow("NAME", "AHEAD", "SIZE")
+	tp.AddRow("main", "0", "1.5")
+	tp.AddRow("feature", "128", "n/a")
+
+	expected := "NAME    AHEAD SIZE\n" +
+		"main        0 1.5 \n" +
+		"feature   128 n/a \n"
+	require.Equal(t, expected, tp.String())
+}
+
+func Test_ttyTablePrinter_numericCols(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: "42", want: "a       42"},
+		{value: "-3", want: "a       -3"},
+		{value: "+1.5", want: "a     +1.5"},
+		{value: ".5", want: "a       .5"},
+		{value: "inf", want: "a    inf  "},
+		{value: "NaN", want: "a    NaN  "},
+		{value: "1e3", want: "a    1e3  "},
+		{value: "0x1p2", want: "a    0x1p2"},
+		{value: "1_000", want: "a    1_000"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.value, func(t *testing.T) {
+			tp := NewTablePrinter()
+			tp.SetTTYSeparator(" ")
+			tp.SetIsTTY(true)
+			tp.AddRow("NAME", "VALUE")
+			tp.AddRow("a", tc.value)
+
+			require.Equal(t, "NAME VALUE\n"+tc.want+"\n", tp.String())
+		})
+	}
+}
+
+func TestNew_truncate(t *testing.T) {
+	streams := iostreams.New()
+	require.True(t, New(streams).Truncate)
+
+	streams.SetTruncateTables(false)
+	require.False(t, New(streams).Truncate)
+
+	// The setting belongs to the streams, so other streams still truncate.
+	require.True(t, New(iostreams.New()).Truncate)
+	require.True(t, NewTablePrinter().Truncate)
+}
+
+func Test_nonTTYTablePrinter_wrapAndAlign(t *testing.T) {
+	tp := NewTablePrinter()
+	tp.SetTerminalWidth(10)
+	tp.SetIsTTY(false)
+	tp.SetWrapColumns(1)
+
+	tp.AddRow("ID", "TITLE", "COUNT")
+	tp.AddRow("1", "a long title to wrap", "42")
+
+	require.Equal(t, "ID\tTITLE\tCOUNT\n1\ta long title to wrap\t42\n", tp.String())
+}
+
+func TestTablePrinter_AddHeader(t *testing.T) {
+	t.Setenv("COLORTERM", "")
+	t.Setenv("TERM", "xterm-256color")
+	t.Setenv("COLOR_ENABLED", "1")
+
+	streams := iostreams.New(iostreams.WithStdout(nil, true), iostreams.WithStderr(nil, true))
+	theme, err := iostreams.NewTheme("solarized", "header=160+b")
+	require.NoError(t, err)
+	streams.SetTheme(theme)
+
+	table := New(streams)
+	table.SetIsTTY(true)
+	table.AddHeader("ID", "NAME")
+	table.AddRow(1, "one")
+	require.Equal(t, "\x1b[0;1;38;5;160mID\x1b[0m\t\x1b[0;1;38;5;160mNAME\x1b[0m\n 1\tone \n", table.Render())
+
+	// Without streams, the header isn't colored.
+	plain := NewTablePrinter()
+	plain.AddHeader("ID", "NAME")
+	require.Equal(t, "ID\tNAME\n", plain.Render())
+}
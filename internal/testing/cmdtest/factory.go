@@ -133,6 +133,12 @@ func (f *Factory) RepoOverride(repo string) error {
 	return nil
 }
 
+func (f *Factory) BranchOverride(branch string) {
+	f.BranchStub = func() (string, error) {
+		return branch, nil
+	}
+}
+
 func (f *Factory) ApiClient(repoHost string) (*api.Client, error) {
 	return f.ApiClientStub(repoHost)
 }
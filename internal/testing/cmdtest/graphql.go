@@ -0,0 +1,24 @@
+package cmdtest
+
+import (
+	"encoding/json"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// GraphQLResponse returns a GraphQL.Do mock action that decodes body into the response.
+func GraphQLResponse(body string) func(gitlab.GraphQLQuery, any, ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return GraphQLResponses(body)
+}
+
+// GraphQLResponses returns a GraphQL.Do mock action that decodes the next body
+// into the response on each call.
+func GraphQLResponses(bodies ...string) func(gitlab.GraphQLQuery, any, ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return func(_ gitlab.GraphQLQuery, response any, _ ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+		body := bodies[0]
+		if len(bodies) > 1 {
+			bodies = bodies[1:]
+		}
+		return nil, json.Unmarshal([]byte(body), response)
+	}
+}
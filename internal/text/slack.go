@@ -0,0 +1,44 @@
+package text
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SlackPreviewLines is the number of lines of a description that Slack previews quote.
+const SlackPreviewLines = 10
+
+// slackEscaper escapes the characters that Slack mrkdwn uses for links and mentions.
+var slackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// SlackEscape escapes s so that Slack mrkdwn shows it as plain text.
+func SlackEscape(s string) string {
+	return slackEscaper.Replace(s)
+}
+
+// SlackLink returns a Slack mrkdwn link to url, shown as label.
+func SlackLink(url, label string) string {
+	// Labels end at the first '>', and can't contain a '|'.
+	return fmt.Sprintf("<%s|%s>", url, strings.ReplaceAll(SlackEscape(label), "|", "¦"))
+}
+
+// SlackBold returns s in bold, with the leading and trailing spaces that stop
+// Slack from rendering it removed.
+func SlackBold(s string) string {
+	return "*" + strings.TrimSpace(s) + "*"
+}
+
+// SlackQuote returns s as a Slack mrkdwn block quote of at most maxLines lines.
+// A trailing quoted "…" shows that lines were cut.
+func SlackQuote(s string, maxLines int) string {
+	lines := strings.Split(strings.TrimSpace(s), "\n")
+	if len(lines) > maxLines {
+		lines = append(lines[:maxLines], "…")
+	}
+
+	var b strings.Builder
+	for _, line := range lines {
+		b.WriteString("> " + SlackEscape(strings.TrimRight(line, "\r")) + "\n")
+	}
+	return b.String()
+}
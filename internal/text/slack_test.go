@@ -0,0 +1,27 @@
+//go:build !integration
+
+package text
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlackEscape(t *testing.T) {
+	assert.Equal(t, "Fix &lt;br&gt; &amp; &lt;hr&gt; tags", SlackEscape("Fix <br> & <hr> tags"))
+}
+
+func TestSlackLink(t *testing.T) {
+	assert.Equal(t, "<https://gitlab.com/OWNER/REPO/-/issues/1|#1 Use a ¦ in &lt;title&gt;>",
+		SlackLink("https://gitlab.com/OWNER/REPO/-/issues/1", "#1 Use a | in <title>"))
+}
+
+func TestSlackBold(t *testing.T) {
+	assert.Equal(t, "*Showing 2 issues.*", SlackBold(" Showing 2 issues.\n"))
+}
+
+func TestSlackQuote(t *testing.T) {
+	assert.Equal(t, "> first\n> second\n", SlackQuote("first\r\nsecond\n", 5))
+	assert.Equal(t, "> one\n> two\n> …\n", SlackQuote("one\ntwo\nthree", 2))
+}
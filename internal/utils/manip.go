@@ -20,3 +20,11 @@ func StringToInt(str string) int {
 	}
 	return strInt
 }
+
+// ShortSHA returns the first 8 characters of a commit SHA, like GitLab's short IDs.
+func ShortSHA(sha string) string {
+	if len(sha) > 8 {
+		return sha[:8]
+	}
+	return sha
+}
@@ -23,4 +23,8 @@ func Test_HelperFuncs(t *testing.T) {
 		got := ReplaceNonAlphaNumericChars("profclems-glab", "/")
 		assert.Equal(t, "profclems/glab", got)
 	})
+	t.Run("ShortSHA()", func(t *testing.T) {
+		assert.Equal(t, "0123abcd", ShortSHA("0123abcd4567ef"))
+		assert.Equal(t, "0123", ShortSHA("0123"))
+	})
 }
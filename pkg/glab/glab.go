@@ -0,0 +1,190 @@
+// Package glab runs glab commands from Go programs, without shelling out to the
+// glab binary.
+//
+// A Client holds the settings shared by the commands it runs: the input and
+// output streams, the GitLab host, the token, and the default repository.
+// Commands are passed the same arguments as on the command line:
+//
+//	var out bytes.Buffer
+//	client, err := glab.New(glab.WithStdout(&out), glab.WithRepo("gitlab-org/cli"))
+//	if err != nil {
+//		return err
+//	}
+//	if err := client.Run(ctx, "mr", "list", "--output", "json"); err != nil {
+//		return err
+//	}
+//
+// The output streams are never terminals, so commands don't prompt and print
+// their non-TTY output. Aliases aren't expanded.
+package glab
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"runtime"
+	"strings"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+)
+
+// Client runs glab commands.
+type Client struct {
+	stdin   io.Reader
+	stdout  io.Writer
+	stderr  io.Writer
+	host    string
+	token   string
+	repo    string
+	version string
+
+	cfg config.Config
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithStdin sets the standard input of the commands. It defaults to no input.
+func WithStdin(r io.Reader) Option {
+	return func(c *Client) {
+		c.stdin = r
+	}
+}
+
+// WithStdout sets the standard output of the commands. It defaults to io.Discard.
+func WithStdout(w io.Writer) Option {
+	return func(c *Client) {
+		c.stdout = w
+	}
+}
+
+// WithStderr sets the standard error of the commands. It defaults to io.Discard.
+func WithStderr(w io.Writer) Option {
+	return func(c *Client) {
+		c.stderr = w
+	}
+}
+
+// WithHost sets the GitLab host, like gitlab.example.com, used when the
+// repository doesn't name one.
+func WithHost(host string) Option {
+	return func(c *Client) {
+		c.host = host
+	}
+}
+
+// WithToken authenticates the commands with a token for the host. The glab
+// configuration file isn't read or written when a token is set.
+func WithToken(token string) Option {
+	return func(c *Client) {
+		c.token = token
+	}
+}
+
+// WithRepo sets the repository of the commands, in OWNER/REPO, GROUP/NAMESPACE/REPO,
+// or full URL format, like the --repo flag. It defaults to the repository of the
+// git remotes in the working directory.
+func WithRepo(repo string) Option {
+	return func(c *Client) {
+		c.repo = repo
+	}
+}
+
+// WithVersion sets the version reported by the commands, and sent in the user agent.
+func WithVersion(version string) Option {
+	return func(c *Client) {
+		c.version = version
+	}
+}
+
+// New returns a Client configured with opts.
+func New(opts ...Option) (*Client, error) {
+	c := &Client{
+		stdout:  io.Discard,
+		stderr:  io.Discard,
+		host:    os.Getenv("GITLAB_HOST"),
+		version: "DEV",
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.token == "" {
+		cfg, err := config.Init()
+		if err != nil {
+			return nil, err
+		}
+		c.cfg = cfg
+	} else {
+		c.cfg = config.NewBlankConfig()
+		host := c.host
+		if host == "" {
+			host = "gitlab.com"
+		}
+		if err := c.cfg.Set(host, "token", c.token); err != nil {
+			return nil, err
+		}
+	}
+	if c.host != "" {
+		if err := c.cfg.Set("", "host", c.host); err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+// ExitError is returned by Run when a command fails with a specific exit code.
+// The glab binary exits with Code for these errors, and with 1 for other errors.
+type ExitError struct {
+	Err  error
+	Code int
+}
+
+func (e *ExitError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ExitError) Unwrap() error {
+	return e.Err
+}
+
+// Run runs the glab command named by args, like "issue", "list", "--label", "bug".
+// Each call gets a fresh command tree, so flags and the resolved repository don't
+// carry over from one call to the next.
+func (c *Client) Run(ctx context.Context, args ...string) error {
+	stdin := io.NopCloser(strings.NewReader(""))
+	if c.stdin != nil {
+		stdin = io.NopCloser(c.stdin)
+	}
+	ios := iostreams.New(
+		iostreams.WithStdin(stdin, false),
+		iostreams.WithStdout(c.stdout, false),
+		iostreams.WithStderr(c.stderr, false),
+	)
+
+	f := cmdutils.NewFactory(ios, true, c.cfg, api.BuildInfo{Version: c.version, Platform: runtime.GOOS, Architecture: runtime.GOARCH})
+	if err := f.RepoOverride(c.repo); err != nil {
+		return err
+	}
+
+	rootCmd := commands.NewCmdRootForArgs(f, args)
+	rootCmd.SetArgs(args)
+	rootCmd.SetIn(stdin)
+	rootCmd.SetOut(c.stdout)
+	rootCmd.SetErr(c.stderr)
+
+	if err := rootCmd.ExecuteContext(ctx); err != nil {
+		var exitError *cmdutils.ExitError
+		if errors.As(err, &exitError) {
+			return &ExitError{Err: err, Code: exitError.Code}
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+//go:build !integration
+
+package glab
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientRun(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	client, err := New(WithStdout(&stdout), WithStderr(&stderr), WithToken("token"), WithVersion("v1.2.3"))
+	require.NoError(t, err)
+
+	require.NoError(t, client.Run(context.Background(), "version"))
+	assert.Equal(t, "glab 1.2.3 ()\n", stdout.String())
+	assert.Empty(t, stderr.String())
+
+	// Flags don't carry over between calls.
+	stdout.Reset()
+	err = client.Run(context.Background(), "version", "--unknown")
+	assert.ErrorContains(t, err, "unknown flag: --unknown")
+	assert.Empty(t, stdout.String())
+}
+
+func TestClientRun_invalidRepo(t *testing.T) {
+	client, err := New(WithToken("token"), WithRepo("invalid"))
+	require.NoError(t, err)
+
+	assert.Error(t, client.Run(context.Background(), "version"))
+}